@@ -0,0 +1,319 @@
+package attributes
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DateLayout = "2006-01-02"
+
+const (
+	OperatorEQ       = "eq"
+	OperatorNEQ      = "neq"
+	OperatorLT       = "lt"
+	OperatorLTE      = "lte"
+	OperatorGT       = "gt"
+	OperatorGTE      = "gte"
+	OperatorContains = "contains"
+	OperatorExists   = "exists"
+)
+
+// Coerce converts a value to the canonical representation of the type of the attribute definition.
+// Textual representations are accepted for all types, which allows using Coerce for default values and imports.
+func Coerce(def *ent.AttributeDefinition, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch def.Type {
+	case attributedefinition.TypeString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case attributedefinition.TypeNumber:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case float32:
+			return float64(v), nil
+		case int:
+			return float64(v), nil
+		case int32:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case string:
+			number, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a number", v)
+			}
+			return number, nil
+		}
+	case attributedefinition.TypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v.Format(DateLayout), nil
+		case string:
+			v = strings.TrimSpace(v)
+			if date, err := time.Parse(DateLayout, v); err == nil {
+				return date.Format(DateLayout), nil
+			}
+			if date, err := time.Parse(time.RFC3339, v); err == nil {
+				return date.Format(DateLayout), nil
+			}
+			return nil, fmt.Errorf("%q is not a date in the format YYYY-MM-DD", v)
+		}
+	case attributedefinition.TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a boolean", v)
+			}
+			return b, nil
+		}
+	case attributedefinition.TypeEnum:
+		if s, ok := value.(string); ok {
+			if !slices.Contains(def.EnumValues, s) {
+				return nil, fmt.Errorf("%q is not one of %s", s, strings.Join(def.EnumValues, ", "))
+			}
+			return s, nil
+		}
+	case attributedefinition.TypeURL:
+		if s, ok := value.(string); ok {
+			u, err := url.ParseRequestURI(strings.TrimSpace(s))
+			if err != nil || u.Scheme == "" || u.Host == "" {
+				return nil, fmt.Errorf("%q is not an absolute URL", s)
+			}
+			return u.String(), nil
+		}
+	case attributedefinition.TypeReference:
+		if s, ok := value.(string); ok {
+			id, err := uuid.Parse(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("%q is not an item ID", s)
+			}
+			return id.String(), nil
+		}
+	default:
+		return nil, fmt.Errorf("unknown attribute type %s", def.Type)
+	}
+
+	return nil, fmt.Errorf("%v is not a valid %s value", value, def.Type)
+}
+
+// Validate validates the attribute values of an item against the attribute definitions of its asset class.
+// Unknown attributes are rejected, missing attributes are set to their default value and required attributes
+// without value are reported. The returned map holds the canonical values.
+// Secret attributes are not stored with the other values, so values for them are rejected and callers have to
+// split them off before.
+func Validate(defs []*ent.AttributeDefinition, values map[string]any) (map[string]any, error) {
+	res := make(map[string]any)
+	errs := make([]error, 0)
+
+	for key := range values {
+		idx := slices.IndexFunc(defs, func(def *ent.AttributeDefinition) bool { return def.Key == key })
+		if idx < 0 {
+			errs = append(errs, fmt.Errorf("attribute %q is not defined", key))
+		} else if defs[idx].Secret {
+			errs = append(errs, fmt.Errorf("attribute %q is secret", key))
+		}
+	}
+
+	for _, def := range defs {
+		if def.Secret {
+			continue
+		}
+
+		value, err := Coerce(def, values[def.Key])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("attribute %q: %w", def.Key, err))
+			continue
+		}
+
+		if value == nil && def.DefaultValue != "" {
+			if value, err = Coerce(def, def.DefaultValue); err != nil {
+				errs = append(errs, fmt.Errorf("attribute %q: invalid default value: %w", def.Key, err))
+				continue
+			}
+		}
+
+		if value == nil {
+			if def.Required {
+				errs = append(errs, fmt.Errorf("attribute %q is required", def.Key))
+			}
+			continue
+		}
+
+		res[def.Key] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return res, nil
+}
+
+// ValidateDefinition checks that the default and enum values of an attribute definition are consistent with its type.
+// Secret attributes have to be text without default value.
+func ValidateDefinition(def *ent.AttributeDefinition) error {
+	if def.Type == attributedefinition.TypeEnum && len(def.EnumValues) == 0 {
+		return errors.New("enum attributes require at least one value")
+	}
+
+	if def.Secret && def.Type != attributedefinition.TypeString {
+		return errors.New("secret attributes must have the string type")
+	}
+
+	if def.Secret && def.DefaultValue != "" {
+		return errors.New("secret attributes can not have a default value")
+	}
+
+	if def.DefaultValue != "" {
+		if _, err := Coerce(def, def.DefaultValue); err != nil {
+			return fmt.Errorf("invalid default value: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// References returns the item IDs that are referenced by the given attribute values.
+func References(defs []*ent.AttributeDefinition, values map[string]any) []uuid.UUID {
+	ids := make([]uuid.UUID, 0)
+	for _, def := range defs {
+		if def.Type != attributedefinition.TypeReference {
+			continue
+		}
+
+		if s, ok := values[def.Key].(string); ok {
+			if id, err := uuid.Parse(s); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// Predicate returns an item predicate comparing the value of the attribute with the given operator.
+// Secret attributes can not be compared, as their values are encrypted.
+func Predicate(def *ent.AttributeDefinition, operator string, value any) (predicate.Item, error) {
+	if def.Secret {
+		return nil, fmt.Errorf("attribute %q is secret and can not be filtered", def.Key)
+	}
+
+	path := sqljson.Path(def.Key)
+
+	if operator == OperatorExists {
+		return predicate.Item(func(s *sql.Selector) {
+			s.Where(sqljson.ValueIsNotNull(item.FieldAttributes, path))
+		}), nil
+	}
+
+	if operator == OperatorContains {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("attribute %q: contains requires a text value", def.Key)
+		}
+
+		return predicate.Item(func(sel *sql.Selector) {
+			sel.Where(sqljson.StringContains(item.FieldAttributes, s, path))
+		}), nil
+	}
+
+	value, err := Coerce(def, value)
+	if err != nil {
+		return nil, fmt.Errorf("attribute %q: %w", def.Key, err)
+	}
+
+	var p func(column string, arg any, opts ...sqljson.Option) *sql.Predicate
+	switch operator {
+	case OperatorEQ, "":
+		p = sqljson.ValueEQ
+	case OperatorNEQ:
+		p = sqljson.ValueNEQ
+	case OperatorLT:
+		p = sqljson.ValueLT
+	case OperatorLTE:
+		p = sqljson.ValueLTE
+	case OperatorGT:
+		p = sqljson.ValueGT
+	case OperatorGTE:
+		p = sqljson.ValueGTE
+	default:
+		return nil, fmt.Errorf("unknown operator %q", operator)
+	}
+
+	return predicate.Item(func(s *sql.Selector) {
+		s.Where(p(item.FieldAttributes, value, path))
+	}), nil
+}
+
+// Ensure creates the definitions of the given attributes that the asset class is missing, matched by key, and returns
+// all attribute definitions of the class. It is used by jobs that fill in attributes of the classes they work on.
+func Ensure(ctx context.Context, client *ent.Client, classID uuid.UUID, defs []*ent.AttributeDefinition, user string) ([]*ent.AttributeDefinition, error) {
+	query := func() ([]*ent.AttributeDefinition, error) {
+		existing, err := client.AttributeDefinition.Query().
+			Where(attributedefinition.HasAssetClassWith(assetclass.ID(classID)), attributedefinition.DeletedAtIsNil()).
+			Order(attributedefinition.ByOrder(), attributedefinition.ByKey()).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query attribute definitions: %w", err)
+		}
+
+		return existing, nil
+	}
+
+	existing, err := query()
+	if err != nil {
+		return nil, err
+	}
+
+	order := len(existing)
+	builders := make([]*ent.AttributeDefinitionCreate, 0)
+	for _, def := range defs {
+		if slices.ContainsFunc(existing, func(e *ent.AttributeDefinition) bool { return e.Key == def.Key }) {
+			continue
+		}
+
+		builders = append(builders, client.AttributeDefinition.Create().
+			SetAssetClassID(classID).
+			SetKey(def.Key).
+			SetName(def.Name).
+			SetDescription(def.Description).
+			SetType(def.Type).
+			SetRequired(def.Required).
+			SetOrder(order).
+			SetCreatedBy(user).
+			SetUpdatedBy(user))
+		order++
+	}
+
+	if len(builders) == 0 {
+		return existing, nil
+	}
+
+	if err := client.AttributeDefinition.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to create attribute definitions: %w", err)
+	}
+
+	return query()
+}
@@ -0,0 +1,153 @@
+package attributes
+
+import (
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"testing"
+	"time"
+)
+
+type coerceTest struct {
+	Type     attributedefinition.Type
+	Value    any
+	Expected any
+	Error    bool
+}
+
+func TestCoerce(t *testing.T) {
+	tests := []coerceTest{
+		{attributedefinition.TypeString, "value", "value", false},
+		{attributedefinition.TypeString, 1.0, nil, true},
+		{attributedefinition.TypeNumber, 42.0, 42.0, false},
+		{attributedefinition.TypeNumber, 42, 42.0, false},
+		{attributedefinition.TypeNumber, " 4.2 ", 4.2, false},
+		{attributedefinition.TypeNumber, "many", nil, true},
+		{attributedefinition.TypeDate, "2025-01-31", "2025-01-31", false},
+		{attributedefinition.TypeDate, "2025-01-31T10:00:00Z", "2025-01-31", false},
+		{attributedefinition.TypeDate, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), "2025-01-31", false},
+		{attributedefinition.TypeDate, "31.01.2025", nil, true},
+		{attributedefinition.TypeBool, true, true, false},
+		{attributedefinition.TypeBool, "false", false, false},
+		{attributedefinition.TypeBool, "maybe", nil, true},
+		{attributedefinition.TypeEnum, "small", "small", false},
+		{attributedefinition.TypeEnum, "huge", nil, true},
+		{attributedefinition.TypeURL, "https://example.com/a", "https://example.com/a", false},
+		{attributedefinition.TypeURL, "example.com", nil, true},
+		{attributedefinition.TypeReference, "6F9619FF-8B86-D011-B42D-00C04FC964FF", "6f9619ff-8b86-d011-b42d-00c04fc964ff", false},
+		{attributedefinition.TypeReference, "item", nil, true},
+		{attributedefinition.TypeString, nil, nil, false},
+	}
+
+	for _, test := range tests {
+		def := &ent.AttributeDefinition{Key: "test", Type: test.Type, EnumValues: []string{"small", "large"}}
+
+		value, err := Coerce(def, test.Value)
+		if test.Error {
+			if err == nil {
+				t.Errorf("Expected error for %s value %v, got %v", test.Type, test.Value, value)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Unexpected error for %s value %v: %v", test.Type, test.Value, err)
+			continue
+		}
+
+		if value != test.Expected {
+			t.Errorf("Expected %v for %s value %v, got %v", test.Expected, test.Type, test.Value, value)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	defs := []*ent.AttributeDefinition{
+		{Key: "seats", Type: attributedefinition.TypeNumber, Required: true},
+		{Key: "expires", Type: attributedefinition.TypeDate},
+		{Key: "tier", Type: attributedefinition.TypeEnum, EnumValues: []string{"basic", "pro"}, DefaultValue: "basic"},
+	}
+
+	values, err := Validate(defs, map[string]any{"seats": "10"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if values["seats"] != 10.0 || values["tier"] != "basic" {
+		t.Errorf("Unexpected values: %v", values)
+	}
+
+	if _, ok := values["expires"]; ok {
+		t.Errorf("Expected optional attribute without default to be omitted: %v", values)
+	}
+
+	if _, err := Validate(defs, map[string]any{}); err == nil {
+		t.Error("Expected error for missing required attribute")
+	}
+
+	if _, err := Validate(defs, map[string]any{"seats": 1.0, "color": "red"}); err == nil {
+		t.Error("Expected error for unknown attribute")
+	}
+
+	if _, err := Validate(defs, map[string]any{"seats": 1.0, "tier": "enterprise"}); err == nil {
+		t.Error("Expected error for invalid enum value")
+	}
+
+	// secret attributes are stored separately and skipped
+	defs = append(defs, &ent.AttributeDefinition{Key: "password", Type: attributedefinition.TypeString, Secret: true, Required: true})
+	if values, err = Validate(defs, map[string]any{"seats": 1.0}); err != nil || len(values) != 2 {
+		t.Errorf("Expected secret attribute to be skipped, got %v, %v", values, err)
+	}
+
+	if _, err := Validate(defs, map[string]any{"seats": 1.0, "password": "s3cret"}); err == nil {
+		t.Error("Expected error for secret value")
+	}
+}
+
+func TestValidateDefinition(t *testing.T) {
+	if err := ValidateDefinition(&ent.AttributeDefinition{Type: attributedefinition.TypeEnum}); err == nil {
+		t.Error("Expected error for enum without values")
+	}
+
+	if err := ValidateDefinition(&ent.AttributeDefinition{Type: attributedefinition.TypeNumber, DefaultValue: "ten"}); err == nil {
+		t.Error("Expected error for invalid default value")
+	}
+
+	if err := ValidateDefinition(&ent.AttributeDefinition{Type: attributedefinition.TypeNumber, DefaultValue: "10"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if err := ValidateDefinition(&ent.AttributeDefinition{Type: attributedefinition.TypeNumber, Secret: true}); err == nil {
+		t.Error("Expected error for secret number")
+	}
+
+	if err := ValidateDefinition(&ent.AttributeDefinition{Type: attributedefinition.TypeString, Secret: true, DefaultValue: "admin"}); err == nil {
+		t.Error("Expected error for secret with default value")
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	def := &ent.AttributeDefinition{Key: "seats", Type: attributedefinition.TypeNumber}
+
+	for _, operator := range []string{OperatorEQ, OperatorNEQ, OperatorLT, OperatorLTE, OperatorGT, OperatorGTE, OperatorExists} {
+		if _, err := Predicate(def, operator, 1.0); err != nil {
+			t.Errorf("Unexpected error for operator %s: %v", operator, err)
+		}
+	}
+
+	if _, err := Predicate(def, "like", 1.0); err == nil {
+		t.Error("Expected error for unknown operator")
+	}
+
+	if _, err := Predicate(def, OperatorGT, "many"); err == nil {
+		t.Error("Expected error for invalid value")
+	}
+
+	if _, err := Predicate(def, OperatorContains, 1.0); err == nil {
+		t.Error("Expected error for contains with non text value")
+	}
+
+	secret := &ent.AttributeDefinition{Key: "password", Type: attributedefinition.TypeString, Secret: true}
+	if _, err := Predicate(secret, OperatorExists, nil); err == nil {
+		t.Error("Expected error for secret attribute")
+	}
+}
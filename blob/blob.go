@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"context"
+	"dig-inv/env"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrNotFound is returned if no object is stored under a key.
+var ErrNotFound = errors.New("object not found")
+
+// Storage stores the files of attachments as objects under keys, which are chosen by the caller and may contain
+// slashes to group objects.
+type Storage interface {
+	// Put stores size bytes read from r under key, replacing an existing object.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get returns the content of the object stored under key, which has to be closed by the caller.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing object is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// Open returns the storage configured by ATTACHMENT_STORAGE.
+func Open() (Storage, error) {
+	switch kind := env.GetAttachmentStorage(); kind {
+	case "local":
+		return NewLocal(env.GetAttachmentDir())
+	case "s3":
+		return NewS3(S3Config{
+			Endpoint:        env.GetS3Endpoint(),
+			Bucket:          env.GetS3Bucket(),
+			Region:          env.GetS3Region(),
+			AccessKeyID:     env.GetS3AccessKeyID(),
+			SecretAccessKey: env.GetS3SecretAccessKey(),
+		})
+	default:
+		return nil, fmt.Errorf("unknown attachment storage %q, expected local or s3", kind)
+	}
+}
@@ -0,0 +1,77 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testStorage stores, reads, replaces and deletes an object.
+func testStorage(t *testing.T, storage Storage) {
+	ctx := context.Background()
+	key := "item/invoice"
+
+	if _, err := storage.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected missing object, got %v", err)
+	}
+
+	for _, content := range []string{"first invoice", "second invoice"} {
+		if err := storage.Put(ctx, key, strings.NewReader(content), int64(len(content))); err != nil {
+			t.Fatalf("Failed to put object: %v", err)
+		}
+
+		r, err := storage.Get(ctx, key)
+		if err != nil {
+			t.Fatalf("Failed to get object: %v", err)
+		}
+
+		data, err := io.ReadAll(r)
+		_ = r.Close()
+		if err != nil || string(data) != content {
+			t.Errorf("Expected %q, got %q, %v", content, data, err)
+		}
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Fatalf("Failed to delete object: %v", err)
+	}
+
+	if _, err := storage.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected deleted object to be missing, got %v", err)
+	}
+
+	if err := storage.Delete(ctx, key); err != nil {
+		t.Errorf("Expected deleting a missing object to succeed, got %v", err)
+	}
+}
+
+func TestLocal(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewLocal(dir)
+	if err != nil {
+		t.Fatalf("Failed to create storage: %v", err)
+	}
+
+	testStorage(t, storage)
+
+	ctx := context.Background()
+	for _, key := range []string{"", "../escape", "/etc/passwd", `item\..\..\escape`} {
+		if err := storage.Put(ctx, key, strings.NewReader("x"), 1); err == nil {
+			t.Errorf("Expected error for key %q", key)
+		}
+	}
+
+	// incomplete uploads leave nothing behind
+	if err := storage.Put(ctx, "item/short", strings.NewReader("short"), 10); err == nil {
+		t.Errorf("Expected error for short upload")
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "item"))
+	if err != nil || len(entries) != 0 {
+		t.Errorf("Expected no files, got %v, %v", entries, err)
+	}
+}
@@ -0,0 +1,149 @@
+package certs
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultPort = 443
+
+const dialTimeout = 10 * time.Second
+
+// rootCAs verifies the certificate chains, nil for the system roots
+var rootCAs *x509.CertPool
+
+// Certificate is the leaf certificate presented by an endpoint.
+type Certificate struct {
+	// Endpoint is the normalized host:port the certificate was retrieved from.
+	Endpoint    string
+	Host        string
+	CommonName  string
+	Issuer      string
+	SANs        []string
+	Serial      string
+	KeyType     string
+	NotBefore   time.Time
+	NotAfter    time.Time
+	Fingerprint string
+	// ChainError is set if the certificate is not trusted, e.g. because it is self-signed, expired or the server
+	// does not send the intermediates.
+	ChainError string
+	// HostnameMismatch is set if the certificate is not valid for the host of the endpoint.
+	HostnameMismatch bool
+}
+
+// Endpoint normalizes a host with an optional port to host:port, with port 443 if none is given.
+func Endpoint(endpoint string) (string, error) {
+	endpoint = strings.TrimSpace(endpoint)
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		host, port = strings.Trim(endpoint, "[]"), strconv.Itoa(DefaultPort)
+	}
+
+	if host == "" || strings.ContainsAny(host, " /") {
+		return "", fmt.Errorf("invalid endpoint %q", endpoint)
+	}
+
+	if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+		return "", fmt.Errorf("invalid port of endpoint %q", endpoint)
+	}
+
+	return net.JoinHostPort(strings.ToLower(host), port), nil
+}
+
+// Inspect connects to the endpoint and returns its certificate. The chain and hostname are verified separately, so
+// untrusted certificates are returned with the verification errors instead of failing the handshake.
+func Inspect(ctx context.Context, endpoint string) (*Certificate, error) {
+	endpoint, err := Endpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	host, _, _ := net.SplitHostPort(endpoint)
+
+	// the chain is verified after the handshake, so untrusted certificates can be inspected as well
+	config := &tls.Config{InsecureSkipVerify: true}
+	if net.ParseIP(host) == nil {
+		config.ServerName = host
+	}
+
+	dialer := tls.Dialer{NetDialer: &net.Dialer{Timeout: dialTimeout}, Config: config}
+	conn, err := dialer.DialContext(ctx, "tcp", endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", endpoint, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	chain := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("%s presented no certificate", endpoint)
+	}
+	leaf := chain[0]
+
+	res := &Certificate{
+		Endpoint:    endpoint,
+		Host:        host,
+		CommonName:  leaf.Subject.CommonName,
+		Issuer:      leaf.Issuer.String(),
+		SANs:        subjectAltNames(leaf),
+		Serial:      strings.ToUpper(leaf.SerialNumber.Text(16)),
+		KeyType:     keyType(leaf),
+		NotBefore:   leaf.NotBefore.UTC(),
+		NotAfter:    leaf.NotAfter.UTC(),
+		Fingerprint: fingerprint(leaf),
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{Roots: rootCAs, Intermediates: intermediates}); err != nil {
+		res.ChainError = err.Error()
+	}
+
+	if err := leaf.VerifyHostname(host); err != nil {
+		res.HostnameMismatch = true
+	}
+
+	return res, nil
+}
+
+func subjectAltNames(cert *x509.Certificate) []string {
+	names := append([]string{}, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	return names
+}
+
+func keyType(cert *x509.Certificate) string {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA %d", key.N.BitLen())
+	case *ecdsa.PublicKey:
+		return "ECDSA " + key.Curve.Params().Name
+	case ed25519.PublicKey:
+		return "Ed25519"
+	}
+
+	return cert.PublicKeyAlgorithm.String()
+}
+
+func fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
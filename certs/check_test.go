@@ -0,0 +1,95 @@
+package certs
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// startTLSServer starts a TLS server with the certificate of httptest, which is valid for example.com and 127.0.0.1,
+// and trusts it for the duration of the test.
+func startTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	rootCAs = x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	t.Cleanup(func() {
+		rootCAs = nil
+	})
+
+	return server, strings.TrimPrefix(server.URL, "https://")
+}
+
+func TestEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"example.com":        "example.com:443",
+		" Example.com:8443 ": "example.com:8443",
+		"[::1]:443":          "[::1]:443",
+		"::1":                "[::1]:443",
+	}
+	for input, expected := range tests {
+		endpoint, err := Endpoint(input)
+		if err != nil || endpoint != expected {
+			t.Errorf("Expected %q for %q, got %q (%v)", expected, input, endpoint, err)
+		}
+	}
+
+	for _, input := range []string{"", "example.com:0", "example.com:https", "https://example.com"} {
+		if _, err := Endpoint(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestInspect(t *testing.T) {
+	server, endpoint := startTLSServer(t)
+	ctx := context.Background()
+
+	cert, err := Inspect(ctx, endpoint)
+	if err != nil {
+		t.Fatalf("Failed to inspect %s: %v", endpoint, err)
+	}
+
+	if cert.ChainError != "" || cert.HostnameMismatch {
+		t.Errorf("Expected trusted certificate, got chain error %q and mismatch %v", cert.ChainError, cert.HostnameMismatch)
+	}
+
+	if !slices.Contains(cert.SANs, "example.com") || !slices.Contains(cert.SANs, "127.0.0.1") {
+		t.Errorf("Unexpected SANs: %v", cert.SANs)
+	}
+
+	expected := server.Certificate()
+	if cert.NotAfter != expected.NotAfter.UTC() || cert.Serial == "" || cert.KeyType == "" || len(cert.Fingerprint) != 64 || cert.Issuer == "" {
+		t.Errorf("Unexpected certificate: %+v", cert)
+	}
+
+	// the certificate is not valid for localhost
+	port := endpoint[strings.LastIndex(endpoint, ":"):]
+	cert, err = Inspect(ctx, "localhost"+port)
+	if err != nil {
+		t.Fatalf("Failed to inspect localhost: %v", err)
+	}
+
+	if !cert.HostnameMismatch {
+		t.Errorf("Expected hostname mismatch for localhost")
+	}
+
+	rootCAs = nil
+	cert, err = Inspect(ctx, endpoint)
+	if err != nil {
+		t.Fatalf("Failed to inspect %s: %v", endpoint, err)
+	}
+
+	if cert.ChainError == "" {
+		t.Errorf("Expected chain error for untrusted certificate")
+	}
+
+	if _, err := Inspect(ctx, "127.0.0.1:1"); err == nil {
+		t.Errorf("Expected error for closed port")
+	}
+}
@@ -0,0 +1,355 @@
+package certs
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/drift"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/worker"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// JobType is the type of the job runs that check TLS certificates. The comma-separated `endpoints` parameter lists
+// host:port endpoints to check, the `classes` parameter the IDs of the asset classes whose items are checked. Without
+// parameters, all servers and domains of the inventory are checked.
+const JobType = "certificate_check"
+
+// Provider marks the asset class whose items are the checked certificates.
+const Provider = "certificate"
+
+// classAttributes are the attributes of the certificate asset class, which is created by the first check
+var classAttributes = []*ent.AttributeDefinition{
+	{Key: "endpoint", Name: "Endpoint", Type: attributedefinition.TypeString, Required: true, Description: "The host and port the certificate was retrieved from."},
+	{Key: "common_name", Name: "Common name", Type: attributedefinition.TypeString},
+	{Key: "issuer", Name: "Issuer", Type: attributedefinition.TypeString},
+	{Key: "sans", Name: "Subject alternative names", Type: attributedefinition.TypeString, Description: "The comma-separated DNS names and IP addresses the certificate is valid for."},
+	{Key: "serial", Name: "Serial number", Type: attributedefinition.TypeString},
+	{Key: "key_type", Name: "Key type", Type: attributedefinition.TypeString},
+	{Key: "not_before", Name: "Not before", Type: attributedefinition.TypeDate},
+	{Key: "not_after", Name: "Not after", Type: attributedefinition.TypeDate, Description: "The expiry date of the certificate."},
+	{Key: "fingerprint", Name: "SHA-256 fingerprint", Type: attributedefinition.TypeString},
+	{Key: "chain_error", Name: "Chain error", Type: attributedefinition.TypeString, Description: "Why the certificate is not trusted, empty for valid chains."},
+	{Key: "hostname_mismatch", Name: "Hostname mismatch", Type: attributedefinition.TypeBool, Description: "Whether the certificate is not valid for the host of the endpoint."},
+	{Key: "last_checked", Name: "Last checked", Type: attributedefinition.TypeDate},
+}
+
+// hostAttributes are the attributes of servers and domains that hold the host to check. Items without these
+// attributes are checked at their https URL attributes or at their name, if it is a host name.
+var hostAttributes = []string{"hostname", "fqdn", "domain"}
+
+var hostnamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+
+// RunCheckJob is the worker handler of certificate checks. Every certificate is recorded as item of the certificate
+// asset class, keyed by its endpoint, and the servers and domains serving it depend on it. New, changed and no longer
+// served certificates are recorded as drift report, which is applied according to the drift policy of the class.
+// Discovered endpoints that do not serve TLS are skipped, configured endpoints that can not be checked fail the job.
+func RunCheckJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	class, err := CertificateClass(ctx, client, worker.Subject)
+	if err != nil {
+		return "", err
+	}
+
+	var classIds []uuid.UUID
+	for _, id := range splitList(run.Parameters["classes"]) {
+		classId, err := uuid.Parse(id)
+		if err != nil {
+			return "", fmt.Errorf("invalid asset class %q: %w", id, err)
+		}
+		classIds = append(classIds, classId)
+	}
+
+	sources, err := discover(ctx, client, class, classIds)
+	if err != nil {
+		return "", err
+	}
+
+	configured := make(map[string]bool)
+	for _, e := range splitList(run.Parameters["endpoints"]) {
+		endpoint, err := Endpoint(e)
+		if err != nil {
+			return "", err
+		}
+		configured[endpoint] = true
+	}
+
+	endpoints := slices.Collect(maps.Keys(configured))
+	if len(configured) == 0 || len(classIds) > 0 {
+		endpoints = append(endpoints, slices.Collect(maps.Keys(sources))...)
+	}
+	slices.Sort(endpoints)
+	endpoints = slices.Compact(endpoints)
+
+	var checked, untrusted, mismatched int
+	var observed []drift.Observed
+	var unknown []string
+	var errs []error
+	for i, endpoint := range endpoints {
+		worker.ReportProgress(ctx, i*100/len(endpoints))
+
+		cert, err := Inspect(ctx, endpoint)
+		if err != nil {
+			if configured[endpoint] {
+				errs = append(errs, err)
+			}
+			unknown = append(unknown, endpoint)
+			worker.Logf(ctx, "Skipped %s: %v", endpoint, err)
+			continue
+		}
+
+		observed = append(observed, observe(cert))
+		checked++
+		if cert.ChainError != "" {
+			untrusted++
+			worker.Logf(ctx, "Certificate of %s is not trusted: %s", endpoint, cert.ChainError)
+		}
+		if cert.HostnameMismatch {
+			mismatched++
+			worker.Logf(ctx, "Certificate of %s is not valid for %s", endpoint, cert.Host)
+		}
+	}
+
+	// certificates are only gone if all servers and domains were checked and none of them serves them anymore
+	report, err := drift.Reconcile(ctx, client, drift.Sync{
+		Source:       JobType,
+		Class:        class,
+		KeyAttribute: "endpoint",
+		Observed:     observed,
+		Complete:     len(configured) == 0 && len(classIds) == 0,
+		Unknown:      unknown,
+		Volatile:     []string{"last_checked"},
+	}, worker.Subject)
+	if err != nil {
+		return "", err
+	}
+	if report != nil {
+		worker.Logf(ctx, "Recorded %d certificate changes, %s", len(report.Changes), report.Status)
+	}
+
+	for _, o := range observed {
+		if err := link(ctx, client, class, o.Key, sources[o.Key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	result := fmt.Sprintf("checked %d certificates, %d with chain errors, %d with hostname mismatches", checked, untrusted, mismatched)
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%s, %d failed: %w", result, len(errs), errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+// CertificateClass returns the certificate asset class with its attributes and creates it, or its missing attributes,
+// if necessary.
+func CertificateClass(ctx context.Context, client *ent.Client, user string) (*ent.AssetClass, error) {
+	class, err := client.AssetClass.Query().
+		Where(assetclass.Provider(Provider), assetclass.DeletedAtIsNil()).
+		Order(assetclass.ByCreatedAt()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		class, err = client.AssetClass.Create().
+			SetName("Certificate").
+			SetDescription("TLS certificates found by the certificate check.").
+			SetProvider(Provider).
+			SetCreatedBy(user).
+			SetUpdatedBy(user).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create certificate asset class: %w", err)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificate asset class: %w", err)
+	}
+
+	if class.Edges.Attributes, err = attributes.Ensure(ctx, client, class.ID, classAttributes, user); err != nil {
+		return nil, err
+	}
+
+	return class, nil
+}
+
+// discover returns the endpoints of the servers and domains in the given asset classes, or in all classes, together
+// with the items serving them.
+func discover(ctx context.Context, client *ent.Client, class *ent.AssetClass, classIds []uuid.UUID) (map[string][]uuid.UUID, error) {
+	query := client.Item.Query().
+		Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.IDNEQ(class.ID), assetclass.DeletedAtIsNil()))
+	if len(classIds) > 0 {
+		query = query.Where(item.HasAssetClassWith(assetclass.IDIn(classIds...)))
+	}
+
+	items, err := query.
+		WithAssetClass(func(q *ent.AssetClassQuery) {
+			q.WithAttributes(func(q *ent.AttributeDefinitionQuery) {
+				q.Where(attributedefinition.DeletedAtIsNil())
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query items: %w", err)
+	}
+
+	sources := make(map[string][]uuid.UUID)
+	for _, i := range items {
+		for _, endpoint := range itemEndpoints(i) {
+			sources[endpoint] = append(sources[endpoint], i.ID)
+		}
+	}
+
+	return sources, nil
+}
+
+func itemEndpoints(i *ent.Item) []string {
+	var endpoints []string
+	for _, def := range i.Edges.AssetClass.Edges.Attributes {
+		value, ok := i.Attributes[def.Key].(string)
+		if !ok || value == "" {
+			continue
+		}
+
+		if def.Type == attributedefinition.TypeURL {
+			if u, err := url.Parse(value); err == nil && u.Scheme == "https" {
+				value = u.Host
+			} else {
+				continue
+			}
+		} else if !slices.Contains(hostAttributes, def.Key) {
+			continue
+		}
+
+		if endpoint, err := Endpoint(value); err == nil {
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	if name := strings.ToLower(i.Name); len(endpoints) == 0 && hostnamePattern.MatchString(name) {
+		endpoints = append(endpoints, fmt.Sprintf("%s:%d", name, DefaultPort))
+	}
+
+	return slices.Compact(endpoints)
+}
+
+// observe returns the state of the certificate item of the endpoint.
+func observe(cert *Certificate) drift.Observed {
+	values := map[string]any{
+		"endpoint":          cert.Endpoint,
+		"common_name":       cert.CommonName,
+		"issuer":            cert.Issuer,
+		"sans":              strings.Join(cert.SANs, ", "),
+		"serial":            cert.Serial,
+		"key_type":          cert.KeyType,
+		"not_before":        cert.NotBefore,
+		"not_after":         cert.NotAfter,
+		"fingerprint":       cert.Fingerprint,
+		"chain_error":       nil,
+		"hostname_mismatch": cert.HostnameMismatch,
+		"last_checked":      time.Now().UTC(),
+	}
+	if cert.ChainError != "" {
+		values["chain_error"] = cert.ChainError
+	}
+
+	return drift.Observed{Key: cert.Endpoint, Name: cert.Endpoint, Attributes: values}
+}
+
+// link lets the items serving the endpoint depend on its certificate item. Certificates whose item waits for the
+// approval of its drift report are linked by the first check after the approval.
+func link(ctx context.Context, client *ent.Client, class *ent.AssetClass, endpoint string, sources []uuid.UUID) error {
+	defs := class.Edges.Attributes
+	idx := slices.IndexFunc(defs, func(def *ent.AttributeDefinition) bool { return def.Key == "endpoint" })
+	if idx < 0 {
+		return errors.New("certificate asset class has no endpoint attribute")
+	}
+
+	byEndpoint, err := attributes.Predicate(defs[idx], attributes.OperatorEQ, endpoint)
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Item.Query().
+		Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(class.ID)), byEndpoint).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query certificate of %s: %w", endpoint, err)
+	}
+
+	for _, source := range sources {
+		linked, err := client.ItemRelation.Query().
+			Where(
+				itemrelation.TypeEQ(itemrelation.TypeDependsOn),
+				itemrelation.HasSourceWith(item.ID(source)),
+				itemrelation.HasTargetWith(item.ID(existing.ID)),
+				itemrelation.DeletedAtIsNil(),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query relations of %s: %w", endpoint, err)
+		}
+		if linked {
+			continue
+		}
+
+		err = client.ItemRelation.Create().
+			SetType(itemrelation.TypeDependsOn).
+			SetSourceID(source).
+			SetTargetID(existing.ID).
+			SetCreatedBy(worker.Subject).
+			SetUpdatedBy(worker.Subject).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to link certificate of %s: %w", endpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// SearchContent returns the names and addresses the certificates served by the item are valid for, which are indexed
+// with the item.
+func SearchContent(ctx context.Context, client *ent.Client, i *ent.Item) ([]string, error) {
+	certificates, err := client.Item.Query().
+		Where(
+			item.DeletedAtIsNil(),
+			item.HasAssetClassWith(assetclass.Provider(Provider)),
+			item.HasIncomingRelationsWith(
+				itemrelation.TypeEQ(itemrelation.TypeDependsOn),
+				itemrelation.DeletedAtIsNil(),
+				itemrelation.HasSourceWith(item.ID(i.ID)),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query certificates of %s: %w", i.Name, err)
+	}
+
+	var names []string
+	for _, cert := range certificates {
+		if name, ok := cert.Attributes["common_name"].(string); ok {
+			names = append(names, name)
+		}
+		if sans, ok := cert.Attributes["sans"].(string); ok {
+			names = append(names, splitList(sans)...)
+		}
+	}
+
+	return names, nil
+}
+
+func splitList(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+}
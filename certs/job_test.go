@@ -0,0 +1,100 @@
+package certs
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/store/storetest"
+	"testing"
+	"time"
+)
+
+func TestRunCheckJob(t *testing.T) {
+	client := storetest.OpenClient(t, "certs")
+	ctx := context.Background()
+	_, endpoint := startTLSServer(t)
+
+	servers := client.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("hostname").
+		SetName("Hostname").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(servers).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	web := client.Item.Create().
+		SetName("web-1").
+		SetAssetClass(servers).
+		SetAttributes(map[string]any{"hostname": endpoint}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	// does not serve TLS and is skipped
+	client.Item.Create().
+		SetName("db-1").
+		SetAssetClass(servers).
+		SetAttributes(map[string]any{"hostname": "127.0.0.1:1"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	// not a host name
+	client.Item.Create().SetName("Rack 4").SetAssetClass(servers).SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+
+	for range 2 {
+		result, err := RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{}})
+		if err != nil {
+			t.Fatalf("Failed to run check: %v", err)
+		}
+
+		if result != "checked 1 certificates, 0 with chain errors, 0 with hostname mismatches" {
+			t.Errorf("Unexpected result: %s", result)
+		}
+	}
+
+	class, err := CertificateClass(ctx, client, "a")
+	if err != nil {
+		t.Fatalf("Failed to get certificate class: %v", err)
+	}
+
+	if len(class.Edges.Attributes) != len(classAttributes) {
+		t.Errorf("Expected %d certificate attributes, got %d", len(classAttributes), len(class.Edges.Attributes))
+	}
+
+	certificates := client.Item.Query().Where(item.HasAssetClassWith(assetclass.ID(class.ID))).AllX(ctx)
+	if len(certificates) != 1 {
+		t.Fatalf("Expected one certificate item, got %d", len(certificates))
+	}
+
+	cert := certificates[0]
+	if cert.Attributes["endpoint"] != endpoint || cert.Attributes["hostname_mismatch"] != false || cert.Attributes["not_after"] == nil {
+		t.Errorf("Unexpected certificate attributes: %v", cert.Attributes)
+	}
+
+	relations := client.ItemRelation.Query().
+		Where(itemrelation.HasSourceWith(item.ID(web.ID)), itemrelation.HasTargetWith(item.ID(cert.ID))).
+		AllX(ctx)
+	if len(relations) != 1 || relations[0].Type != itemrelation.TypeDependsOn {
+		t.Errorf("Expected the server to depend on its certificate once, got %v", relations)
+	}
+
+	// certificates no longer served by any item vanish with the last full check
+	client.Item.UpdateOne(web).SetDeletedAt(time.Now()).SetUpdatedBy("a").ExecX(ctx)
+	if _, err := RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{}}); err != nil {
+		t.Fatalf("Failed to run check: %v", err)
+	}
+
+	if client.Item.GetX(ctx, cert.ID).DeletedAt == nil {
+		t.Errorf("Expected certificate without servers to be deleted")
+	}
+
+	// configured endpoints that can not be checked fail the job
+	_, err = RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{"endpoints": "127.0.0.1:1"}})
+	if err == nil {
+		t.Errorf("Expected error for unreachable endpoint")
+	}
+}
@@ -7,8 +7,13 @@ import (
 )
 
 type Handler struct {
-	ServerHandler func() error
-	WorkerHandler func() error
+	ServerHandler  func() error
+	WorkerHandler  func() error
+	QueryHandler   func(expression string) error
+	ImportHandler  func(params *ImportParams) error
+	BackupHandler  func(file string) error
+	RestoreHandler func(file string) error
+	RotateHandler  func(user string) error
 }
 
 type ServerParams struct {
@@ -33,14 +38,89 @@ func (l *WorkerParams) Run(ctx *Handler) error {
 	return ctx.WorkerHandler()
 }
 
+type QueryParams struct {
+	Expression string `arg:"" help:"Query expression, e.g. 'class:server tag:prod expires<30d'"`
+}
+
+func (q *QueryParams) Run(ctx *Handler) error {
+	if ctx.QueryHandler == nil {
+		log.S.Warn("Query handler is not set, skipping query execution")
+		return nil
+	}
+	return ctx.QueryHandler(q.Expression)
+}
+
+type ImportParams struct {
+	File   string            `arg:"" type:"existingfile" help:"CSV or JSON file to import"`
+	Format string            `enum:",csv,json" default:"" help:"Format of the file, detected from the file extension if not set"`
+	Key    string            `default:"id" help:"Column matching rows to existing items: id, name or attributes.<key>"`
+	Map    map[string]string `help:"Map columns of the file to item columns, e.g. --map Hostname=attributes.hostname"`
+	DryRun bool              `help:"Validate all rows without changing anything"`
+	User   string            `default:"cli" help:"User recorded as creator of the imported items"`
+}
+
+func (i *ImportParams) Run(ctx *Handler) error {
+	if ctx.ImportHandler == nil {
+		log.S.Warn("Import handler is not set, skipping import execution")
+		return nil
+	}
+	return ctx.ImportHandler(i)
+}
+
+type BackupParams struct {
+	File string `arg:"" type:"path" help:"Archive file to write the backup to"`
+}
+
+func (b *BackupParams) Run(ctx *Handler) error {
+	if ctx.BackupHandler == nil {
+		log.S.Warn("Backup handler is not set, skipping backup execution")
+		return nil
+	}
+	return ctx.BackupHandler(b.File)
+}
+
+type RestoreParams struct {
+	File string `arg:"" type:"existingfile" help:"Archive file created by the backup command"`
+}
+
+func (r *RestoreParams) Run(ctx *Handler) error {
+	if ctx.RestoreHandler == nil {
+		log.S.Warn("Restore handler is not set, skipping restore execution")
+		return nil
+	}
+	return ctx.RestoreHandler(r.File)
+}
+
+type RotateKeyParams struct {
+	User string `default:"cli" help:"User recorded as updater of the re-encrypted secrets"`
+}
+
+func (r *RotateKeyParams) Run(ctx *Handler) error {
+	if ctx.RotateHandler == nil {
+		log.S.Warn("Rotate handler is not set, skipping key rotation")
+		return nil
+	}
+	return ctx.RotateHandler(r.User)
+}
+
 var Wrapper struct {
-	Server ServerParams `cmd:"" help:"Run the server"`
-	Worker WorkerParams `cmd:"" help:"Run the worker"`
+	Server    ServerParams    `cmd:"" help:"Run the server"`
+	Worker    WorkerParams    `cmd:"" help:"Run the worker"`
+	Query     QueryParams     `cmd:"" help:"List the items matching a query"`
+	Import    ImportParams    `cmd:"" help:"Create or update items from a CSV or JSON file"`
+	Backup    BackupParams    `cmd:"" help:"Write all data including deleted items and the audit history to an archive"`
+	Restore   RestoreParams   `cmd:"" help:"Load a backup archive into an empty database"`
+	RotateKey RotateKeyParams `cmd:"" help:"Re-encrypt all secrets with the first master key, after which previous keys can be removed"`
 }
 
 const (
-	CommandServer = "server"
-	CommandWorker = "worker"
+	CommandServer  = "server"
+	CommandWorker  = "worker"
+	CommandQuery   = "query"
+	CommandImport  = "import"
+	CommandBackup  = "backup"
+	CommandRestore = "restore"
+	CommandRotate  = "rotate-key"
 )
 
 func (cli *Handler) Run() error {
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -22,6 +23,141 @@ func TestCLI_RunWorker(t *testing.T) {
 	)
 }
 
+func TestCLI_RunQuery(t *testing.T) {
+	mockCommandlineArgs(
+		t,
+		func(t *testing.T) {
+			cli := NewCLI(nil, nil)
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			var expression string
+			cli.QueryHandler = func(e string) error {
+				expression = e
+				return nil
+			}
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			if expression != "class:server tag:prod" {
+				t.Errorf("Expected query expression to be passed, got %q", expression)
+			}
+		},
+		CommandQuery,
+		"class:server tag:prod",
+	)
+}
+
+func TestCLI_RunImport(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "items.csv")
+	if err := os.WriteFile(file, []byte("name\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write import file: %v", err)
+	}
+
+	mockCommandlineArgs(
+		t,
+		func(t *testing.T) {
+			cli := NewCLI(nil, nil)
+
+			var params *ImportParams
+			cli.ImportHandler = func(p *ImportParams) error {
+				params = p
+				return nil
+			}
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			if params == nil || params.File != file || params.Key != "name" || !params.DryRun || params.Map["Host"] != "attributes.hostname" {
+				t.Errorf("Unexpected import params: %+v", params)
+			}
+		},
+		CommandImport,
+		file,
+		"--key=name",
+		"--dry-run",
+		"--map=Host=attributes.hostname",
+	)
+}
+
+func TestCLI_RunBackupRestore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	mockCommandlineArgs(
+		t,
+		func(t *testing.T) {
+			cli := NewCLI(nil, nil)
+
+			var backupFile string
+			cli.BackupHandler = func(f string) error {
+				backupFile = f
+				return nil
+			}
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			if backupFile != file {
+				t.Errorf("Expected backup file %q, got %q", file, backupFile)
+			}
+		},
+		CommandBackup,
+		file,
+	)
+
+	if err := os.WriteFile(file, []byte{}, 0o600); err != nil {
+		t.Fatalf("Failed to write backup file: %v", err)
+	}
+
+	mockCommandlineArgs(
+		t,
+		func(t *testing.T) {
+			cli := NewCLI(nil, nil)
+
+			var restoreFile string
+			cli.RestoreHandler = func(f string) error {
+				restoreFile = f
+				return nil
+			}
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			if restoreFile != file {
+				t.Errorf("Expected restore file %q, got %q", file, restoreFile)
+			}
+		},
+		CommandRestore,
+		file,
+	)
+}
+
+func TestCLI_RunRotateKey(t *testing.T) {
+	mockCommandlineArgs(
+		t,
+		func(t *testing.T) {
+			cli := NewCLI(nil, nil)
+
+			var rotatedBy string
+			cli.RotateHandler = func(user string) error {
+				rotatedBy = user
+				return nil
+			}
+			if err := cli.Run(); err != nil {
+				t.Errorf("Expected nil error, got %v", err)
+			}
+
+			if rotatedBy != "admin" {
+				t.Errorf("Expected rotation by admin, got %q", rotatedBy)
+			}
+		},
+		CommandRotate,
+		"--user=admin",
+	)
+}
+
 func TestCLI_RunWithError(t *testing.T) {
 	mockCommandlineArgs(
 		t,
@@ -1,8 +1,24 @@
 package cli
 
 import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/env"
+	"dig-inv/export"
+	"dig-inv/importer"
 	"dig-inv/log"
+	"dig-inv/querylang"
+	"dig-inv/secrets"
 	"dig-inv/services"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
 )
 
 // https://entgo.io/
@@ -10,13 +26,25 @@ import (
 const ErrorExitCode = 0xF1
 
 type Entrypoint struct {
-	serverHandler func() error
-	workerHandler func() error
+	serverHandler  func() error
+	workerHandler  func() error
+	queryHandler   func(expression string) error
+	importHandler  func(params *ImportParams) error
+	backupHandler  func(file string) error
+	restoreHandler func(file string) error
+	rotateHandler  func(user string) error
 }
 
 func (e *Entrypoint) Run() int {
 	log.S.Info("Starting dig-inv")
-	if err := NewCLI(e.serverHandler, e.workerHandler).Run(); err != nil {
+	cli := NewCLI(e.serverHandler, e.workerHandler)
+	cli.QueryHandler = e.queryHandler
+	cli.ImportHandler = e.importHandler
+	cli.BackupHandler = e.backupHandler
+	cli.RestoreHandler = e.restoreHandler
+	cli.RotateHandler = e.rotateHandler
+
+	if err := cli.Run(); err != nil {
 		log.S.Errorw("Failed to run CLI", "error", err)
 
 		return ErrorExitCode
@@ -36,14 +64,234 @@ func NewEntrypoint(
 }
 
 func Run() int {
-	return NewEntrypoint(
-		services.NewGatewayServer().Run,
-		worker,
-	).Run()
+	entrypoint := NewEntrypoint(
+		server,
+		func() error {
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			return runWorker(ctx)
+		},
+	)
+	entrypoint.queryHandler = query
+	entrypoint.importHandler = importItems
+	entrypoint.backupHandler = backup
+	entrypoint.restoreHandler = restore
+	entrypoint.rotateHandler = rotateKey
+
+	return entrypoint.Run()
+}
+
+func newWorker() *worker.Worker {
+	return worker.New(services.JobHandlers, export.PurgeExpired, services.PurgeDeletedAttachments)
+}
+
+func server() error {
+	gateway := services.NewGatewayServer()
+	if !env.GetIsWorkerEmbedded() {
+		return gateway.Run()
+	}
+
+	// the worker needs the schema, which is created with the server
+	if _, err := gateway.GetServer(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		if err := newWorker().Run(ctx); err != nil {
+			log.S.Errorw("Embedded worker failed", "error", err)
+		}
+	}()
+
+	return gateway.Run()
 }
 
-func worker() error {
+func runWorker(ctx context.Context) error {
 	log.S.Info("Running as worker")
 
+	return newWorker().Run(ctx)
+}
+
+func query(expression string) error {
+	ctx := context.Background()
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	p, err := querylang.ItemPredicate(ctx, client, expression)
+	if err != nil {
+		return fmt.Errorf("invalid query: %w", err)
+	}
+
+	items, err := client.Item.Query().
+		Where(item.DeletedAtIsNil(), p).
+		WithAssetClass().
+		Order(item.ByName()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query items: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tNAME\tCLASS")
+	for _, i := range items {
+		class := ""
+		if i.Edges.AssetClass != nil {
+			class = i.Edges.AssetClass.Name
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", i.ID, i.Name, class)
+	}
+
+	return w.Flush()
+}
+
+func importItems(params *ImportParams) error {
+	ctx := context.Background()
+
+	format := params.Format
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(params.File)), ".")
+	}
+
+	file, err := os.Open(params.File)
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	res, err := importer.Import(ctx, client, file, importer.Options{
+		Format:  format,
+		Key:     params.Key,
+		Mapping: params.Map,
+		DryRun:  params.DryRun,
+		User:    params.User,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to import items: %w", err)
+	}
+
+	for _, rowErr := range res.Errors {
+		_, _ = fmt.Fprintln(os.Stderr, rowErr.Error())
+	}
+
+	if len(res.Errors) > 0 {
+		return fmt.Errorf("import failed with %d errors, nothing was changed", len(res.Errors))
+	}
+
+	action := "Imported"
+	if !res.Applied {
+		action = "Validated"
+	}
+	fmt.Printf("%s %d new and %d updated items\n", action, res.Created, res.Updated)
+
+	return nil
+}
+
+func backup(file string) error {
+	ctx := context.Background()
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	out, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create backup file: %w", err)
+	}
+
+	if err := store.Backup(ctx, client, out); err != nil {
+		_ = out.Close()
+		_ = os.Remove(file)
+		return err
+	}
+
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to write backup file: %w", err)
+	}
+
+	fmt.Printf("Wrote backup to %s\n", file)
+
+	return nil
+}
+
+func restore(file string) error {
+	ctx := context.Background()
+
+	in, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open backup file: %w", err)
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	if err := store.Restore(ctx, client, in); err != nil {
+		return err
+	}
+
+	fmt.Printf("Restored backup from %s\n", file)
+
+	return nil
+}
+
+func rotateKey(user string) error {
+	ctx := context.Background()
+
+	keyring, err := secrets.LoadKeyring()
+	if err != nil {
+		return err
+	}
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	count, err := secrets.Rotate(ctx, client, keyring, user)
+	if err != nil {
+		return fmt.Errorf("failed to rotate master key: %w", err)
+	}
+
+	fmt.Printf("Re-encrypted %d secrets with master key %s\n", count, keyring.KeyID())
+
 	return nil
 }
@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"context"
+	"dig-inv/store"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestEntrypoint_RunSuccess(t *testing.T) {
@@ -39,7 +43,11 @@ func TestEntrypoint_RunWithError(t *testing.T) {
 }
 
 func TestWorker(t *testing.T) {
-	err := worker()
+	// the worker polls until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := runWorker(ctx)
 	if err != nil {
 		t.Errorf("Expected nil error, got %v", err)
 	}
@@ -48,7 +56,7 @@ func TestWorker(t *testing.T) {
 func Test_Run(t *testing.T) {
 	mockCommandlineArgs(t, func(t *testing.T) {
 		Run()
-	}, CommandWorker)
+	}, CommandQuery, "name:test")
 }
 
 func Test_RunWithError(t *testing.T) {
@@ -63,3 +71,42 @@ func Test_RunWithError(t *testing.T) {
 		}
 	}, CommandServer)
 }
+
+func TestImportItems(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "items.csv")
+	if err := os.WriteFile(file, []byte("name,asset_class\nimported,Missing\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write import file: %v", err)
+	}
+
+	err := importItems(&ImportParams{File: file, Key: "id", User: "cli"})
+	if err == nil {
+		t.Error("Expected error for row with unknown asset class")
+	}
+
+	err = importItems(&ImportParams{File: filepath.Join(t.TempDir(), "missing.csv")})
+	if err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "backup.tar.gz")
+
+	if err := backup(file); err != nil {
+		t.Fatalf("Expected nil error, got %v", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+	client.Tag.Create().SetName("backup").SetCreatedBy("cli").SetUpdatedBy("cli").SaveX(context.Background())
+
+	if err := backup(file); err == nil {
+		t.Error("Expected error for existing backup file")
+	}
+
+	if err := restore(file); err == nil {
+		t.Error("Expected error for restore into a non-empty database")
+	}
+}
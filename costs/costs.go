@@ -0,0 +1,111 @@
+package costs
+
+import (
+	"dig-inv/env"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownCurrency is returned for currencies that are neither the base currency nor part of the rates table.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// Rates converts amounts between the base currency of the spend reports and the currencies of the rates table.
+type Rates struct {
+	base string
+	// rates holds the value of one unit of each currency in the base currency
+	rates map[string]float64
+}
+
+// ParseRates parses a rates table such as `USD=0.92, GBP=1.17`, which holds the value of one unit of every currency in
+// the base currency.
+func ParseRates(base, table string) (*Rates, error) {
+	base = strings.ToUpper(strings.TrimSpace(base))
+	if !currencyPattern.MatchString(base) {
+		return nil, fmt.Errorf("invalid base currency %q", base)
+	}
+
+	rates := map[string]float64{base: 1}
+	for _, entry := range strings.Split(table, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		currency, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rate %q, expected CURRENCY=RATE", entry)
+		}
+
+		currency = strings.ToUpper(strings.TrimSpace(currency))
+		if !currencyPattern.MatchString(currency) {
+			return nil, fmt.Errorf("invalid currency %q", currency)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || rate <= 0 || math.IsInf(rate, 0) {
+			return nil, fmt.Errorf("invalid rate of %s: %q", currency, value)
+		}
+
+		if currency == base && rate != 1 {
+			return nil, fmt.Errorf("the rate of the base currency %s has to be 1", base)
+		}
+		rates[currency] = rate
+	}
+
+	return &Rates{base: base, rates: rates}, nil
+}
+
+// LoadRates returns the rates configured by COST_CURRENCY and CURRENCY_RATES.
+func LoadRates() (*Rates, error) {
+	return ParseRates(env.GetCostCurrency(), env.GetCurrencyRates())
+}
+
+// Base returns the currency the rates are relative to.
+func (r *Rates) Base() string {
+	return r.base
+}
+
+// Has reports whether amounts in the currency can be converted.
+func (r *Rates) Has(currency string) bool {
+	_, ok := r.rates[currency]
+	return ok
+}
+
+// Convert converts the amount between two currencies of the table, through the base currency.
+func (r *Rates) Convert(amount float64, from, to string) (float64, error) {
+	fromRate, ok := r.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, from)
+	}
+
+	toRate, ok := r.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownCurrency, to)
+	}
+
+	return amount * fromRate / toRate, nil
+}
+
+// Monthly returns the share of an amount charged per billing period that falls on a single month. Amounts without
+// period are taken as monthly.
+func Monthly(amount float64, period string) float64 {
+	switch period {
+	case "quarterly":
+		return amount / 3
+	case "yearly":
+		return amount / 12
+	}
+
+	return amount
+}
+
+// Round rounds the amount to cents, which is done after summing up, so rounding errors do not add up.
+func Round(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
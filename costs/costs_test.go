@@ -0,0 +1,73 @@
+package costs
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseRates(t *testing.T) {
+	rates, err := ParseRates("eur", " usd=0.5, GBP = 1.25 ,")
+	if err != nil {
+		t.Fatalf("Failed to parse rates: %v", err)
+	}
+
+	if rates.Base() != "EUR" || !rates.Has("EUR") || !rates.Has("USD") || !rates.Has("GBP") || rates.Has("CHF") {
+		t.Errorf("Unexpected rates: %v", rates)
+	}
+
+	tests := []struct {
+		amount   float64
+		from, to string
+		expected float64
+	}{
+		{10, "USD", "EUR", 5},
+		{10, "EUR", "USD", 20},
+		{10, "GBP", "USD", 25},
+		{10, "EUR", "EUR", 10},
+	}
+	for _, test := range tests {
+		converted, err := rates.Convert(test.amount, test.from, test.to)
+		if err != nil || math.Abs(converted-test.expected) > 1e-9 {
+			t.Errorf("Expected %v %s to be %v %s, got %v (%v)", test.amount, test.from, test.expected, test.to, converted, err)
+		}
+	}
+
+	if _, err := rates.Convert(10, "CHF", "EUR"); !errors.Is(err, ErrUnknownCurrency) {
+		t.Errorf("Expected unknown currency, got %v", err)
+	}
+
+	for _, table := range []string{"USD", "USD=abc", "USD=0", "USD=-1", "DOLLAR=1", "EUR=2"} {
+		if _, err := ParseRates("EUR", table); err == nil {
+			t.Errorf("Expected invalid table %q to be rejected", table)
+		}
+	}
+
+	if _, err := ParseRates("", ""); err == nil {
+		t.Errorf("Expected missing base currency to be rejected")
+	}
+}
+
+func TestLoadRates(t *testing.T) {
+	t.Setenv("COST_CURRENCY", "USD")
+	t.Setenv("CURRENCY_RATES", "EUR=1.08")
+
+	rates, err := LoadRates()
+	if err != nil {
+		t.Fatalf("Failed to load rates: %v", err)
+	}
+
+	if converted, _ := rates.Convert(100, "EUR", "USD"); Round(converted) != 108 {
+		t.Errorf("Expected 108 USD, got %v", converted)
+	}
+}
+
+func TestMonthly(t *testing.T) {
+	if Monthly(30, "monthly") != 30 || Monthly(30, "quarterly") != 10 || Monthly(120, "yearly") != 10 || Monthly(30, "") != 30 {
+		t.Errorf("Unexpected monthly amounts")
+	}
+
+	if Round(10.005000001) != 10.01 || Round(3.333333) != 3.33 {
+		t.Errorf("Unexpected rounding")
+	}
+}
@@ -0,0 +1,396 @@
+package dnscheck
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schema"
+	"dig-inv/env"
+	"dig-inv/worker"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"golang.org/x/net/dns/dnsmessage"
+	"slices"
+	"strings"
+)
+
+// JobType is the type of the job runs that resolve the recorded DNS records. The comma-separated `classes` parameter
+// lists the IDs of the asset classes holding DNS records, by default the classes named DNS record or with the dns
+// provider are used. The `servers` parameter lists the IDs of the asset classes of servers, whose deleted items are
+// decommissioned servers, by default the classes named Server are used. The `resolvers` parameter overrides the
+// configured resolvers.
+const JobType = "dns_check"
+
+// Provider marks asset classes whose items are DNS records.
+const Provider = "dns"
+
+// recordAttributes describe the record of an item, they are added to the DNS record classes if missing
+var recordAttributes = []*ent.AttributeDefinition{
+	{Key: "record_name", Name: "Record name", Type: attributedefinition.TypeString, Description: "The fully qualified name of the record, the name of the item is used if empty."},
+	{Key: "record_type", Name: "Record type", Type: attributedefinition.TypeString, Description: "The type of the record, only A, AAAA, CNAME, MX and TXT records are checked."},
+	{Key: "value", Name: "Value", Type: attributedefinition.TypeString, Description: "The value of the record, e.g. the address of A records or `10 mail.example.org` for MX records."},
+}
+
+// hostAttributes are the attributes of servers that hold their host name, CNAMEs pointing to the host names of deleted
+// servers are dangling. They are selected into the fields of serverHosts.
+var hostAttributes = []string{"hostname", "fqdn"}
+
+// statuses by severity, the status of a check is the most severe status of its answers
+var severity = []dnscheck.Status{
+	dnscheck.StatusOk,
+	dnscheck.StatusError,
+	dnscheck.StatusMismatch,
+	dnscheck.StatusNxdomain,
+	dnscheck.StatusDangling,
+}
+
+type record struct {
+	item       *ent.Item
+	name       string
+	recordType string
+	value      string
+}
+
+// RunCheckJob is the worker handler of DNS checks. Every recorded record is resolved against all resolvers and the
+// result is recorded for the record item. Mismatches, NXDOMAINs and dangling CNAMEs are reported in the result, records
+// that could not be resolved fail the job.
+func RunCheckJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	resolvers := splitList(run.Parameters["resolvers"])
+	if len(resolvers) == 0 {
+		resolvers = env.GetDnsResolvers()
+	}
+	if len(resolvers) == 0 {
+		var err error
+		if resolvers, err = systemResolvers(); err != nil {
+			return "", err
+		}
+	}
+
+	classes, err := assetClasses(ctx, client, run.Parameters["classes"], "DNS record", assetclass.Or(
+		assetclass.Provider(Provider),
+		assetclass.NameEqualFold("DNS record"),
+		assetclass.NameEqualFold("DNS records"),
+	))
+	if err != nil {
+		return "", err
+	}
+
+	servers, err := assetClasses(ctx, client, run.Parameters["servers"], "server", assetclass.Or(
+		assetclass.NameEqualFold("Server"),
+		assetclass.NameEqualFold("Servers"),
+	))
+	if err != nil {
+		return "", err
+	}
+
+	records, err := recordedRecords(ctx, client, classes)
+	if err != nil {
+		return "", err
+	}
+
+	decommissioned, err := decommissionedHosts(ctx, client, servers)
+	if err != nil {
+		return "", err
+	}
+
+	counts := make(map[dnscheck.Status]int)
+	var errs []error
+	for i, r := range records {
+		worker.ReportProgress(ctx, i*100/len(records))
+
+		status, answers, message := check(ctx, resolvers, r, decommissioned)
+		err := client.DnsCheck.Create().
+			SetItem(r.item).
+			SetRecordName(r.name).
+			SetRecordType(dnscheck.RecordType(r.recordType)).
+			SetExpected(r.value).
+			SetStatus(status).
+			SetAnswers(answers).
+			SetMessage(message).
+			SetCreatedBy(worker.Subject).
+			SetUpdatedBy(worker.Subject).
+			Exec(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to record check of %s: %w", r.name, err)
+		}
+
+		counts[status]++
+		if status == dnscheck.StatusError {
+			errs = append(errs, fmt.Errorf("%s %s: %s", r.recordType, r.name, message))
+		} else if status != dnscheck.StatusOk {
+			worker.Logf(ctx, "%s %s: %s", r.recordType, r.name, message)
+		}
+	}
+
+	result := fmt.Sprintf("checked %d records, %d mismatches, %d NXDOMAINs, %d dangling CNAMEs",
+		len(records), counts[dnscheck.StatusMismatch], counts[dnscheck.StatusNxdomain], counts[dnscheck.StatusDangling])
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%s, %d failed: %w", result, len(errs), errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+// assetClasses returns the asset classes with the given comma-separated IDs, or the classes matching the defaults.
+func assetClasses(ctx context.Context, client *ent.Client, ids, kind string, defaults predicate.AssetClass) ([]*ent.AssetClass, error) {
+	query := client.AssetClass.Query().Where(assetclass.DeletedAtIsNil())
+
+	if ids != "" {
+		classIds := make([]uuid.UUID, 0)
+		for _, id := range splitList(ids) {
+			classId, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid asset class %q: %w", id, err)
+			}
+			classIds = append(classIds, classId)
+		}
+		query = query.Where(assetclass.IDIn(classIds...))
+	} else {
+		query = query.Where(defaults)
+	}
+
+	classes, err := query.Order(assetclass.ByName()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s asset classes: %w", kind, err)
+	}
+
+	return classes, nil
+}
+
+// recordedRecords returns the checkable records of the DNS record classes and adds the record attributes to them.
+func recordedRecords(ctx context.Context, client *ent.Client, classes []*ent.AssetClass) ([]record, error) {
+	var records []record
+	for _, class := range classes {
+		if _, err := attributes.Ensure(ctx, client, class.ID, recordAttributes, worker.Subject); err != nil {
+			return nil, err
+		}
+
+		items, err := client.Item.Query().
+			Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(class.ID))).
+			Order(item.ByName(), item.ByID()).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query DNS records of %s: %w", class.Name, err)
+		}
+
+		for _, i := range items {
+			r := record{item: i, name: normalizeName(i.Name)}
+			if name, ok := i.Attributes["record_name"].(string); ok && name != "" {
+				r.name = normalizeName(name)
+			}
+			r.recordType, _ = i.Attributes["record_type"].(string)
+			r.recordType = strings.ToUpper(strings.TrimSpace(r.recordType))
+			if _, ok := recordTypes[r.recordType]; !ok {
+				continue
+			}
+			value, _ := i.Attributes["value"].(string)
+			r.value = normalizeValue(r.recordType, value)
+
+			records = append(records, r)
+		}
+	}
+
+	return records, nil
+}
+
+// decommissionedHosts returns the host names of deleted servers that are not used by any other server. Only the names
+// and host attributes of the items of the server classes are loaded.
+func decommissionedHosts(ctx context.Context, client *ent.Client, servers []*ent.AssetClass) (map[string]bool, error) {
+	classIds := make([]uuid.UUID, 0, len(servers))
+	for _, class := range servers {
+		classIds = append(classIds, class.ID)
+	}
+
+	columns := make([]ent.AggregateFunc, 0, len(hostAttributes))
+	for _, key := range hostAttributes {
+		columns = append(columns, attributeColumn(key))
+	}
+
+	var hosts []serverHosts
+	err := client.Item.Query().
+		Where(item.HasAssetClassWith(assetclass.IDIn(classIds...))).
+		Select(item.FieldName, item.FieldDeletedAt).
+		Aggregate(columns...).
+		Scan(ctx, &hosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query servers: %w", err)
+	}
+
+	decommissioned := make(map[string]bool)
+	for _, h := range hosts {
+		if h.DeletedAt.Valid {
+			for _, host := range h.names() {
+				decommissioned[host] = true
+			}
+		}
+	}
+
+	for _, h := range hosts {
+		if !h.DeletedAt.Valid {
+			for _, host := range h.names() {
+				delete(decommissioned, host)
+			}
+		}
+	}
+
+	return decommissioned, nil
+}
+
+// serverHosts are the name and host attributes of a server.
+type serverHosts struct {
+	Name      string         `sql:"name"`
+	DeletedAt sql.NullTime   `sql:"deleted_at"`
+	Hostname  sql.NullString `sql:"hostname"`
+	Fqdn      sql.NullString `sql:"fqdn"`
+}
+
+func (h serverHosts) names() []string {
+	hosts := []string{normalizeName(h.Name)}
+	for _, host := range []sql.NullString{h.Hostname, h.Fqdn} {
+		if host.Valid && host.String != "" {
+			hosts = append(hosts, normalizeName(host.String))
+		}
+	}
+
+	return hosts
+}
+
+// attributeColumn selects the attribute with the key as a column of the same name.
+func attributeColumn(key string) ent.AggregateFunc {
+	return func(s *sql.Selector) string {
+		column := s.C(item.FieldAttributes)
+		if s.Dialect() == dialect.Postgres {
+			return sql.As(fmt.Sprintf("%s->>'%s'", column, key), key)
+		}
+		return sql.As(fmt.Sprintf("json_extract(%s, '$.%s')", column, key), key)
+	}
+}
+
+// SearchContent returns the values the record of the item resolved to at its latest check, e.g. the IP addresses of A
+// records, which are indexed with the item.
+func SearchContent(ctx context.Context, client *ent.Client, i *ent.Item) ([]string, error) {
+	latest, err := client.DnsCheck.Query().
+		Where(dnscheck.HasItemWith(item.ID(i.ID))).
+		Order(ent.Desc(dnscheck.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query DNS check of %s: %w", i.Name, err)
+	}
+
+	var values []string
+	for _, answer := range latest.Answers {
+		values = append(values, answer.Values...)
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(values))), nil
+}
+
+// check resolves the record against every resolver and returns the most severe status with its reason.
+func check(ctx context.Context, resolvers []string, r record, decommissioned map[string]bool) (dnscheck.Status, []schema.DnsAnswer, string) {
+	status := dnscheck.StatusOk
+	var message string
+	answers := make([]schema.DnsAnswer, 0, len(resolvers))
+
+	for _, resolver := range resolvers {
+		answer, answerStatus, reason := checkResolver(ctx, resolver, r, decommissioned)
+		answers = append(answers, answer)
+
+		if slices.Index(severity, answerStatus) > slices.Index(severity, status) {
+			status = answerStatus
+			message = fmt.Sprintf("%s: %s", resolver, reason)
+		}
+	}
+
+	return status, answers, message
+}
+
+func checkResolver(ctx context.Context, resolver string, r record, decommissioned map[string]bool) (schema.DnsAnswer, dnscheck.Status, string) {
+	answer := schema.DnsAnswer{Resolver: resolver}
+
+	rcode, values, err := resolve(ctx, resolver, r.name, recordTypes[r.recordType])
+	if err != nil {
+		answer.Error = err.Error()
+		return answer, dnscheck.StatusError, err.Error()
+	}
+	answer.Rcode = rcodeName(rcode)
+	answer.Values = values
+
+	switch rcode {
+	case dnsmessage.RCodeSuccess:
+	case dnsmessage.RCodeNameError:
+		return answer, dnscheck.StatusNxdomain, fmt.Sprintf("%s does not exist", r.name)
+	default:
+		return answer, dnscheck.StatusError, fmt.Sprintf("responded with %s", answer.Rcode)
+	}
+
+	if r.value != "" && !slices.Contains(values, r.value) {
+		if len(values) == 0 {
+			return answer, dnscheck.StatusMismatch, fmt.Sprintf("%s has no %s records", r.name, r.recordType)
+		}
+		return answer, dnscheck.StatusMismatch, fmt.Sprintf("%s resolves to %s instead of %s", r.name, strings.Join(values, ", "), r.value)
+	}
+
+	if r.recordType != "CNAME" {
+		return answer, dnscheck.StatusOk, ""
+	}
+
+	// the target of a CNAME is dangling if it points to a deleted server or does not resolve
+	for _, target := range values {
+		if decommissioned[target] {
+			return answer, dnscheck.StatusDangling, fmt.Sprintf("%s points to the decommissioned server %s", r.name, target)
+		}
+
+		resolves := false
+		for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+			rcode, addresses, err := resolve(ctx, resolver, target, qtype)
+			if err != nil {
+				return answer, dnscheck.StatusError, err.Error()
+			}
+			if rcode == dnsmessage.RCodeSuccess && len(addresses) > 0 {
+				resolves = true
+				break
+			}
+		}
+
+		if !resolves {
+			return answer, dnscheck.StatusDangling, fmt.Sprintf("%s points to %s, which does not resolve", r.name, target)
+		}
+	}
+
+	return answer, dnscheck.StatusOk, ""
+}
+
+// rcodeName returns the mnemonic of the response code, e.g. NXDOMAIN for RCodeNameError.
+func rcodeName(rcode dnsmessage.RCode) string {
+	switch rcode {
+	case dnsmessage.RCodeSuccess:
+		return "NOERROR"
+	case dnsmessage.RCodeFormatError:
+		return "FORMERR"
+	case dnsmessage.RCodeServerFailure:
+		return "SERVFAIL"
+	case dnsmessage.RCodeNameError:
+		return "NXDOMAIN"
+	case dnsmessage.RCodeNotImplemented:
+		return "NOTIMP"
+	case dnsmessage.RCodeRefused:
+		return "REFUSED"
+	}
+
+	return rcode.String()
+}
+
+func splitList(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+}
@@ -0,0 +1,145 @@
+package dnscheck
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/item"
+	"dig-inv/store/storetest"
+	"golang.org/x/net/dns/dnsmessage"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunCheckJob(t *testing.T) {
+	client := storetest.OpenClient(t, "dnscheck")
+	ctx := context.Background()
+	resolver := startDNSServer(t, zone{
+		"example.org":        {&dnsmessage.MXResource{Pref: 10, MX: mustName("mail.example.org")}},
+		"www.example.org":    {&dnsmessage.CNAMEResource{CNAME: mustName("web.example.org")}},
+		"web.example.org":    {&dnsmessage.AResource{A: [4]byte{192, 0, 2, 10}}},
+		"api.example.org":    {&dnsmessage.AResource{A: [4]byte{192, 0, 2, 21}}},
+		"legacy.example.org": {&dnsmessage.CNAMEResource{CNAME: mustName("gone.example.org")}},
+		"app.example.org":    {&dnsmessage.CNAMEResource{CNAME: mustName("srv-7.example.org")}},
+		"srv-7.example.org":  {&dnsmessage.AResource{A: [4]byte{192, 0, 2, 7}}},
+	})
+
+	servers := client.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.Item.Create().
+		SetName("srv-7").
+		SetAssetClass(servers).
+		SetAttributes(map[string]any{"hostname": "srv-7.example.org"}).
+		SetDeletedAt(time.Now()).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	// deleted items that are not servers are not decommissioned servers
+	websites := client.AssetClass.Create().SetName("Websites").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.Item.Create().
+		SetName("web.example.org").
+		SetAssetClass(websites).
+		SetDeletedAt(time.Now()).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	records := client.AssetClass.Create().SetName("DNS records").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	create := func(name, recordType, value string) *ent.Item {
+		return client.Item.Create().
+			SetName(name).
+			SetAssetClass(records).
+			SetAttributes(map[string]any{"record_type": recordType, "value": value}).
+			SetCreatedBy("a").
+			SetUpdatedBy("a").
+			SaveX(ctx)
+	}
+
+	mx := create("example.org", "MX", "10 Mail.example.org.")
+	www := create("www.example.org", "CNAME", "web.example.org")
+	create("web.example.org", "a", "192.0.2.10")
+	api := create("api.example.org", "A", "192.0.2.20")
+	old := create("old.example.org", "A", "192.0.2.30")
+	legacy := create("legacy.example.org", "CNAME", "gone.example.org")
+	app := create("app.example.org", "CNAME", "srv-7.example.org")
+	// not checked
+	create("_sip._tcp.example.org", "SRV", "10 5 5060 sip.example.org")
+
+	for range 2 {
+		result, err := RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{"resolvers": resolver}})
+		if err != nil {
+			t.Fatalf("Failed to run check: %v", err)
+		}
+
+		if result != "checked 7 records, 1 mismatches, 1 NXDOMAINs, 2 dangling CNAMEs" {
+			t.Errorf("Unexpected result: %s", result)
+		}
+	}
+
+	expected := map[*ent.Item]dnscheck.Status{
+		mx:     dnscheck.StatusOk,
+		www:    dnscheck.StatusOk,
+		api:    dnscheck.StatusMismatch,
+		old:    dnscheck.StatusNxdomain,
+		legacy: dnscheck.StatusDangling,
+		app:    dnscheck.StatusDangling,
+	}
+	for record, status := range expected {
+		checks := client.DnsCheck.Query().Where(dnscheck.HasItemWith(item.ID(record.ID))).AllX(ctx)
+		if len(checks) != 2 {
+			t.Fatalf("Expected 2 checks of %s, got %d", record.Name, len(checks))
+		}
+
+		if checks[0].Status != status || len(checks[0].Answers) != 1 || checks[0].Answers[0].Resolver != resolver {
+			t.Errorf("Unexpected check of %s: %+v", record.Name, checks[0])
+		}
+	}
+
+	check := client.DnsCheck.Query().Where(dnscheck.HasItemWith(item.ID(app.ID))).FirstX(ctx)
+	if !strings.Contains(check.Message, "decommissioned server srv-7.example.org") {
+		t.Errorf("Unexpected message: %s", check.Message)
+	}
+
+	check = client.DnsCheck.Query().Where(dnscheck.HasItemWith(item.ID(api.ID))).FirstX(ctx)
+	if check.Answers[0].Rcode != "NOERROR" || check.Answers[0].Values[0] != "192.0.2.21" {
+		t.Errorf("Unexpected answer: %+v", check.Answers[0])
+	}
+}
+
+func TestRunCheckJobDisagreeingResolvers(t *testing.T) {
+	client := storetest.OpenClient(t, "dnscheck")
+	ctx := context.Background()
+	current := startDNSServer(t, zone{"web.example.org": {&dnsmessage.AResource{A: [4]byte{192, 0, 2, 10}}}})
+	stale := startDNSServer(t, zone{"web.example.org": {&dnsmessage.AResource{A: [4]byte{192, 0, 2, 9}}}})
+	t.Setenv("DNS_RESOLVERS", current+","+stale)
+
+	class := client.AssetClass.Create().SetName("Records").SetProvider(Provider).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	web := client.Item.Create().
+		SetName("Website").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"record_name": "web.example.org", "record_type": "A", "value": "192.0.2.10"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	if _, err := RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{}}); err != nil {
+		t.Fatalf("Failed to run check: %v", err)
+	}
+
+	check := client.DnsCheck.Query().Where(dnscheck.HasItemWith(item.ID(web.ID))).OnlyX(ctx)
+	if check.Status != dnscheck.StatusMismatch || check.RecordName != "web.example.org" || len(check.Answers) != 2 ||
+		!strings.HasPrefix(check.Message, stale) {
+		t.Errorf("Unexpected check: %+v", check)
+	}
+
+	// unreachable resolvers fail the job, the check is recorded nevertheless
+	_, err := RunCheckJob(ctx, client, &ent.JobRun{Parameters: map[string]string{"resolvers": "127.0.0.1:1"}})
+	if err == nil || !strings.Contains(err.Error(), "1 failed") {
+		t.Errorf("Expected unreachable resolver to fail the job, got %v", err)
+	}
+
+	if n := client.DnsCheck.Query().Where(dnscheck.StatusEQ(dnscheck.StatusError)).CountX(ctx); n != 1 {
+		t.Errorf("Expected 1 failed check, got %d", n)
+	}
+}
@@ -0,0 +1,195 @@
+package dnscheck
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"golang.org/x/net/dns/dnsmessage"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+)
+
+const queryTimeout = 5 * time.Second
+
+// resolvConf lists the name servers of the system, which are used if no resolvers are configured
+var resolvConf = "/etc/resolv.conf"
+
+var recordTypes = map[string]dnsmessage.Type{
+	"A":     dnsmessage.TypeA,
+	"AAAA":  dnsmessage.TypeAAAA,
+	"CNAME": dnsmessage.TypeCNAME,
+	"MX":    dnsmessage.TypeMX,
+	"TXT":   dnsmessage.TypeTXT,
+}
+
+// resolve queries the resolver for the records of the name and returns the response code and the records of the type
+// in their presentation format. Records of other types, e.g. the CNAME leading to an A record, are not returned.
+func resolve(ctx context.Context, resolver, name string, qtype dnsmessage.Type) (dnsmessage.RCode, []string, error) {
+	qname, err := dnsmessage.NewName(strings.TrimSuffix(name, ".") + ".")
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid record name %q: %w", name, err)
+	}
+
+	id := uint16(rand.UintN(1 << 16))
+	query, err := (&dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: id, RecursionDesired: true},
+		Questions: []dnsmessage.Question{{Name: qname, Type: qtype, Class: dnsmessage.ClassINET}},
+	}).Pack()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to pack query: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	res, err := exchange(ctx, "udp", resolver, query)
+	if err == nil && res.Truncated {
+		// the answers did not fit into a datagram, e.g. large TXT records
+		res, err = exchange(ctx, "tcp", resolver, query)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if res.ID != id || len(res.Questions) != 1 || res.Questions[0].Type != qtype ||
+		!strings.EqualFold(res.Questions[0].Name.String(), qname.String()) {
+		return 0, nil, fmt.Errorf("%s responded to another query", resolver)
+	}
+
+	var values []string
+	for _, answer := range res.Answers {
+		if answer.Header.Type != qtype {
+			continue
+		}
+
+		switch body := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			values = append(values, netip.AddrFrom4(body.A).String())
+		case *dnsmessage.AAAAResource:
+			values = append(values, netip.AddrFrom16(body.AAAA).String())
+		case *dnsmessage.CNAMEResource:
+			values = append(values, normalizeName(body.CNAME.String()))
+		case *dnsmessage.MXResource:
+			values = append(values, fmt.Sprintf("%d %s", body.Pref, normalizeName(body.MX.String())))
+		case *dnsmessage.TXTResource:
+			values = append(values, strings.Join(body.TXT, ""))
+		}
+	}
+
+	return res.RCode, values, nil
+}
+
+func exchange(ctx context.Context, network, resolver string, query []byte) (*dnsmessage.Message, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, resolver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", resolver, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	buf := make([]byte, 0, 2+len(query))
+	if network == "tcp" {
+		// messages over TCP are prefixed with their length
+		buf = binary.BigEndian.AppendUint16(buf, uint16(len(query)))
+	}
+	if _, err := conn.Write(append(buf, query...)); err != nil {
+		return nil, fmt.Errorf("failed to query %s: %w", resolver, err)
+	}
+
+	var response []byte
+	if network == "tcp" {
+		var length uint16
+		if err := binary.Read(conn, binary.BigEndian, &length); err != nil {
+			return nil, fmt.Errorf("failed to read response of %s: %w", resolver, err)
+		}
+		response = make([]byte, length)
+		if _, err := io.ReadFull(conn, response); err != nil {
+			return nil, fmt.Errorf("failed to read response of %s: %w", resolver, err)
+		}
+	} else {
+		response = make([]byte, 65535)
+		n, err := conn.Read(response)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response of %s: %w", resolver, err)
+		}
+		response = response[:n]
+	}
+
+	var res dnsmessage.Message
+	if err := res.Unpack(response); err != nil {
+		return nil, fmt.Errorf("invalid response of %s: %w", resolver, err)
+	}
+
+	return &res, nil
+}
+
+// systemResolvers returns the name servers of the system.
+func systemResolvers() ([]string, error) {
+	f, err := os.Open(resolvConf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read system resolvers: %w", err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var resolvers []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(fields[1]); err == nil {
+			resolvers = append(resolvers, netip.AddrPortFrom(addr, 53).String())
+		}
+	}
+
+	if len(resolvers) == 0 {
+		return nil, errors.New("no system resolvers configured")
+	}
+
+	return resolvers, nil
+}
+
+// normalizeValue converts a recorded value to the presentation format returned by resolve.
+func normalizeValue(recordType, value string) string {
+	value = strings.TrimSpace(value)
+
+	switch recordType {
+	case "A", "AAAA":
+		if addr, err := netip.ParseAddr(value); err == nil {
+			return addr.String()
+		}
+	case "CNAME":
+		return normalizeName(value)
+	case "MX":
+		if fields := strings.Fields(value); len(fields) == 2 {
+			return fields[0] + " " + normalizeName(fields[1])
+		}
+	case "TXT":
+		// long TXT records are often recorded as quoted strings
+		if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+			return strings.ReplaceAll(value[1:len(value)-1], `" "`, "")
+		}
+	}
+
+	return value
+}
+
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
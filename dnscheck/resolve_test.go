@@ -0,0 +1,226 @@
+package dnscheck
+
+import (
+	"context"
+	"golang.org/x/net/dns/dnsmessage"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// zone maps lowercase record names to their records, names without records do not exist
+type zone map[string][]dnsmessage.ResourceBody
+
+// startDNSServer starts a resolver for the zone on a local UDP port and returns its address. Responses with more than
+// two TXT records are truncated, so they have to be retried over TCP, which is served on the same port.
+func startDNSServer(t *testing.T, records zone) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	listener, err := net.Listen("tcp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(answer(t, records, buf[:n], true), addr)
+		}
+	}()
+
+	go func() {
+		for {
+			c, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			buf := make([]byte, 514)
+			n, _ := c.Read(buf)
+			if n > 2 {
+				res := answer(t, records, buf[2:n], false)
+				_, _ = c.Write(append([]byte{byte(len(res) >> 8), byte(len(res))}, res...))
+			}
+			_ = c.Close()
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+func answer(t *testing.T, records zone, query []byte, udp bool) []byte {
+	var msg dnsmessage.Message
+	if err := msg.Unpack(query); err != nil || len(msg.Questions) != 1 {
+		t.Errorf("Invalid query: %v", err)
+		return nil
+	}
+	q := msg.Questions[0]
+
+	res := dnsmessage.Message{
+		Header:    dnsmessage.Header{ID: msg.ID, Response: true, RecursionAvailable: true},
+		Questions: msg.Questions,
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(q.Name.String(), "."))
+	bodies, ok := records[name]
+	if !ok {
+		res.RCode = dnsmessage.RCodeNameError
+	}
+
+	txt := 0
+	for _, body := range bodies {
+		// aliases are answered for every type, like a recursive resolver would before following them
+		if bodyType(body) != q.Type && bodyType(body) != dnsmessage.TypeCNAME {
+			continue
+		}
+
+		if bodyType(body) == dnsmessage.TypeTXT {
+			if txt++; udp && txt > 2 {
+				res.Truncated = true
+				res.Answers = nil
+				break
+			}
+		}
+
+		res.Answers = append(res.Answers, dnsmessage.Resource{
+			Header: dnsmessage.ResourceHeader{Name: q.Name, Type: bodyType(body), Class: dnsmessage.ClassINET, TTL: 300},
+			Body:   body,
+		})
+	}
+
+	packed, err := res.Pack()
+	if err != nil {
+		t.Errorf("Failed to pack response: %v", err)
+	}
+
+	return packed
+}
+
+func bodyType(body dnsmessage.ResourceBody) dnsmessage.Type {
+	switch body.(type) {
+	case *dnsmessage.AResource:
+		return dnsmessage.TypeA
+	case *dnsmessage.AAAAResource:
+		return dnsmessage.TypeAAAA
+	case *dnsmessage.CNAMEResource:
+		return dnsmessage.TypeCNAME
+	case *dnsmessage.MXResource:
+		return dnsmessage.TypeMX
+	case *dnsmessage.TXTResource:
+		return dnsmessage.TypeTXT
+	}
+
+	return dnsmessage.TypeALL
+}
+
+func mustName(name string) dnsmessage.Name {
+	return dnsmessage.MustNewName(name + ".")
+}
+
+func TestResolve(t *testing.T) {
+	resolver := startDNSServer(t, zone{
+		"www.example.org": {&dnsmessage.CNAMEResource{CNAME: mustName("Web.Example.org")}},
+		"web.example.org": {
+			&dnsmessage.AResource{A: [4]byte{192, 0, 2, 10}},
+			&dnsmessage.AAAAResource{AAAA: netip.MustParseAddr("2001:db8::10").As16()},
+		},
+		"example.org": {
+			&dnsmessage.MXResource{Pref: 10, MX: mustName("mail.example.org")},
+			&dnsmessage.TXTResource{TXT: []string{"v=spf1 ", "-all"}},
+			&dnsmessage.TXTResource{TXT: []string{"a"}},
+			&dnsmessage.TXTResource{TXT: []string{"b"}},
+		},
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		qtype  dnsmessage.Type
+		rcode  dnsmessage.RCode
+		values []string
+	}{
+		{"web.example.org", dnsmessage.TypeA, dnsmessage.RCodeSuccess, []string{"192.0.2.10"}},
+		{"web.example.org.", dnsmessage.TypeAAAA, dnsmessage.RCodeSuccess, []string{"2001:db8::10"}},
+		{"www.example.org", dnsmessage.TypeCNAME, dnsmessage.RCodeSuccess, []string{"web.example.org"}},
+		// the alias is not an A record
+		{"www.example.org", dnsmessage.TypeA, dnsmessage.RCodeSuccess, nil},
+		{"example.org", dnsmessage.TypeMX, dnsmessage.RCodeSuccess, []string{"10 mail.example.org"}},
+		// truncated and retried over TCP
+		{"example.org", dnsmessage.TypeTXT, dnsmessage.RCodeSuccess, []string{"v=spf1 -all", "a", "b"}},
+		{"missing.example.org", dnsmessage.TypeA, dnsmessage.RCodeNameError, nil},
+	}
+
+	for _, test := range tests {
+		rcode, values, err := resolve(ctx, resolver, test.name, test.qtype)
+		if err != nil {
+			t.Fatalf("Failed to resolve %s: %v", test.name, err)
+		}
+
+		if rcode != test.rcode || !slices.Equal(values, test.values) {
+			t.Errorf("Unexpected answer for %s %s: %s %v", test.qtype, test.name, rcode, values)
+		}
+	}
+
+	if _, _, err := resolve(ctx, resolver, "invalid..example.org", dnsmessage.TypeA); err == nil {
+		t.Error("Expected invalid name to fail")
+	}
+}
+
+func TestNormalizeValue(t *testing.T) {
+	tests := []struct {
+		recordType, value, expected string
+	}{
+		{"A", " 192.0.2.1 ", "192.0.2.1"},
+		{"AAAA", "2001:DB8:0::1", "2001:db8::1"},
+		{"CNAME", "Web.Example.org.", "web.example.org"},
+		{"MX", "10   Mail.Example.org.", "10 mail.example.org"},
+		{"TXT", `"v=spf1 " "-all"`, "v=spf1 -all"},
+		{"TXT", "v=spf1 -all", "v=spf1 -all"},
+	}
+
+	for _, test := range tests {
+		if actual := normalizeValue(test.recordType, test.value); actual != test.expected {
+			t.Errorf("Expected %q for %s %q, got %q", test.expected, test.recordType, test.value, actual)
+		}
+	}
+}
+
+func TestSystemResolvers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resolv.conf")
+	content := "# generated\nsearch example.org\nnameserver 192.0.2.53\nnameserver 2001:db8::53\noptions ndots:2\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write resolv.conf: %v", err)
+	}
+
+	previous := resolvConf
+	resolvConf = path
+	t.Cleanup(func() {
+		resolvConf = previous
+	})
+
+	resolvers, err := systemResolvers()
+	if err != nil {
+		t.Fatalf("Failed to read system resolvers: %v", err)
+	}
+
+	if !slices.Equal(resolvers, []string{"192.0.2.53:53", "[2001:db8::53]:53"}) {
+		t.Errorf("Unexpected resolvers: %v", resolvers)
+	}
+}
@@ -0,0 +1,307 @@
+package domains
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/drift"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"dig-inv/worker"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JobType is the type of the job runs that look up the registration data of domains. The comma-separated `classes`
+// parameter lists the IDs of the asset classes holding domains, by default the classes named Domain or with the
+// domain provider are used. The `renewal_prices` parameter lists the yearly renewal prices of the registrar per
+// top-level domain, e.g. `de=5.90 EUR, co.uk=8.50 GBP`, which are set as cost of the domains.
+const JobType = "domain_lookup"
+
+// Provider marks asset classes whose items are domains.
+const Provider = "domain"
+
+// attributes that are updated from the registration data, they are added to the domain classes if missing
+var domainAttributes = []*ent.AttributeDefinition{
+	{Key: "registrar", Name: "Registrar", Type: attributedefinition.TypeString},
+	{Key: "expiry_date", Name: "Expiry date", Type: attributedefinition.TypeDate, Description: "The date the registration of the domain expires."},
+	{Key: "status", Name: "Status", Type: attributedefinition.TypeString, Description: "The comma-separated status codes of the domain at its registry."},
+	{Key: "nameservers", Name: "Name servers", Type: attributedefinition.TypeString, Description: "The comma-separated name servers of the domain at its registry."},
+}
+
+// RunLookupJob is the worker handler of domain lookups. The registrar, expiry date, status and name servers of every
+// domain are updated from its registry through a drift report, which is applied according to the drift policy of the
+// domain class, and every lookup is recorded together with the values that differed.
+func RunLookupJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	classes, err := domainClasses(ctx, client, run.Parameters["classes"])
+	if err != nil {
+		return "", err
+	}
+
+	prices, err := renewalPrices(run.Parameters["renewal_prices"])
+	if err != nil {
+		return "", err
+	}
+
+	lookup := NewClient()
+	var looked, discrepant int
+	var errs []error
+	for _, class := range classes {
+		if _, err := attributes.Ensure(ctx, client, class.ID, domainAttributes, worker.Subject); err != nil {
+			return "", err
+		}
+
+		items, err := client.Item.Query().
+			Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(class.ID))).
+			Order(item.ByName(), item.ByID()).
+			All(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to query domains of %s: %w", class.Name, err)
+		}
+
+		var observed []drift.Observed
+		for i, domain := range items {
+			worker.ReportProgress(ctx, i*100/len(items))
+
+			actual, discrepancies, err := lookupDomain(ctx, client, lookup, domain)
+			if err != nil {
+				worker.Logf(ctx, "Failed to look up %s: %v", domain.Name, err)
+				errs = append(errs, fmt.Errorf("%s: %w", domain.Name, err))
+				continue
+			}
+
+			looked++
+			cost := renewalPrice(prices, domainName(domain))
+			if len(actual) > 0 || cost != nil {
+				observed = append(observed, drift.Observed{ItemID: domain.ID, Name: domain.Name, Attributes: actual, Cost: cost})
+			}
+			if len(discrepancies) > 0 {
+				discrepant++
+				for _, d := range discrepancies {
+					worker.Logf(ctx, "%s: %s was %v, registry reports %v", domain.Name, d.Attribute, d.Stored, d.Actual)
+				}
+			}
+		}
+
+		report, err := drift.Reconcile(ctx, client, drift.Sync{Source: JobType, Class: class, Observed: observed}, worker.Subject)
+		if err != nil {
+			return "", err
+		}
+		if report != nil {
+			worker.Logf(ctx, "Recorded %d changed domains of %s, %s", len(report.Changes), class.Name, report.Status)
+		}
+	}
+
+	result := fmt.Sprintf("looked up %d domains, %d with discrepancies", looked, discrepant)
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%s, %d failed: %w", result, len(errs), errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+func domainClasses(ctx context.Context, client *ent.Client, ids string) ([]*ent.AssetClass, error) {
+	query := client.AssetClass.Query().Where(assetclass.DeletedAtIsNil())
+
+	if ids != "" {
+		classIds := make([]uuid.UUID, 0)
+		for _, id := range strings.FieldsFunc(ids, func(r rune) bool { return r == ',' || r == ' ' }) {
+			classId, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid asset class %q: %w", id, err)
+			}
+			classIds = append(classIds, classId)
+		}
+		query = query.Where(assetclass.IDIn(classIds...))
+	} else {
+		query = query.Where(assetclass.Or(
+			assetclass.Provider(Provider),
+			assetclass.NameEqualFold("Domain"),
+			assetclass.NameEqualFold("Domains"),
+		))
+	}
+
+	classes, err := query.Order(assetclass.ByName()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query domain asset classes: %w", err)
+	}
+
+	return classes, nil
+}
+
+// renewalPrices parses the yearly renewal prices per top-level domain, such as `de=5.90 EUR, co.uk=8.50 GBP`.
+func renewalPrices(list string) (map[string]schema.Cost, error) {
+	prices := make(map[string]schema.Cost)
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		tld, price, ok := strings.Cut(entry, "=")
+		amount, currency, hasCurrency := strings.Cut(strings.TrimSpace(price), " ")
+		if !ok || !hasCurrency {
+			return nil, fmt.Errorf("invalid renewal price %q, expected TLD=AMOUNT CURRENCY", entry)
+		}
+
+		value, err := strconv.ParseFloat(amount, 64)
+		if err != nil || value < 0 || math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, fmt.Errorf("invalid renewal price of %s: %q", tld, amount)
+		}
+
+		cost := schema.Cost{Amount: value, Currency: strings.ToUpper(strings.TrimSpace(currency)), Period: string(item.CostPeriodYearly)}
+		if err := item.CostCurrencyValidator(cost.Currency); err != nil {
+			return nil, fmt.Errorf("invalid currency of %s: %q", tld, currency)
+		}
+		prices[strings.TrimPrefix(normalizeHost(tld), ".")] = cost
+	}
+
+	return prices, nil
+}
+
+// renewalPrice returns the price of the most specific top-level domain of the domain, nil if there is none.
+func renewalPrice(prices map[string]schema.Cost, domain string) *schema.Cost {
+	for suffix := domain; suffix != ""; {
+		if price, ok := prices[suffix]; ok {
+			return &price
+		}
+
+		_, suffix, _ = strings.Cut(suffix, ".")
+	}
+
+	return nil
+}
+
+// lookupDomain looks up the domain at its registry and returns the attributes whose stored values differ from the
+// registration data, which are applied through a drift report. Every lookup is recorded, failed lookups as well.
+func lookupDomain(ctx context.Context, client *ent.Client, lookup *Client, domain *ent.Item) (map[string]any, []schema.LookupDiscrepancy, error) {
+	name := domainName(domain)
+	record := client.DomainLookup.Create().
+		SetItem(domain).
+		SetDomain(name).
+		SetCreatedBy(worker.Subject).
+		SetUpdatedBy(worker.Subject)
+
+	reg, err := lookup.Lookup(ctx, name)
+	if err != nil {
+		if recordErr := record.SetSource(domainlookup.SourceRdap).SetError(err.Error()).Exec(ctx); recordErr != nil {
+			return nil, nil, fmt.Errorf("failed to record lookup: %w", recordErr)
+		}
+		return nil, nil, err
+	}
+
+	actual := registrationValues(reg)
+	changed := make(map[string]any)
+	var discrepancies []schema.LookupDiscrepancy
+	for _, key := range slices.Sorted(maps.Keys(actual)) {
+		stored, _ := domain.Attributes[key].(string)
+		if sameValue(key, stored, actual[key]) {
+			continue
+		}
+
+		if stored != "" {
+			discrepancies = append(discrepancies, schema.LookupDiscrepancy{Attribute: key, Stored: stored, Actual: actual[key]})
+		}
+		changed[key] = actual[key]
+	}
+
+	err = record.
+		SetSource(registrationSource(reg)).
+		SetServer(reg.Server).
+		SetRegistrar(reg.Registrar).
+		SetNillableExpiresAt(reg.ExpiresAt).
+		SetStatuses(reg.Statuses).
+		SetNameservers(reg.Nameservers).
+		SetDiscrepancies(discrepancies).
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record lookup: %w", err)
+	}
+
+	return changed, discrepancies, nil
+}
+
+// domainName returns the domain attribute of the item, or its name.
+func domainName(domain *ent.Item) string {
+	if name, ok := domain.Attributes["domain"].(string); ok && name != "" {
+		return normalizeHost(name)
+	}
+
+	return normalizeHost(domain.Name)
+}
+
+// registrationValues returns the attribute values of the registration data that is known.
+func registrationValues(reg *Registration) map[string]string {
+	values := make(map[string]string)
+	if reg.Registrar != "" {
+		values["registrar"] = reg.Registrar
+	}
+
+	if reg.ExpiresAt != nil {
+		values["expiry_date"] = reg.ExpiresAt.Format(attributes.DateLayout)
+	}
+
+	if len(reg.Statuses) > 0 {
+		values["status"] = strings.Join(sortedList(reg.Statuses), ", ")
+	}
+
+	if len(reg.Nameservers) > 0 {
+		values["nameservers"] = strings.Join(sortedList(reg.Nameservers), ", ")
+	}
+
+	return values
+}
+
+// sameValue compares registrars case-insensitively and lists regardless of their order.
+func sameValue(key, stored, actual string) bool {
+	switch key {
+	case "registrar":
+		return strings.EqualFold(strings.TrimSpace(stored), actual)
+	case "status", "nameservers":
+		list := strings.FieldsFunc(strings.ToLower(stored), func(r rune) bool { return r == ',' })
+		for i := range list {
+			list[i] = normalizeHost(list[i])
+		}
+		return slices.Equal(sortedList(list), strings.Split(actual, ", "))
+	}
+
+	return stored == actual
+}
+
+// SearchContent returns the registrar and name servers of the latest lookup of the domain, which are indexed with the
+// item.
+func SearchContent(ctx context.Context, client *ent.Client, i *ent.Item) ([]string, error) {
+	latest, err := client.DomainLookup.Query().
+		Where(domainlookup.HasItemWith(item.ID(i.ID)), domainlookup.Or(domainlookup.ErrorIsNil(), domainlookup.Error(""))).
+		Order(ent.Desc(domainlookup.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lookup of %s: %w", i.Name, err)
+	}
+
+	return append([]string{latest.Domain, latest.Registrar}, latest.Nameservers...), nil
+}
+
+func sortedList(list []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(list)))
+}
+
+func registrationSource(reg *Registration) domainlookup.Source {
+	if reg.Source == "whois" {
+		return domainlookup.SourceWhois
+	}
+
+	return domainlookup.SourceRdap
+}
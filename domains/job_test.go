@@ -0,0 +1,120 @@
+package domains
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/store/storetest"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRunLookupJob(t *testing.T) {
+	startRegistry(t)
+	client := storetest.OpenClient(t, "domains")
+	ctx := context.Background()
+
+	class := client.AssetClass.Create().SetName("Domains").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("domain").
+		SetName("Domain").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	org := client.Item.Create().
+		SetName("Example.org.").
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	com := client.Item.Create().
+		SetName("Company website").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{
+			"domain":      "example.com",
+			"registrar":   "other registrar llc",
+			"expiry_date": "2025-08-13",
+			"nameservers": "b.iana-servers.net, A.IANA-SERVERS.NET.",
+		}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	missing := client.Item.Create().SetName("unknown.org").SetAssetClass(class).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	_, err := RunLookupJob(ctx, client, &ent.JobRun{Parameters: map[string]string{"renewal_prices": "org=9.50 usd, co.uk=8 GBP"}})
+	if err == nil || !strings.HasPrefix(err.Error(), "looked up 2 domains, 1 with discrepancies, 1 failed") {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	org = client.Item.GetX(ctx, org.ID)
+	if org.Attributes["registrar"] != "Example Registrar, Inc." || org.Attributes["expiry_date"] != "2030-05-01" ||
+		org.Attributes["status"] != "active, client transfer prohibited" ||
+		org.Attributes["nameservers"] != "ns1.example.net, ns2.example.net" {
+		t.Errorf("Unexpected attributes: %v", org.Attributes)
+	}
+
+	if org.CostAmount == nil || *org.CostAmount != 9.5 || org.CostCurrency != "USD" || *org.CostPeriod != item.CostPeriodYearly {
+		t.Errorf("Unexpected cost: %v %s %v", org.CostAmount, org.CostCurrency, org.CostPeriod)
+	}
+
+	// the registrar and name servers only differ in case and order
+	com = client.Item.GetX(ctx, com.ID)
+	if com.Attributes["registrar"] != "other registrar llc" || com.Attributes["expiry_date"] != "2031-08-13" {
+		t.Errorf("Unexpected attributes: %v", com.Attributes)
+	}
+	if com.CostAmount != nil {
+		t.Errorf("Expected no cost without a renewal price, got %v", *com.CostAmount)
+	}
+
+	lookup := client.DomainLookup.Query().Where(domainlookup.HasItemWith(item.ID(com.ID))).OnlyX(ctx)
+	if lookup.Source != domainlookup.SourceWhois || lookup.Domain != "example.com" || len(lookup.Discrepancies) != 1 {
+		t.Fatalf("Unexpected lookup: %+v", lookup)
+	}
+
+	if d := lookup.Discrepancies[0]; d.Attribute != "expiry_date" || d.Stored != "2025-08-13" || d.Actual != "2031-08-13" {
+		t.Errorf("Unexpected discrepancy: %+v", d)
+	}
+
+	failed := client.DomainLookup.Query().Where(domainlookup.HasItemWith(item.ID(missing.ID))).OnlyX(ctx)
+	if failed.Error == "" {
+		t.Errorf("Expected failed lookup to be recorded with its error")
+	}
+
+	if n := client.AttributeDefinition.Query().CountX(ctx); n != len(domainAttributes)+1 {
+		t.Errorf("Expected %d attributes, got %d", len(domainAttributes)+1, n)
+	}
+}
+
+func TestRenewalPrice(t *testing.T) {
+	prices, err := renewalPrices("uk=7 GBP, co.uk=8.50 gbp, .DE=5.9 EUR")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := map[string]string{
+		"example.co.uk": "8.5 GBP",
+		"example.uk":    "7 GBP",
+		"example.de":    "5.9 EUR",
+		"example.com":   "",
+	}
+	for domain, expected := range tests {
+		price := ""
+		if cost := renewalPrice(prices, domain); cost != nil {
+			price = fmt.Sprintf("%v %s", cost.Amount, cost.Currency)
+		}
+		if price != expected {
+			t.Errorf("Expected %q for %s, got %q", expected, domain, price)
+		}
+	}
+
+	for _, list := range []string{"de=5.90", "de=-1 EUR", "de=5 euro"} {
+		if _, err := renewalPrices(list); err == nil {
+			t.Errorf("Expected %q to be invalid", list)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package domains
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter spaces the requests to the same registry, as registries block clients that query too often.
+type limiter struct {
+	interval time.Duration
+
+	mu sync.Mutex
+	// the earliest time of the next request by registry
+	next map[string]time.Time
+}
+
+func newLimiter(interval time.Duration) *limiter {
+	return &limiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+	}
+}
+
+// wait blocks until a request to the registry is allowed and reserves the time slot for it.
+func (l *limiter) wait(ctx context.Context, registry string) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[registry]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[registry] = at.Add(l.interval)
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(at))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,235 @@
+package domains
+
+import (
+	"context"
+	"dig-inv/env"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const requestTimeout = 30 * time.Second
+
+// Registration is the registration data of a domain as reported by its registry.
+type Registration struct {
+	// Source is either rdap or whois.
+	Source      string
+	Server      string
+	Registrar   string
+	ExpiresAt   *time.Time
+	Statuses    []string
+	Nameservers []string
+}
+
+// Client looks up the registration data of domains over RDAP and falls back to WHOIS. Requests to the same registry
+// are rate limited, so a client should be shared by all lookups of a job.
+type Client struct {
+	http         *http.Client
+	bootstrapURL string
+	whoisServer  string
+	limiter      *limiter
+
+	mu sync.Mutex
+	// RDAP base URLs by top-level domain, loaded by the first lookup
+	services map[string]string
+}
+
+func NewClient() *Client {
+	return &Client{
+		http:         &http.Client{Timeout: requestTimeout},
+		bootstrapURL: env.GetRdapBootstrapURL(),
+		whoisServer:  env.GetWhoisServer(),
+		limiter:      newLimiter(env.GetRegistryRequestInterval()),
+	}
+}
+
+// Lookup returns the registration data of the domain. WHOIS is used if the registry has no RDAP server or the RDAP
+// lookup fails.
+func (c *Client) Lookup(ctx context.Context, domain string) (*Registration, error) {
+	reg, rdapErr := c.rdap(ctx, domain)
+	if rdapErr == nil {
+		return reg, nil
+	}
+
+	reg, whoisErr := c.whois(ctx, domain)
+	if whoisErr != nil {
+		return nil, errors.Join(fmt.Errorf("rdap: %w", rdapErr), fmt.Errorf("whois: %w", whoisErr))
+	}
+
+	return reg, nil
+}
+
+// rdapBootstrap is the format of the IANA bootstrap file, a list of top-level domains and the URLs of their server.
+type rdapBootstrap struct {
+	Services [][][]string `json:"services"`
+}
+
+type rdapDomain struct {
+	Status []string `json:"status"`
+	Events []struct {
+		Action string `json:"eventAction"`
+		Date   string `json:"eventDate"`
+	} `json:"events"`
+	Entities    []rdapEntity `json:"entities"`
+	Nameservers []struct {
+		LdhName string `json:"ldhName"`
+	} `json:"nameservers"`
+}
+
+type rdapEntity struct {
+	Roles      []string     `json:"roles"`
+	VcardArray []any        `json:"vcardArray"`
+	Entities   []rdapEntity `json:"entities"`
+}
+
+func (c *Client) rdap(ctx context.Context, domain string) (*Registration, error) {
+	base, err := c.rdapServer(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rdap server %q: %w", base, err)
+	}
+
+	if err := c.limiter.wait(ctx, u.Host); err != nil {
+		return nil, err
+	}
+
+	var res rdapDomain
+	if err := c.getJSON(ctx, strings.TrimSuffix(base, "/")+"/domain/"+url.PathEscape(domain), &res); err != nil {
+		return nil, err
+	}
+
+	reg := &Registration{
+		Source:    "rdap",
+		Server:    base,
+		Registrar: rdapRegistrar(res.Entities),
+	}
+
+	for _, status := range res.Status {
+		reg.Statuses = append(reg.Statuses, strings.ToLower(status))
+	}
+
+	for _, event := range res.Events {
+		if event.Action != "expiration" {
+			continue
+		}
+
+		if expiresAt, err := time.Parse(time.RFC3339, event.Date); err == nil {
+			expiresAt = expiresAt.UTC()
+			reg.ExpiresAt = &expiresAt
+		}
+	}
+
+	for _, ns := range res.Nameservers {
+		reg.Nameservers = append(reg.Nameservers, normalizeHost(ns.LdhName))
+	}
+
+	return reg, nil
+}
+
+// rdapServer returns the base URL of the RDAP server responsible for the domain.
+func (c *Client) rdapServer(ctx context.Context, domain string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.services == nil {
+		var bootstrap rdapBootstrap
+		if err := c.getJSON(ctx, c.bootstrapURL, &bootstrap); err != nil {
+			return "", fmt.Errorf("failed to load bootstrap: %w", err)
+		}
+
+		c.services = make(map[string]string)
+		for _, service := range bootstrap.Services {
+			if len(service) < 2 || len(service[1]) == 0 {
+				continue
+			}
+
+			// servers are listed by preference, https first
+			for _, tld := range service[0] {
+				c.services[strings.ToLower(tld)] = service[1][0]
+			}
+		}
+	}
+
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		if base, ok := c.services[strings.Join(labels[i:], ".")]; ok {
+			return base, nil
+		}
+	}
+
+	return "", fmt.Errorf("no rdap server for %s", domain)
+}
+
+func (c *Client) getJSON(ctx context.Context, u string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/rdap+json, application/json")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %w", u, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode == http.StatusNotFound {
+		return errors.New("domain not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s responded with %s", u, res.Status)
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode response of %s: %w", u, err)
+	}
+
+	return nil
+}
+
+// rdapRegistrar returns the formatted name of the vCard of the registrar entity.
+func rdapRegistrar(entities []rdapEntity) string {
+	for _, entity := range entities {
+		if !slices.Contains(entity.Roles, "registrar") {
+			if name := rdapRegistrar(entity.Entities); name != "" {
+				return name
+			}
+			continue
+		}
+
+		if len(entity.VcardArray) < 2 {
+			continue
+		}
+
+		properties, _ := entity.VcardArray[1].([]any)
+		for _, property := range properties {
+			values, _ := property.([]any)
+			if len(values) < 4 || values[0] != "fn" {
+				continue
+			}
+
+			if name, ok := values[3].(string); ok {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
+
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+}
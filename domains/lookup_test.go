@@ -0,0 +1,170 @@
+package domains
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const rdapResponse = `{
+  "ldhName": "EXAMPLE.ORG",
+  "status": ["client transfer prohibited", "active"],
+  "events": [
+    {"eventAction": "registration", "eventDate": "2010-05-01T12:00:00Z"},
+    {"eventAction": "expiration", "eventDate": "2030-05-01T12:00:00Z"}
+  ],
+  "entities": [
+    {
+      "roles": ["registrar"],
+      "vcardArray": ["vcard", [["version", {}, "text", "4.0"], ["fn", {}, "text", "Example Registrar, Inc."]]]
+    }
+  ],
+  "nameservers": [{"ldhName": "NS1.EXAMPLE.NET."}, {"ldhName": "ns2.example.net"}]
+}`
+
+const whoisResponse = `% WHOIS test server
+
+Domain Name: EXAMPLE.COM
+Registrar: Other Registrar LLC
+Registry Expiry Date: 2031-08-13T04:00:00Z
+Domain Status: clientDeleteProhibited https://icann.org/epp#clientDeleteProhibited
+Domain Status: clientTransferProhibited https://icann.org/epp#clientTransferProhibited
+Name Server: A.IANA-SERVERS.NET
+Name Server: B.IANA-SERVERS.NET
+`
+
+// startRegistry starts an RDAP server for .org and a WHOIS server referring to itself for .com, and configures the
+// lookup client to use them.
+func startRegistry(t *testing.T) {
+	var rdap *httptest.Server
+	rdap = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/dns.json":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"services": [][][]string{{{"org"}, {rdap.URL + "/rdap/"}}},
+			})
+		case "/rdap/domain/example.org":
+			w.Header().Set("Content-Type", "application/rdap+json")
+			_, _ = w.Write([]byte(rdapResponse))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(rdap.Close)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			query, _ := bufio.NewReader(conn).ReadString('\n')
+			switch strings.TrimSpace(query) {
+			case "example.com":
+				// the first response refers to the registry, which is the same server here
+				_, _ = conn.Write([]byte("refer: " + listener.Addr().String() + "\n\n" + whoisResponse))
+			default:
+				_, _ = conn.Write([]byte("% no match\n"))
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	t.Setenv("RDAP_BOOTSTRAP_URL", rdap.URL+"/dns.json")
+	t.Setenv("WHOIS_SERVER", listener.Addr().String())
+	t.Setenv("REGISTRY_REQUEST_INTERVAL", "1ms")
+}
+
+func TestLookup(t *testing.T) {
+	startRegistry(t)
+	client := NewClient()
+	ctx := context.Background()
+
+	reg, err := client.Lookup(ctx, "example.org")
+	if err != nil {
+		t.Fatalf("Failed to look up example.org: %v", err)
+	}
+
+	if reg.Source != "rdap" || reg.Registrar != "Example Registrar, Inc." {
+		t.Errorf("Unexpected registration: %+v", reg)
+	}
+
+	if reg.ExpiresAt == nil || !reg.ExpiresAt.Equal(time.Date(2030, 5, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected expiry: %v", reg.ExpiresAt)
+	}
+
+	if !slices.Equal(reg.Nameservers, []string{"ns1.example.net", "ns2.example.net"}) {
+		t.Errorf("Unexpected name servers: %v", reg.Nameservers)
+	}
+
+	reg, err = client.Lookup(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("Failed to look up example.com: %v", err)
+	}
+
+	if reg.Source != "whois" || reg.Registrar != "Other Registrar LLC" {
+		t.Errorf("Unexpected registration: %+v", reg)
+	}
+
+	if reg.ExpiresAt == nil || !reg.ExpiresAt.Equal(time.Date(2031, 8, 13, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected expiry: %v", reg.ExpiresAt)
+	}
+
+	if !slices.Equal(reg.Statuses, []string{"client delete prohibited", "client transfer prohibited"}) {
+		t.Errorf("Unexpected statuses: %v", reg.Statuses)
+	}
+
+	if !slices.Equal(reg.Nameservers, []string{"a.iana-servers.net", "b.iana-servers.net"}) {
+		t.Errorf("Unexpected name servers: %v", reg.Nameservers)
+	}
+
+	if _, err := client.Lookup(ctx, "unknown.org"); err == nil {
+		t.Error("Expected lookup of unknown domain to fail")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(50 * time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		if err := l.wait(ctx, "rdap.example.org"); err != nil {
+			t.Fatalf("Failed to wait: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("Requests to the same registry were not limited, took %v", elapsed)
+	}
+
+	// other registries are not delayed
+	start = time.Now()
+	if err := l.wait(ctx, "whois.example.com"); err != nil {
+		t.Fatalf("Failed to wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 40*time.Millisecond {
+		t.Errorf("Request to another registry was delayed by %v", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := l.wait(canceled, "rdap.example.org"); err == nil {
+		t.Error("Expected wait to fail for canceled context")
+	}
+}
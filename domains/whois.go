@@ -0,0 +1,175 @@
+package domains
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// WHOIS responses are limited, as the protocol has no framing besides closing the connection
+const maxWhoisResponse = 1 << 20
+
+// referrals to other WHOIS servers are followed at most this often
+const maxWhoisReferrals = 2
+
+var whoisDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006.01.02",
+	"02-Jan-2006",
+}
+
+func (c *Client) whois(ctx context.Context, domain string) (*Registration, error) {
+	server := c.whoisServer
+	response, err := c.queryWhois(ctx, server, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// the IANA server only refers to the server of the registry
+	for range maxWhoisReferrals {
+		referral := whoisReferral(response)
+		if referral == "" || referral == server {
+			break
+		}
+
+		server = referral
+		if response, err = c.queryWhois(ctx, server, domain); err != nil {
+			return nil, err
+		}
+	}
+
+	reg := parseWhois(response)
+	if reg.Registrar == "" && reg.ExpiresAt == nil && len(reg.Nameservers) == 0 {
+		return nil, fmt.Errorf("%s has no registration data for %s", server, domain)
+	}
+
+	reg.Server = server
+
+	return reg, nil
+}
+
+func (c *Client) queryWhois(ctx context.Context, server, domain string) (string, error) {
+	host, _, err := net.SplitHostPort(server)
+	if err != nil {
+		return "", fmt.Errorf("invalid whois server %q: %w", server, err)
+	}
+
+	if err := c.limiter.wait(ctx, host); err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", server)
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %w", server, err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	if _, err := io.WriteString(conn, domain+"\r\n"); err != nil {
+		return "", fmt.Errorf("failed to query %s: %w", server, err)
+	}
+
+	response, err := io.ReadAll(io.LimitReader(conn, maxWhoisResponse))
+	if err != nil {
+		return "", fmt.Errorf("failed to read response of %s: %w", server, err)
+	}
+
+	return string(response), nil
+}
+
+// whoisReferral returns the server a response refers to, with the default port.
+func whoisReferral(response string) string {
+	for key, value := range whoisFields(response) {
+		if key == "refer" || key == "whois" {
+			if _, _, err := net.SplitHostPort(value); err != nil {
+				return net.JoinHostPort(value, "43")
+			}
+			return value
+		}
+	}
+
+	return ""
+}
+
+// parseWhois reads the registration data from the fields common to most registries.
+func parseWhois(response string) *Registration {
+	reg := &Registration{Source: "whois"}
+
+	for key, value := range whoisFields(response) {
+		switch key {
+		case "registrar", "registrar name", "sponsoring registrar":
+			if reg.Registrar == "" {
+				reg.Registrar = value
+			}
+		case "registry expiry date", "registrar registration expiration date", "expiration date", "expiry date",
+			"expires", "expire", "paid-till":
+			if reg.ExpiresAt != nil {
+				continue
+			}
+
+			for _, layout := range whoisDateLayouts {
+				if expiresAt, err := time.Parse(layout, value); err == nil {
+					expiresAt = expiresAt.UTC()
+					reg.ExpiresAt = &expiresAt
+					break
+				}
+			}
+		case "domain status", "status", "state":
+			// statuses are followed by a link to their description
+			reg.Statuses = append(reg.Statuses, whoisStatus(strings.Fields(value)[0]))
+		case "name server", "nserver", "nameserver":
+			reg.Nameservers = append(reg.Nameservers, normalizeHost(strings.Fields(value)[0]))
+		}
+	}
+
+	return reg
+}
+
+// whoisFields yields the lowercase keys and the values of the non-empty `key: value` lines of a response.
+func whoisFields(response string) func(yield func(string, string) bool) {
+	return func(yield func(string, string) bool) {
+		scanner := bufio.NewScanner(strings.NewReader(response))
+		for scanner.Scan() {
+			key, value, found := strings.Cut(scanner.Text(), ":")
+			key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+			if !found || value == "" || strings.HasPrefix(key, "%") || strings.HasPrefix(key, "#") {
+				continue
+			}
+
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// whoisStatus converts EPP status codes to the RDAP notation, e.g. clientTransferProhibited to
+// client transfer prohibited.
+func whoisStatus(status string) string {
+	var b strings.Builder
+	for i, r := range status {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
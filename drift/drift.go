@@ -0,0 +1,409 @@
+package drift
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+)
+
+const (
+	KindNew      = "new"
+	KindChanged  = "changed"
+	KindVanished = "vanished"
+)
+
+// ErrNotPending is returned when a report that was already applied, rejected or superseded is reviewed.
+var ErrNotPending = errors.New("drift report is not pending")
+
+// Observed is an item as reported by a provider.
+type Observed struct {
+	// ItemID is set if the provider was asked about a stored item, otherwise the item is found by its key
+	ItemID uuid.UUID
+	// Key is the value of the key attribute of the sync
+	Key        string
+	Name       string
+	Attributes map[string]any
+	// Cost is the price of the item, reported by providers that expose pricing
+	Cost *schema.Cost
+}
+
+// Sync is the state of the items of an asset class at a provider.
+type Sync struct {
+	// Source is the job type of the sync
+	Source string
+	Class  *ent.AssetClass
+	// KeyAttribute identifies the items at the provider, e.g. the endpoint of certificates
+	KeyAttribute string
+	Observed     []Observed
+	// Complete is set if all items of the provider were observed, stored items that were not observed have vanished
+	Complete bool
+	// Unknown holds the keys of items whose state could not be retrieved, they neither changed nor vanished
+	Unknown []string
+	// Volatile attributes, e.g. the time of the last check, do not count as drift. They are written to the items right
+	// away.
+	Volatile []string
+}
+
+// Reconcile compares the provider state with the stored items and records the differences as drift report, which is
+// applied right away if the drift policy of the asset class allows it. No report is recorded if nothing drifted.
+// Pending reports of the same sync are superseded, as they no longer reflect the state of the provider.
+func Reconcile(ctx context.Context, client *ent.Client, sync Sync, user string) (*ent.DriftReport, error) {
+	defs, err := client.AttributeDefinition.Query().
+		Where(attributedefinition.DeletedAtIsNil(), attributedefinition.HasAssetClassWith(assetclass.ID(sync.Class.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attributes of %s: %w", sync.Class.Name, err)
+	}
+
+	items, err := client.Item.Query().
+		Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(sync.Class.ID))).
+		Order(item.ByName(), item.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query items of %s: %w", sync.Class.Name, err)
+	}
+
+	byId := make(map[uuid.UUID]*ent.Item, len(items))
+	byKey := make(map[string]*ent.Item, len(items))
+	for _, i := range items {
+		byId[i.ID] = i
+		if key, ok := i.Attributes[sync.KeyAttribute].(string); ok && key != "" && sync.KeyAttribute != "" {
+			byKey[key] = i
+		}
+	}
+
+	changes := make([]schema.DriftChange, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, o := range sync.Observed {
+		values, err := coerce(defs, o.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state of %s: %w", o.Name, err)
+		}
+		if err := validateCost(o.Cost); err != nil {
+			return nil, fmt.Errorf("invalid cost of %s: %w", o.Name, err)
+		}
+
+		existing := byId[o.ItemID]
+		if existing == nil && o.Key != "" {
+			existing = byKey[o.Key]
+		}
+		if existing == nil {
+			changes = append(changes, schema.DriftChange{Kind: KindNew, Key: o.Key, Name: o.Name, Attributes: values, Cost: o.Cost})
+			continue
+		}
+		seen[existing.ID] = true
+
+		diffs := compare(existing.Attributes, values, sync.Volatile)
+		stored := itemCost(existing)
+		costChanged := o.Cost != nil && (stored == nil || *stored != *o.Cost)
+		if len(diffs) > 0 || costChanged {
+			change := schema.DriftChange{
+				Kind:       KindChanged,
+				ItemID:     existing.ID.String(),
+				Key:        o.Key,
+				Name:       existing.Name,
+				Attributes: values,
+				Diffs:      diffs,
+			}
+			if costChanged {
+				change.Cost = o.Cost
+				change.StoredCost = stored
+			}
+			changes = append(changes, change)
+			continue
+		}
+
+		if err := refresh(ctx, client, defs, existing, values, sync.Volatile, user); err != nil {
+			return nil, err
+		}
+	}
+
+	if sync.Complete {
+		for _, i := range items {
+			key, _ := i.Attributes[sync.KeyAttribute].(string)
+			if seen[i.ID] || slices.Contains(sync.Unknown, key) {
+				continue
+			}
+			changes = append(changes, schema.DriftChange{Kind: KindVanished, ItemID: i.ID.String(), Key: key, Name: i.Name})
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	_, err = client.DriftReport.Update().
+		Where(
+			driftreport.StatusEQ(driftreport.StatusPending),
+			driftreport.Source(sync.Source),
+			driftreport.HasAssetClassWith(assetclass.ID(sync.Class.ID)),
+			driftreport.DeletedAtIsNil(),
+		).
+		SetStatus(driftreport.StatusSuperseded).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to supersede drift reports of %s: %w", sync.Class.Name, err)
+	}
+
+	report, err := client.DriftReport.Create().
+		SetSource(sync.Source).
+		SetAssetClass(sync.Class).
+		SetChanges(changes).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record drift of %s: %w", sync.Class.Name, err)
+	}
+
+	if sync.Class.DriftPolicy == assetclass.DriftPolicyApprove {
+		return report, nil
+	}
+
+	return Apply(ctx, client, report.ID, user)
+}
+
+// Apply writes the changes of a pending report to the items: new items are created, changed items get the values of
+// the provider, while attributes the provider does not report are kept, and vanished items are deleted. Either all
+// changes are applied or none.
+func Apply(ctx context.Context, client *ent.Client, id uuid.UUID, user string) (*ent.DriftReport, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	report, err := apply(ctx, tx.Client(), id, user)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit drift report: %w", err)
+	}
+
+	return report, nil
+}
+
+func apply(ctx context.Context, client *ent.Client, id uuid.UUID, user string) (*ent.DriftReport, error) {
+	report, err := review(ctx, client, id, driftreport.StatusApplied, user)
+	if err != nil {
+		return nil, err
+	}
+
+	class := report.Edges.AssetClass
+	defs, err := client.AttributeDefinition.Query().
+		Where(attributedefinition.DeletedAtIsNil(), attributedefinition.HasAssetClassWith(assetclass.ID(class.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attributes of %s: %w", class.Name, err)
+	}
+
+	now := time.Now()
+	for _, change := range report.Changes {
+		if change.Kind == KindNew {
+			values, err := attributes.Validate(defs, change.Attributes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid attributes of %s: %w", change.Name, err)
+			}
+
+			create := client.Item.Create().
+				SetName(change.Name).
+				SetAssetClass(class).
+				SetAttributes(values).
+				SetCreatedBy(user).
+				SetUpdatedBy(user)
+			if change.Cost != nil {
+				setCost(create.Mutation(), change.Cost)
+			}
+
+			err = create.Exec(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create %s: %w", change.Name, err)
+			}
+			continue
+		}
+
+		itemId, err := uuid.Parse(change.ItemID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item of %s: %w", change.Name, err)
+		}
+
+		// items that were deleted in the meantime are left alone
+		existing, err := client.Item.Query().Where(item.ID(itemId), item.DeletedAtIsNil()).Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s: %w", change.Name, err)
+		}
+
+		update := client.Item.UpdateOne(existing).SetUpdatedBy(user)
+		if change.Kind == KindVanished {
+			update.SetDeletedAt(now)
+		} else {
+			values := maps.Clone(existing.Attributes)
+			if values == nil {
+				values = make(map[string]any)
+			}
+			maps.Copy(values, change.Attributes)
+
+			values, err = attributes.Validate(defs, values)
+			if err != nil {
+				return nil, fmt.Errorf("invalid attributes of %s: %w", change.Name, err)
+			}
+			update.SetAttributes(values)
+			if change.Cost != nil {
+				setCost(update.Mutation(), change.Cost)
+			}
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", change.Name, err)
+		}
+	}
+
+	return report, nil
+}
+
+// Reject marks a pending report as rejected without changing the items.
+func Reject(ctx context.Context, client *ent.Client, id uuid.UUID, user string) (*ent.DriftReport, error) {
+	return review(ctx, client, id, driftreport.StatusRejected, user)
+}
+
+// review moves a pending report to the given status. The status is only changed if the report is still pending, so
+// concurrent reviews and a superseding sync cannot both succeed.
+func review(ctx context.Context, client *ent.Client, id uuid.UUID, status driftreport.Status, user string) (*ent.DriftReport, error) {
+	reviewed, err := client.DriftReport.Update().
+		Where(driftreport.ID(id), driftreport.StatusEQ(driftreport.StatusPending), driftreport.DeletedAtIsNil()).
+		SetStatus(status).
+		SetReviewedBy(user).
+		SetReviewedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update drift report: %w", err)
+	}
+
+	report, err := client.DriftReport.Query().
+		Where(driftreport.ID(id), driftreport.DeletedAtIsNil()).
+		WithAssetClass().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if reviewed == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNotPending, report.Status)
+	}
+
+	return report, nil
+}
+
+// coerce converts the reported values to their canonical representation, so they can be compared to stored values.
+func coerce(defs []*ent.AttributeDefinition, values map[string]any) (map[string]any, error) {
+	res := make(map[string]any, len(values))
+	for key, value := range values {
+		idx := slices.IndexFunc(defs, func(def *ent.AttributeDefinition) bool { return def.Key == key })
+		if idx < 0 {
+			return nil, fmt.Errorf("attribute %q is not defined", key)
+		}
+
+		converted, err := attributes.Coerce(defs[idx], value)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %q: %w", key, err)
+		}
+		res[key] = converted
+	}
+
+	return res, nil
+}
+
+// compare returns the reported attributes that differ from the stored ones, sorted by attribute.
+func compare(stored, actual map[string]any, volatile []string) []schema.AttributeDrift {
+	var res []schema.AttributeDrift
+	for _, key := range slices.Sorted(maps.Keys(actual)) {
+		if slices.Contains(volatile, key) || reflect.DeepEqual(stored[key], actual[key]) {
+			continue
+		}
+		res = append(res, schema.AttributeDrift{Attribute: key, Stored: stored[key], Actual: actual[key]})
+	}
+
+	return res
+}
+
+// validateCost checks a reported price against the cost fields of items, so invalid prices are found before they are
+// recorded in a report.
+func validateCost(cost *schema.Cost) error {
+	if cost == nil {
+		return nil
+	}
+
+	if err := item.CostAmountValidator(cost.Amount); err != nil {
+		return err
+	}
+	if err := item.CostCurrencyValidator(cost.Currency); err != nil {
+		return err
+	}
+
+	return item.CostPeriodValidator(item.CostPeriod(cost.Period))
+}
+
+// itemCost returns the price of the item, nil if it has none.
+func itemCost(i *ent.Item) *schema.Cost {
+	if i.CostAmount == nil || i.CostPeriod == nil {
+		return nil
+	}
+
+	return &schema.Cost{Amount: *i.CostAmount, Currency: i.CostCurrency, Period: string(*i.CostPeriod)}
+}
+
+// setCost writes the reported price, while the cost center of the item is kept, as providers do not know it.
+func setCost(m *ent.ItemMutation, cost *schema.Cost) {
+	m.SetCostAmount(cost.Amount)
+	m.SetCostCurrency(cost.Currency)
+	m.SetCostPeriod(item.CostPeriod(cost.Period))
+}
+
+// refresh writes the volatile attributes of an item that did not drift.
+func refresh(ctx context.Context, client *ent.Client, defs []*ent.AttributeDefinition, i *ent.Item, actual map[string]any, volatile []string, user string) error {
+	values := maps.Clone(i.Attributes)
+	if values == nil {
+		values = make(map[string]any)
+	}
+
+	changed := false
+	for _, key := range volatile {
+		if value, ok := actual[key]; ok && !reflect.DeepEqual(values[key], value) {
+			values[key] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	values, err := attributes.Validate(defs, values)
+	if err != nil {
+		return fmt.Errorf("invalid attributes of %s: %w", i.Name, err)
+	}
+
+	if err := client.Item.UpdateOne(i).SetAttributes(values).SetUpdatedBy(user).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update %s: %w", i.Name, err)
+	}
+
+	return nil
+}
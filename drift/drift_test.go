@@ -0,0 +1,315 @@
+package drift
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"dig-inv/store/storetest"
+	"errors"
+	"testing"
+	"time"
+)
+
+// serverClass creates an asset class of servers keyed by their provider ID, with a manually maintained note.
+func serverClass(t *testing.T, client *ent.Client, name string, policy assetclass.DriftPolicy) *ent.AssetClass {
+	ctx := context.Background()
+	class := client.AssetClass.Create().
+		SetName(name).
+		SetDriftPolicy(policy).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	for _, def := range []struct {
+		key string
+		typ attributedefinition.Type
+	}{
+		{"server_id", attributedefinition.TypeString},
+		{"ip_address", attributedefinition.TypeString},
+		{"cores", attributedefinition.TypeNumber},
+		{"note", attributedefinition.TypeString},
+		{"synced", attributedefinition.TypeDate},
+	} {
+		client.AttributeDefinition.Create().
+			SetKey(def.key).
+			SetName(def.key).
+			SetType(def.typ).
+			SetAssetClass(class).
+			SetCreatedBy("a").
+			SetUpdatedBy("a").
+			ExecX(ctx)
+	}
+
+	return class
+}
+
+func TestReconcile(t *testing.T) {
+	client := storetest.OpenClient(t, "drift")
+	ctx := context.Background()
+	class := serverClass(t, client, "Cloud servers", assetclass.DriftPolicyAutoApply)
+
+	web := client.Item.Create().
+		SetName("web-1").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "1", "ip_address": "10.0.0.1", "cores": 2, "note": "do not reboot"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	db := client.Item.Create().
+		SetName("db-1").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "2", "ip_address": "10.0.0.2", "cores": 4}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	old := client.Item.Create().
+		SetName("old-1").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "3"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.Item.Create().
+		SetName("flaky-1").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "5"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	sync := Sync{
+		Source:       "server_sync",
+		Class:        class,
+		KeyAttribute: "server_id",
+		Observed: []Observed{
+			{Key: "1", Name: "web-1", Attributes: map[string]any{"server_id": "1", "ip_address": "10.0.0.10", "cores": "2", "synced": "2026-05-01"}},
+			{Key: "2", Name: "db-1", Attributes: map[string]any{"server_id": "2", "ip_address": "10.0.0.2", "cores": 4, "synced": "2026-05-01"}},
+			{Key: "4", Name: "cache-1", Attributes: map[string]any{"server_id": "4", "cores": 1}},
+		},
+		Complete: true,
+		Unknown:  []string{"5"},
+		Volatile: []string{"synced"},
+	}
+
+	report, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if report.Status != driftreport.StatusApplied || report.ReviewedBy != "worker" || report.ReviewedAt == nil {
+		t.Errorf("Expected report to be applied right away, got %+v", report)
+	}
+
+	kinds := make(map[string]string)
+	for _, change := range report.Changes {
+		kinds[change.Name] = change.Kind
+	}
+	if len(kinds) != 3 || kinds["web-1"] != KindChanged || kinds["cache-1"] != KindNew || kinds["old-1"] != KindVanished {
+		t.Errorf("Unexpected changes: %v", kinds)
+	}
+
+	for _, change := range report.Changes {
+		if change.Kind == KindChanged && (len(change.Diffs) != 1 || change.Diffs[0].Attribute != "ip_address" ||
+			change.Diffs[0].Stored != "10.0.0.1" || change.Diffs[0].Actual != "10.0.0.10") {
+			t.Errorf("Unexpected diffs: %+v", change.Diffs)
+		}
+	}
+
+	// attributes the provider does not report are kept
+	web = client.Item.GetX(ctx, web.ID)
+	if web.Attributes["ip_address"] != "10.0.0.10" || web.Attributes["note"] != "do not reboot" || web.Attributes["synced"] != "2026-05-01" {
+		t.Errorf("Unexpected attributes of changed item: %v", web.Attributes)
+	}
+
+	// items that did not drift only get their volatile attributes
+	if db = client.Item.GetX(ctx, db.ID); db.Attributes["synced"] != "2026-05-01" {
+		t.Errorf("Expected volatile attribute to be refreshed, got %v", db.Attributes)
+	}
+
+	if client.Item.GetX(ctx, old.ID).DeletedAt == nil {
+		t.Errorf("Expected vanished item to be deleted")
+	}
+
+	if !client.Item.Query().Where(item.Name("cache-1"), item.DeletedAtIsNil()).ExistX(ctx) {
+		t.Errorf("Expected new item to be created")
+	}
+
+	if client.Item.Query().Where(item.Name("flaky-1"), item.DeletedAtIsNil()).CountX(ctx) != 1 {
+		t.Errorf("Expected unknown item to be kept")
+	}
+
+	// nothing is recorded once the items match the provider
+	sync.Observed[0].Attributes["synced"] = "2026-05-02"
+	report, err = Reconcile(ctx, client, sync, "worker")
+	if err != nil || report != nil {
+		t.Errorf("Expected no drift, got %+v, %v", report, err)
+	}
+
+	if web = client.Item.GetX(ctx, web.ID); web.Attributes["synced"] != "2026-05-02" {
+		t.Errorf("Expected volatile attribute to be refreshed, got %v", web.Attributes)
+	}
+
+	// partial syncs do not remove anything
+	sync.Complete = false
+	sync.Observed = sync.Observed[:1]
+	if report, err = Reconcile(ctx, client, sync, "worker"); err != nil || report != nil {
+		t.Errorf("Expected no drift for partial sync, got %+v, %v", report, err)
+	}
+
+	// observed attributes must be defined
+	sync.Observed = []Observed{{Key: "9", Name: "x", Attributes: map[string]any{"unknown": 1}}}
+	if _, err = Reconcile(ctx, client, sync, "worker"); err == nil {
+		t.Errorf("Expected error for unknown attribute")
+	}
+}
+
+func TestReconcile_Approve(t *testing.T) {
+	client := storetest.OpenClient(t, "drift")
+	ctx := context.Background()
+	class := serverClass(t, client, "Approved servers", assetclass.DriftPolicyApprove)
+
+	web := client.Item.Create().
+		SetName("web-2").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "1", "ip_address": "10.0.0.1"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	sync := Sync{
+		Source:       "server_sync",
+		Class:        class,
+		KeyAttribute: "server_id",
+		Observed:     []Observed{{ItemID: web.ID, Name: "web-2", Attributes: map[string]any{"ip_address": "10.0.0.10"}}},
+	}
+
+	first, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if first.Status != driftreport.StatusPending || client.Item.GetX(ctx, web.ID).Attributes["ip_address"] != "10.0.0.1" {
+		t.Errorf("Expected changes to wait for approval, got %+v", first)
+	}
+
+	// the next sync supersedes the pending report
+	sync.Observed[0].Attributes["ip_address"] = "10.0.0.20"
+	second, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if status := client.DriftReport.GetX(ctx, first.ID).Status; status != driftreport.StatusSuperseded {
+		t.Errorf("Expected first report to be superseded, got %s", status)
+	}
+
+	if _, err := Apply(ctx, client, first.ID, "alice"); !errors.Is(err, ErrNotPending) {
+		t.Errorf("Expected superseded report not to be applied, got %v", err)
+	}
+
+	applied, err := Apply(ctx, client, second.ID, "alice")
+	if err != nil {
+		t.Fatalf("Failed to apply report: %v", err)
+	}
+
+	if applied.Status != driftreport.StatusApplied || applied.ReviewedBy != "alice" ||
+		client.Item.GetX(ctx, web.ID).Attributes["ip_address"] != "10.0.0.20" {
+		t.Errorf("Unexpected applied report: %+v", applied)
+	}
+
+	// rejected reports leave the items alone
+	sync.Observed[0].Attributes["ip_address"] = "10.0.0.30"
+	third, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	rejected, err := Reject(ctx, client, third.ID, "bob")
+	if err != nil || rejected.Status != driftreport.StatusRejected || rejected.ReviewedBy != "bob" {
+		t.Errorf("Unexpected rejected report: %+v, %v", rejected, err)
+	}
+
+	if ip := client.Item.GetX(ctx, web.ID).Attributes["ip_address"]; ip != "10.0.0.20" {
+		t.Errorf("Expected rejected change not to be applied, got %v", ip)
+	}
+
+	if _, err := Reject(ctx, client, third.ID, "bob"); !errors.Is(err, ErrNotPending) {
+		t.Errorf("Expected rejected report not to be rejected again, got %v", err)
+	}
+
+	// changes of items that were deleted in the meantime are skipped
+	sync.Observed[0].Attributes["ip_address"] = "10.0.0.40"
+	fourth, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+	client.Item.UpdateOneID(web.ID).SetDeletedAt(time.Now()).SetUpdatedBy("a").ExecX(ctx)
+
+	if _, err := Apply(ctx, client, fourth.ID, "alice"); err != nil {
+		t.Errorf("Failed to apply report of deleted item: %v", err)
+	}
+}
+
+func TestReconcile_Cost(t *testing.T) {
+	client := storetest.OpenClient(t, "drift")
+	ctx := context.Background()
+	class := serverClass(t, client, "Priced servers", assetclass.DriftPolicyAutoApply)
+
+	web := client.Item.Create().
+		SetName("web-3").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"server_id": "1"}).
+		SetCostAmount(4.5).
+		SetCostCurrency("EUR").
+		SetCostPeriod(item.CostPeriodMonthly).
+		SetCostCenter("platform").
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	price := &schema.Cost{Amount: 5.39, Currency: "EUR", Period: "monthly"}
+	sync := Sync{
+		Source:       "server_sync",
+		Class:        class,
+		KeyAttribute: "server_id",
+		Observed: []Observed{
+			{ItemID: web.ID, Name: "web-3", Attributes: map[string]any{"server_id": "1"}, Cost: price},
+			{Key: "2", Name: "db-1", Attributes: map[string]any{"server_id": "2"}, Cost: price},
+		},
+	}
+
+	report, err := Reconcile(ctx, client, sync, "worker")
+	if err != nil {
+		t.Fatalf("Failed to reconcile: %v", err)
+	}
+
+	if len(report.Changes) != 2 || report.Changes[0].Cost == nil || report.Changes[0].StoredCost == nil ||
+		report.Changes[0].StoredCost.Amount != 4.5 || len(report.Changes[0].Diffs) != 0 {
+		t.Fatalf("Expected the price change to be recorded, got %+v", report.Changes)
+	}
+
+	// the cost center is not known to the provider and kept
+	updated := client.Item.GetX(ctx, web.ID)
+	if *updated.CostAmount != 5.39 || updated.CostCenter != "platform" {
+		t.Errorf("Unexpected cost of updated item: %v %v", *updated.CostAmount, updated.CostCenter)
+	}
+
+	created := client.Item.Query().Where(item.Name("db-1")).OnlyX(ctx)
+	if created.CostAmount == nil || *created.CostAmount != 5.39 || *created.CostPeriod != item.CostPeriodMonthly {
+		t.Errorf("Expected new item to get the reported price, got %+v", created)
+	}
+
+	// an unchanged price is no drift
+	if report, err := Reconcile(ctx, client, sync, "worker"); err != nil || report != nil {
+		t.Errorf("Expected no drift, got %+v, %v", report, err)
+	}
+
+	sync.Observed[0].Cost = &schema.Cost{Amount: 5, Currency: "euro", Period: "monthly"}
+	if _, err := Reconcile(ctx, client, sync, "worker"); err == nil {
+		t.Errorf("Expected invalid currency to be rejected")
+	}
+}
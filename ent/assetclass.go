@@ -31,6 +31,8 @@ type AssetClass struct {
 	Color string `json:"color,omitempty"`
 	// The provider of the asset class, which is used to identify the provider of the asset class. This is used to determine which provider's table to use for storing additional information about the asset class.
 	Provider string `json:"provider,omitempty"`
+	// How changes found by provider syncs are handled: applied to the items right away, or recorded in a drift report that has to be approved first.
+	DriftPolicy assetclass.DriftPolicy `json:"drift_policy,omitempty"`
 	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
 	CreatedBy string `json:"created_by,omitempty"`
 	// When the resource was created in the inventory system.
@@ -42,9 +44,30 @@ type AssetClass struct {
 	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
 	DeletedBy string `json:"deleted_by,omitempty"`
 	// The time when the resource was deleted from the inventory system.
-	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
-	item_asset_class *uuid.UUID
-	selectValues     sql.SelectValues
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AssetClassQuery when eager-loading is set.
+	Edges                 AssetClassEdges `json:"edges"`
+	webhook_asset_classes *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// AssetClassEdges holds the relations/edges for other nodes in the graph.
+type AssetClassEdges struct {
+	// The custom attributes that are defined for items of this asset class.
+	Attributes []*AttributeDefinition `json:"attributes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AttributesOrErr returns the Attributes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AssetClassEdges) AttributesOrErr() ([]*AttributeDefinition, error) {
+	if e.loadedTypes[0] {
+		return e.Attributes, nil
+	}
+	return nil, &NotLoadedError{edge: "attributes"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -54,13 +77,13 @@ func (*AssetClass) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case assetclass.FieldOrder:
 			values[i] = new(sql.NullInt64)
-		case assetclass.FieldName, assetclass.FieldDescription, assetclass.FieldIcon, assetclass.FieldColor, assetclass.FieldProvider, assetclass.FieldCreatedBy, assetclass.FieldUpdatedBy, assetclass.FieldDeletedBy:
+		case assetclass.FieldName, assetclass.FieldDescription, assetclass.FieldIcon, assetclass.FieldColor, assetclass.FieldProvider, assetclass.FieldDriftPolicy, assetclass.FieldCreatedBy, assetclass.FieldUpdatedBy, assetclass.FieldDeletedBy:
 			values[i] = new(sql.NullString)
 		case assetclass.FieldCreatedAt, assetclass.FieldUpdatedAt, assetclass.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case assetclass.FieldID:
 			values[i] = new(uuid.UUID)
-		case assetclass.ForeignKeys[0]: // item_asset_class
+		case assetclass.ForeignKeys[0]: // webhook_asset_classes
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -119,6 +142,12 @@ func (ac *AssetClass) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ac.Provider = value.String
 			}
+		case assetclass.FieldDriftPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field drift_policy", values[i])
+			} else if value.Valid {
+				ac.DriftPolicy = assetclass.DriftPolicy(value.String)
+			}
 		case assetclass.FieldCreatedBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -158,10 +187,10 @@ func (ac *AssetClass) assignValues(columns []string, values []any) error {
 			}
 		case assetclass.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field item_asset_class", values[i])
+				return fmt.Errorf("unexpected type %T for field webhook_asset_classes", values[i])
 			} else if value.Valid {
-				ac.item_asset_class = new(uuid.UUID)
-				*ac.item_asset_class = *value.S.(*uuid.UUID)
+				ac.webhook_asset_classes = new(uuid.UUID)
+				*ac.webhook_asset_classes = *value.S.(*uuid.UUID)
 			}
 		default:
 			ac.selectValues.Set(columns[i], values[i])
@@ -176,6 +205,11 @@ func (ac *AssetClass) Value(name string) (ent.Value, error) {
 	return ac.selectValues.Get(name)
 }
 
+// QueryAttributes queries the "attributes" edge of the AssetClass entity.
+func (ac *AssetClass) QueryAttributes() *AttributeDefinitionQuery {
+	return NewAssetClassClient(ac.config).QueryAttributes(ac)
+}
+
 // Update returns a builder for updating this AssetClass.
 // Note that you need to call AssetClass.Unwrap() before calling this method if this AssetClass
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -217,6 +251,9 @@ func (ac *AssetClass) String() string {
 	builder.WriteString("provider=")
 	builder.WriteString(ac.Provider)
 	builder.WriteString(", ")
+	builder.WriteString("drift_policy=")
+	builder.WriteString(fmt.Sprintf("%v", ac.DriftPolicy))
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(ac.CreatedBy)
 	builder.WriteString(", ")
@@ -3,9 +3,11 @@
 package assetclass
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -26,6 +28,8 @@ const (
 	FieldColor = "color"
 	// FieldProvider holds the string denoting the provider field in the database.
 	FieldProvider = "provider"
+	// FieldDriftPolicy holds the string denoting the drift_policy field in the database.
+	FieldDriftPolicy = "drift_policy"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -38,8 +42,17 @@ const (
 	FieldDeletedBy = "deleted_by"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
+	// EdgeAttributes holds the string denoting the attributes edge name in mutations.
+	EdgeAttributes = "attributes"
 	// Table holds the table name of the assetclass in the database.
 	Table = "asset_classes"
+	// AttributesTable is the table that holds the attributes relation/edge.
+	AttributesTable = "attribute_definitions"
+	// AttributesInverseTable is the table name for the AttributeDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "attributedefinition" package.
+	AttributesInverseTable = "attribute_definitions"
+	// AttributesColumn is the table column denoting the attributes relation/edge.
+	AttributesColumn = "asset_class_attributes"
 )
 
 // Columns holds all SQL columns for assetclass fields.
@@ -51,6 +64,7 @@ var Columns = []string{
 	FieldIcon,
 	FieldColor,
 	FieldProvider,
+	FieldDriftPolicy,
 	FieldCreatedBy,
 	FieldCreatedAt,
 	FieldUpdatedBy,
@@ -62,7 +76,7 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "asset_classes"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
-	"item_asset_class",
+	"webhook_asset_classes",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -95,6 +109,32 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// DriftPolicy defines the type for the "drift_policy" enum field.
+type DriftPolicy string
+
+// DriftPolicyAutoApply is the default value of the DriftPolicy enum.
+const DefaultDriftPolicy = DriftPolicyAutoApply
+
+// DriftPolicy values.
+const (
+	DriftPolicyAutoApply DriftPolicy = "auto_apply"
+	DriftPolicyApprove   DriftPolicy = "approve"
+)
+
+func (dp DriftPolicy) String() string {
+	return string(dp)
+}
+
+// DriftPolicyValidator is a validator for the "drift_policy" field enum values. It is called by the builders before save.
+func DriftPolicyValidator(dp DriftPolicy) error {
+	switch dp {
+	case DriftPolicyAutoApply, DriftPolicyApprove:
+		return nil
+	default:
+		return fmt.Errorf("assetclass: invalid enum value for drift_policy field: %q", dp)
+	}
+}
+
 // OrderOption defines the ordering options for the AssetClass queries.
 type OrderOption func(*sql.Selector)
 
@@ -133,6 +173,11 @@ func ByProvider(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProvider, opts...).ToFunc()
 }
 
+// ByDriftPolicy orders the results by the drift_policy field.
+func ByDriftPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDriftPolicy, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -162,3 +207,24 @@ func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
 func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
 }
+
+// ByAttributesCount orders the results by attributes count.
+func ByAttributesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttributesStep(), opts...)
+	}
+}
+
+// ByAttributes orders the results by attributes terms.
+func ByAttributes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttributesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAttributesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttributesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
 )
 
@@ -520,6 +521,26 @@ func ProviderContainsFold(v string) predicate.AssetClass {
 	return predicate.AssetClass(sql.FieldContainsFold(FieldProvider, v))
 }
 
+// DriftPolicyEQ applies the EQ predicate on the "drift_policy" field.
+func DriftPolicyEQ(v DriftPolicy) predicate.AssetClass {
+	return predicate.AssetClass(sql.FieldEQ(FieldDriftPolicy, v))
+}
+
+// DriftPolicyNEQ applies the NEQ predicate on the "drift_policy" field.
+func DriftPolicyNEQ(v DriftPolicy) predicate.AssetClass {
+	return predicate.AssetClass(sql.FieldNEQ(FieldDriftPolicy, v))
+}
+
+// DriftPolicyIn applies the In predicate on the "drift_policy" field.
+func DriftPolicyIn(vs ...DriftPolicy) predicate.AssetClass {
+	return predicate.AssetClass(sql.FieldIn(FieldDriftPolicy, vs...))
+}
+
+// DriftPolicyNotIn applies the NotIn predicate on the "drift_policy" field.
+func DriftPolicyNotIn(vs ...DriftPolicy) predicate.AssetClass {
+	return predicate.AssetClass(sql.FieldNotIn(FieldDriftPolicy, vs...))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v string) predicate.AssetClass {
 	return predicate.AssetClass(sql.FieldEQ(FieldCreatedBy, v))
@@ -855,6 +876,29 @@ func DeletedAtNotNil() predicate.AssetClass {
 	return predicate.AssetClass(sql.FieldNotNull(FieldDeletedAt))
 }
 
+// HasAttributes applies the HasEdge predicate on the "attributes" edge.
+func HasAttributes() predicate.AssetClass {
+	return predicate.AssetClass(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttributesTable, AttributesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttributesWith applies the HasEdge predicate on the "attributes" edge with a given conditions (other predicates).
+func HasAttributesWith(preds ...predicate.AttributeDefinition) predicate.AssetClass {
+	return predicate.AssetClass(func(s *sql.Selector) {
+		step := newAttributesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.AssetClass) predicate.AssetClass {
 	return predicate.AssetClass(sql.AndPredicates(predicates...))
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
 	"errors"
 	"fmt"
 	"time"
@@ -97,6 +98,20 @@ func (acc *AssetClassCreate) SetNillableProvider(s *string) *AssetClassCreate {
 	return acc
 }
 
+// SetDriftPolicy sets the "drift_policy" field.
+func (acc *AssetClassCreate) SetDriftPolicy(ap assetclass.DriftPolicy) *AssetClassCreate {
+	acc.mutation.SetDriftPolicy(ap)
+	return acc
+}
+
+// SetNillableDriftPolicy sets the "drift_policy" field if the given value is not nil.
+func (acc *AssetClassCreate) SetNillableDriftPolicy(ap *assetclass.DriftPolicy) *AssetClassCreate {
+	if ap != nil {
+		acc.SetDriftPolicy(*ap)
+	}
+	return acc
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (acc *AssetClassCreate) SetCreatedBy(s string) *AssetClassCreate {
 	acc.mutation.SetCreatedBy(s)
@@ -179,6 +194,21 @@ func (acc *AssetClassCreate) SetNillableID(u *uuid.UUID) *AssetClassCreate {
 	return acc
 }
 
+// AddAttributeIDs adds the "attributes" edge to the AttributeDefinition entity by IDs.
+func (acc *AssetClassCreate) AddAttributeIDs(ids ...uuid.UUID) *AssetClassCreate {
+	acc.mutation.AddAttributeIDs(ids...)
+	return acc
+}
+
+// AddAttributes adds the "attributes" edges to the AttributeDefinition entity.
+func (acc *AssetClassCreate) AddAttributes(a ...*AttributeDefinition) *AssetClassCreate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acc.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the AssetClassMutation object of the builder.
 func (acc *AssetClassCreate) Mutation() *AssetClassMutation {
 	return acc.mutation
@@ -218,6 +248,10 @@ func (acc *AssetClassCreate) defaults() {
 		v := assetclass.DefaultOrder
 		acc.mutation.SetOrder(v)
 	}
+	if _, ok := acc.mutation.DriftPolicy(); !ok {
+		v := assetclass.DefaultDriftPolicy
+		acc.mutation.SetDriftPolicy(v)
+	}
 	if _, ok := acc.mutation.CreatedAt(); !ok {
 		v := assetclass.DefaultCreatedAt()
 		acc.mutation.SetCreatedAt(v)
@@ -245,6 +279,14 @@ func (acc *AssetClassCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AssetClass.name": %w`, err)}
 		}
 	}
+	if _, ok := acc.mutation.DriftPolicy(); !ok {
+		return &ValidationError{Name: "drift_policy", err: errors.New(`ent: missing required field "AssetClass.drift_policy"`)}
+	}
+	if v, ok := acc.mutation.DriftPolicy(); ok {
+		if err := assetclass.DriftPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "drift_policy", err: fmt.Errorf(`ent: validator failed for field "AssetClass.drift_policy": %w`, err)}
+		}
+	}
 	if _, ok := acc.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AssetClass.created_by"`)}
 	}
@@ -316,6 +358,10 @@ func (acc *AssetClassCreate) createSpec() (*AssetClass, *sqlgraph.CreateSpec) {
 		_spec.SetField(assetclass.FieldProvider, field.TypeString, value)
 		_node.Provider = value
 	}
+	if value, ok := acc.mutation.DriftPolicy(); ok {
+		_spec.SetField(assetclass.FieldDriftPolicy, field.TypeEnum, value)
+		_node.DriftPolicy = value
+	}
 	if value, ok := acc.mutation.CreatedBy(); ok {
 		_spec.SetField(assetclass.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
@@ -340,6 +386,22 @@ func (acc *AssetClassCreate) createSpec() (*AssetClass, *sqlgraph.CreateSpec) {
 		_spec.SetField(assetclass.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
 	}
+	if nodes := acc.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
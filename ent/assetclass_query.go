@@ -4,7 +4,9 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
 	"dig-inv/ent/predicate"
 	"fmt"
 	"math"
@@ -19,11 +21,12 @@ import (
 // AssetClassQuery is the builder for querying AssetClass entities.
 type AssetClassQuery struct {
 	config
-	ctx        *QueryContext
-	order      []assetclass.OrderOption
-	inters     []Interceptor
-	predicates []predicate.AssetClass
-	withFKs    bool
+	ctx            *QueryContext
+	order          []assetclass.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.AssetClass
+	withAttributes *AttributeDefinitionQuery
+	withFKs        bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -60,6 +63,28 @@ func (acq *AssetClassQuery) Order(o ...assetclass.OrderOption) *AssetClassQuery
 	return acq
 }
 
+// QueryAttributes chains the current query on the "attributes" edge.
+func (acq *AssetClassQuery) QueryAttributes() *AttributeDefinitionQuery {
+	query := (&AttributeDefinitionClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(assetclass.Table, assetclass.FieldID, selector),
+			sqlgraph.To(attributedefinition.Table, attributedefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, assetclass.AttributesTable, assetclass.AttributesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first AssetClass entity from the query.
 // Returns a *NotFoundError when no AssetClass was found.
 func (acq *AssetClassQuery) First(ctx context.Context) (*AssetClass, error) {
@@ -247,17 +272,29 @@ func (acq *AssetClassQuery) Clone() *AssetClassQuery {
 		return nil
 	}
 	return &AssetClassQuery{
-		config:     acq.config,
-		ctx:        acq.ctx.Clone(),
-		order:      append([]assetclass.OrderOption{}, acq.order...),
-		inters:     append([]Interceptor{}, acq.inters...),
-		predicates: append([]predicate.AssetClass{}, acq.predicates...),
+		config:         acq.config,
+		ctx:            acq.ctx.Clone(),
+		order:          append([]assetclass.OrderOption{}, acq.order...),
+		inters:         append([]Interceptor{}, acq.inters...),
+		predicates:     append([]predicate.AssetClass{}, acq.predicates...),
+		withAttributes: acq.withAttributes.Clone(),
 		// clone intermediate query.
 		sql:  acq.sql.Clone(),
 		path: acq.path,
 	}
 }
 
+// WithAttributes tells the query-builder to eager-load the nodes that are connected to
+// the "attributes" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AssetClassQuery) WithAttributes(opts ...func(*AttributeDefinitionQuery)) *AssetClassQuery {
+	query := (&AttributeDefinitionClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withAttributes = query
+	return acq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -334,9 +371,12 @@ func (acq *AssetClassQuery) prepareQuery(ctx context.Context) error {
 
 func (acq *AssetClassQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AssetClass, error) {
 	var (
-		nodes   = []*AssetClass{}
-		withFKs = acq.withFKs
-		_spec   = acq.querySpec()
+		nodes       = []*AssetClass{}
+		withFKs     = acq.withFKs
+		_spec       = acq.querySpec()
+		loadedTypes = [1]bool{
+			acq.withAttributes != nil,
+		}
 	)
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, assetclass.ForeignKeys...)
@@ -347,6 +387,7 @@ func (acq *AssetClassQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &AssetClass{config: acq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -358,9 +399,48 @@ func (acq *AssetClassQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := acq.withAttributes; query != nil {
+		if err := acq.loadAttributes(ctx, query, nodes,
+			func(n *AssetClass) { n.Edges.Attributes = []*AttributeDefinition{} },
+			func(n *AssetClass, e *AttributeDefinition) { n.Edges.Attributes = append(n.Edges.Attributes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (acq *AssetClassQuery) loadAttributes(ctx context.Context, query *AttributeDefinitionQuery, nodes []*AssetClass, init func(*AssetClass), assign func(*AssetClass, *AttributeDefinition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*AssetClass)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AttributeDefinition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(assetclass.AttributesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.asset_class_attributes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "asset_class_attributes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "asset_class_attributes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (acq *AssetClassQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := acq.querySpec()
 	_spec.Node.Columns = acq.ctx.Fields
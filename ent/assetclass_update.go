@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
 	"dig-inv/ent/predicate"
 	"errors"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // AssetClassUpdate is the builder for updating AssetClass entities.
@@ -143,6 +145,20 @@ func (acu *AssetClassUpdate) ClearProvider() *AssetClassUpdate {
 	return acu
 }
 
+// SetDriftPolicy sets the "drift_policy" field.
+func (acu *AssetClassUpdate) SetDriftPolicy(ap assetclass.DriftPolicy) *AssetClassUpdate {
+	acu.mutation.SetDriftPolicy(ap)
+	return acu
+}
+
+// SetNillableDriftPolicy sets the "drift_policy" field if the given value is not nil.
+func (acu *AssetClassUpdate) SetNillableDriftPolicy(ap *assetclass.DriftPolicy) *AssetClassUpdate {
+	if ap != nil {
+		acu.SetDriftPolicy(*ap)
+	}
+	return acu
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (acu *AssetClassUpdate) SetCreatedBy(s string) *AssetClassUpdate {
 	acu.mutation.SetCreatedBy(s)
@@ -217,11 +233,47 @@ func (acu *AssetClassUpdate) ClearDeletedAt() *AssetClassUpdate {
 	return acu
 }
 
+// AddAttributeIDs adds the "attributes" edge to the AttributeDefinition entity by IDs.
+func (acu *AssetClassUpdate) AddAttributeIDs(ids ...uuid.UUID) *AssetClassUpdate {
+	acu.mutation.AddAttributeIDs(ids...)
+	return acu
+}
+
+// AddAttributes adds the "attributes" edges to the AttributeDefinition entity.
+func (acu *AssetClassUpdate) AddAttributes(a ...*AttributeDefinition) *AssetClassUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acu.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the AssetClassMutation object of the builder.
 func (acu *AssetClassUpdate) Mutation() *AssetClassMutation {
 	return acu.mutation
 }
 
+// ClearAttributes clears all "attributes" edges to the AttributeDefinition entity.
+func (acu *AssetClassUpdate) ClearAttributes() *AssetClassUpdate {
+	acu.mutation.ClearAttributes()
+	return acu
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to AttributeDefinition entities by IDs.
+func (acu *AssetClassUpdate) RemoveAttributeIDs(ids ...uuid.UUID) *AssetClassUpdate {
+	acu.mutation.RemoveAttributeIDs(ids...)
+	return acu
+}
+
+// RemoveAttributes removes "attributes" edges to AttributeDefinition entities.
+func (acu *AssetClassUpdate) RemoveAttributes(a ...*AttributeDefinition) *AssetClassUpdate {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acu.RemoveAttributeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (acu *AssetClassUpdate) Save(ctx context.Context) (int, error) {
 	acu.defaults()
@@ -265,6 +317,11 @@ func (acu *AssetClassUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AssetClass.name": %w`, err)}
 		}
 	}
+	if v, ok := acu.mutation.DriftPolicy(); ok {
+		if err := assetclass.DriftPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "drift_policy", err: fmt.Errorf(`ent: validator failed for field "AssetClass.drift_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -313,6 +370,9 @@ func (acu *AssetClassUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if acu.mutation.ProviderCleared() {
 		_spec.ClearField(assetclass.FieldProvider, field.TypeString)
 	}
+	if value, ok := acu.mutation.DriftPolicy(); ok {
+		_spec.SetField(assetclass.FieldDriftPolicy, field.TypeEnum, value)
+	}
 	if value, ok := acu.mutation.CreatedBy(); ok {
 		_spec.SetField(assetclass.FieldCreatedBy, field.TypeString, value)
 	}
@@ -334,6 +394,51 @@ func (acu *AssetClassUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if acu.mutation.DeletedAtCleared() {
 		_spec.ClearField(assetclass.FieldDeletedAt, field.TypeTime)
 	}
+	if acu.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !acu.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{assetclass.Label}
@@ -469,6 +574,20 @@ func (acuo *AssetClassUpdateOne) ClearProvider() *AssetClassUpdateOne {
 	return acuo
 }
 
+// SetDriftPolicy sets the "drift_policy" field.
+func (acuo *AssetClassUpdateOne) SetDriftPolicy(ap assetclass.DriftPolicy) *AssetClassUpdateOne {
+	acuo.mutation.SetDriftPolicy(ap)
+	return acuo
+}
+
+// SetNillableDriftPolicy sets the "drift_policy" field if the given value is not nil.
+func (acuo *AssetClassUpdateOne) SetNillableDriftPolicy(ap *assetclass.DriftPolicy) *AssetClassUpdateOne {
+	if ap != nil {
+		acuo.SetDriftPolicy(*ap)
+	}
+	return acuo
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (acuo *AssetClassUpdateOne) SetCreatedBy(s string) *AssetClassUpdateOne {
 	acuo.mutation.SetCreatedBy(s)
@@ -543,11 +662,47 @@ func (acuo *AssetClassUpdateOne) ClearDeletedAt() *AssetClassUpdateOne {
 	return acuo
 }
 
+// AddAttributeIDs adds the "attributes" edge to the AttributeDefinition entity by IDs.
+func (acuo *AssetClassUpdateOne) AddAttributeIDs(ids ...uuid.UUID) *AssetClassUpdateOne {
+	acuo.mutation.AddAttributeIDs(ids...)
+	return acuo
+}
+
+// AddAttributes adds the "attributes" edges to the AttributeDefinition entity.
+func (acuo *AssetClassUpdateOne) AddAttributes(a ...*AttributeDefinition) *AssetClassUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acuo.AddAttributeIDs(ids...)
+}
+
 // Mutation returns the AssetClassMutation object of the builder.
 func (acuo *AssetClassUpdateOne) Mutation() *AssetClassMutation {
 	return acuo.mutation
 }
 
+// ClearAttributes clears all "attributes" edges to the AttributeDefinition entity.
+func (acuo *AssetClassUpdateOne) ClearAttributes() *AssetClassUpdateOne {
+	acuo.mutation.ClearAttributes()
+	return acuo
+}
+
+// RemoveAttributeIDs removes the "attributes" edge to AttributeDefinition entities by IDs.
+func (acuo *AssetClassUpdateOne) RemoveAttributeIDs(ids ...uuid.UUID) *AssetClassUpdateOne {
+	acuo.mutation.RemoveAttributeIDs(ids...)
+	return acuo
+}
+
+// RemoveAttributes removes "attributes" edges to AttributeDefinition entities.
+func (acuo *AssetClassUpdateOne) RemoveAttributes(a ...*AttributeDefinition) *AssetClassUpdateOne {
+	ids := make([]uuid.UUID, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return acuo.RemoveAttributeIDs(ids...)
+}
+
 // Where appends a list predicates to the AssetClassUpdate builder.
 func (acuo *AssetClassUpdateOne) Where(ps ...predicate.AssetClass) *AssetClassUpdateOne {
 	acuo.mutation.Where(ps...)
@@ -604,6 +759,11 @@ func (acuo *AssetClassUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AssetClass.name": %w`, err)}
 		}
 	}
+	if v, ok := acuo.mutation.DriftPolicy(); ok {
+		if err := assetclass.DriftPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "drift_policy", err: fmt.Errorf(`ent: validator failed for field "AssetClass.drift_policy": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -669,6 +829,9 @@ func (acuo *AssetClassUpdateOne) sqlSave(ctx context.Context) (_node *AssetClass
 	if acuo.mutation.ProviderCleared() {
 		_spec.ClearField(assetclass.FieldProvider, field.TypeString)
 	}
+	if value, ok := acuo.mutation.DriftPolicy(); ok {
+		_spec.SetField(assetclass.FieldDriftPolicy, field.TypeEnum, value)
+	}
 	if value, ok := acuo.mutation.CreatedBy(); ok {
 		_spec.SetField(assetclass.FieldCreatedBy, field.TypeString, value)
 	}
@@ -690,6 +853,51 @@ func (acuo *AssetClassUpdateOne) sqlSave(ctx context.Context) (_node *AssetClass
 	if acuo.mutation.DeletedAtCleared() {
 		_spec.ClearField(assetclass.FieldDeletedAt, field.TypeTime)
 	}
+	if acuo.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.RemovedAttributesIDs(); len(nodes) > 0 && !acuo.mutation.AttributesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.AttributesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   assetclass.AttributesTable,
+			Columns: []string{assetclass.AttributesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &AssetClass{config: acuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,502 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AttachmentUpdate is the builder for updating Attachment entities.
+type AttachmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (au *AttachmentUpdate) Where(ps ...predicate.Attachment) *AttachmentUpdate {
+	au.mutation.Where(ps...)
+	return au
+}
+
+// SetFilename sets the "filename" field.
+func (au *AttachmentUpdate) SetFilename(s string) *AttachmentUpdate {
+	au.mutation.SetFilename(s)
+	return au
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableFilename(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetFilename(*s)
+	}
+	return au
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (au *AttachmentUpdate) SetPurgedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetPurgedAt(t)
+	return au
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillablePurgedAt(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetPurgedAt(*t)
+	}
+	return au
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (au *AttachmentUpdate) ClearPurgedAt() *AttachmentUpdate {
+	au.mutation.ClearPurgedAt()
+	return au
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (au *AttachmentUpdate) SetCreatedBy(s string) *AttachmentUpdate {
+	au.mutation.SetCreatedBy(s)
+	return au
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableCreatedBy(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetCreatedBy(*s)
+	}
+	return au
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (au *AttachmentUpdate) SetUpdatedBy(s string) *AttachmentUpdate {
+	au.mutation.SetUpdatedBy(s)
+	return au
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableUpdatedBy(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetUpdatedBy(*s)
+	}
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AttachmentUpdate) SetUpdatedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (au *AttachmentUpdate) SetDeletedBy(s string) *AttachmentUpdate {
+	au.mutation.SetDeletedBy(s)
+	return au
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableDeletedBy(s *string) *AttachmentUpdate {
+	if s != nil {
+		au.SetDeletedBy(*s)
+	}
+	return au
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (au *AttachmentUpdate) ClearDeletedBy() *AttachmentUpdate {
+	au.mutation.ClearDeletedBy()
+	return au
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (au *AttachmentUpdate) SetDeletedAt(t time.Time) *AttachmentUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *AttachmentUpdate) SetNillableDeletedAt(t *time.Time) *AttachmentUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *AttachmentUpdate) ClearDeletedAt() *AttachmentUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (au *AttachmentUpdate) Mutation() *AttachmentMutation {
+	return au.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *AttachmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *AttachmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *AttachmentUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *AttachmentUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *AttachmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *AttachmentUpdate) check() error {
+	if v, ok := au.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if au.mutation.ItemCleared() && len(au.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.item"`)
+	}
+	return nil
+}
+
+func (au *AttachmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := au.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := au.mutation.PurgedAt(); ok {
+		_spec.SetField(attachment.FieldPurgedAt, field.TypeTime, value)
+	}
+	if au.mutation.PurgedAtCleared() {
+		_spec.ClearField(attachment.FieldPurgedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.CreatedBy(); ok {
+		_spec.SetField(attachment.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := au.mutation.UpdatedBy(); ok {
+		_spec.SetField(attachment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := au.mutation.DeletedBy(); ok {
+		_spec.SetField(attachment.FieldDeletedBy, field.TypeString, value)
+	}
+	if au.mutation.DeletedByCleared() {
+		_spec.ClearField(attachment.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(attachment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(attachment.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	au.mutation.done = true
+	return n, nil
+}
+
+// AttachmentUpdateOne is the builder for updating a single Attachment entity.
+type AttachmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttachmentMutation
+}
+
+// SetFilename sets the "filename" field.
+func (auo *AttachmentUpdateOne) SetFilename(s string) *AttachmentUpdateOne {
+	auo.mutation.SetFilename(s)
+	return auo
+}
+
+// SetNillableFilename sets the "filename" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableFilename(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetFilename(*s)
+	}
+	return auo
+}
+
+// SetPurgedAt sets the "purged_at" field.
+func (auo *AttachmentUpdateOne) SetPurgedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetPurgedAt(t)
+	return auo
+}
+
+// SetNillablePurgedAt sets the "purged_at" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillablePurgedAt(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetPurgedAt(*t)
+	}
+	return auo
+}
+
+// ClearPurgedAt clears the value of the "purged_at" field.
+func (auo *AttachmentUpdateOne) ClearPurgedAt() *AttachmentUpdateOne {
+	auo.mutation.ClearPurgedAt()
+	return auo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (auo *AttachmentUpdateOne) SetCreatedBy(s string) *AttachmentUpdateOne {
+	auo.mutation.SetCreatedBy(s)
+	return auo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableCreatedBy(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetCreatedBy(*s)
+	}
+	return auo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (auo *AttachmentUpdateOne) SetUpdatedBy(s string) *AttachmentUpdateOne {
+	auo.mutation.SetUpdatedBy(s)
+	return auo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableUpdatedBy(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetUpdatedBy(*s)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AttachmentUpdateOne) SetUpdatedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (auo *AttachmentUpdateOne) SetDeletedBy(s string) *AttachmentUpdateOne {
+	auo.mutation.SetDeletedBy(s)
+	return auo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableDeletedBy(s *string) *AttachmentUpdateOne {
+	if s != nil {
+		auo.SetDeletedBy(*s)
+	}
+	return auo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (auo *AttachmentUpdateOne) ClearDeletedBy() *AttachmentUpdateOne {
+	auo.mutation.ClearDeletedBy()
+	return auo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *AttachmentUpdateOne) SetDeletedAt(t time.Time) *AttachmentUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *AttachmentUpdateOne) SetNillableDeletedAt(t *time.Time) *AttachmentUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *AttachmentUpdateOne) ClearDeletedAt() *AttachmentUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// Mutation returns the AttachmentMutation object of the builder.
+func (auo *AttachmentUpdateOne) Mutation() *AttachmentMutation {
+	return auo.mutation
+}
+
+// Where appends a list predicates to the AttachmentUpdate builder.
+func (auo *AttachmentUpdateOne) Where(ps ...predicate.Attachment) *AttachmentUpdateOne {
+	auo.mutation.Where(ps...)
+	return auo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (auo *AttachmentUpdateOne) Select(field string, fields ...string) *AttachmentUpdateOne {
+	auo.fields = append([]string{field}, fields...)
+	return auo
+}
+
+// Save executes the query and returns the updated Attachment entity.
+func (auo *AttachmentUpdateOne) Save(ctx context.Context) (*Attachment, error) {
+	auo.defaults()
+	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) SaveX(ctx context.Context) *Attachment {
+	node, err := auo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (auo *AttachmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := auo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (auo *AttachmentUpdateOne) ExecX(ctx context.Context) {
+	if err := auo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (auo *AttachmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		v := attachment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (auo *AttachmentUpdateOne) check() error {
+	if v, ok := auo.mutation.Filename(); ok {
+		if err := attachment.FilenameValidator(v); err != nil {
+			return &ValidationError{Name: "filename", err: fmt.Errorf(`ent: validator failed for field "Attachment.filename": %w`, err)}
+		}
+	}
+	if auo.mutation.ItemCleared() && len(auo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Attachment.item"`)
+	}
+	return nil
+}
+
+func (auo *AttachmentUpdateOne) sqlSave(ctx context.Context) (_node *Attachment, err error) {
+	if err := auo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attachment.Table, attachment.Columns, sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID))
+	id, ok := auo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Attachment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := auo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attachment.FieldID)
+		for _, f := range fields {
+			if !attachment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attachment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := auo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := auo.mutation.Filename(); ok {
+		_spec.SetField(attachment.FieldFilename, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.PurgedAt(); ok {
+		_spec.SetField(attachment.FieldPurgedAt, field.TypeTime, value)
+	}
+	if auo.mutation.PurgedAtCleared() {
+		_spec.ClearField(attachment.FieldPurgedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.CreatedBy(); ok {
+		_spec.SetField(attachment.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.UpdatedBy(); ok {
+		_spec.SetField(attachment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attachment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := auo.mutation.DeletedBy(); ok {
+		_spec.SetField(attachment.FieldDeletedBy, field.TypeString, value)
+	}
+	if auo.mutation.DeletedByCleared() {
+		_spec.ClearField(attachment.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(attachment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attachment.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &Attachment{config: auo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, auo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attachment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	auo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// AttributeDefinition is the model entity for the AttributeDefinition schema.
+type AttributeDefinition struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the attribute definition.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The key under which the attribute value is stored on the items of the asset class. Only lowercase letters, digits and underscores are allowed.
+	Key string `json:"key,omitempty"`
+	// The human readable name of the attribute, which is displayed in the user interface.
+	Name string `json:"name,omitempty"`
+	// A description of the attribute, which can be used to provide additional information about the expected value.
+	Description string `json:"description,omitempty"`
+	// The type of the attribute value. Values are validated against this type before they are stored.
+	Type attributedefinition.Type `json:"type,omitempty"`
+	// Whether items of the asset class must provide a value for the attribute.
+	Required bool `json:"required,omitempty"`
+	// The default value of the attribute in its textual representation, which is used if an item does not provide a value.
+	DefaultValue string `json:"default_value,omitempty"`
+	// The allowed values of an attribute with the enum type.
+	EnumValues []string `json:"enum_values,omitempty"`
+	// Whether the attribute holds a secret like a password. Secret values are stored encrypted and masked in all responses. The flag can not be changed after creation, as existing values would have to be moved between plaintext and encrypted storage.
+	Secret bool `json:"secret,omitempty"`
+	// The order of the attribute, which is used to determine the order in which attributes are displayed in the user interface.
+	Order int `json:"order,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AttributeDefinitionQuery when eager-loading is set.
+	Edges                  AttributeDefinitionEdges `json:"edges"`
+	asset_class_attributes *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// AttributeDefinitionEdges holds the relations/edges for other nodes in the graph.
+type AttributeDefinitionEdges struct {
+	// The asset class that defines this attribute.
+	AssetClass *AssetClass `json:"asset_class,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AssetClassOrErr returns the AssetClass value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AttributeDefinitionEdges) AssetClassOrErr() (*AssetClass, error) {
+	if e.AssetClass != nil {
+		return e.AssetClass, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: assetclass.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset_class"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AttributeDefinition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldEnumValues:
+			values[i] = new([]byte)
+		case attributedefinition.FieldRequired, attributedefinition.FieldSecret:
+			values[i] = new(sql.NullBool)
+		case attributedefinition.FieldOrder:
+			values[i] = new(sql.NullInt64)
+		case attributedefinition.FieldKey, attributedefinition.FieldName, attributedefinition.FieldDescription, attributedefinition.FieldType, attributedefinition.FieldDefaultValue, attributedefinition.FieldCreatedBy, attributedefinition.FieldUpdatedBy, attributedefinition.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case attributedefinition.FieldCreatedAt, attributedefinition.FieldUpdatedAt, attributedefinition.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case attributedefinition.FieldID:
+			values[i] = new(uuid.UUID)
+		case attributedefinition.ForeignKeys[0]: // asset_class_attributes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AttributeDefinition fields.
+func (ad *AttributeDefinition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case attributedefinition.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ad.ID = *value
+			}
+		case attributedefinition.FieldKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				ad.Key = value.String
+			}
+		case attributedefinition.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ad.Name = value.String
+			}
+		case attributedefinition.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ad.Description = value.String
+			}
+		case attributedefinition.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ad.Type = attributedefinition.Type(value.String)
+			}
+		case attributedefinition.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				ad.Required = value.Bool
+			}
+		case attributedefinition.FieldDefaultValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_value", values[i])
+			} else if value.Valid {
+				ad.DefaultValue = value.String
+			}
+		case attributedefinition.FieldEnumValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field enum_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ad.EnumValues); err != nil {
+					return fmt.Errorf("unmarshal field enum_values: %w", err)
+				}
+			}
+		case attributedefinition.FieldSecret:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ad.Secret = value.Bool
+			}
+		case attributedefinition.FieldOrder:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order", values[i])
+			} else if value.Valid {
+				ad.Order = int(value.Int64)
+			}
+		case attributedefinition.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ad.CreatedBy = value.String
+			}
+		case attributedefinition.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ad.CreatedAt = value.Time
+			}
+		case attributedefinition.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ad.UpdatedBy = value.String
+			}
+		case attributedefinition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ad.UpdatedAt = value.Time
+			}
+		case attributedefinition.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				ad.DeletedBy = value.String
+			}
+		case attributedefinition.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ad.DeletedAt = new(time.Time)
+				*ad.DeletedAt = value.Time
+			}
+		case attributedefinition.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field asset_class_attributes", values[i])
+			} else if value.Valid {
+				ad.asset_class_attributes = new(uuid.UUID)
+				*ad.asset_class_attributes = *value.S.(*uuid.UUID)
+			}
+		default:
+			ad.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AttributeDefinition.
+// This includes values selected through modifiers, order, etc.
+func (ad *AttributeDefinition) Value(name string) (ent.Value, error) {
+	return ad.selectValues.Get(name)
+}
+
+// QueryAssetClass queries the "asset_class" edge of the AttributeDefinition entity.
+func (ad *AttributeDefinition) QueryAssetClass() *AssetClassQuery {
+	return NewAttributeDefinitionClient(ad.config).QueryAssetClass(ad)
+}
+
+// Update returns a builder for updating this AttributeDefinition.
+// Note that you need to call AttributeDefinition.Unwrap() before calling this method if this AttributeDefinition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ad *AttributeDefinition) Update() *AttributeDefinitionUpdateOne {
+	return NewAttributeDefinitionClient(ad.config).UpdateOne(ad)
+}
+
+// Unwrap unwraps the AttributeDefinition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ad *AttributeDefinition) Unwrap() *AttributeDefinition {
+	_tx, ok := ad.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AttributeDefinition is not a transactional entity")
+	}
+	ad.config.driver = _tx.drv
+	return ad
+}
+
+// String implements the fmt.Stringer.
+func (ad *AttributeDefinition) String() string {
+	var builder strings.Builder
+	builder.WriteString("AttributeDefinition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ad.ID))
+	builder.WriteString("key=")
+	builder.WriteString(ad.Key)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ad.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ad.Description)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Type))
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Required))
+	builder.WriteString(", ")
+	builder.WriteString("default_value=")
+	builder.WriteString(ad.DefaultValue)
+	builder.WriteString(", ")
+	builder.WriteString("enum_values=")
+	builder.WriteString(fmt.Sprintf("%v", ad.EnumValues))
+	builder.WriteString(", ")
+	builder.WriteString("secret=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Secret))
+	builder.WriteString(", ")
+	builder.WriteString("order=")
+	builder.WriteString(fmt.Sprintf("%v", ad.Order))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ad.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ad.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ad.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ad.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(ad.DeletedBy)
+	builder.WriteString(", ")
+	if v := ad.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AttributeDefinitions is a parsable slice of AttributeDefinition.
+type AttributeDefinitions []*AttributeDefinition
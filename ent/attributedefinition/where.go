@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package attributedefinition
+
+import (
+	"dig-inv/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// Required applies equality check predicate on the "required" field. It's identical to RequiredEQ.
+func Required(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// DefaultValue applies equality check predicate on the "default_value" field. It's identical to DefaultValueEQ.
+func DefaultValue(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldSecret, v))
+}
+
+// Order applies equality check predicate on the "order" field. It's identical to OrderEQ.
+func Order(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldOrder, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldType, vs...))
+}
+
+// RequiredEQ applies the EQ predicate on the "required" field.
+func RequiredEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldRequired, v))
+}
+
+// RequiredNEQ applies the NEQ predicate on the "required" field.
+func RequiredNEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldRequired, v))
+}
+
+// DefaultValueEQ applies the EQ predicate on the "default_value" field.
+func DefaultValueEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueNEQ applies the NEQ predicate on the "default_value" field.
+func DefaultValueNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldDefaultValue, v))
+}
+
+// DefaultValueIn applies the In predicate on the "default_value" field.
+func DefaultValueIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueNotIn applies the NotIn predicate on the "default_value" field.
+func DefaultValueNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldDefaultValue, vs...))
+}
+
+// DefaultValueGT applies the GT predicate on the "default_value" field.
+func DefaultValueGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldDefaultValue, v))
+}
+
+// DefaultValueGTE applies the GTE predicate on the "default_value" field.
+func DefaultValueGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldDefaultValue, v))
+}
+
+// DefaultValueLT applies the LT predicate on the "default_value" field.
+func DefaultValueLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldDefaultValue, v))
+}
+
+// DefaultValueLTE applies the LTE predicate on the "default_value" field.
+func DefaultValueLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldDefaultValue, v))
+}
+
+// DefaultValueContains applies the Contains predicate on the "default_value" field.
+func DefaultValueContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldDefaultValue, v))
+}
+
+// DefaultValueHasPrefix applies the HasPrefix predicate on the "default_value" field.
+func DefaultValueHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldDefaultValue, v))
+}
+
+// DefaultValueHasSuffix applies the HasSuffix predicate on the "default_value" field.
+func DefaultValueHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldDefaultValue, v))
+}
+
+// DefaultValueIsNil applies the IsNil predicate on the "default_value" field.
+func DefaultValueIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIsNull(FieldDefaultValue))
+}
+
+// DefaultValueNotNil applies the NotNil predicate on the "default_value" field.
+func DefaultValueNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotNull(FieldDefaultValue))
+}
+
+// DefaultValueEqualFold applies the EqualFold predicate on the "default_value" field.
+func DefaultValueEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldDefaultValue, v))
+}
+
+// DefaultValueContainsFold applies the ContainsFold predicate on the "default_value" field.
+func DefaultValueContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldDefaultValue, v))
+}
+
+// EnumValuesIsNil applies the IsNil predicate on the "enum_values" field.
+func EnumValuesIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIsNull(FieldEnumValues))
+}
+
+// EnumValuesNotNil applies the NotNil predicate on the "enum_values" field.
+func EnumValuesNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotNull(FieldEnumValues))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v bool) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldSecret, v))
+}
+
+// OrderEQ applies the EQ predicate on the "order" field.
+func OrderEQ(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldOrder, v))
+}
+
+// OrderNEQ applies the NEQ predicate on the "order" field.
+func OrderNEQ(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldOrder, v))
+}
+
+// OrderIn applies the In predicate on the "order" field.
+func OrderIn(vs ...int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldOrder, vs...))
+}
+
+// OrderNotIn applies the NotIn predicate on the "order" field.
+func OrderNotIn(vs ...int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldOrder, vs...))
+}
+
+// OrderGT applies the GT predicate on the "order" field.
+func OrderGT(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldOrder, v))
+}
+
+// OrderGTE applies the GTE predicate on the "order" field.
+func OrderGTE(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldOrder, v))
+}
+
+// OrderLT applies the LT predicate on the "order" field.
+func OrderLT(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldOrder, v))
+}
+
+// OrderLTE applies the LTE predicate on the "order" field.
+func OrderLTE(v int) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldOrder, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasAssetClass applies the HasEdge predicate on the "asset_class" edge.
+func HasAssetClass() predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AssetClassTable, AssetClassColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssetClassWith applies the HasEdge predicate on the "asset_class" edge with a given conditions (other predicates).
+func HasAssetClassWith(preds ...predicate.AssetClass) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(func(s *sql.Selector) {
+		step := newAssetClassStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AttributeDefinition) predicate.AttributeDefinition {
+	return predicate.AttributeDefinition(sql.NotPredicates(p))
+}
@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttributeDefinitionCreate is the builder for creating a AttributeDefinition entity.
+type AttributeDefinitionCreate struct {
+	config
+	mutation *AttributeDefinitionMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (adc *AttributeDefinitionCreate) SetKey(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetKey(s)
+	return adc
+}
+
+// SetName sets the "name" field.
+func (adc *AttributeDefinitionCreate) SetName(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetName(s)
+	return adc
+}
+
+// SetDescription sets the "description" field.
+func (adc *AttributeDefinitionCreate) SetDescription(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetDescription(s)
+	return adc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableDescription(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetDescription(*s)
+	}
+	return adc
+}
+
+// SetType sets the "type" field.
+func (adc *AttributeDefinitionCreate) SetType(a attributedefinition.Type) *AttributeDefinitionCreate {
+	adc.mutation.SetType(a)
+	return adc
+}
+
+// SetRequired sets the "required" field.
+func (adc *AttributeDefinitionCreate) SetRequired(b bool) *AttributeDefinitionCreate {
+	adc.mutation.SetRequired(b)
+	return adc
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableRequired(b *bool) *AttributeDefinitionCreate {
+	if b != nil {
+		adc.SetRequired(*b)
+	}
+	return adc
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (adc *AttributeDefinitionCreate) SetDefaultValue(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetDefaultValue(s)
+	return adc
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableDefaultValue(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetDefaultValue(*s)
+	}
+	return adc
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adc *AttributeDefinitionCreate) SetEnumValues(s []string) *AttributeDefinitionCreate {
+	adc.mutation.SetEnumValues(s)
+	return adc
+}
+
+// SetSecret sets the "secret" field.
+func (adc *AttributeDefinitionCreate) SetSecret(b bool) *AttributeDefinitionCreate {
+	adc.mutation.SetSecret(b)
+	return adc
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableSecret(b *bool) *AttributeDefinitionCreate {
+	if b != nil {
+		adc.SetSecret(*b)
+	}
+	return adc
+}
+
+// SetOrder sets the "order" field.
+func (adc *AttributeDefinitionCreate) SetOrder(i int) *AttributeDefinitionCreate {
+	adc.mutation.SetOrder(i)
+	return adc
+}
+
+// SetNillableOrder sets the "order" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableOrder(i *int) *AttributeDefinitionCreate {
+	if i != nil {
+		adc.SetOrder(*i)
+	}
+	return adc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (adc *AttributeDefinitionCreate) SetCreatedBy(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetCreatedBy(s)
+	return adc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (adc *AttributeDefinitionCreate) SetCreatedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetCreatedAt(t)
+	return adc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableCreatedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetCreatedAt(*t)
+	}
+	return adc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (adc *AttributeDefinitionCreate) SetUpdatedBy(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetUpdatedBy(s)
+	return adc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adc *AttributeDefinitionCreate) SetUpdatedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetUpdatedAt(t)
+	return adc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableUpdatedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetUpdatedAt(*t)
+	}
+	return adc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (adc *AttributeDefinitionCreate) SetDeletedBy(s string) *AttributeDefinitionCreate {
+	adc.mutation.SetDeletedBy(s)
+	return adc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableDeletedBy(s *string) *AttributeDefinitionCreate {
+	if s != nil {
+		adc.SetDeletedBy(*s)
+	}
+	return adc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (adc *AttributeDefinitionCreate) SetDeletedAt(t time.Time) *AttributeDefinitionCreate {
+	adc.mutation.SetDeletedAt(t)
+	return adc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableDeletedAt(t *time.Time) *AttributeDefinitionCreate {
+	if t != nil {
+		adc.SetDeletedAt(*t)
+	}
+	return adc
+}
+
+// SetID sets the "id" field.
+func (adc *AttributeDefinitionCreate) SetID(u uuid.UUID) *AttributeDefinitionCreate {
+	adc.mutation.SetID(u)
+	return adc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (adc *AttributeDefinitionCreate) SetNillableID(u *uuid.UUID) *AttributeDefinitionCreate {
+	if u != nil {
+		adc.SetID(*u)
+	}
+	return adc
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (adc *AttributeDefinitionCreate) SetAssetClassID(id uuid.UUID) *AttributeDefinitionCreate {
+	adc.mutation.SetAssetClassID(id)
+	return adc
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (adc *AttributeDefinitionCreate) SetAssetClass(a *AssetClass) *AttributeDefinitionCreate {
+	return adc.SetAssetClassID(a.ID)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adc *AttributeDefinitionCreate) Mutation() *AttributeDefinitionMutation {
+	return adc.mutation
+}
+
+// Save creates the AttributeDefinition in the database.
+func (adc *AttributeDefinitionCreate) Save(ctx context.Context) (*AttributeDefinition, error) {
+	adc.defaults()
+	return withHooks(ctx, adc.sqlSave, adc.mutation, adc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (adc *AttributeDefinitionCreate) SaveX(ctx context.Context) *AttributeDefinition {
+	v, err := adc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adc *AttributeDefinitionCreate) Exec(ctx context.Context) error {
+	_, err := adc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adc *AttributeDefinitionCreate) ExecX(ctx context.Context) {
+	if err := adc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adc *AttributeDefinitionCreate) defaults() {
+	if _, ok := adc.mutation.Required(); !ok {
+		v := attributedefinition.DefaultRequired
+		adc.mutation.SetRequired(v)
+	}
+	if _, ok := adc.mutation.Secret(); !ok {
+		v := attributedefinition.DefaultSecret
+		adc.mutation.SetSecret(v)
+	}
+	if _, ok := adc.mutation.Order(); !ok {
+		v := attributedefinition.DefaultOrder
+		adc.mutation.SetOrder(v)
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		v := attributedefinition.DefaultCreatedAt()
+		adc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.DefaultUpdatedAt()
+		adc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := adc.mutation.ID(); !ok {
+		v := attributedefinition.DefaultID()
+		adc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adc *AttributeDefinitionCreate) check() error {
+	if _, ok := adc.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "AttributeDefinition.key"`)}
+	}
+	if v, ok := adc.mutation.Key(); ok {
+		if err := attributedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.key": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "AttributeDefinition.name"`)}
+	}
+	if v, ok := adc.mutation.Name(); ok {
+		if err := attributedefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.name": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AttributeDefinition.type"`)}
+	}
+	if v, ok := adc.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	if _, ok := adc.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "AttributeDefinition.required"`)}
+	}
+	if _, ok := adc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "AttributeDefinition.secret"`)}
+	}
+	if _, ok := adc.mutation.Order(); !ok {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required field "AttributeDefinition.order"`)}
+	}
+	if _, ok := adc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "AttributeDefinition.created_by"`)}
+	}
+	if _, ok := adc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AttributeDefinition.created_at"`)}
+	}
+	if _, ok := adc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "AttributeDefinition.updated_by"`)}
+	}
+	if _, ok := adc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AttributeDefinition.updated_at"`)}
+	}
+	if len(adc.mutation.AssetClassIDs()) == 0 {
+		return &ValidationError{Name: "asset_class", err: errors.New(`ent: missing required edge "AttributeDefinition.asset_class"`)}
+	}
+	return nil
+}
+
+func (adc *AttributeDefinitionCreate) sqlSave(ctx context.Context) (*AttributeDefinition, error) {
+	if err := adc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := adc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, adc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	adc.mutation.id = &_node.ID
+	adc.mutation.done = true
+	return _node, nil
+}
+
+func (adc *AttributeDefinitionCreate) createSpec() (*AttributeDefinition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AttributeDefinition{config: adc.config}
+		_spec = sqlgraph.NewCreateSpec(attributedefinition.Table, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID))
+	)
+	if id, ok := adc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := adc.mutation.Key(); ok {
+		_spec.SetField(attributedefinition.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := adc.mutation.Name(); ok {
+		_spec.SetField(attributedefinition.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := adc.mutation.Description(); ok {
+		_spec.SetField(attributedefinition.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := adc.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := adc.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := adc.mutation.DefaultValue(); ok {
+		_spec.SetField(attributedefinition.FieldDefaultValue, field.TypeString, value)
+		_node.DefaultValue = value
+	}
+	if value, ok := adc.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+		_node.EnumValues = value
+	}
+	if value, ok := adc.mutation.Secret(); ok {
+		_spec.SetField(attributedefinition.FieldSecret, field.TypeBool, value)
+		_node.Secret = value
+	}
+	if value, ok := adc.mutation.Order(); ok {
+		_spec.SetField(attributedefinition.FieldOrder, field.TypeInt, value)
+		_node.Order = value
+	}
+	if value, ok := adc.mutation.CreatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := adc.mutation.CreatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := adc.mutation.UpdatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := adc.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := adc.mutation.DeletedBy(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := adc.mutation.DeletedAt(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := adc.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attributedefinition.AssetClassTable,
+			Columns: []string{attributedefinition.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.asset_class_attributes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AttributeDefinitionCreateBulk is the builder for creating many AttributeDefinition entities in bulk.
+type AttributeDefinitionCreateBulk struct {
+	config
+	err      error
+	builders []*AttributeDefinitionCreate
+}
+
+// Save creates the AttributeDefinition entities in the database.
+func (adcb *AttributeDefinitionCreateBulk) Save(ctx context.Context) ([]*AttributeDefinition, error) {
+	if adcb.err != nil {
+		return nil, adcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(adcb.builders))
+	nodes := make([]*AttributeDefinition, len(adcb.builders))
+	mutators := make([]Mutator, len(adcb.builders))
+	for i := range adcb.builders {
+		func(i int, root context.Context) {
+			builder := adcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AttributeDefinitionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, adcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, adcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, adcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) SaveX(ctx context.Context) []*AttributeDefinition {
+	v, err := adcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (adcb *AttributeDefinitionCreateBulk) Exec(ctx context.Context) error {
+	_, err := adcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adcb *AttributeDefinitionCreateBulk) ExecX(ctx context.Context) {
+	if err := adcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
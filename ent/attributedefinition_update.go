@@ -0,0 +1,883 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AttributeDefinitionUpdate is the builder for updating AttributeDefinition entities.
+type AttributeDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AttributeDefinitionMutation
+}
+
+// Where appends a list predicates to the AttributeDefinitionUpdate builder.
+func (adu *AttributeDefinitionUpdate) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionUpdate {
+	adu.mutation.Where(ps...)
+	return adu
+}
+
+// SetKey sets the "key" field.
+func (adu *AttributeDefinitionUpdate) SetKey(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetKey(s)
+	return adu
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableKey(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetKey(*s)
+	}
+	return adu
+}
+
+// SetName sets the "name" field.
+func (adu *AttributeDefinitionUpdate) SetName(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetName(s)
+	return adu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableName(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetName(*s)
+	}
+	return adu
+}
+
+// SetDescription sets the "description" field.
+func (adu *AttributeDefinitionUpdate) SetDescription(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetDescription(s)
+	return adu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableDescription(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetDescription(*s)
+	}
+	return adu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (adu *AttributeDefinitionUpdate) ClearDescription() *AttributeDefinitionUpdate {
+	adu.mutation.ClearDescription()
+	return adu
+}
+
+// SetType sets the "type" field.
+func (adu *AttributeDefinitionUpdate) SetType(a attributedefinition.Type) *AttributeDefinitionUpdate {
+	adu.mutation.SetType(a)
+	return adu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableType(a *attributedefinition.Type) *AttributeDefinitionUpdate {
+	if a != nil {
+		adu.SetType(*a)
+	}
+	return adu
+}
+
+// SetRequired sets the "required" field.
+func (adu *AttributeDefinitionUpdate) SetRequired(b bool) *AttributeDefinitionUpdate {
+	adu.mutation.SetRequired(b)
+	return adu
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableRequired(b *bool) *AttributeDefinitionUpdate {
+	if b != nil {
+		adu.SetRequired(*b)
+	}
+	return adu
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (adu *AttributeDefinitionUpdate) SetDefaultValue(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetDefaultValue(s)
+	return adu
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableDefaultValue(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetDefaultValue(*s)
+	}
+	return adu
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (adu *AttributeDefinitionUpdate) ClearDefaultValue() *AttributeDefinitionUpdate {
+	adu.mutation.ClearDefaultValue()
+	return adu
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) SetEnumValues(s []string) *AttributeDefinitionUpdate {
+	adu.mutation.SetEnumValues(s)
+	return adu
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) AppendEnumValues(s []string) *AttributeDefinitionUpdate {
+	adu.mutation.AppendEnumValues(s)
+	return adu
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (adu *AttributeDefinitionUpdate) ClearEnumValues() *AttributeDefinitionUpdate {
+	adu.mutation.ClearEnumValues()
+	return adu
+}
+
+// SetOrder sets the "order" field.
+func (adu *AttributeDefinitionUpdate) SetOrder(i int) *AttributeDefinitionUpdate {
+	adu.mutation.ResetOrder()
+	adu.mutation.SetOrder(i)
+	return adu
+}
+
+// SetNillableOrder sets the "order" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableOrder(i *int) *AttributeDefinitionUpdate {
+	if i != nil {
+		adu.SetOrder(*i)
+	}
+	return adu
+}
+
+// AddOrder adds i to the "order" field.
+func (adu *AttributeDefinitionUpdate) AddOrder(i int) *AttributeDefinitionUpdate {
+	adu.mutation.AddOrder(i)
+	return adu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (adu *AttributeDefinitionUpdate) SetCreatedBy(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetCreatedBy(s)
+	return adu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableCreatedBy(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetCreatedBy(*s)
+	}
+	return adu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (adu *AttributeDefinitionUpdate) SetUpdatedBy(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetUpdatedBy(s)
+	return adu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableUpdatedBy(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetUpdatedBy(*s)
+	}
+	return adu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (adu *AttributeDefinitionUpdate) SetUpdatedAt(t time.Time) *AttributeDefinitionUpdate {
+	adu.mutation.SetUpdatedAt(t)
+	return adu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (adu *AttributeDefinitionUpdate) SetDeletedBy(s string) *AttributeDefinitionUpdate {
+	adu.mutation.SetDeletedBy(s)
+	return adu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableDeletedBy(s *string) *AttributeDefinitionUpdate {
+	if s != nil {
+		adu.SetDeletedBy(*s)
+	}
+	return adu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (adu *AttributeDefinitionUpdate) ClearDeletedBy() *AttributeDefinitionUpdate {
+	adu.mutation.ClearDeletedBy()
+	return adu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (adu *AttributeDefinitionUpdate) SetDeletedAt(t time.Time) *AttributeDefinitionUpdate {
+	adu.mutation.SetDeletedAt(t)
+	return adu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (adu *AttributeDefinitionUpdate) SetNillableDeletedAt(t *time.Time) *AttributeDefinitionUpdate {
+	if t != nil {
+		adu.SetDeletedAt(*t)
+	}
+	return adu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (adu *AttributeDefinitionUpdate) ClearDeletedAt() *AttributeDefinitionUpdate {
+	adu.mutation.ClearDeletedAt()
+	return adu
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (adu *AttributeDefinitionUpdate) SetAssetClassID(id uuid.UUID) *AttributeDefinitionUpdate {
+	adu.mutation.SetAssetClassID(id)
+	return adu
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (adu *AttributeDefinitionUpdate) SetAssetClass(a *AssetClass) *AttributeDefinitionUpdate {
+	return adu.SetAssetClassID(a.ID)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (adu *AttributeDefinitionUpdate) Mutation() *AttributeDefinitionMutation {
+	return adu.mutation
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (adu *AttributeDefinitionUpdate) ClearAssetClass() *AttributeDefinitionUpdate {
+	adu.mutation.ClearAssetClass()
+	return adu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (adu *AttributeDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	adu.defaults()
+	return withHooks(ctx, adu.sqlSave, adu.mutation, adu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := adu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (adu *AttributeDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := adu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (adu *AttributeDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := adu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (adu *AttributeDefinitionUpdate) defaults() {
+	if _, ok := adu.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.UpdateDefaultUpdatedAt()
+		adu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (adu *AttributeDefinitionUpdate) check() error {
+	if v, ok := adu.mutation.Key(); ok {
+		if err := attributedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := adu.mutation.Name(); ok {
+		if err := attributedefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.name": %w`, err)}
+		}
+	}
+	if v, ok := adu.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	if adu.mutation.AssetClassCleared() && len(adu.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttributeDefinition.asset_class"`)
+	}
+	return nil
+}
+
+func (adu *AttributeDefinitionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := adu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attributedefinition.Table, attributedefinition.Columns, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID))
+	if ps := adu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := adu.mutation.Key(); ok {
+		_spec.SetField(attributedefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.Name(); ok {
+		_spec.SetField(attributedefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.Description(); ok {
+		_spec.SetField(attributedefinition.FieldDescription, field.TypeString, value)
+	}
+	if adu.mutation.DescriptionCleared() {
+		_spec.ClearField(attributedefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := adu.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := adu.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := adu.mutation.DefaultValue(); ok {
+		_spec.SetField(attributedefinition.FieldDefaultValue, field.TypeString, value)
+	}
+	if adu.mutation.DefaultValueCleared() {
+		_spec.ClearField(attributedefinition.FieldDefaultValue, field.TypeString)
+	}
+	if value, ok := adu.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := adu.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attributedefinition.FieldEnumValues, value)
+		})
+	}
+	if adu.mutation.EnumValuesCleared() {
+		_spec.ClearField(attributedefinition.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := adu.mutation.Order(); ok {
+		_spec.SetField(attributedefinition.FieldOrder, field.TypeInt, value)
+	}
+	if value, ok := adu.mutation.AddedOrder(); ok {
+		_spec.AddField(attributedefinition.FieldOrder, field.TypeInt, value)
+	}
+	if value, ok := adu.mutation.CreatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.UpdatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := adu.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := adu.mutation.DeletedBy(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedBy, field.TypeString, value)
+	}
+	if adu.mutation.DeletedByCleared() {
+		_spec.ClearField(attributedefinition.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := adu.mutation.DeletedAt(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedAt, field.TypeTime, value)
+	}
+	if adu.mutation.DeletedAtCleared() {
+		_spec.ClearField(attributedefinition.FieldDeletedAt, field.TypeTime)
+	}
+	if adu.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attributedefinition.AssetClassTable,
+			Columns: []string{attributedefinition.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := adu.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attributedefinition.AssetClassTable,
+			Columns: []string{attributedefinition.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, adu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	adu.mutation.done = true
+	return n, nil
+}
+
+// AttributeDefinitionUpdateOne is the builder for updating a single AttributeDefinition entity.
+type AttributeDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AttributeDefinitionMutation
+}
+
+// SetKey sets the "key" field.
+func (aduo *AttributeDefinitionUpdateOne) SetKey(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetKey(s)
+	return aduo
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableKey(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetKey(*s)
+	}
+	return aduo
+}
+
+// SetName sets the "name" field.
+func (aduo *AttributeDefinitionUpdateOne) SetName(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetName(s)
+	return aduo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableName(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetName(*s)
+	}
+	return aduo
+}
+
+// SetDescription sets the "description" field.
+func (aduo *AttributeDefinitionUpdateOne) SetDescription(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetDescription(s)
+	return aduo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableDescription(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetDescription(*s)
+	}
+	return aduo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearDescription() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearDescription()
+	return aduo
+}
+
+// SetType sets the "type" field.
+func (aduo *AttributeDefinitionUpdateOne) SetType(a attributedefinition.Type) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetType(a)
+	return aduo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableType(a *attributedefinition.Type) *AttributeDefinitionUpdateOne {
+	if a != nil {
+		aduo.SetType(*a)
+	}
+	return aduo
+}
+
+// SetRequired sets the "required" field.
+func (aduo *AttributeDefinitionUpdateOne) SetRequired(b bool) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetRequired(b)
+	return aduo
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableRequired(b *bool) *AttributeDefinitionUpdateOne {
+	if b != nil {
+		aduo.SetRequired(*b)
+	}
+	return aduo
+}
+
+// SetDefaultValue sets the "default_value" field.
+func (aduo *AttributeDefinitionUpdateOne) SetDefaultValue(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetDefaultValue(s)
+	return aduo
+}
+
+// SetNillableDefaultValue sets the "default_value" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableDefaultValue(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetDefaultValue(*s)
+	}
+	return aduo
+}
+
+// ClearDefaultValue clears the value of the "default_value" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearDefaultValue() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearDefaultValue()
+	return aduo
+}
+
+// SetEnumValues sets the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) SetEnumValues(s []string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetEnumValues(s)
+	return aduo
+}
+
+// AppendEnumValues appends s to the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) AppendEnumValues(s []string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.AppendEnumValues(s)
+	return aduo
+}
+
+// ClearEnumValues clears the value of the "enum_values" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearEnumValues() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearEnumValues()
+	return aduo
+}
+
+// SetOrder sets the "order" field.
+func (aduo *AttributeDefinitionUpdateOne) SetOrder(i int) *AttributeDefinitionUpdateOne {
+	aduo.mutation.ResetOrder()
+	aduo.mutation.SetOrder(i)
+	return aduo
+}
+
+// SetNillableOrder sets the "order" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableOrder(i *int) *AttributeDefinitionUpdateOne {
+	if i != nil {
+		aduo.SetOrder(*i)
+	}
+	return aduo
+}
+
+// AddOrder adds i to the "order" field.
+func (aduo *AttributeDefinitionUpdateOne) AddOrder(i int) *AttributeDefinitionUpdateOne {
+	aduo.mutation.AddOrder(i)
+	return aduo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (aduo *AttributeDefinitionUpdateOne) SetCreatedBy(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetCreatedBy(s)
+	return aduo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableCreatedBy(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetCreatedBy(*s)
+	}
+	return aduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (aduo *AttributeDefinitionUpdateOne) SetUpdatedBy(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetUpdatedBy(s)
+	return aduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableUpdatedBy(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetUpdatedBy(*s)
+	}
+	return aduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aduo *AttributeDefinitionUpdateOne) SetUpdatedAt(t time.Time) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetUpdatedAt(t)
+	return aduo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (aduo *AttributeDefinitionUpdateOne) SetDeletedBy(s string) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetDeletedBy(s)
+	return aduo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableDeletedBy(s *string) *AttributeDefinitionUpdateOne {
+	if s != nil {
+		aduo.SetDeletedBy(*s)
+	}
+	return aduo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearDeletedBy() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearDeletedBy()
+	return aduo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (aduo *AttributeDefinitionUpdateOne) SetDeletedAt(t time.Time) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetDeletedAt(t)
+	return aduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (aduo *AttributeDefinitionUpdateOne) SetNillableDeletedAt(t *time.Time) *AttributeDefinitionUpdateOne {
+	if t != nil {
+		aduo.SetDeletedAt(*t)
+	}
+	return aduo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (aduo *AttributeDefinitionUpdateOne) ClearDeletedAt() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearDeletedAt()
+	return aduo
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (aduo *AttributeDefinitionUpdateOne) SetAssetClassID(id uuid.UUID) *AttributeDefinitionUpdateOne {
+	aduo.mutation.SetAssetClassID(id)
+	return aduo
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (aduo *AttributeDefinitionUpdateOne) SetAssetClass(a *AssetClass) *AttributeDefinitionUpdateOne {
+	return aduo.SetAssetClassID(a.ID)
+}
+
+// Mutation returns the AttributeDefinitionMutation object of the builder.
+func (aduo *AttributeDefinitionUpdateOne) Mutation() *AttributeDefinitionMutation {
+	return aduo.mutation
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (aduo *AttributeDefinitionUpdateOne) ClearAssetClass() *AttributeDefinitionUpdateOne {
+	aduo.mutation.ClearAssetClass()
+	return aduo
+}
+
+// Where appends a list predicates to the AttributeDefinitionUpdate builder.
+func (aduo *AttributeDefinitionUpdateOne) Where(ps ...predicate.AttributeDefinition) *AttributeDefinitionUpdateOne {
+	aduo.mutation.Where(ps...)
+	return aduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aduo *AttributeDefinitionUpdateOne) Select(field string, fields ...string) *AttributeDefinitionUpdateOne {
+	aduo.fields = append([]string{field}, fields...)
+	return aduo
+}
+
+// Save executes the query and returns the updated AttributeDefinition entity.
+func (aduo *AttributeDefinitionUpdateOne) Save(ctx context.Context) (*AttributeDefinition, error) {
+	aduo.defaults()
+	return withHooks(ctx, aduo.sqlSave, aduo.mutation, aduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) SaveX(ctx context.Context) *AttributeDefinition {
+	node, err := aduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aduo *AttributeDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aduo *AttributeDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := aduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aduo *AttributeDefinitionUpdateOne) defaults() {
+	if _, ok := aduo.mutation.UpdatedAt(); !ok {
+		v := attributedefinition.UpdateDefaultUpdatedAt()
+		aduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aduo *AttributeDefinitionUpdateOne) check() error {
+	if v, ok := aduo.mutation.Key(); ok {
+		if err := attributedefinition.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.key": %w`, err)}
+		}
+	}
+	if v, ok := aduo.mutation.Name(); ok {
+		if err := attributedefinition.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.name": %w`, err)}
+		}
+	}
+	if v, ok := aduo.mutation.GetType(); ok {
+		if err := attributedefinition.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AttributeDefinition.type": %w`, err)}
+		}
+	}
+	if aduo.mutation.AssetClassCleared() && len(aduo.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AttributeDefinition.asset_class"`)
+	}
+	return nil
+}
+
+func (aduo *AttributeDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *AttributeDefinition, err error) {
+	if err := aduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(attributedefinition.Table, attributedefinition.Columns, sqlgraph.NewFieldSpec(attributedefinition.FieldID, field.TypeUUID))
+	id, ok := aduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AttributeDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, attributedefinition.FieldID)
+		for _, f := range fields {
+			if !attributedefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != attributedefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aduo.mutation.Key(); ok {
+		_spec.SetField(attributedefinition.FieldKey, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.Name(); ok {
+		_spec.SetField(attributedefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.Description(); ok {
+		_spec.SetField(attributedefinition.FieldDescription, field.TypeString, value)
+	}
+	if aduo.mutation.DescriptionCleared() {
+		_spec.ClearField(attributedefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := aduo.mutation.GetType(); ok {
+		_spec.SetField(attributedefinition.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := aduo.mutation.Required(); ok {
+		_spec.SetField(attributedefinition.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := aduo.mutation.DefaultValue(); ok {
+		_spec.SetField(attributedefinition.FieldDefaultValue, field.TypeString, value)
+	}
+	if aduo.mutation.DefaultValueCleared() {
+		_spec.ClearField(attributedefinition.FieldDefaultValue, field.TypeString)
+	}
+	if value, ok := aduo.mutation.EnumValues(); ok {
+		_spec.SetField(attributedefinition.FieldEnumValues, field.TypeJSON, value)
+	}
+	if value, ok := aduo.mutation.AppendedEnumValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, attributedefinition.FieldEnumValues, value)
+		})
+	}
+	if aduo.mutation.EnumValuesCleared() {
+		_spec.ClearField(attributedefinition.FieldEnumValues, field.TypeJSON)
+	}
+	if value, ok := aduo.mutation.Order(); ok {
+		_spec.SetField(attributedefinition.FieldOrder, field.TypeInt, value)
+	}
+	if value, ok := aduo.mutation.AddedOrder(); ok {
+		_spec.AddField(attributedefinition.FieldOrder, field.TypeInt, value)
+	}
+	if value, ok := aduo.mutation.CreatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := aduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(attributedefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := aduo.mutation.DeletedBy(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedBy, field.TypeString, value)
+	}
+	if aduo.mutation.DeletedByCleared() {
+		_spec.ClearField(attributedefinition.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := aduo.mutation.DeletedAt(); ok {
+		_spec.SetField(attributedefinition.FieldDeletedAt, field.TypeTime, value)
+	}
+	if aduo.mutation.DeletedAtCleared() {
+		_spec.ClearField(attributedefinition.FieldDeletedAt, field.TypeTime)
+	}
+	if aduo.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attributedefinition.AssetClassTable,
+			Columns: []string{attributedefinition.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aduo.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   attributedefinition.AssetClassTable,
+			Columns: []string{attributedefinition.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AttributeDefinition{config: aduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{attributedefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aduo.mutation.done = true
+	return _node, nil
+}
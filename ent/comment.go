@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/comment"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Comment is the model entity for the Comment schema.
+type Comment struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the comment.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The markdown text of the comment, which is rendered by the user interface.
+	Body string `json:"body,omitempty"`
+	// The subjects of the users mentioned in the body with @subject, in the order of their first mention.
+	Mentions []string `json:"mentions,omitempty"`
+	// The previous versions of the body, oldest first.
+	Edits []schema.CommentEdit `json:"edits,omitempty"`
+	// When the body was last edited, empty if it was never edited.
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentQuery when eager-loading is set.
+	Edges           CommentEdges `json:"edges"`
+	comment_item    *uuid.UUID
+	comment_replies *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// CommentEdges holds the relations/edges for other nodes in the graph.
+type CommentEdges struct {
+	// The item the comment is about.
+	Item *Item `json:"item,omitempty"`
+	// The users mentioned in the body, which are looked up by the mentions of a user.
+	Mentioned []*CommentMention `json:"mentioned,omitempty"`
+	// The comment this comment replies to, empty for comments that start a thread. Replies belong to the same item as their parent.
+	Parent *Comment `json:"parent,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*Comment `json:"replies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// MentionedOrErr returns the Mentioned value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) MentionedOrErr() ([]*CommentMention, error) {
+	if e.loadedTypes[1] {
+		return e.Mentioned, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentEdges) ParentOrErr() (*Comment, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e CommentEdges) RepliesOrErr() ([]*Comment, error) {
+	if e.loadedTypes[3] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Comment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldMentions, comment.FieldEdits:
+			values[i] = new([]byte)
+		case comment.FieldBody, comment.FieldCreatedBy, comment.FieldUpdatedBy, comment.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case comment.FieldEditedAt, comment.FieldCreatedAt, comment.FieldUpdatedAt, comment.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case comment.FieldID:
+			values[i] = new(uuid.UUID)
+		case comment.ForeignKeys[0]: // comment_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case comment.ForeignKeys[1]: // comment_replies
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Comment fields.
+func (c *Comment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				c.ID = *value
+			}
+		case comment.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				c.Body = value.String
+			}
+		case comment.FieldMentions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mentions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Mentions); err != nil {
+					return fmt.Errorf("unmarshal field mentions: %w", err)
+				}
+			}
+		case comment.FieldEdits:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field edits", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Edits); err != nil {
+					return fmt.Errorf("unmarshal field edits: %w", err)
+				}
+			}
+		case comment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				c.EditedAt = new(time.Time)
+				*c.EditedAt = value.Time
+			}
+		case comment.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				c.CreatedBy = value.String
+			}
+		case comment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case comment.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				c.UpdatedBy = value.String
+			}
+		case comment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		case comment.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				c.DeletedBy = value.String
+			}
+		case comment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
+		case comment.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_item", values[i])
+			} else if value.Valid {
+				c.comment_item = new(uuid.UUID)
+				*c.comment_item = *value.S.(*uuid.UUID)
+			}
+		case comment.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_replies", values[i])
+			} else if value.Valid {
+				c.comment_replies = new(uuid.UUID)
+				*c.comment_replies = *value.S.(*uuid.UUID)
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Comment.
+// This includes values selected through modifiers, order, etc.
+func (c *Comment) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the Comment entity.
+func (c *Comment) QueryItem() *ItemQuery {
+	return NewCommentClient(c.config).QueryItem(c)
+}
+
+// QueryMentioned queries the "mentioned" edge of the Comment entity.
+func (c *Comment) QueryMentioned() *CommentMentionQuery {
+	return NewCommentClient(c.config).QueryMentioned(c)
+}
+
+// QueryParent queries the "parent" edge of the Comment entity.
+func (c *Comment) QueryParent() *CommentQuery {
+	return NewCommentClient(c.config).QueryParent(c)
+}
+
+// QueryReplies queries the "replies" edge of the Comment entity.
+func (c *Comment) QueryReplies() *CommentQuery {
+	return NewCommentClient(c.config).QueryReplies(c)
+}
+
+// Update returns a builder for updating this Comment.
+// Note that you need to call Comment.Unwrap() before calling this method if this Comment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Comment) Update() *CommentUpdateOne {
+	return NewCommentClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Comment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Comment) Unwrap() *Comment {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Comment is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Comment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Comment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("body=")
+	builder.WriteString(c.Body)
+	builder.WriteString(", ")
+	builder.WriteString("mentions=")
+	builder.WriteString(fmt.Sprintf("%v", c.Mentions))
+	builder.WriteString(", ")
+	builder.WriteString("edits=")
+	builder.WriteString(fmt.Sprintf("%v", c.Edits))
+	builder.WriteString(", ")
+	if v := c.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(c.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(c.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(c.DeletedBy)
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Comments is a parsable slice of Comment.
+type Comments []*Comment
@@ -0,0 +1,1054 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CommentUpdate is the builder for updating Comment entities.
+type CommentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommentMutation
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetBody sets the "body" field.
+func (cu *CommentUpdate) SetBody(s string) *CommentUpdate {
+	cu.mutation.SetBody(s)
+	return cu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableBody(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetBody(*s)
+	}
+	return cu
+}
+
+// SetMentions sets the "mentions" field.
+func (cu *CommentUpdate) SetMentions(s []string) *CommentUpdate {
+	cu.mutation.SetMentions(s)
+	return cu
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (cu *CommentUpdate) AppendMentions(s []string) *CommentUpdate {
+	cu.mutation.AppendMentions(s)
+	return cu
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (cu *CommentUpdate) ClearMentions() *CommentUpdate {
+	cu.mutation.ClearMentions()
+	return cu
+}
+
+// SetEdits sets the "edits" field.
+func (cu *CommentUpdate) SetEdits(se []schema.CommentEdit) *CommentUpdate {
+	cu.mutation.SetEdits(se)
+	return cu
+}
+
+// AppendEdits appends se to the "edits" field.
+func (cu *CommentUpdate) AppendEdits(se []schema.CommentEdit) *CommentUpdate {
+	cu.mutation.AppendEdits(se)
+	return cu
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (cu *CommentUpdate) ClearEdits() *CommentUpdate {
+	cu.mutation.ClearEdits()
+	return cu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cu *CommentUpdate) SetCreatedBy(s string) *CommentUpdate {
+	cu.mutation.SetCreatedBy(s)
+	return cu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableCreatedBy(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetCreatedBy(*s)
+	}
+	return cu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cu *CommentUpdate) SetUpdatedBy(s string) *CommentUpdate {
+	cu.mutation.SetUpdatedBy(s)
+	return cu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableUpdatedBy(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetUpdatedBy(*s)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CommentUpdate) SetUpdatedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cu *CommentUpdate) SetDeletedBy(s string) *CommentUpdate {
+	cu.mutation.SetDeletedBy(s)
+	return cu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeletedBy(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetDeletedBy(*s)
+	}
+	return cu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cu *CommentUpdate) ClearDeletedBy() *CommentUpdate {
+	cu.mutation.ClearDeletedBy()
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CommentUpdate) SetDeletedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeletedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CommentUpdate) ClearDeletedAt() *CommentUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
+// AddMentionedIDs adds the "mentioned" edge to the CommentMention entity by IDs.
+func (cu *CommentUpdate) AddMentionedIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.AddMentionedIDs(ids...)
+	return cu
+}
+
+// AddMentioned adds the "mentioned" edges to the CommentMention entity.
+func (cu *CommentUpdate) AddMentioned(c ...*CommentMention) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddMentionedIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Comment entity by ID.
+func (cu *CommentUpdate) SetParentID(id uuid.UUID) *CommentUpdate {
+	cu.mutation.SetParentID(id)
+	return cu
+}
+
+// SetNillableParentID sets the "parent" edge to the Comment entity by ID if the given value is not nil.
+func (cu *CommentUpdate) SetNillableParentID(id *uuid.UUID) *CommentUpdate {
+	if id != nil {
+		cu = cu.SetParentID(*id)
+	}
+	return cu
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cu *CommentUpdate) SetParent(c *Comment) *CommentUpdate {
+	return cu.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cu *CommentUpdate) AddReplyIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.AddReplyIDs(ids...)
+	return cu
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cu *CommentUpdate) AddReplies(c ...*Comment) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.AddReplyIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cu *CommentUpdate) Mutation() *CommentMutation {
+	return cu.mutation
+}
+
+// ClearMentioned clears all "mentioned" edges to the CommentMention entity.
+func (cu *CommentUpdate) ClearMentioned() *CommentUpdate {
+	cu.mutation.ClearMentioned()
+	return cu
+}
+
+// RemoveMentionedIDs removes the "mentioned" edge to CommentMention entities by IDs.
+func (cu *CommentUpdate) RemoveMentionedIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.RemoveMentionedIDs(ids...)
+	return cu
+}
+
+// RemoveMentioned removes "mentioned" edges to CommentMention entities.
+func (cu *CommentUpdate) RemoveMentioned(c ...*CommentMention) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveMentionedIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (cu *CommentUpdate) ClearParent() *CommentUpdate {
+	cu.mutation.ClearParent()
+	return cu
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (cu *CommentUpdate) ClearReplies() *CommentUpdate {
+	cu.mutation.ClearReplies()
+	return cu
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (cu *CommentUpdate) RemoveReplyIDs(ids ...uuid.UUID) *CommentUpdate {
+	cu.mutation.RemoveReplyIDs(ids...)
+	return cu
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (cu *CommentUpdate) RemoveReplies(c ...*Comment) *CommentUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cu.RemoveReplyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CommentUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CommentUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CommentUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := comment.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	if v, ok := cu.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Comment.body": %w`, err)}
+		}
+	}
+	if cu.mutation.ItemCleared() && len(cu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.item"`)
+	}
+	return nil
+}
+
+func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Mentions(); ok {
+		_spec.SetField(comment.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldMentions, value)
+		})
+	}
+	if cu.mutation.MentionsCleared() {
+		_spec.ClearField(comment.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Edits(); ok {
+		_spec.SetField(comment.FieldEdits, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedEdits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldEdits, value)
+		})
+	}
+	if cu.mutation.EditsCleared() {
+		_spec.ClearField(comment.FieldEdits, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.CreatedBy(); ok {
+		_spec.SetField(comment.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.UpdatedBy(); ok {
+		_spec.SetField(comment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.DeletedBy(); ok {
+		_spec.SetField(comment.FieldDeletedBy, field.TypeString, value)
+	}
+	if cu.mutation.DeletedByCleared() {
+		_spec.ClearField(comment.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if cu.mutation.MentionedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedMentionedIDs(); len(nodes) > 0 && !cu.mutation.MentionedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.MentionedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cu.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CommentUpdateOne is the builder for updating a single Comment entity.
+type CommentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommentMutation
+}
+
+// SetBody sets the "body" field.
+func (cuo *CommentUpdateOne) SetBody(s string) *CommentUpdateOne {
+	cuo.mutation.SetBody(s)
+	return cuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableBody(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetBody(*s)
+	}
+	return cuo
+}
+
+// SetMentions sets the "mentions" field.
+func (cuo *CommentUpdateOne) SetMentions(s []string) *CommentUpdateOne {
+	cuo.mutation.SetMentions(s)
+	return cuo
+}
+
+// AppendMentions appends s to the "mentions" field.
+func (cuo *CommentUpdateOne) AppendMentions(s []string) *CommentUpdateOne {
+	cuo.mutation.AppendMentions(s)
+	return cuo
+}
+
+// ClearMentions clears the value of the "mentions" field.
+func (cuo *CommentUpdateOne) ClearMentions() *CommentUpdateOne {
+	cuo.mutation.ClearMentions()
+	return cuo
+}
+
+// SetEdits sets the "edits" field.
+func (cuo *CommentUpdateOne) SetEdits(se []schema.CommentEdit) *CommentUpdateOne {
+	cuo.mutation.SetEdits(se)
+	return cuo
+}
+
+// AppendEdits appends se to the "edits" field.
+func (cuo *CommentUpdateOne) AppendEdits(se []schema.CommentEdit) *CommentUpdateOne {
+	cuo.mutation.AppendEdits(se)
+	return cuo
+}
+
+// ClearEdits clears the value of the "edits" field.
+func (cuo *CommentUpdateOne) ClearEdits() *CommentUpdateOne {
+	cuo.mutation.ClearEdits()
+	return cuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cuo *CommentUpdateOne) SetCreatedBy(s string) *CommentUpdateOne {
+	cuo.mutation.SetCreatedBy(s)
+	return cuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableCreatedBy(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetCreatedBy(*s)
+	}
+	return cuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cuo *CommentUpdateOne) SetUpdatedBy(s string) *CommentUpdateOne {
+	cuo.mutation.SetUpdatedBy(s)
+	return cuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableUpdatedBy(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetUpdatedBy(*s)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CommentUpdateOne) SetUpdatedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cuo *CommentUpdateOne) SetDeletedBy(s string) *CommentUpdateOne {
+	cuo.mutation.SetDeletedBy(s)
+	return cuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeletedBy(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetDeletedBy(*s)
+	}
+	return cuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cuo *CommentUpdateOne) ClearDeletedBy() *CommentUpdateOne {
+	cuo.mutation.ClearDeletedBy()
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CommentUpdateOne) SetDeletedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
+// AddMentionedIDs adds the "mentioned" edge to the CommentMention entity by IDs.
+func (cuo *CommentUpdateOne) AddMentionedIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.AddMentionedIDs(ids...)
+	return cuo
+}
+
+// AddMentioned adds the "mentioned" edges to the CommentMention entity.
+func (cuo *CommentUpdateOne) AddMentioned(c ...*CommentMention) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddMentionedIDs(ids...)
+}
+
+// SetParentID sets the "parent" edge to the Comment entity by ID.
+func (cuo *CommentUpdateOne) SetParentID(id uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.SetParentID(id)
+	return cuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Comment entity by ID if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableParentID(id *uuid.UUID) *CommentUpdateOne {
+	if id != nil {
+		cuo = cuo.SetParentID(*id)
+	}
+	return cuo
+}
+
+// SetParent sets the "parent" edge to the Comment entity.
+func (cuo *CommentUpdateOne) SetParent(c *Comment) *CommentUpdateOne {
+	return cuo.SetParentID(c.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the Comment entity by IDs.
+func (cuo *CommentUpdateOne) AddReplyIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.AddReplyIDs(ids...)
+	return cuo
+}
+
+// AddReplies adds the "replies" edges to the Comment entity.
+func (cuo *CommentUpdateOne) AddReplies(c ...*Comment) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.AddReplyIDs(ids...)
+}
+
+// Mutation returns the CommentMutation object of the builder.
+func (cuo *CommentUpdateOne) Mutation() *CommentMutation {
+	return cuo.mutation
+}
+
+// ClearMentioned clears all "mentioned" edges to the CommentMention entity.
+func (cuo *CommentUpdateOne) ClearMentioned() *CommentUpdateOne {
+	cuo.mutation.ClearMentioned()
+	return cuo
+}
+
+// RemoveMentionedIDs removes the "mentioned" edge to CommentMention entities by IDs.
+func (cuo *CommentUpdateOne) RemoveMentionedIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.RemoveMentionedIDs(ids...)
+	return cuo
+}
+
+// RemoveMentioned removes "mentioned" edges to CommentMention entities.
+func (cuo *CommentUpdateOne) RemoveMentioned(c ...*CommentMention) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveMentionedIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Comment entity.
+func (cuo *CommentUpdateOne) ClearParent() *CommentUpdateOne {
+	cuo.mutation.ClearParent()
+	return cuo
+}
+
+// ClearReplies clears all "replies" edges to the Comment entity.
+func (cuo *CommentUpdateOne) ClearReplies() *CommentUpdateOne {
+	cuo.mutation.ClearReplies()
+	return cuo
+}
+
+// RemoveReplyIDs removes the "replies" edge to Comment entities by IDs.
+func (cuo *CommentUpdateOne) RemoveReplyIDs(ids ...uuid.UUID) *CommentUpdateOne {
+	cuo.mutation.RemoveReplyIDs(ids...)
+	return cuo
+}
+
+// RemoveReplies removes "replies" edges to Comment entities.
+func (cuo *CommentUpdateOne) RemoveReplies(c ...*Comment) *CommentUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return cuo.RemoveReplyIDs(ids...)
+}
+
+// Where appends a list predicates to the CommentUpdate builder.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CommentUpdateOne) Select(field string, fields ...string) *CommentUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Comment entity.
+func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CommentUpdateOne) SaveX(ctx context.Context) *Comment {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CommentUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := comment.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	if v, ok := cuo.mutation.Body(); ok {
+		if err := comment.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Comment.body": %w`, err)}
+		}
+	}
+	if cuo.mutation.ItemCleared() && len(cuo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Comment.item"`)
+	}
+	return nil
+}
+
+func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(comment.Table, comment.Columns, sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Comment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for _, f := range fields {
+			if !comment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Body(); ok {
+		_spec.SetField(comment.FieldBody, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Mentions(); ok {
+		_spec.SetField(comment.FieldMentions, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedMentions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldMentions, value)
+		})
+	}
+	if cuo.mutation.MentionsCleared() {
+		_spec.ClearField(comment.FieldMentions, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Edits(); ok {
+		_spec.SetField(comment.FieldEdits, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedEdits(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, comment.FieldEdits, value)
+		})
+	}
+	if cuo.mutation.EditsCleared() {
+		_spec.ClearField(comment.FieldEdits, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.CreatedBy(); ok {
+		_spec.SetField(comment.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(comment.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.DeletedBy(); ok {
+		_spec.SetField(comment.FieldDeletedBy, field.TypeString, value)
+	}
+	if cuo.mutation.DeletedByCleared() {
+		_spec.ClearField(comment.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
+	if cuo.mutation.MentionedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedMentionedIDs(); len(nodes) > 0 && !cuo.mutation.MentionedCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.MentionedIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.MentionedTable,
+			Columns: []string{comment.MentionedColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   comment.ParentTable,
+			Columns: []string{comment.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if cuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !cuo.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   comment.RepliesTable,
+			Columns: []string{comment.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Comment{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{comment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// CommentMention is the model entity for the CommentMention schema.
+type CommentMention struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the mention.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The subject of the mentioned user.
+	Subject string `json:"subject,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CommentMentionQuery when eager-loading is set.
+	Edges             CommentMentionEdges `json:"edges"`
+	comment_mentioned *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// CommentMentionEdges holds the relations/edges for other nodes in the graph.
+type CommentMentionEdges struct {
+	// The comment with the mention.
+	Comment *Comment `json:"comment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CommentOrErr returns the Comment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CommentMentionEdges) CommentOrErr() (*Comment, error) {
+	if e.Comment != nil {
+		return e.Comment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: comment.Label}
+	}
+	return nil, &NotLoadedError{edge: "comment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentMention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentmention.FieldSubject, commentmention.FieldCreatedBy, commentmention.FieldUpdatedBy, commentmention.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case commentmention.FieldCreatedAt, commentmention.FieldUpdatedAt, commentmention.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case commentmention.FieldID:
+			values[i] = new(uuid.UUID)
+		case commentmention.ForeignKeys[0]: // comment_mentioned
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentMention fields.
+func (cm *CommentMention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentmention.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				cm.ID = *value
+			}
+		case commentmention.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				cm.Subject = value.String
+			}
+		case commentmention.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				cm.CreatedBy = value.String
+			}
+		case commentmention.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cm.CreatedAt = value.Time
+			}
+		case commentmention.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				cm.UpdatedBy = value.String
+			}
+		case commentmention.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				cm.UpdatedAt = value.Time
+			}
+		case commentmention.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				cm.DeletedBy = value.String
+			}
+		case commentmention.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				cm.DeletedAt = new(time.Time)
+				*cm.DeletedAt = value.Time
+			}
+		case commentmention.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_mentioned", values[i])
+			} else if value.Valid {
+				cm.comment_mentioned = new(uuid.UUID)
+				*cm.comment_mentioned = *value.S.(*uuid.UUID)
+			}
+		default:
+			cm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentMention.
+// This includes values selected through modifiers, order, etc.
+func (cm *CommentMention) Value(name string) (ent.Value, error) {
+	return cm.selectValues.Get(name)
+}
+
+// QueryComment queries the "comment" edge of the CommentMention entity.
+func (cm *CommentMention) QueryComment() *CommentQuery {
+	return NewCommentMentionClient(cm.config).QueryComment(cm)
+}
+
+// Update returns a builder for updating this CommentMention.
+// Note that you need to call CommentMention.Unwrap() before calling this method if this CommentMention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cm *CommentMention) Update() *CommentMentionUpdateOne {
+	return NewCommentMentionClient(cm.config).UpdateOne(cm)
+}
+
+// Unwrap unwraps the CommentMention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cm *CommentMention) Unwrap() *CommentMention {
+	_tx, ok := cm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentMention is not a transactional entity")
+	}
+	cm.config.driver = _tx.drv
+	return cm
+}
+
+// String implements the fmt.Stringer.
+func (cm *CommentMention) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentMention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cm.ID))
+	builder.WriteString("subject=")
+	builder.WriteString(cm.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(cm.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(cm.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(cm.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(cm.DeletedBy)
+	builder.WriteString(", ")
+	if v := cm.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentMentions is a parsable slice of CommentMention.
+type CommentMentions []*CommentMention
@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// CommentMentionCreate is the builder for creating a CommentMention entity.
+type CommentMentionCreate struct {
+	config
+	mutation *CommentMentionMutation
+	hooks    []Hook
+}
+
+// SetSubject sets the "subject" field.
+func (cmc *CommentMentionCreate) SetSubject(s string) *CommentMentionCreate {
+	cmc.mutation.SetSubject(s)
+	return cmc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cmc *CommentMentionCreate) SetCreatedBy(s string) *CommentMentionCreate {
+	cmc.mutation.SetCreatedBy(s)
+	return cmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cmc *CommentMentionCreate) SetCreatedAt(t time.Time) *CommentMentionCreate {
+	cmc.mutation.SetCreatedAt(t)
+	return cmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableCreatedAt(t *time.Time) *CommentMentionCreate {
+	if t != nil {
+		cmc.SetCreatedAt(*t)
+	}
+	return cmc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cmc *CommentMentionCreate) SetUpdatedBy(s string) *CommentMentionCreate {
+	cmc.mutation.SetUpdatedBy(s)
+	return cmc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmc *CommentMentionCreate) SetUpdatedAt(t time.Time) *CommentMentionCreate {
+	cmc.mutation.SetUpdatedAt(t)
+	return cmc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableUpdatedAt(t *time.Time) *CommentMentionCreate {
+	if t != nil {
+		cmc.SetUpdatedAt(*t)
+	}
+	return cmc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cmc *CommentMentionCreate) SetDeletedBy(s string) *CommentMentionCreate {
+	cmc.mutation.SetDeletedBy(s)
+	return cmc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableDeletedBy(s *string) *CommentMentionCreate {
+	if s != nil {
+		cmc.SetDeletedBy(*s)
+	}
+	return cmc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmc *CommentMentionCreate) SetDeletedAt(t time.Time) *CommentMentionCreate {
+	cmc.mutation.SetDeletedAt(t)
+	return cmc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableDeletedAt(t *time.Time) *CommentMentionCreate {
+	if t != nil {
+		cmc.SetDeletedAt(*t)
+	}
+	return cmc
+}
+
+// SetID sets the "id" field.
+func (cmc *CommentMentionCreate) SetID(u uuid.UUID) *CommentMentionCreate {
+	cmc.mutation.SetID(u)
+	return cmc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableID(u *uuid.UUID) *CommentMentionCreate {
+	if u != nil {
+		cmc.SetID(*u)
+	}
+	return cmc
+}
+
+// SetCommentID sets the "comment" edge to the Comment entity by ID.
+func (cmc *CommentMentionCreate) SetCommentID(id uuid.UUID) *CommentMentionCreate {
+	cmc.mutation.SetCommentID(id)
+	return cmc
+}
+
+// SetComment sets the "comment" edge to the Comment entity.
+func (cmc *CommentMentionCreate) SetComment(c *Comment) *CommentMentionCreate {
+	return cmc.SetCommentID(c.ID)
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmc *CommentMentionCreate) Mutation() *CommentMentionMutation {
+	return cmc.mutation
+}
+
+// Save creates the CommentMention in the database.
+func (cmc *CommentMentionCreate) Save(ctx context.Context) (*CommentMention, error) {
+	cmc.defaults()
+	return withHooks(ctx, cmc.sqlSave, cmc.mutation, cmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cmc *CommentMentionCreate) SaveX(ctx context.Context) *CommentMention {
+	v, err := cmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmc *CommentMentionCreate) Exec(ctx context.Context) error {
+	_, err := cmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmc *CommentMentionCreate) ExecX(ctx context.Context) {
+	if err := cmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmc *CommentMentionCreate) defaults() {
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		v := commentmention.DefaultCreatedAt()
+		cmc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cmc.mutation.UpdatedAt(); !ok {
+		v := commentmention.DefaultUpdatedAt()
+		cmc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := cmc.mutation.ID(); !ok {
+		v := commentmention.DefaultID()
+		cmc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmc *CommentMentionCreate) check() error {
+	if _, ok := cmc.mutation.Subject(); !ok {
+		return &ValidationError{Name: "subject", err: errors.New(`ent: missing required field "CommentMention.subject"`)}
+	}
+	if v, ok := cmc.mutation.Subject(); ok {
+		if err := commentmention.SubjectValidator(v); err != nil {
+			return &ValidationError{Name: "subject", err: fmt.Errorf(`ent: validator failed for field "CommentMention.subject": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "CommentMention.created_by"`)}
+	}
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentMention.created_at"`)}
+	}
+	if _, ok := cmc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "CommentMention.updated_by"`)}
+	}
+	if _, ok := cmc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CommentMention.updated_at"`)}
+	}
+	if len(cmc.mutation.CommentIDs()) == 0 {
+		return &ValidationError{Name: "comment", err: errors.New(`ent: missing required edge "CommentMention.comment"`)}
+	}
+	return nil
+}
+
+func (cmc *CommentMentionCreate) sqlSave(ctx context.Context) (*CommentMention, error) {
+	if err := cmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	cmc.mutation.id = &_node.ID
+	cmc.mutation.done = true
+	return _node, nil
+}
+
+func (cmc *CommentMentionCreate) createSpec() (*CommentMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentMention{config: cmc.config}
+		_spec = sqlgraph.NewCreateSpec(commentmention.Table, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID))
+	)
+	if id, ok := cmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := cmc.mutation.Subject(); ok {
+		_spec.SetField(commentmention.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := cmc.mutation.CreatedBy(); ok {
+		_spec.SetField(commentmention.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := cmc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentmention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cmc.mutation.UpdatedBy(); ok {
+		_spec.SetField(commentmention.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := cmc.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentmention.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := cmc.mutation.DeletedBy(); ok {
+		_spec.SetField(commentmention.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := cmc.mutation.DeletedAt(); ok {
+		_spec.SetField(commentmention.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := cmc.mutation.CommentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   commentmention.CommentTable,
+			Columns: []string{commentmention.CommentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.comment_mentioned = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CommentMentionCreateBulk is the builder for creating many CommentMention entities in bulk.
+type CommentMentionCreateBulk struct {
+	config
+	err      error
+	builders []*CommentMentionCreate
+}
+
+// Save creates the CommentMention entities in the database.
+func (cmcb *CommentMentionCreateBulk) Save(ctx context.Context) ([]*CommentMention, error) {
+	if cmcb.err != nil {
+		return nil, cmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cmcb.builders))
+	nodes := make([]*CommentMention, len(cmcb.builders))
+	mutators := make([]Mutator, len(cmcb.builders))
+	for i := range cmcb.builders {
+		func(i int, root context.Context) {
+			builder := cmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmcb *CommentMentionCreateBulk) SaveX(ctx context.Context) []*CommentMention {
+	v, err := cmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmcb *CommentMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmcb *CommentMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := cmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
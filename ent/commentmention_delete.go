@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentMentionDelete is the builder for deleting a CommentMention entity.
+type CommentMentionDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommentMentionMutation
+}
+
+// Where appends a list predicates to the CommentMentionDelete builder.
+func (cmd *CommentMentionDelete) Where(ps ...predicate.CommentMention) *CommentMentionDelete {
+	cmd.mutation.Where(ps...)
+	return cmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cmd *CommentMentionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmd.sqlExec, cmd.mutation, cmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmd *CommentMentionDelete) ExecX(ctx context.Context) int {
+	n, err := cmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cmd *CommentMentionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commentmention.Table, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID))
+	if ps := cmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cmd.mutation.done = true
+	return affected, err
+}
+
+// CommentMentionDeleteOne is the builder for deleting a single CommentMention entity.
+type CommentMentionDeleteOne struct {
+	cmd *CommentMentionDelete
+}
+
+// Where appends a list predicates to the CommentMentionDelete builder.
+func (cmdo *CommentMentionDeleteOne) Where(ps ...predicate.CommentMention) *CommentMentionDeleteOne {
+	cmdo.cmd.mutation.Where(ps...)
+	return cmdo
+}
+
+// Exec executes the deletion query.
+func (cmdo *CommentMentionDeleteOne) Exec(ctx context.Context) error {
+	n, err := cmdo.cmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commentmention.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmdo *CommentMentionDeleteOne) ExecX(ctx context.Context) {
+	if err := cmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentMentionUpdate is the builder for updating CommentMention entities.
+type CommentMentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommentMentionMutation
+}
+
+// Where appends a list predicates to the CommentMentionUpdate builder.
+func (cmu *CommentMentionUpdate) Where(ps ...predicate.CommentMention) *CommentMentionUpdate {
+	cmu.mutation.Where(ps...)
+	return cmu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cmu *CommentMentionUpdate) SetCreatedBy(s string) *CommentMentionUpdate {
+	cmu.mutation.SetCreatedBy(s)
+	return cmu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableCreatedBy(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetCreatedBy(*s)
+	}
+	return cmu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cmu *CommentMentionUpdate) SetUpdatedBy(s string) *CommentMentionUpdate {
+	cmu.mutation.SetUpdatedBy(s)
+	return cmu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableUpdatedBy(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetUpdatedBy(*s)
+	}
+	return cmu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmu *CommentMentionUpdate) SetUpdatedAt(t time.Time) *CommentMentionUpdate {
+	cmu.mutation.SetUpdatedAt(t)
+	return cmu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cmu *CommentMentionUpdate) SetDeletedBy(s string) *CommentMentionUpdate {
+	cmu.mutation.SetDeletedBy(s)
+	return cmu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableDeletedBy(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetDeletedBy(*s)
+	}
+	return cmu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cmu *CommentMentionUpdate) ClearDeletedBy() *CommentMentionUpdate {
+	cmu.mutation.ClearDeletedBy()
+	return cmu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmu *CommentMentionUpdate) SetDeletedAt(t time.Time) *CommentMentionUpdate {
+	cmu.mutation.SetDeletedAt(t)
+	return cmu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableDeletedAt(t *time.Time) *CommentMentionUpdate {
+	if t != nil {
+		cmu.SetDeletedAt(*t)
+	}
+	return cmu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cmu *CommentMentionUpdate) ClearDeletedAt() *CommentMentionUpdate {
+	cmu.mutation.ClearDeletedAt()
+	return cmu
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmu *CommentMentionUpdate) Mutation() *CommentMentionMutation {
+	return cmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cmu *CommentMentionUpdate) Save(ctx context.Context) (int, error) {
+	cmu.defaults()
+	return withHooks(ctx, cmu.sqlSave, cmu.mutation, cmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmu *CommentMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cmu *CommentMentionUpdate) Exec(ctx context.Context) error {
+	_, err := cmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmu *CommentMentionUpdate) ExecX(ctx context.Context) {
+	if err := cmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmu *CommentMentionUpdate) defaults() {
+	if _, ok := cmu.mutation.UpdatedAt(); !ok {
+		v := commentmention.UpdateDefaultUpdatedAt()
+		cmu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmu *CommentMentionUpdate) check() error {
+	if cmu.mutation.CommentCleared() && len(cmu.mutation.CommentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CommentMention.comment"`)
+	}
+	return nil
+}
+
+func (cmu *CommentMentionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentmention.Table, commentmention.Columns, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID))
+	if ps := cmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmu.mutation.CreatedBy(); ok {
+		_spec.SetField(commentmention.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.UpdatedBy(); ok {
+		_spec.SetField(commentmention.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentmention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cmu.mutation.DeletedBy(); ok {
+		_spec.SetField(commentmention.FieldDeletedBy, field.TypeString, value)
+	}
+	if cmu.mutation.DeletedByCleared() {
+		_spec.ClearField(commentmention.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := cmu.mutation.DeletedAt(); ok {
+		_spec.SetField(commentmention.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cmu.mutation.DeletedAtCleared() {
+		_spec.ClearField(commentmention.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cmu.mutation.done = true
+	return n, nil
+}
+
+// CommentMentionUpdateOne is the builder for updating a single CommentMention entity.
+type CommentMentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommentMentionMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (cmuo *CommentMentionUpdateOne) SetCreatedBy(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetCreatedBy(s)
+	return cmuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableCreatedBy(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetCreatedBy(*s)
+	}
+	return cmuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (cmuo *CommentMentionUpdateOne) SetUpdatedBy(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetUpdatedBy(s)
+	return cmuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableUpdatedBy(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetUpdatedBy(*s)
+	}
+	return cmuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cmuo *CommentMentionUpdateOne) SetUpdatedAt(t time.Time) *CommentMentionUpdateOne {
+	cmuo.mutation.SetUpdatedAt(t)
+	return cmuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (cmuo *CommentMentionUpdateOne) SetDeletedBy(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetDeletedBy(s)
+	return cmuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableDeletedBy(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetDeletedBy(*s)
+	}
+	return cmuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (cmuo *CommentMentionUpdateOne) ClearDeletedBy() *CommentMentionUpdateOne {
+	cmuo.mutation.ClearDeletedBy()
+	return cmuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cmuo *CommentMentionUpdateOne) SetDeletedAt(t time.Time) *CommentMentionUpdateOne {
+	cmuo.mutation.SetDeletedAt(t)
+	return cmuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentMentionUpdateOne {
+	if t != nil {
+		cmuo.SetDeletedAt(*t)
+	}
+	return cmuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cmuo *CommentMentionUpdateOne) ClearDeletedAt() *CommentMentionUpdateOne {
+	cmuo.mutation.ClearDeletedAt()
+	return cmuo
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmuo *CommentMentionUpdateOne) Mutation() *CommentMentionMutation {
+	return cmuo.mutation
+}
+
+// Where appends a list predicates to the CommentMentionUpdate builder.
+func (cmuo *CommentMentionUpdateOne) Where(ps ...predicate.CommentMention) *CommentMentionUpdateOne {
+	cmuo.mutation.Where(ps...)
+	return cmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cmuo *CommentMentionUpdateOne) Select(field string, fields ...string) *CommentMentionUpdateOne {
+	cmuo.fields = append([]string{field}, fields...)
+	return cmuo
+}
+
+// Save executes the query and returns the updated CommentMention entity.
+func (cmuo *CommentMentionUpdateOne) Save(ctx context.Context) (*CommentMention, error) {
+	cmuo.defaults()
+	return withHooks(ctx, cmuo.sqlSave, cmuo.mutation, cmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmuo *CommentMentionUpdateOne) SaveX(ctx context.Context) *CommentMention {
+	node, err := cmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cmuo *CommentMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmuo *CommentMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := cmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmuo *CommentMentionUpdateOne) defaults() {
+	if _, ok := cmuo.mutation.UpdatedAt(); !ok {
+		v := commentmention.UpdateDefaultUpdatedAt()
+		cmuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmuo *CommentMentionUpdateOne) check() error {
+	if cmuo.mutation.CommentCleared() && len(cmuo.mutation.CommentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CommentMention.comment"`)
+	}
+	return nil
+}
+
+func (cmuo *CommentMentionUpdateOne) sqlSave(ctx context.Context) (_node *CommentMention, err error) {
+	if err := cmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentmention.Table, commentmention.Columns, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeUUID))
+	id, ok := cmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommentMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentmention.FieldID)
+		for _, f := range fields {
+			if !commentmention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commentmention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmuo.mutation.CreatedBy(); ok {
+		_spec.SetField(commentmention.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(commentmention.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(commentmention.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cmuo.mutation.DeletedBy(); ok {
+		_spec.SetField(commentmention.FieldDeletedBy, field.TypeString, value)
+	}
+	if cmuo.mutation.DeletedByCleared() {
+		_spec.ClearField(commentmention.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := cmuo.mutation.DeletedAt(); ok {
+		_spec.SetField(commentmention.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cmuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(commentmention.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &CommentMention{config: cmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cmuo.mutation.done = true
+	return _node, nil
+}
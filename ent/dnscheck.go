@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DnsCheck is the model entity for the DnsCheck schema.
+type DnsCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the check.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The fully qualified name of the record that was resolved.
+	RecordName string `json:"record_name,omitempty"`
+	// The type of the record that was resolved.
+	RecordType dnscheck.RecordType `json:"record_type,omitempty"`
+	// The recorded value of the record in its presentation format, e.g. `10 mail.example.org` for MX records.
+	Expected string `json:"expected,omitempty"`
+	// The result of the check. A mismatch means the recorded value is not among the answers, a dangling CNAME points to a name that does not resolve or to a decommissioned server. Resolvers that disagree result in the worst status.
+	Status dnscheck.Status `json:"status,omitempty"`
+	// The answers of every resolver that was queried.
+	Answers []schema.DnsAnswer `json:"answers,omitempty"`
+	// Why the check did not succeed.
+	Message string `json:"message,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DnsCheckQuery when eager-loading is set.
+	Edges          DnsCheckEdges `json:"edges"`
+	dns_check_item *uuid.UUID
+	selectValues   sql.SelectValues
+}
+
+// DnsCheckEdges holds the relations/edges for other nodes in the graph.
+type DnsCheckEdges struct {
+	// The DNS record item that was checked.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DnsCheckEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DnsCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dnscheck.FieldAnswers:
+			values[i] = new([]byte)
+		case dnscheck.FieldRecordName, dnscheck.FieldRecordType, dnscheck.FieldExpected, dnscheck.FieldStatus, dnscheck.FieldMessage, dnscheck.FieldCreatedBy, dnscheck.FieldUpdatedBy, dnscheck.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case dnscheck.FieldCreatedAt, dnscheck.FieldUpdatedAt, dnscheck.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case dnscheck.FieldID:
+			values[i] = new(uuid.UUID)
+		case dnscheck.ForeignKeys[0]: // dns_check_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DnsCheck fields.
+func (dc *DnsCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dnscheck.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dc.ID = *value
+			}
+		case dnscheck.FieldRecordName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field record_name", values[i])
+			} else if value.Valid {
+				dc.RecordName = value.String
+			}
+		case dnscheck.FieldRecordType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field record_type", values[i])
+			} else if value.Valid {
+				dc.RecordType = dnscheck.RecordType(value.String)
+			}
+		case dnscheck.FieldExpected:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expected", values[i])
+			} else if value.Valid {
+				dc.Expected = value.String
+			}
+		case dnscheck.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dc.Status = dnscheck.Status(value.String)
+			}
+		case dnscheck.FieldAnswers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field answers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dc.Answers); err != nil {
+					return fmt.Errorf("unmarshal field answers: %w", err)
+				}
+			}
+		case dnscheck.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				dc.Message = value.String
+			}
+		case dnscheck.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				dc.CreatedBy = value.String
+			}
+		case dnscheck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dc.CreatedAt = value.Time
+			}
+		case dnscheck.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				dc.UpdatedBy = value.String
+			}
+		case dnscheck.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dc.UpdatedAt = value.Time
+			}
+		case dnscheck.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				dc.DeletedBy = value.String
+			}
+		case dnscheck.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dc.DeletedAt = new(time.Time)
+				*dc.DeletedAt = value.Time
+			}
+		case dnscheck.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field dns_check_item", values[i])
+			} else if value.Valid {
+				dc.dns_check_item = new(uuid.UUID)
+				*dc.dns_check_item = *value.S.(*uuid.UUID)
+			}
+		default:
+			dc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DnsCheck.
+// This includes values selected through modifiers, order, etc.
+func (dc *DnsCheck) Value(name string) (ent.Value, error) {
+	return dc.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the DnsCheck entity.
+func (dc *DnsCheck) QueryItem() *ItemQuery {
+	return NewDnsCheckClient(dc.config).QueryItem(dc)
+}
+
+// Update returns a builder for updating this DnsCheck.
+// Note that you need to call DnsCheck.Unwrap() before calling this method if this DnsCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dc *DnsCheck) Update() *DnsCheckUpdateOne {
+	return NewDnsCheckClient(dc.config).UpdateOne(dc)
+}
+
+// Unwrap unwraps the DnsCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dc *DnsCheck) Unwrap() *DnsCheck {
+	_tx, ok := dc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DnsCheck is not a transactional entity")
+	}
+	dc.config.driver = _tx.drv
+	return dc
+}
+
+// String implements the fmt.Stringer.
+func (dc *DnsCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("DnsCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dc.ID))
+	builder.WriteString("record_name=")
+	builder.WriteString(dc.RecordName)
+	builder.WriteString(", ")
+	builder.WriteString("record_type=")
+	builder.WriteString(fmt.Sprintf("%v", dc.RecordType))
+	builder.WriteString(", ")
+	builder.WriteString("expected=")
+	builder.WriteString(dc.Expected)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Status))
+	builder.WriteString(", ")
+	builder.WriteString("answers=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Answers))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(dc.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(dc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(dc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(dc.DeletedBy)
+	builder.WriteString(", ")
+	if v := dc.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DnsChecks is a parsable slice of DnsCheck.
+type DnsChecks []*DnsCheck
@@ -0,0 +1,740 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dnscheck
+
+import (
+	"dig-inv/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldID, id))
+}
+
+// RecordName applies equality check predicate on the "record_name" field. It's identical to RecordNameEQ.
+func RecordName(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldRecordName, v))
+}
+
+// Expected applies equality check predicate on the "expected" field. It's identical to ExpectedEQ.
+func Expected(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldExpected, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// RecordNameEQ applies the EQ predicate on the "record_name" field.
+func RecordNameEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldRecordName, v))
+}
+
+// RecordNameNEQ applies the NEQ predicate on the "record_name" field.
+func RecordNameNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldRecordName, v))
+}
+
+// RecordNameIn applies the In predicate on the "record_name" field.
+func RecordNameIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldRecordName, vs...))
+}
+
+// RecordNameNotIn applies the NotIn predicate on the "record_name" field.
+func RecordNameNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldRecordName, vs...))
+}
+
+// RecordNameGT applies the GT predicate on the "record_name" field.
+func RecordNameGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldRecordName, v))
+}
+
+// RecordNameGTE applies the GTE predicate on the "record_name" field.
+func RecordNameGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldRecordName, v))
+}
+
+// RecordNameLT applies the LT predicate on the "record_name" field.
+func RecordNameLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldRecordName, v))
+}
+
+// RecordNameLTE applies the LTE predicate on the "record_name" field.
+func RecordNameLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldRecordName, v))
+}
+
+// RecordNameContains applies the Contains predicate on the "record_name" field.
+func RecordNameContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldRecordName, v))
+}
+
+// RecordNameHasPrefix applies the HasPrefix predicate on the "record_name" field.
+func RecordNameHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldRecordName, v))
+}
+
+// RecordNameHasSuffix applies the HasSuffix predicate on the "record_name" field.
+func RecordNameHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldRecordName, v))
+}
+
+// RecordNameEqualFold applies the EqualFold predicate on the "record_name" field.
+func RecordNameEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldRecordName, v))
+}
+
+// RecordNameContainsFold applies the ContainsFold predicate on the "record_name" field.
+func RecordNameContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldRecordName, v))
+}
+
+// RecordTypeEQ applies the EQ predicate on the "record_type" field.
+func RecordTypeEQ(v RecordType) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldRecordType, v))
+}
+
+// RecordTypeNEQ applies the NEQ predicate on the "record_type" field.
+func RecordTypeNEQ(v RecordType) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldRecordType, v))
+}
+
+// RecordTypeIn applies the In predicate on the "record_type" field.
+func RecordTypeIn(vs ...RecordType) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldRecordType, vs...))
+}
+
+// RecordTypeNotIn applies the NotIn predicate on the "record_type" field.
+func RecordTypeNotIn(vs ...RecordType) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldRecordType, vs...))
+}
+
+// ExpectedEQ applies the EQ predicate on the "expected" field.
+func ExpectedEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldExpected, v))
+}
+
+// ExpectedNEQ applies the NEQ predicate on the "expected" field.
+func ExpectedNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldExpected, v))
+}
+
+// ExpectedIn applies the In predicate on the "expected" field.
+func ExpectedIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldExpected, vs...))
+}
+
+// ExpectedNotIn applies the NotIn predicate on the "expected" field.
+func ExpectedNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldExpected, vs...))
+}
+
+// ExpectedGT applies the GT predicate on the "expected" field.
+func ExpectedGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldExpected, v))
+}
+
+// ExpectedGTE applies the GTE predicate on the "expected" field.
+func ExpectedGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldExpected, v))
+}
+
+// ExpectedLT applies the LT predicate on the "expected" field.
+func ExpectedLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldExpected, v))
+}
+
+// ExpectedLTE applies the LTE predicate on the "expected" field.
+func ExpectedLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldExpected, v))
+}
+
+// ExpectedContains applies the Contains predicate on the "expected" field.
+func ExpectedContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldExpected, v))
+}
+
+// ExpectedHasPrefix applies the HasPrefix predicate on the "expected" field.
+func ExpectedHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldExpected, v))
+}
+
+// ExpectedHasSuffix applies the HasSuffix predicate on the "expected" field.
+func ExpectedHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldExpected, v))
+}
+
+// ExpectedIsNil applies the IsNil predicate on the "expected" field.
+func ExpectedIsNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIsNull(FieldExpected))
+}
+
+// ExpectedNotNil applies the NotNil predicate on the "expected" field.
+func ExpectedNotNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotNull(FieldExpected))
+}
+
+// ExpectedEqualFold applies the EqualFold predicate on the "expected" field.
+func ExpectedEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldExpected, v))
+}
+
+// ExpectedContainsFold applies the ContainsFold predicate on the "expected" field.
+func ExpectedContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldExpected, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AnswersIsNil applies the IsNil predicate on the "answers" field.
+func AnswersIsNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIsNull(FieldAnswers))
+}
+
+// AnswersNotNil applies the NotNil predicate on the "answers" field.
+func AnswersNotNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotNull(FieldAnswers))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DnsCheck {
+	return predicate.DnsCheck(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.DnsCheck {
+	return predicate.DnsCheck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.DnsCheck {
+	return predicate.DnsCheck(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DnsCheck) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DnsCheck) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DnsCheck) predicate.DnsCheck {
+	return predicate.DnsCheck(sql.NotPredicates(p))
+}
@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DnsCheckCreate is the builder for creating a DnsCheck entity.
+type DnsCheckCreate struct {
+	config
+	mutation *DnsCheckMutation
+	hooks    []Hook
+}
+
+// SetRecordName sets the "record_name" field.
+func (dcc *DnsCheckCreate) SetRecordName(s string) *DnsCheckCreate {
+	dcc.mutation.SetRecordName(s)
+	return dcc
+}
+
+// SetRecordType sets the "record_type" field.
+func (dcc *DnsCheckCreate) SetRecordType(dt dnscheck.RecordType) *DnsCheckCreate {
+	dcc.mutation.SetRecordType(dt)
+	return dcc
+}
+
+// SetExpected sets the "expected" field.
+func (dcc *DnsCheckCreate) SetExpected(s string) *DnsCheckCreate {
+	dcc.mutation.SetExpected(s)
+	return dcc
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableExpected(s *string) *DnsCheckCreate {
+	if s != nil {
+		dcc.SetExpected(*s)
+	}
+	return dcc
+}
+
+// SetStatus sets the "status" field.
+func (dcc *DnsCheckCreate) SetStatus(d dnscheck.Status) *DnsCheckCreate {
+	dcc.mutation.SetStatus(d)
+	return dcc
+}
+
+// SetAnswers sets the "answers" field.
+func (dcc *DnsCheckCreate) SetAnswers(sa []schema.DnsAnswer) *DnsCheckCreate {
+	dcc.mutation.SetAnswers(sa)
+	return dcc
+}
+
+// SetMessage sets the "message" field.
+func (dcc *DnsCheckCreate) SetMessage(s string) *DnsCheckCreate {
+	dcc.mutation.SetMessage(s)
+	return dcc
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableMessage(s *string) *DnsCheckCreate {
+	if s != nil {
+		dcc.SetMessage(*s)
+	}
+	return dcc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dcc *DnsCheckCreate) SetCreatedBy(s string) *DnsCheckCreate {
+	dcc.mutation.SetCreatedBy(s)
+	return dcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dcc *DnsCheckCreate) SetCreatedAt(t time.Time) *DnsCheckCreate {
+	dcc.mutation.SetCreatedAt(t)
+	return dcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableCreatedAt(t *time.Time) *DnsCheckCreate {
+	if t != nil {
+		dcc.SetCreatedAt(*t)
+	}
+	return dcc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dcc *DnsCheckCreate) SetUpdatedBy(s string) *DnsCheckCreate {
+	dcc.mutation.SetUpdatedBy(s)
+	return dcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcc *DnsCheckCreate) SetUpdatedAt(t time.Time) *DnsCheckCreate {
+	dcc.mutation.SetUpdatedAt(t)
+	return dcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableUpdatedAt(t *time.Time) *DnsCheckCreate {
+	if t != nil {
+		dcc.SetUpdatedAt(*t)
+	}
+	return dcc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dcc *DnsCheckCreate) SetDeletedBy(s string) *DnsCheckCreate {
+	dcc.mutation.SetDeletedBy(s)
+	return dcc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableDeletedBy(s *string) *DnsCheckCreate {
+	if s != nil {
+		dcc.SetDeletedBy(*s)
+	}
+	return dcc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dcc *DnsCheckCreate) SetDeletedAt(t time.Time) *DnsCheckCreate {
+	dcc.mutation.SetDeletedAt(t)
+	return dcc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableDeletedAt(t *time.Time) *DnsCheckCreate {
+	if t != nil {
+		dcc.SetDeletedAt(*t)
+	}
+	return dcc
+}
+
+// SetID sets the "id" field.
+func (dcc *DnsCheckCreate) SetID(u uuid.UUID) *DnsCheckCreate {
+	dcc.mutation.SetID(u)
+	return dcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dcc *DnsCheckCreate) SetNillableID(u *uuid.UUID) *DnsCheckCreate {
+	if u != nil {
+		dcc.SetID(*u)
+	}
+	return dcc
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (dcc *DnsCheckCreate) SetItemID(id uuid.UUID) *DnsCheckCreate {
+	dcc.mutation.SetItemID(id)
+	return dcc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (dcc *DnsCheckCreate) SetItem(i *Item) *DnsCheckCreate {
+	return dcc.SetItemID(i.ID)
+}
+
+// Mutation returns the DnsCheckMutation object of the builder.
+func (dcc *DnsCheckCreate) Mutation() *DnsCheckMutation {
+	return dcc.mutation
+}
+
+// Save creates the DnsCheck in the database.
+func (dcc *DnsCheckCreate) Save(ctx context.Context) (*DnsCheck, error) {
+	dcc.defaults()
+	return withHooks(ctx, dcc.sqlSave, dcc.mutation, dcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dcc *DnsCheckCreate) SaveX(ctx context.Context) *DnsCheck {
+	v, err := dcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcc *DnsCheckCreate) Exec(ctx context.Context) error {
+	_, err := dcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcc *DnsCheckCreate) ExecX(ctx context.Context) {
+	if err := dcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcc *DnsCheckCreate) defaults() {
+	if _, ok := dcc.mutation.CreatedAt(); !ok {
+		v := dnscheck.DefaultCreatedAt()
+		dcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dcc.mutation.UpdatedAt(); !ok {
+		v := dnscheck.DefaultUpdatedAt()
+		dcc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dcc.mutation.ID(); !ok {
+		v := dnscheck.DefaultID()
+		dcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcc *DnsCheckCreate) check() error {
+	if _, ok := dcc.mutation.RecordName(); !ok {
+		return &ValidationError{Name: "record_name", err: errors.New(`ent: missing required field "DnsCheck.record_name"`)}
+	}
+	if v, ok := dcc.mutation.RecordName(); ok {
+		if err := dnscheck.RecordNameValidator(v); err != nil {
+			return &ValidationError{Name: "record_name", err: fmt.Errorf(`ent: validator failed for field "DnsCheck.record_name": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.RecordType(); !ok {
+		return &ValidationError{Name: "record_type", err: errors.New(`ent: missing required field "DnsCheck.record_type"`)}
+	}
+	if v, ok := dcc.mutation.RecordType(); ok {
+		if err := dnscheck.RecordTypeValidator(v); err != nil {
+			return &ValidationError{Name: "record_type", err: fmt.Errorf(`ent: validator failed for field "DnsCheck.record_type": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DnsCheck.status"`)}
+	}
+	if v, ok := dcc.mutation.Status(); ok {
+		if err := dnscheck.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DnsCheck.status": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "DnsCheck.created_by"`)}
+	}
+	if _, ok := dcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DnsCheck.created_at"`)}
+	}
+	if _, ok := dcc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "DnsCheck.updated_by"`)}
+	}
+	if _, ok := dcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DnsCheck.updated_at"`)}
+	}
+	if len(dcc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "DnsCheck.item"`)}
+	}
+	return nil
+}
+
+func (dcc *DnsCheckCreate) sqlSave(ctx context.Context) (*DnsCheck, error) {
+	if err := dcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dcc.mutation.id = &_node.ID
+	dcc.mutation.done = true
+	return _node, nil
+}
+
+func (dcc *DnsCheckCreate) createSpec() (*DnsCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DnsCheck{config: dcc.config}
+		_spec = sqlgraph.NewCreateSpec(dnscheck.Table, sqlgraph.NewFieldSpec(dnscheck.FieldID, field.TypeUUID))
+	)
+	if id, ok := dcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dcc.mutation.RecordName(); ok {
+		_spec.SetField(dnscheck.FieldRecordName, field.TypeString, value)
+		_node.RecordName = value
+	}
+	if value, ok := dcc.mutation.RecordType(); ok {
+		_spec.SetField(dnscheck.FieldRecordType, field.TypeEnum, value)
+		_node.RecordType = value
+	}
+	if value, ok := dcc.mutation.Expected(); ok {
+		_spec.SetField(dnscheck.FieldExpected, field.TypeString, value)
+		_node.Expected = value
+	}
+	if value, ok := dcc.mutation.Status(); ok {
+		_spec.SetField(dnscheck.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := dcc.mutation.Answers(); ok {
+		_spec.SetField(dnscheck.FieldAnswers, field.TypeJSON, value)
+		_node.Answers = value
+	}
+	if value, ok := dcc.mutation.Message(); ok {
+		_spec.SetField(dnscheck.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := dcc.mutation.CreatedBy(); ok {
+		_spec.SetField(dnscheck.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := dcc.mutation.CreatedAt(); ok {
+		_spec.SetField(dnscheck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dcc.mutation.UpdatedBy(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := dcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dcc.mutation.DeletedBy(); ok {
+		_spec.SetField(dnscheck.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := dcc.mutation.DeletedAt(); ok {
+		_spec.SetField(dnscheck.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := dcc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dnscheck.ItemTable,
+			Columns: []string{dnscheck.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.dns_check_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DnsCheckCreateBulk is the builder for creating many DnsCheck entities in bulk.
+type DnsCheckCreateBulk struct {
+	config
+	err      error
+	builders []*DnsCheckCreate
+}
+
+// Save creates the DnsCheck entities in the database.
+func (dccb *DnsCheckCreateBulk) Save(ctx context.Context) ([]*DnsCheck, error) {
+	if dccb.err != nil {
+		return nil, dccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dccb.builders))
+	nodes := make([]*DnsCheck, len(dccb.builders))
+	mutators := make([]Mutator, len(dccb.builders))
+	for i := range dccb.builders {
+		func(i int, root context.Context) {
+			builder := dccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DnsCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dccb *DnsCheckCreateBulk) SaveX(ctx context.Context) []*DnsCheck {
+	v, err := dccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dccb *DnsCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := dccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dccb *DnsCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := dccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
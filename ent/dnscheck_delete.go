@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DnsCheckDelete is the builder for deleting a DnsCheck entity.
+type DnsCheckDelete struct {
+	config
+	hooks    []Hook
+	mutation *DnsCheckMutation
+}
+
+// Where appends a list predicates to the DnsCheckDelete builder.
+func (dcd *DnsCheckDelete) Where(ps ...predicate.DnsCheck) *DnsCheckDelete {
+	dcd.mutation.Where(ps...)
+	return dcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dcd *DnsCheckDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dcd.sqlExec, dcd.mutation, dcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcd *DnsCheckDelete) ExecX(ctx context.Context) int {
+	n, err := dcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dcd *DnsCheckDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dnscheck.Table, sqlgraph.NewFieldSpec(dnscheck.FieldID, field.TypeUUID))
+	if ps := dcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dcd.mutation.done = true
+	return affected, err
+}
+
+// DnsCheckDeleteOne is the builder for deleting a single DnsCheck entity.
+type DnsCheckDeleteOne struct {
+	dcd *DnsCheckDelete
+}
+
+// Where appends a list predicates to the DnsCheckDelete builder.
+func (dcdo *DnsCheckDeleteOne) Where(ps ...predicate.DnsCheck) *DnsCheckDeleteOne {
+	dcdo.dcd.mutation.Where(ps...)
+	return dcdo
+}
+
+// Exec executes the deletion query.
+func (dcdo *DnsCheckDeleteOne) Exec(ctx context.Context) error {
+	n, err := dcdo.dcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dnscheck.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcdo *DnsCheckDeleteOne) ExecX(ctx context.Context) {
+	if err := dcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DnsCheckQuery is the builder for querying DnsCheck entities.
+type DnsCheckQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dnscheck.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DnsCheck
+	withItem   *ItemQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DnsCheckQuery builder.
+func (dcq *DnsCheckQuery) Where(ps ...predicate.DnsCheck) *DnsCheckQuery {
+	dcq.predicates = append(dcq.predicates, ps...)
+	return dcq
+}
+
+// Limit the number of records to be returned by this query.
+func (dcq *DnsCheckQuery) Limit(limit int) *DnsCheckQuery {
+	dcq.ctx.Limit = &limit
+	return dcq
+}
+
+// Offset to start from.
+func (dcq *DnsCheckQuery) Offset(offset int) *DnsCheckQuery {
+	dcq.ctx.Offset = &offset
+	return dcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dcq *DnsCheckQuery) Unique(unique bool) *DnsCheckQuery {
+	dcq.ctx.Unique = &unique
+	return dcq
+}
+
+// Order specifies how the records should be ordered.
+func (dcq *DnsCheckQuery) Order(o ...dnscheck.OrderOption) *DnsCheckQuery {
+	dcq.order = append(dcq.order, o...)
+	return dcq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (dcq *DnsCheckQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: dcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dnscheck.Table, dnscheck.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, dnscheck.ItemTable, dnscheck.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DnsCheck entity from the query.
+// Returns a *NotFoundError when no DnsCheck was found.
+func (dcq *DnsCheckQuery) First(ctx context.Context) (*DnsCheck, error) {
+	nodes, err := dcq.Limit(1).All(setContextOp(ctx, dcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dnscheck.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dcq *DnsCheckQuery) FirstX(ctx context.Context) *DnsCheck {
+	node, err := dcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DnsCheck ID from the query.
+// Returns a *NotFoundError when no DnsCheck ID was found.
+func (dcq *DnsCheckQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dcq.Limit(1).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dnscheck.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dcq *DnsCheckQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DnsCheck entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DnsCheck entity is found.
+// Returns a *NotFoundError when no DnsCheck entities are found.
+func (dcq *DnsCheckQuery) Only(ctx context.Context) (*DnsCheck, error) {
+	nodes, err := dcq.Limit(2).All(setContextOp(ctx, dcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dnscheck.Label}
+	default:
+		return nil, &NotSingularError{dnscheck.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dcq *DnsCheckQuery) OnlyX(ctx context.Context) *DnsCheck {
+	node, err := dcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DnsCheck ID in the query.
+// Returns a *NotSingularError when more than one DnsCheck ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dcq *DnsCheckQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dcq.Limit(2).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dnscheck.Label}
+	default:
+		err = &NotSingularError{dnscheck.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dcq *DnsCheckQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DnsChecks.
+func (dcq *DnsCheckQuery) All(ctx context.Context) ([]*DnsCheck, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryAll)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DnsCheck, *DnsCheckQuery]()
+	return withInterceptors[[]*DnsCheck](ctx, dcq, qr, dcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dcq *DnsCheckQuery) AllX(ctx context.Context) []*DnsCheck {
+	nodes, err := dcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DnsCheck IDs.
+func (dcq *DnsCheckQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dcq.ctx.Unique == nil && dcq.path != nil {
+		dcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryIDs)
+	if err = dcq.Select(dnscheck.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dcq *DnsCheckQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dcq *DnsCheckQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryCount)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dcq, querierCount[*DnsCheckQuery](), dcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dcq *DnsCheckQuery) CountX(ctx context.Context) int {
+	count, err := dcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dcq *DnsCheckQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryExist)
+	switch _, err := dcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dcq *DnsCheckQuery) ExistX(ctx context.Context) bool {
+	exist, err := dcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DnsCheckQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dcq *DnsCheckQuery) Clone() *DnsCheckQuery {
+	if dcq == nil {
+		return nil
+	}
+	return &DnsCheckQuery{
+		config:     dcq.config,
+		ctx:        dcq.ctx.Clone(),
+		order:      append([]dnscheck.OrderOption{}, dcq.order...),
+		inters:     append([]Interceptor{}, dcq.inters...),
+		predicates: append([]predicate.DnsCheck{}, dcq.predicates...),
+		withItem:   dcq.withItem.Clone(),
+		// clone intermediate query.
+		sql:  dcq.sql.Clone(),
+		path: dcq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (dcq *DnsCheckQuery) WithItem(opts ...func(*ItemQuery)) *DnsCheckQuery {
+	query := (&ItemClient{config: dcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dcq.withItem = query
+	return dcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RecordName string `json:"record_name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DnsCheck.Query().
+//		GroupBy(dnscheck.FieldRecordName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dcq *DnsCheckQuery) GroupBy(field string, fields ...string) *DnsCheckGroupBy {
+	dcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DnsCheckGroupBy{build: dcq}
+	grbuild.flds = &dcq.ctx.Fields
+	grbuild.label = dnscheck.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RecordName string `json:"record_name,omitempty"`
+//	}
+//
+//	client.DnsCheck.Query().
+//		Select(dnscheck.FieldRecordName).
+//		Scan(ctx, &v)
+func (dcq *DnsCheckQuery) Select(fields ...string) *DnsCheckSelect {
+	dcq.ctx.Fields = append(dcq.ctx.Fields, fields...)
+	sbuild := &DnsCheckSelect{DnsCheckQuery: dcq}
+	sbuild.label = dnscheck.Label
+	sbuild.flds, sbuild.scan = &dcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DnsCheckSelect configured with the given aggregations.
+func (dcq *DnsCheckQuery) Aggregate(fns ...AggregateFunc) *DnsCheckSelect {
+	return dcq.Select().Aggregate(fns...)
+}
+
+func (dcq *DnsCheckQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dcq.ctx.Fields {
+		if !dnscheck.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dcq.path != nil {
+		prev, err := dcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dcq.sql = prev
+	}
+	return nil
+}
+
+func (dcq *DnsCheckQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DnsCheck, error) {
+	var (
+		nodes       = []*DnsCheck{}
+		withFKs     = dcq.withFKs
+		_spec       = dcq.querySpec()
+		loadedTypes = [1]bool{
+			dcq.withItem != nil,
+		}
+	)
+	if dcq.withItem != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, dnscheck.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DnsCheck).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DnsCheck{config: dcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dcq.withItem; query != nil {
+		if err := dcq.loadItem(ctx, query, nodes, nil,
+			func(n *DnsCheck, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dcq *DnsCheckQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*DnsCheck, init func(*DnsCheck), assign func(*DnsCheck, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DnsCheck)
+	for i := range nodes {
+		if nodes[i].dns_check_item == nil {
+			continue
+		}
+		fk := *nodes[i].dns_check_item
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "dns_check_item" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dcq *DnsCheckQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dcq.querySpec()
+	_spec.Node.Columns = dcq.ctx.Fields
+	if len(dcq.ctx.Fields) > 0 {
+		_spec.Unique = dcq.ctx.Unique != nil && *dcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dcq.driver, _spec)
+}
+
+func (dcq *DnsCheckQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dnscheck.Table, dnscheck.Columns, sqlgraph.NewFieldSpec(dnscheck.FieldID, field.TypeUUID))
+	_spec.From = dcq.sql
+	if unique := dcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dnscheck.FieldID)
+		for i := range fields {
+			if fields[i] != dnscheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dcq *DnsCheckQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dcq.driver.Dialect())
+	t1 := builder.Table(dnscheck.Table)
+	columns := dcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dnscheck.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dcq.sql != nil {
+		selector = dcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dcq.ctx.Unique != nil && *dcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dcq.predicates {
+		p(selector)
+	}
+	for _, p := range dcq.order {
+		p(selector)
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DnsCheckGroupBy is the group-by builder for DnsCheck entities.
+type DnsCheckGroupBy struct {
+	selector
+	build *DnsCheckQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dcgb *DnsCheckGroupBy) Aggregate(fns ...AggregateFunc) *DnsCheckGroupBy {
+	dcgb.fns = append(dcgb.fns, fns...)
+	return dcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcgb *DnsCheckGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DnsCheckQuery, *DnsCheckGroupBy](ctx, dcgb.build, dcgb, dcgb.build.inters, v)
+}
+
+func (dcgb *DnsCheckGroupBy) sqlScan(ctx context.Context, root *DnsCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dcgb.fns))
+	for _, fn := range dcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dcgb.flds)+len(dcgb.fns))
+		for _, f := range *dcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DnsCheckSelect is the builder for selecting fields of DnsCheck entities.
+type DnsCheckSelect struct {
+	*DnsCheckQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dcs *DnsCheckSelect) Aggregate(fns ...AggregateFunc) *DnsCheckSelect {
+	dcs.fns = append(dcs.fns, fns...)
+	return dcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcs *DnsCheckSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcs.ctx, ent.OpQuerySelect)
+	if err := dcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DnsCheckQuery, *DnsCheckSelect](ctx, dcs.DnsCheckQuery, dcs, dcs.inters, v)
+}
+
+func (dcs *DnsCheckSelect) sqlScan(ctx context.Context, root *DnsCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dcs.fns))
+	for _, fn := range dcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DnsCheckUpdate is the builder for updating DnsCheck entities.
+type DnsCheckUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DnsCheckMutation
+}
+
+// Where appends a list predicates to the DnsCheckUpdate builder.
+func (dcu *DnsCheckUpdate) Where(ps ...predicate.DnsCheck) *DnsCheckUpdate {
+	dcu.mutation.Where(ps...)
+	return dcu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dcu *DnsCheckUpdate) SetCreatedBy(s string) *DnsCheckUpdate {
+	dcu.mutation.SetCreatedBy(s)
+	return dcu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dcu *DnsCheckUpdate) SetNillableCreatedBy(s *string) *DnsCheckUpdate {
+	if s != nil {
+		dcu.SetCreatedBy(*s)
+	}
+	return dcu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dcu *DnsCheckUpdate) SetUpdatedBy(s string) *DnsCheckUpdate {
+	dcu.mutation.SetUpdatedBy(s)
+	return dcu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dcu *DnsCheckUpdate) SetNillableUpdatedBy(s *string) *DnsCheckUpdate {
+	if s != nil {
+		dcu.SetUpdatedBy(*s)
+	}
+	return dcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcu *DnsCheckUpdate) SetUpdatedAt(t time.Time) *DnsCheckUpdate {
+	dcu.mutation.SetUpdatedAt(t)
+	return dcu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dcu *DnsCheckUpdate) SetDeletedBy(s string) *DnsCheckUpdate {
+	dcu.mutation.SetDeletedBy(s)
+	return dcu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dcu *DnsCheckUpdate) SetNillableDeletedBy(s *string) *DnsCheckUpdate {
+	if s != nil {
+		dcu.SetDeletedBy(*s)
+	}
+	return dcu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (dcu *DnsCheckUpdate) ClearDeletedBy() *DnsCheckUpdate {
+	dcu.mutation.ClearDeletedBy()
+	return dcu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dcu *DnsCheckUpdate) SetDeletedAt(t time.Time) *DnsCheckUpdate {
+	dcu.mutation.SetDeletedAt(t)
+	return dcu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dcu *DnsCheckUpdate) SetNillableDeletedAt(t *time.Time) *DnsCheckUpdate {
+	if t != nil {
+		dcu.SetDeletedAt(*t)
+	}
+	return dcu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dcu *DnsCheckUpdate) ClearDeletedAt() *DnsCheckUpdate {
+	dcu.mutation.ClearDeletedAt()
+	return dcu
+}
+
+// Mutation returns the DnsCheckMutation object of the builder.
+func (dcu *DnsCheckUpdate) Mutation() *DnsCheckMutation {
+	return dcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dcu *DnsCheckUpdate) Save(ctx context.Context) (int, error) {
+	dcu.defaults()
+	return withHooks(ctx, dcu.sqlSave, dcu.mutation, dcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcu *DnsCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := dcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dcu *DnsCheckUpdate) Exec(ctx context.Context) error {
+	_, err := dcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcu *DnsCheckUpdate) ExecX(ctx context.Context) {
+	if err := dcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcu *DnsCheckUpdate) defaults() {
+	if _, ok := dcu.mutation.UpdatedAt(); !ok {
+		v := dnscheck.UpdateDefaultUpdatedAt()
+		dcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcu *DnsCheckUpdate) check() error {
+	if dcu.mutation.ItemCleared() && len(dcu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DnsCheck.item"`)
+	}
+	return nil
+}
+
+func (dcu *DnsCheckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dnscheck.Table, dnscheck.Columns, sqlgraph.NewFieldSpec(dnscheck.FieldID, field.TypeUUID))
+	if ps := dcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if dcu.mutation.ExpectedCleared() {
+		_spec.ClearField(dnscheck.FieldExpected, field.TypeString)
+	}
+	if dcu.mutation.AnswersCleared() {
+		_spec.ClearField(dnscheck.FieldAnswers, field.TypeJSON)
+	}
+	if dcu.mutation.MessageCleared() {
+		_spec.ClearField(dnscheck.FieldMessage, field.TypeString)
+	}
+	if value, ok := dcu.mutation.CreatedBy(); ok {
+		_spec.SetField(dnscheck.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dcu.mutation.UpdatedBy(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := dcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dcu.mutation.DeletedBy(); ok {
+		_spec.SetField(dnscheck.FieldDeletedBy, field.TypeString, value)
+	}
+	if dcu.mutation.DeletedByCleared() {
+		_spec.ClearField(dnscheck.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := dcu.mutation.DeletedAt(); ok {
+		_spec.SetField(dnscheck.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dcu.mutation.DeletedAtCleared() {
+		_spec.ClearField(dnscheck.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dnscheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dcu.mutation.done = true
+	return n, nil
+}
+
+// DnsCheckUpdateOne is the builder for updating a single DnsCheck entity.
+type DnsCheckUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DnsCheckMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dcuo *DnsCheckUpdateOne) SetCreatedBy(s string) *DnsCheckUpdateOne {
+	dcuo.mutation.SetCreatedBy(s)
+	return dcuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dcuo *DnsCheckUpdateOne) SetNillableCreatedBy(s *string) *DnsCheckUpdateOne {
+	if s != nil {
+		dcuo.SetCreatedBy(*s)
+	}
+	return dcuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dcuo *DnsCheckUpdateOne) SetUpdatedBy(s string) *DnsCheckUpdateOne {
+	dcuo.mutation.SetUpdatedBy(s)
+	return dcuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dcuo *DnsCheckUpdateOne) SetNillableUpdatedBy(s *string) *DnsCheckUpdateOne {
+	if s != nil {
+		dcuo.SetUpdatedBy(*s)
+	}
+	return dcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dcuo *DnsCheckUpdateOne) SetUpdatedAt(t time.Time) *DnsCheckUpdateOne {
+	dcuo.mutation.SetUpdatedAt(t)
+	return dcuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dcuo *DnsCheckUpdateOne) SetDeletedBy(s string) *DnsCheckUpdateOne {
+	dcuo.mutation.SetDeletedBy(s)
+	return dcuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dcuo *DnsCheckUpdateOne) SetNillableDeletedBy(s *string) *DnsCheckUpdateOne {
+	if s != nil {
+		dcuo.SetDeletedBy(*s)
+	}
+	return dcuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (dcuo *DnsCheckUpdateOne) ClearDeletedBy() *DnsCheckUpdateOne {
+	dcuo.mutation.ClearDeletedBy()
+	return dcuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dcuo *DnsCheckUpdateOne) SetDeletedAt(t time.Time) *DnsCheckUpdateOne {
+	dcuo.mutation.SetDeletedAt(t)
+	return dcuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dcuo *DnsCheckUpdateOne) SetNillableDeletedAt(t *time.Time) *DnsCheckUpdateOne {
+	if t != nil {
+		dcuo.SetDeletedAt(*t)
+	}
+	return dcuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dcuo *DnsCheckUpdateOne) ClearDeletedAt() *DnsCheckUpdateOne {
+	dcuo.mutation.ClearDeletedAt()
+	return dcuo
+}
+
+// Mutation returns the DnsCheckMutation object of the builder.
+func (dcuo *DnsCheckUpdateOne) Mutation() *DnsCheckMutation {
+	return dcuo.mutation
+}
+
+// Where appends a list predicates to the DnsCheckUpdate builder.
+func (dcuo *DnsCheckUpdateOne) Where(ps ...predicate.DnsCheck) *DnsCheckUpdateOne {
+	dcuo.mutation.Where(ps...)
+	return dcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dcuo *DnsCheckUpdateOne) Select(field string, fields ...string) *DnsCheckUpdateOne {
+	dcuo.fields = append([]string{field}, fields...)
+	return dcuo
+}
+
+// Save executes the query and returns the updated DnsCheck entity.
+func (dcuo *DnsCheckUpdateOne) Save(ctx context.Context) (*DnsCheck, error) {
+	dcuo.defaults()
+	return withHooks(ctx, dcuo.sqlSave, dcuo.mutation, dcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcuo *DnsCheckUpdateOne) SaveX(ctx context.Context) *DnsCheck {
+	node, err := dcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dcuo *DnsCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := dcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcuo *DnsCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := dcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcuo *DnsCheckUpdateOne) defaults() {
+	if _, ok := dcuo.mutation.UpdatedAt(); !ok {
+		v := dnscheck.UpdateDefaultUpdatedAt()
+		dcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcuo *DnsCheckUpdateOne) check() error {
+	if dcuo.mutation.ItemCleared() && len(dcuo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DnsCheck.item"`)
+	}
+	return nil
+}
+
+func (dcuo *DnsCheckUpdateOne) sqlSave(ctx context.Context) (_node *DnsCheck, err error) {
+	if err := dcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dnscheck.Table, dnscheck.Columns, sqlgraph.NewFieldSpec(dnscheck.FieldID, field.TypeUUID))
+	id, ok := dcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DnsCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dnscheck.FieldID)
+		for _, f := range fields {
+			if !dnscheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dnscheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if dcuo.mutation.ExpectedCleared() {
+		_spec.ClearField(dnscheck.FieldExpected, field.TypeString)
+	}
+	if dcuo.mutation.AnswersCleared() {
+		_spec.ClearField(dnscheck.FieldAnswers, field.TypeJSON)
+	}
+	if dcuo.mutation.MessageCleared() {
+		_spec.ClearField(dnscheck.FieldMessage, field.TypeString)
+	}
+	if value, ok := dcuo.mutation.CreatedBy(); ok {
+		_spec.SetField(dnscheck.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dcuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := dcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dnscheck.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dcuo.mutation.DeletedBy(); ok {
+		_spec.SetField(dnscheck.FieldDeletedBy, field.TypeString, value)
+	}
+	if dcuo.mutation.DeletedByCleared() {
+		_spec.ClearField(dnscheck.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := dcuo.mutation.DeletedAt(); ok {
+		_spec.SetField(dnscheck.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dcuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(dnscheck.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &DnsCheck{config: dcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dnscheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dcuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DomainLookup is the model entity for the DomainLookup schema.
+type DomainLookup struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the lookup.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The domain name that was looked up.
+	Domain string `json:"domain,omitempty"`
+	// The protocol the registration data was retrieved with. WHOIS is only used for registries without RDAP server or if RDAP fails.
+	Source domainlookup.Source `json:"source,omitempty"`
+	// The RDAP base URL or WHOIS server that answered the lookup.
+	Server string `json:"server,omitempty"`
+	// The name of the registrar of the domain.
+	Registrar string `json:"registrar,omitempty"`
+	// The time the registration of the domain expires.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// The status codes of the domain in RDAP notation, e.g. `client transfer prohibited`.
+	Statuses []string `json:"statuses,omitempty"`
+	// The lowercase host names of the name servers of the domain.
+	Nameservers []string `json:"nameservers,omitempty"`
+	// The attributes of the domain item that differed from the registration data before the item was updated.
+	Discrepancies []schema.LookupDiscrepancy `json:"discrepancies,omitempty"`
+	// Why the lookup failed. Failed lookups do not change the domain item.
+	Error string `json:"error,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DomainLookupQuery when eager-loading is set.
+	Edges              DomainLookupEdges `json:"edges"`
+	domain_lookup_item *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// DomainLookupEdges holds the relations/edges for other nodes in the graph.
+type DomainLookupEdges struct {
+	// The domain item that was looked up.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DomainLookupEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DomainLookup) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case domainlookup.FieldStatuses, domainlookup.FieldNameservers, domainlookup.FieldDiscrepancies:
+			values[i] = new([]byte)
+		case domainlookup.FieldDomain, domainlookup.FieldSource, domainlookup.FieldServer, domainlookup.FieldRegistrar, domainlookup.FieldError, domainlookup.FieldCreatedBy, domainlookup.FieldUpdatedBy, domainlookup.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case domainlookup.FieldExpiresAt, domainlookup.FieldCreatedAt, domainlookup.FieldUpdatedAt, domainlookup.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case domainlookup.FieldID:
+			values[i] = new(uuid.UUID)
+		case domainlookup.ForeignKeys[0]: // domain_lookup_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DomainLookup fields.
+func (dl *DomainLookup) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case domainlookup.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dl.ID = *value
+			}
+		case domainlookup.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				dl.Domain = value.String
+			}
+		case domainlookup.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				dl.Source = domainlookup.Source(value.String)
+			}
+		case domainlookup.FieldServer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field server", values[i])
+			} else if value.Valid {
+				dl.Server = value.String
+			}
+		case domainlookup.FieldRegistrar:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field registrar", values[i])
+			} else if value.Valid {
+				dl.Registrar = value.String
+			}
+		case domainlookup.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				dl.ExpiresAt = new(time.Time)
+				*dl.ExpiresAt = value.Time
+			}
+		case domainlookup.FieldStatuses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field statuses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dl.Statuses); err != nil {
+					return fmt.Errorf("unmarshal field statuses: %w", err)
+				}
+			}
+		case domainlookup.FieldNameservers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field nameservers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dl.Nameservers); err != nil {
+					return fmt.Errorf("unmarshal field nameservers: %w", err)
+				}
+			}
+		case domainlookup.FieldDiscrepancies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field discrepancies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dl.Discrepancies); err != nil {
+					return fmt.Errorf("unmarshal field discrepancies: %w", err)
+				}
+			}
+		case domainlookup.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				dl.Error = value.String
+			}
+		case domainlookup.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				dl.CreatedBy = value.String
+			}
+		case domainlookup.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dl.CreatedAt = value.Time
+			}
+		case domainlookup.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				dl.UpdatedBy = value.String
+			}
+		case domainlookup.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dl.UpdatedAt = value.Time
+			}
+		case domainlookup.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				dl.DeletedBy = value.String
+			}
+		case domainlookup.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dl.DeletedAt = new(time.Time)
+				*dl.DeletedAt = value.Time
+			}
+		case domainlookup.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field domain_lookup_item", values[i])
+			} else if value.Valid {
+				dl.domain_lookup_item = new(uuid.UUID)
+				*dl.domain_lookup_item = *value.S.(*uuid.UUID)
+			}
+		default:
+			dl.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DomainLookup.
+// This includes values selected through modifiers, order, etc.
+func (dl *DomainLookup) Value(name string) (ent.Value, error) {
+	return dl.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the DomainLookup entity.
+func (dl *DomainLookup) QueryItem() *ItemQuery {
+	return NewDomainLookupClient(dl.config).QueryItem(dl)
+}
+
+// Update returns a builder for updating this DomainLookup.
+// Note that you need to call DomainLookup.Unwrap() before calling this method if this DomainLookup
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dl *DomainLookup) Update() *DomainLookupUpdateOne {
+	return NewDomainLookupClient(dl.config).UpdateOne(dl)
+}
+
+// Unwrap unwraps the DomainLookup entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dl *DomainLookup) Unwrap() *DomainLookup {
+	_tx, ok := dl.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DomainLookup is not a transactional entity")
+	}
+	dl.config.driver = _tx.drv
+	return dl
+}
+
+// String implements the fmt.Stringer.
+func (dl *DomainLookup) String() string {
+	var builder strings.Builder
+	builder.WriteString("DomainLookup(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dl.ID))
+	builder.WriteString("domain=")
+	builder.WriteString(dl.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Source))
+	builder.WriteString(", ")
+	builder.WriteString("server=")
+	builder.WriteString(dl.Server)
+	builder.WriteString(", ")
+	builder.WriteString("registrar=")
+	builder.WriteString(dl.Registrar)
+	builder.WriteString(", ")
+	if v := dl.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("statuses=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Statuses))
+	builder.WriteString(", ")
+	builder.WriteString("nameservers=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Nameservers))
+	builder.WriteString(", ")
+	builder.WriteString("discrepancies=")
+	builder.WriteString(fmt.Sprintf("%v", dl.Discrepancies))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(dl.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(dl.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dl.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(dl.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dl.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(dl.DeletedBy)
+	builder.WriteString(", ")
+	if v := dl.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DomainLookups is a parsable slice of DomainLookup.
+type DomainLookups []*DomainLookup
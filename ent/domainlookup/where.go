@@ -0,0 +1,875 @@
+// Code generated by ent, DO NOT EDIT.
+
+package domainlookup
+
+import (
+	"dig-inv/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldID, id))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDomain, v))
+}
+
+// Server applies equality check predicate on the "server" field. It's identical to ServerEQ.
+func Server(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldServer, v))
+}
+
+// Registrar applies equality check predicate on the "registrar" field. It's identical to RegistrarEQ.
+func Registrar(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldRegistrar, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v Source) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v Source) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...Source) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...Source) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// ServerEQ applies the EQ predicate on the "server" field.
+func ServerEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldServer, v))
+}
+
+// ServerNEQ applies the NEQ predicate on the "server" field.
+func ServerNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldServer, v))
+}
+
+// ServerIn applies the In predicate on the "server" field.
+func ServerIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldServer, vs...))
+}
+
+// ServerNotIn applies the NotIn predicate on the "server" field.
+func ServerNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldServer, vs...))
+}
+
+// ServerGT applies the GT predicate on the "server" field.
+func ServerGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldServer, v))
+}
+
+// ServerGTE applies the GTE predicate on the "server" field.
+func ServerGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldServer, v))
+}
+
+// ServerLT applies the LT predicate on the "server" field.
+func ServerLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldServer, v))
+}
+
+// ServerLTE applies the LTE predicate on the "server" field.
+func ServerLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldServer, v))
+}
+
+// ServerContains applies the Contains predicate on the "server" field.
+func ServerContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldServer, v))
+}
+
+// ServerHasPrefix applies the HasPrefix predicate on the "server" field.
+func ServerHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldServer, v))
+}
+
+// ServerHasSuffix applies the HasSuffix predicate on the "server" field.
+func ServerHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldServer, v))
+}
+
+// ServerIsNil applies the IsNil predicate on the "server" field.
+func ServerIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldServer))
+}
+
+// ServerNotNil applies the NotNil predicate on the "server" field.
+func ServerNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldServer))
+}
+
+// ServerEqualFold applies the EqualFold predicate on the "server" field.
+func ServerEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldServer, v))
+}
+
+// ServerContainsFold applies the ContainsFold predicate on the "server" field.
+func ServerContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldServer, v))
+}
+
+// RegistrarEQ applies the EQ predicate on the "registrar" field.
+func RegistrarEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldRegistrar, v))
+}
+
+// RegistrarNEQ applies the NEQ predicate on the "registrar" field.
+func RegistrarNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldRegistrar, v))
+}
+
+// RegistrarIn applies the In predicate on the "registrar" field.
+func RegistrarIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldRegistrar, vs...))
+}
+
+// RegistrarNotIn applies the NotIn predicate on the "registrar" field.
+func RegistrarNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldRegistrar, vs...))
+}
+
+// RegistrarGT applies the GT predicate on the "registrar" field.
+func RegistrarGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldRegistrar, v))
+}
+
+// RegistrarGTE applies the GTE predicate on the "registrar" field.
+func RegistrarGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldRegistrar, v))
+}
+
+// RegistrarLT applies the LT predicate on the "registrar" field.
+func RegistrarLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldRegistrar, v))
+}
+
+// RegistrarLTE applies the LTE predicate on the "registrar" field.
+func RegistrarLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldRegistrar, v))
+}
+
+// RegistrarContains applies the Contains predicate on the "registrar" field.
+func RegistrarContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldRegistrar, v))
+}
+
+// RegistrarHasPrefix applies the HasPrefix predicate on the "registrar" field.
+func RegistrarHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldRegistrar, v))
+}
+
+// RegistrarHasSuffix applies the HasSuffix predicate on the "registrar" field.
+func RegistrarHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldRegistrar, v))
+}
+
+// RegistrarIsNil applies the IsNil predicate on the "registrar" field.
+func RegistrarIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldRegistrar))
+}
+
+// RegistrarNotNil applies the NotNil predicate on the "registrar" field.
+func RegistrarNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldRegistrar))
+}
+
+// RegistrarEqualFold applies the EqualFold predicate on the "registrar" field.
+func RegistrarEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldRegistrar, v))
+}
+
+// RegistrarContainsFold applies the ContainsFold predicate on the "registrar" field.
+func RegistrarContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldRegistrar, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// StatusesIsNil applies the IsNil predicate on the "statuses" field.
+func StatusesIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldStatuses))
+}
+
+// StatusesNotNil applies the NotNil predicate on the "statuses" field.
+func StatusesNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldStatuses))
+}
+
+// NameserversIsNil applies the IsNil predicate on the "nameservers" field.
+func NameserversIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldNameservers))
+}
+
+// NameserversNotNil applies the NotNil predicate on the "nameservers" field.
+func NameserversNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldNameservers))
+}
+
+// DiscrepanciesIsNil applies the IsNil predicate on the "discrepancies" field.
+func DiscrepanciesIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldDiscrepancies))
+}
+
+// DiscrepanciesNotNil applies the NotNil predicate on the "discrepancies" field.
+func DiscrepanciesNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldDiscrepancies))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.DomainLookup {
+	return predicate.DomainLookup(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.DomainLookup {
+	return predicate.DomainLookup(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.DomainLookup {
+	return predicate.DomainLookup(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DomainLookup) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DomainLookup) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DomainLookup) predicate.DomainLookup {
+	return predicate.DomainLookup(sql.NotPredicates(p))
+}
@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DomainLookupCreate is the builder for creating a DomainLookup entity.
+type DomainLookupCreate struct {
+	config
+	mutation *DomainLookupMutation
+	hooks    []Hook
+}
+
+// SetDomain sets the "domain" field.
+func (dlc *DomainLookupCreate) SetDomain(s string) *DomainLookupCreate {
+	dlc.mutation.SetDomain(s)
+	return dlc
+}
+
+// SetSource sets the "source" field.
+func (dlc *DomainLookupCreate) SetSource(d domainlookup.Source) *DomainLookupCreate {
+	dlc.mutation.SetSource(d)
+	return dlc
+}
+
+// SetServer sets the "server" field.
+func (dlc *DomainLookupCreate) SetServer(s string) *DomainLookupCreate {
+	dlc.mutation.SetServer(s)
+	return dlc
+}
+
+// SetNillableServer sets the "server" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableServer(s *string) *DomainLookupCreate {
+	if s != nil {
+		dlc.SetServer(*s)
+	}
+	return dlc
+}
+
+// SetRegistrar sets the "registrar" field.
+func (dlc *DomainLookupCreate) SetRegistrar(s string) *DomainLookupCreate {
+	dlc.mutation.SetRegistrar(s)
+	return dlc
+}
+
+// SetNillableRegistrar sets the "registrar" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableRegistrar(s *string) *DomainLookupCreate {
+	if s != nil {
+		dlc.SetRegistrar(*s)
+	}
+	return dlc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (dlc *DomainLookupCreate) SetExpiresAt(t time.Time) *DomainLookupCreate {
+	dlc.mutation.SetExpiresAt(t)
+	return dlc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableExpiresAt(t *time.Time) *DomainLookupCreate {
+	if t != nil {
+		dlc.SetExpiresAt(*t)
+	}
+	return dlc
+}
+
+// SetStatuses sets the "statuses" field.
+func (dlc *DomainLookupCreate) SetStatuses(s []string) *DomainLookupCreate {
+	dlc.mutation.SetStatuses(s)
+	return dlc
+}
+
+// SetNameservers sets the "nameservers" field.
+func (dlc *DomainLookupCreate) SetNameservers(s []string) *DomainLookupCreate {
+	dlc.mutation.SetNameservers(s)
+	return dlc
+}
+
+// SetDiscrepancies sets the "discrepancies" field.
+func (dlc *DomainLookupCreate) SetDiscrepancies(sd []schema.LookupDiscrepancy) *DomainLookupCreate {
+	dlc.mutation.SetDiscrepancies(sd)
+	return dlc
+}
+
+// SetError sets the "error" field.
+func (dlc *DomainLookupCreate) SetError(s string) *DomainLookupCreate {
+	dlc.mutation.SetError(s)
+	return dlc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableError(s *string) *DomainLookupCreate {
+	if s != nil {
+		dlc.SetError(*s)
+	}
+	return dlc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dlc *DomainLookupCreate) SetCreatedBy(s string) *DomainLookupCreate {
+	dlc.mutation.SetCreatedBy(s)
+	return dlc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dlc *DomainLookupCreate) SetCreatedAt(t time.Time) *DomainLookupCreate {
+	dlc.mutation.SetCreatedAt(t)
+	return dlc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableCreatedAt(t *time.Time) *DomainLookupCreate {
+	if t != nil {
+		dlc.SetCreatedAt(*t)
+	}
+	return dlc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dlc *DomainLookupCreate) SetUpdatedBy(s string) *DomainLookupCreate {
+	dlc.mutation.SetUpdatedBy(s)
+	return dlc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlc *DomainLookupCreate) SetUpdatedAt(t time.Time) *DomainLookupCreate {
+	dlc.mutation.SetUpdatedAt(t)
+	return dlc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableUpdatedAt(t *time.Time) *DomainLookupCreate {
+	if t != nil {
+		dlc.SetUpdatedAt(*t)
+	}
+	return dlc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dlc *DomainLookupCreate) SetDeletedBy(s string) *DomainLookupCreate {
+	dlc.mutation.SetDeletedBy(s)
+	return dlc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableDeletedBy(s *string) *DomainLookupCreate {
+	if s != nil {
+		dlc.SetDeletedBy(*s)
+	}
+	return dlc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dlc *DomainLookupCreate) SetDeletedAt(t time.Time) *DomainLookupCreate {
+	dlc.mutation.SetDeletedAt(t)
+	return dlc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableDeletedAt(t *time.Time) *DomainLookupCreate {
+	if t != nil {
+		dlc.SetDeletedAt(*t)
+	}
+	return dlc
+}
+
+// SetID sets the "id" field.
+func (dlc *DomainLookupCreate) SetID(u uuid.UUID) *DomainLookupCreate {
+	dlc.mutation.SetID(u)
+	return dlc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dlc *DomainLookupCreate) SetNillableID(u *uuid.UUID) *DomainLookupCreate {
+	if u != nil {
+		dlc.SetID(*u)
+	}
+	return dlc
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (dlc *DomainLookupCreate) SetItemID(id uuid.UUID) *DomainLookupCreate {
+	dlc.mutation.SetItemID(id)
+	return dlc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (dlc *DomainLookupCreate) SetItem(i *Item) *DomainLookupCreate {
+	return dlc.SetItemID(i.ID)
+}
+
+// Mutation returns the DomainLookupMutation object of the builder.
+func (dlc *DomainLookupCreate) Mutation() *DomainLookupMutation {
+	return dlc.mutation
+}
+
+// Save creates the DomainLookup in the database.
+func (dlc *DomainLookupCreate) Save(ctx context.Context) (*DomainLookup, error) {
+	dlc.defaults()
+	return withHooks(ctx, dlc.sqlSave, dlc.mutation, dlc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dlc *DomainLookupCreate) SaveX(ctx context.Context) *DomainLookup {
+	v, err := dlc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlc *DomainLookupCreate) Exec(ctx context.Context) error {
+	_, err := dlc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlc *DomainLookupCreate) ExecX(ctx context.Context) {
+	if err := dlc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlc *DomainLookupCreate) defaults() {
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		v := domainlookup.DefaultCreatedAt()
+		dlc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		v := domainlookup.DefaultUpdatedAt()
+		dlc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := dlc.mutation.ID(); !ok {
+		v := domainlookup.DefaultID()
+		dlc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlc *DomainLookupCreate) check() error {
+	if _, ok := dlc.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "DomainLookup.domain"`)}
+	}
+	if v, ok := dlc.mutation.Domain(); ok {
+		if err := domainlookup.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "DomainLookup.domain": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "DomainLookup.source"`)}
+	}
+	if v, ok := dlc.mutation.Source(); ok {
+		if err := domainlookup.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DomainLookup.source": %w`, err)}
+		}
+	}
+	if _, ok := dlc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "DomainLookup.created_by"`)}
+	}
+	if _, ok := dlc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DomainLookup.created_at"`)}
+	}
+	if _, ok := dlc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "DomainLookup.updated_by"`)}
+	}
+	if _, ok := dlc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DomainLookup.updated_at"`)}
+	}
+	if len(dlc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "DomainLookup.item"`)}
+	}
+	return nil
+}
+
+func (dlc *DomainLookupCreate) sqlSave(ctx context.Context) (*DomainLookup, error) {
+	if err := dlc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dlc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dlc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dlc.mutation.id = &_node.ID
+	dlc.mutation.done = true
+	return _node, nil
+}
+
+func (dlc *DomainLookupCreate) createSpec() (*DomainLookup, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DomainLookup{config: dlc.config}
+		_spec = sqlgraph.NewCreateSpec(domainlookup.Table, sqlgraph.NewFieldSpec(domainlookup.FieldID, field.TypeUUID))
+	)
+	if id, ok := dlc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dlc.mutation.Domain(); ok {
+		_spec.SetField(domainlookup.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := dlc.mutation.Source(); ok {
+		_spec.SetField(domainlookup.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := dlc.mutation.Server(); ok {
+		_spec.SetField(domainlookup.FieldServer, field.TypeString, value)
+		_node.Server = value
+	}
+	if value, ok := dlc.mutation.Registrar(); ok {
+		_spec.SetField(domainlookup.FieldRegistrar, field.TypeString, value)
+		_node.Registrar = value
+	}
+	if value, ok := dlc.mutation.ExpiresAt(); ok {
+		_spec.SetField(domainlookup.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := dlc.mutation.Statuses(); ok {
+		_spec.SetField(domainlookup.FieldStatuses, field.TypeJSON, value)
+		_node.Statuses = value
+	}
+	if value, ok := dlc.mutation.Nameservers(); ok {
+		_spec.SetField(domainlookup.FieldNameservers, field.TypeJSON, value)
+		_node.Nameservers = value
+	}
+	if value, ok := dlc.mutation.Discrepancies(); ok {
+		_spec.SetField(domainlookup.FieldDiscrepancies, field.TypeJSON, value)
+		_node.Discrepancies = value
+	}
+	if value, ok := dlc.mutation.Error(); ok {
+		_spec.SetField(domainlookup.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := dlc.mutation.CreatedBy(); ok {
+		_spec.SetField(domainlookup.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := dlc.mutation.CreatedAt(); ok {
+		_spec.SetField(domainlookup.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dlc.mutation.UpdatedBy(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := dlc.mutation.UpdatedAt(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := dlc.mutation.DeletedBy(); ok {
+		_spec.SetField(domainlookup.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := dlc.mutation.DeletedAt(); ok {
+		_spec.SetField(domainlookup.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := dlc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   domainlookup.ItemTable,
+			Columns: []string{domainlookup.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.domain_lookup_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DomainLookupCreateBulk is the builder for creating many DomainLookup entities in bulk.
+type DomainLookupCreateBulk struct {
+	config
+	err      error
+	builders []*DomainLookupCreate
+}
+
+// Save creates the DomainLookup entities in the database.
+func (dlcb *DomainLookupCreateBulk) Save(ctx context.Context) ([]*DomainLookup, error) {
+	if dlcb.err != nil {
+		return nil, dlcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dlcb.builders))
+	nodes := make([]*DomainLookup, len(dlcb.builders))
+	mutators := make([]Mutator, len(dlcb.builders))
+	for i := range dlcb.builders {
+		func(i int, root context.Context) {
+			builder := dlcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DomainLookupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dlcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dlcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dlcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlcb *DomainLookupCreateBulk) SaveX(ctx context.Context) []*DomainLookup {
+	v, err := dlcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dlcb *DomainLookupCreateBulk) Exec(ctx context.Context) error {
+	_, err := dlcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlcb *DomainLookupCreateBulk) ExecX(ctx context.Context) {
+	if err := dlcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DomainLookupDelete is the builder for deleting a DomainLookup entity.
+type DomainLookupDelete struct {
+	config
+	hooks    []Hook
+	mutation *DomainLookupMutation
+}
+
+// Where appends a list predicates to the DomainLookupDelete builder.
+func (dld *DomainLookupDelete) Where(ps ...predicate.DomainLookup) *DomainLookupDelete {
+	dld.mutation.Where(ps...)
+	return dld
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dld *DomainLookupDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dld.sqlExec, dld.mutation, dld.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dld *DomainLookupDelete) ExecX(ctx context.Context) int {
+	n, err := dld.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dld *DomainLookupDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(domainlookup.Table, sqlgraph.NewFieldSpec(domainlookup.FieldID, field.TypeUUID))
+	if ps := dld.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dld.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dld.mutation.done = true
+	return affected, err
+}
+
+// DomainLookupDeleteOne is the builder for deleting a single DomainLookup entity.
+type DomainLookupDeleteOne struct {
+	dld *DomainLookupDelete
+}
+
+// Where appends a list predicates to the DomainLookupDelete builder.
+func (dldo *DomainLookupDeleteOne) Where(ps ...predicate.DomainLookup) *DomainLookupDeleteOne {
+	dldo.dld.mutation.Where(ps...)
+	return dldo
+}
+
+// Exec executes the deletion query.
+func (dldo *DomainLookupDeleteOne) Exec(ctx context.Context) error {
+	n, err := dldo.dld.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{domainlookup.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dldo *DomainLookupDeleteOne) ExecX(ctx context.Context) {
+	if err := dldo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
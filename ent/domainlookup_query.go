@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DomainLookupQuery is the builder for querying DomainLookup entities.
+type DomainLookupQuery struct {
+	config
+	ctx        *QueryContext
+	order      []domainlookup.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DomainLookup
+	withItem   *ItemQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DomainLookupQuery builder.
+func (dlq *DomainLookupQuery) Where(ps ...predicate.DomainLookup) *DomainLookupQuery {
+	dlq.predicates = append(dlq.predicates, ps...)
+	return dlq
+}
+
+// Limit the number of records to be returned by this query.
+func (dlq *DomainLookupQuery) Limit(limit int) *DomainLookupQuery {
+	dlq.ctx.Limit = &limit
+	return dlq
+}
+
+// Offset to start from.
+func (dlq *DomainLookupQuery) Offset(offset int) *DomainLookupQuery {
+	dlq.ctx.Offset = &offset
+	return dlq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dlq *DomainLookupQuery) Unique(unique bool) *DomainLookupQuery {
+	dlq.ctx.Unique = &unique
+	return dlq
+}
+
+// Order specifies how the records should be ordered.
+func (dlq *DomainLookupQuery) Order(o ...domainlookup.OrderOption) *DomainLookupQuery {
+	dlq.order = append(dlq.order, o...)
+	return dlq
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (dlq *DomainLookupQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: dlq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dlq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dlq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(domainlookup.Table, domainlookup.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, domainlookup.ItemTable, domainlookup.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dlq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DomainLookup entity from the query.
+// Returns a *NotFoundError when no DomainLookup was found.
+func (dlq *DomainLookupQuery) First(ctx context.Context) (*DomainLookup, error) {
+	nodes, err := dlq.Limit(1).All(setContextOp(ctx, dlq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{domainlookup.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dlq *DomainLookupQuery) FirstX(ctx context.Context) *DomainLookup {
+	node, err := dlq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DomainLookup ID from the query.
+// Returns a *NotFoundError when no DomainLookup ID was found.
+func (dlq *DomainLookupQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dlq.Limit(1).IDs(setContextOp(ctx, dlq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{domainlookup.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dlq *DomainLookupQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dlq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DomainLookup entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DomainLookup entity is found.
+// Returns a *NotFoundError when no DomainLookup entities are found.
+func (dlq *DomainLookupQuery) Only(ctx context.Context) (*DomainLookup, error) {
+	nodes, err := dlq.Limit(2).All(setContextOp(ctx, dlq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{domainlookup.Label}
+	default:
+		return nil, &NotSingularError{domainlookup.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dlq *DomainLookupQuery) OnlyX(ctx context.Context) *DomainLookup {
+	node, err := dlq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DomainLookup ID in the query.
+// Returns a *NotSingularError when more than one DomainLookup ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dlq *DomainLookupQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dlq.Limit(2).IDs(setContextOp(ctx, dlq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{domainlookup.Label}
+	default:
+		err = &NotSingularError{domainlookup.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dlq *DomainLookupQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dlq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DomainLookups.
+func (dlq *DomainLookupQuery) All(ctx context.Context) ([]*DomainLookup, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryAll)
+	if err := dlq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DomainLookup, *DomainLookupQuery]()
+	return withInterceptors[[]*DomainLookup](ctx, dlq, qr, dlq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dlq *DomainLookupQuery) AllX(ctx context.Context) []*DomainLookup {
+	nodes, err := dlq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DomainLookup IDs.
+func (dlq *DomainLookupQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dlq.ctx.Unique == nil && dlq.path != nil {
+		dlq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryIDs)
+	if err = dlq.Select(domainlookup.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dlq *DomainLookupQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dlq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dlq *DomainLookupQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryCount)
+	if err := dlq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dlq, querierCount[*DomainLookupQuery](), dlq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dlq *DomainLookupQuery) CountX(ctx context.Context) int {
+	count, err := dlq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dlq *DomainLookupQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dlq.ctx, ent.OpQueryExist)
+	switch _, err := dlq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dlq *DomainLookupQuery) ExistX(ctx context.Context) bool {
+	exist, err := dlq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DomainLookupQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dlq *DomainLookupQuery) Clone() *DomainLookupQuery {
+	if dlq == nil {
+		return nil
+	}
+	return &DomainLookupQuery{
+		config:     dlq.config,
+		ctx:        dlq.ctx.Clone(),
+		order:      append([]domainlookup.OrderOption{}, dlq.order...),
+		inters:     append([]Interceptor{}, dlq.inters...),
+		predicates: append([]predicate.DomainLookup{}, dlq.predicates...),
+		withItem:   dlq.withItem.Clone(),
+		// clone intermediate query.
+		sql:  dlq.sql.Clone(),
+		path: dlq.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (dlq *DomainLookupQuery) WithItem(opts ...func(*ItemQuery)) *DomainLookupQuery {
+	query := (&ItemClient{config: dlq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dlq.withItem = query
+	return dlq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Domain string `json:"domain,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DomainLookup.Query().
+//		GroupBy(domainlookup.FieldDomain).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dlq *DomainLookupQuery) GroupBy(field string, fields ...string) *DomainLookupGroupBy {
+	dlq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DomainLookupGroupBy{build: dlq}
+	grbuild.flds = &dlq.ctx.Fields
+	grbuild.label = domainlookup.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Domain string `json:"domain,omitempty"`
+//	}
+//
+//	client.DomainLookup.Query().
+//		Select(domainlookup.FieldDomain).
+//		Scan(ctx, &v)
+func (dlq *DomainLookupQuery) Select(fields ...string) *DomainLookupSelect {
+	dlq.ctx.Fields = append(dlq.ctx.Fields, fields...)
+	sbuild := &DomainLookupSelect{DomainLookupQuery: dlq}
+	sbuild.label = domainlookup.Label
+	sbuild.flds, sbuild.scan = &dlq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DomainLookupSelect configured with the given aggregations.
+func (dlq *DomainLookupQuery) Aggregate(fns ...AggregateFunc) *DomainLookupSelect {
+	return dlq.Select().Aggregate(fns...)
+}
+
+func (dlq *DomainLookupQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dlq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dlq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dlq.ctx.Fields {
+		if !domainlookup.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dlq.path != nil {
+		prev, err := dlq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dlq.sql = prev
+	}
+	return nil
+}
+
+func (dlq *DomainLookupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DomainLookup, error) {
+	var (
+		nodes       = []*DomainLookup{}
+		withFKs     = dlq.withFKs
+		_spec       = dlq.querySpec()
+		loadedTypes = [1]bool{
+			dlq.withItem != nil,
+		}
+	)
+	if dlq.withItem != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, domainlookup.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DomainLookup).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DomainLookup{config: dlq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dlq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dlq.withItem; query != nil {
+		if err := dlq.loadItem(ctx, query, nodes, nil,
+			func(n *DomainLookup, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dlq *DomainLookupQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*DomainLookup, init func(*DomainLookup), assign func(*DomainLookup, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DomainLookup)
+	for i := range nodes {
+		if nodes[i].domain_lookup_item == nil {
+			continue
+		}
+		fk := *nodes[i].domain_lookup_item
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "domain_lookup_item" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dlq *DomainLookupQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dlq.querySpec()
+	_spec.Node.Columns = dlq.ctx.Fields
+	if len(dlq.ctx.Fields) > 0 {
+		_spec.Unique = dlq.ctx.Unique != nil && *dlq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dlq.driver, _spec)
+}
+
+func (dlq *DomainLookupQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(domainlookup.Table, domainlookup.Columns, sqlgraph.NewFieldSpec(domainlookup.FieldID, field.TypeUUID))
+	_spec.From = dlq.sql
+	if unique := dlq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dlq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dlq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, domainlookup.FieldID)
+		for i := range fields {
+			if fields[i] != domainlookup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dlq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dlq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dlq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dlq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dlq *DomainLookupQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dlq.driver.Dialect())
+	t1 := builder.Table(domainlookup.Table)
+	columns := dlq.ctx.Fields
+	if len(columns) == 0 {
+		columns = domainlookup.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dlq.sql != nil {
+		selector = dlq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dlq.ctx.Unique != nil && *dlq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dlq.predicates {
+		p(selector)
+	}
+	for _, p := range dlq.order {
+		p(selector)
+	}
+	if offset := dlq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dlq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DomainLookupGroupBy is the group-by builder for DomainLookup entities.
+type DomainLookupGroupBy struct {
+	selector
+	build *DomainLookupQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dlgb *DomainLookupGroupBy) Aggregate(fns ...AggregateFunc) *DomainLookupGroupBy {
+	dlgb.fns = append(dlgb.fns, fns...)
+	return dlgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dlgb *DomainLookupGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dlgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dlgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DomainLookupQuery, *DomainLookupGroupBy](ctx, dlgb.build, dlgb, dlgb.build.inters, v)
+}
+
+func (dlgb *DomainLookupGroupBy) sqlScan(ctx context.Context, root *DomainLookupQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dlgb.fns))
+	for _, fn := range dlgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dlgb.flds)+len(dlgb.fns))
+		for _, f := range *dlgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dlgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dlgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DomainLookupSelect is the builder for selecting fields of DomainLookup entities.
+type DomainLookupSelect struct {
+	*DomainLookupQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dls *DomainLookupSelect) Aggregate(fns ...AggregateFunc) *DomainLookupSelect {
+	dls.fns = append(dls.fns, fns...)
+	return dls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dls *DomainLookupSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dls.ctx, ent.OpQuerySelect)
+	if err := dls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DomainLookupQuery, *DomainLookupSelect](ctx, dls.DomainLookupQuery, dls, dls.inters, v)
+}
+
+func (dls *DomainLookupSelect) sqlScan(ctx context.Context, root *DomainLookupQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dls.fns))
+	for _, fn := range dls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
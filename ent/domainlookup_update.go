@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DomainLookupUpdate is the builder for updating DomainLookup entities.
+type DomainLookupUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DomainLookupMutation
+}
+
+// Where appends a list predicates to the DomainLookupUpdate builder.
+func (dlu *DomainLookupUpdate) Where(ps ...predicate.DomainLookup) *DomainLookupUpdate {
+	dlu.mutation.Where(ps...)
+	return dlu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dlu *DomainLookupUpdate) SetCreatedBy(s string) *DomainLookupUpdate {
+	dlu.mutation.SetCreatedBy(s)
+	return dlu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dlu *DomainLookupUpdate) SetNillableCreatedBy(s *string) *DomainLookupUpdate {
+	if s != nil {
+		dlu.SetCreatedBy(*s)
+	}
+	return dlu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dlu *DomainLookupUpdate) SetUpdatedBy(s string) *DomainLookupUpdate {
+	dlu.mutation.SetUpdatedBy(s)
+	return dlu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dlu *DomainLookupUpdate) SetNillableUpdatedBy(s *string) *DomainLookupUpdate {
+	if s != nil {
+		dlu.SetUpdatedBy(*s)
+	}
+	return dlu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dlu *DomainLookupUpdate) SetUpdatedAt(t time.Time) *DomainLookupUpdate {
+	dlu.mutation.SetUpdatedAt(t)
+	return dlu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dlu *DomainLookupUpdate) SetDeletedBy(s string) *DomainLookupUpdate {
+	dlu.mutation.SetDeletedBy(s)
+	return dlu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dlu *DomainLookupUpdate) SetNillableDeletedBy(s *string) *DomainLookupUpdate {
+	if s != nil {
+		dlu.SetDeletedBy(*s)
+	}
+	return dlu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (dlu *DomainLookupUpdate) ClearDeletedBy() *DomainLookupUpdate {
+	dlu.mutation.ClearDeletedBy()
+	return dlu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dlu *DomainLookupUpdate) SetDeletedAt(t time.Time) *DomainLookupUpdate {
+	dlu.mutation.SetDeletedAt(t)
+	return dlu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dlu *DomainLookupUpdate) SetNillableDeletedAt(t *time.Time) *DomainLookupUpdate {
+	if t != nil {
+		dlu.SetDeletedAt(*t)
+	}
+	return dlu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dlu *DomainLookupUpdate) ClearDeletedAt() *DomainLookupUpdate {
+	dlu.mutation.ClearDeletedAt()
+	return dlu
+}
+
+// Mutation returns the DomainLookupMutation object of the builder.
+func (dlu *DomainLookupUpdate) Mutation() *DomainLookupMutation {
+	return dlu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dlu *DomainLookupUpdate) Save(ctx context.Context) (int, error) {
+	dlu.defaults()
+	return withHooks(ctx, dlu.sqlSave, dlu.mutation, dlu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dlu *DomainLookupUpdate) SaveX(ctx context.Context) int {
+	affected, err := dlu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dlu *DomainLookupUpdate) Exec(ctx context.Context) error {
+	_, err := dlu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dlu *DomainLookupUpdate) ExecX(ctx context.Context) {
+	if err := dlu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dlu *DomainLookupUpdate) defaults() {
+	if _, ok := dlu.mutation.UpdatedAt(); !ok {
+		v := domainlookup.UpdateDefaultUpdatedAt()
+		dlu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dlu *DomainLookupUpdate) check() error {
+	if dlu.mutation.ItemCleared() && len(dlu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DomainLookup.item"`)
+	}
+	return nil
+}
+
+func (dlu *DomainLookupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dlu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(domainlookup.Table, domainlookup.Columns, sqlgraph.NewFieldSpec(domainlookup.FieldID, field.TypeUUID))
+	if ps := dlu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if dlu.mutation.ServerCleared() {
+		_spec.ClearField(domainlookup.FieldServer, field.TypeString)
+	}
+	if dlu.mutation.RegistrarCleared() {
+		_spec.ClearField(domainlookup.FieldRegistrar, field.TypeString)
+	}
+	if dlu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(domainlookup.FieldExpiresAt, field.TypeTime)
+	}
+	if dlu.mutation.StatusesCleared() {
+		_spec.ClearField(domainlookup.FieldStatuses, field.TypeJSON)
+	}
+	if dlu.mutation.NameserversCleared() {
+		_spec.ClearField(domainlookup.FieldNameservers, field.TypeJSON)
+	}
+	if dlu.mutation.DiscrepanciesCleared() {
+		_spec.ClearField(domainlookup.FieldDiscrepancies, field.TypeJSON)
+	}
+	if dlu.mutation.ErrorCleared() {
+		_spec.ClearField(domainlookup.FieldError, field.TypeString)
+	}
+	if value, ok := dlu.mutation.CreatedBy(); ok {
+		_spec.SetField(domainlookup.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.UpdatedBy(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := dlu.mutation.UpdatedAt(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dlu.mutation.DeletedBy(); ok {
+		_spec.SetField(domainlookup.FieldDeletedBy, field.TypeString, value)
+	}
+	if dlu.mutation.DeletedByCleared() {
+		_spec.ClearField(domainlookup.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := dlu.mutation.DeletedAt(); ok {
+		_spec.SetField(domainlookup.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dlu.mutation.DeletedAtCleared() {
+		_spec.ClearField(domainlookup.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dlu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domainlookup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dlu.mutation.done = true
+	return n, nil
+}
+
+// DomainLookupUpdateOne is the builder for updating a single DomainLookup entity.
+type DomainLookupUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DomainLookupMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dluo *DomainLookupUpdateOne) SetCreatedBy(s string) *DomainLookupUpdateOne {
+	dluo.mutation.SetCreatedBy(s)
+	return dluo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dluo *DomainLookupUpdateOne) SetNillableCreatedBy(s *string) *DomainLookupUpdateOne {
+	if s != nil {
+		dluo.SetCreatedBy(*s)
+	}
+	return dluo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dluo *DomainLookupUpdateOne) SetUpdatedBy(s string) *DomainLookupUpdateOne {
+	dluo.mutation.SetUpdatedBy(s)
+	return dluo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dluo *DomainLookupUpdateOne) SetNillableUpdatedBy(s *string) *DomainLookupUpdateOne {
+	if s != nil {
+		dluo.SetUpdatedBy(*s)
+	}
+	return dluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dluo *DomainLookupUpdateOne) SetUpdatedAt(t time.Time) *DomainLookupUpdateOne {
+	dluo.mutation.SetUpdatedAt(t)
+	return dluo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dluo *DomainLookupUpdateOne) SetDeletedBy(s string) *DomainLookupUpdateOne {
+	dluo.mutation.SetDeletedBy(s)
+	return dluo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dluo *DomainLookupUpdateOne) SetNillableDeletedBy(s *string) *DomainLookupUpdateOne {
+	if s != nil {
+		dluo.SetDeletedBy(*s)
+	}
+	return dluo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (dluo *DomainLookupUpdateOne) ClearDeletedBy() *DomainLookupUpdateOne {
+	dluo.mutation.ClearDeletedBy()
+	return dluo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dluo *DomainLookupUpdateOne) SetDeletedAt(t time.Time) *DomainLookupUpdateOne {
+	dluo.mutation.SetDeletedAt(t)
+	return dluo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dluo *DomainLookupUpdateOne) SetNillableDeletedAt(t *time.Time) *DomainLookupUpdateOne {
+	if t != nil {
+		dluo.SetDeletedAt(*t)
+	}
+	return dluo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dluo *DomainLookupUpdateOne) ClearDeletedAt() *DomainLookupUpdateOne {
+	dluo.mutation.ClearDeletedAt()
+	return dluo
+}
+
+// Mutation returns the DomainLookupMutation object of the builder.
+func (dluo *DomainLookupUpdateOne) Mutation() *DomainLookupMutation {
+	return dluo.mutation
+}
+
+// Where appends a list predicates to the DomainLookupUpdate builder.
+func (dluo *DomainLookupUpdateOne) Where(ps ...predicate.DomainLookup) *DomainLookupUpdateOne {
+	dluo.mutation.Where(ps...)
+	return dluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dluo *DomainLookupUpdateOne) Select(field string, fields ...string) *DomainLookupUpdateOne {
+	dluo.fields = append([]string{field}, fields...)
+	return dluo
+}
+
+// Save executes the query and returns the updated DomainLookup entity.
+func (dluo *DomainLookupUpdateOne) Save(ctx context.Context) (*DomainLookup, error) {
+	dluo.defaults()
+	return withHooks(ctx, dluo.sqlSave, dluo.mutation, dluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dluo *DomainLookupUpdateOne) SaveX(ctx context.Context) *DomainLookup {
+	node, err := dluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dluo *DomainLookupUpdateOne) Exec(ctx context.Context) error {
+	_, err := dluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dluo *DomainLookupUpdateOne) ExecX(ctx context.Context) {
+	if err := dluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dluo *DomainLookupUpdateOne) defaults() {
+	if _, ok := dluo.mutation.UpdatedAt(); !ok {
+		v := domainlookup.UpdateDefaultUpdatedAt()
+		dluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dluo *DomainLookupUpdateOne) check() error {
+	if dluo.mutation.ItemCleared() && len(dluo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DomainLookup.item"`)
+	}
+	return nil
+}
+
+func (dluo *DomainLookupUpdateOne) sqlSave(ctx context.Context) (_node *DomainLookup, err error) {
+	if err := dluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(domainlookup.Table, domainlookup.Columns, sqlgraph.NewFieldSpec(domainlookup.FieldID, field.TypeUUID))
+	id, ok := dluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DomainLookup.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, domainlookup.FieldID)
+		for _, f := range fields {
+			if !domainlookup.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != domainlookup.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if dluo.mutation.ServerCleared() {
+		_spec.ClearField(domainlookup.FieldServer, field.TypeString)
+	}
+	if dluo.mutation.RegistrarCleared() {
+		_spec.ClearField(domainlookup.FieldRegistrar, field.TypeString)
+	}
+	if dluo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(domainlookup.FieldExpiresAt, field.TypeTime)
+	}
+	if dluo.mutation.StatusesCleared() {
+		_spec.ClearField(domainlookup.FieldStatuses, field.TypeJSON)
+	}
+	if dluo.mutation.NameserversCleared() {
+		_spec.ClearField(domainlookup.FieldNameservers, field.TypeJSON)
+	}
+	if dluo.mutation.DiscrepanciesCleared() {
+		_spec.ClearField(domainlookup.FieldDiscrepancies, field.TypeJSON)
+	}
+	if dluo.mutation.ErrorCleared() {
+		_spec.ClearField(domainlookup.FieldError, field.TypeString)
+	}
+	if value, ok := dluo.mutation.CreatedBy(); ok {
+		_spec.SetField(domainlookup.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.UpdatedBy(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := dluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(domainlookup.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dluo.mutation.DeletedBy(); ok {
+		_spec.SetField(domainlookup.FieldDeletedBy, field.TypeString, value)
+	}
+	if dluo.mutation.DeletedByCleared() {
+		_spec.ClearField(domainlookup.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := dluo.mutation.DeletedAt(); ok {
+		_spec.SetField(domainlookup.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dluo.mutation.DeletedAtCleared() {
+		_spec.ClearField(domainlookup.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &DomainLookup{config: dluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{domainlookup.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dluo.mutation.done = true
+	return _node, nil
+}
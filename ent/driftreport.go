@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DriftReport is the model entity for the DriftReport schema.
+type DriftReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the drift report.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The job type of the sync that found the drift, e.g. `certificate_check`.
+	Source string `json:"source,omitempty"`
+	// Whether the changes wait for approval, were applied to the items or were rejected. Pending reports are superseded by the next report of the same sync, which holds the current state of the provider.
+	Status driftreport.Status `json:"status,omitempty"`
+	// The new, changed and vanished items found by the sync.
+	Changes []schema.DriftChange `json:"changes,omitempty"`
+	// The user who approved or rejected the report, or the worker for reports that were applied automatically.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// The time the report was applied or rejected.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DriftReportQuery when eager-loading is set.
+	Edges                    DriftReportEdges `json:"edges"`
+	drift_report_asset_class *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// DriftReportEdges holds the relations/edges for other nodes in the graph.
+type DriftReportEdges struct {
+	// The asset class whose items drifted.
+	AssetClass *AssetClass `json:"asset_class,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AssetClassOrErr returns the AssetClass value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DriftReportEdges) AssetClassOrErr() (*AssetClass, error) {
+	if e.AssetClass != nil {
+		return e.AssetClass, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: assetclass.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset_class"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DriftReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case driftreport.FieldChanges:
+			values[i] = new([]byte)
+		case driftreport.FieldSource, driftreport.FieldStatus, driftreport.FieldReviewedBy, driftreport.FieldCreatedBy, driftreport.FieldUpdatedBy, driftreport.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case driftreport.FieldReviewedAt, driftreport.FieldCreatedAt, driftreport.FieldUpdatedAt, driftreport.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case driftreport.FieldID:
+			values[i] = new(uuid.UUID)
+		case driftreport.ForeignKeys[0]: // drift_report_asset_class
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DriftReport fields.
+func (dr *DriftReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case driftreport.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dr.ID = *value
+			}
+		case driftreport.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				dr.Source = value.String
+			}
+		case driftreport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dr.Status = driftreport.Status(value.String)
+			}
+		case driftreport.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dr.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case driftreport.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				dr.ReviewedBy = value.String
+			}
+		case driftreport.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				dr.ReviewedAt = new(time.Time)
+				*dr.ReviewedAt = value.Time
+			}
+		case driftreport.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				dr.CreatedBy = value.String
+			}
+		case driftreport.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dr.CreatedAt = value.Time
+			}
+		case driftreport.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				dr.UpdatedBy = value.String
+			}
+		case driftreport.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dr.UpdatedAt = value.Time
+			}
+		case driftreport.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				dr.DeletedBy = value.String
+			}
+		case driftreport.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				dr.DeletedAt = new(time.Time)
+				*dr.DeletedAt = value.Time
+			}
+		case driftreport.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field drift_report_asset_class", values[i])
+			} else if value.Valid {
+				dr.drift_report_asset_class = new(uuid.UUID)
+				*dr.drift_report_asset_class = *value.S.(*uuid.UUID)
+			}
+		default:
+			dr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DriftReport.
+// This includes values selected through modifiers, order, etc.
+func (dr *DriftReport) Value(name string) (ent.Value, error) {
+	return dr.selectValues.Get(name)
+}
+
+// QueryAssetClass queries the "asset_class" edge of the DriftReport entity.
+func (dr *DriftReport) QueryAssetClass() *AssetClassQuery {
+	return NewDriftReportClient(dr.config).QueryAssetClass(dr)
+}
+
+// Update returns a builder for updating this DriftReport.
+// Note that you need to call DriftReport.Unwrap() before calling this method if this DriftReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dr *DriftReport) Update() *DriftReportUpdateOne {
+	return NewDriftReportClient(dr.config).UpdateOne(dr)
+}
+
+// Unwrap unwraps the DriftReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dr *DriftReport) Unwrap() *DriftReport {
+	_tx, ok := dr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DriftReport is not a transactional entity")
+	}
+	dr.config.driver = _tx.drv
+	return dr
+}
+
+// String implements the fmt.Stringer.
+func (dr *DriftReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("DriftReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dr.ID))
+	builder.WriteString("source=")
+	builder.WriteString(dr.Source)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(dr.ReviewedBy)
+	builder.WriteString(", ")
+	if v := dr.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(dr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(dr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(dr.DeletedBy)
+	builder.WriteString(", ")
+	if v := dr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DriftReports is a parsable slice of DriftReport.
+type DriftReports []*DriftReport
@@ -0,0 +1,443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DriftReportCreate is the builder for creating a DriftReport entity.
+type DriftReportCreate struct {
+	config
+	mutation *DriftReportMutation
+	hooks    []Hook
+}
+
+// SetSource sets the "source" field.
+func (drc *DriftReportCreate) SetSource(s string) *DriftReportCreate {
+	drc.mutation.SetSource(s)
+	return drc
+}
+
+// SetStatus sets the "status" field.
+func (drc *DriftReportCreate) SetStatus(d driftreport.Status) *DriftReportCreate {
+	drc.mutation.SetStatus(d)
+	return drc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableStatus(d *driftreport.Status) *DriftReportCreate {
+	if d != nil {
+		drc.SetStatus(*d)
+	}
+	return drc
+}
+
+// SetChanges sets the "changes" field.
+func (drc *DriftReportCreate) SetChanges(sc []schema.DriftChange) *DriftReportCreate {
+	drc.mutation.SetChanges(sc)
+	return drc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (drc *DriftReportCreate) SetReviewedBy(s string) *DriftReportCreate {
+	drc.mutation.SetReviewedBy(s)
+	return drc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableReviewedBy(s *string) *DriftReportCreate {
+	if s != nil {
+		drc.SetReviewedBy(*s)
+	}
+	return drc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (drc *DriftReportCreate) SetReviewedAt(t time.Time) *DriftReportCreate {
+	drc.mutation.SetReviewedAt(t)
+	return drc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableReviewedAt(t *time.Time) *DriftReportCreate {
+	if t != nil {
+		drc.SetReviewedAt(*t)
+	}
+	return drc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (drc *DriftReportCreate) SetCreatedBy(s string) *DriftReportCreate {
+	drc.mutation.SetCreatedBy(s)
+	return drc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (drc *DriftReportCreate) SetCreatedAt(t time.Time) *DriftReportCreate {
+	drc.mutation.SetCreatedAt(t)
+	return drc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableCreatedAt(t *time.Time) *DriftReportCreate {
+	if t != nil {
+		drc.SetCreatedAt(*t)
+	}
+	return drc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (drc *DriftReportCreate) SetUpdatedBy(s string) *DriftReportCreate {
+	drc.mutation.SetUpdatedBy(s)
+	return drc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (drc *DriftReportCreate) SetUpdatedAt(t time.Time) *DriftReportCreate {
+	drc.mutation.SetUpdatedAt(t)
+	return drc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableUpdatedAt(t *time.Time) *DriftReportCreate {
+	if t != nil {
+		drc.SetUpdatedAt(*t)
+	}
+	return drc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (drc *DriftReportCreate) SetDeletedBy(s string) *DriftReportCreate {
+	drc.mutation.SetDeletedBy(s)
+	return drc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableDeletedBy(s *string) *DriftReportCreate {
+	if s != nil {
+		drc.SetDeletedBy(*s)
+	}
+	return drc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (drc *DriftReportCreate) SetDeletedAt(t time.Time) *DriftReportCreate {
+	drc.mutation.SetDeletedAt(t)
+	return drc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableDeletedAt(t *time.Time) *DriftReportCreate {
+	if t != nil {
+		drc.SetDeletedAt(*t)
+	}
+	return drc
+}
+
+// SetID sets the "id" field.
+func (drc *DriftReportCreate) SetID(u uuid.UUID) *DriftReportCreate {
+	drc.mutation.SetID(u)
+	return drc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (drc *DriftReportCreate) SetNillableID(u *uuid.UUID) *DriftReportCreate {
+	if u != nil {
+		drc.SetID(*u)
+	}
+	return drc
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (drc *DriftReportCreate) SetAssetClassID(id uuid.UUID) *DriftReportCreate {
+	drc.mutation.SetAssetClassID(id)
+	return drc
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (drc *DriftReportCreate) SetAssetClass(a *AssetClass) *DriftReportCreate {
+	return drc.SetAssetClassID(a.ID)
+}
+
+// Mutation returns the DriftReportMutation object of the builder.
+func (drc *DriftReportCreate) Mutation() *DriftReportMutation {
+	return drc.mutation
+}
+
+// Save creates the DriftReport in the database.
+func (drc *DriftReportCreate) Save(ctx context.Context) (*DriftReport, error) {
+	drc.defaults()
+	return withHooks(ctx, drc.sqlSave, drc.mutation, drc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (drc *DriftReportCreate) SaveX(ctx context.Context) *DriftReport {
+	v, err := drc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drc *DriftReportCreate) Exec(ctx context.Context) error {
+	_, err := drc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drc *DriftReportCreate) ExecX(ctx context.Context) {
+	if err := drc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (drc *DriftReportCreate) defaults() {
+	if _, ok := drc.mutation.Status(); !ok {
+		v := driftreport.DefaultStatus
+		drc.mutation.SetStatus(v)
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		v := driftreport.DefaultCreatedAt()
+		drc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := drc.mutation.UpdatedAt(); !ok {
+		v := driftreport.DefaultUpdatedAt()
+		drc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := drc.mutation.ID(); !ok {
+		v := driftreport.DefaultID()
+		drc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (drc *DriftReportCreate) check() error {
+	if _, ok := drc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "DriftReport.source"`)}
+	}
+	if v, ok := drc.mutation.Source(); ok {
+		if err := driftreport.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DriftReport.source": %w`, err)}
+		}
+	}
+	if _, ok := drc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DriftReport.status"`)}
+	}
+	if v, ok := drc.mutation.Status(); ok {
+		if err := driftreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DriftReport.status": %w`, err)}
+		}
+	}
+	if _, ok := drc.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "DriftReport.changes"`)}
+	}
+	if _, ok := drc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "DriftReport.created_by"`)}
+	}
+	if _, ok := drc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DriftReport.created_at"`)}
+	}
+	if _, ok := drc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "DriftReport.updated_by"`)}
+	}
+	if _, ok := drc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DriftReport.updated_at"`)}
+	}
+	if len(drc.mutation.AssetClassIDs()) == 0 {
+		return &ValidationError{Name: "asset_class", err: errors.New(`ent: missing required edge "DriftReport.asset_class"`)}
+	}
+	return nil
+}
+
+func (drc *DriftReportCreate) sqlSave(ctx context.Context) (*DriftReport, error) {
+	if err := drc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := drc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, drc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	drc.mutation.id = &_node.ID
+	drc.mutation.done = true
+	return _node, nil
+}
+
+func (drc *DriftReportCreate) createSpec() (*DriftReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DriftReport{config: drc.config}
+		_spec = sqlgraph.NewCreateSpec(driftreport.Table, sqlgraph.NewFieldSpec(driftreport.FieldID, field.TypeUUID))
+	)
+	if id, ok := drc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := drc.mutation.Source(); ok {
+		_spec.SetField(driftreport.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := drc.mutation.Status(); ok {
+		_spec.SetField(driftreport.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := drc.mutation.Changes(); ok {
+		_spec.SetField(driftreport.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := drc.mutation.ReviewedBy(); ok {
+		_spec.SetField(driftreport.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := drc.mutation.ReviewedAt(); ok {
+		_spec.SetField(driftreport.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := drc.mutation.CreatedBy(); ok {
+		_spec.SetField(driftreport.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := drc.mutation.CreatedAt(); ok {
+		_spec.SetField(driftreport.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := drc.mutation.UpdatedBy(); ok {
+		_spec.SetField(driftreport.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := drc.mutation.UpdatedAt(); ok {
+		_spec.SetField(driftreport.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := drc.mutation.DeletedBy(); ok {
+		_spec.SetField(driftreport.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := drc.mutation.DeletedAt(); ok {
+		_spec.SetField(driftreport.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := drc.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   driftreport.AssetClassTable,
+			Columns: []string{driftreport.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.drift_report_asset_class = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DriftReportCreateBulk is the builder for creating many DriftReport entities in bulk.
+type DriftReportCreateBulk struct {
+	config
+	err      error
+	builders []*DriftReportCreate
+}
+
+// Save creates the DriftReport entities in the database.
+func (drcb *DriftReportCreateBulk) Save(ctx context.Context) ([]*DriftReport, error) {
+	if drcb.err != nil {
+		return nil, drcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(drcb.builders))
+	nodes := make([]*DriftReport, len(drcb.builders))
+	mutators := make([]Mutator, len(drcb.builders))
+	for i := range drcb.builders {
+		func(i int, root context.Context) {
+			builder := drcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DriftReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, drcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, drcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, drcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (drcb *DriftReportCreateBulk) SaveX(ctx context.Context) []*DriftReport {
+	v, err := drcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (drcb *DriftReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := drcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drcb *DriftReportCreateBulk) ExecX(ctx context.Context) {
+	if err := drcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
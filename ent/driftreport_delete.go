@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DriftReportDelete is the builder for deleting a DriftReport entity.
+type DriftReportDelete struct {
+	config
+	hooks    []Hook
+	mutation *DriftReportMutation
+}
+
+// Where appends a list predicates to the DriftReportDelete builder.
+func (drd *DriftReportDelete) Where(ps ...predicate.DriftReport) *DriftReportDelete {
+	drd.mutation.Where(ps...)
+	return drd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (drd *DriftReportDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, drd.sqlExec, drd.mutation, drd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drd *DriftReportDelete) ExecX(ctx context.Context) int {
+	n, err := drd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (drd *DriftReportDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(driftreport.Table, sqlgraph.NewFieldSpec(driftreport.FieldID, field.TypeUUID))
+	if ps := drd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, drd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	drd.mutation.done = true
+	return affected, err
+}
+
+// DriftReportDeleteOne is the builder for deleting a single DriftReport entity.
+type DriftReportDeleteOne struct {
+	drd *DriftReportDelete
+}
+
+// Where appends a list predicates to the DriftReportDelete builder.
+func (drdo *DriftReportDeleteOne) Where(ps ...predicate.DriftReport) *DriftReportDeleteOne {
+	drdo.drd.mutation.Where(ps...)
+	return drdo
+}
+
+// Exec executes the deletion query.
+func (drdo *DriftReportDeleteOne) Exec(ctx context.Context) error {
+	n, err := drdo.drd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{driftreport.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (drdo *DriftReportDeleteOne) ExecX(ctx context.Context) {
+	if err := drdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DriftReportQuery is the builder for querying DriftReport entities.
+type DriftReportQuery struct {
+	config
+	ctx            *QueryContext
+	order          []driftreport.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.DriftReport
+	withAssetClass *AssetClassQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DriftReportQuery builder.
+func (drq *DriftReportQuery) Where(ps ...predicate.DriftReport) *DriftReportQuery {
+	drq.predicates = append(drq.predicates, ps...)
+	return drq
+}
+
+// Limit the number of records to be returned by this query.
+func (drq *DriftReportQuery) Limit(limit int) *DriftReportQuery {
+	drq.ctx.Limit = &limit
+	return drq
+}
+
+// Offset to start from.
+func (drq *DriftReportQuery) Offset(offset int) *DriftReportQuery {
+	drq.ctx.Offset = &offset
+	return drq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (drq *DriftReportQuery) Unique(unique bool) *DriftReportQuery {
+	drq.ctx.Unique = &unique
+	return drq
+}
+
+// Order specifies how the records should be ordered.
+func (drq *DriftReportQuery) Order(o ...driftreport.OrderOption) *DriftReportQuery {
+	drq.order = append(drq.order, o...)
+	return drq
+}
+
+// QueryAssetClass chains the current query on the "asset_class" edge.
+func (drq *DriftReportQuery) QueryAssetClass() *AssetClassQuery {
+	query := (&AssetClassClient{config: drq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := drq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := drq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(driftreport.Table, driftreport.FieldID, selector),
+			sqlgraph.To(assetclass.Table, assetclass.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, driftreport.AssetClassTable, driftreport.AssetClassColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(drq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DriftReport entity from the query.
+// Returns a *NotFoundError when no DriftReport was found.
+func (drq *DriftReportQuery) First(ctx context.Context) (*DriftReport, error) {
+	nodes, err := drq.Limit(1).All(setContextOp(ctx, drq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{driftreport.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (drq *DriftReportQuery) FirstX(ctx context.Context) *DriftReport {
+	node, err := drq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DriftReport ID from the query.
+// Returns a *NotFoundError when no DriftReport ID was found.
+func (drq *DriftReportQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = drq.Limit(1).IDs(setContextOp(ctx, drq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{driftreport.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (drq *DriftReportQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := drq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DriftReport entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DriftReport entity is found.
+// Returns a *NotFoundError when no DriftReport entities are found.
+func (drq *DriftReportQuery) Only(ctx context.Context) (*DriftReport, error) {
+	nodes, err := drq.Limit(2).All(setContextOp(ctx, drq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{driftreport.Label}
+	default:
+		return nil, &NotSingularError{driftreport.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (drq *DriftReportQuery) OnlyX(ctx context.Context) *DriftReport {
+	node, err := drq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DriftReport ID in the query.
+// Returns a *NotSingularError when more than one DriftReport ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (drq *DriftReportQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = drq.Limit(2).IDs(setContextOp(ctx, drq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{driftreport.Label}
+	default:
+		err = &NotSingularError{driftreport.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (drq *DriftReportQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := drq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DriftReports.
+func (drq *DriftReportQuery) All(ctx context.Context) ([]*DriftReport, error) {
+	ctx = setContextOp(ctx, drq.ctx, ent.OpQueryAll)
+	if err := drq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DriftReport, *DriftReportQuery]()
+	return withInterceptors[[]*DriftReport](ctx, drq, qr, drq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (drq *DriftReportQuery) AllX(ctx context.Context) []*DriftReport {
+	nodes, err := drq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DriftReport IDs.
+func (drq *DriftReportQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if drq.ctx.Unique == nil && drq.path != nil {
+		drq.Unique(true)
+	}
+	ctx = setContextOp(ctx, drq.ctx, ent.OpQueryIDs)
+	if err = drq.Select(driftreport.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (drq *DriftReportQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := drq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (drq *DriftReportQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, drq.ctx, ent.OpQueryCount)
+	if err := drq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, drq, querierCount[*DriftReportQuery](), drq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (drq *DriftReportQuery) CountX(ctx context.Context) int {
+	count, err := drq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (drq *DriftReportQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, drq.ctx, ent.OpQueryExist)
+	switch _, err := drq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (drq *DriftReportQuery) ExistX(ctx context.Context) bool {
+	exist, err := drq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DriftReportQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (drq *DriftReportQuery) Clone() *DriftReportQuery {
+	if drq == nil {
+		return nil
+	}
+	return &DriftReportQuery{
+		config:         drq.config,
+		ctx:            drq.ctx.Clone(),
+		order:          append([]driftreport.OrderOption{}, drq.order...),
+		inters:         append([]Interceptor{}, drq.inters...),
+		predicates:     append([]predicate.DriftReport{}, drq.predicates...),
+		withAssetClass: drq.withAssetClass.Clone(),
+		// clone intermediate query.
+		sql:  drq.sql.Clone(),
+		path: drq.path,
+	}
+}
+
+// WithAssetClass tells the query-builder to eager-load the nodes that are connected to
+// the "asset_class" edge. The optional arguments are used to configure the query builder of the edge.
+func (drq *DriftReportQuery) WithAssetClass(opts ...func(*AssetClassQuery)) *DriftReportQuery {
+	query := (&AssetClassClient{config: drq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	drq.withAssetClass = query
+	return drq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Source string `json:"source,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DriftReport.Query().
+//		GroupBy(driftreport.FieldSource).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (drq *DriftReportQuery) GroupBy(field string, fields ...string) *DriftReportGroupBy {
+	drq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DriftReportGroupBy{build: drq}
+	grbuild.flds = &drq.ctx.Fields
+	grbuild.label = driftreport.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Source string `json:"source,omitempty"`
+//	}
+//
+//	client.DriftReport.Query().
+//		Select(driftreport.FieldSource).
+//		Scan(ctx, &v)
+func (drq *DriftReportQuery) Select(fields ...string) *DriftReportSelect {
+	drq.ctx.Fields = append(drq.ctx.Fields, fields...)
+	sbuild := &DriftReportSelect{DriftReportQuery: drq}
+	sbuild.label = driftreport.Label
+	sbuild.flds, sbuild.scan = &drq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DriftReportSelect configured with the given aggregations.
+func (drq *DriftReportQuery) Aggregate(fns ...AggregateFunc) *DriftReportSelect {
+	return drq.Select().Aggregate(fns...)
+}
+
+func (drq *DriftReportQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range drq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, drq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range drq.ctx.Fields {
+		if !driftreport.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if drq.path != nil {
+		prev, err := drq.path(ctx)
+		if err != nil {
+			return err
+		}
+		drq.sql = prev
+	}
+	return nil
+}
+
+func (drq *DriftReportQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DriftReport, error) {
+	var (
+		nodes       = []*DriftReport{}
+		withFKs     = drq.withFKs
+		_spec       = drq.querySpec()
+		loadedTypes = [1]bool{
+			drq.withAssetClass != nil,
+		}
+	)
+	if drq.withAssetClass != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, driftreport.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DriftReport).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DriftReport{config: drq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, drq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := drq.withAssetClass; query != nil {
+		if err := drq.loadAssetClass(ctx, query, nodes, nil,
+			func(n *DriftReport, e *AssetClass) { n.Edges.AssetClass = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (drq *DriftReportQuery) loadAssetClass(ctx context.Context, query *AssetClassQuery, nodes []*DriftReport, init func(*DriftReport), assign func(*DriftReport, *AssetClass)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*DriftReport)
+	for i := range nodes {
+		if nodes[i].drift_report_asset_class == nil {
+			continue
+		}
+		fk := *nodes[i].drift_report_asset_class
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(assetclass.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "drift_report_asset_class" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (drq *DriftReportQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := drq.querySpec()
+	_spec.Node.Columns = drq.ctx.Fields
+	if len(drq.ctx.Fields) > 0 {
+		_spec.Unique = drq.ctx.Unique != nil && *drq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, drq.driver, _spec)
+}
+
+func (drq *DriftReportQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(driftreport.Table, driftreport.Columns, sqlgraph.NewFieldSpec(driftreport.FieldID, field.TypeUUID))
+	_spec.From = drq.sql
+	if unique := drq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if drq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := drq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, driftreport.FieldID)
+		for i := range fields {
+			if fields[i] != driftreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := drq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := drq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := drq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := drq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (drq *DriftReportQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(drq.driver.Dialect())
+	t1 := builder.Table(driftreport.Table)
+	columns := drq.ctx.Fields
+	if len(columns) == 0 {
+		columns = driftreport.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if drq.sql != nil {
+		selector = drq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if drq.ctx.Unique != nil && *drq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range drq.predicates {
+		p(selector)
+	}
+	for _, p := range drq.order {
+		p(selector)
+	}
+	if offset := drq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := drq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DriftReportGroupBy is the group-by builder for DriftReport entities.
+type DriftReportGroupBy struct {
+	selector
+	build *DriftReportQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (drgb *DriftReportGroupBy) Aggregate(fns ...AggregateFunc) *DriftReportGroupBy {
+	drgb.fns = append(drgb.fns, fns...)
+	return drgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (drgb *DriftReportGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, drgb.build.ctx, ent.OpQueryGroupBy)
+	if err := drgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DriftReportQuery, *DriftReportGroupBy](ctx, drgb.build, drgb, drgb.build.inters, v)
+}
+
+func (drgb *DriftReportGroupBy) sqlScan(ctx context.Context, root *DriftReportQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(drgb.fns))
+	for _, fn := range drgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*drgb.flds)+len(drgb.fns))
+		for _, f := range *drgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*drgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := drgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DriftReportSelect is the builder for selecting fields of DriftReport entities.
+type DriftReportSelect struct {
+	*DriftReportQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (drs *DriftReportSelect) Aggregate(fns ...AggregateFunc) *DriftReportSelect {
+	drs.fns = append(drs.fns, fns...)
+	return drs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (drs *DriftReportSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, drs.ctx, ent.OpQuerySelect)
+	if err := drs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DriftReportQuery, *DriftReportSelect](ctx, drs.DriftReportQuery, drs, drs.inters, v)
+}
+
+func (drs *DriftReportSelect) sqlScan(ctx context.Context, root *DriftReportQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(drs.fns))
+	for _, fn := range drs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*drs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := drs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
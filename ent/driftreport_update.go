@@ -0,0 +1,554 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/driftreport"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DriftReportUpdate is the builder for updating DriftReport entities.
+type DriftReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DriftReportMutation
+}
+
+// Where appends a list predicates to the DriftReportUpdate builder.
+func (dru *DriftReportUpdate) Where(ps ...predicate.DriftReport) *DriftReportUpdate {
+	dru.mutation.Where(ps...)
+	return dru
+}
+
+// SetStatus sets the "status" field.
+func (dru *DriftReportUpdate) SetStatus(d driftreport.Status) *DriftReportUpdate {
+	dru.mutation.SetStatus(d)
+	return dru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableStatus(d *driftreport.Status) *DriftReportUpdate {
+	if d != nil {
+		dru.SetStatus(*d)
+	}
+	return dru
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (dru *DriftReportUpdate) SetReviewedBy(s string) *DriftReportUpdate {
+	dru.mutation.SetReviewedBy(s)
+	return dru
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableReviewedBy(s *string) *DriftReportUpdate {
+	if s != nil {
+		dru.SetReviewedBy(*s)
+	}
+	return dru
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (dru *DriftReportUpdate) ClearReviewedBy() *DriftReportUpdate {
+	dru.mutation.ClearReviewedBy()
+	return dru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (dru *DriftReportUpdate) SetReviewedAt(t time.Time) *DriftReportUpdate {
+	dru.mutation.SetReviewedAt(t)
+	return dru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableReviewedAt(t *time.Time) *DriftReportUpdate {
+	if t != nil {
+		dru.SetReviewedAt(*t)
+	}
+	return dru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (dru *DriftReportUpdate) ClearReviewedAt() *DriftReportUpdate {
+	dru.mutation.ClearReviewedAt()
+	return dru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (dru *DriftReportUpdate) SetCreatedBy(s string) *DriftReportUpdate {
+	dru.mutation.SetCreatedBy(s)
+	return dru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableCreatedBy(s *string) *DriftReportUpdate {
+	if s != nil {
+		dru.SetCreatedBy(*s)
+	}
+	return dru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (dru *DriftReportUpdate) SetUpdatedBy(s string) *DriftReportUpdate {
+	dru.mutation.SetUpdatedBy(s)
+	return dru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableUpdatedBy(s *string) *DriftReportUpdate {
+	if s != nil {
+		dru.SetUpdatedBy(*s)
+	}
+	return dru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dru *DriftReportUpdate) SetUpdatedAt(t time.Time) *DriftReportUpdate {
+	dru.mutation.SetUpdatedAt(t)
+	return dru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (dru *DriftReportUpdate) SetDeletedBy(s string) *DriftReportUpdate {
+	dru.mutation.SetDeletedBy(s)
+	return dru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableDeletedBy(s *string) *DriftReportUpdate {
+	if s != nil {
+		dru.SetDeletedBy(*s)
+	}
+	return dru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (dru *DriftReportUpdate) ClearDeletedBy() *DriftReportUpdate {
+	dru.mutation.ClearDeletedBy()
+	return dru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (dru *DriftReportUpdate) SetDeletedAt(t time.Time) *DriftReportUpdate {
+	dru.mutation.SetDeletedAt(t)
+	return dru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (dru *DriftReportUpdate) SetNillableDeletedAt(t *time.Time) *DriftReportUpdate {
+	if t != nil {
+		dru.SetDeletedAt(*t)
+	}
+	return dru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (dru *DriftReportUpdate) ClearDeletedAt() *DriftReportUpdate {
+	dru.mutation.ClearDeletedAt()
+	return dru
+}
+
+// Mutation returns the DriftReportMutation object of the builder.
+func (dru *DriftReportUpdate) Mutation() *DriftReportMutation {
+	return dru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dru *DriftReportUpdate) Save(ctx context.Context) (int, error) {
+	dru.defaults()
+	return withHooks(ctx, dru.sqlSave, dru.mutation, dru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dru *DriftReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := dru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dru *DriftReportUpdate) Exec(ctx context.Context) error {
+	_, err := dru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dru *DriftReportUpdate) ExecX(ctx context.Context) {
+	if err := dru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dru *DriftReportUpdate) defaults() {
+	if _, ok := dru.mutation.UpdatedAt(); !ok {
+		v := driftreport.UpdateDefaultUpdatedAt()
+		dru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dru *DriftReportUpdate) check() error {
+	if v, ok := dru.mutation.Status(); ok {
+		if err := driftreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DriftReport.status": %w`, err)}
+		}
+	}
+	if dru.mutation.AssetClassCleared() && len(dru.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriftReport.asset_class"`)
+	}
+	return nil
+}
+
+func (dru *DriftReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driftreport.Table, driftreport.Columns, sqlgraph.NewFieldSpec(driftreport.FieldID, field.TypeUUID))
+	if ps := dru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dru.mutation.Status(); ok {
+		_spec.SetField(driftreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := dru.mutation.ReviewedBy(); ok {
+		_spec.SetField(driftreport.FieldReviewedBy, field.TypeString, value)
+	}
+	if dru.mutation.ReviewedByCleared() {
+		_spec.ClearField(driftreport.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := dru.mutation.ReviewedAt(); ok {
+		_spec.SetField(driftreport.FieldReviewedAt, field.TypeTime, value)
+	}
+	if dru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(driftreport.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := dru.mutation.CreatedBy(); ok {
+		_spec.SetField(driftreport.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := dru.mutation.UpdatedBy(); ok {
+		_spec.SetField(driftreport.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := dru.mutation.UpdatedAt(); ok {
+		_spec.SetField(driftreport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := dru.mutation.DeletedBy(); ok {
+		_spec.SetField(driftreport.FieldDeletedBy, field.TypeString, value)
+	}
+	if dru.mutation.DeletedByCleared() {
+		_spec.ClearField(driftreport.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := dru.mutation.DeletedAt(); ok {
+		_spec.SetField(driftreport.FieldDeletedAt, field.TypeTime, value)
+	}
+	if dru.mutation.DeletedAtCleared() {
+		_spec.ClearField(driftreport.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driftreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dru.mutation.done = true
+	return n, nil
+}
+
+// DriftReportUpdateOne is the builder for updating a single DriftReport entity.
+type DriftReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DriftReportMutation
+}
+
+// SetStatus sets the "status" field.
+func (druo *DriftReportUpdateOne) SetStatus(d driftreport.Status) *DriftReportUpdateOne {
+	druo.mutation.SetStatus(d)
+	return druo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableStatus(d *driftreport.Status) *DriftReportUpdateOne {
+	if d != nil {
+		druo.SetStatus(*d)
+	}
+	return druo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (druo *DriftReportUpdateOne) SetReviewedBy(s string) *DriftReportUpdateOne {
+	druo.mutation.SetReviewedBy(s)
+	return druo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableReviewedBy(s *string) *DriftReportUpdateOne {
+	if s != nil {
+		druo.SetReviewedBy(*s)
+	}
+	return druo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (druo *DriftReportUpdateOne) ClearReviewedBy() *DriftReportUpdateOne {
+	druo.mutation.ClearReviewedBy()
+	return druo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (druo *DriftReportUpdateOne) SetReviewedAt(t time.Time) *DriftReportUpdateOne {
+	druo.mutation.SetReviewedAt(t)
+	return druo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableReviewedAt(t *time.Time) *DriftReportUpdateOne {
+	if t != nil {
+		druo.SetReviewedAt(*t)
+	}
+	return druo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (druo *DriftReportUpdateOne) ClearReviewedAt() *DriftReportUpdateOne {
+	druo.mutation.ClearReviewedAt()
+	return druo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (druo *DriftReportUpdateOne) SetCreatedBy(s string) *DriftReportUpdateOne {
+	druo.mutation.SetCreatedBy(s)
+	return druo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableCreatedBy(s *string) *DriftReportUpdateOne {
+	if s != nil {
+		druo.SetCreatedBy(*s)
+	}
+	return druo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (druo *DriftReportUpdateOne) SetUpdatedBy(s string) *DriftReportUpdateOne {
+	druo.mutation.SetUpdatedBy(s)
+	return druo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableUpdatedBy(s *string) *DriftReportUpdateOne {
+	if s != nil {
+		druo.SetUpdatedBy(*s)
+	}
+	return druo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (druo *DriftReportUpdateOne) SetUpdatedAt(t time.Time) *DriftReportUpdateOne {
+	druo.mutation.SetUpdatedAt(t)
+	return druo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (druo *DriftReportUpdateOne) SetDeletedBy(s string) *DriftReportUpdateOne {
+	druo.mutation.SetDeletedBy(s)
+	return druo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableDeletedBy(s *string) *DriftReportUpdateOne {
+	if s != nil {
+		druo.SetDeletedBy(*s)
+	}
+	return druo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (druo *DriftReportUpdateOne) ClearDeletedBy() *DriftReportUpdateOne {
+	druo.mutation.ClearDeletedBy()
+	return druo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (druo *DriftReportUpdateOne) SetDeletedAt(t time.Time) *DriftReportUpdateOne {
+	druo.mutation.SetDeletedAt(t)
+	return druo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (druo *DriftReportUpdateOne) SetNillableDeletedAt(t *time.Time) *DriftReportUpdateOne {
+	if t != nil {
+		druo.SetDeletedAt(*t)
+	}
+	return druo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (druo *DriftReportUpdateOne) ClearDeletedAt() *DriftReportUpdateOne {
+	druo.mutation.ClearDeletedAt()
+	return druo
+}
+
+// Mutation returns the DriftReportMutation object of the builder.
+func (druo *DriftReportUpdateOne) Mutation() *DriftReportMutation {
+	return druo.mutation
+}
+
+// Where appends a list predicates to the DriftReportUpdate builder.
+func (druo *DriftReportUpdateOne) Where(ps ...predicate.DriftReport) *DriftReportUpdateOne {
+	druo.mutation.Where(ps...)
+	return druo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (druo *DriftReportUpdateOne) Select(field string, fields ...string) *DriftReportUpdateOne {
+	druo.fields = append([]string{field}, fields...)
+	return druo
+}
+
+// Save executes the query and returns the updated DriftReport entity.
+func (druo *DriftReportUpdateOne) Save(ctx context.Context) (*DriftReport, error) {
+	druo.defaults()
+	return withHooks(ctx, druo.sqlSave, druo.mutation, druo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (druo *DriftReportUpdateOne) SaveX(ctx context.Context) *DriftReport {
+	node, err := druo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (druo *DriftReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := druo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (druo *DriftReportUpdateOne) ExecX(ctx context.Context) {
+	if err := druo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (druo *DriftReportUpdateOne) defaults() {
+	if _, ok := druo.mutation.UpdatedAt(); !ok {
+		v := driftreport.UpdateDefaultUpdatedAt()
+		druo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (druo *DriftReportUpdateOne) check() error {
+	if v, ok := druo.mutation.Status(); ok {
+		if err := driftreport.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DriftReport.status": %w`, err)}
+		}
+	}
+	if druo.mutation.AssetClassCleared() && len(druo.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DriftReport.asset_class"`)
+	}
+	return nil
+}
+
+func (druo *DriftReportUpdateOne) sqlSave(ctx context.Context) (_node *DriftReport, err error) {
+	if err := druo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(driftreport.Table, driftreport.Columns, sqlgraph.NewFieldSpec(driftreport.FieldID, field.TypeUUID))
+	id, ok := druo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DriftReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := druo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, driftreport.FieldID)
+		for _, f := range fields {
+			if !driftreport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != driftreport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := druo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := druo.mutation.Status(); ok {
+		_spec.SetField(driftreport.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := druo.mutation.ReviewedBy(); ok {
+		_spec.SetField(driftreport.FieldReviewedBy, field.TypeString, value)
+	}
+	if druo.mutation.ReviewedByCleared() {
+		_spec.ClearField(driftreport.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := druo.mutation.ReviewedAt(); ok {
+		_spec.SetField(driftreport.FieldReviewedAt, field.TypeTime, value)
+	}
+	if druo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(driftreport.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := druo.mutation.CreatedBy(); ok {
+		_spec.SetField(driftreport.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := druo.mutation.UpdatedBy(); ok {
+		_spec.SetField(driftreport.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := druo.mutation.UpdatedAt(); ok {
+		_spec.SetField(driftreport.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := druo.mutation.DeletedBy(); ok {
+		_spec.SetField(driftreport.FieldDeletedBy, field.TypeString, value)
+	}
+	if druo.mutation.DeletedByCleared() {
+		_spec.ClearField(driftreport.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := druo.mutation.DeletedAt(); ok {
+		_spec.SetField(driftreport.FieldDeletedAt, field.TypeTime, value)
+	}
+	if druo.mutation.DeletedAtCleared() {
+		_spec.ClearField(driftreport.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &DriftReport{config: druo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, druo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{driftreport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	druo.mutation.done = true
+	return _node, nil
+}
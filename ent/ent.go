@@ -5,9 +5,30 @@ package ent
 import (
 	"context"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/driftreport"
 	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/notificationdelivery"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"dig-inv/ent/provideraccount"
+	"dig-inv/ent/reachability"
+	"dig-inv/ent/reminderrule"
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/schedule"
 	"dig-inv/ent/tag"
 	"dig-inv/ent/usergroup"
+	"dig-inv/ent/webhook"
+	"dig-inv/ent/webhookdelivery"
 	"errors"
 	"fmt"
 	"reflect"
@@ -76,10 +97,31 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			assetclass.Table: assetclass.ValidColumn,
-			item.Table:       item.ValidColumn,
-			tag.Table:        tag.ValidColumn,
-			usergroup.Table:  usergroup.ValidColumn,
+			assetclass.Table:           assetclass.ValidColumn,
+			attachment.Table:           attachment.ValidColumn,
+			attributedefinition.Table:  attributedefinition.ValidColumn,
+			auditlog.Table:             auditlog.ValidColumn,
+			comment.Table:              comment.ValidColumn,
+			commentmention.Table:       commentmention.ValidColumn,
+			dnscheck.Table:             dnscheck.ValidColumn,
+			domainlookup.Table:         domainlookup.ValidColumn,
+			driftreport.Table:          driftreport.ValidColumn,
+			item.Table:                 item.ValidColumn,
+			itemrelation.Table:         itemrelation.ValidColumn,
+			jobrun.Table:               jobrun.ValidColumn,
+			notificationchannel.Table:  notificationchannel.ValidColumn,
+			notificationdelivery.Table: notificationdelivery.ValidColumn,
+			probe.Table:                probe.ValidColumn,
+			proberesult.Table:          proberesult.ValidColumn,
+			provideraccount.Table:      provideraccount.ValidColumn,
+			reachability.Table:         reachability.ValidColumn,
+			reminderrule.Table:         reminderrule.ValidColumn,
+			savedview.Table:            savedview.ValidColumn,
+			schedule.Table:             schedule.ValidColumn,
+			tag.Table:                  tag.ValidColumn,
+			usergroup.Table:            usergroup.ValidColumn,
+			webhook.Table:              webhook.ValidColumn,
+			webhookdelivery.Table:      webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,7 +3,11 @@
 package ent
 
 import (
+	"dig-inv/ent/assetclass"
 	"dig-inv/ent/item"
+	"dig-inv/ent/reachability"
+	"dig-inv/ent/schema"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -23,6 +27,18 @@ type Item struct {
 	Name string `json:"name,omitempty"`
 	// A description of the item, which can be used to provide additional information about the item.
 	Description string `json:"description,omitempty"`
+	// The values of the custom attributes defined by the asset class of the item, keyed by the attribute key.
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// The encrypted values of the secret attributes of the item, keyed by the attribute key. Secret values are kept apart from the other attributes, so they are never searched, exported or returned, and can only be read through the RevealSecret RPC.
+	Secrets map[string]schema.EncryptedValue `json:"-"`
+	// The recurring cost of the item per billing period, e.g. the monthly price of a server. Empty for items without known cost.
+	CostAmount *float64 `json:"cost_amount,omitempty"`
+	// The ISO 4217 code of the currency of the cost amount, e.g. EUR.
+	CostCurrency string `json:"cost_currency,omitempty"`
+	// The billing period the cost amount is charged for, e.g. yearly for domain renewals.
+	CostPeriod *item.CostPeriod `json:"cost_period,omitempty"`
+	// The cost center the item is billed to, which is used to group spend reports.
+	CostCenter string `json:"cost_center,omitempty"`
 	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
 	CreatedBy string `json:"created_by,omitempty"`
 	// When the resource was created in the inventory system.
@@ -38,8 +54,8 @@ type Item struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ItemQuery when eager-loading is set.
 	Edges            ItemEdges `json:"edges"`
-	tag_items        *uuid.UUID
-	user_group_items *uuid.UUID
+	item_asset_class *uuid.UUID
+	item_children    *uuid.UUID
 	selectValues     sql.SelectValues
 }
 
@@ -50,10 +66,24 @@ type ItemEdges struct {
 	// The user groups that are associated with this item. This edge represents the many-to-many relationship between items and user groups, allowing multiple user groups to be associated with a single item and vice versa.
 	UserGroups []*UserGroup `json:"user_groups,omitempty"`
 	// The asset class that this item belongs to. This edge represents the many-to-one relationship between items and asset classes, allowing multiple items to be associated with a single asset class. The asset class is defined in the AssetClass schema.
-	AssetClass []*AssetClass `json:"asset_class,omitempty"`
+	AssetClass *AssetClass `json:"asset_class,omitempty"`
+	// The parent item this item is nested in, e.g. the rack holding a server or the zone holding a DNS record. Items without a parent are root items. The children edge holds the items that are directly nested in this item.
+	Parent *Item `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Item `json:"children,omitempty"`
+	// The relations that start at this item.
+	OutgoingRelations []*ItemRelation `json:"outgoing_relations,omitempty"`
+	// The relations that point to this item.
+	IncomingRelations []*ItemRelation `json:"incoming_relations,omitempty"`
+	// The files attached to this item.
+	Attachments []*Attachment `json:"attachments,omitempty"`
+	// The comments on this item.
+	Comments []*Comment `json:"comments,omitempty"`
+	// The up or down state of the item, as determined by its probes. Empty for items that are not probed.
+	Reachability *Reachability `json:"reachability,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // TagsOrErr returns the Tags value or an error if the edge
@@ -75,28 +105,101 @@ func (e ItemEdges) UserGroupsOrErr() ([]*UserGroup, error) {
 }
 
 // AssetClassOrErr returns the AssetClass value or an error if the edge
-// was not loaded in eager-loading.
-func (e ItemEdges) AssetClassOrErr() ([]*AssetClass, error) {
-	if e.loadedTypes[2] {
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) AssetClassOrErr() (*AssetClass, error) {
+	if e.AssetClass != nil {
 		return e.AssetClass, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: assetclass.Label}
 	}
 	return nil, &NotLoadedError{edge: "asset_class"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) ParentOrErr() (*Item, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) ChildrenOrErr() ([]*Item, error) {
+	if e.loadedTypes[4] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// OutgoingRelationsOrErr returns the OutgoingRelations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) OutgoingRelationsOrErr() ([]*ItemRelation, error) {
+	if e.loadedTypes[5] {
+		return e.OutgoingRelations, nil
+	}
+	return nil, &NotLoadedError{edge: "outgoing_relations"}
+}
+
+// IncomingRelationsOrErr returns the IncomingRelations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) IncomingRelationsOrErr() ([]*ItemRelation, error) {
+	if e.loadedTypes[6] {
+		return e.IncomingRelations, nil
+	}
+	return nil, &NotLoadedError{edge: "incoming_relations"}
+}
+
+// AttachmentsOrErr returns the Attachments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) AttachmentsOrErr() ([]*Attachment, error) {
+	if e.loadedTypes[7] {
+		return e.Attachments, nil
+	}
+	return nil, &NotLoadedError{edge: "attachments"}
+}
+
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) CommentsOrErr() ([]*Comment, error) {
+	if e.loadedTypes[8] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
+// ReachabilityOrErr returns the Reachability value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) ReachabilityOrErr() (*Reachability, error) {
+	if e.Reachability != nil {
+		return e.Reachability, nil
+	} else if e.loadedTypes[9] {
+		return nil, &NotFoundError{label: reachability.Label}
+	}
+	return nil, &NotLoadedError{edge: "reachability"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldName, item.FieldDescription, item.FieldCreatedBy, item.FieldUpdatedBy, item.FieldDeletedBy:
+		case item.FieldAttributes, item.FieldSecrets:
+			values[i] = new([]byte)
+		case item.FieldCostAmount:
+			values[i] = new(sql.NullFloat64)
+		case item.FieldName, item.FieldDescription, item.FieldCostCurrency, item.FieldCostPeriod, item.FieldCostCenter, item.FieldCreatedBy, item.FieldUpdatedBy, item.FieldDeletedBy:
 			values[i] = new(sql.NullString)
 		case item.FieldCreatedAt, item.FieldUpdatedAt, item.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case item.FieldID:
 			values[i] = new(uuid.UUID)
-		case item.ForeignKeys[0]: // tag_items
+		case item.ForeignKeys[0]: // item_asset_class
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case item.ForeignKeys[1]: // user_group_items
+		case item.ForeignKeys[1]: // item_children
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -131,6 +234,48 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				i.Description = value.String
 			}
+		case item.FieldAttributes:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attributes", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Attributes); err != nil {
+					return fmt.Errorf("unmarshal field attributes: %w", err)
+				}
+			}
+		case item.FieldSecrets:
+			if value, ok := values[j].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[j])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &i.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
+		case item.FieldCostAmount:
+			if value, ok := values[j].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_amount", values[j])
+			} else if value.Valid {
+				i.CostAmount = new(float64)
+				*i.CostAmount = value.Float64
+			}
+		case item.FieldCostCurrency:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_currency", values[j])
+			} else if value.Valid {
+				i.CostCurrency = value.String
+			}
+		case item.FieldCostPeriod:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_period", values[j])
+			} else if value.Valid {
+				i.CostPeriod = new(item.CostPeriod)
+				*i.CostPeriod = item.CostPeriod(value.String)
+			}
+		case item.FieldCostCenter:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_center", values[j])
+			} else if value.Valid {
+				i.CostCenter = value.String
+			}
 		case item.FieldCreatedBy:
 			if value, ok := values[j].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[j])
@@ -170,17 +315,17 @@ func (i *Item) assignValues(columns []string, values []any) error {
 			}
 		case item.ForeignKeys[0]:
 			if value, ok := values[j].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field tag_items", values[j])
+				return fmt.Errorf("unexpected type %T for field item_asset_class", values[j])
 			} else if value.Valid {
-				i.tag_items = new(uuid.UUID)
-				*i.tag_items = *value.S.(*uuid.UUID)
+				i.item_asset_class = new(uuid.UUID)
+				*i.item_asset_class = *value.S.(*uuid.UUID)
 			}
 		case item.ForeignKeys[1]:
 			if value, ok := values[j].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field user_group_items", values[j])
+				return fmt.Errorf("unexpected type %T for field item_children", values[j])
 			} else if value.Valid {
-				i.user_group_items = new(uuid.UUID)
-				*i.user_group_items = *value.S.(*uuid.UUID)
+				i.item_children = new(uuid.UUID)
+				*i.item_children = *value.S.(*uuid.UUID)
 			}
 		default:
 			i.selectValues.Set(columns[j], values[j])
@@ -210,6 +355,41 @@ func (i *Item) QueryAssetClass() *AssetClassQuery {
 	return NewItemClient(i.config).QueryAssetClass(i)
 }
 
+// QueryParent queries the "parent" edge of the Item entity.
+func (i *Item) QueryParent() *ItemQuery {
+	return NewItemClient(i.config).QueryParent(i)
+}
+
+// QueryChildren queries the "children" edge of the Item entity.
+func (i *Item) QueryChildren() *ItemQuery {
+	return NewItemClient(i.config).QueryChildren(i)
+}
+
+// QueryOutgoingRelations queries the "outgoing_relations" edge of the Item entity.
+func (i *Item) QueryOutgoingRelations() *ItemRelationQuery {
+	return NewItemClient(i.config).QueryOutgoingRelations(i)
+}
+
+// QueryIncomingRelations queries the "incoming_relations" edge of the Item entity.
+func (i *Item) QueryIncomingRelations() *ItemRelationQuery {
+	return NewItemClient(i.config).QueryIncomingRelations(i)
+}
+
+// QueryAttachments queries the "attachments" edge of the Item entity.
+func (i *Item) QueryAttachments() *AttachmentQuery {
+	return NewItemClient(i.config).QueryAttachments(i)
+}
+
+// QueryComments queries the "comments" edge of the Item entity.
+func (i *Item) QueryComments() *CommentQuery {
+	return NewItemClient(i.config).QueryComments(i)
+}
+
+// QueryReachability queries the "reachability" edge of the Item entity.
+func (i *Item) QueryReachability() *ReachabilityQuery {
+	return NewItemClient(i.config).QueryReachability(i)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -239,6 +419,27 @@ func (i *Item) String() string {
 	builder.WriteString("description=")
 	builder.WriteString(i.Description)
 	builder.WriteString(", ")
+	builder.WriteString("attributes=")
+	builder.WriteString(fmt.Sprintf("%v", i.Attributes))
+	builder.WriteString(", ")
+	builder.WriteString("secrets=<sensitive>")
+	builder.WriteString(", ")
+	if v := i.CostAmount; v != nil {
+		builder.WriteString("cost_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cost_currency=")
+	builder.WriteString(i.CostCurrency)
+	builder.WriteString(", ")
+	if v := i.CostPeriod; v != nil {
+		builder.WriteString("cost_period=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cost_center=")
+	builder.WriteString(i.CostCenter)
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(i.CreatedBy)
 	builder.WriteString(", ")
@@ -3,6 +3,7 @@
 package item
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -19,6 +20,18 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldAttributes holds the string denoting the attributes field in the database.
+	FieldAttributes = "attributes"
+	// FieldSecrets holds the string denoting the secrets field in the database.
+	FieldSecrets = "secrets"
+	// FieldCostAmount holds the string denoting the cost_amount field in the database.
+	FieldCostAmount = "cost_amount"
+	// FieldCostCurrency holds the string denoting the cost_currency field in the database.
+	FieldCostCurrency = "cost_currency"
+	// FieldCostPeriod holds the string denoting the cost_period field in the database.
+	FieldCostPeriod = "cost_period"
+	// FieldCostCenter holds the string denoting the cost_center field in the database.
+	FieldCostCenter = "cost_center"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -37,29 +50,82 @@ const (
 	EdgeUserGroups = "user_groups"
 	// EdgeAssetClass holds the string denoting the asset_class edge name in mutations.
 	EdgeAssetClass = "asset_class"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeOutgoingRelations holds the string denoting the outgoing_relations edge name in mutations.
+	EdgeOutgoingRelations = "outgoing_relations"
+	// EdgeIncomingRelations holds the string denoting the incoming_relations edge name in mutations.
+	EdgeIncomingRelations = "incoming_relations"
+	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
+	EdgeAttachments = "attachments"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
+	// EdgeReachability holds the string denoting the reachability edge name in mutations.
+	EdgeReachability = "reachability"
 	// Table holds the table name of the item in the database.
 	Table = "items"
-	// TagsTable is the table that holds the tags relation/edge.
-	TagsTable = "tags"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "item_tags"
 	// TagsInverseTable is the table name for the Tag entity.
 	// It exists in this package in order to avoid circular dependency with the "tag" package.
 	TagsInverseTable = "tags"
-	// TagsColumn is the table column denoting the tags relation/edge.
-	TagsColumn = "item_tags"
-	// UserGroupsTable is the table that holds the user_groups relation/edge.
-	UserGroupsTable = "user_groups"
+	// UserGroupsTable is the table that holds the user_groups relation/edge. The primary key declared below.
+	UserGroupsTable = "item_user_groups"
 	// UserGroupsInverseTable is the table name for the UserGroup entity.
 	// It exists in this package in order to avoid circular dependency with the "usergroup" package.
 	UserGroupsInverseTable = "user_groups"
-	// UserGroupsColumn is the table column denoting the user_groups relation/edge.
-	UserGroupsColumn = "item_user_groups"
 	// AssetClassTable is the table that holds the asset_class relation/edge.
-	AssetClassTable = "asset_classes"
+	AssetClassTable = "items"
 	// AssetClassInverseTable is the table name for the AssetClass entity.
 	// It exists in this package in order to avoid circular dependency with the "assetclass" package.
 	AssetClassInverseTable = "asset_classes"
 	// AssetClassColumn is the table column denoting the asset_class relation/edge.
 	AssetClassColumn = "item_asset_class"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "items"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "item_children"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "items"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "item_children"
+	// OutgoingRelationsTable is the table that holds the outgoing_relations relation/edge.
+	OutgoingRelationsTable = "item_relations"
+	// OutgoingRelationsInverseTable is the table name for the ItemRelation entity.
+	// It exists in this package in order to avoid circular dependency with the "itemrelation" package.
+	OutgoingRelationsInverseTable = "item_relations"
+	// OutgoingRelationsColumn is the table column denoting the outgoing_relations relation/edge.
+	OutgoingRelationsColumn = "item_relation_source"
+	// IncomingRelationsTable is the table that holds the incoming_relations relation/edge.
+	IncomingRelationsTable = "item_relations"
+	// IncomingRelationsInverseTable is the table name for the ItemRelation entity.
+	// It exists in this package in order to avoid circular dependency with the "itemrelation" package.
+	IncomingRelationsInverseTable = "item_relations"
+	// IncomingRelationsColumn is the table column denoting the incoming_relations relation/edge.
+	IncomingRelationsColumn = "item_relation_target"
+	// AttachmentsTable is the table that holds the attachments relation/edge.
+	AttachmentsTable = "attachments"
+	// AttachmentsInverseTable is the table name for the Attachment entity.
+	// It exists in this package in order to avoid circular dependency with the "attachment" package.
+	AttachmentsInverseTable = "attachments"
+	// AttachmentsColumn is the table column denoting the attachments relation/edge.
+	AttachmentsColumn = "attachment_item"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "comments"
+	// CommentsInverseTable is the table name for the Comment entity.
+	// It exists in this package in order to avoid circular dependency with the "comment" package.
+	CommentsInverseTable = "comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "comment_item"
+	// ReachabilityTable is the table that holds the reachability relation/edge.
+	ReachabilityTable = "reachabilities"
+	// ReachabilityInverseTable is the table name for the Reachability entity.
+	// It exists in this package in order to avoid circular dependency with the "reachability" package.
+	ReachabilityInverseTable = "reachabilities"
+	// ReachabilityColumn is the table column denoting the reachability relation/edge.
+	ReachabilityColumn = "item_reachability"
 )
 
 // Columns holds all SQL columns for item fields.
@@ -67,6 +133,12 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldDescription,
+	FieldAttributes,
+	FieldSecrets,
+	FieldCostAmount,
+	FieldCostCurrency,
+	FieldCostPeriod,
+	FieldCostCenter,
 	FieldCreatedBy,
 	FieldCreatedAt,
 	FieldUpdatedBy,
@@ -78,10 +150,19 @@ var Columns = []string{
 // ForeignKeys holds the SQL foreign-keys that are owned by the "items"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
-	"tag_items",
-	"user_group_items",
+	"item_asset_class",
+	"item_children",
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"item_id", "tag_id"}
+	// UserGroupsPrimaryKey and UserGroupsColumn2 are the table columns denoting the
+	// primary key for the user_groups relation (M2M).
+	UserGroupsPrimaryKey = []string{"item_id", "user_group_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -100,6 +181,10 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// CostAmountValidator is a validator for the "cost_amount" field. It is called by the builders before save.
+	CostAmountValidator func(float64) error
+	// CostCurrencyValidator is a validator for the "cost_currency" field. It is called by the builders before save.
+	CostCurrencyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -110,6 +195,30 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// CostPeriod defines the type for the "cost_period" enum field.
+type CostPeriod string
+
+// CostPeriod values.
+const (
+	CostPeriodMonthly   CostPeriod = "monthly"
+	CostPeriodQuarterly CostPeriod = "quarterly"
+	CostPeriodYearly    CostPeriod = "yearly"
+)
+
+func (cp CostPeriod) String() string {
+	return string(cp)
+}
+
+// CostPeriodValidator is a validator for the "cost_period" field enum values. It is called by the builders before save.
+func CostPeriodValidator(cp CostPeriod) error {
+	switch cp {
+	case CostPeriodMonthly, CostPeriodQuarterly, CostPeriodYearly:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for cost_period field: %q", cp)
+	}
+}
+
 // OrderOption defines the ordering options for the Item queries.
 type OrderOption func(*sql.Selector)
 
@@ -128,6 +237,26 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByCostAmount orders the results by the cost_amount field.
+func ByCostAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostAmount, opts...).ToFunc()
+}
+
+// ByCostCurrency orders the results by the cost_currency field.
+func ByCostCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostCurrency, opts...).ToFunc()
+}
+
+// ByCostPeriod orders the results by the cost_period field.
+func ByCostPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostPeriod, opts...).ToFunc()
+}
+
+// ByCostCenter orders the results by the cost_center field.
+func ByCostCenter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostCenter, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -186,37 +315,163 @@ func ByUserGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
-// ByAssetClassCount orders the results by asset_class count.
-func ByAssetClassCount(opts ...sql.OrderTermOption) OrderOption {
+// ByAssetClassField orders the results by asset_class field.
+func ByAssetClassField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAssetClassStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOutgoingRelationsCount orders the results by outgoing_relations count.
+func ByOutgoingRelationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutgoingRelationsStep(), opts...)
+	}
+}
+
+// ByOutgoingRelations orders the results by outgoing_relations terms.
+func ByOutgoingRelations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutgoingRelationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIncomingRelationsCount orders the results by incoming_relations count.
+func ByIncomingRelationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIncomingRelationsStep(), opts...)
+	}
+}
+
+// ByIncomingRelations orders the results by incoming_relations terms.
+func ByIncomingRelations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIncomingRelationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAttachmentsCount orders the results by attachments count.
+func ByAttachmentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newAssetClassStep(), opts...)
+		sqlgraph.OrderByNeighborsCount(s, newAttachmentsStep(), opts...)
 	}
 }
 
-// ByAssetClass orders the results by asset_class terms.
-func ByAssetClass(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByAttachments orders the results by attachments terms.
+func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newAssetClassStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newAttachmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReachabilityField orders the results by reachability field.
+func ByReachabilityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReachabilityStep(), sql.OrderByField(field, opts...))
 	}
 }
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(TagsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 	)
 }
 func newUserGroupsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(UserGroupsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, UserGroupsTable, UserGroupsColumn),
+		sqlgraph.Edge(sqlgraph.M2M, false, UserGroupsTable, UserGroupsPrimaryKey...),
 	)
 }
 func newAssetClassStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(AssetClassInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2M, false, AssetClassTable, AssetClassColumn),
+		sqlgraph.Edge(sqlgraph.M2O, false, AssetClassTable, AssetClassColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newOutgoingRelationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutgoingRelationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, OutgoingRelationsTable, OutgoingRelationsColumn),
+	)
+}
+func newIncomingRelationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IncomingRelationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, IncomingRelationsTable, IncomingRelationsColumn),
+	)
+}
+func newAttachmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttachmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+	)
+}
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, CommentsTable, CommentsColumn),
+	)
+}
+func newReachabilityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReachabilityInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ReachabilityTable, ReachabilityColumn),
 	)
 }
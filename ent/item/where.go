@@ -66,6 +66,21 @@ func Description(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldDescription, v))
 }
 
+// CostAmount applies equality check predicate on the "cost_amount" field. It's identical to CostAmountEQ.
+func CostAmount(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostAmount, v))
+}
+
+// CostCurrency applies equality check predicate on the "cost_currency" field. It's identical to CostCurrencyEQ.
+func CostCurrency(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostCurrency, v))
+}
+
+// CostCenter applies equality check predicate on the "cost_center" field. It's identical to CostCenterEQ.
+func CostCenter(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostCenter, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldCreatedBy, v))
@@ -236,6 +251,256 @@ func DescriptionContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// AttributesIsNil applies the IsNil predicate on the "attributes" field.
+func AttributesIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldAttributes))
+}
+
+// AttributesNotNil applies the NotNil predicate on the "attributes" field.
+func AttributesNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldAttributes))
+}
+
+// SecretsIsNil applies the IsNil predicate on the "secrets" field.
+func SecretsIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldSecrets))
+}
+
+// SecretsNotNil applies the NotNil predicate on the "secrets" field.
+func SecretsNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldSecrets))
+}
+
+// CostAmountEQ applies the EQ predicate on the "cost_amount" field.
+func CostAmountEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostAmount, v))
+}
+
+// CostAmountNEQ applies the NEQ predicate on the "cost_amount" field.
+func CostAmountNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCostAmount, v))
+}
+
+// CostAmountIn applies the In predicate on the "cost_amount" field.
+func CostAmountIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCostAmount, vs...))
+}
+
+// CostAmountNotIn applies the NotIn predicate on the "cost_amount" field.
+func CostAmountNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCostAmount, vs...))
+}
+
+// CostAmountGT applies the GT predicate on the "cost_amount" field.
+func CostAmountGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCostAmount, v))
+}
+
+// CostAmountGTE applies the GTE predicate on the "cost_amount" field.
+func CostAmountGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCostAmount, v))
+}
+
+// CostAmountLT applies the LT predicate on the "cost_amount" field.
+func CostAmountLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCostAmount, v))
+}
+
+// CostAmountLTE applies the LTE predicate on the "cost_amount" field.
+func CostAmountLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCostAmount, v))
+}
+
+// CostAmountIsNil applies the IsNil predicate on the "cost_amount" field.
+func CostAmountIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCostAmount))
+}
+
+// CostAmountNotNil applies the NotNil predicate on the "cost_amount" field.
+func CostAmountNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCostAmount))
+}
+
+// CostCurrencyEQ applies the EQ predicate on the "cost_currency" field.
+func CostCurrencyEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostCurrency, v))
+}
+
+// CostCurrencyNEQ applies the NEQ predicate on the "cost_currency" field.
+func CostCurrencyNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCostCurrency, v))
+}
+
+// CostCurrencyIn applies the In predicate on the "cost_currency" field.
+func CostCurrencyIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCostCurrency, vs...))
+}
+
+// CostCurrencyNotIn applies the NotIn predicate on the "cost_currency" field.
+func CostCurrencyNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCostCurrency, vs...))
+}
+
+// CostCurrencyGT applies the GT predicate on the "cost_currency" field.
+func CostCurrencyGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCostCurrency, v))
+}
+
+// CostCurrencyGTE applies the GTE predicate on the "cost_currency" field.
+func CostCurrencyGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCostCurrency, v))
+}
+
+// CostCurrencyLT applies the LT predicate on the "cost_currency" field.
+func CostCurrencyLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCostCurrency, v))
+}
+
+// CostCurrencyLTE applies the LTE predicate on the "cost_currency" field.
+func CostCurrencyLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCostCurrency, v))
+}
+
+// CostCurrencyContains applies the Contains predicate on the "cost_currency" field.
+func CostCurrencyContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldCostCurrency, v))
+}
+
+// CostCurrencyHasPrefix applies the HasPrefix predicate on the "cost_currency" field.
+func CostCurrencyHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldCostCurrency, v))
+}
+
+// CostCurrencyHasSuffix applies the HasSuffix predicate on the "cost_currency" field.
+func CostCurrencyHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldCostCurrency, v))
+}
+
+// CostCurrencyIsNil applies the IsNil predicate on the "cost_currency" field.
+func CostCurrencyIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCostCurrency))
+}
+
+// CostCurrencyNotNil applies the NotNil predicate on the "cost_currency" field.
+func CostCurrencyNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCostCurrency))
+}
+
+// CostCurrencyEqualFold applies the EqualFold predicate on the "cost_currency" field.
+func CostCurrencyEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldCostCurrency, v))
+}
+
+// CostCurrencyContainsFold applies the ContainsFold predicate on the "cost_currency" field.
+func CostCurrencyContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldCostCurrency, v))
+}
+
+// CostPeriodEQ applies the EQ predicate on the "cost_period" field.
+func CostPeriodEQ(v CostPeriod) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostPeriod, v))
+}
+
+// CostPeriodNEQ applies the NEQ predicate on the "cost_period" field.
+func CostPeriodNEQ(v CostPeriod) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCostPeriod, v))
+}
+
+// CostPeriodIn applies the In predicate on the "cost_period" field.
+func CostPeriodIn(vs ...CostPeriod) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCostPeriod, vs...))
+}
+
+// CostPeriodNotIn applies the NotIn predicate on the "cost_period" field.
+func CostPeriodNotIn(vs ...CostPeriod) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCostPeriod, vs...))
+}
+
+// CostPeriodIsNil applies the IsNil predicate on the "cost_period" field.
+func CostPeriodIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCostPeriod))
+}
+
+// CostPeriodNotNil applies the NotNil predicate on the "cost_period" field.
+func CostPeriodNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCostPeriod))
+}
+
+// CostCenterEQ applies the EQ predicate on the "cost_center" field.
+func CostCenterEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldCostCenter, v))
+}
+
+// CostCenterNEQ applies the NEQ predicate on the "cost_center" field.
+func CostCenterNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldCostCenter, v))
+}
+
+// CostCenterIn applies the In predicate on the "cost_center" field.
+func CostCenterIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldCostCenter, vs...))
+}
+
+// CostCenterNotIn applies the NotIn predicate on the "cost_center" field.
+func CostCenterNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldCostCenter, vs...))
+}
+
+// CostCenterGT applies the GT predicate on the "cost_center" field.
+func CostCenterGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldCostCenter, v))
+}
+
+// CostCenterGTE applies the GTE predicate on the "cost_center" field.
+func CostCenterGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldCostCenter, v))
+}
+
+// CostCenterLT applies the LT predicate on the "cost_center" field.
+func CostCenterLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldCostCenter, v))
+}
+
+// CostCenterLTE applies the LTE predicate on the "cost_center" field.
+func CostCenterLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldCostCenter, v))
+}
+
+// CostCenterContains applies the Contains predicate on the "cost_center" field.
+func CostCenterContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldCostCenter, v))
+}
+
+// CostCenterHasPrefix applies the HasPrefix predicate on the "cost_center" field.
+func CostCenterHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldCostCenter, v))
+}
+
+// CostCenterHasSuffix applies the HasSuffix predicate on the "cost_center" field.
+func CostCenterHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldCostCenter, v))
+}
+
+// CostCenterIsNil applies the IsNil predicate on the "cost_center" field.
+func CostCenterIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldCostCenter))
+}
+
+// CostCenterNotNil applies the NotNil predicate on the "cost_center" field.
+func CostCenterNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldCostCenter))
+}
+
+// CostCenterEqualFold applies the EqualFold predicate on the "cost_center" field.
+func CostCenterEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldCostCenter, v))
+}
+
+// CostCenterContainsFold applies the ContainsFold predicate on the "cost_center" field.
+func CostCenterContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldCostCenter, v))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldCreatedBy, v))
@@ -576,7 +841,7 @@ func HasTags() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -599,7 +864,7 @@ func HasUserGroups() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, UserGroupsTable, UserGroupsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, UserGroupsTable, UserGroupsPrimaryKey...),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -622,7 +887,7 @@ func HasAssetClass() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, AssetClassTable, AssetClassColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, AssetClassTable, AssetClassColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -640,6 +905,167 @@ func HasAssetClassWith(preds ...predicate.AssetClass) predicate.Item {
 	})
 }
 
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Item) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Item) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOutgoingRelations applies the HasEdge predicate on the "outgoing_relations" edge.
+func HasOutgoingRelations() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, OutgoingRelationsTable, OutgoingRelationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutgoingRelationsWith applies the HasEdge predicate on the "outgoing_relations" edge with a given conditions (other predicates).
+func HasOutgoingRelationsWith(preds ...predicate.ItemRelation) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newOutgoingRelationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomingRelations applies the HasEdge predicate on the "incoming_relations" edge.
+func HasIncomingRelations() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, IncomingRelationsTable, IncomingRelationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomingRelationsWith applies the HasEdge predicate on the "incoming_relations" edge with a given conditions (other predicates).
+func HasIncomingRelationsWith(preds ...predicate.ItemRelation) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newIncomingRelationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttachments applies the HasEdge predicate on the "attachments" edge.
+func HasAttachments() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttachmentsWith applies the HasEdge predicate on the "attachments" edge with a given conditions (other predicates).
+func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newAttachmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.Comment) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReachability applies the HasEdge predicate on the "reachability" edge.
+func HasReachability() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ReachabilityTable, ReachabilityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReachabilityWith applies the HasEdge predicate on the "reachability" edge with a given conditions (other predicates).
+func HasReachabilityWith(preds ...predicate.Reachability) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newReachabilityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(sql.AndPredicates(predicates...))
@@ -5,7 +5,12 @@ package ent
 import (
 	"context"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/comment"
 	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/reachability"
+	"dig-inv/ent/schema"
 	"dig-inv/ent/tag"
 	"dig-inv/ent/usergroup"
 	"errors"
@@ -44,6 +49,74 @@ func (ic *ItemCreate) SetNillableDescription(s *string) *ItemCreate {
 	return ic
 }
 
+// SetAttributes sets the "attributes" field.
+func (ic *ItemCreate) SetAttributes(m map[string]interface{}) *ItemCreate {
+	ic.mutation.SetAttributes(m)
+	return ic
+}
+
+// SetSecrets sets the "secrets" field.
+func (ic *ItemCreate) SetSecrets(mv map[string]schema.EncryptedValue) *ItemCreate {
+	ic.mutation.SetSecrets(mv)
+	return ic
+}
+
+// SetCostAmount sets the "cost_amount" field.
+func (ic *ItemCreate) SetCostAmount(f float64) *ItemCreate {
+	ic.mutation.SetCostAmount(f)
+	return ic
+}
+
+// SetNillableCostAmount sets the "cost_amount" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCostAmount(f *float64) *ItemCreate {
+	if f != nil {
+		ic.SetCostAmount(*f)
+	}
+	return ic
+}
+
+// SetCostCurrency sets the "cost_currency" field.
+func (ic *ItemCreate) SetCostCurrency(s string) *ItemCreate {
+	ic.mutation.SetCostCurrency(s)
+	return ic
+}
+
+// SetNillableCostCurrency sets the "cost_currency" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCostCurrency(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetCostCurrency(*s)
+	}
+	return ic
+}
+
+// SetCostPeriod sets the "cost_period" field.
+func (ic *ItemCreate) SetCostPeriod(ip item.CostPeriod) *ItemCreate {
+	ic.mutation.SetCostPeriod(ip)
+	return ic
+}
+
+// SetNillableCostPeriod sets the "cost_period" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCostPeriod(ip *item.CostPeriod) *ItemCreate {
+	if ip != nil {
+		ic.SetCostPeriod(*ip)
+	}
+	return ic
+}
+
+// SetCostCenter sets the "cost_center" field.
+func (ic *ItemCreate) SetCostCenter(s string) *ItemCreate {
+	ic.mutation.SetCostCenter(s)
+	return ic
+}
+
+// SetNillableCostCenter sets the "cost_center" field if the given value is not nil.
+func (ic *ItemCreate) SetNillableCostCenter(s *string) *ItemCreate {
+	if s != nil {
+		ic.SetCostCenter(*s)
+	}
+	return ic
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (ic *ItemCreate) SetCreatedBy(s string) *ItemCreate {
 	ic.mutation.SetCreatedBy(s)
@@ -156,19 +229,128 @@ func (ic *ItemCreate) AddUserGroups(u ...*UserGroup) *ItemCreate {
 	return ic.AddUserGroupIDs(ids...)
 }
 
-// AddAssetClasIDs adds the "asset_class" edge to the AssetClass entity by IDs.
-func (ic *ItemCreate) AddAssetClasIDs(ids ...uuid.UUID) *ItemCreate {
-	ic.mutation.AddAssetClasIDs(ids...)
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (ic *ItemCreate) SetAssetClassID(id uuid.UUID) *ItemCreate {
+	ic.mutation.SetAssetClassID(id)
+	return ic
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (ic *ItemCreate) SetAssetClass(a *AssetClass) *ItemCreate {
+	return ic.SetAssetClassID(a.ID)
+}
+
+// SetParentID sets the "parent" edge to the Item entity by ID.
+func (ic *ItemCreate) SetParentID(id uuid.UUID) *ItemCreate {
+	ic.mutation.SetParentID(id)
+	return ic
+}
+
+// SetNillableParentID sets the "parent" edge to the Item entity by ID if the given value is not nil.
+func (ic *ItemCreate) SetNillableParentID(id *uuid.UUID) *ItemCreate {
+	if id != nil {
+		ic = ic.SetParentID(*id)
+	}
+	return ic
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (ic *ItemCreate) SetParent(i *Item) *ItemCreate {
+	return ic.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (ic *ItemCreate) AddChildIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddChildIDs(ids...)
+	return ic
+}
+
+// AddChildren adds the "children" edges to the Item entity.
+func (ic *ItemCreate) AddChildren(i ...*Item) *ItemCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddChildIDs(ids...)
+}
+
+// AddOutgoingRelationIDs adds the "outgoing_relations" edge to the ItemRelation entity by IDs.
+func (ic *ItemCreate) AddOutgoingRelationIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddOutgoingRelationIDs(ids...)
+	return ic
+}
+
+// AddOutgoingRelations adds the "outgoing_relations" edges to the ItemRelation entity.
+func (ic *ItemCreate) AddOutgoingRelations(i ...*ItemRelation) *ItemCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddOutgoingRelationIDs(ids...)
+}
+
+// AddIncomingRelationIDs adds the "incoming_relations" edge to the ItemRelation entity by IDs.
+func (ic *ItemCreate) AddIncomingRelationIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddIncomingRelationIDs(ids...)
+	return ic
+}
+
+// AddIncomingRelations adds the "incoming_relations" edges to the ItemRelation entity.
+func (ic *ItemCreate) AddIncomingRelations(i ...*ItemRelation) *ItemCreate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return ic.AddIncomingRelationIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (ic *ItemCreate) AddAttachmentIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddAttachmentIDs(ids...)
 	return ic
 }
 
-// AddAssetClass adds the "asset_class" edges to the AssetClass entity.
-func (ic *ItemCreate) AddAssetClass(a ...*AssetClass) *ItemCreate {
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (ic *ItemCreate) AddAttachments(a ...*Attachment) *ItemCreate {
 	ids := make([]uuid.UUID, len(a))
 	for i := range a {
 		ids[i] = a[i].ID
 	}
-	return ic.AddAssetClasIDs(ids...)
+	return ic.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (ic *ItemCreate) AddCommentIDs(ids ...uuid.UUID) *ItemCreate {
+	ic.mutation.AddCommentIDs(ids...)
+	return ic
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (ic *ItemCreate) AddComments(c ...*Comment) *ItemCreate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return ic.AddCommentIDs(ids...)
+}
+
+// SetReachabilityID sets the "reachability" edge to the Reachability entity by ID.
+func (ic *ItemCreate) SetReachabilityID(id uuid.UUID) *ItemCreate {
+	ic.mutation.SetReachabilityID(id)
+	return ic
+}
+
+// SetNillableReachabilityID sets the "reachability" edge to the Reachability entity by ID if the given value is not nil.
+func (ic *ItemCreate) SetNillableReachabilityID(id *uuid.UUID) *ItemCreate {
+	if id != nil {
+		ic = ic.SetReachabilityID(*id)
+	}
+	return ic
+}
+
+// SetReachability sets the "reachability" edge to the Reachability entity.
+func (ic *ItemCreate) SetReachability(r *Reachability) *ItemCreate {
+	return ic.SetReachabilityID(r.ID)
 }
 
 // Mutation returns the ItemMutation object of the builder.
@@ -230,6 +412,21 @@ func (ic *ItemCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Item.name": %w`, err)}
 		}
 	}
+	if v, ok := ic.mutation.CostAmount(); ok {
+		if err := item.CostAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cost_amount", err: fmt.Errorf(`ent: validator failed for field "Item.cost_amount": %w`, err)}
+		}
+	}
+	if v, ok := ic.mutation.CostCurrency(); ok {
+		if err := item.CostCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "cost_currency", err: fmt.Errorf(`ent: validator failed for field "Item.cost_currency": %w`, err)}
+		}
+	}
+	if v, ok := ic.mutation.CostPeriod(); ok {
+		if err := item.CostPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "cost_period", err: fmt.Errorf(`ent: validator failed for field "Item.cost_period": %w`, err)}
+		}
+	}
 	if _, ok := ic.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Item.created_by"`)}
 	}
@@ -288,6 +485,30 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := ic.mutation.Attributes(); ok {
+		_spec.SetField(item.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := ic.mutation.Secrets(); ok {
+		_spec.SetField(item.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
+	if value, ok := ic.mutation.CostAmount(); ok {
+		_spec.SetField(item.FieldCostAmount, field.TypeFloat64, value)
+		_node.CostAmount = &value
+	}
+	if value, ok := ic.mutation.CostCurrency(); ok {
+		_spec.SetField(item.FieldCostCurrency, field.TypeString, value)
+		_node.CostCurrency = value
+	}
+	if value, ok := ic.mutation.CostPeriod(); ok {
+		_spec.SetField(item.FieldCostPeriod, field.TypeEnum, value)
+		_node.CostPeriod = &value
+	}
+	if value, ok := ic.mutation.CostCenter(); ok {
+		_spec.SetField(item.FieldCostCenter, field.TypeString, value)
+		_node.CostCenter = value
+	}
 	if value, ok := ic.mutation.CreatedBy(); ok {
 		_spec.SetField(item.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
@@ -314,10 +535,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 	}
 	if nodes := ic.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -330,10 +551,10 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 	}
 	if nodes := ic.mutation.UserGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -346,7 +567,7 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 	}
 	if nodes := ic.mutation.AssetClassIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   item.AssetClassTable,
 			Columns: []string{item.AssetClassColumn},
@@ -358,6 +579,120 @@ func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_node.item_asset_class = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.item_children = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.OutgoingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.IncomingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ic.mutation.ReachabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.ReachabilityTable,
+			Columns: []string{item.ReachabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
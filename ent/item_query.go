@@ -6,8 +6,12 @@ import (
 	"context"
 	"database/sql/driver"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/comment"
 	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
 	"dig-inv/ent/predicate"
+	"dig-inv/ent/reachability"
 	"dig-inv/ent/tag"
 	"dig-inv/ent/usergroup"
 	"fmt"
@@ -23,14 +27,21 @@ import (
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	ctx            *QueryContext
-	order          []item.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Item
-	withTags       *TagQuery
-	withUserGroups *UserGroupQuery
-	withAssetClass *AssetClassQuery
-	withFKs        bool
+	ctx                   *QueryContext
+	order                 []item.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Item
+	withTags              *TagQuery
+	withUserGroups        *UserGroupQuery
+	withAssetClass        *AssetClassQuery
+	withParent            *ItemQuery
+	withChildren          *ItemQuery
+	withOutgoingRelations *ItemRelationQuery
+	withIncomingRelations *ItemRelationQuery
+	withAttachments       *AttachmentQuery
+	withComments          *CommentQuery
+	withReachability      *ReachabilityQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -81,7 +92,7 @@ func (iq *ItemQuery) QueryTags() *TagQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(item.Table, item.FieldID, selector),
 			sqlgraph.To(tag.Table, tag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.TagsTable, item.TagsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, item.TagsTable, item.TagsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
 		return fromU, nil
@@ -103,7 +114,7 @@ func (iq *ItemQuery) QueryUserGroups() *UserGroupQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(item.Table, item.FieldID, selector),
 			sqlgraph.To(usergroup.Table, usergroup.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.UserGroupsTable, item.UserGroupsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, item.UserGroupsTable, item.UserGroupsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
 		return fromU, nil
@@ -125,7 +136,161 @@ func (iq *ItemQuery) QueryAssetClass() *AssetClassQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(item.Table, item.FieldID, selector),
 			sqlgraph.To(assetclass.Table, assetclass.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.AssetClassTable, item.AssetClassColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, item.AssetClassTable, item.AssetClassColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (iq *ItemQuery) QueryParent() *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.ParentTable, item.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (iq *ItemQuery) QueryChildren() *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.ChildrenTable, item.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOutgoingRelations chains the current query on the "outgoing_relations" edge.
+func (iq *ItemQuery) QueryOutgoingRelations() *ItemRelationQuery {
+	query := (&ItemRelationClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemrelation.Table, itemrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, item.OutgoingRelationsTable, item.OutgoingRelationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncomingRelations chains the current query on the "incoming_relations" edge.
+func (iq *ItemQuery) QueryIncomingRelations() *ItemRelationQuery {
+	query := (&ItemRelationClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(itemrelation.Table, itemrelation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, item.IncomingRelationsTable, item.IncomingRelationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAttachments chains the current query on the "attachments" edge.
+func (iq *ItemQuery) QueryAttachments() *AttachmentQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(attachment.Table, attachment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, item.AttachmentsTable, item.AttachmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryComments chains the current query on the "comments" edge.
+func (iq *ItemQuery) QueryComments() *CommentQuery {
+	query := (&CommentClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(comment.Table, comment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, item.CommentsTable, item.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReachability chains the current query on the "reachability" edge.
+func (iq *ItemQuery) QueryReachability() *ReachabilityQuery {
+	query := (&ReachabilityClient{config: iq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := iq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(reachability.Table, reachability.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, item.ReachabilityTable, item.ReachabilityColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
 		return fromU, nil
@@ -320,14 +485,21 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 		return nil
 	}
 	return &ItemQuery{
-		config:         iq.config,
-		ctx:            iq.ctx.Clone(),
-		order:          append([]item.OrderOption{}, iq.order...),
-		inters:         append([]Interceptor{}, iq.inters...),
-		predicates:     append([]predicate.Item{}, iq.predicates...),
-		withTags:       iq.withTags.Clone(),
-		withUserGroups: iq.withUserGroups.Clone(),
-		withAssetClass: iq.withAssetClass.Clone(),
+		config:                iq.config,
+		ctx:                   iq.ctx.Clone(),
+		order:                 append([]item.OrderOption{}, iq.order...),
+		inters:                append([]Interceptor{}, iq.inters...),
+		predicates:            append([]predicate.Item{}, iq.predicates...),
+		withTags:              iq.withTags.Clone(),
+		withUserGroups:        iq.withUserGroups.Clone(),
+		withAssetClass:        iq.withAssetClass.Clone(),
+		withParent:            iq.withParent.Clone(),
+		withChildren:          iq.withChildren.Clone(),
+		withOutgoingRelations: iq.withOutgoingRelations.Clone(),
+		withIncomingRelations: iq.withIncomingRelations.Clone(),
+		withAttachments:       iq.withAttachments.Clone(),
+		withComments:          iq.withComments.Clone(),
+		withReachability:      iq.withReachability.Clone(),
 		// clone intermediate query.
 		sql:  iq.sql.Clone(),
 		path: iq.path,
@@ -367,6 +539,83 @@ func (iq *ItemQuery) WithAssetClass(opts ...func(*AssetClassQuery)) *ItemQuery {
 	return iq
 }
 
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithParent(opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withParent = query
+	return iq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithChildren(opts ...func(*ItemQuery)) *ItemQuery {
+	query := (&ItemClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withChildren = query
+	return iq
+}
+
+// WithOutgoingRelations tells the query-builder to eager-load the nodes that are connected to
+// the "outgoing_relations" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithOutgoingRelations(opts ...func(*ItemRelationQuery)) *ItemQuery {
+	query := (&ItemRelationClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withOutgoingRelations = query
+	return iq
+}
+
+// WithIncomingRelations tells the query-builder to eager-load the nodes that are connected to
+// the "incoming_relations" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithIncomingRelations(opts ...func(*ItemRelationQuery)) *ItemQuery {
+	query := (&ItemRelationClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withIncomingRelations = query
+	return iq
+}
+
+// WithAttachments tells the query-builder to eager-load the nodes that are connected to
+// the "attachments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithAttachments(opts ...func(*AttachmentQuery)) *ItemQuery {
+	query := (&AttachmentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withAttachments = query
+	return iq
+}
+
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithComments(opts ...func(*CommentQuery)) *ItemQuery {
+	query := (&CommentClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withComments = query
+	return iq
+}
+
+// WithReachability tells the query-builder to eager-load the nodes that are connected to
+// the "reachability" edge. The optional arguments are used to configure the query builder of the edge.
+func (iq *ItemQuery) WithReachability(opts ...func(*ReachabilityQuery)) *ItemQuery {
+	query := (&ReachabilityClient{config: iq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withReachability = query
+	return iq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -446,12 +695,22 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		nodes       = []*Item{}
 		withFKs     = iq.withFKs
 		_spec       = iq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [10]bool{
 			iq.withTags != nil,
 			iq.withUserGroups != nil,
 			iq.withAssetClass != nil,
+			iq.withParent != nil,
+			iq.withChildren != nil,
+			iq.withOutgoingRelations != nil,
+			iq.withIncomingRelations != nil,
+			iq.withAttachments != nil,
+			iq.withComments != nil,
+			iq.withReachability != nil,
 		}
 	)
+	if iq.withAssetClass != nil || iq.withParent != nil {
+		withFKs = true
+	}
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, item.ForeignKeys...)
 	}
@@ -488,9 +747,55 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 		}
 	}
 	if query := iq.withAssetClass; query != nil {
-		if err := iq.loadAssetClass(ctx, query, nodes,
-			func(n *Item) { n.Edges.AssetClass = []*AssetClass{} },
-			func(n *Item, e *AssetClass) { n.Edges.AssetClass = append(n.Edges.AssetClass, e) }); err != nil {
+		if err := iq.loadAssetClass(ctx, query, nodes, nil,
+			func(n *Item, e *AssetClass) { n.Edges.AssetClass = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withParent; query != nil {
+		if err := iq.loadParent(ctx, query, nodes, nil,
+			func(n *Item, e *Item) { n.Edges.Parent = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withChildren; query != nil {
+		if err := iq.loadChildren(ctx, query, nodes,
+			func(n *Item) { n.Edges.Children = []*Item{} },
+			func(n *Item, e *Item) { n.Edges.Children = append(n.Edges.Children, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withOutgoingRelations; query != nil {
+		if err := iq.loadOutgoingRelations(ctx, query, nodes,
+			func(n *Item) { n.Edges.OutgoingRelations = []*ItemRelation{} },
+			func(n *Item, e *ItemRelation) { n.Edges.OutgoingRelations = append(n.Edges.OutgoingRelations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withIncomingRelations; query != nil {
+		if err := iq.loadIncomingRelations(ctx, query, nodes,
+			func(n *Item) { n.Edges.IncomingRelations = []*ItemRelation{} },
+			func(n *Item, e *ItemRelation) { n.Edges.IncomingRelations = append(n.Edges.IncomingRelations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withAttachments; query != nil {
+		if err := iq.loadAttachments(ctx, query, nodes,
+			func(n *Item) { n.Edges.Attachments = []*Attachment{} },
+			func(n *Item, e *Attachment) { n.Edges.Attachments = append(n.Edges.Attachments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withComments; query != nil {
+		if err := iq.loadComments(ctx, query, nodes,
+			func(n *Item) { n.Edges.Comments = []*Comment{} },
+			func(n *Item, e *Comment) { n.Edges.Comments = append(n.Edges.Comments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := iq.withReachability; query != nil {
+		if err := iq.loadReachability(ctx, query, nodes, nil,
+			func(n *Item, e *Reachability) { n.Edges.Reachability = e }); err != nil {
 			return nil, err
 		}
 	}
@@ -498,6 +803,192 @@ func (iq *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 }
 
 func (iq *ItemQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Item, init func(*Item), assign func(*Item, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Item)
+	nids := make(map[uuid.UUID]map[*Item]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(item.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(item.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(item.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(item.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Item]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadUserGroups(ctx context.Context, query *UserGroupQuery, nodes []*Item, init func(*Item), assign func(*Item, *UserGroup)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Item)
+	nids := make(map[uuid.UUID]map[*Item]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(item.UserGroupsTable)
+		s.Join(joinT).On(s.C(usergroup.FieldID), joinT.C(item.UserGroupsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(item.UserGroupsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(item.UserGroupsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Item]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*UserGroup](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "user_groups" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadAssetClass(ctx context.Context, query *AssetClassQuery, nodes []*Item, init func(*Item), assign func(*Item, *AssetClass)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Item)
+	for i := range nodes {
+		if nodes[i].item_asset_class == nil {
+			continue
+		}
+		fk := *nodes[i].item_asset_class
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(assetclass.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_asset_class" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadParent(ctx context.Context, query *ItemQuery, nodes []*Item, init func(*Item), assign func(*Item, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Item)
+	for i := range nodes {
+		if nodes[i].item_children == nil {
+			continue
+		}
+		fk := *nodes[i].item_children
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_children" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (iq *ItemQuery) loadChildren(ctx context.Context, query *ItemQuery, nodes []*Item, init func(*Item), assign func(*Item, *Item)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Item)
 	for i := range nodes {
@@ -508,27 +999,27 @@ func (iq *ItemQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Ite
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.Tag(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(item.TagsColumn), fks...))
+	query.Where(predicate.Item(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.ChildrenColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.item_tags
+		fk := n.item_children
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "item_tags" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "item_children" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "item_tags" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "item_children" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (iq *ItemQuery) loadUserGroups(ctx context.Context, query *UserGroupQuery, nodes []*Item, init func(*Item), assign func(*Item, *UserGroup)) error {
+func (iq *ItemQuery) loadOutgoingRelations(ctx context.Context, query *ItemRelationQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemRelation)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Item)
 	for i := range nodes {
@@ -539,27 +1030,89 @@ func (iq *ItemQuery) loadUserGroups(ctx context.Context, query *UserGroupQuery,
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.UserGroup(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(item.UserGroupsColumn), fks...))
+	query.Where(predicate.ItemRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.OutgoingRelationsColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.item_user_groups
+		fk := n.item_relation_source
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "item_user_groups" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "item_relation_source" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "item_user_groups" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "item_relation_source" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
 	return nil
 }
-func (iq *ItemQuery) loadAssetClass(ctx context.Context, query *AssetClassQuery, nodes []*Item, init func(*Item), assign func(*Item, *AssetClass)) error {
+func (iq *ItemQuery) loadIncomingRelations(ctx context.Context, query *ItemRelationQuery, nodes []*Item, init func(*Item), assign func(*Item, *ItemRelation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ItemRelation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.IncomingRelationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.item_relation_target
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "item_relation_target" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_relation_target" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadAttachments(ctx context.Context, query *AttachmentQuery, nodes []*Item, init func(*Item), assign func(*Item, *Attachment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Attachment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.AttachmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.attachment_item
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "attachment_item" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "attachment_item" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadComments(ctx context.Context, query *CommentQuery, nodes []*Item, init func(*Item), assign func(*Item, *Comment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Item)
 	for i := range nodes {
@@ -570,21 +1123,49 @@ func (iq *ItemQuery) loadAssetClass(ctx context.Context, query *AssetClassQuery,
 		}
 	}
 	query.withFKs = true
-	query.Where(predicate.AssetClass(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(item.AssetClassColumn), fks...))
+	query.Where(predicate.Comment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.comment_item
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "comment_item" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "comment_item" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (iq *ItemQuery) loadReachability(ctx context.Context, query *ReachabilityQuery, nodes []*Item, init func(*Item), assign func(*Item, *Reachability)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.Reachability(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.ReachabilityColumn), fks...))
 	}))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.item_asset_class
+		fk := n.item_reachability
 		if fk == nil {
-			return fmt.Errorf(`foreign-key "item_asset_class" is nil for node %v`, n.ID)
+			return fmt.Errorf(`foreign-key "item_reachability" is nil for node %v`, n.ID)
 		}
 		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "item_asset_class" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "item_reachability" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
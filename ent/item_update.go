@@ -5,8 +5,13 @@ package ent
 import (
 	"context"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/comment"
 	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
 	"dig-inv/ent/predicate"
+	"dig-inv/ent/reachability"
+	"dig-inv/ent/schema"
 	"dig-inv/ent/tag"
 	"dig-inv/ent/usergroup"
 	"errors"
@@ -66,6 +71,117 @@ func (iu *ItemUpdate) ClearDescription() *ItemUpdate {
 	return iu
 }
 
+// SetAttributes sets the "attributes" field.
+func (iu *ItemUpdate) SetAttributes(m map[string]interface{}) *ItemUpdate {
+	iu.mutation.SetAttributes(m)
+	return iu
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (iu *ItemUpdate) ClearAttributes() *ItemUpdate {
+	iu.mutation.ClearAttributes()
+	return iu
+}
+
+// SetSecrets sets the "secrets" field.
+func (iu *ItemUpdate) SetSecrets(mv map[string]schema.EncryptedValue) *ItemUpdate {
+	iu.mutation.SetSecrets(mv)
+	return iu
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (iu *ItemUpdate) ClearSecrets() *ItemUpdate {
+	iu.mutation.ClearSecrets()
+	return iu
+}
+
+// SetCostAmount sets the "cost_amount" field.
+func (iu *ItemUpdate) SetCostAmount(f float64) *ItemUpdate {
+	iu.mutation.ResetCostAmount()
+	iu.mutation.SetCostAmount(f)
+	return iu
+}
+
+// SetNillableCostAmount sets the "cost_amount" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCostAmount(f *float64) *ItemUpdate {
+	if f != nil {
+		iu.SetCostAmount(*f)
+	}
+	return iu
+}
+
+// AddCostAmount adds f to the "cost_amount" field.
+func (iu *ItemUpdate) AddCostAmount(f float64) *ItemUpdate {
+	iu.mutation.AddCostAmount(f)
+	return iu
+}
+
+// ClearCostAmount clears the value of the "cost_amount" field.
+func (iu *ItemUpdate) ClearCostAmount() *ItemUpdate {
+	iu.mutation.ClearCostAmount()
+	return iu
+}
+
+// SetCostCurrency sets the "cost_currency" field.
+func (iu *ItemUpdate) SetCostCurrency(s string) *ItemUpdate {
+	iu.mutation.SetCostCurrency(s)
+	return iu
+}
+
+// SetNillableCostCurrency sets the "cost_currency" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCostCurrency(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetCostCurrency(*s)
+	}
+	return iu
+}
+
+// ClearCostCurrency clears the value of the "cost_currency" field.
+func (iu *ItemUpdate) ClearCostCurrency() *ItemUpdate {
+	iu.mutation.ClearCostCurrency()
+	return iu
+}
+
+// SetCostPeriod sets the "cost_period" field.
+func (iu *ItemUpdate) SetCostPeriod(ip item.CostPeriod) *ItemUpdate {
+	iu.mutation.SetCostPeriod(ip)
+	return iu
+}
+
+// SetNillableCostPeriod sets the "cost_period" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCostPeriod(ip *item.CostPeriod) *ItemUpdate {
+	if ip != nil {
+		iu.SetCostPeriod(*ip)
+	}
+	return iu
+}
+
+// ClearCostPeriod clears the value of the "cost_period" field.
+func (iu *ItemUpdate) ClearCostPeriod() *ItemUpdate {
+	iu.mutation.ClearCostPeriod()
+	return iu
+}
+
+// SetCostCenter sets the "cost_center" field.
+func (iu *ItemUpdate) SetCostCenter(s string) *ItemUpdate {
+	iu.mutation.SetCostCenter(s)
+	return iu
+}
+
+// SetNillableCostCenter sets the "cost_center" field if the given value is not nil.
+func (iu *ItemUpdate) SetNillableCostCenter(s *string) *ItemUpdate {
+	if s != nil {
+		iu.SetCostCenter(*s)
+	}
+	return iu
+}
+
+// ClearCostCenter clears the value of the "cost_center" field.
+func (iu *ItemUpdate) ClearCostCenter() *ItemUpdate {
+	iu.mutation.ClearCostCenter()
+	return iu
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (iu *ItemUpdate) SetCreatedBy(s string) *ItemUpdate {
 	iu.mutation.SetCreatedBy(s)
@@ -170,19 +286,128 @@ func (iu *ItemUpdate) AddUserGroups(u ...*UserGroup) *ItemUpdate {
 	return iu.AddUserGroupIDs(ids...)
 }
 
-// AddAssetClasIDs adds the "asset_class" edge to the AssetClass entity by IDs.
-func (iu *ItemUpdate) AddAssetClasIDs(ids ...uuid.UUID) *ItemUpdate {
-	iu.mutation.AddAssetClasIDs(ids...)
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (iu *ItemUpdate) SetAssetClassID(id uuid.UUID) *ItemUpdate {
+	iu.mutation.SetAssetClassID(id)
+	return iu
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (iu *ItemUpdate) SetAssetClass(a *AssetClass) *ItemUpdate {
+	return iu.SetAssetClassID(a.ID)
+}
+
+// SetParentID sets the "parent" edge to the Item entity by ID.
+func (iu *ItemUpdate) SetParentID(id uuid.UUID) *ItemUpdate {
+	iu.mutation.SetParentID(id)
+	return iu
+}
+
+// SetNillableParentID sets the "parent" edge to the Item entity by ID if the given value is not nil.
+func (iu *ItemUpdate) SetNillableParentID(id *uuid.UUID) *ItemUpdate {
+	if id != nil {
+		iu = iu.SetParentID(*id)
+	}
+	return iu
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (iu *ItemUpdate) SetParent(i *Item) *ItemUpdate {
+	return iu.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (iu *ItemUpdate) AddChildIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddChildIDs(ids...)
+	return iu
+}
+
+// AddChildren adds the "children" edges to the Item entity.
+func (iu *ItemUpdate) AddChildren(i ...*Item) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddChildIDs(ids...)
+}
+
+// AddOutgoingRelationIDs adds the "outgoing_relations" edge to the ItemRelation entity by IDs.
+func (iu *ItemUpdate) AddOutgoingRelationIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddOutgoingRelationIDs(ids...)
+	return iu
+}
+
+// AddOutgoingRelations adds the "outgoing_relations" edges to the ItemRelation entity.
+func (iu *ItemUpdate) AddOutgoingRelations(i ...*ItemRelation) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddOutgoingRelationIDs(ids...)
+}
+
+// AddIncomingRelationIDs adds the "incoming_relations" edge to the ItemRelation entity by IDs.
+func (iu *ItemUpdate) AddIncomingRelationIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddIncomingRelationIDs(ids...)
+	return iu
+}
+
+// AddIncomingRelations adds the "incoming_relations" edges to the ItemRelation entity.
+func (iu *ItemUpdate) AddIncomingRelations(i ...*ItemRelation) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.AddIncomingRelationIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iu *ItemUpdate) AddAttachmentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddAttachmentIDs(ids...)
 	return iu
 }
 
-// AddAssetClass adds the "asset_class" edges to the AssetClass entity.
-func (iu *ItemUpdate) AddAssetClass(a ...*AssetClass) *ItemUpdate {
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iu *ItemUpdate) AddAttachments(a ...*Attachment) *ItemUpdate {
 	ids := make([]uuid.UUID, len(a))
 	for i := range a {
 		ids[i] = a[i].ID
 	}
-	return iu.AddAssetClasIDs(ids...)
+	return iu.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (iu *ItemUpdate) AddCommentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.AddCommentIDs(ids...)
+	return iu
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (iu *ItemUpdate) AddComments(c ...*Comment) *ItemUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.AddCommentIDs(ids...)
+}
+
+// SetReachabilityID sets the "reachability" edge to the Reachability entity by ID.
+func (iu *ItemUpdate) SetReachabilityID(id uuid.UUID) *ItemUpdate {
+	iu.mutation.SetReachabilityID(id)
+	return iu
+}
+
+// SetNillableReachabilityID sets the "reachability" edge to the Reachability entity by ID if the given value is not nil.
+func (iu *ItemUpdate) SetNillableReachabilityID(id *uuid.UUID) *ItemUpdate {
+	if id != nil {
+		iu = iu.SetReachabilityID(*id)
+	}
+	return iu
+}
+
+// SetReachability sets the "reachability" edge to the Reachability entity.
+func (iu *ItemUpdate) SetReachability(r *Reachability) *ItemUpdate {
+	return iu.SetReachabilityID(r.ID)
 }
 
 // Mutation returns the ItemMutation object of the builder.
@@ -232,25 +457,127 @@ func (iu *ItemUpdate) RemoveUserGroups(u ...*UserGroup) *ItemUpdate {
 	return iu.RemoveUserGroupIDs(ids...)
 }
 
-// ClearAssetClass clears all "asset_class" edges to the AssetClass entity.
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
 func (iu *ItemUpdate) ClearAssetClass() *ItemUpdate {
 	iu.mutation.ClearAssetClass()
 	return iu
 }
 
-// RemoveAssetClasIDs removes the "asset_class" edge to AssetClass entities by IDs.
-func (iu *ItemUpdate) RemoveAssetClasIDs(ids ...uuid.UUID) *ItemUpdate {
-	iu.mutation.RemoveAssetClasIDs(ids...)
+// ClearParent clears the "parent" edge to the Item entity.
+func (iu *ItemUpdate) ClearParent() *ItemUpdate {
+	iu.mutation.ClearParent()
+	return iu
+}
+
+// ClearChildren clears all "children" edges to the Item entity.
+func (iu *ItemUpdate) ClearChildren() *ItemUpdate {
+	iu.mutation.ClearChildren()
+	return iu
+}
+
+// RemoveChildIDs removes the "children" edge to Item entities by IDs.
+func (iu *ItemUpdate) RemoveChildIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveChildIDs(ids...)
+	return iu
+}
+
+// RemoveChildren removes "children" edges to Item entities.
+func (iu *ItemUpdate) RemoveChildren(i ...*Item) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveChildIDs(ids...)
+}
+
+// ClearOutgoingRelations clears all "outgoing_relations" edges to the ItemRelation entity.
+func (iu *ItemUpdate) ClearOutgoingRelations() *ItemUpdate {
+	iu.mutation.ClearOutgoingRelations()
+	return iu
+}
+
+// RemoveOutgoingRelationIDs removes the "outgoing_relations" edge to ItemRelation entities by IDs.
+func (iu *ItemUpdate) RemoveOutgoingRelationIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveOutgoingRelationIDs(ids...)
+	return iu
+}
+
+// RemoveOutgoingRelations removes "outgoing_relations" edges to ItemRelation entities.
+func (iu *ItemUpdate) RemoveOutgoingRelations(i ...*ItemRelation) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveOutgoingRelationIDs(ids...)
+}
+
+// ClearIncomingRelations clears all "incoming_relations" edges to the ItemRelation entity.
+func (iu *ItemUpdate) ClearIncomingRelations() *ItemUpdate {
+	iu.mutation.ClearIncomingRelations()
+	return iu
+}
+
+// RemoveIncomingRelationIDs removes the "incoming_relations" edge to ItemRelation entities by IDs.
+func (iu *ItemUpdate) RemoveIncomingRelationIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveIncomingRelationIDs(ids...)
 	return iu
 }
 
-// RemoveAssetClass removes "asset_class" edges to AssetClass entities.
-func (iu *ItemUpdate) RemoveAssetClass(a ...*AssetClass) *ItemUpdate {
+// RemoveIncomingRelations removes "incoming_relations" edges to ItemRelation entities.
+func (iu *ItemUpdate) RemoveIncomingRelations(i ...*ItemRelation) *ItemUpdate {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iu.RemoveIncomingRelationIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iu *ItemUpdate) ClearAttachments() *ItemUpdate {
+	iu.mutation.ClearAttachments()
+	return iu
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iu *ItemUpdate) RemoveAttachmentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveAttachmentIDs(ids...)
+	return iu
+}
+
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iu *ItemUpdate) RemoveAttachments(a ...*Attachment) *ItemUpdate {
 	ids := make([]uuid.UUID, len(a))
 	for i := range a {
 		ids[i] = a[i].ID
 	}
-	return iu.RemoveAssetClasIDs(ids...)
+	return iu.RemoveAttachmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (iu *ItemUpdate) ClearComments() *ItemUpdate {
+	iu.mutation.ClearComments()
+	return iu
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (iu *ItemUpdate) RemoveCommentIDs(ids ...uuid.UUID) *ItemUpdate {
+	iu.mutation.RemoveCommentIDs(ids...)
+	return iu
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (iu *ItemUpdate) RemoveComments(c ...*Comment) *ItemUpdate {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iu.RemoveCommentIDs(ids...)
+}
+
+// ClearReachability clears the "reachability" edge to the Reachability entity.
+func (iu *ItemUpdate) ClearReachability() *ItemUpdate {
+	iu.mutation.ClearReachability()
+	return iu
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -296,6 +623,24 @@ func (iu *ItemUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Item.name": %w`, err)}
 		}
 	}
+	if v, ok := iu.mutation.CostAmount(); ok {
+		if err := item.CostAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cost_amount", err: fmt.Errorf(`ent: validator failed for field "Item.cost_amount": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.CostCurrency(); ok {
+		if err := item.CostCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "cost_currency", err: fmt.Errorf(`ent: validator failed for field "Item.cost_currency": %w`, err)}
+		}
+	}
+	if v, ok := iu.mutation.CostPeriod(); ok {
+		if err := item.CostPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "cost_period", err: fmt.Errorf(`ent: validator failed for field "Item.cost_period": %w`, err)}
+		}
+	}
+	if iu.mutation.AssetClassCleared() && len(iu.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Item.asset_class"`)
+	}
 	return nil
 }
 
@@ -320,6 +665,45 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if iu.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iu.mutation.Attributes(); ok {
+		_spec.SetField(item.FieldAttributes, field.TypeJSON, value)
+	}
+	if iu.mutation.AttributesCleared() {
+		_spec.ClearField(item.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.Secrets(); ok {
+		_spec.SetField(item.FieldSecrets, field.TypeJSON, value)
+	}
+	if iu.mutation.SecretsCleared() {
+		_spec.ClearField(item.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := iu.mutation.CostAmount(); ok {
+		_spec.SetField(item.FieldCostAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iu.mutation.AddedCostAmount(); ok {
+		_spec.AddField(item.FieldCostAmount, field.TypeFloat64, value)
+	}
+	if iu.mutation.CostAmountCleared() {
+		_spec.ClearField(item.FieldCostAmount, field.TypeFloat64)
+	}
+	if value, ok := iu.mutation.CostCurrency(); ok {
+		_spec.SetField(item.FieldCostCurrency, field.TypeString, value)
+	}
+	if iu.mutation.CostCurrencyCleared() {
+		_spec.ClearField(item.FieldCostCurrency, field.TypeString)
+	}
+	if value, ok := iu.mutation.CostPeriod(); ok {
+		_spec.SetField(item.FieldCostPeriod, field.TypeEnum, value)
+	}
+	if iu.mutation.CostPeriodCleared() {
+		_spec.ClearField(item.FieldCostPeriod, field.TypeEnum)
+	}
+	if value, ok := iu.mutation.CostCenter(); ok {
+		_spec.SetField(item.FieldCostCenter, field.TypeString, value)
+	}
+	if iu.mutation.CostCenterCleared() {
+		_spec.ClearField(item.FieldCostCenter, field.TypeString)
+	}
 	if value, ok := iu.mutation.CreatedBy(); ok {
 		_spec.SetField(item.FieldCreatedBy, field.TypeString, value)
 	}
@@ -343,10 +727,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if iu.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -356,10 +740,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := iu.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iu.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -372,10 +756,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := iu.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -388,10 +772,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if iu.mutation.UserGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -401,10 +785,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := iu.mutation.RemovedUserGroupsIDs(); len(nodes) > 0 && !iu.mutation.UserGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -417,10 +801,10 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if nodes := iu.mutation.UserGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -433,7 +817,7 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	}
 	if iu.mutation.AssetClassCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   item.AssetClassTable,
 			Columns: []string{item.AssetClassColumn},
@@ -444,9 +828,9 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := iu.mutation.RemovedAssetClassIDs(); len(nodes) > 0 && !iu.mutation.AssetClassCleared() {
+	if nodes := iu.mutation.AssetClassIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   item.AssetClassTable,
 			Columns: []string{item.AssetClassColumn},
@@ -458,56 +842,323 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := iu.mutation.AssetClassIDs(); len(nodes) > 0 {
+	if nodes := iu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   item.AssetClassTable,
-			Columns: []string{item.AssetClassColumn},
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !iu.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{item.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if nodes := iu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	iu.mutation.done = true
-	return n, nil
-}
-
-// ItemUpdateOne is the builder for updating a single Item entity.
-type ItemUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *ItemMutation
-}
-
-// SetName sets the "name" field.
-func (iuo *ItemUpdateOne) SetName(s string) *ItemUpdateOne {
-	iuo.mutation.SetName(s)
-	return iuo
-}
-
-// SetNillableName sets the "name" field if the given value is not nil.
-func (iuo *ItemUpdateOne) SetNillableName(s *string) *ItemUpdateOne {
-	if s != nil {
-		iuo.SetName(*s)
+	if iu.mutation.OutgoingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return iuo
+	if nodes := iu.mutation.RemovedOutgoingRelationsIDs(); len(nodes) > 0 && !iu.mutation.OutgoingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.OutgoingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.IncomingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedIncomingRelationsIDs(); len(nodes) > 0 && !iu.mutation.IncomingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.IncomingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iu.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iu.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iu.mutation.ReachabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.ReachabilityTable,
+			Columns: []string{item.ReachabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.ReachabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.ReachabilityTable,
+			Columns: []string{item.ReachabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{item.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// ItemUpdateOne is the builder for updating a single Item entity.
+type ItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemMutation
+}
+
+// SetName sets the "name" field.
+func (iuo *ItemUpdateOne) SetName(s string) *ItemUpdateOne {
+	iuo.mutation.SetName(s)
+	return iuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableName(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetName(*s)
+	}
+	return iuo
 }
 
 // SetDescription sets the "description" field.
@@ -530,6 +1181,117 @@ func (iuo *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
 	return iuo
 }
 
+// SetAttributes sets the "attributes" field.
+func (iuo *ItemUpdateOne) SetAttributes(m map[string]interface{}) *ItemUpdateOne {
+	iuo.mutation.SetAttributes(m)
+	return iuo
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (iuo *ItemUpdateOne) ClearAttributes() *ItemUpdateOne {
+	iuo.mutation.ClearAttributes()
+	return iuo
+}
+
+// SetSecrets sets the "secrets" field.
+func (iuo *ItemUpdateOne) SetSecrets(mv map[string]schema.EncryptedValue) *ItemUpdateOne {
+	iuo.mutation.SetSecrets(mv)
+	return iuo
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (iuo *ItemUpdateOne) ClearSecrets() *ItemUpdateOne {
+	iuo.mutation.ClearSecrets()
+	return iuo
+}
+
+// SetCostAmount sets the "cost_amount" field.
+func (iuo *ItemUpdateOne) SetCostAmount(f float64) *ItemUpdateOne {
+	iuo.mutation.ResetCostAmount()
+	iuo.mutation.SetCostAmount(f)
+	return iuo
+}
+
+// SetNillableCostAmount sets the "cost_amount" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCostAmount(f *float64) *ItemUpdateOne {
+	if f != nil {
+		iuo.SetCostAmount(*f)
+	}
+	return iuo
+}
+
+// AddCostAmount adds f to the "cost_amount" field.
+func (iuo *ItemUpdateOne) AddCostAmount(f float64) *ItemUpdateOne {
+	iuo.mutation.AddCostAmount(f)
+	return iuo
+}
+
+// ClearCostAmount clears the value of the "cost_amount" field.
+func (iuo *ItemUpdateOne) ClearCostAmount() *ItemUpdateOne {
+	iuo.mutation.ClearCostAmount()
+	return iuo
+}
+
+// SetCostCurrency sets the "cost_currency" field.
+func (iuo *ItemUpdateOne) SetCostCurrency(s string) *ItemUpdateOne {
+	iuo.mutation.SetCostCurrency(s)
+	return iuo
+}
+
+// SetNillableCostCurrency sets the "cost_currency" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCostCurrency(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetCostCurrency(*s)
+	}
+	return iuo
+}
+
+// ClearCostCurrency clears the value of the "cost_currency" field.
+func (iuo *ItemUpdateOne) ClearCostCurrency() *ItemUpdateOne {
+	iuo.mutation.ClearCostCurrency()
+	return iuo
+}
+
+// SetCostPeriod sets the "cost_period" field.
+func (iuo *ItemUpdateOne) SetCostPeriod(ip item.CostPeriod) *ItemUpdateOne {
+	iuo.mutation.SetCostPeriod(ip)
+	return iuo
+}
+
+// SetNillableCostPeriod sets the "cost_period" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCostPeriod(ip *item.CostPeriod) *ItemUpdateOne {
+	if ip != nil {
+		iuo.SetCostPeriod(*ip)
+	}
+	return iuo
+}
+
+// ClearCostPeriod clears the value of the "cost_period" field.
+func (iuo *ItemUpdateOne) ClearCostPeriod() *ItemUpdateOne {
+	iuo.mutation.ClearCostPeriod()
+	return iuo
+}
+
+// SetCostCenter sets the "cost_center" field.
+func (iuo *ItemUpdateOne) SetCostCenter(s string) *ItemUpdateOne {
+	iuo.mutation.SetCostCenter(s)
+	return iuo
+}
+
+// SetNillableCostCenter sets the "cost_center" field if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableCostCenter(s *string) *ItemUpdateOne {
+	if s != nil {
+		iuo.SetCostCenter(*s)
+	}
+	return iuo
+}
+
+// ClearCostCenter clears the value of the "cost_center" field.
+func (iuo *ItemUpdateOne) ClearCostCenter() *ItemUpdateOne {
+	iuo.mutation.ClearCostCenter()
+	return iuo
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (iuo *ItemUpdateOne) SetCreatedBy(s string) *ItemUpdateOne {
 	iuo.mutation.SetCreatedBy(s)
@@ -634,19 +1396,128 @@ func (iuo *ItemUpdateOne) AddUserGroups(u ...*UserGroup) *ItemUpdateOne {
 	return iuo.AddUserGroupIDs(ids...)
 }
 
-// AddAssetClasIDs adds the "asset_class" edge to the AssetClass entity by IDs.
-func (iuo *ItemUpdateOne) AddAssetClasIDs(ids ...uuid.UUID) *ItemUpdateOne {
-	iuo.mutation.AddAssetClasIDs(ids...)
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (iuo *ItemUpdateOne) SetAssetClassID(id uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.SetAssetClassID(id)
+	return iuo
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (iuo *ItemUpdateOne) SetAssetClass(a *AssetClass) *ItemUpdateOne {
+	return iuo.SetAssetClassID(a.ID)
+}
+
+// SetParentID sets the "parent" edge to the Item entity by ID.
+func (iuo *ItemUpdateOne) SetParentID(id uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.SetParentID(id)
+	return iuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Item entity by ID if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableParentID(id *uuid.UUID) *ItemUpdateOne {
+	if id != nil {
+		iuo = iuo.SetParentID(*id)
+	}
+	return iuo
+}
+
+// SetParent sets the "parent" edge to the Item entity.
+func (iuo *ItemUpdateOne) SetParent(i *Item) *ItemUpdateOne {
+	return iuo.SetParentID(i.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Item entity by IDs.
+func (iuo *ItemUpdateOne) AddChildIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddChildIDs(ids...)
 	return iuo
 }
 
-// AddAssetClass adds the "asset_class" edges to the AssetClass entity.
-func (iuo *ItemUpdateOne) AddAssetClass(a ...*AssetClass) *ItemUpdateOne {
+// AddChildren adds the "children" edges to the Item entity.
+func (iuo *ItemUpdateOne) AddChildren(i ...*Item) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddChildIDs(ids...)
+}
+
+// AddOutgoingRelationIDs adds the "outgoing_relations" edge to the ItemRelation entity by IDs.
+func (iuo *ItemUpdateOne) AddOutgoingRelationIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddOutgoingRelationIDs(ids...)
+	return iuo
+}
+
+// AddOutgoingRelations adds the "outgoing_relations" edges to the ItemRelation entity.
+func (iuo *ItemUpdateOne) AddOutgoingRelations(i ...*ItemRelation) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddOutgoingRelationIDs(ids...)
+}
+
+// AddIncomingRelationIDs adds the "incoming_relations" edge to the ItemRelation entity by IDs.
+func (iuo *ItemUpdateOne) AddIncomingRelationIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddIncomingRelationIDs(ids...)
+	return iuo
+}
+
+// AddIncomingRelations adds the "incoming_relations" edges to the ItemRelation entity.
+func (iuo *ItemUpdateOne) AddIncomingRelations(i ...*ItemRelation) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.AddIncomingRelationIDs(ids...)
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by IDs.
+func (iuo *ItemUpdateOne) AddAttachmentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddAttachmentIDs(ids...)
+	return iuo
+}
+
+// AddAttachments adds the "attachments" edges to the Attachment entity.
+func (iuo *ItemUpdateOne) AddAttachments(a ...*Attachment) *ItemUpdateOne {
 	ids := make([]uuid.UUID, len(a))
 	for i := range a {
 		ids[i] = a[i].ID
 	}
-	return iuo.AddAssetClasIDs(ids...)
+	return iuo.AddAttachmentIDs(ids...)
+}
+
+// AddCommentIDs adds the "comments" edge to the Comment entity by IDs.
+func (iuo *ItemUpdateOne) AddCommentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.AddCommentIDs(ids...)
+	return iuo
+}
+
+// AddComments adds the "comments" edges to the Comment entity.
+func (iuo *ItemUpdateOne) AddComments(c ...*Comment) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.AddCommentIDs(ids...)
+}
+
+// SetReachabilityID sets the "reachability" edge to the Reachability entity by ID.
+func (iuo *ItemUpdateOne) SetReachabilityID(id uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.SetReachabilityID(id)
+	return iuo
+}
+
+// SetNillableReachabilityID sets the "reachability" edge to the Reachability entity by ID if the given value is not nil.
+func (iuo *ItemUpdateOne) SetNillableReachabilityID(id *uuid.UUID) *ItemUpdateOne {
+	if id != nil {
+		iuo = iuo.SetReachabilityID(*id)
+	}
+	return iuo
+}
+
+// SetReachability sets the "reachability" edge to the Reachability entity.
+func (iuo *ItemUpdateOne) SetReachability(r *Reachability) *ItemUpdateOne {
+	return iuo.SetReachabilityID(r.ID)
 }
 
 // Mutation returns the ItemMutation object of the builder.
@@ -696,25 +1567,127 @@ func (iuo *ItemUpdateOne) RemoveUserGroups(u ...*UserGroup) *ItemUpdateOne {
 	return iuo.RemoveUserGroupIDs(ids...)
 }
 
-// ClearAssetClass clears all "asset_class" edges to the AssetClass entity.
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
 func (iuo *ItemUpdateOne) ClearAssetClass() *ItemUpdateOne {
 	iuo.mutation.ClearAssetClass()
 	return iuo
 }
 
-// RemoveAssetClasIDs removes the "asset_class" edge to AssetClass entities by IDs.
-func (iuo *ItemUpdateOne) RemoveAssetClasIDs(ids ...uuid.UUID) *ItemUpdateOne {
-	iuo.mutation.RemoveAssetClasIDs(ids...)
+// ClearParent clears the "parent" edge to the Item entity.
+func (iuo *ItemUpdateOne) ClearParent() *ItemUpdateOne {
+	iuo.mutation.ClearParent()
+	return iuo
+}
+
+// ClearChildren clears all "children" edges to the Item entity.
+func (iuo *ItemUpdateOne) ClearChildren() *ItemUpdateOne {
+	iuo.mutation.ClearChildren()
+	return iuo
+}
+
+// RemoveChildIDs removes the "children" edge to Item entities by IDs.
+func (iuo *ItemUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveChildIDs(ids...)
+	return iuo
+}
+
+// RemoveChildren removes "children" edges to Item entities.
+func (iuo *ItemUpdateOne) RemoveChildren(i ...*Item) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveChildIDs(ids...)
+}
+
+// ClearOutgoingRelations clears all "outgoing_relations" edges to the ItemRelation entity.
+func (iuo *ItemUpdateOne) ClearOutgoingRelations() *ItemUpdateOne {
+	iuo.mutation.ClearOutgoingRelations()
+	return iuo
+}
+
+// RemoveOutgoingRelationIDs removes the "outgoing_relations" edge to ItemRelation entities by IDs.
+func (iuo *ItemUpdateOne) RemoveOutgoingRelationIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveOutgoingRelationIDs(ids...)
+	return iuo
+}
+
+// RemoveOutgoingRelations removes "outgoing_relations" edges to ItemRelation entities.
+func (iuo *ItemUpdateOne) RemoveOutgoingRelations(i ...*ItemRelation) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveOutgoingRelationIDs(ids...)
+}
+
+// ClearIncomingRelations clears all "incoming_relations" edges to the ItemRelation entity.
+func (iuo *ItemUpdateOne) ClearIncomingRelations() *ItemUpdateOne {
+	iuo.mutation.ClearIncomingRelations()
+	return iuo
+}
+
+// RemoveIncomingRelationIDs removes the "incoming_relations" edge to ItemRelation entities by IDs.
+func (iuo *ItemUpdateOne) RemoveIncomingRelationIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveIncomingRelationIDs(ids...)
+	return iuo
+}
+
+// RemoveIncomingRelations removes "incoming_relations" edges to ItemRelation entities.
+func (iuo *ItemUpdateOne) RemoveIncomingRelations(i ...*ItemRelation) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(i))
+	for j := range i {
+		ids[j] = i[j].ID
+	}
+	return iuo.RemoveIncomingRelationIDs(ids...)
+}
+
+// ClearAttachments clears all "attachments" edges to the Attachment entity.
+func (iuo *ItemUpdateOne) ClearAttachments() *ItemUpdateOne {
+	iuo.mutation.ClearAttachments()
+	return iuo
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to Attachment entities by IDs.
+func (iuo *ItemUpdateOne) RemoveAttachmentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveAttachmentIDs(ids...)
 	return iuo
 }
 
-// RemoveAssetClass removes "asset_class" edges to AssetClass entities.
-func (iuo *ItemUpdateOne) RemoveAssetClass(a ...*AssetClass) *ItemUpdateOne {
+// RemoveAttachments removes "attachments" edges to Attachment entities.
+func (iuo *ItemUpdateOne) RemoveAttachments(a ...*Attachment) *ItemUpdateOne {
 	ids := make([]uuid.UUID, len(a))
 	for i := range a {
 		ids[i] = a[i].ID
 	}
-	return iuo.RemoveAssetClasIDs(ids...)
+	return iuo.RemoveAttachmentIDs(ids...)
+}
+
+// ClearComments clears all "comments" edges to the Comment entity.
+func (iuo *ItemUpdateOne) ClearComments() *ItemUpdateOne {
+	iuo.mutation.ClearComments()
+	return iuo
+}
+
+// RemoveCommentIDs removes the "comments" edge to Comment entities by IDs.
+func (iuo *ItemUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	iuo.mutation.RemoveCommentIDs(ids...)
+	return iuo
+}
+
+// RemoveComments removes "comments" edges to Comment entities.
+func (iuo *ItemUpdateOne) RemoveComments(c ...*Comment) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return iuo.RemoveCommentIDs(ids...)
+}
+
+// ClearReachability clears the "reachability" edge to the Reachability entity.
+func (iuo *ItemUpdateOne) ClearReachability() *ItemUpdateOne {
+	iuo.mutation.ClearReachability()
+	return iuo
 }
 
 // Where appends a list predicates to the ItemUpdate builder.
@@ -773,6 +1746,24 @@ func (iuo *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Item.name": %w`, err)}
 		}
 	}
+	if v, ok := iuo.mutation.CostAmount(); ok {
+		if err := item.CostAmountValidator(v); err != nil {
+			return &ValidationError{Name: "cost_amount", err: fmt.Errorf(`ent: validator failed for field "Item.cost_amount": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.CostCurrency(); ok {
+		if err := item.CostCurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "cost_currency", err: fmt.Errorf(`ent: validator failed for field "Item.cost_currency": %w`, err)}
+		}
+	}
+	if v, ok := iuo.mutation.CostPeriod(); ok {
+		if err := item.CostPeriodValidator(v); err != nil {
+			return &ValidationError{Name: "cost_period", err: fmt.Errorf(`ent: validator failed for field "Item.cost_period": %w`, err)}
+		}
+	}
+	if iuo.mutation.AssetClassCleared() && len(iuo.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Item.asset_class"`)
+	}
 	return nil
 }
 
@@ -814,6 +1805,45 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	if iuo.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := iuo.mutation.Attributes(); ok {
+		_spec.SetField(item.FieldAttributes, field.TypeJSON, value)
+	}
+	if iuo.mutation.AttributesCleared() {
+		_spec.ClearField(item.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.Secrets(); ok {
+		_spec.SetField(item.FieldSecrets, field.TypeJSON, value)
+	}
+	if iuo.mutation.SecretsCleared() {
+		_spec.ClearField(item.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := iuo.mutation.CostAmount(); ok {
+		_spec.SetField(item.FieldCostAmount, field.TypeFloat64, value)
+	}
+	if value, ok := iuo.mutation.AddedCostAmount(); ok {
+		_spec.AddField(item.FieldCostAmount, field.TypeFloat64, value)
+	}
+	if iuo.mutation.CostAmountCleared() {
+		_spec.ClearField(item.FieldCostAmount, field.TypeFloat64)
+	}
+	if value, ok := iuo.mutation.CostCurrency(); ok {
+		_spec.SetField(item.FieldCostCurrency, field.TypeString, value)
+	}
+	if iuo.mutation.CostCurrencyCleared() {
+		_spec.ClearField(item.FieldCostCurrency, field.TypeString)
+	}
+	if value, ok := iuo.mutation.CostPeriod(); ok {
+		_spec.SetField(item.FieldCostPeriod, field.TypeEnum, value)
+	}
+	if iuo.mutation.CostPeriodCleared() {
+		_spec.ClearField(item.FieldCostPeriod, field.TypeEnum)
+	}
+	if value, ok := iuo.mutation.CostCenter(); ok {
+		_spec.SetField(item.FieldCostCenter, field.TypeString, value)
+	}
+	if iuo.mutation.CostCenterCleared() {
+		_spec.ClearField(item.FieldCostCenter, field.TypeString)
+	}
 	if value, ok := iuo.mutation.CreatedBy(); ok {
 		_spec.SetField(item.FieldCreatedBy, field.TypeString, value)
 	}
@@ -837,10 +1867,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if iuo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -850,10 +1880,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if nodes := iuo.mutation.RemovedTagsIDs(); len(nodes) > 0 && !iuo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -866,10 +1896,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if nodes := iuo.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.TagsTable,
-			Columns: []string{item.TagsColumn},
+			Columns: item.TagsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
@@ -882,10 +1912,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if iuo.mutation.UserGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -895,10 +1925,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if nodes := iuo.mutation.RemovedUserGroupsIDs(); len(nodes) > 0 && !iuo.mutation.UserGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -911,10 +1941,10 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if nodes := iuo.mutation.UserGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2M,
 			Inverse: false,
 			Table:   item.UserGroupsTable,
-			Columns: []string{item.UserGroupsColumn},
+			Columns: item.UserGroupsPrimaryKey,
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
 				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
@@ -927,7 +1957,7 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 	}
 	if iuo.mutation.AssetClassCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   item.AssetClassTable,
 			Columns: []string{item.AssetClassColumn},
@@ -938,9 +1968,9 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := iuo.mutation.RemovedAssetClassIDs(); len(nodes) > 0 && !iuo.mutation.AssetClassCleared() {
+	if nodes := iuo.mutation.AssetClassIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   item.AssetClassTable,
 			Columns: []string{item.AssetClassColumn},
@@ -952,17 +1982,284 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error)
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := iuo.mutation.AssetClassIDs(); len(nodes) > 0 {
+	if nodes := iuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   item.ParentTable,
+			Columns: []string{item.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ChildrenCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   item.AssetClassTable,
-			Columns: []string{item.AssetClassColumn},
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !iuo.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.ChildrenTable,
+			Columns: []string{item.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.OutgoingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedOutgoingRelationsIDs(); len(nodes) > 0 && !iuo.mutation.OutgoingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.OutgoingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.OutgoingRelationsTable,
+			Columns: []string{item.OutgoingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.IncomingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedIncomingRelationsIDs(); len(nodes) > 0 && !iuo.mutation.IncomingRelationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.IncomingRelationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.IncomingRelationsTable,
+			Columns: []string{item.IncomingRelationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedAttachmentsIDs(); len(nodes) > 0 && !iuo.mutation.AttachmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.AttachmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.AttachmentsTable,
+			Columns: []string{item.AttachmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(attachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !iuo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   item.CommentsTable,
+			Columns: []string{item.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(comment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iuo.mutation.ReachabilityCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.ReachabilityTable,
+			Columns: []string{item.ReachabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.ReachabilityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.ReachabilityTable,
+			Columns: []string{item.ReachabilityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
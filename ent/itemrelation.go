@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ItemRelation is the model entity for the ItemRelation schema.
+type ItemRelation struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the relation.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The type of the relation, read as: source <type> target, e.g. a host hosts a virtual machine.
+	Type itemrelation.Type `json:"type,omitempty"`
+	// A description of the relation, which can be used to provide additional information about the relation.
+	Description string `json:"description,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemRelationQuery when eager-loading is set.
+	Edges                ItemRelationEdges `json:"edges"`
+	item_relation_source *uuid.UUID
+	item_relation_target *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ItemRelationEdges holds the relations/edges for other nodes in the graph.
+type ItemRelationEdges struct {
+	// The item the relation starts at.
+	Source *Item `json:"source,omitempty"`
+	// The item the relation points to.
+	Target *Item `json:"target,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// SourceOrErr returns the Source value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemRelationEdges) SourceOrErr() (*Item, error) {
+	if e.Source != nil {
+		return e.Source, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "source"}
+}
+
+// TargetOrErr returns the Target value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemRelationEdges) TargetOrErr() (*Item, error) {
+	if e.Target != nil {
+		return e.Target, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "target"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemRelation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemrelation.FieldType, itemrelation.FieldDescription, itemrelation.FieldCreatedBy, itemrelation.FieldUpdatedBy, itemrelation.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case itemrelation.FieldCreatedAt, itemrelation.FieldUpdatedAt, itemrelation.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case itemrelation.FieldID:
+			values[i] = new(uuid.UUID)
+		case itemrelation.ForeignKeys[0]: // item_relation_source
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case itemrelation.ForeignKeys[1]: // item_relation_target
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemRelation fields.
+func (ir *ItemRelation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemrelation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ir.ID = *value
+			}
+		case itemrelation.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ir.Type = itemrelation.Type(value.String)
+			}
+		case itemrelation.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ir.Description = value.String
+			}
+		case itemrelation.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				ir.CreatedBy = value.String
+			}
+		case itemrelation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ir.CreatedAt = value.Time
+			}
+		case itemrelation.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				ir.UpdatedBy = value.String
+			}
+		case itemrelation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ir.UpdatedAt = value.Time
+			}
+		case itemrelation.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				ir.DeletedBy = value.String
+			}
+		case itemrelation.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				ir.DeletedAt = new(time.Time)
+				*ir.DeletedAt = value.Time
+			}
+		case itemrelation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_relation_source", values[i])
+			} else if value.Valid {
+				ir.item_relation_source = new(uuid.UUID)
+				*ir.item_relation_source = *value.S.(*uuid.UUID)
+			}
+		case itemrelation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_relation_target", values[i])
+			} else if value.Valid {
+				ir.item_relation_target = new(uuid.UUID)
+				*ir.item_relation_target = *value.S.(*uuid.UUID)
+			}
+		default:
+			ir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemRelation.
+// This includes values selected through modifiers, order, etc.
+func (ir *ItemRelation) Value(name string) (ent.Value, error) {
+	return ir.selectValues.Get(name)
+}
+
+// QuerySource queries the "source" edge of the ItemRelation entity.
+func (ir *ItemRelation) QuerySource() *ItemQuery {
+	return NewItemRelationClient(ir.config).QuerySource(ir)
+}
+
+// QueryTarget queries the "target" edge of the ItemRelation entity.
+func (ir *ItemRelation) QueryTarget() *ItemQuery {
+	return NewItemRelationClient(ir.config).QueryTarget(ir)
+}
+
+// Update returns a builder for updating this ItemRelation.
+// Note that you need to call ItemRelation.Unwrap() before calling this method if this ItemRelation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ir *ItemRelation) Update() *ItemRelationUpdateOne {
+	return NewItemRelationClient(ir.config).UpdateOne(ir)
+}
+
+// Unwrap unwraps the ItemRelation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ir *ItemRelation) Unwrap() *ItemRelation {
+	_tx, ok := ir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemRelation is not a transactional entity")
+	}
+	ir.config.driver = _tx.drv
+	return ir
+}
+
+// String implements the fmt.Stringer.
+func (ir *ItemRelation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemRelation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ir.ID))
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", ir.Type))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ir.Description)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(ir.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ir.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(ir.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ir.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(ir.DeletedBy)
+	builder.WriteString(", ")
+	if v := ir.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemRelations is a parsable slice of ItemRelation.
+type ItemRelations []*ItemRelation
@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ItemRelationCreate is the builder for creating a ItemRelation entity.
+type ItemRelationCreate struct {
+	config
+	mutation *ItemRelationMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (irc *ItemRelationCreate) SetType(i itemrelation.Type) *ItemRelationCreate {
+	irc.mutation.SetType(i)
+	return irc
+}
+
+// SetDescription sets the "description" field.
+func (irc *ItemRelationCreate) SetDescription(s string) *ItemRelationCreate {
+	irc.mutation.SetDescription(s)
+	return irc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableDescription(s *string) *ItemRelationCreate {
+	if s != nil {
+		irc.SetDescription(*s)
+	}
+	return irc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (irc *ItemRelationCreate) SetCreatedBy(s string) *ItemRelationCreate {
+	irc.mutation.SetCreatedBy(s)
+	return irc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (irc *ItemRelationCreate) SetCreatedAt(t time.Time) *ItemRelationCreate {
+	irc.mutation.SetCreatedAt(t)
+	return irc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableCreatedAt(t *time.Time) *ItemRelationCreate {
+	if t != nil {
+		irc.SetCreatedAt(*t)
+	}
+	return irc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (irc *ItemRelationCreate) SetUpdatedBy(s string) *ItemRelationCreate {
+	irc.mutation.SetUpdatedBy(s)
+	return irc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (irc *ItemRelationCreate) SetUpdatedAt(t time.Time) *ItemRelationCreate {
+	irc.mutation.SetUpdatedAt(t)
+	return irc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableUpdatedAt(t *time.Time) *ItemRelationCreate {
+	if t != nil {
+		irc.SetUpdatedAt(*t)
+	}
+	return irc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (irc *ItemRelationCreate) SetDeletedBy(s string) *ItemRelationCreate {
+	irc.mutation.SetDeletedBy(s)
+	return irc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableDeletedBy(s *string) *ItemRelationCreate {
+	if s != nil {
+		irc.SetDeletedBy(*s)
+	}
+	return irc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (irc *ItemRelationCreate) SetDeletedAt(t time.Time) *ItemRelationCreate {
+	irc.mutation.SetDeletedAt(t)
+	return irc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableDeletedAt(t *time.Time) *ItemRelationCreate {
+	if t != nil {
+		irc.SetDeletedAt(*t)
+	}
+	return irc
+}
+
+// SetID sets the "id" field.
+func (irc *ItemRelationCreate) SetID(u uuid.UUID) *ItemRelationCreate {
+	irc.mutation.SetID(u)
+	return irc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (irc *ItemRelationCreate) SetNillableID(u *uuid.UUID) *ItemRelationCreate {
+	if u != nil {
+		irc.SetID(*u)
+	}
+	return irc
+}
+
+// SetSourceID sets the "source" edge to the Item entity by ID.
+func (irc *ItemRelationCreate) SetSourceID(id uuid.UUID) *ItemRelationCreate {
+	irc.mutation.SetSourceID(id)
+	return irc
+}
+
+// SetSource sets the "source" edge to the Item entity.
+func (irc *ItemRelationCreate) SetSource(i *Item) *ItemRelationCreate {
+	return irc.SetSourceID(i.ID)
+}
+
+// SetTargetID sets the "target" edge to the Item entity by ID.
+func (irc *ItemRelationCreate) SetTargetID(id uuid.UUID) *ItemRelationCreate {
+	irc.mutation.SetTargetID(id)
+	return irc
+}
+
+// SetTarget sets the "target" edge to the Item entity.
+func (irc *ItemRelationCreate) SetTarget(i *Item) *ItemRelationCreate {
+	return irc.SetTargetID(i.ID)
+}
+
+// Mutation returns the ItemRelationMutation object of the builder.
+func (irc *ItemRelationCreate) Mutation() *ItemRelationMutation {
+	return irc.mutation
+}
+
+// Save creates the ItemRelation in the database.
+func (irc *ItemRelationCreate) Save(ctx context.Context) (*ItemRelation, error) {
+	irc.defaults()
+	return withHooks(ctx, irc.sqlSave, irc.mutation, irc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (irc *ItemRelationCreate) SaveX(ctx context.Context) *ItemRelation {
+	v, err := irc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (irc *ItemRelationCreate) Exec(ctx context.Context) error {
+	_, err := irc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irc *ItemRelationCreate) ExecX(ctx context.Context) {
+	if err := irc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (irc *ItemRelationCreate) defaults() {
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		v := itemrelation.DefaultCreatedAt()
+		irc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		v := itemrelation.DefaultUpdatedAt()
+		irc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := irc.mutation.ID(); !ok {
+		v := itemrelation.DefaultID()
+		irc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (irc *ItemRelationCreate) check() error {
+	if _, ok := irc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ItemRelation.type"`)}
+	}
+	if v, ok := irc.mutation.GetType(); ok {
+		if err := itemrelation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemRelation.type": %w`, err)}
+		}
+	}
+	if _, ok := irc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ItemRelation.created_by"`)}
+	}
+	if _, ok := irc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ItemRelation.created_at"`)}
+	}
+	if _, ok := irc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "ItemRelation.updated_by"`)}
+	}
+	if _, ok := irc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemRelation.updated_at"`)}
+	}
+	if len(irc.mutation.SourceIDs()) == 0 {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required edge "ItemRelation.source"`)}
+	}
+	if len(irc.mutation.TargetIDs()) == 0 {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required edge "ItemRelation.target"`)}
+	}
+	return nil
+}
+
+func (irc *ItemRelationCreate) sqlSave(ctx context.Context) (*ItemRelation, error) {
+	if err := irc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := irc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, irc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	irc.mutation.id = &_node.ID
+	irc.mutation.done = true
+	return _node, nil
+}
+
+func (irc *ItemRelationCreate) createSpec() (*ItemRelation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemRelation{config: irc.config}
+		_spec = sqlgraph.NewCreateSpec(itemrelation.Table, sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID))
+	)
+	if id, ok := irc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := irc.mutation.GetType(); ok {
+		_spec.SetField(itemrelation.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := irc.mutation.Description(); ok {
+		_spec.SetField(itemrelation.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := irc.mutation.CreatedBy(); ok {
+		_spec.SetField(itemrelation.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := irc.mutation.CreatedAt(); ok {
+		_spec.SetField(itemrelation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := irc.mutation.UpdatedBy(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := irc.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := irc.mutation.DeletedBy(); ok {
+		_spec.SetField(itemrelation.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := irc.mutation.DeletedAt(); ok {
+		_spec.SetField(itemrelation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := irc.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.SourceTable,
+			Columns: []string{itemrelation.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.item_relation_source = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := irc.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.TargetTable,
+			Columns: []string{itemrelation.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.item_relation_target = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemRelationCreateBulk is the builder for creating many ItemRelation entities in bulk.
+type ItemRelationCreateBulk struct {
+	config
+	err      error
+	builders []*ItemRelationCreate
+}
+
+// Save creates the ItemRelation entities in the database.
+func (ircb *ItemRelationCreateBulk) Save(ctx context.Context) ([]*ItemRelation, error) {
+	if ircb.err != nil {
+		return nil, ircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ircb.builders))
+	nodes := make([]*ItemRelation, len(ircb.builders))
+	mutators := make([]Mutator, len(ircb.builders))
+	for i := range ircb.builders {
+		func(i int, root context.Context) {
+			builder := ircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemRelationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ircb *ItemRelationCreateBulk) SaveX(ctx context.Context) []*ItemRelation {
+	v, err := ircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ircb *ItemRelationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ircb *ItemRelationCreateBulk) ExecX(ctx context.Context) {
+	if err := ircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ItemRelationDelete is the builder for deleting a ItemRelation entity.
+type ItemRelationDelete struct {
+	config
+	hooks    []Hook
+	mutation *ItemRelationMutation
+}
+
+// Where appends a list predicates to the ItemRelationDelete builder.
+func (ird *ItemRelationDelete) Where(ps ...predicate.ItemRelation) *ItemRelationDelete {
+	ird.mutation.Where(ps...)
+	return ird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ird *ItemRelationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ird.sqlExec, ird.mutation, ird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ird *ItemRelationDelete) ExecX(ctx context.Context) int {
+	n, err := ird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ird *ItemRelationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(itemrelation.Table, sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID))
+	if ps := ird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ird.mutation.done = true
+	return affected, err
+}
+
+// ItemRelationDeleteOne is the builder for deleting a single ItemRelation entity.
+type ItemRelationDeleteOne struct {
+	ird *ItemRelationDelete
+}
+
+// Where appends a list predicates to the ItemRelationDelete builder.
+func (irdo *ItemRelationDeleteOne) Where(ps ...predicate.ItemRelation) *ItemRelationDeleteOne {
+	irdo.ird.mutation.Where(ps...)
+	return irdo
+}
+
+// Exec executes the deletion query.
+func (irdo *ItemRelationDeleteOne) Exec(ctx context.Context) error {
+	n, err := irdo.ird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{itemrelation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (irdo *ItemRelationDeleteOne) ExecX(ctx context.Context) {
+	if err := irdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
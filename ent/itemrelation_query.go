@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/predicate"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ItemRelationQuery is the builder for querying ItemRelation entities.
+type ItemRelationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []itemrelation.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ItemRelation
+	withSource *ItemQuery
+	withTarget *ItemQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ItemRelationQuery builder.
+func (irq *ItemRelationQuery) Where(ps ...predicate.ItemRelation) *ItemRelationQuery {
+	irq.predicates = append(irq.predicates, ps...)
+	return irq
+}
+
+// Limit the number of records to be returned by this query.
+func (irq *ItemRelationQuery) Limit(limit int) *ItemRelationQuery {
+	irq.ctx.Limit = &limit
+	return irq
+}
+
+// Offset to start from.
+func (irq *ItemRelationQuery) Offset(offset int) *ItemRelationQuery {
+	irq.ctx.Offset = &offset
+	return irq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (irq *ItemRelationQuery) Unique(unique bool) *ItemRelationQuery {
+	irq.ctx.Unique = &unique
+	return irq
+}
+
+// Order specifies how the records should be ordered.
+func (irq *ItemRelationQuery) Order(o ...itemrelation.OrderOption) *ItemRelationQuery {
+	irq.order = append(irq.order, o...)
+	return irq
+}
+
+// QuerySource chains the current query on the "source" edge.
+func (irq *ItemRelationQuery) QuerySource() *ItemQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrelation.Table, itemrelation.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, itemrelation.SourceTable, itemrelation.SourceColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTarget chains the current query on the "target" edge.
+func (irq *ItemRelationQuery) QueryTarget() *ItemQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := irq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := irq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemrelation.Table, itemrelation.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, itemrelation.TargetTable, itemrelation.TargetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(irq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ItemRelation entity from the query.
+// Returns a *NotFoundError when no ItemRelation was found.
+func (irq *ItemRelationQuery) First(ctx context.Context) (*ItemRelation, error) {
+	nodes, err := irq.Limit(1).All(setContextOp(ctx, irq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{itemrelation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (irq *ItemRelationQuery) FirstX(ctx context.Context) *ItemRelation {
+	node, err := irq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ItemRelation ID from the query.
+// Returns a *NotFoundError when no ItemRelation ID was found.
+func (irq *ItemRelationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = irq.Limit(1).IDs(setContextOp(ctx, irq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{itemrelation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (irq *ItemRelationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := irq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ItemRelation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ItemRelation entity is found.
+// Returns a *NotFoundError when no ItemRelation entities are found.
+func (irq *ItemRelationQuery) Only(ctx context.Context) (*ItemRelation, error) {
+	nodes, err := irq.Limit(2).All(setContextOp(ctx, irq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{itemrelation.Label}
+	default:
+		return nil, &NotSingularError{itemrelation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (irq *ItemRelationQuery) OnlyX(ctx context.Context) *ItemRelation {
+	node, err := irq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ItemRelation ID in the query.
+// Returns a *NotSingularError when more than one ItemRelation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (irq *ItemRelationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = irq.Limit(2).IDs(setContextOp(ctx, irq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{itemrelation.Label}
+	default:
+		err = &NotSingularError{itemrelation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (irq *ItemRelationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := irq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ItemRelations.
+func (irq *ItemRelationQuery) All(ctx context.Context) ([]*ItemRelation, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryAll)
+	if err := irq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ItemRelation, *ItemRelationQuery]()
+	return withInterceptors[[]*ItemRelation](ctx, irq, qr, irq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (irq *ItemRelationQuery) AllX(ctx context.Context) []*ItemRelation {
+	nodes, err := irq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ItemRelation IDs.
+func (irq *ItemRelationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if irq.ctx.Unique == nil && irq.path != nil {
+		irq.Unique(true)
+	}
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryIDs)
+	if err = irq.Select(itemrelation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (irq *ItemRelationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := irq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (irq *ItemRelationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryCount)
+	if err := irq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, irq, querierCount[*ItemRelationQuery](), irq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (irq *ItemRelationQuery) CountX(ctx context.Context) int {
+	count, err := irq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (irq *ItemRelationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, irq.ctx, ent.OpQueryExist)
+	switch _, err := irq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (irq *ItemRelationQuery) ExistX(ctx context.Context) bool {
+	exist, err := irq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ItemRelationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (irq *ItemRelationQuery) Clone() *ItemRelationQuery {
+	if irq == nil {
+		return nil
+	}
+	return &ItemRelationQuery{
+		config:     irq.config,
+		ctx:        irq.ctx.Clone(),
+		order:      append([]itemrelation.OrderOption{}, irq.order...),
+		inters:     append([]Interceptor{}, irq.inters...),
+		predicates: append([]predicate.ItemRelation{}, irq.predicates...),
+		withSource: irq.withSource.Clone(),
+		withTarget: irq.withTarget.Clone(),
+		// clone intermediate query.
+		sql:  irq.sql.Clone(),
+		path: irq.path,
+	}
+}
+
+// WithSource tells the query-builder to eager-load the nodes that are connected to
+// the "source" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *ItemRelationQuery) WithSource(opts ...func(*ItemQuery)) *ItemRelationQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withSource = query
+	return irq
+}
+
+// WithTarget tells the query-builder to eager-load the nodes that are connected to
+// the "target" edge. The optional arguments are used to configure the query builder of the edge.
+func (irq *ItemRelationQuery) WithTarget(opts ...func(*ItemQuery)) *ItemRelationQuery {
+	query := (&ItemClient{config: irq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	irq.withTarget = query
+	return irq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Type itemrelation.Type `json:"type,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ItemRelation.Query().
+//		GroupBy(itemrelation.FieldType).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (irq *ItemRelationQuery) GroupBy(field string, fields ...string) *ItemRelationGroupBy {
+	irq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ItemRelationGroupBy{build: irq}
+	grbuild.flds = &irq.ctx.Fields
+	grbuild.label = itemrelation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Type itemrelation.Type `json:"type,omitempty"`
+//	}
+//
+//	client.ItemRelation.Query().
+//		Select(itemrelation.FieldType).
+//		Scan(ctx, &v)
+func (irq *ItemRelationQuery) Select(fields ...string) *ItemRelationSelect {
+	irq.ctx.Fields = append(irq.ctx.Fields, fields...)
+	sbuild := &ItemRelationSelect{ItemRelationQuery: irq}
+	sbuild.label = itemrelation.Label
+	sbuild.flds, sbuild.scan = &irq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ItemRelationSelect configured with the given aggregations.
+func (irq *ItemRelationQuery) Aggregate(fns ...AggregateFunc) *ItemRelationSelect {
+	return irq.Select().Aggregate(fns...)
+}
+
+func (irq *ItemRelationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range irq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, irq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range irq.ctx.Fields {
+		if !itemrelation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if irq.path != nil {
+		prev, err := irq.path(ctx)
+		if err != nil {
+			return err
+		}
+		irq.sql = prev
+	}
+	return nil
+}
+
+func (irq *ItemRelationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ItemRelation, error) {
+	var (
+		nodes       = []*ItemRelation{}
+		withFKs     = irq.withFKs
+		_spec       = irq.querySpec()
+		loadedTypes = [2]bool{
+			irq.withSource != nil,
+			irq.withTarget != nil,
+		}
+	)
+	if irq.withSource != nil || irq.withTarget != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrelation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ItemRelation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ItemRelation{config: irq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, irq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := irq.withSource; query != nil {
+		if err := irq.loadSource(ctx, query, nodes, nil,
+			func(n *ItemRelation, e *Item) { n.Edges.Source = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := irq.withTarget; query != nil {
+		if err := irq.loadTarget(ctx, query, nodes, nil,
+			func(n *ItemRelation, e *Item) { n.Edges.Target = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (irq *ItemRelationQuery) loadSource(ctx context.Context, query *ItemQuery, nodes []*ItemRelation, init func(*ItemRelation), assign func(*ItemRelation, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ItemRelation)
+	for i := range nodes {
+		if nodes[i].item_relation_source == nil {
+			continue
+		}
+		fk := *nodes[i].item_relation_source
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_relation_source" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (irq *ItemRelationQuery) loadTarget(ctx context.Context, query *ItemQuery, nodes []*ItemRelation, init func(*ItemRelation), assign func(*ItemRelation, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ItemRelation)
+	for i := range nodes {
+		if nodes[i].item_relation_target == nil {
+			continue
+		}
+		fk := *nodes[i].item_relation_target
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "item_relation_target" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (irq *ItemRelationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := irq.querySpec()
+	_spec.Node.Columns = irq.ctx.Fields
+	if len(irq.ctx.Fields) > 0 {
+		_spec.Unique = irq.ctx.Unique != nil && *irq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, irq.driver, _spec)
+}
+
+func (irq *ItemRelationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(itemrelation.Table, itemrelation.Columns, sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID))
+	_spec.From = irq.sql
+	if unique := irq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if irq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := irq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrelation.FieldID)
+		for i := range fields {
+			if fields[i] != itemrelation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := irq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := irq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (irq *ItemRelationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(irq.driver.Dialect())
+	t1 := builder.Table(itemrelation.Table)
+	columns := irq.ctx.Fields
+	if len(columns) == 0 {
+		columns = itemrelation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if irq.sql != nil {
+		selector = irq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if irq.ctx.Unique != nil && *irq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range irq.predicates {
+		p(selector)
+	}
+	for _, p := range irq.order {
+		p(selector)
+	}
+	if offset := irq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := irq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ItemRelationGroupBy is the group-by builder for ItemRelation entities.
+type ItemRelationGroupBy struct {
+	selector
+	build *ItemRelationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (irgb *ItemRelationGroupBy) Aggregate(fns ...AggregateFunc) *ItemRelationGroupBy {
+	irgb.fns = append(irgb.fns, fns...)
+	return irgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irgb *ItemRelationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irgb.build.ctx, ent.OpQueryGroupBy)
+	if err := irgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemRelationQuery, *ItemRelationGroupBy](ctx, irgb.build, irgb, irgb.build.inters, v)
+}
+
+func (irgb *ItemRelationGroupBy) sqlScan(ctx context.Context, root *ItemRelationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(irgb.fns))
+	for _, fn := range irgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*irgb.flds)+len(irgb.fns))
+		for _, f := range *irgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*irgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ItemRelationSelect is the builder for selecting fields of ItemRelation entities.
+type ItemRelationSelect struct {
+	*ItemRelationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (irs *ItemRelationSelect) Aggregate(fns ...AggregateFunc) *ItemRelationSelect {
+	irs.fns = append(irs.fns, fns...)
+	return irs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (irs *ItemRelationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, irs.ctx, ent.OpQuerySelect)
+	if err := irs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ItemRelationQuery, *ItemRelationSelect](ctx, irs.ItemRelationQuery, irs, irs.inters, v)
+}
+
+func (irs *ItemRelationSelect) sqlScan(ctx context.Context, root *ItemRelationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(irs.fns))
+	for _, fn := range irs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*irs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := irs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
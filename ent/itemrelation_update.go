@@ -0,0 +1,694 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ItemRelationUpdate is the builder for updating ItemRelation entities.
+type ItemRelationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ItemRelationMutation
+}
+
+// Where appends a list predicates to the ItemRelationUpdate builder.
+func (iru *ItemRelationUpdate) Where(ps ...predicate.ItemRelation) *ItemRelationUpdate {
+	iru.mutation.Where(ps...)
+	return iru
+}
+
+// SetType sets the "type" field.
+func (iru *ItemRelationUpdate) SetType(i itemrelation.Type) *ItemRelationUpdate {
+	iru.mutation.SetType(i)
+	return iru
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableType(i *itemrelation.Type) *ItemRelationUpdate {
+	if i != nil {
+		iru.SetType(*i)
+	}
+	return iru
+}
+
+// SetDescription sets the "description" field.
+func (iru *ItemRelationUpdate) SetDescription(s string) *ItemRelationUpdate {
+	iru.mutation.SetDescription(s)
+	return iru
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableDescription(s *string) *ItemRelationUpdate {
+	if s != nil {
+		iru.SetDescription(*s)
+	}
+	return iru
+}
+
+// ClearDescription clears the value of the "description" field.
+func (iru *ItemRelationUpdate) ClearDescription() *ItemRelationUpdate {
+	iru.mutation.ClearDescription()
+	return iru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iru *ItemRelationUpdate) SetCreatedBy(s string) *ItemRelationUpdate {
+	iru.mutation.SetCreatedBy(s)
+	return iru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableCreatedBy(s *string) *ItemRelationUpdate {
+	if s != nil {
+		iru.SetCreatedBy(*s)
+	}
+	return iru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iru *ItemRelationUpdate) SetUpdatedBy(s string) *ItemRelationUpdate {
+	iru.mutation.SetUpdatedBy(s)
+	return iru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableUpdatedBy(s *string) *ItemRelationUpdate {
+	if s != nil {
+		iru.SetUpdatedBy(*s)
+	}
+	return iru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iru *ItemRelationUpdate) SetUpdatedAt(t time.Time) *ItemRelationUpdate {
+	iru.mutation.SetUpdatedAt(t)
+	return iru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (iru *ItemRelationUpdate) SetDeletedBy(s string) *ItemRelationUpdate {
+	iru.mutation.SetDeletedBy(s)
+	return iru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableDeletedBy(s *string) *ItemRelationUpdate {
+	if s != nil {
+		iru.SetDeletedBy(*s)
+	}
+	return iru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (iru *ItemRelationUpdate) ClearDeletedBy() *ItemRelationUpdate {
+	iru.mutation.ClearDeletedBy()
+	return iru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iru *ItemRelationUpdate) SetDeletedAt(t time.Time) *ItemRelationUpdate {
+	iru.mutation.SetDeletedAt(t)
+	return iru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iru *ItemRelationUpdate) SetNillableDeletedAt(t *time.Time) *ItemRelationUpdate {
+	if t != nil {
+		iru.SetDeletedAt(*t)
+	}
+	return iru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iru *ItemRelationUpdate) ClearDeletedAt() *ItemRelationUpdate {
+	iru.mutation.ClearDeletedAt()
+	return iru
+}
+
+// SetSourceID sets the "source" edge to the Item entity by ID.
+func (iru *ItemRelationUpdate) SetSourceID(id uuid.UUID) *ItemRelationUpdate {
+	iru.mutation.SetSourceID(id)
+	return iru
+}
+
+// SetSource sets the "source" edge to the Item entity.
+func (iru *ItemRelationUpdate) SetSource(i *Item) *ItemRelationUpdate {
+	return iru.SetSourceID(i.ID)
+}
+
+// SetTargetID sets the "target" edge to the Item entity by ID.
+func (iru *ItemRelationUpdate) SetTargetID(id uuid.UUID) *ItemRelationUpdate {
+	iru.mutation.SetTargetID(id)
+	return iru
+}
+
+// SetTarget sets the "target" edge to the Item entity.
+func (iru *ItemRelationUpdate) SetTarget(i *Item) *ItemRelationUpdate {
+	return iru.SetTargetID(i.ID)
+}
+
+// Mutation returns the ItemRelationMutation object of the builder.
+func (iru *ItemRelationUpdate) Mutation() *ItemRelationMutation {
+	return iru.mutation
+}
+
+// ClearSource clears the "source" edge to the Item entity.
+func (iru *ItemRelationUpdate) ClearSource() *ItemRelationUpdate {
+	iru.mutation.ClearSource()
+	return iru
+}
+
+// ClearTarget clears the "target" edge to the Item entity.
+func (iru *ItemRelationUpdate) ClearTarget() *ItemRelationUpdate {
+	iru.mutation.ClearTarget()
+	return iru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iru *ItemRelationUpdate) Save(ctx context.Context) (int, error) {
+	iru.defaults()
+	return withHooks(ctx, iru.sqlSave, iru.mutation, iru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iru *ItemRelationUpdate) SaveX(ctx context.Context) int {
+	affected, err := iru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iru *ItemRelationUpdate) Exec(ctx context.Context) error {
+	_, err := iru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iru *ItemRelationUpdate) ExecX(ctx context.Context) {
+	if err := iru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iru *ItemRelationUpdate) defaults() {
+	if _, ok := iru.mutation.UpdatedAt(); !ok {
+		v := itemrelation.UpdateDefaultUpdatedAt()
+		iru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iru *ItemRelationUpdate) check() error {
+	if v, ok := iru.mutation.GetType(); ok {
+		if err := itemrelation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemRelation.type": %w`, err)}
+		}
+	}
+	if iru.mutation.SourceCleared() && len(iru.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemRelation.source"`)
+	}
+	if iru.mutation.TargetCleared() && len(iru.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemRelation.target"`)
+	}
+	return nil
+}
+
+func (iru *ItemRelationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemrelation.Table, itemrelation.Columns, sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID))
+	if ps := iru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iru.mutation.GetType(); ok {
+		_spec.SetField(itemrelation.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := iru.mutation.Description(); ok {
+		_spec.SetField(itemrelation.FieldDescription, field.TypeString, value)
+	}
+	if iru.mutation.DescriptionCleared() {
+		_spec.ClearField(itemrelation.FieldDescription, field.TypeString)
+	}
+	if value, ok := iru.mutation.CreatedBy(); ok {
+		_spec.SetField(itemrelation.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := iru.mutation.UpdatedBy(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := iru.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := iru.mutation.DeletedBy(); ok {
+		_spec.SetField(itemrelation.FieldDeletedBy, field.TypeString, value)
+	}
+	if iru.mutation.DeletedByCleared() {
+		_spec.ClearField(itemrelation.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := iru.mutation.DeletedAt(); ok {
+		_spec.SetField(itemrelation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iru.mutation.DeletedAtCleared() {
+		_spec.ClearField(itemrelation.FieldDeletedAt, field.TypeTime)
+	}
+	if iru.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.SourceTable,
+			Columns: []string{itemrelation.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iru.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.SourceTable,
+			Columns: []string{itemrelation.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iru.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.TargetTable,
+			Columns: []string{itemrelation.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iru.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.TargetTable,
+			Columns: []string{itemrelation.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrelation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iru.mutation.done = true
+	return n, nil
+}
+
+// ItemRelationUpdateOne is the builder for updating a single ItemRelation entity.
+type ItemRelationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ItemRelationMutation
+}
+
+// SetType sets the "type" field.
+func (iruo *ItemRelationUpdateOne) SetType(i itemrelation.Type) *ItemRelationUpdateOne {
+	iruo.mutation.SetType(i)
+	return iruo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableType(i *itemrelation.Type) *ItemRelationUpdateOne {
+	if i != nil {
+		iruo.SetType(*i)
+	}
+	return iruo
+}
+
+// SetDescription sets the "description" field.
+func (iruo *ItemRelationUpdateOne) SetDescription(s string) *ItemRelationUpdateOne {
+	iruo.mutation.SetDescription(s)
+	return iruo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableDescription(s *string) *ItemRelationUpdateOne {
+	if s != nil {
+		iruo.SetDescription(*s)
+	}
+	return iruo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (iruo *ItemRelationUpdateOne) ClearDescription() *ItemRelationUpdateOne {
+	iruo.mutation.ClearDescription()
+	return iruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (iruo *ItemRelationUpdateOne) SetCreatedBy(s string) *ItemRelationUpdateOne {
+	iruo.mutation.SetCreatedBy(s)
+	return iruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableCreatedBy(s *string) *ItemRelationUpdateOne {
+	if s != nil {
+		iruo.SetCreatedBy(*s)
+	}
+	return iruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (iruo *ItemRelationUpdateOne) SetUpdatedBy(s string) *ItemRelationUpdateOne {
+	iruo.mutation.SetUpdatedBy(s)
+	return iruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableUpdatedBy(s *string) *ItemRelationUpdateOne {
+	if s != nil {
+		iruo.SetUpdatedBy(*s)
+	}
+	return iruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (iruo *ItemRelationUpdateOne) SetUpdatedAt(t time.Time) *ItemRelationUpdateOne {
+	iruo.mutation.SetUpdatedAt(t)
+	return iruo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (iruo *ItemRelationUpdateOne) SetDeletedBy(s string) *ItemRelationUpdateOne {
+	iruo.mutation.SetDeletedBy(s)
+	return iruo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableDeletedBy(s *string) *ItemRelationUpdateOne {
+	if s != nil {
+		iruo.SetDeletedBy(*s)
+	}
+	return iruo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (iruo *ItemRelationUpdateOne) ClearDeletedBy() *ItemRelationUpdateOne {
+	iruo.mutation.ClearDeletedBy()
+	return iruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (iruo *ItemRelationUpdateOne) SetDeletedAt(t time.Time) *ItemRelationUpdateOne {
+	iruo.mutation.SetDeletedAt(t)
+	return iruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (iruo *ItemRelationUpdateOne) SetNillableDeletedAt(t *time.Time) *ItemRelationUpdateOne {
+	if t != nil {
+		iruo.SetDeletedAt(*t)
+	}
+	return iruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (iruo *ItemRelationUpdateOne) ClearDeletedAt() *ItemRelationUpdateOne {
+	iruo.mutation.ClearDeletedAt()
+	return iruo
+}
+
+// SetSourceID sets the "source" edge to the Item entity by ID.
+func (iruo *ItemRelationUpdateOne) SetSourceID(id uuid.UUID) *ItemRelationUpdateOne {
+	iruo.mutation.SetSourceID(id)
+	return iruo
+}
+
+// SetSource sets the "source" edge to the Item entity.
+func (iruo *ItemRelationUpdateOne) SetSource(i *Item) *ItemRelationUpdateOne {
+	return iruo.SetSourceID(i.ID)
+}
+
+// SetTargetID sets the "target" edge to the Item entity by ID.
+func (iruo *ItemRelationUpdateOne) SetTargetID(id uuid.UUID) *ItemRelationUpdateOne {
+	iruo.mutation.SetTargetID(id)
+	return iruo
+}
+
+// SetTarget sets the "target" edge to the Item entity.
+func (iruo *ItemRelationUpdateOne) SetTarget(i *Item) *ItemRelationUpdateOne {
+	return iruo.SetTargetID(i.ID)
+}
+
+// Mutation returns the ItemRelationMutation object of the builder.
+func (iruo *ItemRelationUpdateOne) Mutation() *ItemRelationMutation {
+	return iruo.mutation
+}
+
+// ClearSource clears the "source" edge to the Item entity.
+func (iruo *ItemRelationUpdateOne) ClearSource() *ItemRelationUpdateOne {
+	iruo.mutation.ClearSource()
+	return iruo
+}
+
+// ClearTarget clears the "target" edge to the Item entity.
+func (iruo *ItemRelationUpdateOne) ClearTarget() *ItemRelationUpdateOne {
+	iruo.mutation.ClearTarget()
+	return iruo
+}
+
+// Where appends a list predicates to the ItemRelationUpdate builder.
+func (iruo *ItemRelationUpdateOne) Where(ps ...predicate.ItemRelation) *ItemRelationUpdateOne {
+	iruo.mutation.Where(ps...)
+	return iruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iruo *ItemRelationUpdateOne) Select(field string, fields ...string) *ItemRelationUpdateOne {
+	iruo.fields = append([]string{field}, fields...)
+	return iruo
+}
+
+// Save executes the query and returns the updated ItemRelation entity.
+func (iruo *ItemRelationUpdateOne) Save(ctx context.Context) (*ItemRelation, error) {
+	iruo.defaults()
+	return withHooks(ctx, iruo.sqlSave, iruo.mutation, iruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iruo *ItemRelationUpdateOne) SaveX(ctx context.Context) *ItemRelation {
+	node, err := iruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iruo *ItemRelationUpdateOne) Exec(ctx context.Context) error {
+	_, err := iruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iruo *ItemRelationUpdateOne) ExecX(ctx context.Context) {
+	if err := iruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (iruo *ItemRelationUpdateOne) defaults() {
+	if _, ok := iruo.mutation.UpdatedAt(); !ok {
+		v := itemrelation.UpdateDefaultUpdatedAt()
+		iruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iruo *ItemRelationUpdateOne) check() error {
+	if v, ok := iruo.mutation.GetType(); ok {
+		if err := itemrelation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ItemRelation.type": %w`, err)}
+		}
+	}
+	if iruo.mutation.SourceCleared() && len(iruo.mutation.SourceIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemRelation.source"`)
+	}
+	if iruo.mutation.TargetCleared() && len(iruo.mutation.TargetIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemRelation.target"`)
+	}
+	return nil
+}
+
+func (iruo *ItemRelationUpdateOne) sqlSave(ctx context.Context) (_node *ItemRelation, err error) {
+	if err := iruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemrelation.Table, itemrelation.Columns, sqlgraph.NewFieldSpec(itemrelation.FieldID, field.TypeUUID))
+	id, ok := iruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemRelation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemrelation.FieldID)
+		for _, f := range fields {
+			if !itemrelation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemrelation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iruo.mutation.GetType(); ok {
+		_spec.SetField(itemrelation.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := iruo.mutation.Description(); ok {
+		_spec.SetField(itemrelation.FieldDescription, field.TypeString, value)
+	}
+	if iruo.mutation.DescriptionCleared() {
+		_spec.ClearField(itemrelation.FieldDescription, field.TypeString)
+	}
+	if value, ok := iruo.mutation.CreatedBy(); ok {
+		_spec.SetField(itemrelation.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := iruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := iruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemrelation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := iruo.mutation.DeletedBy(); ok {
+		_spec.SetField(itemrelation.FieldDeletedBy, field.TypeString, value)
+	}
+	if iruo.mutation.DeletedByCleared() {
+		_spec.ClearField(itemrelation.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := iruo.mutation.DeletedAt(); ok {
+		_spec.SetField(itemrelation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if iruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(itemrelation.FieldDeletedAt, field.TypeTime)
+	}
+	if iruo.mutation.SourceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.SourceTable,
+			Columns: []string{itemrelation.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iruo.mutation.SourceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.SourceTable,
+			Columns: []string{itemrelation.SourceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if iruo.mutation.TargetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.TargetTable,
+			Columns: []string{itemrelation.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iruo.mutation.TargetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   itemrelation.TargetTable,
+			Columns: []string{itemrelation.TargetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ItemRelation{config: iruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemrelation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iruo.mutation.done = true
+	return _node, nil
+}
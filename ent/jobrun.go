@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/schedule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// JobRun is the model entity for the JobRun schema.
+type JobRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the job run. This is a UUID that is generated when the job is queued.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The type of the job, which determines the handler that executes it, e.g. `export`.
+	Type string `json:"type,omitempty"`
+	// The parameters of the job, which are passed to the handler. The meaning of the parameters depends on the type of the job.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// The status of the job run. Queued runs are picked up by the next available worker.
+	Status jobrun.Status `json:"status,omitempty"`
+	// The progress of a running job in percent, as reported by its handler.
+	Progress int `json:"progress,omitempty"`
+	// The last lines logged by the handler of the job, to find out why a run failed without access to the worker logs.
+	Log string `json:"log,omitempty"`
+	// Whether a user asked to cancel the running job. The worker executing it stops the handler on its next poll.
+	CancelRequested bool `json:"cancel_requested,omitempty"`
+	// The failed or canceled run this run retries.
+	RetryOf *uuid.UUID `json:"retry_of,omitempty"`
+	// The earliest time a worker executes the queued run, e.g. the next retry of a webhook delivery. Empty to run it right away.
+	RunAfter *time.Time `json:"run_after,omitempty"`
+	// The time when a worker started to execute the job.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// The last time the worker executing the run reported that it is still alive. Running runs without a heartbeat for longer than the lease of the workers are failed, as their worker stopped.
+	HeartbeatAt *time.Time `json:"heartbeat_at,omitempty"`
+	// The time when the job succeeded or failed.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// The result of a successful run, e.g. the name of the file written by an export.
+	Result string `json:"result,omitempty"`
+	// The error of a failed run.
+	Error string `json:"error,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the JobRunQuery when eager-loading is set.
+	Edges         JobRunEdges `json:"edges"`
+	schedule_runs *uuid.UUID
+	selectValues  sql.SelectValues
+}
+
+// JobRunEdges holds the relations/edges for other nodes in the graph.
+type JobRunEdges struct {
+	// The schedule that queued the run, empty for runs queued by a user.
+	Schedule *Schedule `json:"schedule,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ScheduleOrErr returns the Schedule value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e JobRunEdges) ScheduleOrErr() (*Schedule, error) {
+	if e.Schedule != nil {
+		return e.Schedule, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: schedule.Label}
+	}
+	return nil, &NotLoadedError{edge: "schedule"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*JobRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldRetryOf:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case jobrun.FieldParameters:
+			values[i] = new([]byte)
+		case jobrun.FieldCancelRequested:
+			values[i] = new(sql.NullBool)
+		case jobrun.FieldProgress:
+			values[i] = new(sql.NullInt64)
+		case jobrun.FieldType, jobrun.FieldStatus, jobrun.FieldLog, jobrun.FieldResult, jobrun.FieldError, jobrun.FieldCreatedBy, jobrun.FieldUpdatedBy, jobrun.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case jobrun.FieldRunAfter, jobrun.FieldStartedAt, jobrun.FieldHeartbeatAt, jobrun.FieldFinishedAt, jobrun.FieldCreatedAt, jobrun.FieldUpdatedAt, jobrun.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case jobrun.FieldID:
+			values[i] = new(uuid.UUID)
+		case jobrun.ForeignKeys[0]: // schedule_runs
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the JobRun fields.
+func (jr *JobRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case jobrun.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				jr.ID = *value
+			}
+		case jobrun.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				jr.Type = value.String
+			}
+		case jobrun.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &jr.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case jobrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				jr.Status = jobrun.Status(value.String)
+			}
+		case jobrun.FieldProgress:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field progress", values[i])
+			} else if value.Valid {
+				jr.Progress = int(value.Int64)
+			}
+		case jobrun.FieldLog:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field log", values[i])
+			} else if value.Valid {
+				jr.Log = value.String
+			}
+		case jobrun.FieldCancelRequested:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_requested", values[i])
+			} else if value.Valid {
+				jr.CancelRequested = value.Bool
+			}
+		case jobrun.FieldRetryOf:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field retry_of", values[i])
+			} else if value.Valid {
+				jr.RetryOf = new(uuid.UUID)
+				*jr.RetryOf = *value.S.(*uuid.UUID)
+			}
+		case jobrun.FieldRunAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field run_after", values[i])
+			} else if value.Valid {
+				jr.RunAfter = new(time.Time)
+				*jr.RunAfter = value.Time
+			}
+		case jobrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				jr.StartedAt = new(time.Time)
+				*jr.StartedAt = value.Time
+			}
+		case jobrun.FieldHeartbeatAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field heartbeat_at", values[i])
+			} else if value.Valid {
+				jr.HeartbeatAt = new(time.Time)
+				*jr.HeartbeatAt = value.Time
+			}
+		case jobrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				jr.FinishedAt = new(time.Time)
+				*jr.FinishedAt = value.Time
+			}
+		case jobrun.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				jr.Result = value.String
+			}
+		case jobrun.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				jr.Error = value.String
+			}
+		case jobrun.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				jr.CreatedBy = value.String
+			}
+		case jobrun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				jr.CreatedAt = value.Time
+			}
+		case jobrun.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				jr.UpdatedBy = value.String
+			}
+		case jobrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				jr.UpdatedAt = value.Time
+			}
+		case jobrun.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				jr.DeletedBy = value.String
+			}
+		case jobrun.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				jr.DeletedAt = new(time.Time)
+				*jr.DeletedAt = value.Time
+			}
+		case jobrun.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field schedule_runs", values[i])
+			} else if value.Valid {
+				jr.schedule_runs = new(uuid.UUID)
+				*jr.schedule_runs = *value.S.(*uuid.UUID)
+			}
+		default:
+			jr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the JobRun.
+// This includes values selected through modifiers, order, etc.
+func (jr *JobRun) Value(name string) (ent.Value, error) {
+	return jr.selectValues.Get(name)
+}
+
+// QuerySchedule queries the "schedule" edge of the JobRun entity.
+func (jr *JobRun) QuerySchedule() *ScheduleQuery {
+	return NewJobRunClient(jr.config).QuerySchedule(jr)
+}
+
+// Update returns a builder for updating this JobRun.
+// Note that you need to call JobRun.Unwrap() before calling this method if this JobRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (jr *JobRun) Update() *JobRunUpdateOne {
+	return NewJobRunClient(jr.config).UpdateOne(jr)
+}
+
+// Unwrap unwraps the JobRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (jr *JobRun) Unwrap() *JobRun {
+	_tx, ok := jr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: JobRun is not a transactional entity")
+	}
+	jr.config.driver = _tx.drv
+	return jr
+}
+
+// String implements the fmt.Stringer.
+func (jr *JobRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("JobRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", jr.ID))
+	builder.WriteString("type=")
+	builder.WriteString(jr.Type)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("progress=")
+	builder.WriteString(fmt.Sprintf("%v", jr.Progress))
+	builder.WriteString(", ")
+	builder.WriteString("log=")
+	builder.WriteString(jr.Log)
+	builder.WriteString(", ")
+	builder.WriteString("cancel_requested=")
+	builder.WriteString(fmt.Sprintf("%v", jr.CancelRequested))
+	builder.WriteString(", ")
+	if v := jr.RetryOf; v != nil {
+		builder.WriteString("retry_of=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := jr.RunAfter; v != nil {
+		builder.WriteString("run_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := jr.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := jr.HeartbeatAt; v != nil {
+		builder.WriteString("heartbeat_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := jr.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(jr.Result)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(jr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(jr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(jr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(jr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(jr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(jr.DeletedBy)
+	builder.WriteString(", ")
+	if v := jr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// JobRuns is a parsable slice of JobRun.
+type JobRuns []*JobRun
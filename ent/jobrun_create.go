@@ -0,0 +1,607 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/schedule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// JobRunCreate is the builder for creating a JobRun entity.
+type JobRunCreate struct {
+	config
+	mutation *JobRunMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (jrc *JobRunCreate) SetType(s string) *JobRunCreate {
+	jrc.mutation.SetType(s)
+	return jrc
+}
+
+// SetParameters sets the "parameters" field.
+func (jrc *JobRunCreate) SetParameters(m map[string]string) *JobRunCreate {
+	jrc.mutation.SetParameters(m)
+	return jrc
+}
+
+// SetStatus sets the "status" field.
+func (jrc *JobRunCreate) SetStatus(j jobrun.Status) *JobRunCreate {
+	jrc.mutation.SetStatus(j)
+	return jrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStatus(j *jobrun.Status) *JobRunCreate {
+	if j != nil {
+		jrc.SetStatus(*j)
+	}
+	return jrc
+}
+
+// SetProgress sets the "progress" field.
+func (jrc *JobRunCreate) SetProgress(i int) *JobRunCreate {
+	jrc.mutation.SetProgress(i)
+	return jrc
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableProgress(i *int) *JobRunCreate {
+	if i != nil {
+		jrc.SetProgress(*i)
+	}
+	return jrc
+}
+
+// SetLog sets the "log" field.
+func (jrc *JobRunCreate) SetLog(s string) *JobRunCreate {
+	jrc.mutation.SetLog(s)
+	return jrc
+}
+
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableLog(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetLog(*s)
+	}
+	return jrc
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (jrc *JobRunCreate) SetCancelRequested(b bool) *JobRunCreate {
+	jrc.mutation.SetCancelRequested(b)
+	return jrc
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableCancelRequested(b *bool) *JobRunCreate {
+	if b != nil {
+		jrc.SetCancelRequested(*b)
+	}
+	return jrc
+}
+
+// SetRetryOf sets the "retry_of" field.
+func (jrc *JobRunCreate) SetRetryOf(u uuid.UUID) *JobRunCreate {
+	jrc.mutation.SetRetryOf(u)
+	return jrc
+}
+
+// SetNillableRetryOf sets the "retry_of" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableRetryOf(u *uuid.UUID) *JobRunCreate {
+	if u != nil {
+		jrc.SetRetryOf(*u)
+	}
+	return jrc
+}
+
+// SetRunAfter sets the "run_after" field.
+func (jrc *JobRunCreate) SetRunAfter(t time.Time) *JobRunCreate {
+	jrc.mutation.SetRunAfter(t)
+	return jrc
+}
+
+// SetNillableRunAfter sets the "run_after" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableRunAfter(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetRunAfter(*t)
+	}
+	return jrc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jrc *JobRunCreate) SetStartedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetStartedAt(t)
+	return jrc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableStartedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetStartedAt(*t)
+	}
+	return jrc
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (jrc *JobRunCreate) SetHeartbeatAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetHeartbeatAt(t)
+	return jrc
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableHeartbeatAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetHeartbeatAt(*t)
+	}
+	return jrc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jrc *JobRunCreate) SetFinishedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetFinishedAt(t)
+	return jrc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableFinishedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetFinishedAt(*t)
+	}
+	return jrc
+}
+
+// SetResult sets the "result" field.
+func (jrc *JobRunCreate) SetResult(s string) *JobRunCreate {
+	jrc.mutation.SetResult(s)
+	return jrc
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableResult(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetResult(*s)
+	}
+	return jrc
+}
+
+// SetError sets the "error" field.
+func (jrc *JobRunCreate) SetError(s string) *JobRunCreate {
+	jrc.mutation.SetError(s)
+	return jrc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableError(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetError(*s)
+	}
+	return jrc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (jrc *JobRunCreate) SetCreatedBy(s string) *JobRunCreate {
+	jrc.mutation.SetCreatedBy(s)
+	return jrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (jrc *JobRunCreate) SetCreatedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetCreatedAt(t)
+	return jrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableCreatedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetCreatedAt(*t)
+	}
+	return jrc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (jrc *JobRunCreate) SetUpdatedBy(s string) *JobRunCreate {
+	jrc.mutation.SetUpdatedBy(s)
+	return jrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jrc *JobRunCreate) SetUpdatedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetUpdatedAt(t)
+	return jrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableUpdatedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetUpdatedAt(*t)
+	}
+	return jrc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (jrc *JobRunCreate) SetDeletedBy(s string) *JobRunCreate {
+	jrc.mutation.SetDeletedBy(s)
+	return jrc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableDeletedBy(s *string) *JobRunCreate {
+	if s != nil {
+		jrc.SetDeletedBy(*s)
+	}
+	return jrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (jrc *JobRunCreate) SetDeletedAt(t time.Time) *JobRunCreate {
+	jrc.mutation.SetDeletedAt(t)
+	return jrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableDeletedAt(t *time.Time) *JobRunCreate {
+	if t != nil {
+		jrc.SetDeletedAt(*t)
+	}
+	return jrc
+}
+
+// SetID sets the "id" field.
+func (jrc *JobRunCreate) SetID(u uuid.UUID) *JobRunCreate {
+	jrc.mutation.SetID(u)
+	return jrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableID(u *uuid.UUID) *JobRunCreate {
+	if u != nil {
+		jrc.SetID(*u)
+	}
+	return jrc
+}
+
+// SetScheduleID sets the "schedule" edge to the Schedule entity by ID.
+func (jrc *JobRunCreate) SetScheduleID(id uuid.UUID) *JobRunCreate {
+	jrc.mutation.SetScheduleID(id)
+	return jrc
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the Schedule entity by ID if the given value is not nil.
+func (jrc *JobRunCreate) SetNillableScheduleID(id *uuid.UUID) *JobRunCreate {
+	if id != nil {
+		jrc = jrc.SetScheduleID(*id)
+	}
+	return jrc
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (jrc *JobRunCreate) SetSchedule(s *Schedule) *JobRunCreate {
+	return jrc.SetScheduleID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jrc *JobRunCreate) Mutation() *JobRunMutation {
+	return jrc.mutation
+}
+
+// Save creates the JobRun in the database.
+func (jrc *JobRunCreate) Save(ctx context.Context) (*JobRun, error) {
+	jrc.defaults()
+	return withHooks(ctx, jrc.sqlSave, jrc.mutation, jrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (jrc *JobRunCreate) SaveX(ctx context.Context) *JobRun {
+	v, err := jrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrc *JobRunCreate) Exec(ctx context.Context) error {
+	_, err := jrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrc *JobRunCreate) ExecX(ctx context.Context) {
+	if err := jrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jrc *JobRunCreate) defaults() {
+	if _, ok := jrc.mutation.Status(); !ok {
+		v := jobrun.DefaultStatus
+		jrc.mutation.SetStatus(v)
+	}
+	if _, ok := jrc.mutation.Progress(); !ok {
+		v := jobrun.DefaultProgress
+		jrc.mutation.SetProgress(v)
+	}
+	if _, ok := jrc.mutation.CancelRequested(); !ok {
+		v := jobrun.DefaultCancelRequested
+		jrc.mutation.SetCancelRequested(v)
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		v := jobrun.DefaultCreatedAt()
+		jrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := jrc.mutation.UpdatedAt(); !ok {
+		v := jobrun.DefaultUpdatedAt()
+		jrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := jrc.mutation.ID(); !ok {
+		v := jobrun.DefaultID()
+		jrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jrc *JobRunCreate) check() error {
+	if _, ok := jrc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "JobRun.type"`)}
+	}
+	if v, ok := jrc.mutation.GetType(); ok {
+		if err := jobrun.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "JobRun.type": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "JobRun.status"`)}
+	}
+	if v, ok := jrc.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.Progress(); !ok {
+		return &ValidationError{Name: "progress", err: errors.New(`ent: missing required field "JobRun.progress"`)}
+	}
+	if v, ok := jrc.mutation.Progress(); ok {
+		if err := jobrun.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "JobRun.progress": %w`, err)}
+		}
+	}
+	if _, ok := jrc.mutation.CancelRequested(); !ok {
+		return &ValidationError{Name: "cancel_requested", err: errors.New(`ent: missing required field "JobRun.cancel_requested"`)}
+	}
+	if _, ok := jrc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "JobRun.created_by"`)}
+	}
+	if _, ok := jrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "JobRun.created_at"`)}
+	}
+	if _, ok := jrc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "JobRun.updated_by"`)}
+	}
+	if _, ok := jrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "JobRun.updated_at"`)}
+	}
+	return nil
+}
+
+func (jrc *JobRunCreate) sqlSave(ctx context.Context) (*JobRun, error) {
+	if err := jrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := jrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, jrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	jrc.mutation.id = &_node.ID
+	jrc.mutation.done = true
+	return _node, nil
+}
+
+func (jrc *JobRunCreate) createSpec() (*JobRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &JobRun{config: jrc.config}
+		_spec = sqlgraph.NewCreateSpec(jobrun.Table, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID))
+	)
+	if id, ok := jrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := jrc.mutation.GetType(); ok {
+		_spec.SetField(jobrun.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := jrc.mutation.Parameters(); ok {
+		_spec.SetField(jobrun.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := jrc.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := jrc.mutation.Progress(); ok {
+		_spec.SetField(jobrun.FieldProgress, field.TypeInt, value)
+		_node.Progress = value
+	}
+	if value, ok := jrc.mutation.Log(); ok {
+		_spec.SetField(jobrun.FieldLog, field.TypeString, value)
+		_node.Log = value
+	}
+	if value, ok := jrc.mutation.CancelRequested(); ok {
+		_spec.SetField(jobrun.FieldCancelRequested, field.TypeBool, value)
+		_node.CancelRequested = value
+	}
+	if value, ok := jrc.mutation.RetryOf(); ok {
+		_spec.SetField(jobrun.FieldRetryOf, field.TypeUUID, value)
+		_node.RetryOf = &value
+	}
+	if value, ok := jrc.mutation.RunAfter(); ok {
+		_spec.SetField(jobrun.FieldRunAfter, field.TypeTime, value)
+		_node.RunAfter = &value
+	}
+	if value, ok := jrc.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := jrc.mutation.HeartbeatAt(); ok {
+		_spec.SetField(jobrun.FieldHeartbeatAt, field.TypeTime, value)
+		_node.HeartbeatAt = &value
+	}
+	if value, ok := jrc.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := jrc.mutation.Result(); ok {
+		_spec.SetField(jobrun.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := jrc.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := jrc.mutation.CreatedBy(); ok {
+		_spec.SetField(jobrun.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := jrc.mutation.CreatedAt(); ok {
+		_spec.SetField(jobrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := jrc.mutation.UpdatedBy(); ok {
+		_spec.SetField(jobrun.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := jrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := jrc.mutation.DeletedBy(); ok {
+		_spec.SetField(jobrun.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := jrc.mutation.DeletedAt(); ok {
+		_spec.SetField(jobrun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := jrc.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ScheduleTable,
+			Columns: []string{jobrun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.schedule_runs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// JobRunCreateBulk is the builder for creating many JobRun entities in bulk.
+type JobRunCreateBulk struct {
+	config
+	err      error
+	builders []*JobRunCreate
+}
+
+// Save creates the JobRun entities in the database.
+func (jrcb *JobRunCreateBulk) Save(ctx context.Context) ([]*JobRun, error) {
+	if jrcb.err != nil {
+		return nil, jrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(jrcb.builders))
+	nodes := make([]*JobRun, len(jrcb.builders))
+	mutators := make([]Mutator, len(jrcb.builders))
+	for i := range jrcb.builders {
+		func(i int, root context.Context) {
+			builder := jrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*JobRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, jrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, jrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, jrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) SaveX(ctx context.Context) []*JobRun {
+	v, err := jrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (jrcb *JobRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := jrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jrcb *JobRunCreateBulk) ExecX(ctx context.Context) {
+	if err := jrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schedule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// JobRunUpdate is the builder for updating JobRun entities.
+type JobRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jru *JobRunUpdate) Where(ps ...predicate.JobRun) *JobRunUpdate {
+	jru.mutation.Where(ps...)
+	return jru
+}
+
+// SetParameters sets the "parameters" field.
+func (jru *JobRunUpdate) SetParameters(m map[string]string) *JobRunUpdate {
+	jru.mutation.SetParameters(m)
+	return jru
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (jru *JobRunUpdate) ClearParameters() *JobRunUpdate {
+	jru.mutation.ClearParameters()
+	return jru
+}
+
+// SetStatus sets the "status" field.
+func (jru *JobRunUpdate) SetStatus(j jobrun.Status) *JobRunUpdate {
+	jru.mutation.SetStatus(j)
+	return jru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStatus(j *jobrun.Status) *JobRunUpdate {
+	if j != nil {
+		jru.SetStatus(*j)
+	}
+	return jru
+}
+
+// SetProgress sets the "progress" field.
+func (jru *JobRunUpdate) SetProgress(i int) *JobRunUpdate {
+	jru.mutation.ResetProgress()
+	jru.mutation.SetProgress(i)
+	return jru
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableProgress(i *int) *JobRunUpdate {
+	if i != nil {
+		jru.SetProgress(*i)
+	}
+	return jru
+}
+
+// AddProgress adds i to the "progress" field.
+func (jru *JobRunUpdate) AddProgress(i int) *JobRunUpdate {
+	jru.mutation.AddProgress(i)
+	return jru
+}
+
+// SetLog sets the "log" field.
+func (jru *JobRunUpdate) SetLog(s string) *JobRunUpdate {
+	jru.mutation.SetLog(s)
+	return jru
+}
+
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableLog(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetLog(*s)
+	}
+	return jru
+}
+
+// ClearLog clears the value of the "log" field.
+func (jru *JobRunUpdate) ClearLog() *JobRunUpdate {
+	jru.mutation.ClearLog()
+	return jru
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (jru *JobRunUpdate) SetCancelRequested(b bool) *JobRunUpdate {
+	jru.mutation.SetCancelRequested(b)
+	return jru
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableCancelRequested(b *bool) *JobRunUpdate {
+	if b != nil {
+		jru.SetCancelRequested(*b)
+	}
+	return jru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jru *JobRunUpdate) SetStartedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetStartedAt(t)
+	return jru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableStartedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetStartedAt(*t)
+	}
+	return jru
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (jru *JobRunUpdate) ClearStartedAt() *JobRunUpdate {
+	jru.mutation.ClearStartedAt()
+	return jru
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (jru *JobRunUpdate) SetHeartbeatAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetHeartbeatAt(t)
+	return jru
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableHeartbeatAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetHeartbeatAt(*t)
+	}
+	return jru
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (jru *JobRunUpdate) ClearHeartbeatAt() *JobRunUpdate {
+	jru.mutation.ClearHeartbeatAt()
+	return jru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jru *JobRunUpdate) SetFinishedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetFinishedAt(t)
+	return jru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableFinishedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetFinishedAt(*t)
+	}
+	return jru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jru *JobRunUpdate) ClearFinishedAt() *JobRunUpdate {
+	jru.mutation.ClearFinishedAt()
+	return jru
+}
+
+// SetResult sets the "result" field.
+func (jru *JobRunUpdate) SetResult(s string) *JobRunUpdate {
+	jru.mutation.SetResult(s)
+	return jru
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableResult(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetResult(*s)
+	}
+	return jru
+}
+
+// ClearResult clears the value of the "result" field.
+func (jru *JobRunUpdate) ClearResult() *JobRunUpdate {
+	jru.mutation.ClearResult()
+	return jru
+}
+
+// SetError sets the "error" field.
+func (jru *JobRunUpdate) SetError(s string) *JobRunUpdate {
+	jru.mutation.SetError(s)
+	return jru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableError(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetError(*s)
+	}
+	return jru
+}
+
+// ClearError clears the value of the "error" field.
+func (jru *JobRunUpdate) ClearError() *JobRunUpdate {
+	jru.mutation.ClearError()
+	return jru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (jru *JobRunUpdate) SetCreatedBy(s string) *JobRunUpdate {
+	jru.mutation.SetCreatedBy(s)
+	return jru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableCreatedBy(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetCreatedBy(*s)
+	}
+	return jru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (jru *JobRunUpdate) SetUpdatedBy(s string) *JobRunUpdate {
+	jru.mutation.SetUpdatedBy(s)
+	return jru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableUpdatedBy(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetUpdatedBy(*s)
+	}
+	return jru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jru *JobRunUpdate) SetUpdatedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetUpdatedAt(t)
+	return jru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (jru *JobRunUpdate) SetDeletedBy(s string) *JobRunUpdate {
+	jru.mutation.SetDeletedBy(s)
+	return jru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableDeletedBy(s *string) *JobRunUpdate {
+	if s != nil {
+		jru.SetDeletedBy(*s)
+	}
+	return jru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (jru *JobRunUpdate) ClearDeletedBy() *JobRunUpdate {
+	jru.mutation.ClearDeletedBy()
+	return jru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (jru *JobRunUpdate) SetDeletedAt(t time.Time) *JobRunUpdate {
+	jru.mutation.SetDeletedAt(t)
+	return jru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableDeletedAt(t *time.Time) *JobRunUpdate {
+	if t != nil {
+		jru.SetDeletedAt(*t)
+	}
+	return jru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (jru *JobRunUpdate) ClearDeletedAt() *JobRunUpdate {
+	jru.mutation.ClearDeletedAt()
+	return jru
+}
+
+// SetScheduleID sets the "schedule" edge to the Schedule entity by ID.
+func (jru *JobRunUpdate) SetScheduleID(id uuid.UUID) *JobRunUpdate {
+	jru.mutation.SetScheduleID(id)
+	return jru
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the Schedule entity by ID if the given value is not nil.
+func (jru *JobRunUpdate) SetNillableScheduleID(id *uuid.UUID) *JobRunUpdate {
+	if id != nil {
+		jru = jru.SetScheduleID(*id)
+	}
+	return jru
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (jru *JobRunUpdate) SetSchedule(s *Schedule) *JobRunUpdate {
+	return jru.SetScheduleID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jru *JobRunUpdate) Mutation() *JobRunMutation {
+	return jru.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (jru *JobRunUpdate) ClearSchedule() *JobRunUpdate {
+	jru.mutation.ClearSchedule()
+	return jru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (jru *JobRunUpdate) Save(ctx context.Context) (int, error) {
+	jru.defaults()
+	return withHooks(ctx, jru.sqlSave, jru.mutation, jru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jru *JobRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := jru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (jru *JobRunUpdate) Exec(ctx context.Context) error {
+	_, err := jru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jru *JobRunUpdate) ExecX(ctx context.Context) {
+	if err := jru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jru *JobRunUpdate) defaults() {
+	if _, ok := jru.mutation.UpdatedAt(); !ok {
+		v := jobrun.UpdateDefaultUpdatedAt()
+		jru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jru *JobRunUpdate) check() error {
+	if v, ok := jru.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if v, ok := jru.mutation.Progress(); ok {
+		if err := jobrun.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "JobRun.progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jru *JobRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := jru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID))
+	if ps := jru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jru.mutation.Parameters(); ok {
+		_spec.SetField(jobrun.FieldParameters, field.TypeJSON, value)
+	}
+	if jru.mutation.ParametersCleared() {
+		_spec.ClearField(jobrun.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := jru.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jru.mutation.Progress(); ok {
+		_spec.SetField(jobrun.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := jru.mutation.AddedProgress(); ok {
+		_spec.AddField(jobrun.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := jru.mutation.Log(); ok {
+		_spec.SetField(jobrun.FieldLog, field.TypeString, value)
+	}
+	if jru.mutation.LogCleared() {
+		_spec.ClearField(jobrun.FieldLog, field.TypeString)
+	}
+	if value, ok := jru.mutation.CancelRequested(); ok {
+		_spec.SetField(jobrun.FieldCancelRequested, field.TypeBool, value)
+	}
+	if jru.mutation.RetryOfCleared() {
+		_spec.ClearField(jobrun.FieldRetryOf, field.TypeUUID)
+	}
+	if jru.mutation.RunAfterCleared() {
+		_spec.ClearField(jobrun.FieldRunAfter, field.TypeTime)
+	}
+	if value, ok := jru.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if jru.mutation.StartedAtCleared() {
+		_spec.ClearField(jobrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := jru.mutation.HeartbeatAt(); ok {
+		_spec.SetField(jobrun.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if jru.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(jobrun.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := jru.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jru.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := jru.mutation.Result(); ok {
+		_spec.SetField(jobrun.FieldResult, field.TypeString, value)
+	}
+	if jru.mutation.ResultCleared() {
+		_spec.ClearField(jobrun.FieldResult, field.TypeString)
+	}
+	if value, ok := jru.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jru.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	if value, ok := jru.mutation.CreatedBy(); ok {
+		_spec.SetField(jobrun.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.UpdatedBy(); ok {
+		_spec.SetField(jobrun.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := jru.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := jru.mutation.DeletedBy(); ok {
+		_spec.SetField(jobrun.FieldDeletedBy, field.TypeString, value)
+	}
+	if jru.mutation.DeletedByCleared() {
+		_spec.ClearField(jobrun.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := jru.mutation.DeletedAt(); ok {
+		_spec.SetField(jobrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if jru.mutation.DeletedAtCleared() {
+		_spec.ClearField(jobrun.FieldDeletedAt, field.TypeTime)
+	}
+	if jru.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ScheduleTable,
+			Columns: []string{jobrun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jru.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ScheduleTable,
+			Columns: []string{jobrun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, jru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	jru.mutation.done = true
+	return n, nil
+}
+
+// JobRunUpdateOne is the builder for updating a single JobRun entity.
+type JobRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *JobRunMutation
+}
+
+// SetParameters sets the "parameters" field.
+func (jruo *JobRunUpdateOne) SetParameters(m map[string]string) *JobRunUpdateOne {
+	jruo.mutation.SetParameters(m)
+	return jruo
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (jruo *JobRunUpdateOne) ClearParameters() *JobRunUpdateOne {
+	jruo.mutation.ClearParameters()
+	return jruo
+}
+
+// SetStatus sets the "status" field.
+func (jruo *JobRunUpdateOne) SetStatus(j jobrun.Status) *JobRunUpdateOne {
+	jruo.mutation.SetStatus(j)
+	return jruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStatus(j *jobrun.Status) *JobRunUpdateOne {
+	if j != nil {
+		jruo.SetStatus(*j)
+	}
+	return jruo
+}
+
+// SetProgress sets the "progress" field.
+func (jruo *JobRunUpdateOne) SetProgress(i int) *JobRunUpdateOne {
+	jruo.mutation.ResetProgress()
+	jruo.mutation.SetProgress(i)
+	return jruo
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableProgress(i *int) *JobRunUpdateOne {
+	if i != nil {
+		jruo.SetProgress(*i)
+	}
+	return jruo
+}
+
+// AddProgress adds i to the "progress" field.
+func (jruo *JobRunUpdateOne) AddProgress(i int) *JobRunUpdateOne {
+	jruo.mutation.AddProgress(i)
+	return jruo
+}
+
+// SetLog sets the "log" field.
+func (jruo *JobRunUpdateOne) SetLog(s string) *JobRunUpdateOne {
+	jruo.mutation.SetLog(s)
+	return jruo
+}
+
+// SetNillableLog sets the "log" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableLog(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetLog(*s)
+	}
+	return jruo
+}
+
+// ClearLog clears the value of the "log" field.
+func (jruo *JobRunUpdateOne) ClearLog() *JobRunUpdateOne {
+	jruo.mutation.ClearLog()
+	return jruo
+}
+
+// SetCancelRequested sets the "cancel_requested" field.
+func (jruo *JobRunUpdateOne) SetCancelRequested(b bool) *JobRunUpdateOne {
+	jruo.mutation.SetCancelRequested(b)
+	return jruo
+}
+
+// SetNillableCancelRequested sets the "cancel_requested" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableCancelRequested(b *bool) *JobRunUpdateOne {
+	if b != nil {
+		jruo.SetCancelRequested(*b)
+	}
+	return jruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (jruo *JobRunUpdateOne) SetStartedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetStartedAt(t)
+	return jruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableStartedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetStartedAt(*t)
+	}
+	return jruo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (jruo *JobRunUpdateOne) ClearStartedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearStartedAt()
+	return jruo
+}
+
+// SetHeartbeatAt sets the "heartbeat_at" field.
+func (jruo *JobRunUpdateOne) SetHeartbeatAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetHeartbeatAt(t)
+	return jruo
+}
+
+// SetNillableHeartbeatAt sets the "heartbeat_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableHeartbeatAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetHeartbeatAt(*t)
+	}
+	return jruo
+}
+
+// ClearHeartbeatAt clears the value of the "heartbeat_at" field.
+func (jruo *JobRunUpdateOne) ClearHeartbeatAt() *JobRunUpdateOne {
+	jruo.mutation.ClearHeartbeatAt()
+	return jruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (jruo *JobRunUpdateOne) SetFinishedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetFinishedAt(t)
+	return jruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableFinishedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetFinishedAt(*t)
+	}
+	return jruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (jruo *JobRunUpdateOne) ClearFinishedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearFinishedAt()
+	return jruo
+}
+
+// SetResult sets the "result" field.
+func (jruo *JobRunUpdateOne) SetResult(s string) *JobRunUpdateOne {
+	jruo.mutation.SetResult(s)
+	return jruo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableResult(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetResult(*s)
+	}
+	return jruo
+}
+
+// ClearResult clears the value of the "result" field.
+func (jruo *JobRunUpdateOne) ClearResult() *JobRunUpdateOne {
+	jruo.mutation.ClearResult()
+	return jruo
+}
+
+// SetError sets the "error" field.
+func (jruo *JobRunUpdateOne) SetError(s string) *JobRunUpdateOne {
+	jruo.mutation.SetError(s)
+	return jruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableError(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetError(*s)
+	}
+	return jruo
+}
+
+// ClearError clears the value of the "error" field.
+func (jruo *JobRunUpdateOne) ClearError() *JobRunUpdateOne {
+	jruo.mutation.ClearError()
+	return jruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (jruo *JobRunUpdateOne) SetCreatedBy(s string) *JobRunUpdateOne {
+	jruo.mutation.SetCreatedBy(s)
+	return jruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableCreatedBy(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetCreatedBy(*s)
+	}
+	return jruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (jruo *JobRunUpdateOne) SetUpdatedBy(s string) *JobRunUpdateOne {
+	jruo.mutation.SetUpdatedBy(s)
+	return jruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableUpdatedBy(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetUpdatedBy(*s)
+	}
+	return jruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (jruo *JobRunUpdateOne) SetUpdatedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetUpdatedAt(t)
+	return jruo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (jruo *JobRunUpdateOne) SetDeletedBy(s string) *JobRunUpdateOne {
+	jruo.mutation.SetDeletedBy(s)
+	return jruo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableDeletedBy(s *string) *JobRunUpdateOne {
+	if s != nil {
+		jruo.SetDeletedBy(*s)
+	}
+	return jruo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (jruo *JobRunUpdateOne) ClearDeletedBy() *JobRunUpdateOne {
+	jruo.mutation.ClearDeletedBy()
+	return jruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (jruo *JobRunUpdateOne) SetDeletedAt(t time.Time) *JobRunUpdateOne {
+	jruo.mutation.SetDeletedAt(t)
+	return jruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableDeletedAt(t *time.Time) *JobRunUpdateOne {
+	if t != nil {
+		jruo.SetDeletedAt(*t)
+	}
+	return jruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (jruo *JobRunUpdateOne) ClearDeletedAt() *JobRunUpdateOne {
+	jruo.mutation.ClearDeletedAt()
+	return jruo
+}
+
+// SetScheduleID sets the "schedule" edge to the Schedule entity by ID.
+func (jruo *JobRunUpdateOne) SetScheduleID(id uuid.UUID) *JobRunUpdateOne {
+	jruo.mutation.SetScheduleID(id)
+	return jruo
+}
+
+// SetNillableScheduleID sets the "schedule" edge to the Schedule entity by ID if the given value is not nil.
+func (jruo *JobRunUpdateOne) SetNillableScheduleID(id *uuid.UUID) *JobRunUpdateOne {
+	if id != nil {
+		jruo = jruo.SetScheduleID(*id)
+	}
+	return jruo
+}
+
+// SetSchedule sets the "schedule" edge to the Schedule entity.
+func (jruo *JobRunUpdateOne) SetSchedule(s *Schedule) *JobRunUpdateOne {
+	return jruo.SetScheduleID(s.ID)
+}
+
+// Mutation returns the JobRunMutation object of the builder.
+func (jruo *JobRunUpdateOne) Mutation() *JobRunMutation {
+	return jruo.mutation
+}
+
+// ClearSchedule clears the "schedule" edge to the Schedule entity.
+func (jruo *JobRunUpdateOne) ClearSchedule() *JobRunUpdateOne {
+	jruo.mutation.ClearSchedule()
+	return jruo
+}
+
+// Where appends a list predicates to the JobRunUpdate builder.
+func (jruo *JobRunUpdateOne) Where(ps ...predicate.JobRun) *JobRunUpdateOne {
+	jruo.mutation.Where(ps...)
+	return jruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (jruo *JobRunUpdateOne) Select(field string, fields ...string) *JobRunUpdateOne {
+	jruo.fields = append([]string{field}, fields...)
+	return jruo
+}
+
+// Save executes the query and returns the updated JobRun entity.
+func (jruo *JobRunUpdateOne) Save(ctx context.Context) (*JobRun, error) {
+	jruo.defaults()
+	return withHooks(ctx, jruo.sqlSave, jruo.mutation, jruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) SaveX(ctx context.Context) *JobRun {
+	node, err := jruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (jruo *JobRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := jruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (jruo *JobRunUpdateOne) ExecX(ctx context.Context) {
+	if err := jruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (jruo *JobRunUpdateOne) defaults() {
+	if _, ok := jruo.mutation.UpdatedAt(); !ok {
+		v := jobrun.UpdateDefaultUpdatedAt()
+		jruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (jruo *JobRunUpdateOne) check() error {
+	if v, ok := jruo.mutation.Status(); ok {
+		if err := jobrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "JobRun.status": %w`, err)}
+		}
+	}
+	if v, ok := jruo.mutation.Progress(); ok {
+		if err := jobrun.ProgressValidator(v); err != nil {
+			return &ValidationError{Name: "progress", err: fmt.Errorf(`ent: validator failed for field "JobRun.progress": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (jruo *JobRunUpdateOne) sqlSave(ctx context.Context) (_node *JobRun, err error) {
+	if err := jruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(jobrun.Table, jobrun.Columns, sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID))
+	id, ok := jruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "JobRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := jruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, jobrun.FieldID)
+		for _, f := range fields {
+			if !jobrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != jobrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := jruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := jruo.mutation.Parameters(); ok {
+		_spec.SetField(jobrun.FieldParameters, field.TypeJSON, value)
+	}
+	if jruo.mutation.ParametersCleared() {
+		_spec.ClearField(jobrun.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := jruo.mutation.Status(); ok {
+		_spec.SetField(jobrun.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := jruo.mutation.Progress(); ok {
+		_spec.SetField(jobrun.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := jruo.mutation.AddedProgress(); ok {
+		_spec.AddField(jobrun.FieldProgress, field.TypeInt, value)
+	}
+	if value, ok := jruo.mutation.Log(); ok {
+		_spec.SetField(jobrun.FieldLog, field.TypeString, value)
+	}
+	if jruo.mutation.LogCleared() {
+		_spec.ClearField(jobrun.FieldLog, field.TypeString)
+	}
+	if value, ok := jruo.mutation.CancelRequested(); ok {
+		_spec.SetField(jobrun.FieldCancelRequested, field.TypeBool, value)
+	}
+	if jruo.mutation.RetryOfCleared() {
+		_spec.ClearField(jobrun.FieldRetryOf, field.TypeUUID)
+	}
+	if jruo.mutation.RunAfterCleared() {
+		_spec.ClearField(jobrun.FieldRunAfter, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.StartedAt(); ok {
+		_spec.SetField(jobrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.StartedAtCleared() {
+		_spec.ClearField(jobrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.HeartbeatAt(); ok {
+		_spec.SetField(jobrun.FieldHeartbeatAt, field.TypeTime, value)
+	}
+	if jruo.mutation.HeartbeatAtCleared() {
+		_spec.ClearField(jobrun.FieldHeartbeatAt, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.FinishedAt(); ok {
+		_spec.SetField(jobrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(jobrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := jruo.mutation.Result(); ok {
+		_spec.SetField(jobrun.FieldResult, field.TypeString, value)
+	}
+	if jruo.mutation.ResultCleared() {
+		_spec.ClearField(jobrun.FieldResult, field.TypeString)
+	}
+	if value, ok := jruo.mutation.Error(); ok {
+		_spec.SetField(jobrun.FieldError, field.TypeString, value)
+	}
+	if jruo.mutation.ErrorCleared() {
+		_spec.ClearField(jobrun.FieldError, field.TypeString)
+	}
+	if value, ok := jruo.mutation.CreatedBy(); ok {
+		_spec.SetField(jobrun.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(jobrun.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := jruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(jobrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := jruo.mutation.DeletedBy(); ok {
+		_spec.SetField(jobrun.FieldDeletedBy, field.TypeString, value)
+	}
+	if jruo.mutation.DeletedByCleared() {
+		_spec.ClearField(jobrun.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := jruo.mutation.DeletedAt(); ok {
+		_spec.SetField(jobrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if jruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(jobrun.FieldDeletedAt, field.TypeTime)
+	}
+	if jruo.mutation.ScheduleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ScheduleTable,
+			Columns: []string{jobrun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := jruo.mutation.ScheduleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   jobrun.ScheduleTable,
+			Columns: []string{jobrun.ScheduleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &JobRun{config: jruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, jruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{jobrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	jruo.mutation.done = true
+	return _node, nil
+}
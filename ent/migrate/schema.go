@@ -17,13 +17,14 @@ var (
 		{Name: "icon", Type: field.TypeString, Nullable: true},
 		{Name: "color", Type: field.TypeString, Nullable: true},
 		{Name: "provider", Type: field.TypeString, Nullable: true},
+		{Name: "drift_policy", Type: field.TypeEnum, Enums: []string{"auto_apply", "approve"}, Default: "auto_apply"},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_by", Type: field.TypeString},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "item_asset_class", Type: field.TypeUUID, Nullable: true},
+		{Name: "webhook_asset_classes", Type: field.TypeUUID, Nullable: true},
 	}
 	// AssetClassesTable holds the schema information for the "asset_classes" table.
 	AssetClassesTable = &schema.Table{
@@ -32,26 +33,294 @@ var (
 		PrimaryKey: []*schema.Column{AssetClassesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "asset_classes_items_asset_class",
-				Columns:    []*schema.Column{AssetClassesColumns[13]},
+				Symbol:     "asset_classes_webhooks_asset_classes",
+				Columns:    []*schema.Column{AssetClassesColumns[14]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// AttachmentsColumns holds the columns for the "attachments" table.
+	AttachmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "filename", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "purged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attachment_item", Type: field.TypeUUID},
+	}
+	// AttachmentsTable holds the schema information for the "attachments" table.
+	AttachmentsTable = &schema.Table{
+		Name:       "attachments",
+		Columns:    AttachmentsColumns,
+		PrimaryKey: []*schema.Column{AttachmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attachments_items_item",
+				Columns:    []*schema.Column{AttachmentsColumns[13]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AttributeDefinitionsColumns holds the columns for the "attribute_definitions" table.
+	AttributeDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "key", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"string", "number", "date", "bool", "enum", "url", "reference"}},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "default_value", Type: field.TypeString, Nullable: true},
+		{Name: "enum_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "secret", Type: field.TypeBool, Default: false},
+		{Name: "order", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "asset_class_attributes", Type: field.TypeUUID},
+	}
+	// AttributeDefinitionsTable holds the schema information for the "attribute_definitions" table.
+	AttributeDefinitionsTable = &schema.Table{
+		Name:       "attribute_definitions",
+		Columns:    AttributeDefinitionsColumns,
+		PrimaryKey: []*schema.Column{AttributeDefinitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "attribute_definitions_asset_classes_attributes",
+				Columns:    []*schema.Column{AttributeDefinitionsColumns[16]},
+				RefColumns: []*schema.Column{AssetClassesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "reveal"}},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "changes", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[2]},
+			},
+			{
+				Name:    "auditlog_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4]},
+			},
+			{
+				Name:    "auditlog_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[5]},
+			},
+		},
+	}
+	// CommentsColumns holds the columns for the "comments" table.
+	CommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "mentions", Type: field.TypeJSON, Nullable: true},
+		{Name: "edits", Type: field.TypeJSON, Nullable: true},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "comment_item", Type: field.TypeUUID},
+		{Name: "comment_replies", Type: field.TypeUUID, Nullable: true},
+	}
+	// CommentsTable holds the schema information for the "comments" table.
+	CommentsTable = &schema.Table{
+		Name:       "comments",
+		Columns:    CommentsColumns,
+		PrimaryKey: []*schema.Column{CommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comments_items_item",
+				Columns:    []*schema.Column{CommentsColumns[11]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "comments_comments_replies",
+				Columns:    []*schema.Column{CommentsColumns[12]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// CommentMentionsColumns holds the columns for the "comment_mentions" table.
+	CommentMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "subject", Type: field.TypeString},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "comment_mentioned", Type: field.TypeUUID},
+	}
+	// CommentMentionsTable holds the schema information for the "comment_mentions" table.
+	CommentMentionsTable = &schema.Table{
+		Name:       "comment_mentions",
+		Columns:    CommentMentionsColumns,
+		PrimaryKey: []*schema.Column{CommentMentionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "comment_mentions_comments_mentioned",
+				Columns:    []*schema.Column{CommentMentionsColumns[8]},
+				RefColumns: []*schema.Column{CommentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commentmention_subject_comment_mentioned",
+				Unique:  true,
+				Columns: []*schema.Column{CommentMentionsColumns[1], CommentMentionsColumns[8]},
+			},
+		},
+	}
+	// DNSChecksColumns holds the columns for the "dns_checks" table.
+	DNSChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "record_name", Type: field.TypeString},
+		{Name: "record_type", Type: field.TypeEnum, Enums: []string{"A", "AAAA", "CNAME", "MX", "TXT"}},
+		{Name: "expected", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ok", "mismatch", "nxdomain", "dangling", "error"}},
+		{Name: "answers", Type: field.TypeJSON, Nullable: true},
+		{Name: "message", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "dns_check_item", Type: field.TypeUUID},
+	}
+	// DNSChecksTable holds the schema information for the "dns_checks" table.
+	DNSChecksTable = &schema.Table{
+		Name:       "dns_checks",
+		Columns:    DNSChecksColumns,
+		PrimaryKey: []*schema.Column{DNSChecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "dns_checks_items_item",
+				Columns:    []*schema.Column{DNSChecksColumns[13]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DomainLookupsColumns holds the columns for the "domain_lookups" table.
+	DomainLookupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "domain", Type: field.TypeString},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"rdap", "whois"}},
+		{Name: "server", Type: field.TypeString, Nullable: true},
+		{Name: "registrar", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "statuses", Type: field.TypeJSON, Nullable: true},
+		{Name: "nameservers", Type: field.TypeJSON, Nullable: true},
+		{Name: "discrepancies", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "domain_lookup_item", Type: field.TypeUUID},
+	}
+	// DomainLookupsTable holds the schema information for the "domain_lookups" table.
+	DomainLookupsTable = &schema.Table{
+		Name:       "domain_lookups",
+		Columns:    DomainLookupsColumns,
+		PrimaryKey: []*schema.Column{DomainLookupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "domain_lookups_items_item",
+				Columns:    []*schema.Column{DomainLookupsColumns[16]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// DriftReportsColumns holds the columns for the "drift_reports" table.
+	DriftReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "applied", "rejected", "superseded"}, Default: "pending"},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "drift_report_asset_class", Type: field.TypeUUID},
+	}
+	// DriftReportsTable holds the schema information for the "drift_reports" table.
+	DriftReportsTable = &schema.Table{
+		Name:       "drift_reports",
+		Columns:    DriftReportsColumns,
+		PrimaryKey: []*schema.Column{DriftReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "drift_reports_asset_classes_asset_class",
+				Columns:    []*schema.Column{DriftReportsColumns[12]},
+				RefColumns: []*schema.Column{AssetClassesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
+		{Name: "cost_amount", Type: field.TypeFloat64, Nullable: true},
+		{Name: "cost_currency", Type: field.TypeString, Nullable: true},
+		{Name: "cost_period", Type: field.TypeEnum, Nullable: true, Enums: []string{"monthly", "quarterly", "yearly"}},
+		{Name: "cost_center", Type: field.TypeString, Nullable: true},
 		{Name: "created_by", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_by", Type: field.TypeString},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "tag_items", Type: field.TypeUUID, Nullable: true},
-		{Name: "user_group_items", Type: field.TypeUUID, Nullable: true},
+		{Name: "item_asset_class", Type: field.TypeUUID},
+		{Name: "item_children", Type: field.TypeUUID, Nullable: true},
 	}
 	// ItemsTable holds the schema information for the "items" table.
 	ItemsTable = &schema.Table{
@@ -60,19 +329,372 @@ var (
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "items_tags_items",
-				Columns:    []*schema.Column{ItemsColumns[9]},
-				RefColumns: []*schema.Column{TagsColumns[0]},
+				Symbol:     "items_asset_classes_asset_class",
+				Columns:    []*schema.Column{ItemsColumns[15]},
+				RefColumns: []*schema.Column{AssetClassesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "items_items_children",
+				Columns:    []*schema.Column{ItemsColumns[16]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+		},
+	}
+	// ItemRelationsColumns holds the columns for the "item_relations" table.
+	ItemRelationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"hosts", "depends_on", "resolves_to", "backs_up"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "item_relation_source", Type: field.TypeUUID},
+		{Name: "item_relation_target", Type: field.TypeUUID},
+	}
+	// ItemRelationsTable holds the schema information for the "item_relations" table.
+	ItemRelationsTable = &schema.Table{
+		Name:       "item_relations",
+		Columns:    ItemRelationsColumns,
+		PrimaryKey: []*schema.Column{ItemRelationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_relations_items_source",
+				Columns:    []*schema.Column{ItemRelationsColumns[9]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "item_relations_items_target",
+				Columns:    []*schema.Column{ItemRelationsColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// JobRunsColumns holds the columns for the "job_runs" table.
+	JobRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeString},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "running", "succeeded", "failed", "canceled"}, Default: "queued"},
+		{Name: "progress", Type: field.TypeInt, Default: 0},
+		{Name: "log", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "cancel_requested", Type: field.TypeBool, Default: false},
+		{Name: "retry_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "run_after", Type: field.TypeTime, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "heartbeat_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "result", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "schedule_runs", Type: field.TypeUUID, Nullable: true},
+	}
+	// JobRunsTable holds the schema information for the "job_runs" table.
+	JobRunsTable = &schema.Table{
+		Name:       "job_runs",
+		Columns:    JobRunsColumns,
+		PrimaryKey: []*schema.Column{JobRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "items_user_groups_items",
-				Columns:    []*schema.Column{ItemsColumns[10]},
+				Symbol:     "job_runs_schedules_runs",
+				Columns:    []*schema.Column{JobRunsColumns[20]},
+				RefColumns: []*schema.Column{SchedulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// NotificationChannelsColumns holds the columns for the "notification_channels" table.
+	NotificationChannelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"smtp", "webhook", "slack"}},
+		{Name: "config", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reminder_rule_channels", Type: field.TypeUUID, Nullable: true},
+	}
+	// NotificationChannelsTable holds the schema information for the "notification_channels" table.
+	NotificationChannelsTable = &schema.Table{
+		Name:       "notification_channels",
+		Columns:    NotificationChannelsColumns,
+		PrimaryKey: []*schema.Column{NotificationChannelsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_channels_reminder_rules_channels",
+				Columns:    []*schema.Column{NotificationChannelsColumns[11]},
+				RefColumns: []*schema.Column{ReminderRulesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// NotificationDeliveriesColumns holds the columns for the "notification_deliveries" table.
+	NotificationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "attribute_key", Type: field.TypeString},
+		{Name: "due_on", Type: field.TypeString},
+		{Name: "days", Type: field.TypeInt},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "notification_delivery_item", Type: field.TypeUUID},
+		{Name: "notification_delivery_channel", Type: field.TypeUUID},
+	}
+	// NotificationDeliveriesTable holds the schema information for the "notification_deliveries" table.
+	NotificationDeliveriesTable = &schema.Table{
+		Name:       "notification_deliveries",
+		Columns:    NotificationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{NotificationDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notification_deliveries_items_item",
+				Columns:    []*schema.Column{NotificationDeliveriesColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "notification_deliveries_notification_channels_channel",
+				Columns:    []*schema.Column{NotificationDeliveriesColumns[11]},
+				RefColumns: []*schema.Column{NotificationChannelsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notificationdelivery_attribute_key_due_on_days_notification_delivery_item_notification_delivery_channel",
+				Unique:  true,
+				Columns: []*schema.Column{NotificationDeliveriesColumns[1], NotificationDeliveriesColumns[2], NotificationDeliveriesColumns[3], NotificationDeliveriesColumns[10], NotificationDeliveriesColumns[11]},
+			},
+		},
+	}
+	// ProbesColumns holds the columns for the "probes" table.
+	ProbesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"tcp", "http", "https"}},
+		{Name: "host", Type: field.TypeString, Nullable: true},
+		{Name: "port", Type: field.TypeInt, Default: 0},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "expected_status", Type: field.TypeInt, Default: 0},
+		{Name: "timeout", Type: field.TypeInt, Default: 5},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "probe_item", Type: field.TypeUUID, Nullable: true},
+		{Name: "probe_asset_class", Type: field.TypeUUID, Nullable: true},
+	}
+	// ProbesTable holds the schema information for the "probes" table.
+	ProbesTable = &schema.Table{
+		Name:       "probes",
+		Columns:    ProbesColumns,
+		PrimaryKey: []*schema.Column{ProbesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "probes_items_item",
+				Columns:    []*schema.Column{ProbesColumns[15]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "probes_asset_classes_asset_class",
+				Columns:    []*schema.Column{ProbesColumns[16]},
+				RefColumns: []*schema.Column{AssetClassesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ProbeResultsColumns holds the columns for the "probe_results" table.
+	ProbeResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "target", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"up", "down"}},
+		{Name: "latency_ms", Type: field.TypeFloat64, Default: 0},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "probe_result_probe", Type: field.TypeUUID},
+		{Name: "probe_result_item", Type: field.TypeUUID},
+	}
+	// ProbeResultsTable holds the schema information for the "probe_results" table.
+	ProbeResultsTable = &schema.Table{
+		Name:       "probe_results",
+		Columns:    ProbeResultsColumns,
+		PrimaryKey: []*schema.Column{ProbeResultsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "probe_results_probes_probe",
+				Columns:    []*schema.Column{ProbeResultsColumns[12]},
+				RefColumns: []*schema.Column{ProbesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "probe_results_items_item",
+				Columns:    []*schema.Column{ProbeResultsColumns[13]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ProviderAccountsColumns holds the columns for the "provider_accounts" table.
+	ProviderAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "config", Type: field.TypeJSON, Nullable: true},
+		{Name: "secrets", Type: field.TypeJSON, Nullable: true},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ProviderAccountsTable holds the schema information for the "provider_accounts" table.
+	ProviderAccountsTable = &schema.Table{
+		Name:       "provider_accounts",
+		Columns:    ProviderAccountsColumns,
+		PrimaryKey: []*schema.Column{ProviderAccountsColumns[0]},
+	}
+	// ReachabilitiesColumns holds the columns for the "reachabilities" table.
+	ReachabilitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"up", "down"}},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "item_reachability", Type: field.TypeUUID, Unique: true},
+	}
+	// ReachabilitiesTable holds the schema information for the "reachabilities" table.
+	ReachabilitiesTable = &schema.Table{
+		Name:       "reachabilities",
+		Columns:    ReachabilitiesColumns,
+		PrimaryKey: []*schema.Column{ReachabilitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reachabilities_items_reachability",
+				Columns:    []*schema.Column{ReachabilitiesColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ReminderRulesColumns holds the columns for the "reminder_rules" table.
+	ReminderRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "attribute_key", Type: field.TypeString, Nullable: true},
+		{Name: "days", Type: field.TypeJSON},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reminder_rule_asset_class", Type: field.TypeUUID},
+	}
+	// ReminderRulesTable holds the schema information for the "reminder_rules" table.
+	ReminderRulesTable = &schema.Table{
+		Name:       "reminder_rules",
+		Columns:    ReminderRulesColumns,
+		PrimaryKey: []*schema.Column{ReminderRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminder_rules_asset_classes_asset_class",
+				Columns:    []*schema.Column{ReminderRulesColumns[10]},
+				RefColumns: []*schema.Column{AssetClassesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SavedViewsColumns holds the columns for the "saved_views" table.
+	SavedViewsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString},
+		{Name: "query", Type: field.TypeString, Nullable: true},
+		{Name: "sort", Type: field.TypeJSON, Nullable: true},
+		{Name: "columns", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "saved_view_user_group", Type: field.TypeUUID, Nullable: true},
+	}
+	// SavedViewsTable holds the schema information for the "saved_views" table.
+	SavedViewsTable = &schema.Table{
+		Name:       "saved_views",
+		Columns:    SavedViewsColumns,
+		PrimaryKey: []*schema.Column{SavedViewsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_views_user_groups_user_group",
+				Columns:    []*schema.Column{SavedViewsColumns[13]},
 				RefColumns: []*schema.Column{UserGroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// SchedulesColumns holds the columns for the "schedules" table.
+	SchedulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "job_type", Type: field.TypeString},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "cron", Type: field.TypeString},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "jitter", Type: field.TypeInt, Default: 0},
+		{Name: "paused", Type: field.TypeBool, Default: false},
+		{Name: "next_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SchedulesTable holds the schema information for the "schedules" table.
+	SchedulesTable = &schema.Table{
+		Name:       "schedules",
+		Columns:    SchedulesColumns,
+		PrimaryKey: []*schema.Column{SchedulesColumns[0]},
+	}
 	// TagsColumns holds the columns for the "tags" table.
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -84,21 +706,12 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "item_tags", Type: field.TypeUUID, Nullable: true},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
 		Name:       "tags",
 		Columns:    TagsColumns,
 		PrimaryKey: []*schema.Column{TagsColumns[0]},
-		ForeignKeys: []*schema.ForeignKey{
-			{
-				Symbol:     "tags_items_tags",
-				Columns:    []*schema.Column{TagsColumns[9]},
-				RefColumns: []*schema.Column{ItemsColumns[0]},
-				OnDelete:   schema.SetNull,
-			},
-		},
 	}
 	// UserGroupsColumns holds the columns for the "user_groups" table.
 	UserGroupsColumns = []*schema.Column{
@@ -112,35 +725,208 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "item_user_groups", Type: field.TypeUUID, Nullable: true},
 	}
 	// UserGroupsTable holds the schema information for the "user_groups" table.
 	UserGroupsTable = &schema.Table{
 		Name:       "user_groups",
 		Columns:    UserGroupsColumns,
 		PrimaryKey: []*schema.Column{UserGroupsColumns[0]},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "entity_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "events", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeEnum, Enums: []string{"create", "update", "delete"}},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeUUID},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_by", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[17]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ItemTagsColumns holds the columns for the "item_tags" table.
+	ItemTagsColumns = []*schema.Column{
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// ItemTagsTable holds the schema information for the "item_tags" table.
+	ItemTagsTable = &schema.Table{
+		Name:       "item_tags",
+		Columns:    ItemTagsColumns,
+		PrimaryKey: []*schema.Column{ItemTagsColumns[0], ItemTagsColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "user_groups_items_user_groups",
-				Columns:    []*schema.Column{UserGroupsColumns[10]},
+				Symbol:     "item_tags_item_id",
+				Columns:    []*schema.Column{ItemTagsColumns[0]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
-				OnDelete:   schema.SetNull,
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_tags_tag_id",
+				Columns:    []*schema.Column{ItemTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ItemUserGroupsColumns holds the columns for the "item_user_groups" table.
+	ItemUserGroupsColumns = []*schema.Column{
+		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "user_group_id", Type: field.TypeUUID},
+	}
+	// ItemUserGroupsTable holds the schema information for the "item_user_groups" table.
+	ItemUserGroupsTable = &schema.Table{
+		Name:       "item_user_groups",
+		Columns:    ItemUserGroupsColumns,
+		PrimaryKey: []*schema.Column{ItemUserGroupsColumns[0], ItemUserGroupsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_user_groups_item_id",
+				Columns:    []*schema.Column{ItemUserGroupsColumns[0]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_user_groups_user_group_id",
+				Columns:    []*schema.Column{ItemUserGroupsColumns[1]},
+				RefColumns: []*schema.Column{UserGroupsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ProbeChannelsColumns holds the columns for the "probe_channels" table.
+	ProbeChannelsColumns = []*schema.Column{
+		{Name: "probe_id", Type: field.TypeUUID},
+		{Name: "notification_channel_id", Type: field.TypeUUID},
+	}
+	// ProbeChannelsTable holds the schema information for the "probe_channels" table.
+	ProbeChannelsTable = &schema.Table{
+		Name:       "probe_channels",
+		Columns:    ProbeChannelsColumns,
+		PrimaryKey: []*schema.Column{ProbeChannelsColumns[0], ProbeChannelsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "probe_channels_probe_id",
+				Columns:    []*schema.Column{ProbeChannelsColumns[0]},
+				RefColumns: []*schema.Column{ProbesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "probe_channels_notification_channel_id",
+				Columns:    []*schema.Column{ProbeChannelsColumns[1]},
+				RefColumns: []*schema.Column{NotificationChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AssetClassesTable,
+		AttachmentsTable,
+		AttributeDefinitionsTable,
+		AuditLogsTable,
+		CommentsTable,
+		CommentMentionsTable,
+		DNSChecksTable,
+		DomainLookupsTable,
+		DriftReportsTable,
 		ItemsTable,
+		ItemRelationsTable,
+		JobRunsTable,
+		NotificationChannelsTable,
+		NotificationDeliveriesTable,
+		ProbesTable,
+		ProbeResultsTable,
+		ProviderAccountsTable,
+		ReachabilitiesTable,
+		ReminderRulesTable,
+		SavedViewsTable,
+		SchedulesTable,
 		TagsTable,
 		UserGroupsTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
+		ItemTagsTable,
+		ItemUserGroupsTable,
+		ProbeChannelsTable,
 	}
 )
 
 func init() {
-	AssetClassesTable.ForeignKeys[0].RefTable = ItemsTable
-	ItemsTable.ForeignKeys[0].RefTable = TagsTable
-	ItemsTable.ForeignKeys[1].RefTable = UserGroupsTable
-	TagsTable.ForeignKeys[0].RefTable = ItemsTable
-	UserGroupsTable.ForeignKeys[0].RefTable = ItemsTable
+	AssetClassesTable.ForeignKeys[0].RefTable = WebhooksTable
+	AttachmentsTable.ForeignKeys[0].RefTable = ItemsTable
+	AttributeDefinitionsTable.ForeignKeys[0].RefTable = AssetClassesTable
+	CommentsTable.ForeignKeys[0].RefTable = ItemsTable
+	CommentsTable.ForeignKeys[1].RefTable = CommentsTable
+	CommentMentionsTable.ForeignKeys[0].RefTable = CommentsTable
+	DNSChecksTable.ForeignKeys[0].RefTable = ItemsTable
+	DomainLookupsTable.ForeignKeys[0].RefTable = ItemsTable
+	DriftReportsTable.ForeignKeys[0].RefTable = AssetClassesTable
+	ItemsTable.ForeignKeys[0].RefTable = AssetClassesTable
+	ItemsTable.ForeignKeys[1].RefTable = ItemsTable
+	ItemRelationsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemRelationsTable.ForeignKeys[1].RefTable = ItemsTable
+	JobRunsTable.ForeignKeys[0].RefTable = SchedulesTable
+	NotificationChannelsTable.ForeignKeys[0].RefTable = ReminderRulesTable
+	NotificationDeliveriesTable.ForeignKeys[0].RefTable = ItemsTable
+	NotificationDeliveriesTable.ForeignKeys[1].RefTable = NotificationChannelsTable
+	ProbesTable.ForeignKeys[0].RefTable = ItemsTable
+	ProbesTable.ForeignKeys[1].RefTable = AssetClassesTable
+	ProbeResultsTable.ForeignKeys[0].RefTable = ProbesTable
+	ProbeResultsTable.ForeignKeys[1].RefTable = ItemsTable
+	ReachabilitiesTable.ForeignKeys[0].RefTable = ItemsTable
+	ReminderRulesTable.ForeignKeys[0].RefTable = AssetClassesTable
+	SavedViewsTable.ForeignKeys[0].RefTable = UserGroupsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
+	ItemTagsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemTagsTable.ForeignKeys[1].RefTable = TagsTable
+	ItemUserGroupsTable.ForeignKeys[0].RefTable = ItemsTable
+	ItemUserGroupsTable.ForeignKeys[1].RefTable = UserGroupsTable
+	ProbeChannelsTable.ForeignKeys[0].RefTable = ProbesTable
+	ProbeChannelsTable.ForeignKeys[1].RefTable = NotificationChannelsTable
 }
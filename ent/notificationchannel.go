@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/notificationchannel"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// NotificationChannel is the model entity for the NotificationChannel schema.
+type NotificationChannel struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the channel.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The name of the channel, which is shown when choosing the channels of a reminder rule.
+	Name string `json:"name,omitempty"`
+	// How notifications are delivered: as email via SMTP, as JSON posted to a webhook, or as message posted to a Slack-compatible incoming webhook.
+	Type notificationchannel.Type `json:"type,omitempty"`
+	// The settings of the channel, which depend on its type, e.g. `host`, `port`, `username`, `password`, `from` and `to` for SMTP or `url` for webhooks.
+	Config map[string]string `json:"-"`
+	// Whether notifications are sent through the channel.
+	Enabled bool `json:"enabled,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationChannelQuery when eager-loading is set.
+	Edges                  NotificationChannelEdges `json:"edges"`
+	reminder_rule_channels *uuid.UUID
+	selectValues           sql.SelectValues
+}
+
+// NotificationChannelEdges holds the relations/edges for other nodes in the graph.
+type NotificationChannelEdges struct {
+	// The reachability probes that notify the channel, a channel can be shared by many probes.
+	Probes []*Probe `json:"probes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProbesOrErr returns the Probes value or an error if the edge
+// was not loaded in eager-loading.
+func (e NotificationChannelEdges) ProbesOrErr() ([]*Probe, error) {
+	if e.loadedTypes[0] {
+		return e.Probes, nil
+	}
+	return nil, &NotLoadedError{edge: "probes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationChannel) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationchannel.FieldConfig:
+			values[i] = new([]byte)
+		case notificationchannel.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case notificationchannel.FieldName, notificationchannel.FieldType, notificationchannel.FieldCreatedBy, notificationchannel.FieldUpdatedBy, notificationchannel.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case notificationchannel.FieldCreatedAt, notificationchannel.FieldUpdatedAt, notificationchannel.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case notificationchannel.FieldID:
+			values[i] = new(uuid.UUID)
+		case notificationchannel.ForeignKeys[0]: // reminder_rule_channels
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationChannel fields.
+func (nc *NotificationChannel) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationchannel.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nc.ID = *value
+			}
+		case notificationchannel.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				nc.Name = value.String
+			}
+		case notificationchannel.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				nc.Type = notificationchannel.Type(value.String)
+			}
+		case notificationchannel.FieldConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &nc.Config); err != nil {
+					return fmt.Errorf("unmarshal field config: %w", err)
+				}
+			}
+		case notificationchannel.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				nc.Enabled = value.Bool
+			}
+		case notificationchannel.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				nc.CreatedBy = value.String
+			}
+		case notificationchannel.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nc.CreatedAt = value.Time
+			}
+		case notificationchannel.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				nc.UpdatedBy = value.String
+			}
+		case notificationchannel.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nc.UpdatedAt = value.Time
+			}
+		case notificationchannel.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				nc.DeletedBy = value.String
+			}
+		case notificationchannel.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				nc.DeletedAt = new(time.Time)
+				*nc.DeletedAt = value.Time
+			}
+		case notificationchannel.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_rule_channels", values[i])
+			} else if value.Valid {
+				nc.reminder_rule_channels = new(uuid.UUID)
+				*nc.reminder_rule_channels = *value.S.(*uuid.UUID)
+			}
+		default:
+			nc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationChannel.
+// This includes values selected through modifiers, order, etc.
+func (nc *NotificationChannel) Value(name string) (ent.Value, error) {
+	return nc.selectValues.Get(name)
+}
+
+// QueryProbes queries the "probes" edge of the NotificationChannel entity.
+func (nc *NotificationChannel) QueryProbes() *ProbeQuery {
+	return NewNotificationChannelClient(nc.config).QueryProbes(nc)
+}
+
+// Update returns a builder for updating this NotificationChannel.
+// Note that you need to call NotificationChannel.Unwrap() before calling this method if this NotificationChannel
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nc *NotificationChannel) Update() *NotificationChannelUpdateOne {
+	return NewNotificationChannelClient(nc.config).UpdateOne(nc)
+}
+
+// Unwrap unwraps the NotificationChannel entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nc *NotificationChannel) Unwrap() *NotificationChannel {
+	_tx, ok := nc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationChannel is not a transactional entity")
+	}
+	nc.config.driver = _tx.drv
+	return nc
+}
+
+// String implements the fmt.Stringer.
+func (nc *NotificationChannel) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationChannel(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nc.ID))
+	builder.WriteString("name=")
+	builder.WriteString(nc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", nc.Type))
+	builder.WriteString(", ")
+	builder.WriteString("config=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", nc.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(nc.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(nc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(nc.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(nc.DeletedBy)
+	builder.WriteString(", ")
+	if v := nc.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationChannels is a parsable slice of NotificationChannel.
+type NotificationChannels []*NotificationChannel
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationChannelDelete is the builder for deleting a NotificationChannel entity.
+type NotificationChannelDelete struct {
+	config
+	hooks    []Hook
+	mutation *NotificationChannelMutation
+}
+
+// Where appends a list predicates to the NotificationChannelDelete builder.
+func (ncd *NotificationChannelDelete) Where(ps ...predicate.NotificationChannel) *NotificationChannelDelete {
+	ncd.mutation.Where(ps...)
+	return ncd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ncd *NotificationChannelDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ncd.sqlExec, ncd.mutation, ncd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncd *NotificationChannelDelete) ExecX(ctx context.Context) int {
+	n, err := ncd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ncd *NotificationChannelDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(notificationchannel.Table, sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID))
+	if ps := ncd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ncd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ncd.mutation.done = true
+	return affected, err
+}
+
+// NotificationChannelDeleteOne is the builder for deleting a single NotificationChannel entity.
+type NotificationChannelDeleteOne struct {
+	ncd *NotificationChannelDelete
+}
+
+// Where appends a list predicates to the NotificationChannelDelete builder.
+func (ncdo *NotificationChannelDeleteOne) Where(ps ...predicate.NotificationChannel) *NotificationChannelDeleteOne {
+	ncdo.ncd.mutation.Where(ps...)
+	return ncdo
+}
+
+// Exec executes the deletion query.
+func (ncdo *NotificationChannelDeleteOne) Exec(ctx context.Context) error {
+	n, err := ncdo.ncd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{notificationchannel.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncdo *NotificationChannelDeleteOne) ExecX(ctx context.Context) {
+	if err := ncdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/probe"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationChannelUpdate is the builder for updating NotificationChannel entities.
+type NotificationChannelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationChannelMutation
+}
+
+// Where appends a list predicates to the NotificationChannelUpdate builder.
+func (ncu *NotificationChannelUpdate) Where(ps ...predicate.NotificationChannel) *NotificationChannelUpdate {
+	ncu.mutation.Where(ps...)
+	return ncu
+}
+
+// SetName sets the "name" field.
+func (ncu *NotificationChannelUpdate) SetName(s string) *NotificationChannelUpdate {
+	ncu.mutation.SetName(s)
+	return ncu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableName(s *string) *NotificationChannelUpdate {
+	if s != nil {
+		ncu.SetName(*s)
+	}
+	return ncu
+}
+
+// SetType sets the "type" field.
+func (ncu *NotificationChannelUpdate) SetType(n notificationchannel.Type) *NotificationChannelUpdate {
+	ncu.mutation.SetType(n)
+	return ncu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableType(n *notificationchannel.Type) *NotificationChannelUpdate {
+	if n != nil {
+		ncu.SetType(*n)
+	}
+	return ncu
+}
+
+// SetConfig sets the "config" field.
+func (ncu *NotificationChannelUpdate) SetConfig(m map[string]string) *NotificationChannelUpdate {
+	ncu.mutation.SetConfig(m)
+	return ncu
+}
+
+// ClearConfig clears the value of the "config" field.
+func (ncu *NotificationChannelUpdate) ClearConfig() *NotificationChannelUpdate {
+	ncu.mutation.ClearConfig()
+	return ncu
+}
+
+// SetEnabled sets the "enabled" field.
+func (ncu *NotificationChannelUpdate) SetEnabled(b bool) *NotificationChannelUpdate {
+	ncu.mutation.SetEnabled(b)
+	return ncu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableEnabled(b *bool) *NotificationChannelUpdate {
+	if b != nil {
+		ncu.SetEnabled(*b)
+	}
+	return ncu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ncu *NotificationChannelUpdate) SetCreatedBy(s string) *NotificationChannelUpdate {
+	ncu.mutation.SetCreatedBy(s)
+	return ncu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableCreatedBy(s *string) *NotificationChannelUpdate {
+	if s != nil {
+		ncu.SetCreatedBy(*s)
+	}
+	return ncu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ncu *NotificationChannelUpdate) SetUpdatedBy(s string) *NotificationChannelUpdate {
+	ncu.mutation.SetUpdatedBy(s)
+	return ncu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableUpdatedBy(s *string) *NotificationChannelUpdate {
+	if s != nil {
+		ncu.SetUpdatedBy(*s)
+	}
+	return ncu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ncu *NotificationChannelUpdate) SetUpdatedAt(t time.Time) *NotificationChannelUpdate {
+	ncu.mutation.SetUpdatedAt(t)
+	return ncu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ncu *NotificationChannelUpdate) SetDeletedBy(s string) *NotificationChannelUpdate {
+	ncu.mutation.SetDeletedBy(s)
+	return ncu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableDeletedBy(s *string) *NotificationChannelUpdate {
+	if s != nil {
+		ncu.SetDeletedBy(*s)
+	}
+	return ncu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ncu *NotificationChannelUpdate) ClearDeletedBy() *NotificationChannelUpdate {
+	ncu.mutation.ClearDeletedBy()
+	return ncu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ncu *NotificationChannelUpdate) SetDeletedAt(t time.Time) *NotificationChannelUpdate {
+	ncu.mutation.SetDeletedAt(t)
+	return ncu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ncu *NotificationChannelUpdate) SetNillableDeletedAt(t *time.Time) *NotificationChannelUpdate {
+	if t != nil {
+		ncu.SetDeletedAt(*t)
+	}
+	return ncu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ncu *NotificationChannelUpdate) ClearDeletedAt() *NotificationChannelUpdate {
+	ncu.mutation.ClearDeletedAt()
+	return ncu
+}
+
+// AddProbeIDs adds the "probes" edge to the Probe entity by IDs.
+func (ncu *NotificationChannelUpdate) AddProbeIDs(ids ...uuid.UUID) *NotificationChannelUpdate {
+	ncu.mutation.AddProbeIDs(ids...)
+	return ncu
+}
+
+// AddProbes adds the "probes" edges to the Probe entity.
+func (ncu *NotificationChannelUpdate) AddProbes(p ...*Probe) *NotificationChannelUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ncu.AddProbeIDs(ids...)
+}
+
+// Mutation returns the NotificationChannelMutation object of the builder.
+func (ncu *NotificationChannelUpdate) Mutation() *NotificationChannelMutation {
+	return ncu.mutation
+}
+
+// ClearProbes clears all "probes" edges to the Probe entity.
+func (ncu *NotificationChannelUpdate) ClearProbes() *NotificationChannelUpdate {
+	ncu.mutation.ClearProbes()
+	return ncu
+}
+
+// RemoveProbeIDs removes the "probes" edge to Probe entities by IDs.
+func (ncu *NotificationChannelUpdate) RemoveProbeIDs(ids ...uuid.UUID) *NotificationChannelUpdate {
+	ncu.mutation.RemoveProbeIDs(ids...)
+	return ncu
+}
+
+// RemoveProbes removes "probes" edges to Probe entities.
+func (ncu *NotificationChannelUpdate) RemoveProbes(p ...*Probe) *NotificationChannelUpdate {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ncu.RemoveProbeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ncu *NotificationChannelUpdate) Save(ctx context.Context) (int, error) {
+	ncu.defaults()
+	return withHooks(ctx, ncu.sqlSave, ncu.mutation, ncu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncu *NotificationChannelUpdate) SaveX(ctx context.Context) int {
+	affected, err := ncu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ncu *NotificationChannelUpdate) Exec(ctx context.Context) error {
+	_, err := ncu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncu *NotificationChannelUpdate) ExecX(ctx context.Context) {
+	if err := ncu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ncu *NotificationChannelUpdate) defaults() {
+	if _, ok := ncu.mutation.UpdatedAt(); !ok {
+		v := notificationchannel.UpdateDefaultUpdatedAt()
+		ncu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ncu *NotificationChannelUpdate) check() error {
+	if v, ok := ncu.mutation.Name(); ok {
+		if err := notificationchannel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.name": %w`, err)}
+		}
+	}
+	if v, ok := ncu.mutation.GetType(); ok {
+		if err := notificationchannel.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ncu *NotificationChannelUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ncu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationchannel.Table, notificationchannel.Columns, sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID))
+	if ps := ncu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ncu.mutation.Name(); ok {
+		_spec.SetField(notificationchannel.FieldName, field.TypeString, value)
+	}
+	if value, ok := ncu.mutation.GetType(); ok {
+		_spec.SetField(notificationchannel.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ncu.mutation.Config(); ok {
+		_spec.SetField(notificationchannel.FieldConfig, field.TypeJSON, value)
+	}
+	if ncu.mutation.ConfigCleared() {
+		_spec.ClearField(notificationchannel.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := ncu.mutation.Enabled(); ok {
+		_spec.SetField(notificationchannel.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := ncu.mutation.CreatedBy(); ok {
+		_spec.SetField(notificationchannel.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ncu.mutation.UpdatedBy(); ok {
+		_spec.SetField(notificationchannel.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := ncu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationchannel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ncu.mutation.DeletedBy(); ok {
+		_spec.SetField(notificationchannel.FieldDeletedBy, field.TypeString, value)
+	}
+	if ncu.mutation.DeletedByCleared() {
+		_spec.ClearField(notificationchannel.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ncu.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationchannel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ncu.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDeletedAt, field.TypeTime)
+	}
+	if ncu.mutation.ProbesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ncu.mutation.RemovedProbesIDs(); len(nodes) > 0 && !ncu.mutation.ProbesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ncu.mutation.ProbesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ncu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationchannel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ncu.mutation.done = true
+	return n, nil
+}
+
+// NotificationChannelUpdateOne is the builder for updating a single NotificationChannel entity.
+type NotificationChannelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationChannelMutation
+}
+
+// SetName sets the "name" field.
+func (ncuo *NotificationChannelUpdateOne) SetName(s string) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetName(s)
+	return ncuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableName(s *string) *NotificationChannelUpdateOne {
+	if s != nil {
+		ncuo.SetName(*s)
+	}
+	return ncuo
+}
+
+// SetType sets the "type" field.
+func (ncuo *NotificationChannelUpdateOne) SetType(n notificationchannel.Type) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetType(n)
+	return ncuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableType(n *notificationchannel.Type) *NotificationChannelUpdateOne {
+	if n != nil {
+		ncuo.SetType(*n)
+	}
+	return ncuo
+}
+
+// SetConfig sets the "config" field.
+func (ncuo *NotificationChannelUpdateOne) SetConfig(m map[string]string) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetConfig(m)
+	return ncuo
+}
+
+// ClearConfig clears the value of the "config" field.
+func (ncuo *NotificationChannelUpdateOne) ClearConfig() *NotificationChannelUpdateOne {
+	ncuo.mutation.ClearConfig()
+	return ncuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (ncuo *NotificationChannelUpdateOne) SetEnabled(b bool) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetEnabled(b)
+	return ncuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableEnabled(b *bool) *NotificationChannelUpdateOne {
+	if b != nil {
+		ncuo.SetEnabled(*b)
+	}
+	return ncuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ncuo *NotificationChannelUpdateOne) SetCreatedBy(s string) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetCreatedBy(s)
+	return ncuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableCreatedBy(s *string) *NotificationChannelUpdateOne {
+	if s != nil {
+		ncuo.SetCreatedBy(*s)
+	}
+	return ncuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ncuo *NotificationChannelUpdateOne) SetUpdatedBy(s string) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetUpdatedBy(s)
+	return ncuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableUpdatedBy(s *string) *NotificationChannelUpdateOne {
+	if s != nil {
+		ncuo.SetUpdatedBy(*s)
+	}
+	return ncuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ncuo *NotificationChannelUpdateOne) SetUpdatedAt(t time.Time) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetUpdatedAt(t)
+	return ncuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ncuo *NotificationChannelUpdateOne) SetDeletedBy(s string) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetDeletedBy(s)
+	return ncuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableDeletedBy(s *string) *NotificationChannelUpdateOne {
+	if s != nil {
+		ncuo.SetDeletedBy(*s)
+	}
+	return ncuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ncuo *NotificationChannelUpdateOne) ClearDeletedBy() *NotificationChannelUpdateOne {
+	ncuo.mutation.ClearDeletedBy()
+	return ncuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ncuo *NotificationChannelUpdateOne) SetDeletedAt(t time.Time) *NotificationChannelUpdateOne {
+	ncuo.mutation.SetDeletedAt(t)
+	return ncuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ncuo *NotificationChannelUpdateOne) SetNillableDeletedAt(t *time.Time) *NotificationChannelUpdateOne {
+	if t != nil {
+		ncuo.SetDeletedAt(*t)
+	}
+	return ncuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ncuo *NotificationChannelUpdateOne) ClearDeletedAt() *NotificationChannelUpdateOne {
+	ncuo.mutation.ClearDeletedAt()
+	return ncuo
+}
+
+// AddProbeIDs adds the "probes" edge to the Probe entity by IDs.
+func (ncuo *NotificationChannelUpdateOne) AddProbeIDs(ids ...uuid.UUID) *NotificationChannelUpdateOne {
+	ncuo.mutation.AddProbeIDs(ids...)
+	return ncuo
+}
+
+// AddProbes adds the "probes" edges to the Probe entity.
+func (ncuo *NotificationChannelUpdateOne) AddProbes(p ...*Probe) *NotificationChannelUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ncuo.AddProbeIDs(ids...)
+}
+
+// Mutation returns the NotificationChannelMutation object of the builder.
+func (ncuo *NotificationChannelUpdateOne) Mutation() *NotificationChannelMutation {
+	return ncuo.mutation
+}
+
+// ClearProbes clears all "probes" edges to the Probe entity.
+func (ncuo *NotificationChannelUpdateOne) ClearProbes() *NotificationChannelUpdateOne {
+	ncuo.mutation.ClearProbes()
+	return ncuo
+}
+
+// RemoveProbeIDs removes the "probes" edge to Probe entities by IDs.
+func (ncuo *NotificationChannelUpdateOne) RemoveProbeIDs(ids ...uuid.UUID) *NotificationChannelUpdateOne {
+	ncuo.mutation.RemoveProbeIDs(ids...)
+	return ncuo
+}
+
+// RemoveProbes removes "probes" edges to Probe entities.
+func (ncuo *NotificationChannelUpdateOne) RemoveProbes(p ...*Probe) *NotificationChannelUpdateOne {
+	ids := make([]uuid.UUID, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ncuo.RemoveProbeIDs(ids...)
+}
+
+// Where appends a list predicates to the NotificationChannelUpdate builder.
+func (ncuo *NotificationChannelUpdateOne) Where(ps ...predicate.NotificationChannel) *NotificationChannelUpdateOne {
+	ncuo.mutation.Where(ps...)
+	return ncuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ncuo *NotificationChannelUpdateOne) Select(field string, fields ...string) *NotificationChannelUpdateOne {
+	ncuo.fields = append([]string{field}, fields...)
+	return ncuo
+}
+
+// Save executes the query and returns the updated NotificationChannel entity.
+func (ncuo *NotificationChannelUpdateOne) Save(ctx context.Context) (*NotificationChannel, error) {
+	ncuo.defaults()
+	return withHooks(ctx, ncuo.sqlSave, ncuo.mutation, ncuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncuo *NotificationChannelUpdateOne) SaveX(ctx context.Context) *NotificationChannel {
+	node, err := ncuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ncuo *NotificationChannelUpdateOne) Exec(ctx context.Context) error {
+	_, err := ncuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncuo *NotificationChannelUpdateOne) ExecX(ctx context.Context) {
+	if err := ncuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ncuo *NotificationChannelUpdateOne) defaults() {
+	if _, ok := ncuo.mutation.UpdatedAt(); !ok {
+		v := notificationchannel.UpdateDefaultUpdatedAt()
+		ncuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ncuo *NotificationChannelUpdateOne) check() error {
+	if v, ok := ncuo.mutation.Name(); ok {
+		if err := notificationchannel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.name": %w`, err)}
+		}
+	}
+	if v, ok := ncuo.mutation.GetType(); ok {
+		if err := notificationchannel.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "NotificationChannel.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ncuo *NotificationChannelUpdateOne) sqlSave(ctx context.Context) (_node *NotificationChannel, err error) {
+	if err := ncuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationchannel.Table, notificationchannel.Columns, sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID))
+	id, ok := ncuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationChannel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ncuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationchannel.FieldID)
+		for _, f := range fields {
+			if !notificationchannel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationchannel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ncuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ncuo.mutation.Name(); ok {
+		_spec.SetField(notificationchannel.FieldName, field.TypeString, value)
+	}
+	if value, ok := ncuo.mutation.GetType(); ok {
+		_spec.SetField(notificationchannel.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := ncuo.mutation.Config(); ok {
+		_spec.SetField(notificationchannel.FieldConfig, field.TypeJSON, value)
+	}
+	if ncuo.mutation.ConfigCleared() {
+		_spec.ClearField(notificationchannel.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := ncuo.mutation.Enabled(); ok {
+		_spec.SetField(notificationchannel.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := ncuo.mutation.CreatedBy(); ok {
+		_spec.SetField(notificationchannel.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ncuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(notificationchannel.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := ncuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationchannel.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ncuo.mutation.DeletedBy(); ok {
+		_spec.SetField(notificationchannel.FieldDeletedBy, field.TypeString, value)
+	}
+	if ncuo.mutation.DeletedByCleared() {
+		_spec.ClearField(notificationchannel.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ncuo.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationchannel.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ncuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationchannel.FieldDeletedAt, field.TypeTime)
+	}
+	if ncuo.mutation.ProbesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ncuo.mutation.RemovedProbesIDs(); len(nodes) > 0 && !ncuo.mutation.ProbesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ncuo.mutation.ProbesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   notificationchannel.ProbesTable,
+			Columns: notificationchannel.ProbesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &NotificationChannel{config: ncuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ncuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationchannel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ncuo.mutation.done = true
+	return _node, nil
+}
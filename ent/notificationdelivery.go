@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/notificationdelivery"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// NotificationDelivery is the model entity for the NotificationDelivery schema.
+type NotificationDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the delivery.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The key of the date attribute the reminder was sent for.
+	AttributeKey string `json:"attribute_key,omitempty"`
+	// The date the reminder was sent for, in the format YYYY-MM-DD. A changed date, e.g. after a renewal, is reminded again.
+	DueOn string `json:"due_on,omitempty"`
+	// The number of days before the date of the reminder rule that triggered the reminder.
+	Days int `json:"days,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the NotificationDeliveryQuery when eager-loading is set.
+	Edges                         NotificationDeliveryEdges `json:"edges"`
+	notification_delivery_item    *uuid.UUID
+	notification_delivery_channel *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// NotificationDeliveryEdges holds the relations/edges for other nodes in the graph.
+type NotificationDeliveryEdges struct {
+	// The item the reminder was sent for.
+	Item *Item `json:"item,omitempty"`
+	// The channel the reminder was sent to.
+	Channel *NotificationChannel `json:"channel,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationDeliveryEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// ChannelOrErr returns the Channel value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e NotificationDeliveryEdges) ChannelOrErr() (*NotificationChannel, error) {
+	if e.Channel != nil {
+		return e.Channel, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: notificationchannel.Label}
+	}
+	return nil, &NotLoadedError{edge: "channel"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*NotificationDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldDays:
+			values[i] = new(sql.NullInt64)
+		case notificationdelivery.FieldAttributeKey, notificationdelivery.FieldDueOn, notificationdelivery.FieldCreatedBy, notificationdelivery.FieldUpdatedBy, notificationdelivery.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case notificationdelivery.FieldCreatedAt, notificationdelivery.FieldUpdatedAt, notificationdelivery.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case notificationdelivery.FieldID:
+			values[i] = new(uuid.UUID)
+		case notificationdelivery.ForeignKeys[0]: // notification_delivery_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case notificationdelivery.ForeignKeys[1]: // notification_delivery_channel
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the NotificationDelivery fields.
+func (nd *NotificationDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case notificationdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				nd.ID = *value
+			}
+		case notificationdelivery.FieldAttributeKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_key", values[i])
+			} else if value.Valid {
+				nd.AttributeKey = value.String
+			}
+		case notificationdelivery.FieldDueOn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field due_on", values[i])
+			} else if value.Valid {
+				nd.DueOn = value.String
+			}
+		case notificationdelivery.FieldDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value.Valid {
+				nd.Days = int(value.Int64)
+			}
+		case notificationdelivery.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				nd.CreatedBy = value.String
+			}
+		case notificationdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				nd.CreatedAt = value.Time
+			}
+		case notificationdelivery.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				nd.UpdatedBy = value.String
+			}
+		case notificationdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				nd.UpdatedAt = value.Time
+			}
+		case notificationdelivery.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				nd.DeletedBy = value.String
+			}
+		case notificationdelivery.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				nd.DeletedAt = new(time.Time)
+				*nd.DeletedAt = value.Time
+			}
+		case notificationdelivery.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_delivery_item", values[i])
+			} else if value.Valid {
+				nd.notification_delivery_item = new(uuid.UUID)
+				*nd.notification_delivery_item = *value.S.(*uuid.UUID)
+			}
+		case notificationdelivery.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field notification_delivery_channel", values[i])
+			} else if value.Valid {
+				nd.notification_delivery_channel = new(uuid.UUID)
+				*nd.notification_delivery_channel = *value.S.(*uuid.UUID)
+			}
+		default:
+			nd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the NotificationDelivery.
+// This includes values selected through modifiers, order, etc.
+func (nd *NotificationDelivery) Value(name string) (ent.Value, error) {
+	return nd.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the NotificationDelivery entity.
+func (nd *NotificationDelivery) QueryItem() *ItemQuery {
+	return NewNotificationDeliveryClient(nd.config).QueryItem(nd)
+}
+
+// QueryChannel queries the "channel" edge of the NotificationDelivery entity.
+func (nd *NotificationDelivery) QueryChannel() *NotificationChannelQuery {
+	return NewNotificationDeliveryClient(nd.config).QueryChannel(nd)
+}
+
+// Update returns a builder for updating this NotificationDelivery.
+// Note that you need to call NotificationDelivery.Unwrap() before calling this method if this NotificationDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (nd *NotificationDelivery) Update() *NotificationDeliveryUpdateOne {
+	return NewNotificationDeliveryClient(nd.config).UpdateOne(nd)
+}
+
+// Unwrap unwraps the NotificationDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (nd *NotificationDelivery) Unwrap() *NotificationDelivery {
+	_tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NotificationDelivery is not a transactional entity")
+	}
+	nd.config.driver = _tx.drv
+	return nd
+}
+
+// String implements the fmt.Stringer.
+func (nd *NotificationDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("NotificationDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", nd.ID))
+	builder.WriteString("attribute_key=")
+	builder.WriteString(nd.AttributeKey)
+	builder.WriteString(", ")
+	builder.WriteString("due_on=")
+	builder.WriteString(nd.DueOn)
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", nd.Days))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(nd.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(nd.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(nd.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(nd.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(nd.DeletedBy)
+	builder.WriteString(", ")
+	if v := nd.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NotificationDeliveries is a parsable slice of NotificationDelivery.
+type NotificationDeliveries []*NotificationDelivery
@@ -0,0 +1,426 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/notificationdelivery"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// NotificationDeliveryCreate is the builder for creating a NotificationDelivery entity.
+type NotificationDeliveryCreate struct {
+	config
+	mutation *NotificationDeliveryMutation
+	hooks    []Hook
+}
+
+// SetAttributeKey sets the "attribute_key" field.
+func (ndc *NotificationDeliveryCreate) SetAttributeKey(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetAttributeKey(s)
+	return ndc
+}
+
+// SetDueOn sets the "due_on" field.
+func (ndc *NotificationDeliveryCreate) SetDueOn(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetDueOn(s)
+	return ndc
+}
+
+// SetDays sets the "days" field.
+func (ndc *NotificationDeliveryCreate) SetDays(i int) *NotificationDeliveryCreate {
+	ndc.mutation.SetDays(i)
+	return ndc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ndc *NotificationDeliveryCreate) SetCreatedBy(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetCreatedBy(s)
+	return ndc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ndc *NotificationDeliveryCreate) SetCreatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetCreatedAt(t)
+	return ndc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableCreatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetCreatedAt(*t)
+	}
+	return ndc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ndc *NotificationDeliveryCreate) SetUpdatedBy(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetUpdatedBy(s)
+	return ndc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndc *NotificationDeliveryCreate) SetUpdatedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetUpdatedAt(t)
+	return ndc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableUpdatedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetUpdatedAt(*t)
+	}
+	return ndc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ndc *NotificationDeliveryCreate) SetDeletedBy(s string) *NotificationDeliveryCreate {
+	ndc.mutation.SetDeletedBy(s)
+	return ndc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableDeletedBy(s *string) *NotificationDeliveryCreate {
+	if s != nil {
+		ndc.SetDeletedBy(*s)
+	}
+	return ndc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ndc *NotificationDeliveryCreate) SetDeletedAt(t time.Time) *NotificationDeliveryCreate {
+	ndc.mutation.SetDeletedAt(t)
+	return ndc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableDeletedAt(t *time.Time) *NotificationDeliveryCreate {
+	if t != nil {
+		ndc.SetDeletedAt(*t)
+	}
+	return ndc
+}
+
+// SetID sets the "id" field.
+func (ndc *NotificationDeliveryCreate) SetID(u uuid.UUID) *NotificationDeliveryCreate {
+	ndc.mutation.SetID(u)
+	return ndc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (ndc *NotificationDeliveryCreate) SetNillableID(u *uuid.UUID) *NotificationDeliveryCreate {
+	if u != nil {
+		ndc.SetID(*u)
+	}
+	return ndc
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (ndc *NotificationDeliveryCreate) SetItemID(id uuid.UUID) *NotificationDeliveryCreate {
+	ndc.mutation.SetItemID(id)
+	return ndc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (ndc *NotificationDeliveryCreate) SetItem(i *Item) *NotificationDeliveryCreate {
+	return ndc.SetItemID(i.ID)
+}
+
+// SetChannelID sets the "channel" edge to the NotificationChannel entity by ID.
+func (ndc *NotificationDeliveryCreate) SetChannelID(id uuid.UUID) *NotificationDeliveryCreate {
+	ndc.mutation.SetChannelID(id)
+	return ndc
+}
+
+// SetChannel sets the "channel" edge to the NotificationChannel entity.
+func (ndc *NotificationDeliveryCreate) SetChannel(n *NotificationChannel) *NotificationDeliveryCreate {
+	return ndc.SetChannelID(n.ID)
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndc *NotificationDeliveryCreate) Mutation() *NotificationDeliveryMutation {
+	return ndc.mutation
+}
+
+// Save creates the NotificationDelivery in the database.
+func (ndc *NotificationDeliveryCreate) Save(ctx context.Context) (*NotificationDelivery, error) {
+	ndc.defaults()
+	return withHooks(ctx, ndc.sqlSave, ndc.mutation, ndc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ndc *NotificationDeliveryCreate) SaveX(ctx context.Context) *NotificationDelivery {
+	v, err := ndc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndc *NotificationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := ndc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndc *NotificationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := ndc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndc *NotificationDeliveryCreate) defaults() {
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		v := notificationdelivery.DefaultCreatedAt()
+		ndc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.DefaultUpdatedAt()
+		ndc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ndc.mutation.ID(); !ok {
+		v := notificationdelivery.DefaultID()
+		ndc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndc *NotificationDeliveryCreate) check() error {
+	if _, ok := ndc.mutation.AttributeKey(); !ok {
+		return &ValidationError{Name: "attribute_key", err: errors.New(`ent: missing required field "NotificationDelivery.attribute_key"`)}
+	}
+	if v, ok := ndc.mutation.AttributeKey(); ok {
+		if err := notificationdelivery.AttributeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "attribute_key", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.attribute_key": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.DueOn(); !ok {
+		return &ValidationError{Name: "due_on", err: errors.New(`ent: missing required field "NotificationDelivery.due_on"`)}
+	}
+	if v, ok := ndc.mutation.DueOn(); ok {
+		if err := notificationdelivery.DueOnValidator(v); err != nil {
+			return &ValidationError{Name: "due_on", err: fmt.Errorf(`ent: validator failed for field "NotificationDelivery.due_on": %w`, err)}
+		}
+	}
+	if _, ok := ndc.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "NotificationDelivery.days"`)}
+	}
+	if _, ok := ndc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "NotificationDelivery.created_by"`)}
+	}
+	if _, ok := ndc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "NotificationDelivery.created_at"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "NotificationDelivery.updated_by"`)}
+	}
+	if _, ok := ndc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "NotificationDelivery.updated_at"`)}
+	}
+	if len(ndc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "NotificationDelivery.item"`)}
+	}
+	if len(ndc.mutation.ChannelIDs()) == 0 {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required edge "NotificationDelivery.channel"`)}
+	}
+	return nil
+}
+
+func (ndc *NotificationDeliveryCreate) sqlSave(ctx context.Context) (*NotificationDelivery, error) {
+	if err := ndc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ndc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ndc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	ndc.mutation.id = &_node.ID
+	ndc.mutation.done = true
+	return _node, nil
+}
+
+func (ndc *NotificationDeliveryCreate) createSpec() (*NotificationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &NotificationDelivery{config: ndc.config}
+		_spec = sqlgraph.NewCreateSpec(notificationdelivery.Table, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	)
+	if id, ok := ndc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := ndc.mutation.AttributeKey(); ok {
+		_spec.SetField(notificationdelivery.FieldAttributeKey, field.TypeString, value)
+		_node.AttributeKey = value
+	}
+	if value, ok := ndc.mutation.DueOn(); ok {
+		_spec.SetField(notificationdelivery.FieldDueOn, field.TypeString, value)
+		_node.DueOn = value
+	}
+	if value, ok := ndc.mutation.Days(); ok {
+		_spec.SetField(notificationdelivery.FieldDays, field.TypeInt, value)
+		_node.Days = value
+	}
+	if value, ok := ndc.mutation.CreatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := ndc.mutation.CreatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ndc.mutation.UpdatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := ndc.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ndc.mutation.DeletedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := ndc.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := ndc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.ItemTable,
+			Columns: []string{notificationdelivery.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.notification_delivery_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ndc.mutation.ChannelIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   notificationdelivery.ChannelTable,
+			Columns: []string{notificationdelivery.ChannelColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.notification_delivery_channel = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationDeliveryCreateBulk is the builder for creating many NotificationDelivery entities in bulk.
+type NotificationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationDeliveryCreate
+}
+
+// Save creates the NotificationDelivery entities in the database.
+func (ndcb *NotificationDeliveryCreateBulk) Save(ctx context.Context) ([]*NotificationDelivery, error) {
+	if ndcb.err != nil {
+		return nil, ndcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ndcb.builders))
+	nodes := make([]*NotificationDelivery, len(ndcb.builders))
+	mutators := make([]Mutator, len(ndcb.builders))
+	for i := range ndcb.builders {
+		func(i int, root context.Context) {
+			builder := ndcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ndcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ndcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ndcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) SaveX(ctx context.Context) []*NotificationDelivery {
+	v, err := ndcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ndcb *NotificationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := ndcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndcb *NotificationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := ndcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
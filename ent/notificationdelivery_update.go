@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/notificationdelivery"
+	"dig-inv/ent/predicate"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// NotificationDeliveryUpdate is the builder for updating NotificationDelivery entities.
+type NotificationDeliveryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (ndu *NotificationDeliveryUpdate) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdate {
+	ndu.mutation.Where(ps...)
+	return ndu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ndu *NotificationDeliveryUpdate) SetCreatedBy(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetCreatedBy(s)
+	return ndu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableCreatedBy(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetCreatedBy(*s)
+	}
+	return ndu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ndu *NotificationDeliveryUpdate) SetUpdatedBy(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetUpdatedBy(s)
+	return ndu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableUpdatedBy(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetUpdatedBy(*s)
+	}
+	return ndu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ndu *NotificationDeliveryUpdate) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetUpdatedAt(t)
+	return ndu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ndu *NotificationDeliveryUpdate) SetDeletedBy(s string) *NotificationDeliveryUpdate {
+	ndu.mutation.SetDeletedBy(s)
+	return ndu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableDeletedBy(s *string) *NotificationDeliveryUpdate {
+	if s != nil {
+		ndu.SetDeletedBy(*s)
+	}
+	return ndu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ndu *NotificationDeliveryUpdate) ClearDeletedBy() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearDeletedBy()
+	return ndu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ndu *NotificationDeliveryUpdate) SetDeletedAt(t time.Time) *NotificationDeliveryUpdate {
+	ndu.mutation.SetDeletedAt(t)
+	return ndu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ndu *NotificationDeliveryUpdate) SetNillableDeletedAt(t *time.Time) *NotificationDeliveryUpdate {
+	if t != nil {
+		ndu.SetDeletedAt(*t)
+	}
+	return ndu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ndu *NotificationDeliveryUpdate) ClearDeletedAt() *NotificationDeliveryUpdate {
+	ndu.mutation.ClearDeletedAt()
+	return ndu
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (ndu *NotificationDeliveryUpdate) Mutation() *NotificationDeliveryMutation {
+	return ndu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ndu *NotificationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	ndu.defaults()
+	return withHooks(ctx, ndu.sqlSave, ndu.mutation, ndu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := ndu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ndu *NotificationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := ndu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndu *NotificationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := ndu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ndu *NotificationDeliveryUpdate) defaults() {
+	if _, ok := ndu.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		ndu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ndu *NotificationDeliveryUpdate) check() error {
+	if ndu.mutation.ItemCleared() && len(ndu.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.item"`)
+	}
+	if ndu.mutation.ChannelCleared() && len(ndu.mutation.ChannelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.channel"`)
+	}
+	return nil
+}
+
+func (ndu *NotificationDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ndu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	if ps := ndu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ndu.mutation.CreatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ndu.mutation.UpdatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := ndu.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ndu.mutation.DeletedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedBy, field.TypeString, value)
+	}
+	if ndu.mutation.DeletedByCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ndu.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ndu.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ndu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ndu.mutation.done = true
+	return n, nil
+}
+
+// NotificationDeliveryUpdateOne is the builder for updating a single NotificationDelivery entity.
+type NotificationDeliveryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationDeliveryMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (nduo *NotificationDeliveryUpdateOne) SetCreatedBy(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetCreatedBy(s)
+	return nduo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableCreatedBy(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetCreatedBy(*s)
+	}
+	return nduo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (nduo *NotificationDeliveryUpdateOne) SetUpdatedBy(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetUpdatedBy(s)
+	return nduo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableUpdatedBy(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetUpdatedBy(*s)
+	}
+	return nduo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetUpdatedAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetUpdatedAt(t)
+	return nduo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (nduo *NotificationDeliveryUpdateOne) SetDeletedBy(s string) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetDeletedBy(s)
+	return nduo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableDeletedBy(s *string) *NotificationDeliveryUpdateOne {
+	if s != nil {
+		nduo.SetDeletedBy(*s)
+	}
+	return nduo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearDeletedBy() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearDeletedBy()
+	return nduo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (nduo *NotificationDeliveryUpdateOne) SetDeletedAt(t time.Time) *NotificationDeliveryUpdateOne {
+	nduo.mutation.SetDeletedAt(t)
+	return nduo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (nduo *NotificationDeliveryUpdateOne) SetNillableDeletedAt(t *time.Time) *NotificationDeliveryUpdateOne {
+	if t != nil {
+		nduo.SetDeletedAt(*t)
+	}
+	return nduo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (nduo *NotificationDeliveryUpdateOne) ClearDeletedAt() *NotificationDeliveryUpdateOne {
+	nduo.mutation.ClearDeletedAt()
+	return nduo
+}
+
+// Mutation returns the NotificationDeliveryMutation object of the builder.
+func (nduo *NotificationDeliveryUpdateOne) Mutation() *NotificationDeliveryMutation {
+	return nduo.mutation
+}
+
+// Where appends a list predicates to the NotificationDeliveryUpdate builder.
+func (nduo *NotificationDeliveryUpdateOne) Where(ps ...predicate.NotificationDelivery) *NotificationDeliveryUpdateOne {
+	nduo.mutation.Where(ps...)
+	return nduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nduo *NotificationDeliveryUpdateOne) Select(field string, fields ...string) *NotificationDeliveryUpdateOne {
+	nduo.fields = append([]string{field}, fields...)
+	return nduo
+}
+
+// Save executes the query and returns the updated NotificationDelivery entity.
+func (nduo *NotificationDeliveryUpdateOne) Save(ctx context.Context) (*NotificationDelivery, error) {
+	nduo.defaults()
+	return withHooks(ctx, nduo.sqlSave, nduo.mutation, nduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) SaveX(ctx context.Context) *NotificationDelivery {
+	node, err := nduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nduo *NotificationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := nduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nduo *NotificationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := nduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nduo *NotificationDeliveryUpdateOne) defaults() {
+	if _, ok := nduo.mutation.UpdatedAt(); !ok {
+		v := notificationdelivery.UpdateDefaultUpdatedAt()
+		nduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nduo *NotificationDeliveryUpdateOne) check() error {
+	if nduo.mutation.ItemCleared() && len(nduo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.item"`)
+	}
+	if nduo.mutation.ChannelCleared() && len(nduo.mutation.ChannelIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "NotificationDelivery.channel"`)
+	}
+	return nil
+}
+
+func (nduo *NotificationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *NotificationDelivery, err error) {
+	if err := nduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notificationdelivery.Table, notificationdelivery.Columns, sqlgraph.NewFieldSpec(notificationdelivery.FieldID, field.TypeUUID))
+	id, ok := nduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "NotificationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notificationdelivery.FieldID)
+		for _, f := range fields {
+			if !notificationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notificationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nduo.mutation.CreatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := nduo.mutation.UpdatedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := nduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := nduo.mutation.DeletedBy(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedBy, field.TypeString, value)
+	}
+	if nduo.mutation.DeletedByCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := nduo.mutation.DeletedAt(); ok {
+		_spec.SetField(notificationdelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if nduo.mutation.DeletedAtCleared() {
+		_spec.ClearField(notificationdelivery.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &NotificationDelivery{config: nduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notificationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nduo.mutation.done = true
+	return _node, nil
+}
@@ -9,11 +9,74 @@ import (
 // AssetClass is the predicate function for assetclass builders.
 type AssetClass func(*sql.Selector)
 
+// Attachment is the predicate function for attachment builders.
+type Attachment func(*sql.Selector)
+
+// AttributeDefinition is the predicate function for attributedefinition builders.
+type AttributeDefinition func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Comment is the predicate function for comment builders.
+type Comment func(*sql.Selector)
+
+// CommentMention is the predicate function for commentmention builders.
+type CommentMention func(*sql.Selector)
+
+// DnsCheck is the predicate function for dnscheck builders.
+type DnsCheck func(*sql.Selector)
+
+// DomainLookup is the predicate function for domainlookup builders.
+type DomainLookup func(*sql.Selector)
+
+// DriftReport is the predicate function for driftreport builders.
+type DriftReport func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// ItemRelation is the predicate function for itemrelation builders.
+type ItemRelation func(*sql.Selector)
+
+// JobRun is the predicate function for jobrun builders.
+type JobRun func(*sql.Selector)
+
+// NotificationChannel is the predicate function for notificationchannel builders.
+type NotificationChannel func(*sql.Selector)
+
+// NotificationDelivery is the predicate function for notificationdelivery builders.
+type NotificationDelivery func(*sql.Selector)
+
+// Probe is the predicate function for probe builders.
+type Probe func(*sql.Selector)
+
+// ProbeResult is the predicate function for proberesult builders.
+type ProbeResult func(*sql.Selector)
+
+// ProviderAccount is the predicate function for provideraccount builders.
+type ProviderAccount func(*sql.Selector)
+
+// Reachability is the predicate function for reachability builders.
+type Reachability func(*sql.Selector)
+
+// ReminderRule is the predicate function for reminderrule builders.
+type ReminderRule func(*sql.Selector)
+
+// SavedView is the predicate function for savedview builders.
+type SavedView func(*sql.Selector)
+
+// Schedule is the predicate function for schedule builders.
+type Schedule func(*sql.Selector)
+
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
 // UserGroup is the predicate function for usergroup builders.
 type UserGroup func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
@@ -0,0 +1,342 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/item"
+	"dig-inv/ent/probe"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Probe is the model entity for the Probe schema.
+type Probe struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the probe.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The name of the probe, e.g. `SSH` or `Health endpoint`, which is shown in the results and notifications.
+	Name string `json:"name,omitempty"`
+	// How the server is probed: a TCP connection to the port, or a GET request to the path over HTTP or HTTPS.
+	Type probe.Type `json:"type,omitempty"`
+	// The host to probe. If empty, the hostname, fqdn or ip_address attribute of the item is used, or its name.
+	Host string `json:"host,omitempty"`
+	// The port to probe, required for TCP probes. HTTP probes default to port 80 and HTTPS probes to port 443.
+	Port int `json:"port,omitempty"`
+	// The path requested by HTTP probes, `/` if empty.
+	Path string `json:"path,omitempty"`
+	// The status code HTTP probes expect. If 0, every status below 400 counts as up.
+	ExpectedStatus int `json:"expected_status,omitempty"`
+	// The number of seconds after which an unanswered probe counts as down.
+	Timeout int `json:"timeout,omitempty"`
+	// Whether the probe is run.
+	Enabled bool `json:"enabled,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProbeQuery when eager-loading is set.
+	Edges             ProbeEdges `json:"edges"`
+	probe_item        *uuid.UUID
+	probe_asset_class *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// ProbeEdges holds the relations/edges for other nodes in the graph.
+type ProbeEdges struct {
+	// The item that is probed. Either the item or the asset class is set.
+	Item *Item `json:"item,omitempty"`
+	// The asset class whose items are probed.
+	AssetClass *AssetClass `json:"asset_class,omitempty"`
+	// The channels that are told when a probed item goes down or comes back up.
+	Channels []*NotificationChannel `json:"channels,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProbeEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// AssetClassOrErr returns the AssetClass value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProbeEdges) AssetClassOrErr() (*AssetClass, error) {
+	if e.AssetClass != nil {
+		return e.AssetClass, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: assetclass.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset_class"}
+}
+
+// ChannelsOrErr returns the Channels value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProbeEdges) ChannelsOrErr() ([]*NotificationChannel, error) {
+	if e.loadedTypes[2] {
+		return e.Channels, nil
+	}
+	return nil, &NotLoadedError{edge: "channels"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Probe) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case probe.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case probe.FieldPort, probe.FieldExpectedStatus, probe.FieldTimeout:
+			values[i] = new(sql.NullInt64)
+		case probe.FieldName, probe.FieldType, probe.FieldHost, probe.FieldPath, probe.FieldCreatedBy, probe.FieldUpdatedBy, probe.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case probe.FieldCreatedAt, probe.FieldUpdatedAt, probe.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case probe.FieldID:
+			values[i] = new(uuid.UUID)
+		case probe.ForeignKeys[0]: // probe_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case probe.ForeignKeys[1]: // probe_asset_class
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Probe fields.
+func (pr *Probe) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case probe.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case probe.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pr.Name = value.String
+			}
+		case probe.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pr.Type = probe.Type(value.String)
+			}
+		case probe.FieldHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host", values[i])
+			} else if value.Valid {
+				pr.Host = value.String
+			}
+		case probe.FieldPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field port", values[i])
+			} else if value.Valid {
+				pr.Port = int(value.Int64)
+			}
+		case probe.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				pr.Path = value.String
+			}
+		case probe.FieldExpectedStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_status", values[i])
+			} else if value.Valid {
+				pr.ExpectedStatus = int(value.Int64)
+			}
+		case probe.FieldTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout", values[i])
+			} else if value.Valid {
+				pr.Timeout = int(value.Int64)
+			}
+		case probe.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				pr.Enabled = value.Bool
+			}
+		case probe.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pr.CreatedBy = value.String
+			}
+		case probe.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case probe.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pr.UpdatedBy = value.String
+			}
+		case probe.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case probe.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				pr.DeletedBy = value.String
+			}
+		case probe.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
+		case probe.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_item", values[i])
+			} else if value.Valid {
+				pr.probe_item = new(uuid.UUID)
+				*pr.probe_item = *value.S.(*uuid.UUID)
+			}
+		case probe.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_asset_class", values[i])
+			} else if value.Valid {
+				pr.probe_asset_class = new(uuid.UUID)
+				*pr.probe_asset_class = *value.S.(*uuid.UUID)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Probe.
+// This includes values selected through modifiers, order, etc.
+func (pr *Probe) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the Probe entity.
+func (pr *Probe) QueryItem() *ItemQuery {
+	return NewProbeClient(pr.config).QueryItem(pr)
+}
+
+// QueryAssetClass queries the "asset_class" edge of the Probe entity.
+func (pr *Probe) QueryAssetClass() *AssetClassQuery {
+	return NewProbeClient(pr.config).QueryAssetClass(pr)
+}
+
+// QueryChannels queries the "channels" edge of the Probe entity.
+func (pr *Probe) QueryChannels() *NotificationChannelQuery {
+	return NewProbeClient(pr.config).QueryChannels(pr)
+}
+
+// Update returns a builder for updating this Probe.
+// Note that you need to call Probe.Unwrap() before calling this method if this Probe
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *Probe) Update() *ProbeUpdateOne {
+	return NewProbeClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the Probe entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *Probe) Unwrap() *Probe {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Probe is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *Probe) String() string {
+	var builder strings.Builder
+	builder.WriteString("Probe(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Type))
+	builder.WriteString(", ")
+	builder.WriteString("host=")
+	builder.WriteString(pr.Host)
+	builder.WriteString(", ")
+	builder.WriteString("port=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Port))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(pr.Path)
+	builder.WriteString(", ")
+	builder.WriteString("expected_status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ExpectedStatus))
+	builder.WriteString(", ")
+	builder.WriteString("timeout=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Timeout))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(pr.DeletedBy)
+	builder.WriteString(", ")
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Probes is a parsable slice of Probe.
+type Probes []*Probe
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/probe"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProbeCreate is the builder for creating a Probe entity.
+type ProbeCreate struct {
+	config
+	mutation *ProbeMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pc *ProbeCreate) SetName(s string) *ProbeCreate {
+	pc.mutation.SetName(s)
+	return pc
+}
+
+// SetType sets the "type" field.
+func (pc *ProbeCreate) SetType(pr probe.Type) *ProbeCreate {
+	pc.mutation.SetType(pr)
+	return pc
+}
+
+// SetHost sets the "host" field.
+func (pc *ProbeCreate) SetHost(s string) *ProbeCreate {
+	pc.mutation.SetHost(s)
+	return pc
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableHost(s *string) *ProbeCreate {
+	if s != nil {
+		pc.SetHost(*s)
+	}
+	return pc
+}
+
+// SetPort sets the "port" field.
+func (pc *ProbeCreate) SetPort(i int) *ProbeCreate {
+	pc.mutation.SetPort(i)
+	return pc
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillablePort(i *int) *ProbeCreate {
+	if i != nil {
+		pc.SetPort(*i)
+	}
+	return pc
+}
+
+// SetPath sets the "path" field.
+func (pc *ProbeCreate) SetPath(s string) *ProbeCreate {
+	pc.mutation.SetPath(s)
+	return pc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillablePath(s *string) *ProbeCreate {
+	if s != nil {
+		pc.SetPath(*s)
+	}
+	return pc
+}
+
+// SetExpectedStatus sets the "expected_status" field.
+func (pc *ProbeCreate) SetExpectedStatus(i int) *ProbeCreate {
+	pc.mutation.SetExpectedStatus(i)
+	return pc
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableExpectedStatus(i *int) *ProbeCreate {
+	if i != nil {
+		pc.SetExpectedStatus(*i)
+	}
+	return pc
+}
+
+// SetTimeout sets the "timeout" field.
+func (pc *ProbeCreate) SetTimeout(i int) *ProbeCreate {
+	pc.mutation.SetTimeout(i)
+	return pc
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableTimeout(i *int) *ProbeCreate {
+	if i != nil {
+		pc.SetTimeout(*i)
+	}
+	return pc
+}
+
+// SetEnabled sets the "enabled" field.
+func (pc *ProbeCreate) SetEnabled(b bool) *ProbeCreate {
+	pc.mutation.SetEnabled(b)
+	return pc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableEnabled(b *bool) *ProbeCreate {
+	if b != nil {
+		pc.SetEnabled(*b)
+	}
+	return pc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pc *ProbeCreate) SetCreatedBy(s string) *ProbeCreate {
+	pc.mutation.SetCreatedBy(s)
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *ProbeCreate) SetCreatedAt(t time.Time) *ProbeCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableCreatedAt(t *time.Time) *ProbeCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pc *ProbeCreate) SetUpdatedBy(s string) *ProbeCreate {
+	pc.mutation.SetUpdatedBy(s)
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *ProbeCreate) SetUpdatedAt(t time.Time) *ProbeCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableUpdatedAt(t *time.Time) *ProbeCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pc *ProbeCreate) SetDeletedBy(s string) *ProbeCreate {
+	pc.mutation.SetDeletedBy(s)
+	return pc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableDeletedBy(s *string) *ProbeCreate {
+	if s != nil {
+		pc.SetDeletedBy(*s)
+	}
+	return pc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pc *ProbeCreate) SetDeletedAt(t time.Time) *ProbeCreate {
+	pc.mutation.SetDeletedAt(t)
+	return pc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableDeletedAt(t *time.Time) *ProbeCreate {
+	if t != nil {
+		pc.SetDeletedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *ProbeCreate) SetID(u uuid.UUID) *ProbeCreate {
+	pc.mutation.SetID(u)
+	return pc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pc *ProbeCreate) SetNillableID(u *uuid.UUID) *ProbeCreate {
+	if u != nil {
+		pc.SetID(*u)
+	}
+	return pc
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (pc *ProbeCreate) SetItemID(id uuid.UUID) *ProbeCreate {
+	pc.mutation.SetItemID(id)
+	return pc
+}
+
+// SetNillableItemID sets the "item" edge to the Item entity by ID if the given value is not nil.
+func (pc *ProbeCreate) SetNillableItemID(id *uuid.UUID) *ProbeCreate {
+	if id != nil {
+		pc = pc.SetItemID(*id)
+	}
+	return pc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (pc *ProbeCreate) SetItem(i *Item) *ProbeCreate {
+	return pc.SetItemID(i.ID)
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (pc *ProbeCreate) SetAssetClassID(id uuid.UUID) *ProbeCreate {
+	pc.mutation.SetAssetClassID(id)
+	return pc
+}
+
+// SetNillableAssetClassID sets the "asset_class" edge to the AssetClass entity by ID if the given value is not nil.
+func (pc *ProbeCreate) SetNillableAssetClassID(id *uuid.UUID) *ProbeCreate {
+	if id != nil {
+		pc = pc.SetAssetClassID(*id)
+	}
+	return pc
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (pc *ProbeCreate) SetAssetClass(a *AssetClass) *ProbeCreate {
+	return pc.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (pc *ProbeCreate) AddChannelIDs(ids ...uuid.UUID) *ProbeCreate {
+	pc.mutation.AddChannelIDs(ids...)
+	return pc
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (pc *ProbeCreate) AddChannels(n ...*NotificationChannel) *ProbeCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pc.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ProbeMutation object of the builder.
+func (pc *ProbeCreate) Mutation() *ProbeMutation {
+	return pc.mutation
+}
+
+// Save creates the Probe in the database.
+func (pc *ProbeCreate) Save(ctx context.Context) (*Probe, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *ProbeCreate) SaveX(ctx context.Context) *Probe {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *ProbeCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *ProbeCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *ProbeCreate) defaults() {
+	if _, ok := pc.mutation.Port(); !ok {
+		v := probe.DefaultPort
+		pc.mutation.SetPort(v)
+	}
+	if _, ok := pc.mutation.ExpectedStatus(); !ok {
+		v := probe.DefaultExpectedStatus
+		pc.mutation.SetExpectedStatus(v)
+	}
+	if _, ok := pc.mutation.Timeout(); !ok {
+		v := probe.DefaultTimeout
+		pc.mutation.SetTimeout(v)
+	}
+	if _, ok := pc.mutation.Enabled(); !ok {
+		v := probe.DefaultEnabled
+		pc.mutation.SetEnabled(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := probe.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := probe.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pc.mutation.ID(); !ok {
+		v := probe.DefaultID()
+		pc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *ProbeCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Probe.name"`)}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := probe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Probe.name": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Probe.type"`)}
+	}
+	if v, ok := pc.mutation.GetType(); ok {
+		if err := probe.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Probe.type": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Port(); !ok {
+		return &ValidationError{Name: "port", err: errors.New(`ent: missing required field "Probe.port"`)}
+	}
+	if v, ok := pc.mutation.Port(); ok {
+		if err := probe.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "Probe.port": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.ExpectedStatus(); !ok {
+		return &ValidationError{Name: "expected_status", err: errors.New(`ent: missing required field "Probe.expected_status"`)}
+	}
+	if v, ok := pc.mutation.ExpectedStatus(); ok {
+		if err := probe.ExpectedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "expected_status", err: fmt.Errorf(`ent: validator failed for field "Probe.expected_status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Timeout(); !ok {
+		return &ValidationError{Name: "timeout", err: errors.New(`ent: missing required field "Probe.timeout"`)}
+	}
+	if v, ok := pc.mutation.Timeout(); ok {
+		if err := probe.TimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "timeout", err: fmt.Errorf(`ent: validator failed for field "Probe.timeout": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Probe.enabled"`)}
+	}
+	if _, ok := pc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Probe.created_by"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Probe.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "Probe.updated_by"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Probe.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *ProbeCreate) sqlSave(ctx context.Context) (*Probe, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *ProbeCreate) createSpec() (*Probe, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Probe{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(probe.Table, sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID))
+	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.SetField(probe.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pc.mutation.GetType(); ok {
+		_spec.SetField(probe.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := pc.mutation.Host(); ok {
+		_spec.SetField(probe.FieldHost, field.TypeString, value)
+		_node.Host = value
+	}
+	if value, ok := pc.mutation.Port(); ok {
+		_spec.SetField(probe.FieldPort, field.TypeInt, value)
+		_node.Port = value
+	}
+	if value, ok := pc.mutation.Path(); ok {
+		_spec.SetField(probe.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := pc.mutation.ExpectedStatus(); ok {
+		_spec.SetField(probe.FieldExpectedStatus, field.TypeInt, value)
+		_node.ExpectedStatus = value
+	}
+	if value, ok := pc.mutation.Timeout(); ok {
+		_spec.SetField(probe.FieldTimeout, field.TypeInt, value)
+		_node.Timeout = value
+	}
+	if value, ok := pc.mutation.Enabled(); ok {
+		_spec.SetField(probe.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := pc.mutation.CreatedBy(); ok {
+		_spec.SetField(probe.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(probe.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedBy(); ok {
+		_spec.SetField(probe.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(probe.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pc.mutation.DeletedBy(); ok {
+		_spec.SetField(probe.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := pc.mutation.DeletedAt(); ok {
+		_spec.SetField(probe.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := pc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.ItemTable,
+			Columns: []string{probe.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.probe_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.AssetClassTable,
+			Columns: []string{probe.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.probe_asset_class = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProbeCreateBulk is the builder for creating many Probe entities in bulk.
+type ProbeCreateBulk struct {
+	config
+	err      error
+	builders []*ProbeCreate
+}
+
+// Save creates the Probe entities in the database.
+func (pcb *ProbeCreateBulk) Save(ctx context.Context) ([]*Probe, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Probe, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProbeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *ProbeCreateBulk) SaveX(ctx context.Context) []*Probe {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *ProbeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *ProbeCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
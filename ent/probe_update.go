@@ -0,0 +1,1200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/probe"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProbeUpdate is the builder for updating Probe entities.
+type ProbeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProbeMutation
+}
+
+// Where appends a list predicates to the ProbeUpdate builder.
+func (pu *ProbeUpdate) Where(ps ...predicate.Probe) *ProbeUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *ProbeUpdate) SetName(s string) *ProbeUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableName(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetType sets the "type" field.
+func (pu *ProbeUpdate) SetType(pr probe.Type) *ProbeUpdate {
+	pu.mutation.SetType(pr)
+	return pu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableType(pr *probe.Type) *ProbeUpdate {
+	if pr != nil {
+		pu.SetType(*pr)
+	}
+	return pu
+}
+
+// SetHost sets the "host" field.
+func (pu *ProbeUpdate) SetHost(s string) *ProbeUpdate {
+	pu.mutation.SetHost(s)
+	return pu
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableHost(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetHost(*s)
+	}
+	return pu
+}
+
+// ClearHost clears the value of the "host" field.
+func (pu *ProbeUpdate) ClearHost() *ProbeUpdate {
+	pu.mutation.ClearHost()
+	return pu
+}
+
+// SetPort sets the "port" field.
+func (pu *ProbeUpdate) SetPort(i int) *ProbeUpdate {
+	pu.mutation.ResetPort()
+	pu.mutation.SetPort(i)
+	return pu
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillablePort(i *int) *ProbeUpdate {
+	if i != nil {
+		pu.SetPort(*i)
+	}
+	return pu
+}
+
+// AddPort adds i to the "port" field.
+func (pu *ProbeUpdate) AddPort(i int) *ProbeUpdate {
+	pu.mutation.AddPort(i)
+	return pu
+}
+
+// SetPath sets the "path" field.
+func (pu *ProbeUpdate) SetPath(s string) *ProbeUpdate {
+	pu.mutation.SetPath(s)
+	return pu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillablePath(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetPath(*s)
+	}
+	return pu
+}
+
+// ClearPath clears the value of the "path" field.
+func (pu *ProbeUpdate) ClearPath() *ProbeUpdate {
+	pu.mutation.ClearPath()
+	return pu
+}
+
+// SetExpectedStatus sets the "expected_status" field.
+func (pu *ProbeUpdate) SetExpectedStatus(i int) *ProbeUpdate {
+	pu.mutation.ResetExpectedStatus()
+	pu.mutation.SetExpectedStatus(i)
+	return pu
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableExpectedStatus(i *int) *ProbeUpdate {
+	if i != nil {
+		pu.SetExpectedStatus(*i)
+	}
+	return pu
+}
+
+// AddExpectedStatus adds i to the "expected_status" field.
+func (pu *ProbeUpdate) AddExpectedStatus(i int) *ProbeUpdate {
+	pu.mutation.AddExpectedStatus(i)
+	return pu
+}
+
+// SetTimeout sets the "timeout" field.
+func (pu *ProbeUpdate) SetTimeout(i int) *ProbeUpdate {
+	pu.mutation.ResetTimeout()
+	pu.mutation.SetTimeout(i)
+	return pu
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableTimeout(i *int) *ProbeUpdate {
+	if i != nil {
+		pu.SetTimeout(*i)
+	}
+	return pu
+}
+
+// AddTimeout adds i to the "timeout" field.
+func (pu *ProbeUpdate) AddTimeout(i int) *ProbeUpdate {
+	pu.mutation.AddTimeout(i)
+	return pu
+}
+
+// SetEnabled sets the "enabled" field.
+func (pu *ProbeUpdate) SetEnabled(b bool) *ProbeUpdate {
+	pu.mutation.SetEnabled(b)
+	return pu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableEnabled(b *bool) *ProbeUpdate {
+	if b != nil {
+		pu.SetEnabled(*b)
+	}
+	return pu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pu *ProbeUpdate) SetCreatedBy(s string) *ProbeUpdate {
+	pu.mutation.SetCreatedBy(s)
+	return pu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableCreatedBy(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetCreatedBy(*s)
+	}
+	return pu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pu *ProbeUpdate) SetUpdatedBy(s string) *ProbeUpdate {
+	pu.mutation.SetUpdatedBy(s)
+	return pu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableUpdatedBy(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetUpdatedBy(*s)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *ProbeUpdate) SetUpdatedAt(t time.Time) *ProbeUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pu *ProbeUpdate) SetDeletedBy(s string) *ProbeUpdate {
+	pu.mutation.SetDeletedBy(s)
+	return pu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableDeletedBy(s *string) *ProbeUpdate {
+	if s != nil {
+		pu.SetDeletedBy(*s)
+	}
+	return pu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (pu *ProbeUpdate) ClearDeletedBy() *ProbeUpdate {
+	pu.mutation.ClearDeletedBy()
+	return pu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pu *ProbeUpdate) SetDeletedAt(t time.Time) *ProbeUpdate {
+	pu.mutation.SetDeletedAt(t)
+	return pu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableDeletedAt(t *time.Time) *ProbeUpdate {
+	if t != nil {
+		pu.SetDeletedAt(*t)
+	}
+	return pu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pu *ProbeUpdate) ClearDeletedAt() *ProbeUpdate {
+	pu.mutation.ClearDeletedAt()
+	return pu
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (pu *ProbeUpdate) SetItemID(id uuid.UUID) *ProbeUpdate {
+	pu.mutation.SetItemID(id)
+	return pu
+}
+
+// SetNillableItemID sets the "item" edge to the Item entity by ID if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableItemID(id *uuid.UUID) *ProbeUpdate {
+	if id != nil {
+		pu = pu.SetItemID(*id)
+	}
+	return pu
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (pu *ProbeUpdate) SetItem(i *Item) *ProbeUpdate {
+	return pu.SetItemID(i.ID)
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (pu *ProbeUpdate) SetAssetClassID(id uuid.UUID) *ProbeUpdate {
+	pu.mutation.SetAssetClassID(id)
+	return pu
+}
+
+// SetNillableAssetClassID sets the "asset_class" edge to the AssetClass entity by ID if the given value is not nil.
+func (pu *ProbeUpdate) SetNillableAssetClassID(id *uuid.UUID) *ProbeUpdate {
+	if id != nil {
+		pu = pu.SetAssetClassID(*id)
+	}
+	return pu
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (pu *ProbeUpdate) SetAssetClass(a *AssetClass) *ProbeUpdate {
+	return pu.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (pu *ProbeUpdate) AddChannelIDs(ids ...uuid.UUID) *ProbeUpdate {
+	pu.mutation.AddChannelIDs(ids...)
+	return pu
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (pu *ProbeUpdate) AddChannels(n ...*NotificationChannel) *ProbeUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pu.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ProbeMutation object of the builder.
+func (pu *ProbeUpdate) Mutation() *ProbeMutation {
+	return pu.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (pu *ProbeUpdate) ClearItem() *ProbeUpdate {
+	pu.mutation.ClearItem()
+	return pu
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (pu *ProbeUpdate) ClearAssetClass() *ProbeUpdate {
+	pu.mutation.ClearAssetClass()
+	return pu
+}
+
+// ClearChannels clears all "channels" edges to the NotificationChannel entity.
+func (pu *ProbeUpdate) ClearChannels() *ProbeUpdate {
+	pu.mutation.ClearChannels()
+	return pu
+}
+
+// RemoveChannelIDs removes the "channels" edge to NotificationChannel entities by IDs.
+func (pu *ProbeUpdate) RemoveChannelIDs(ids ...uuid.UUID) *ProbeUpdate {
+	pu.mutation.RemoveChannelIDs(ids...)
+	return pu
+}
+
+// RemoveChannels removes "channels" edges to NotificationChannel entities.
+func (pu *ProbeUpdate) RemoveChannels(n ...*NotificationChannel) *ProbeUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return pu.RemoveChannelIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *ProbeUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *ProbeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *ProbeUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *ProbeUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *ProbeUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := probe.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *ProbeUpdate) check() error {
+	if v, ok := pu.mutation.Name(); ok {
+		if err := probe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Probe.name": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.GetType(); ok {
+		if err := probe.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Probe.type": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Port(); ok {
+		if err := probe.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "Probe.port": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.ExpectedStatus(); ok {
+		if err := probe.ExpectedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "expected_status", err: fmt.Errorf(`ent: validator failed for field "Probe.expected_status": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Timeout(); ok {
+		if err := probe.TimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "timeout", err: fmt.Errorf(`ent: validator failed for field "Probe.timeout": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *ProbeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(probe.Table, probe.Columns, sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(probe.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.GetType(); ok {
+		_spec.SetField(probe.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := pu.mutation.Host(); ok {
+		_spec.SetField(probe.FieldHost, field.TypeString, value)
+	}
+	if pu.mutation.HostCleared() {
+		_spec.ClearField(probe.FieldHost, field.TypeString)
+	}
+	if value, ok := pu.mutation.Port(); ok {
+		_spec.SetField(probe.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedPort(); ok {
+		_spec.AddField(probe.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Path(); ok {
+		_spec.SetField(probe.FieldPath, field.TypeString, value)
+	}
+	if pu.mutation.PathCleared() {
+		_spec.ClearField(probe.FieldPath, field.TypeString)
+	}
+	if value, ok := pu.mutation.ExpectedStatus(); ok {
+		_spec.SetField(probe.FieldExpectedStatus, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedExpectedStatus(); ok {
+		_spec.AddField(probe.FieldExpectedStatus, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Timeout(); ok {
+		_spec.SetField(probe.FieldTimeout, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedTimeout(); ok {
+		_spec.AddField(probe.FieldTimeout, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Enabled(); ok {
+		_spec.SetField(probe.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.CreatedBy(); ok {
+		_spec.SetField(probe.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.UpdatedBy(); ok {
+		_spec.SetField(probe.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(probe.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.DeletedBy(); ok {
+		_spec.SetField(probe.FieldDeletedBy, field.TypeString, value)
+	}
+	if pu.mutation.DeletedByCleared() {
+		_spec.ClearField(probe.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := pu.mutation.DeletedAt(); ok {
+		_spec.SetField(probe.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pu.mutation.DeletedAtCleared() {
+		_spec.ClearField(probe.FieldDeletedAt, field.TypeTime)
+	}
+	if pu.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.ItemTable,
+			Columns: []string{probe.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.ItemTable,
+			Columns: []string{probe.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.AssetClassTable,
+			Columns: []string{probe.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.AssetClassTable,
+			Columns: []string{probe.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !pu.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{probe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// ProbeUpdateOne is the builder for updating a single Probe entity.
+type ProbeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProbeMutation
+}
+
+// SetName sets the "name" field.
+func (puo *ProbeUpdateOne) SetName(s string) *ProbeUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableName(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetType sets the "type" field.
+func (puo *ProbeUpdateOne) SetType(pr probe.Type) *ProbeUpdateOne {
+	puo.mutation.SetType(pr)
+	return puo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableType(pr *probe.Type) *ProbeUpdateOne {
+	if pr != nil {
+		puo.SetType(*pr)
+	}
+	return puo
+}
+
+// SetHost sets the "host" field.
+func (puo *ProbeUpdateOne) SetHost(s string) *ProbeUpdateOne {
+	puo.mutation.SetHost(s)
+	return puo
+}
+
+// SetNillableHost sets the "host" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableHost(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetHost(*s)
+	}
+	return puo
+}
+
+// ClearHost clears the value of the "host" field.
+func (puo *ProbeUpdateOne) ClearHost() *ProbeUpdateOne {
+	puo.mutation.ClearHost()
+	return puo
+}
+
+// SetPort sets the "port" field.
+func (puo *ProbeUpdateOne) SetPort(i int) *ProbeUpdateOne {
+	puo.mutation.ResetPort()
+	puo.mutation.SetPort(i)
+	return puo
+}
+
+// SetNillablePort sets the "port" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillablePort(i *int) *ProbeUpdateOne {
+	if i != nil {
+		puo.SetPort(*i)
+	}
+	return puo
+}
+
+// AddPort adds i to the "port" field.
+func (puo *ProbeUpdateOne) AddPort(i int) *ProbeUpdateOne {
+	puo.mutation.AddPort(i)
+	return puo
+}
+
+// SetPath sets the "path" field.
+func (puo *ProbeUpdateOne) SetPath(s string) *ProbeUpdateOne {
+	puo.mutation.SetPath(s)
+	return puo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillablePath(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetPath(*s)
+	}
+	return puo
+}
+
+// ClearPath clears the value of the "path" field.
+func (puo *ProbeUpdateOne) ClearPath() *ProbeUpdateOne {
+	puo.mutation.ClearPath()
+	return puo
+}
+
+// SetExpectedStatus sets the "expected_status" field.
+func (puo *ProbeUpdateOne) SetExpectedStatus(i int) *ProbeUpdateOne {
+	puo.mutation.ResetExpectedStatus()
+	puo.mutation.SetExpectedStatus(i)
+	return puo
+}
+
+// SetNillableExpectedStatus sets the "expected_status" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableExpectedStatus(i *int) *ProbeUpdateOne {
+	if i != nil {
+		puo.SetExpectedStatus(*i)
+	}
+	return puo
+}
+
+// AddExpectedStatus adds i to the "expected_status" field.
+func (puo *ProbeUpdateOne) AddExpectedStatus(i int) *ProbeUpdateOne {
+	puo.mutation.AddExpectedStatus(i)
+	return puo
+}
+
+// SetTimeout sets the "timeout" field.
+func (puo *ProbeUpdateOne) SetTimeout(i int) *ProbeUpdateOne {
+	puo.mutation.ResetTimeout()
+	puo.mutation.SetTimeout(i)
+	return puo
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableTimeout(i *int) *ProbeUpdateOne {
+	if i != nil {
+		puo.SetTimeout(*i)
+	}
+	return puo
+}
+
+// AddTimeout adds i to the "timeout" field.
+func (puo *ProbeUpdateOne) AddTimeout(i int) *ProbeUpdateOne {
+	puo.mutation.AddTimeout(i)
+	return puo
+}
+
+// SetEnabled sets the "enabled" field.
+func (puo *ProbeUpdateOne) SetEnabled(b bool) *ProbeUpdateOne {
+	puo.mutation.SetEnabled(b)
+	return puo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableEnabled(b *bool) *ProbeUpdateOne {
+	if b != nil {
+		puo.SetEnabled(*b)
+	}
+	return puo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (puo *ProbeUpdateOne) SetCreatedBy(s string) *ProbeUpdateOne {
+	puo.mutation.SetCreatedBy(s)
+	return puo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableCreatedBy(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetCreatedBy(*s)
+	}
+	return puo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (puo *ProbeUpdateOne) SetUpdatedBy(s string) *ProbeUpdateOne {
+	puo.mutation.SetUpdatedBy(s)
+	return puo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableUpdatedBy(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetUpdatedBy(*s)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *ProbeUpdateOne) SetUpdatedAt(t time.Time) *ProbeUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (puo *ProbeUpdateOne) SetDeletedBy(s string) *ProbeUpdateOne {
+	puo.mutation.SetDeletedBy(s)
+	return puo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableDeletedBy(s *string) *ProbeUpdateOne {
+	if s != nil {
+		puo.SetDeletedBy(*s)
+	}
+	return puo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (puo *ProbeUpdateOne) ClearDeletedBy() *ProbeUpdateOne {
+	puo.mutation.ClearDeletedBy()
+	return puo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (puo *ProbeUpdateOne) SetDeletedAt(t time.Time) *ProbeUpdateOne {
+	puo.mutation.SetDeletedAt(t)
+	return puo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableDeletedAt(t *time.Time) *ProbeUpdateOne {
+	if t != nil {
+		puo.SetDeletedAt(*t)
+	}
+	return puo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (puo *ProbeUpdateOne) ClearDeletedAt() *ProbeUpdateOne {
+	puo.mutation.ClearDeletedAt()
+	return puo
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (puo *ProbeUpdateOne) SetItemID(id uuid.UUID) *ProbeUpdateOne {
+	puo.mutation.SetItemID(id)
+	return puo
+}
+
+// SetNillableItemID sets the "item" edge to the Item entity by ID if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableItemID(id *uuid.UUID) *ProbeUpdateOne {
+	if id != nil {
+		puo = puo.SetItemID(*id)
+	}
+	return puo
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (puo *ProbeUpdateOne) SetItem(i *Item) *ProbeUpdateOne {
+	return puo.SetItemID(i.ID)
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (puo *ProbeUpdateOne) SetAssetClassID(id uuid.UUID) *ProbeUpdateOne {
+	puo.mutation.SetAssetClassID(id)
+	return puo
+}
+
+// SetNillableAssetClassID sets the "asset_class" edge to the AssetClass entity by ID if the given value is not nil.
+func (puo *ProbeUpdateOne) SetNillableAssetClassID(id *uuid.UUID) *ProbeUpdateOne {
+	if id != nil {
+		puo = puo.SetAssetClassID(*id)
+	}
+	return puo
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (puo *ProbeUpdateOne) SetAssetClass(a *AssetClass) *ProbeUpdateOne {
+	return puo.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (puo *ProbeUpdateOne) AddChannelIDs(ids ...uuid.UUID) *ProbeUpdateOne {
+	puo.mutation.AddChannelIDs(ids...)
+	return puo
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (puo *ProbeUpdateOne) AddChannels(n ...*NotificationChannel) *ProbeUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return puo.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ProbeMutation object of the builder.
+func (puo *ProbeUpdateOne) Mutation() *ProbeMutation {
+	return puo.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (puo *ProbeUpdateOne) ClearItem() *ProbeUpdateOne {
+	puo.mutation.ClearItem()
+	return puo
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (puo *ProbeUpdateOne) ClearAssetClass() *ProbeUpdateOne {
+	puo.mutation.ClearAssetClass()
+	return puo
+}
+
+// ClearChannels clears all "channels" edges to the NotificationChannel entity.
+func (puo *ProbeUpdateOne) ClearChannels() *ProbeUpdateOne {
+	puo.mutation.ClearChannels()
+	return puo
+}
+
+// RemoveChannelIDs removes the "channels" edge to NotificationChannel entities by IDs.
+func (puo *ProbeUpdateOne) RemoveChannelIDs(ids ...uuid.UUID) *ProbeUpdateOne {
+	puo.mutation.RemoveChannelIDs(ids...)
+	return puo
+}
+
+// RemoveChannels removes "channels" edges to NotificationChannel entities.
+func (puo *ProbeUpdateOne) RemoveChannels(n ...*NotificationChannel) *ProbeUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return puo.RemoveChannelIDs(ids...)
+}
+
+// Where appends a list predicates to the ProbeUpdate builder.
+func (puo *ProbeUpdateOne) Where(ps ...predicate.Probe) *ProbeUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *ProbeUpdateOne) Select(field string, fields ...string) *ProbeUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Probe entity.
+func (puo *ProbeUpdateOne) Save(ctx context.Context) (*Probe, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *ProbeUpdateOne) SaveX(ctx context.Context) *Probe {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *ProbeUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *ProbeUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *ProbeUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := probe.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *ProbeUpdateOne) check() error {
+	if v, ok := puo.mutation.Name(); ok {
+		if err := probe.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Probe.name": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.GetType(); ok {
+		if err := probe.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Probe.type": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Port(); ok {
+		if err := probe.PortValidator(v); err != nil {
+			return &ValidationError{Name: "port", err: fmt.Errorf(`ent: validator failed for field "Probe.port": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.ExpectedStatus(); ok {
+		if err := probe.ExpectedStatusValidator(v); err != nil {
+			return &ValidationError{Name: "expected_status", err: fmt.Errorf(`ent: validator failed for field "Probe.expected_status": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Timeout(); ok {
+		if err := probe.TimeoutValidator(v); err != nil {
+			return &ValidationError{Name: "timeout", err: fmt.Errorf(`ent: validator failed for field "Probe.timeout": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *ProbeUpdateOne) sqlSave(ctx context.Context) (_node *Probe, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(probe.Table, probe.Columns, sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Probe.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, probe.FieldID)
+		for _, f := range fields {
+			if !probe.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != probe.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(probe.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.GetType(); ok {
+		_spec.SetField(probe.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.Host(); ok {
+		_spec.SetField(probe.FieldHost, field.TypeString, value)
+	}
+	if puo.mutation.HostCleared() {
+		_spec.ClearField(probe.FieldHost, field.TypeString)
+	}
+	if value, ok := puo.mutation.Port(); ok {
+		_spec.SetField(probe.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedPort(); ok {
+		_spec.AddField(probe.FieldPort, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Path(); ok {
+		_spec.SetField(probe.FieldPath, field.TypeString, value)
+	}
+	if puo.mutation.PathCleared() {
+		_spec.ClearField(probe.FieldPath, field.TypeString)
+	}
+	if value, ok := puo.mutation.ExpectedStatus(); ok {
+		_spec.SetField(probe.FieldExpectedStatus, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedExpectedStatus(); ok {
+		_spec.AddField(probe.FieldExpectedStatus, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Timeout(); ok {
+		_spec.SetField(probe.FieldTimeout, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedTimeout(); ok {
+		_spec.AddField(probe.FieldTimeout, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Enabled(); ok {
+		_spec.SetField(probe.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.CreatedBy(); ok {
+		_spec.SetField(probe.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.UpdatedBy(); ok {
+		_spec.SetField(probe.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(probe.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.DeletedBy(); ok {
+		_spec.SetField(probe.FieldDeletedBy, field.TypeString, value)
+	}
+	if puo.mutation.DeletedByCleared() {
+		_spec.ClearField(probe.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := puo.mutation.DeletedAt(); ok {
+		_spec.SetField(probe.FieldDeletedAt, field.TypeTime, value)
+	}
+	if puo.mutation.DeletedAtCleared() {
+		_spec.ClearField(probe.FieldDeletedAt, field.TypeTime)
+	}
+	if puo.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.ItemTable,
+			Columns: []string{probe.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.ItemTable,
+			Columns: []string{probe.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.AssetClassTable,
+			Columns: []string{probe.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   probe.AssetClassTable,
+			Columns: []string{probe.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !puo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   probe.ChannelsTable,
+			Columns: probe.ChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Probe{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{probe.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/item"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProbeResult is the model entity for the ProbeResult schema.
+type ProbeResult struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the result.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The host:port or URL that was probed.
+	Target string `json:"target,omitempty"`
+	// Whether the target answered as expected within the timeout of the probe.
+	Status proberesult.Status `json:"status,omitempty"`
+	// The number of milliseconds until the connection was established or the response headers were received.
+	LatencyMs float64 `json:"latency_ms,omitempty"`
+	// The status code of the response to HTTP probes.
+	StatusCode int `json:"status_code,omitempty"`
+	// Why the target was down.
+	Error string `json:"error,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProbeResultQuery when eager-loading is set.
+	Edges              ProbeResultEdges `json:"edges"`
+	probe_result_probe *uuid.UUID
+	probe_result_item  *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// ProbeResultEdges holds the relations/edges for other nodes in the graph.
+type ProbeResultEdges struct {
+	// The probe that was run.
+	Probe *Probe `json:"probe,omitempty"`
+	// The item that was probed.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ProbeOrErr returns the Probe value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProbeResultEdges) ProbeOrErr() (*Probe, error) {
+	if e.Probe != nil {
+		return e.Probe, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: probe.Label}
+	}
+	return nil, &NotLoadedError{edge: "probe"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProbeResultEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProbeResult) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case proberesult.FieldLatencyMs:
+			values[i] = new(sql.NullFloat64)
+		case proberesult.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case proberesult.FieldTarget, proberesult.FieldStatus, proberesult.FieldError, proberesult.FieldCreatedBy, proberesult.FieldUpdatedBy, proberesult.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case proberesult.FieldCreatedAt, proberesult.FieldUpdatedAt, proberesult.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case proberesult.FieldID:
+			values[i] = new(uuid.UUID)
+		case proberesult.ForeignKeys[0]: // probe_result_probe
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case proberesult.ForeignKeys[1]: // probe_result_item
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProbeResult fields.
+func (pr *ProbeResult) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case proberesult.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pr.ID = *value
+			}
+		case proberesult.FieldTarget:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				pr.Target = value.String
+			}
+		case proberesult.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pr.Status = proberesult.Status(value.String)
+			}
+		case proberesult.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				pr.LatencyMs = value.Float64
+			}
+		case proberesult.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				pr.StatusCode = int(value.Int64)
+			}
+		case proberesult.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				pr.Error = value.String
+			}
+		case proberesult.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pr.CreatedBy = value.String
+			}
+		case proberesult.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		case proberesult.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pr.UpdatedBy = value.String
+			}
+		case proberesult.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pr.UpdatedAt = value.Time
+			}
+		case proberesult.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				pr.DeletedBy = value.String
+			}
+		case proberesult.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pr.DeletedAt = new(time.Time)
+				*pr.DeletedAt = value.Time
+			}
+		case proberesult.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_result_probe", values[i])
+			} else if value.Valid {
+				pr.probe_result_probe = new(uuid.UUID)
+				*pr.probe_result_probe = *value.S.(*uuid.UUID)
+			}
+		case proberesult.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field probe_result_item", values[i])
+			} else if value.Valid {
+				pr.probe_result_item = new(uuid.UUID)
+				*pr.probe_result_item = *value.S.(*uuid.UUID)
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProbeResult.
+// This includes values selected through modifiers, order, etc.
+func (pr *ProbeResult) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// QueryProbe queries the "probe" edge of the ProbeResult entity.
+func (pr *ProbeResult) QueryProbe() *ProbeQuery {
+	return NewProbeResultClient(pr.config).QueryProbe(pr)
+}
+
+// QueryItem queries the "item" edge of the ProbeResult entity.
+func (pr *ProbeResult) QueryItem() *ItemQuery {
+	return NewProbeResultClient(pr.config).QueryItem(pr)
+}
+
+// Update returns a builder for updating this ProbeResult.
+// Note that you need to call ProbeResult.Unwrap() before calling this method if this ProbeResult
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *ProbeResult) Update() *ProbeResultUpdateOne {
+	return NewProbeResultClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the ProbeResult entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *ProbeResult) Unwrap() *ProbeResult {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProbeResult is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *ProbeResult) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProbeResult(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("target=")
+	builder.WriteString(pr.Target)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", pr.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("status_code=")
+	builder.WriteString(fmt.Sprintf("%v", pr.StatusCode))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(pr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(pr.DeletedBy)
+	builder.WriteString(", ")
+	if v := pr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProbeResults is a parsable slice of ProbeResult.
+type ProbeResults []*ProbeResult
@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProbeResultCreate is the builder for creating a ProbeResult entity.
+type ProbeResultCreate struct {
+	config
+	mutation *ProbeResultMutation
+	hooks    []Hook
+}
+
+// SetTarget sets the "target" field.
+func (prc *ProbeResultCreate) SetTarget(s string) *ProbeResultCreate {
+	prc.mutation.SetTarget(s)
+	return prc
+}
+
+// SetStatus sets the "status" field.
+func (prc *ProbeResultCreate) SetStatus(pr proberesult.Status) *ProbeResultCreate {
+	prc.mutation.SetStatus(pr)
+	return prc
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (prc *ProbeResultCreate) SetLatencyMs(f float64) *ProbeResultCreate {
+	prc.mutation.SetLatencyMs(f)
+	return prc
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableLatencyMs(f *float64) *ProbeResultCreate {
+	if f != nil {
+		prc.SetLatencyMs(*f)
+	}
+	return prc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (prc *ProbeResultCreate) SetStatusCode(i int) *ProbeResultCreate {
+	prc.mutation.SetStatusCode(i)
+	return prc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableStatusCode(i *int) *ProbeResultCreate {
+	if i != nil {
+		prc.SetStatusCode(*i)
+	}
+	return prc
+}
+
+// SetError sets the "error" field.
+func (prc *ProbeResultCreate) SetError(s string) *ProbeResultCreate {
+	prc.mutation.SetError(s)
+	return prc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableError(s *string) *ProbeResultCreate {
+	if s != nil {
+		prc.SetError(*s)
+	}
+	return prc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (prc *ProbeResultCreate) SetCreatedBy(s string) *ProbeResultCreate {
+	prc.mutation.SetCreatedBy(s)
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *ProbeResultCreate) SetCreatedAt(t time.Time) *ProbeResultCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableCreatedAt(t *time.Time) *ProbeResultCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (prc *ProbeResultCreate) SetUpdatedBy(s string) *ProbeResultCreate {
+	prc.mutation.SetUpdatedBy(s)
+	return prc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (prc *ProbeResultCreate) SetUpdatedAt(t time.Time) *ProbeResultCreate {
+	prc.mutation.SetUpdatedAt(t)
+	return prc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableUpdatedAt(t *time.Time) *ProbeResultCreate {
+	if t != nil {
+		prc.SetUpdatedAt(*t)
+	}
+	return prc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (prc *ProbeResultCreate) SetDeletedBy(s string) *ProbeResultCreate {
+	prc.mutation.SetDeletedBy(s)
+	return prc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableDeletedBy(s *string) *ProbeResultCreate {
+	if s != nil {
+		prc.SetDeletedBy(*s)
+	}
+	return prc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (prc *ProbeResultCreate) SetDeletedAt(t time.Time) *ProbeResultCreate {
+	prc.mutation.SetDeletedAt(t)
+	return prc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableDeletedAt(t *time.Time) *ProbeResultCreate {
+	if t != nil {
+		prc.SetDeletedAt(*t)
+	}
+	return prc
+}
+
+// SetID sets the "id" field.
+func (prc *ProbeResultCreate) SetID(u uuid.UUID) *ProbeResultCreate {
+	prc.mutation.SetID(u)
+	return prc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (prc *ProbeResultCreate) SetNillableID(u *uuid.UUID) *ProbeResultCreate {
+	if u != nil {
+		prc.SetID(*u)
+	}
+	return prc
+}
+
+// SetProbeID sets the "probe" edge to the Probe entity by ID.
+func (prc *ProbeResultCreate) SetProbeID(id uuid.UUID) *ProbeResultCreate {
+	prc.mutation.SetProbeID(id)
+	return prc
+}
+
+// SetProbe sets the "probe" edge to the Probe entity.
+func (prc *ProbeResultCreate) SetProbe(p *Probe) *ProbeResultCreate {
+	return prc.SetProbeID(p.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (prc *ProbeResultCreate) SetItemID(id uuid.UUID) *ProbeResultCreate {
+	prc.mutation.SetItemID(id)
+	return prc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (prc *ProbeResultCreate) SetItem(i *Item) *ProbeResultCreate {
+	return prc.SetItemID(i.ID)
+}
+
+// Mutation returns the ProbeResultMutation object of the builder.
+func (prc *ProbeResultCreate) Mutation() *ProbeResultMutation {
+	return prc.mutation
+}
+
+// Save creates the ProbeResult in the database.
+func (prc *ProbeResultCreate) Save(ctx context.Context) (*ProbeResult, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *ProbeResultCreate) SaveX(ctx context.Context) *ProbeResult {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *ProbeResultCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *ProbeResultCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *ProbeResultCreate) defaults() {
+	if _, ok := prc.mutation.LatencyMs(); !ok {
+		v := proberesult.DefaultLatencyMs
+		prc.mutation.SetLatencyMs(v)
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := proberesult.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		v := proberesult.DefaultUpdatedAt()
+		prc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := prc.mutation.ID(); !ok {
+		v := proberesult.DefaultID()
+		prc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *ProbeResultCreate) check() error {
+	if _, ok := prc.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "ProbeResult.target"`)}
+	}
+	if v, ok := prc.mutation.Target(); ok {
+		if err := proberesult.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "ProbeResult.target": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ProbeResult.status"`)}
+	}
+	if v, ok := prc.mutation.Status(); ok {
+		if err := proberesult.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ProbeResult.status": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "ProbeResult.latency_ms"`)}
+	}
+	if _, ok := prc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ProbeResult.created_by"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProbeResult.created_at"`)}
+	}
+	if _, ok := prc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "ProbeResult.updated_by"`)}
+	}
+	if _, ok := prc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProbeResult.updated_at"`)}
+	}
+	if len(prc.mutation.ProbeIDs()) == 0 {
+		return &ValidationError{Name: "probe", err: errors.New(`ent: missing required edge "ProbeResult.probe"`)}
+	}
+	if len(prc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ProbeResult.item"`)}
+	}
+	return nil
+}
+
+func (prc *ProbeResultCreate) sqlSave(ctx context.Context) (*ProbeResult, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *ProbeResultCreate) createSpec() (*ProbeResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProbeResult{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(proberesult.Table, sqlgraph.NewFieldSpec(proberesult.FieldID, field.TypeUUID))
+	)
+	if id, ok := prc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := prc.mutation.Target(); ok {
+		_spec.SetField(proberesult.FieldTarget, field.TypeString, value)
+		_node.Target = value
+	}
+	if value, ok := prc.mutation.Status(); ok {
+		_spec.SetField(proberesult.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := prc.mutation.LatencyMs(); ok {
+		_spec.SetField(proberesult.FieldLatencyMs, field.TypeFloat64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := prc.mutation.StatusCode(); ok {
+		_spec.SetField(proberesult.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := prc.mutation.Error(); ok {
+		_spec.SetField(proberesult.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := prc.mutation.CreatedBy(); ok {
+		_spec.SetField(proberesult.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(proberesult.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := prc.mutation.UpdatedBy(); ok {
+		_spec.SetField(proberesult.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := prc.mutation.UpdatedAt(); ok {
+		_spec.SetField(proberesult.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := prc.mutation.DeletedBy(); ok {
+		_spec.SetField(proberesult.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := prc.mutation.DeletedAt(); ok {
+		_spec.SetField(proberesult.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := prc.mutation.ProbeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   proberesult.ProbeTable,
+			Columns: []string{proberesult.ProbeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(probe.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.probe_result_probe = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := prc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   proberesult.ItemTable,
+			Columns: []string{proberesult.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.probe_result_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProbeResultCreateBulk is the builder for creating many ProbeResult entities in bulk.
+type ProbeResultCreateBulk struct {
+	config
+	err      error
+	builders []*ProbeResultCreate
+}
+
+// Save creates the ProbeResult entities in the database.
+func (prcb *ProbeResultCreateBulk) Save(ctx context.Context) ([]*ProbeResult, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*ProbeResult, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProbeResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *ProbeResultCreateBulk) SaveX(ctx context.Context) []*ProbeResult {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *ProbeResultCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *ProbeResultCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,690 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProbeResultQuery is the builder for querying ProbeResult entities.
+type ProbeResultQuery struct {
+	config
+	ctx        *QueryContext
+	order      []proberesult.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ProbeResult
+	withProbe  *ProbeQuery
+	withItem   *ItemQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProbeResultQuery builder.
+func (prq *ProbeResultQuery) Where(ps ...predicate.ProbeResult) *ProbeResultQuery {
+	prq.predicates = append(prq.predicates, ps...)
+	return prq
+}
+
+// Limit the number of records to be returned by this query.
+func (prq *ProbeResultQuery) Limit(limit int) *ProbeResultQuery {
+	prq.ctx.Limit = &limit
+	return prq
+}
+
+// Offset to start from.
+func (prq *ProbeResultQuery) Offset(offset int) *ProbeResultQuery {
+	prq.ctx.Offset = &offset
+	return prq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (prq *ProbeResultQuery) Unique(unique bool) *ProbeResultQuery {
+	prq.ctx.Unique = &unique
+	return prq
+}
+
+// Order specifies how the records should be ordered.
+func (prq *ProbeResultQuery) Order(o ...proberesult.OrderOption) *ProbeResultQuery {
+	prq.order = append(prq.order, o...)
+	return prq
+}
+
+// QueryProbe chains the current query on the "probe" edge.
+func (prq *ProbeResultQuery) QueryProbe() *ProbeQuery {
+	query := (&ProbeClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proberesult.Table, proberesult.FieldID, selector),
+			sqlgraph.To(probe.Table, probe.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, proberesult.ProbeTable, proberesult.ProbeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (prq *ProbeResultQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: prq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := prq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := prq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proberesult.Table, proberesult.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, proberesult.ItemTable, proberesult.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(prq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProbeResult entity from the query.
+// Returns a *NotFoundError when no ProbeResult was found.
+func (prq *ProbeResultQuery) First(ctx context.Context) (*ProbeResult, error) {
+	nodes, err := prq.Limit(1).All(setContextOp(ctx, prq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{proberesult.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (prq *ProbeResultQuery) FirstX(ctx context.Context) *ProbeResult {
+	node, err := prq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProbeResult ID from the query.
+// Returns a *NotFoundError when no ProbeResult ID was found.
+func (prq *ProbeResultQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(1).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{proberesult.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (prq *ProbeResultQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProbeResult entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProbeResult entity is found.
+// Returns a *NotFoundError when no ProbeResult entities are found.
+func (prq *ProbeResultQuery) Only(ctx context.Context) (*ProbeResult, error) {
+	nodes, err := prq.Limit(2).All(setContextOp(ctx, prq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{proberesult.Label}
+	default:
+		return nil, &NotSingularError{proberesult.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (prq *ProbeResultQuery) OnlyX(ctx context.Context) *ProbeResult {
+	node, err := prq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProbeResult ID in the query.
+// Returns a *NotSingularError when more than one ProbeResult ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (prq *ProbeResultQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = prq.Limit(2).IDs(setContextOp(ctx, prq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{proberesult.Label}
+	default:
+		err = &NotSingularError{proberesult.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (prq *ProbeResultQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := prq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProbeResults.
+func (prq *ProbeResultQuery) All(ctx context.Context) ([]*ProbeResult, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryAll)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProbeResult, *ProbeResultQuery]()
+	return withInterceptors[[]*ProbeResult](ctx, prq, qr, prq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (prq *ProbeResultQuery) AllX(ctx context.Context) []*ProbeResult {
+	nodes, err := prq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProbeResult IDs.
+func (prq *ProbeResultQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if prq.ctx.Unique == nil && prq.path != nil {
+		prq.Unique(true)
+	}
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryIDs)
+	if err = prq.Select(proberesult.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (prq *ProbeResultQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := prq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (prq *ProbeResultQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryCount)
+	if err := prq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, prq, querierCount[*ProbeResultQuery](), prq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (prq *ProbeResultQuery) CountX(ctx context.Context) int {
+	count, err := prq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (prq *ProbeResultQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, prq.ctx, ent.OpQueryExist)
+	switch _, err := prq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (prq *ProbeResultQuery) ExistX(ctx context.Context) bool {
+	exist, err := prq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProbeResultQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (prq *ProbeResultQuery) Clone() *ProbeResultQuery {
+	if prq == nil {
+		return nil
+	}
+	return &ProbeResultQuery{
+		config:     prq.config,
+		ctx:        prq.ctx.Clone(),
+		order:      append([]proberesult.OrderOption{}, prq.order...),
+		inters:     append([]Interceptor{}, prq.inters...),
+		predicates: append([]predicate.ProbeResult{}, prq.predicates...),
+		withProbe:  prq.withProbe.Clone(),
+		withItem:   prq.withItem.Clone(),
+		// clone intermediate query.
+		sql:  prq.sql.Clone(),
+		path: prq.path,
+	}
+}
+
+// WithProbe tells the query-builder to eager-load the nodes that are connected to
+// the "probe" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *ProbeResultQuery) WithProbe(opts ...func(*ProbeQuery)) *ProbeResultQuery {
+	query := (&ProbeClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withProbe = query
+	return prq
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (prq *ProbeResultQuery) WithItem(opts ...func(*ItemQuery)) *ProbeResultQuery {
+	query := (&ItemClient{config: prq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	prq.withItem = query
+	return prq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Target string `json:"target,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProbeResult.Query().
+//		GroupBy(proberesult.FieldTarget).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (prq *ProbeResultQuery) GroupBy(field string, fields ...string) *ProbeResultGroupBy {
+	prq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProbeResultGroupBy{build: prq}
+	grbuild.flds = &prq.ctx.Fields
+	grbuild.label = proberesult.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Target string `json:"target,omitempty"`
+//	}
+//
+//	client.ProbeResult.Query().
+//		Select(proberesult.FieldTarget).
+//		Scan(ctx, &v)
+func (prq *ProbeResultQuery) Select(fields ...string) *ProbeResultSelect {
+	prq.ctx.Fields = append(prq.ctx.Fields, fields...)
+	sbuild := &ProbeResultSelect{ProbeResultQuery: prq}
+	sbuild.label = proberesult.Label
+	sbuild.flds, sbuild.scan = &prq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProbeResultSelect configured with the given aggregations.
+func (prq *ProbeResultQuery) Aggregate(fns ...AggregateFunc) *ProbeResultSelect {
+	return prq.Select().Aggregate(fns...)
+}
+
+func (prq *ProbeResultQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range prq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, prq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range prq.ctx.Fields {
+		if !proberesult.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if prq.path != nil {
+		prev, err := prq.path(ctx)
+		if err != nil {
+			return err
+		}
+		prq.sql = prev
+	}
+	return nil
+}
+
+func (prq *ProbeResultQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProbeResult, error) {
+	var (
+		nodes       = []*ProbeResult{}
+		withFKs     = prq.withFKs
+		_spec       = prq.querySpec()
+		loadedTypes = [2]bool{
+			prq.withProbe != nil,
+			prq.withItem != nil,
+		}
+	)
+	if prq.withProbe != nil || prq.withItem != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, proberesult.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProbeResult).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProbeResult{config: prq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, prq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := prq.withProbe; query != nil {
+		if err := prq.loadProbe(ctx, query, nodes, nil,
+			func(n *ProbeResult, e *Probe) { n.Edges.Probe = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := prq.withItem; query != nil {
+		if err := prq.loadItem(ctx, query, nodes, nil,
+			func(n *ProbeResult, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (prq *ProbeResultQuery) loadProbe(ctx context.Context, query *ProbeQuery, nodes []*ProbeResult, init func(*ProbeResult), assign func(*ProbeResult, *Probe)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProbeResult)
+	for i := range nodes {
+		if nodes[i].probe_result_probe == nil {
+			continue
+		}
+		fk := *nodes[i].probe_result_probe
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(probe.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "probe_result_probe" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (prq *ProbeResultQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ProbeResult, init func(*ProbeResult), assign func(*ProbeResult, *Item)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ProbeResult)
+	for i := range nodes {
+		if nodes[i].probe_result_item == nil {
+			continue
+		}
+		fk := *nodes[i].probe_result_item
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "probe_result_item" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (prq *ProbeResultQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := prq.querySpec()
+	_spec.Node.Columns = prq.ctx.Fields
+	if len(prq.ctx.Fields) > 0 {
+		_spec.Unique = prq.ctx.Unique != nil && *prq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, prq.driver, _spec)
+}
+
+func (prq *ProbeResultQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(proberesult.Table, proberesult.Columns, sqlgraph.NewFieldSpec(proberesult.FieldID, field.TypeUUID))
+	_spec.From = prq.sql
+	if unique := prq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if prq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := prq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proberesult.FieldID)
+		for i := range fields {
+			if fields[i] != proberesult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := prq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := prq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (prq *ProbeResultQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(prq.driver.Dialect())
+	t1 := builder.Table(proberesult.Table)
+	columns := prq.ctx.Fields
+	if len(columns) == 0 {
+		columns = proberesult.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if prq.sql != nil {
+		selector = prq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if prq.ctx.Unique != nil && *prq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range prq.predicates {
+		p(selector)
+	}
+	for _, p := range prq.order {
+		p(selector)
+	}
+	if offset := prq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := prq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProbeResultGroupBy is the group-by builder for ProbeResult entities.
+type ProbeResultGroupBy struct {
+	selector
+	build *ProbeResultQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (prgb *ProbeResultGroupBy) Aggregate(fns ...AggregateFunc) *ProbeResultGroupBy {
+	prgb.fns = append(prgb.fns, fns...)
+	return prgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prgb *ProbeResultGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prgb.build.ctx, ent.OpQueryGroupBy)
+	if err := prgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProbeResultQuery, *ProbeResultGroupBy](ctx, prgb.build, prgb, prgb.build.inters, v)
+}
+
+func (prgb *ProbeResultGroupBy) sqlScan(ctx context.Context, root *ProbeResultQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(prgb.fns))
+	for _, fn := range prgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*prgb.flds)+len(prgb.fns))
+		for _, f := range *prgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*prgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProbeResultSelect is the builder for selecting fields of ProbeResult entities.
+type ProbeResultSelect struct {
+	*ProbeResultQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (prs *ProbeResultSelect) Aggregate(fns ...AggregateFunc) *ProbeResultSelect {
+	prs.fns = append(prs.fns, fns...)
+	return prs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (prs *ProbeResultSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, prs.ctx, ent.OpQuerySelect)
+	if err := prs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProbeResultQuery, *ProbeResultSelect](ctx, prs.ProbeResultQuery, prs, prs.inters, v)
+}
+
+func (prs *ProbeResultSelect) sqlScan(ctx context.Context, root *ProbeResultQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(prs.fns))
+	for _, fn := range prs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*prs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := prs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/proberesult"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProbeResultUpdate is the builder for updating ProbeResult entities.
+type ProbeResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProbeResultMutation
+}
+
+// Where appends a list predicates to the ProbeResultUpdate builder.
+func (pru *ProbeResultUpdate) Where(ps ...predicate.ProbeResult) *ProbeResultUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pru *ProbeResultUpdate) SetCreatedBy(s string) *ProbeResultUpdate {
+	pru.mutation.SetCreatedBy(s)
+	return pru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pru *ProbeResultUpdate) SetNillableCreatedBy(s *string) *ProbeResultUpdate {
+	if s != nil {
+		pru.SetCreatedBy(*s)
+	}
+	return pru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pru *ProbeResultUpdate) SetUpdatedBy(s string) *ProbeResultUpdate {
+	pru.mutation.SetUpdatedBy(s)
+	return pru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pru *ProbeResultUpdate) SetNillableUpdatedBy(s *string) *ProbeResultUpdate {
+	if s != nil {
+		pru.SetUpdatedBy(*s)
+	}
+	return pru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pru *ProbeResultUpdate) SetUpdatedAt(t time.Time) *ProbeResultUpdate {
+	pru.mutation.SetUpdatedAt(t)
+	return pru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pru *ProbeResultUpdate) SetDeletedBy(s string) *ProbeResultUpdate {
+	pru.mutation.SetDeletedBy(s)
+	return pru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pru *ProbeResultUpdate) SetNillableDeletedBy(s *string) *ProbeResultUpdate {
+	if s != nil {
+		pru.SetDeletedBy(*s)
+	}
+	return pru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (pru *ProbeResultUpdate) ClearDeletedBy() *ProbeResultUpdate {
+	pru.mutation.ClearDeletedBy()
+	return pru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pru *ProbeResultUpdate) SetDeletedAt(t time.Time) *ProbeResultUpdate {
+	pru.mutation.SetDeletedAt(t)
+	return pru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pru *ProbeResultUpdate) SetNillableDeletedAt(t *time.Time) *ProbeResultUpdate {
+	if t != nil {
+		pru.SetDeletedAt(*t)
+	}
+	return pru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pru *ProbeResultUpdate) ClearDeletedAt() *ProbeResultUpdate {
+	pru.mutation.ClearDeletedAt()
+	return pru
+}
+
+// Mutation returns the ProbeResultMutation object of the builder.
+func (pru *ProbeResultUpdate) Mutation() *ProbeResultMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *ProbeResultUpdate) Save(ctx context.Context) (int, error) {
+	pru.defaults()
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *ProbeResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *ProbeResultUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *ProbeResultUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pru *ProbeResultUpdate) defaults() {
+	if _, ok := pru.mutation.UpdatedAt(); !ok {
+		v := proberesult.UpdateDefaultUpdatedAt()
+		pru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *ProbeResultUpdate) check() error {
+	if pru.mutation.ProbeCleared() && len(pru.mutation.ProbeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProbeResult.probe"`)
+	}
+	if pru.mutation.ItemCleared() && len(pru.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProbeResult.item"`)
+	}
+	return nil
+}
+
+func (pru *ProbeResultUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proberesult.Table, proberesult.Columns, sqlgraph.NewFieldSpec(proberesult.FieldID, field.TypeUUID))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pru.mutation.StatusCodeCleared() {
+		_spec.ClearField(proberesult.FieldStatusCode, field.TypeInt)
+	}
+	if pru.mutation.ErrorCleared() {
+		_spec.ClearField(proberesult.FieldError, field.TypeString)
+	}
+	if value, ok := pru.mutation.CreatedBy(); ok {
+		_spec.SetField(proberesult.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.UpdatedBy(); ok {
+		_spec.SetField(proberesult.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.UpdatedAt(); ok {
+		_spec.SetField(proberesult.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pru.mutation.DeletedBy(); ok {
+		_spec.SetField(proberesult.FieldDeletedBy, field.TypeString, value)
+	}
+	if pru.mutation.DeletedByCleared() {
+		_spec.ClearField(proberesult.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := pru.mutation.DeletedAt(); ok {
+		_spec.SetField(proberesult.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pru.mutation.DeletedAtCleared() {
+		_spec.ClearField(proberesult.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proberesult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// ProbeResultUpdateOne is the builder for updating a single ProbeResult entity.
+type ProbeResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProbeResultMutation
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pruo *ProbeResultUpdateOne) SetCreatedBy(s string) *ProbeResultUpdateOne {
+	pruo.mutation.SetCreatedBy(s)
+	return pruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pruo *ProbeResultUpdateOne) SetNillableCreatedBy(s *string) *ProbeResultUpdateOne {
+	if s != nil {
+		pruo.SetCreatedBy(*s)
+	}
+	return pruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pruo *ProbeResultUpdateOne) SetUpdatedBy(s string) *ProbeResultUpdateOne {
+	pruo.mutation.SetUpdatedBy(s)
+	return pruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pruo *ProbeResultUpdateOne) SetNillableUpdatedBy(s *string) *ProbeResultUpdateOne {
+	if s != nil {
+		pruo.SetUpdatedBy(*s)
+	}
+	return pruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pruo *ProbeResultUpdateOne) SetUpdatedAt(t time.Time) *ProbeResultUpdateOne {
+	pruo.mutation.SetUpdatedAt(t)
+	return pruo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pruo *ProbeResultUpdateOne) SetDeletedBy(s string) *ProbeResultUpdateOne {
+	pruo.mutation.SetDeletedBy(s)
+	return pruo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pruo *ProbeResultUpdateOne) SetNillableDeletedBy(s *string) *ProbeResultUpdateOne {
+	if s != nil {
+		pruo.SetDeletedBy(*s)
+	}
+	return pruo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (pruo *ProbeResultUpdateOne) ClearDeletedBy() *ProbeResultUpdateOne {
+	pruo.mutation.ClearDeletedBy()
+	return pruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pruo *ProbeResultUpdateOne) SetDeletedAt(t time.Time) *ProbeResultUpdateOne {
+	pruo.mutation.SetDeletedAt(t)
+	return pruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pruo *ProbeResultUpdateOne) SetNillableDeletedAt(t *time.Time) *ProbeResultUpdateOne {
+	if t != nil {
+		pruo.SetDeletedAt(*t)
+	}
+	return pruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pruo *ProbeResultUpdateOne) ClearDeletedAt() *ProbeResultUpdateOne {
+	pruo.mutation.ClearDeletedAt()
+	return pruo
+}
+
+// Mutation returns the ProbeResultMutation object of the builder.
+func (pruo *ProbeResultUpdateOne) Mutation() *ProbeResultMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the ProbeResultUpdate builder.
+func (pruo *ProbeResultUpdateOne) Where(ps ...predicate.ProbeResult) *ProbeResultUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *ProbeResultUpdateOne) Select(field string, fields ...string) *ProbeResultUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated ProbeResult entity.
+func (pruo *ProbeResultUpdateOne) Save(ctx context.Context) (*ProbeResult, error) {
+	pruo.defaults()
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *ProbeResultUpdateOne) SaveX(ctx context.Context) *ProbeResult {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *ProbeResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *ProbeResultUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pruo *ProbeResultUpdateOne) defaults() {
+	if _, ok := pruo.mutation.UpdatedAt(); !ok {
+		v := proberesult.UpdateDefaultUpdatedAt()
+		pruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *ProbeResultUpdateOne) check() error {
+	if pruo.mutation.ProbeCleared() && len(pruo.mutation.ProbeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProbeResult.probe"`)
+	}
+	if pruo.mutation.ItemCleared() && len(pruo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ProbeResult.item"`)
+	}
+	return nil
+}
+
+func (pruo *ProbeResultUpdateOne) sqlSave(ctx context.Context) (_node *ProbeResult, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(proberesult.Table, proberesult.Columns, sqlgraph.NewFieldSpec(proberesult.FieldID, field.TypeUUID))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProbeResult.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, proberesult.FieldID)
+		for _, f := range fields {
+			if !proberesult.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != proberesult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if pruo.mutation.StatusCodeCleared() {
+		_spec.ClearField(proberesult.FieldStatusCode, field.TypeInt)
+	}
+	if pruo.mutation.ErrorCleared() {
+		_spec.ClearField(proberesult.FieldError, field.TypeString)
+	}
+	if value, ok := pruo.mutation.CreatedBy(); ok {
+		_spec.SetField(proberesult.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(proberesult.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(proberesult.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pruo.mutation.DeletedBy(); ok {
+		_spec.SetField(proberesult.FieldDeletedBy, field.TypeString, value)
+	}
+	if pruo.mutation.DeletedByCleared() {
+		_spec.ClearField(proberesult.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := pruo.mutation.DeletedAt(); ok {
+		_spec.SetField(proberesult.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(proberesult.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &ProbeResult{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{proberesult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
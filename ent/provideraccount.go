@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/provideraccount"
+	"dig-inv/ent/schema"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ProviderAccount is the model entity for the ProviderAccount schema.
+type ProviderAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the provider account.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The name of the account, e.g. `Hetzner production`.
+	Name string `json:"name,omitempty"`
+	// The provider integration that uses the account, e.g. `hetzner`.
+	Provider string `json:"provider,omitempty"`
+	// The settings of the account that are not secret, e.g. the region or project.
+	Config map[string]string `json:"config,omitempty"`
+	// The encrypted secrets of the account keyed by their name, e.g. `token`. Secrets are never returned by the API.
+	Secrets map[string]schema.EncryptedValue `json:"-"`
+	// The time the secrets were last replaced.
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProviderAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case provideraccount.FieldConfig, provideraccount.FieldSecrets:
+			values[i] = new([]byte)
+		case provideraccount.FieldName, provideraccount.FieldProvider, provideraccount.FieldCreatedBy, provideraccount.FieldUpdatedBy, provideraccount.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case provideraccount.FieldRotatedAt, provideraccount.FieldCreatedAt, provideraccount.FieldUpdatedAt, provideraccount.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case provideraccount.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProviderAccount fields.
+func (pa *ProviderAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case provideraccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				pa.ID = *value
+			}
+		case provideraccount.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pa.Name = value.String
+			}
+		case provideraccount.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pa.Provider = value.String
+			}
+		case provideraccount.FieldConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Config); err != nil {
+					return fmt.Errorf("unmarshal field config: %w", err)
+				}
+			}
+		case provideraccount.FieldSecrets:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secrets", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Secrets); err != nil {
+					return fmt.Errorf("unmarshal field secrets: %w", err)
+				}
+			}
+		case provideraccount.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				pa.RotatedAt = new(time.Time)
+				*pa.RotatedAt = value.Time
+			}
+		case provideraccount.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pa.CreatedBy = value.String
+			}
+		case provideraccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case provideraccount.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				pa.UpdatedBy = value.String
+			}
+		case provideraccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		case provideraccount.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				pa.DeletedBy = value.String
+			}
+		case provideraccount.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				pa.DeletedAt = new(time.Time)
+				*pa.DeletedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProviderAccount.
+// This includes values selected through modifiers, order, etc.
+func (pa *ProviderAccount) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProviderAccount.
+// Note that you need to call ProviderAccount.Unwrap() before calling this method if this ProviderAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *ProviderAccount) Update() *ProviderAccountUpdateOne {
+	return NewProviderAccountClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the ProviderAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *ProviderAccount) Unwrap() *ProviderAccount {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProviderAccount is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *ProviderAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProviderAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("name=")
+	builder.WriteString(pa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(pa.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("config=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Config))
+	builder.WriteString(", ")
+	builder.WriteString("secrets=<sensitive>")
+	builder.WriteString(", ")
+	if v := pa.RotatedAt; v != nil {
+		builder.WriteString("rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(pa.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(pa.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(pa.DeletedBy)
+	builder.WriteString(", ")
+	if v := pa.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProviderAccounts is a parsable slice of ProviderAccount.
+type ProviderAccounts []*ProviderAccount
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/provideraccount"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ProviderAccountCreate is the builder for creating a ProviderAccount entity.
+type ProviderAccountCreate struct {
+	config
+	mutation *ProviderAccountMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (pac *ProviderAccountCreate) SetName(s string) *ProviderAccountCreate {
+	pac.mutation.SetName(s)
+	return pac
+}
+
+// SetProvider sets the "provider" field.
+func (pac *ProviderAccountCreate) SetProvider(s string) *ProviderAccountCreate {
+	pac.mutation.SetProvider(s)
+	return pac
+}
+
+// SetConfig sets the "config" field.
+func (pac *ProviderAccountCreate) SetConfig(m map[string]string) *ProviderAccountCreate {
+	pac.mutation.SetConfig(m)
+	return pac
+}
+
+// SetSecrets sets the "secrets" field.
+func (pac *ProviderAccountCreate) SetSecrets(mv map[string]schema.EncryptedValue) *ProviderAccountCreate {
+	pac.mutation.SetSecrets(mv)
+	return pac
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (pac *ProviderAccountCreate) SetRotatedAt(t time.Time) *ProviderAccountCreate {
+	pac.mutation.SetRotatedAt(t)
+	return pac
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableRotatedAt(t *time.Time) *ProviderAccountCreate {
+	if t != nil {
+		pac.SetRotatedAt(*t)
+	}
+	return pac
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pac *ProviderAccountCreate) SetCreatedBy(s string) *ProviderAccountCreate {
+	pac.mutation.SetCreatedBy(s)
+	return pac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pac *ProviderAccountCreate) SetCreatedAt(t time.Time) *ProviderAccountCreate {
+	pac.mutation.SetCreatedAt(t)
+	return pac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableCreatedAt(t *time.Time) *ProviderAccountCreate {
+	if t != nil {
+		pac.SetCreatedAt(*t)
+	}
+	return pac
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pac *ProviderAccountCreate) SetUpdatedBy(s string) *ProviderAccountCreate {
+	pac.mutation.SetUpdatedBy(s)
+	return pac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pac *ProviderAccountCreate) SetUpdatedAt(t time.Time) *ProviderAccountCreate {
+	pac.mutation.SetUpdatedAt(t)
+	return pac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableUpdatedAt(t *time.Time) *ProviderAccountCreate {
+	if t != nil {
+		pac.SetUpdatedAt(*t)
+	}
+	return pac
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pac *ProviderAccountCreate) SetDeletedBy(s string) *ProviderAccountCreate {
+	pac.mutation.SetDeletedBy(s)
+	return pac
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableDeletedBy(s *string) *ProviderAccountCreate {
+	if s != nil {
+		pac.SetDeletedBy(*s)
+	}
+	return pac
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pac *ProviderAccountCreate) SetDeletedAt(t time.Time) *ProviderAccountCreate {
+	pac.mutation.SetDeletedAt(t)
+	return pac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableDeletedAt(t *time.Time) *ProviderAccountCreate {
+	if t != nil {
+		pac.SetDeletedAt(*t)
+	}
+	return pac
+}
+
+// SetID sets the "id" field.
+func (pac *ProviderAccountCreate) SetID(u uuid.UUID) *ProviderAccountCreate {
+	pac.mutation.SetID(u)
+	return pac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (pac *ProviderAccountCreate) SetNillableID(u *uuid.UUID) *ProviderAccountCreate {
+	if u != nil {
+		pac.SetID(*u)
+	}
+	return pac
+}
+
+// Mutation returns the ProviderAccountMutation object of the builder.
+func (pac *ProviderAccountCreate) Mutation() *ProviderAccountMutation {
+	return pac.mutation
+}
+
+// Save creates the ProviderAccount in the database.
+func (pac *ProviderAccountCreate) Save(ctx context.Context) (*ProviderAccount, error) {
+	pac.defaults()
+	return withHooks(ctx, pac.sqlSave, pac.mutation, pac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pac *ProviderAccountCreate) SaveX(ctx context.Context) *ProviderAccount {
+	v, err := pac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pac *ProviderAccountCreate) Exec(ctx context.Context) error {
+	_, err := pac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pac *ProviderAccountCreate) ExecX(ctx context.Context) {
+	if err := pac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pac *ProviderAccountCreate) defaults() {
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		v := provideraccount.DefaultCreatedAt()
+		pac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		v := provideraccount.DefaultUpdatedAt()
+		pac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pac.mutation.ID(); !ok {
+		v := provideraccount.DefaultID()
+		pac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pac *ProviderAccountCreate) check() error {
+	if _, ok := pac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProviderAccount.name"`)}
+	}
+	if v, ok := pac.mutation.Name(); ok {
+		if err := provideraccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.name": %w`, err)}
+		}
+	}
+	if _, ok := pac.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "ProviderAccount.provider"`)}
+	}
+	if v, ok := pac.mutation.Provider(); ok {
+		if err := provideraccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.provider": %w`, err)}
+		}
+	}
+	if _, ok := pac.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ProviderAccount.created_by"`)}
+	}
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProviderAccount.created_at"`)}
+	}
+	if _, ok := pac.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "ProviderAccount.updated_by"`)}
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProviderAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (pac *ProviderAccountCreate) sqlSave(ctx context.Context) (*ProviderAccount, error) {
+	if err := pac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	pac.mutation.id = &_node.ID
+	pac.mutation.done = true
+	return _node, nil
+}
+
+func (pac *ProviderAccountCreate) createSpec() (*ProviderAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProviderAccount{config: pac.config}
+		_spec = sqlgraph.NewCreateSpec(provideraccount.Table, sqlgraph.NewFieldSpec(provideraccount.FieldID, field.TypeUUID))
+	)
+	if id, ok := pac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := pac.mutation.Name(); ok {
+		_spec.SetField(provideraccount.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pac.mutation.Provider(); ok {
+		_spec.SetField(provideraccount.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := pac.mutation.Config(); ok {
+		_spec.SetField(provideraccount.FieldConfig, field.TypeJSON, value)
+		_node.Config = value
+	}
+	if value, ok := pac.mutation.Secrets(); ok {
+		_spec.SetField(provideraccount.FieldSecrets, field.TypeJSON, value)
+		_node.Secrets = value
+	}
+	if value, ok := pac.mutation.RotatedAt(); ok {
+		_spec.SetField(provideraccount.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := pac.mutation.CreatedBy(); ok {
+		_spec.SetField(provideraccount.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := pac.mutation.CreatedAt(); ok {
+		_spec.SetField(provideraccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pac.mutation.UpdatedBy(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := pac.mutation.UpdatedAt(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pac.mutation.DeletedBy(); ok {
+		_spec.SetField(provideraccount.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := pac.mutation.DeletedAt(); ok {
+		_spec.SetField(provideraccount.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	return _node, _spec
+}
+
+// ProviderAccountCreateBulk is the builder for creating many ProviderAccount entities in bulk.
+type ProviderAccountCreateBulk struct {
+	config
+	err      error
+	builders []*ProviderAccountCreate
+}
+
+// Save creates the ProviderAccount entities in the database.
+func (pacb *ProviderAccountCreateBulk) Save(ctx context.Context) ([]*ProviderAccount, error) {
+	if pacb.err != nil {
+		return nil, pacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pacb.builders))
+	nodes := make([]*ProviderAccount, len(pacb.builders))
+	mutators := make([]Mutator, len(pacb.builders))
+	for i := range pacb.builders {
+		func(i int, root context.Context) {
+			builder := pacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProviderAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pacb *ProviderAccountCreateBulk) SaveX(ctx context.Context) []*ProviderAccount {
+	v, err := pacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pacb *ProviderAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := pacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pacb *ProviderAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := pacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
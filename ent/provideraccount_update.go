@@ -0,0 +1,613 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/provideraccount"
+	"dig-inv/ent/schema"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProviderAccountUpdate is the builder for updating ProviderAccount entities.
+type ProviderAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProviderAccountMutation
+}
+
+// Where appends a list predicates to the ProviderAccountUpdate builder.
+func (pau *ProviderAccountUpdate) Where(ps ...predicate.ProviderAccount) *ProviderAccountUpdate {
+	pau.mutation.Where(ps...)
+	return pau
+}
+
+// SetName sets the "name" field.
+func (pau *ProviderAccountUpdate) SetName(s string) *ProviderAccountUpdate {
+	pau.mutation.SetName(s)
+	return pau
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableName(s *string) *ProviderAccountUpdate {
+	if s != nil {
+		pau.SetName(*s)
+	}
+	return pau
+}
+
+// SetProvider sets the "provider" field.
+func (pau *ProviderAccountUpdate) SetProvider(s string) *ProviderAccountUpdate {
+	pau.mutation.SetProvider(s)
+	return pau
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableProvider(s *string) *ProviderAccountUpdate {
+	if s != nil {
+		pau.SetProvider(*s)
+	}
+	return pau
+}
+
+// SetConfig sets the "config" field.
+func (pau *ProviderAccountUpdate) SetConfig(m map[string]string) *ProviderAccountUpdate {
+	pau.mutation.SetConfig(m)
+	return pau
+}
+
+// ClearConfig clears the value of the "config" field.
+func (pau *ProviderAccountUpdate) ClearConfig() *ProviderAccountUpdate {
+	pau.mutation.ClearConfig()
+	return pau
+}
+
+// SetSecrets sets the "secrets" field.
+func (pau *ProviderAccountUpdate) SetSecrets(mv map[string]schema.EncryptedValue) *ProviderAccountUpdate {
+	pau.mutation.SetSecrets(mv)
+	return pau
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (pau *ProviderAccountUpdate) ClearSecrets() *ProviderAccountUpdate {
+	pau.mutation.ClearSecrets()
+	return pau
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (pau *ProviderAccountUpdate) SetRotatedAt(t time.Time) *ProviderAccountUpdate {
+	pau.mutation.SetRotatedAt(t)
+	return pau
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableRotatedAt(t *time.Time) *ProviderAccountUpdate {
+	if t != nil {
+		pau.SetRotatedAt(*t)
+	}
+	return pau
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (pau *ProviderAccountUpdate) ClearRotatedAt() *ProviderAccountUpdate {
+	pau.mutation.ClearRotatedAt()
+	return pau
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pau *ProviderAccountUpdate) SetCreatedBy(s string) *ProviderAccountUpdate {
+	pau.mutation.SetCreatedBy(s)
+	return pau
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableCreatedBy(s *string) *ProviderAccountUpdate {
+	if s != nil {
+		pau.SetCreatedBy(*s)
+	}
+	return pau
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pau *ProviderAccountUpdate) SetUpdatedBy(s string) *ProviderAccountUpdate {
+	pau.mutation.SetUpdatedBy(s)
+	return pau
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableUpdatedBy(s *string) *ProviderAccountUpdate {
+	if s != nil {
+		pau.SetUpdatedBy(*s)
+	}
+	return pau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pau *ProviderAccountUpdate) SetUpdatedAt(t time.Time) *ProviderAccountUpdate {
+	pau.mutation.SetUpdatedAt(t)
+	return pau
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pau *ProviderAccountUpdate) SetDeletedBy(s string) *ProviderAccountUpdate {
+	pau.mutation.SetDeletedBy(s)
+	return pau
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableDeletedBy(s *string) *ProviderAccountUpdate {
+	if s != nil {
+		pau.SetDeletedBy(*s)
+	}
+	return pau
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (pau *ProviderAccountUpdate) ClearDeletedBy() *ProviderAccountUpdate {
+	pau.mutation.ClearDeletedBy()
+	return pau
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pau *ProviderAccountUpdate) SetDeletedAt(t time.Time) *ProviderAccountUpdate {
+	pau.mutation.SetDeletedAt(t)
+	return pau
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pau *ProviderAccountUpdate) SetNillableDeletedAt(t *time.Time) *ProviderAccountUpdate {
+	if t != nil {
+		pau.SetDeletedAt(*t)
+	}
+	return pau
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pau *ProviderAccountUpdate) ClearDeletedAt() *ProviderAccountUpdate {
+	pau.mutation.ClearDeletedAt()
+	return pau
+}
+
+// Mutation returns the ProviderAccountMutation object of the builder.
+func (pau *ProviderAccountUpdate) Mutation() *ProviderAccountMutation {
+	return pau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pau *ProviderAccountUpdate) Save(ctx context.Context) (int, error) {
+	pau.defaults()
+	return withHooks(ctx, pau.sqlSave, pau.mutation, pau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pau *ProviderAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := pau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pau *ProviderAccountUpdate) Exec(ctx context.Context) error {
+	_, err := pau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pau *ProviderAccountUpdate) ExecX(ctx context.Context) {
+	if err := pau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pau *ProviderAccountUpdate) defaults() {
+	if _, ok := pau.mutation.UpdatedAt(); !ok {
+		v := provideraccount.UpdateDefaultUpdatedAt()
+		pau.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pau *ProviderAccountUpdate) check() error {
+	if v, ok := pau.mutation.Name(); ok {
+		if err := provideraccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := pau.mutation.Provider(); ok {
+		if err := provideraccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pau *ProviderAccountUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(provideraccount.Table, provideraccount.Columns, sqlgraph.NewFieldSpec(provideraccount.FieldID, field.TypeUUID))
+	if ps := pau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pau.mutation.Name(); ok {
+		_spec.SetField(provideraccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.Provider(); ok {
+		_spec.SetField(provideraccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.Config(); ok {
+		_spec.SetField(provideraccount.FieldConfig, field.TypeJSON, value)
+	}
+	if pau.mutation.ConfigCleared() {
+		_spec.ClearField(provideraccount.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := pau.mutation.Secrets(); ok {
+		_spec.SetField(provideraccount.FieldSecrets, field.TypeJSON, value)
+	}
+	if pau.mutation.SecretsCleared() {
+		_spec.ClearField(provideraccount.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := pau.mutation.RotatedAt(); ok {
+		_spec.SetField(provideraccount.FieldRotatedAt, field.TypeTime, value)
+	}
+	if pau.mutation.RotatedAtCleared() {
+		_spec.ClearField(provideraccount.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := pau.mutation.CreatedBy(); ok {
+		_spec.SetField(provideraccount.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.UpdatedBy(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := pau.mutation.UpdatedAt(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pau.mutation.DeletedBy(); ok {
+		_spec.SetField(provideraccount.FieldDeletedBy, field.TypeString, value)
+	}
+	if pau.mutation.DeletedByCleared() {
+		_spec.ClearField(provideraccount.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := pau.mutation.DeletedAt(); ok {
+		_spec.SetField(provideraccount.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pau.mutation.DeletedAtCleared() {
+		_spec.ClearField(provideraccount.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provideraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pau.mutation.done = true
+	return n, nil
+}
+
+// ProviderAccountUpdateOne is the builder for updating a single ProviderAccount entity.
+type ProviderAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProviderAccountMutation
+}
+
+// SetName sets the "name" field.
+func (pauo *ProviderAccountUpdateOne) SetName(s string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetName(s)
+	return pauo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableName(s *string) *ProviderAccountUpdateOne {
+	if s != nil {
+		pauo.SetName(*s)
+	}
+	return pauo
+}
+
+// SetProvider sets the "provider" field.
+func (pauo *ProviderAccountUpdateOne) SetProvider(s string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetProvider(s)
+	return pauo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableProvider(s *string) *ProviderAccountUpdateOne {
+	if s != nil {
+		pauo.SetProvider(*s)
+	}
+	return pauo
+}
+
+// SetConfig sets the "config" field.
+func (pauo *ProviderAccountUpdateOne) SetConfig(m map[string]string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetConfig(m)
+	return pauo
+}
+
+// ClearConfig clears the value of the "config" field.
+func (pauo *ProviderAccountUpdateOne) ClearConfig() *ProviderAccountUpdateOne {
+	pauo.mutation.ClearConfig()
+	return pauo
+}
+
+// SetSecrets sets the "secrets" field.
+func (pauo *ProviderAccountUpdateOne) SetSecrets(mv map[string]schema.EncryptedValue) *ProviderAccountUpdateOne {
+	pauo.mutation.SetSecrets(mv)
+	return pauo
+}
+
+// ClearSecrets clears the value of the "secrets" field.
+func (pauo *ProviderAccountUpdateOne) ClearSecrets() *ProviderAccountUpdateOne {
+	pauo.mutation.ClearSecrets()
+	return pauo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (pauo *ProviderAccountUpdateOne) SetRotatedAt(t time.Time) *ProviderAccountUpdateOne {
+	pauo.mutation.SetRotatedAt(t)
+	return pauo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableRotatedAt(t *time.Time) *ProviderAccountUpdateOne {
+	if t != nil {
+		pauo.SetRotatedAt(*t)
+	}
+	return pauo
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (pauo *ProviderAccountUpdateOne) ClearRotatedAt() *ProviderAccountUpdateOne {
+	pauo.mutation.ClearRotatedAt()
+	return pauo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pauo *ProviderAccountUpdateOne) SetCreatedBy(s string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetCreatedBy(s)
+	return pauo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableCreatedBy(s *string) *ProviderAccountUpdateOne {
+	if s != nil {
+		pauo.SetCreatedBy(*s)
+	}
+	return pauo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (pauo *ProviderAccountUpdateOne) SetUpdatedBy(s string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetUpdatedBy(s)
+	return pauo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableUpdatedBy(s *string) *ProviderAccountUpdateOne {
+	if s != nil {
+		pauo.SetUpdatedBy(*s)
+	}
+	return pauo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pauo *ProviderAccountUpdateOne) SetUpdatedAt(t time.Time) *ProviderAccountUpdateOne {
+	pauo.mutation.SetUpdatedAt(t)
+	return pauo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (pauo *ProviderAccountUpdateOne) SetDeletedBy(s string) *ProviderAccountUpdateOne {
+	pauo.mutation.SetDeletedBy(s)
+	return pauo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableDeletedBy(s *string) *ProviderAccountUpdateOne {
+	if s != nil {
+		pauo.SetDeletedBy(*s)
+	}
+	return pauo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (pauo *ProviderAccountUpdateOne) ClearDeletedBy() *ProviderAccountUpdateOne {
+	pauo.mutation.ClearDeletedBy()
+	return pauo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (pauo *ProviderAccountUpdateOne) SetDeletedAt(t time.Time) *ProviderAccountUpdateOne {
+	pauo.mutation.SetDeletedAt(t)
+	return pauo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (pauo *ProviderAccountUpdateOne) SetNillableDeletedAt(t *time.Time) *ProviderAccountUpdateOne {
+	if t != nil {
+		pauo.SetDeletedAt(*t)
+	}
+	return pauo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (pauo *ProviderAccountUpdateOne) ClearDeletedAt() *ProviderAccountUpdateOne {
+	pauo.mutation.ClearDeletedAt()
+	return pauo
+}
+
+// Mutation returns the ProviderAccountMutation object of the builder.
+func (pauo *ProviderAccountUpdateOne) Mutation() *ProviderAccountMutation {
+	return pauo.mutation
+}
+
+// Where appends a list predicates to the ProviderAccountUpdate builder.
+func (pauo *ProviderAccountUpdateOne) Where(ps ...predicate.ProviderAccount) *ProviderAccountUpdateOne {
+	pauo.mutation.Where(ps...)
+	return pauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pauo *ProviderAccountUpdateOne) Select(field string, fields ...string) *ProviderAccountUpdateOne {
+	pauo.fields = append([]string{field}, fields...)
+	return pauo
+}
+
+// Save executes the query and returns the updated ProviderAccount entity.
+func (pauo *ProviderAccountUpdateOne) Save(ctx context.Context) (*ProviderAccount, error) {
+	pauo.defaults()
+	return withHooks(ctx, pauo.sqlSave, pauo.mutation, pauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pauo *ProviderAccountUpdateOne) SaveX(ctx context.Context) *ProviderAccount {
+	node, err := pauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pauo *ProviderAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := pauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pauo *ProviderAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := pauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pauo *ProviderAccountUpdateOne) defaults() {
+	if _, ok := pauo.mutation.UpdatedAt(); !ok {
+		v := provideraccount.UpdateDefaultUpdatedAt()
+		pauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pauo *ProviderAccountUpdateOne) check() error {
+	if v, ok := pauo.mutation.Name(); ok {
+		if err := provideraccount.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.name": %w`, err)}
+		}
+	}
+	if v, ok := pauo.mutation.Provider(); ok {
+		if err := provideraccount.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "ProviderAccount.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pauo *ProviderAccountUpdateOne) sqlSave(ctx context.Context) (_node *ProviderAccount, err error) {
+	if err := pauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(provideraccount.Table, provideraccount.Columns, sqlgraph.NewFieldSpec(provideraccount.FieldID, field.TypeUUID))
+	id, ok := pauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProviderAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, provideraccount.FieldID)
+		for _, f := range fields {
+			if !provideraccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != provideraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pauo.mutation.Name(); ok {
+		_spec.SetField(provideraccount.FieldName, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.Provider(); ok {
+		_spec.SetField(provideraccount.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.Config(); ok {
+		_spec.SetField(provideraccount.FieldConfig, field.TypeJSON, value)
+	}
+	if pauo.mutation.ConfigCleared() {
+		_spec.ClearField(provideraccount.FieldConfig, field.TypeJSON)
+	}
+	if value, ok := pauo.mutation.Secrets(); ok {
+		_spec.SetField(provideraccount.FieldSecrets, field.TypeJSON, value)
+	}
+	if pauo.mutation.SecretsCleared() {
+		_spec.ClearField(provideraccount.FieldSecrets, field.TypeJSON)
+	}
+	if value, ok := pauo.mutation.RotatedAt(); ok {
+		_spec.SetField(provideraccount.FieldRotatedAt, field.TypeTime, value)
+	}
+	if pauo.mutation.RotatedAtCleared() {
+		_spec.ClearField(provideraccount.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := pauo.mutation.CreatedBy(); ok {
+		_spec.SetField(provideraccount.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.UpdatedBy(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := pauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(provideraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pauo.mutation.DeletedBy(); ok {
+		_spec.SetField(provideraccount.FieldDeletedBy, field.TypeString, value)
+	}
+	if pauo.mutation.DeletedByCleared() {
+		_spec.ClearField(provideraccount.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := pauo.mutation.DeletedAt(); ok {
+		_spec.SetField(provideraccount.FieldDeletedAt, field.TypeTime, value)
+	}
+	if pauo.mutation.DeletedAtCleared() {
+		_spec.ClearField(provideraccount.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &ProviderAccount{config: pauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{provideraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pauo.mutation.done = true
+	return _node, nil
+}
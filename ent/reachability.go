@@ -0,0 +1,241 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/item"
+	"dig-inv/ent/reachability"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Reachability is the model entity for the Reachability schema.
+type Reachability struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the reachability.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Whether all probes of the item succeeded in their last run. An item is down as soon as one probe fails.
+	State reachability.State `json:"state,omitempty"`
+	// The time the item went down or came back up.
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// The last time any probe of the item succeeded. Empty if the item has never been up.
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReachabilityQuery when eager-loading is set.
+	Edges             ReachabilityEdges `json:"edges"`
+	item_reachability *uuid.UUID
+	selectValues      sql.SelectValues
+}
+
+// ReachabilityEdges holds the relations/edges for other nodes in the graph.
+type ReachabilityEdges struct {
+	// The probed item. Every item has at most one reachability.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReachabilityEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reachability) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reachability.FieldState, reachability.FieldCreatedBy, reachability.FieldUpdatedBy, reachability.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case reachability.FieldChangedAt, reachability.FieldLastSeenAt, reachability.FieldCreatedAt, reachability.FieldUpdatedAt, reachability.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case reachability.FieldID:
+			values[i] = new(uuid.UUID)
+		case reachability.ForeignKeys[0]: // item_reachability
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reachability fields.
+func (r *Reachability) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reachability.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				r.ID = *value
+			}
+		case reachability.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				r.State = reachability.State(value.String)
+			}
+		case reachability.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				r.ChangedAt = value.Time
+			}
+		case reachability.FieldLastSeenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_at", values[i])
+			} else if value.Valid {
+				r.LastSeenAt = new(time.Time)
+				*r.LastSeenAt = value.Time
+			}
+		case reachability.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				r.CreatedBy = value.String
+			}
+		case reachability.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		case reachability.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				r.UpdatedBy = value.String
+			}
+		case reachability.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				r.UpdatedAt = value.Time
+			}
+		case reachability.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				r.DeletedBy = value.String
+			}
+		case reachability.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				r.DeletedAt = new(time.Time)
+				*r.DeletedAt = value.Time
+			}
+		case reachability.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field item_reachability", values[i])
+			} else if value.Valid {
+				r.item_reachability = new(uuid.UUID)
+				*r.item_reachability = *value.S.(*uuid.UUID)
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reachability.
+// This includes values selected through modifiers, order, etc.
+func (r *Reachability) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the Reachability entity.
+func (r *Reachability) QueryItem() *ItemQuery {
+	return NewReachabilityClient(r.config).QueryItem(r)
+}
+
+// Update returns a builder for updating this Reachability.
+// Note that you need to call Reachability.Unwrap() before calling this method if this Reachability
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reachability) Update() *ReachabilityUpdateOne {
+	return NewReachabilityClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reachability entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reachability) Unwrap() *Reachability {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reachability is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reachability) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reachability(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", r.State))
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(r.ChangedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := r.LastSeenAt; v != nil {
+		builder.WriteString("last_seen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(r.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(r.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(r.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(r.DeletedBy)
+	builder.WriteString(", ")
+	if v := r.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reachabilities is a parsable slice of Reachability.
+type Reachabilities []*Reachability
@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/item"
+	"dig-inv/ent/reachability"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReachabilityCreate is the builder for creating a Reachability entity.
+type ReachabilityCreate struct {
+	config
+	mutation *ReachabilityMutation
+	hooks    []Hook
+}
+
+// SetState sets the "state" field.
+func (rc *ReachabilityCreate) SetState(r reachability.State) *ReachabilityCreate {
+	rc.mutation.SetState(r)
+	return rc
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (rc *ReachabilityCreate) SetChangedAt(t time.Time) *ReachabilityCreate {
+	rc.mutation.SetChangedAt(t)
+	return rc
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (rc *ReachabilityCreate) SetLastSeenAt(t time.Time) *ReachabilityCreate {
+	rc.mutation.SetLastSeenAt(t)
+	return rc
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableLastSeenAt(t *time.Time) *ReachabilityCreate {
+	if t != nil {
+		rc.SetLastSeenAt(*t)
+	}
+	return rc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rc *ReachabilityCreate) SetCreatedBy(s string) *ReachabilityCreate {
+	rc.mutation.SetCreatedBy(s)
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReachabilityCreate) SetCreatedAt(t time.Time) *ReachabilityCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableCreatedAt(t *time.Time) *ReachabilityCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rc *ReachabilityCreate) SetUpdatedBy(s string) *ReachabilityCreate {
+	rc.mutation.SetUpdatedBy(s)
+	return rc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rc *ReachabilityCreate) SetUpdatedAt(t time.Time) *ReachabilityCreate {
+	rc.mutation.SetUpdatedAt(t)
+	return rc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableUpdatedAt(t *time.Time) *ReachabilityCreate {
+	if t != nil {
+		rc.SetUpdatedAt(*t)
+	}
+	return rc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (rc *ReachabilityCreate) SetDeletedBy(s string) *ReachabilityCreate {
+	rc.mutation.SetDeletedBy(s)
+	return rc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableDeletedBy(s *string) *ReachabilityCreate {
+	if s != nil {
+		rc.SetDeletedBy(*s)
+	}
+	return rc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rc *ReachabilityCreate) SetDeletedAt(t time.Time) *ReachabilityCreate {
+	rc.mutation.SetDeletedAt(t)
+	return rc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableDeletedAt(t *time.Time) *ReachabilityCreate {
+	if t != nil {
+		rc.SetDeletedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReachabilityCreate) SetID(u uuid.UUID) *ReachabilityCreate {
+	rc.mutation.SetID(u)
+	return rc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rc *ReachabilityCreate) SetNillableID(u *uuid.UUID) *ReachabilityCreate {
+	if u != nil {
+		rc.SetID(*u)
+	}
+	return rc
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (rc *ReachabilityCreate) SetItemID(id uuid.UUID) *ReachabilityCreate {
+	rc.mutation.SetItemID(id)
+	return rc
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (rc *ReachabilityCreate) SetItem(i *Item) *ReachabilityCreate {
+	return rc.SetItemID(i.ID)
+}
+
+// Mutation returns the ReachabilityMutation object of the builder.
+func (rc *ReachabilityCreate) Mutation() *ReachabilityMutation {
+	return rc.mutation
+}
+
+// Save creates the Reachability in the database.
+func (rc *ReachabilityCreate) Save(ctx context.Context) (*Reachability, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReachabilityCreate) SaveX(ctx context.Context) *Reachability {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReachabilityCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReachabilityCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReachabilityCreate) defaults() {
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reachability.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		v := reachability.DefaultUpdatedAt()
+		rc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rc.mutation.ID(); !ok {
+		v := reachability.DefaultID()
+		rc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReachabilityCreate) check() error {
+	if _, ok := rc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "Reachability.state"`)}
+	}
+	if v, ok := rc.mutation.State(); ok {
+		if err := reachability.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Reachability.state": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "Reachability.changed_at"`)}
+	}
+	if _, ok := rc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Reachability.created_by"`)}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reachability.created_at"`)}
+	}
+	if _, ok := rc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "Reachability.updated_by"`)}
+	}
+	if _, ok := rc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Reachability.updated_at"`)}
+	}
+	if len(rc.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Reachability.item"`)}
+	}
+	return nil
+}
+
+func (rc *ReachabilityCreate) sqlSave(ctx context.Context) (*Reachability, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReachabilityCreate) createSpec() (*Reachability, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reachability{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reachability.Table, sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rc.mutation.State(); ok {
+		_spec.SetField(reachability.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := rc.mutation.ChangedAt(); ok {
+		_spec.SetField(reachability.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if value, ok := rc.mutation.LastSeenAt(); ok {
+		_spec.SetField(reachability.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = &value
+	}
+	if value, ok := rc.mutation.CreatedBy(); ok {
+		_spec.SetField(reachability.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reachability.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rc.mutation.UpdatedBy(); ok {
+		_spec.SetField(reachability.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reachability.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rc.mutation.DeletedBy(); ok {
+		_spec.SetField(reachability.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := rc.mutation.DeletedAt(); ok {
+		_spec.SetField(reachability.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := rc.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   reachability.ItemTable,
+			Columns: []string{reachability.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.item_reachability = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReachabilityCreateBulk is the builder for creating many Reachability entities in bulk.
+type ReachabilityCreateBulk struct {
+	config
+	err      error
+	builders []*ReachabilityCreate
+}
+
+// Save creates the Reachability entities in the database.
+func (rcb *ReachabilityCreateBulk) Save(ctx context.Context) ([]*Reachability, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reachability, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReachabilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReachabilityCreateBulk) SaveX(ctx context.Context) []*Reachability {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReachabilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReachabilityCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,536 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/reachability"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReachabilityUpdate is the builder for updating Reachability entities.
+type ReachabilityUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReachabilityMutation
+}
+
+// Where appends a list predicates to the ReachabilityUpdate builder.
+func (ru *ReachabilityUpdate) Where(ps ...predicate.Reachability) *ReachabilityUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetState sets the "state" field.
+func (ru *ReachabilityUpdate) SetState(r reachability.State) *ReachabilityUpdate {
+	ru.mutation.SetState(r)
+	return ru
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableState(r *reachability.State) *ReachabilityUpdate {
+	if r != nil {
+		ru.SetState(*r)
+	}
+	return ru
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (ru *ReachabilityUpdate) SetChangedAt(t time.Time) *ReachabilityUpdate {
+	ru.mutation.SetChangedAt(t)
+	return ru
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableChangedAt(t *time.Time) *ReachabilityUpdate {
+	if t != nil {
+		ru.SetChangedAt(*t)
+	}
+	return ru
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (ru *ReachabilityUpdate) SetLastSeenAt(t time.Time) *ReachabilityUpdate {
+	ru.mutation.SetLastSeenAt(t)
+	return ru
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableLastSeenAt(t *time.Time) *ReachabilityUpdate {
+	if t != nil {
+		ru.SetLastSeenAt(*t)
+	}
+	return ru
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (ru *ReachabilityUpdate) ClearLastSeenAt() *ReachabilityUpdate {
+	ru.mutation.ClearLastSeenAt()
+	return ru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ru *ReachabilityUpdate) SetCreatedBy(s string) *ReachabilityUpdate {
+	ru.mutation.SetCreatedBy(s)
+	return ru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableCreatedBy(s *string) *ReachabilityUpdate {
+	if s != nil {
+		ru.SetCreatedBy(*s)
+	}
+	return ru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ru *ReachabilityUpdate) SetUpdatedBy(s string) *ReachabilityUpdate {
+	ru.mutation.SetUpdatedBy(s)
+	return ru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableUpdatedBy(s *string) *ReachabilityUpdate {
+	if s != nil {
+		ru.SetUpdatedBy(*s)
+	}
+	return ru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ru *ReachabilityUpdate) SetUpdatedAt(t time.Time) *ReachabilityUpdate {
+	ru.mutation.SetUpdatedAt(t)
+	return ru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ru *ReachabilityUpdate) SetDeletedBy(s string) *ReachabilityUpdate {
+	ru.mutation.SetDeletedBy(s)
+	return ru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableDeletedBy(s *string) *ReachabilityUpdate {
+	if s != nil {
+		ru.SetDeletedBy(*s)
+	}
+	return ru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ru *ReachabilityUpdate) ClearDeletedBy() *ReachabilityUpdate {
+	ru.mutation.ClearDeletedBy()
+	return ru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ru *ReachabilityUpdate) SetDeletedAt(t time.Time) *ReachabilityUpdate {
+	ru.mutation.SetDeletedAt(t)
+	return ru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ru *ReachabilityUpdate) SetNillableDeletedAt(t *time.Time) *ReachabilityUpdate {
+	if t != nil {
+		ru.SetDeletedAt(*t)
+	}
+	return ru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ru *ReachabilityUpdate) ClearDeletedAt() *ReachabilityUpdate {
+	ru.mutation.ClearDeletedAt()
+	return ru
+}
+
+// Mutation returns the ReachabilityMutation object of the builder.
+func (ru *ReachabilityUpdate) Mutation() *ReachabilityMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReachabilityUpdate) Save(ctx context.Context) (int, error) {
+	ru.defaults()
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReachabilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReachabilityUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReachabilityUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ru *ReachabilityUpdate) defaults() {
+	if _, ok := ru.mutation.UpdatedAt(); !ok {
+		v := reachability.UpdateDefaultUpdatedAt()
+		ru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReachabilityUpdate) check() error {
+	if v, ok := ru.mutation.State(); ok {
+		if err := reachability.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Reachability.state": %w`, err)}
+		}
+	}
+	if ru.mutation.ItemCleared() && len(ru.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reachability.item"`)
+	}
+	return nil
+}
+
+func (ru *ReachabilityUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reachability.Table, reachability.Columns, sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.State(); ok {
+		_spec.SetField(reachability.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := ru.mutation.ChangedAt(); ok {
+		_spec.SetField(reachability.FieldChangedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.LastSeenAt(); ok {
+		_spec.SetField(reachability.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if ru.mutation.LastSeenAtCleared() {
+		_spec.ClearField(reachability.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := ru.mutation.CreatedBy(); ok {
+		_spec.SetField(reachability.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedBy(); ok {
+		_spec.SetField(reachability.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reachability.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ru.mutation.DeletedBy(); ok {
+		_spec.SetField(reachability.FieldDeletedBy, field.TypeString, value)
+	}
+	if ru.mutation.DeletedByCleared() {
+		_spec.ClearField(reachability.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ru.mutation.DeletedAt(); ok {
+		_spec.SetField(reachability.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ru.mutation.DeletedAtCleared() {
+		_spec.ClearField(reachability.FieldDeletedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reachability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReachabilityUpdateOne is the builder for updating a single Reachability entity.
+type ReachabilityUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReachabilityMutation
+}
+
+// SetState sets the "state" field.
+func (ruo *ReachabilityUpdateOne) SetState(r reachability.State) *ReachabilityUpdateOne {
+	ruo.mutation.SetState(r)
+	return ruo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableState(r *reachability.State) *ReachabilityUpdateOne {
+	if r != nil {
+		ruo.SetState(*r)
+	}
+	return ruo
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (ruo *ReachabilityUpdateOne) SetChangedAt(t time.Time) *ReachabilityUpdateOne {
+	ruo.mutation.SetChangedAt(t)
+	return ruo
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableChangedAt(t *time.Time) *ReachabilityUpdateOne {
+	if t != nil {
+		ruo.SetChangedAt(*t)
+	}
+	return ruo
+}
+
+// SetLastSeenAt sets the "last_seen_at" field.
+func (ruo *ReachabilityUpdateOne) SetLastSeenAt(t time.Time) *ReachabilityUpdateOne {
+	ruo.mutation.SetLastSeenAt(t)
+	return ruo
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableLastSeenAt(t *time.Time) *ReachabilityUpdateOne {
+	if t != nil {
+		ruo.SetLastSeenAt(*t)
+	}
+	return ruo
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (ruo *ReachabilityUpdateOne) ClearLastSeenAt() *ReachabilityUpdateOne {
+	ruo.mutation.ClearLastSeenAt()
+	return ruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (ruo *ReachabilityUpdateOne) SetCreatedBy(s string) *ReachabilityUpdateOne {
+	ruo.mutation.SetCreatedBy(s)
+	return ruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableCreatedBy(s *string) *ReachabilityUpdateOne {
+	if s != nil {
+		ruo.SetCreatedBy(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (ruo *ReachabilityUpdateOne) SetUpdatedBy(s string) *ReachabilityUpdateOne {
+	ruo.mutation.SetUpdatedBy(s)
+	return ruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableUpdatedBy(s *string) *ReachabilityUpdateOne {
+	if s != nil {
+		ruo.SetUpdatedBy(*s)
+	}
+	return ruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ruo *ReachabilityUpdateOne) SetUpdatedAt(t time.Time) *ReachabilityUpdateOne {
+	ruo.mutation.SetUpdatedAt(t)
+	return ruo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (ruo *ReachabilityUpdateOne) SetDeletedBy(s string) *ReachabilityUpdateOne {
+	ruo.mutation.SetDeletedBy(s)
+	return ruo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableDeletedBy(s *string) *ReachabilityUpdateOne {
+	if s != nil {
+		ruo.SetDeletedBy(*s)
+	}
+	return ruo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (ruo *ReachabilityUpdateOne) ClearDeletedBy() *ReachabilityUpdateOne {
+	ruo.mutation.ClearDeletedBy()
+	return ruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (ruo *ReachabilityUpdateOne) SetDeletedAt(t time.Time) *ReachabilityUpdateOne {
+	ruo.mutation.SetDeletedAt(t)
+	return ruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ruo *ReachabilityUpdateOne) SetNillableDeletedAt(t *time.Time) *ReachabilityUpdateOne {
+	if t != nil {
+		ruo.SetDeletedAt(*t)
+	}
+	return ruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (ruo *ReachabilityUpdateOne) ClearDeletedAt() *ReachabilityUpdateOne {
+	ruo.mutation.ClearDeletedAt()
+	return ruo
+}
+
+// Mutation returns the ReachabilityMutation object of the builder.
+func (ruo *ReachabilityUpdateOne) Mutation() *ReachabilityMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReachabilityUpdate builder.
+func (ruo *ReachabilityUpdateOne) Where(ps ...predicate.Reachability) *ReachabilityUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReachabilityUpdateOne) Select(field string, fields ...string) *ReachabilityUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reachability entity.
+func (ruo *ReachabilityUpdateOne) Save(ctx context.Context) (*Reachability, error) {
+	ruo.defaults()
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReachabilityUpdateOne) SaveX(ctx context.Context) *Reachability {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReachabilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReachabilityUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ruo *ReachabilityUpdateOne) defaults() {
+	if _, ok := ruo.mutation.UpdatedAt(); !ok {
+		v := reachability.UpdateDefaultUpdatedAt()
+		ruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReachabilityUpdateOne) check() error {
+	if v, ok := ruo.mutation.State(); ok {
+		if err := reachability.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Reachability.state": %w`, err)}
+		}
+	}
+	if ruo.mutation.ItemCleared() && len(ruo.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reachability.item"`)
+	}
+	return nil
+}
+
+func (ruo *ReachabilityUpdateOne) sqlSave(ctx context.Context) (_node *Reachability, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reachability.Table, reachability.Columns, sqlgraph.NewFieldSpec(reachability.FieldID, field.TypeUUID))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reachability.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reachability.FieldID)
+		for _, f := range fields {
+			if !reachability.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reachability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.State(); ok {
+		_spec.SetField(reachability.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := ruo.mutation.ChangedAt(); ok {
+		_spec.SetField(reachability.FieldChangedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.LastSeenAt(); ok {
+		_spec.SetField(reachability.FieldLastSeenAt, field.TypeTime, value)
+	}
+	if ruo.mutation.LastSeenAtCleared() {
+		_spec.ClearField(reachability.FieldLastSeenAt, field.TypeTime)
+	}
+	if value, ok := ruo.mutation.CreatedBy(); ok {
+		_spec.SetField(reachability.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(reachability.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reachability.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ruo.mutation.DeletedBy(); ok {
+		_spec.SetField(reachability.FieldDeletedBy, field.TypeString, value)
+	}
+	if ruo.mutation.DeletedByCleared() {
+		_spec.ClearField(reachability.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := ruo.mutation.DeletedAt(); ok {
+		_spec.SetField(reachability.FieldDeletedAt, field.TypeTime, value)
+	}
+	if ruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(reachability.FieldDeletedAt, field.TypeTime)
+	}
+	_node = &Reachability{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reachability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
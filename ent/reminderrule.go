@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/reminderrule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ReminderRule is the model entity for the ReminderRule schema.
+type ReminderRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the rule.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The key of the date attribute the rule watches. Rules without a key watch all date attributes of the asset class.
+	AttributeKey string `json:"attribute_key,omitempty"`
+	// The number of days before the date at which a reminder is sent, e.g. [60, 30, 7].
+	Days []int `json:"days,omitempty"`
+	// Whether reminders are sent for the rule.
+	Enabled bool `json:"enabled,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderRuleQuery when eager-loading is set.
+	Edges                     ReminderRuleEdges `json:"edges"`
+	reminder_rule_asset_class *uuid.UUID
+	selectValues              sql.SelectValues
+}
+
+// ReminderRuleEdges holds the relations/edges for other nodes in the graph.
+type ReminderRuleEdges struct {
+	// The asset class whose items are watched.
+	AssetClass *AssetClass `json:"asset_class,omitempty"`
+	// The channels reminders are sent to.
+	Channels []*NotificationChannel `json:"channels,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AssetClassOrErr returns the AssetClass value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderRuleEdges) AssetClassOrErr() (*AssetClass, error) {
+	if e.AssetClass != nil {
+		return e.AssetClass, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: assetclass.Label}
+	}
+	return nil, &NotLoadedError{edge: "asset_class"}
+}
+
+// ChannelsOrErr returns the Channels value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReminderRuleEdges) ChannelsOrErr() ([]*NotificationChannel, error) {
+	if e.loadedTypes[1] {
+		return e.Channels, nil
+	}
+	return nil, &NotLoadedError{edge: "channels"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReminderRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminderrule.FieldDays:
+			values[i] = new([]byte)
+		case reminderrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case reminderrule.FieldAttributeKey, reminderrule.FieldCreatedBy, reminderrule.FieldUpdatedBy, reminderrule.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case reminderrule.FieldCreatedAt, reminderrule.FieldUpdatedAt, reminderrule.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case reminderrule.FieldID:
+			values[i] = new(uuid.UUID)
+		case reminderrule.ForeignKeys[0]: // reminder_rule_asset_class
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReminderRule fields.
+func (rr *ReminderRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminderrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rr.ID = *value
+			}
+		case reminderrule.FieldAttributeKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attribute_key", values[i])
+			} else if value.Valid {
+				rr.AttributeKey = value.String
+			}
+		case reminderrule.FieldDays:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field days", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rr.Days); err != nil {
+					return fmt.Errorf("unmarshal field days: %w", err)
+				}
+			}
+		case reminderrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				rr.Enabled = value.Bool
+			}
+		case reminderrule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				rr.CreatedBy = value.String
+			}
+		case reminderrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case reminderrule.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				rr.UpdatedBy = value.String
+			}
+		case reminderrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		case reminderrule.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				rr.DeletedBy = value.String
+			}
+		case reminderrule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				rr.DeletedAt = new(time.Time)
+				*rr.DeletedAt = value.Time
+			}
+		case reminderrule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_rule_asset_class", values[i])
+			} else if value.Valid {
+				rr.reminder_rule_asset_class = new(uuid.UUID)
+				*rr.reminder_rule_asset_class = *value.S.(*uuid.UUID)
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReminderRule.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReminderRule) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// QueryAssetClass queries the "asset_class" edge of the ReminderRule entity.
+func (rr *ReminderRule) QueryAssetClass() *AssetClassQuery {
+	return NewReminderRuleClient(rr.config).QueryAssetClass(rr)
+}
+
+// QueryChannels queries the "channels" edge of the ReminderRule entity.
+func (rr *ReminderRule) QueryChannels() *NotificationChannelQuery {
+	return NewReminderRuleClient(rr.config).QueryChannels(rr)
+}
+
+// Update returns a builder for updating this ReminderRule.
+// Note that you need to call ReminderRule.Unwrap() before calling this method if this ReminderRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReminderRule) Update() *ReminderRuleUpdateOne {
+	return NewReminderRuleClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReminderRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReminderRule) Unwrap() *ReminderRule {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReminderRule is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReminderRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReminderRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("attribute_key=")
+	builder.WriteString(rr.AttributeKey)
+	builder.WriteString(", ")
+	builder.WriteString("days=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Days))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(rr.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(rr.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(rr.DeletedBy)
+	builder.WriteString(", ")
+	if v := rr.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReminderRules is a parsable slice of ReminderRule.
+type ReminderRules []*ReminderRule
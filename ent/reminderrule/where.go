@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reminderrule
+
+import (
+	"dig-inv/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldID, id))
+}
+
+// AttributeKey applies equality check predicate on the "attribute_key" field. It's identical to AttributeKeyEQ.
+func AttributeKey(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldAttributeKey, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// AttributeKeyEQ applies the EQ predicate on the "attribute_key" field.
+func AttributeKeyEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldAttributeKey, v))
+}
+
+// AttributeKeyNEQ applies the NEQ predicate on the "attribute_key" field.
+func AttributeKeyNEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldAttributeKey, v))
+}
+
+// AttributeKeyIn applies the In predicate on the "attribute_key" field.
+func AttributeKeyIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldAttributeKey, vs...))
+}
+
+// AttributeKeyNotIn applies the NotIn predicate on the "attribute_key" field.
+func AttributeKeyNotIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldAttributeKey, vs...))
+}
+
+// AttributeKeyGT applies the GT predicate on the "attribute_key" field.
+func AttributeKeyGT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldAttributeKey, v))
+}
+
+// AttributeKeyGTE applies the GTE predicate on the "attribute_key" field.
+func AttributeKeyGTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldAttributeKey, v))
+}
+
+// AttributeKeyLT applies the LT predicate on the "attribute_key" field.
+func AttributeKeyLT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldAttributeKey, v))
+}
+
+// AttributeKeyLTE applies the LTE predicate on the "attribute_key" field.
+func AttributeKeyLTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldAttributeKey, v))
+}
+
+// AttributeKeyContains applies the Contains predicate on the "attribute_key" field.
+func AttributeKeyContains(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContains(FieldAttributeKey, v))
+}
+
+// AttributeKeyHasPrefix applies the HasPrefix predicate on the "attribute_key" field.
+func AttributeKeyHasPrefix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasPrefix(FieldAttributeKey, v))
+}
+
+// AttributeKeyHasSuffix applies the HasSuffix predicate on the "attribute_key" field.
+func AttributeKeyHasSuffix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasSuffix(FieldAttributeKey, v))
+}
+
+// AttributeKeyIsNil applies the IsNil predicate on the "attribute_key" field.
+func AttributeKeyIsNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIsNull(FieldAttributeKey))
+}
+
+// AttributeKeyNotNil applies the NotNil predicate on the "attribute_key" field.
+func AttributeKeyNotNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotNull(FieldAttributeKey))
+}
+
+// AttributeKeyEqualFold applies the EqualFold predicate on the "attribute_key" field.
+func AttributeKeyEqualFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEqualFold(FieldAttributeKey, v))
+}
+
+// AttributeKeyContainsFold applies the ContainsFold predicate on the "attribute_key" field.
+func AttributeKeyContainsFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContainsFold(FieldAttributeKey, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByContains applies the Contains predicate on the "updated_by" field.
+func UpdatedByContains(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContains(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasPrefix applies the HasPrefix predicate on the "updated_by" field.
+func UpdatedByHasPrefix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasPrefix(FieldUpdatedBy, v))
+}
+
+// UpdatedByHasSuffix applies the HasSuffix predicate on the "updated_by" field.
+func UpdatedByHasSuffix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasSuffix(FieldUpdatedBy, v))
+}
+
+// UpdatedByEqualFold applies the EqualFold predicate on the "updated_by" field.
+func UpdatedByEqualFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEqualFold(FieldUpdatedBy, v))
+}
+
+// UpdatedByContainsFold applies the ContainsFold predicate on the "updated_by" field.
+func UpdatedByContainsFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContainsFold(FieldUpdatedBy, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ReminderRule {
+	return predicate.ReminderRule(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// HasAssetClass applies the HasEdge predicate on the "asset_class" edge.
+func HasAssetClass() predicate.ReminderRule {
+	return predicate.ReminderRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, AssetClassTable, AssetClassColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAssetClassWith applies the HasEdge predicate on the "asset_class" edge with a given conditions (other predicates).
+func HasAssetClassWith(preds ...predicate.AssetClass) predicate.ReminderRule {
+	return predicate.ReminderRule(func(s *sql.Selector) {
+		step := newAssetClassStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChannels applies the HasEdge predicate on the "channels" edge.
+func HasChannels() predicate.ReminderRule {
+	return predicate.ReminderRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChannelsTable, ChannelsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChannelsWith applies the HasEdge predicate on the "channels" edge with a given conditions (other predicates).
+func HasChannelsWith(preds ...predicate.NotificationChannel) predicate.ReminderRule {
+	return predicate.ReminderRule(func(s *sql.Selector) {
+		step := newChannelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReminderRule) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReminderRule) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReminderRule) predicate.ReminderRule {
+	return predicate.ReminderRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/reminderrule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderRuleCreate is the builder for creating a ReminderRule entity.
+type ReminderRuleCreate struct {
+	config
+	mutation *ReminderRuleMutation
+	hooks    []Hook
+}
+
+// SetAttributeKey sets the "attribute_key" field.
+func (rrc *ReminderRuleCreate) SetAttributeKey(s string) *ReminderRuleCreate {
+	rrc.mutation.SetAttributeKey(s)
+	return rrc
+}
+
+// SetNillableAttributeKey sets the "attribute_key" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableAttributeKey(s *string) *ReminderRuleCreate {
+	if s != nil {
+		rrc.SetAttributeKey(*s)
+	}
+	return rrc
+}
+
+// SetDays sets the "days" field.
+func (rrc *ReminderRuleCreate) SetDays(i []int) *ReminderRuleCreate {
+	rrc.mutation.SetDays(i)
+	return rrc
+}
+
+// SetEnabled sets the "enabled" field.
+func (rrc *ReminderRuleCreate) SetEnabled(b bool) *ReminderRuleCreate {
+	rrc.mutation.SetEnabled(b)
+	return rrc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableEnabled(b *bool) *ReminderRuleCreate {
+	if b != nil {
+		rrc.SetEnabled(*b)
+	}
+	return rrc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rrc *ReminderRuleCreate) SetCreatedBy(s string) *ReminderRuleCreate {
+	rrc.mutation.SetCreatedBy(s)
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReminderRuleCreate) SetCreatedAt(t time.Time) *ReminderRuleCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableCreatedAt(t *time.Time) *ReminderRuleCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rrc *ReminderRuleCreate) SetUpdatedBy(s string) *ReminderRuleCreate {
+	rrc.mutation.SetUpdatedBy(s)
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *ReminderRuleCreate) SetUpdatedAt(t time.Time) *ReminderRuleCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableUpdatedAt(t *time.Time) *ReminderRuleCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (rrc *ReminderRuleCreate) SetDeletedBy(s string) *ReminderRuleCreate {
+	rrc.mutation.SetDeletedBy(s)
+	return rrc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableDeletedBy(s *string) *ReminderRuleCreate {
+	if s != nil {
+		rrc.SetDeletedBy(*s)
+	}
+	return rrc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rrc *ReminderRuleCreate) SetDeletedAt(t time.Time) *ReminderRuleCreate {
+	rrc.mutation.SetDeletedAt(t)
+	return rrc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableDeletedAt(t *time.Time) *ReminderRuleCreate {
+	if t != nil {
+		rrc.SetDeletedAt(*t)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReminderRuleCreate) SetID(u uuid.UUID) *ReminderRuleCreate {
+	rrc.mutation.SetID(u)
+	return rrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (rrc *ReminderRuleCreate) SetNillableID(u *uuid.UUID) *ReminderRuleCreate {
+	if u != nil {
+		rrc.SetID(*u)
+	}
+	return rrc
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (rrc *ReminderRuleCreate) SetAssetClassID(id uuid.UUID) *ReminderRuleCreate {
+	rrc.mutation.SetAssetClassID(id)
+	return rrc
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (rrc *ReminderRuleCreate) SetAssetClass(a *AssetClass) *ReminderRuleCreate {
+	return rrc.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (rrc *ReminderRuleCreate) AddChannelIDs(ids ...uuid.UUID) *ReminderRuleCreate {
+	rrc.mutation.AddChannelIDs(ids...)
+	return rrc
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (rrc *ReminderRuleCreate) AddChannels(n ...*NotificationChannel) *ReminderRuleCreate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return rrc.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ReminderRuleMutation object of the builder.
+func (rrc *ReminderRuleCreate) Mutation() *ReminderRuleMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReminderRule in the database.
+func (rrc *ReminderRuleCreate) Save(ctx context.Context) (*ReminderRule, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReminderRuleCreate) SaveX(ctx context.Context) *ReminderRule {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReminderRuleCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReminderRuleCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReminderRuleCreate) defaults() {
+	if _, ok := rrc.mutation.Enabled(); !ok {
+		v := reminderrule.DefaultEnabled
+		rrc.mutation.SetEnabled(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := reminderrule.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := reminderrule.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rrc.mutation.ID(); !ok {
+		v := reminderrule.DefaultID()
+		rrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReminderRuleCreate) check() error {
+	if _, ok := rrc.mutation.Days(); !ok {
+		return &ValidationError{Name: "days", err: errors.New(`ent: missing required field "ReminderRule.days"`)}
+	}
+	if _, ok := rrc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "ReminderRule.enabled"`)}
+	}
+	if _, ok := rrc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "ReminderRule.created_by"`)}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReminderRule.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "ReminderRule.updated_by"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReminderRule.updated_at"`)}
+	}
+	if len(rrc.mutation.AssetClassIDs()) == 0 {
+		return &ValidationError{Name: "asset_class", err: errors.New(`ent: missing required edge "ReminderRule.asset_class"`)}
+	}
+	return nil
+}
+
+func (rrc *ReminderRuleCreate) sqlSave(ctx context.Context) (*ReminderRule, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReminderRuleCreate) createSpec() (*ReminderRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReminderRule{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(reminderrule.Table, sqlgraph.NewFieldSpec(reminderrule.FieldID, field.TypeUUID))
+	)
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rrc.mutation.AttributeKey(); ok {
+		_spec.SetField(reminderrule.FieldAttributeKey, field.TypeString, value)
+		_node.AttributeKey = value
+	}
+	if value, ok := rrc.mutation.Days(); ok {
+		_spec.SetField(reminderrule.FieldDays, field.TypeJSON, value)
+		_node.Days = value
+	}
+	if value, ok := rrc.mutation.Enabled(); ok {
+		_spec.SetField(reminderrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := rrc.mutation.CreatedBy(); ok {
+		_spec.SetField(reminderrule.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(reminderrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedBy(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rrc.mutation.DeletedBy(); ok {
+		_spec.SetField(reminderrule.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := rrc.mutation.DeletedAt(); ok {
+		_spec.SetField(reminderrule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := rrc.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reminderrule.AssetClassTable,
+			Columns: []string{reminderrule.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.reminder_rule_asset_class = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rrc.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReminderRuleCreateBulk is the builder for creating many ReminderRule entities in bulk.
+type ReminderRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderRuleCreate
+}
+
+// Save creates the ReminderRule entities in the database.
+func (rrcb *ReminderRuleCreateBulk) Save(ctx context.Context) ([]*ReminderRule, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReminderRule, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReminderRuleCreateBulk) SaveX(ctx context.Context) []*ReminderRule {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReminderRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReminderRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,691 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/reminderrule"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderRuleQuery is the builder for querying ReminderRule entities.
+type ReminderRuleQuery struct {
+	config
+	ctx            *QueryContext
+	order          []reminderrule.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.ReminderRule
+	withAssetClass *AssetClassQuery
+	withChannels   *NotificationChannelQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReminderRuleQuery builder.
+func (rrq *ReminderRuleQuery) Where(ps ...predicate.ReminderRule) *ReminderRuleQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *ReminderRuleQuery) Limit(limit int) *ReminderRuleQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *ReminderRuleQuery) Offset(offset int) *ReminderRuleQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *ReminderRuleQuery) Unique(unique bool) *ReminderRuleQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *ReminderRuleQuery) Order(o ...reminderrule.OrderOption) *ReminderRuleQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// QueryAssetClass chains the current query on the "asset_class" edge.
+func (rrq *ReminderRuleQuery) QueryAssetClass() *AssetClassQuery {
+	query := (&AssetClassClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderrule.Table, reminderrule.FieldID, selector),
+			sqlgraph.To(assetclass.Table, assetclass.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reminderrule.AssetClassTable, reminderrule.AssetClassColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChannels chains the current query on the "channels" edge.
+func (rrq *ReminderRuleQuery) QueryChannels() *NotificationChannelQuery {
+	query := (&NotificationChannelClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminderrule.Table, reminderrule.FieldID, selector),
+			sqlgraph.To(notificationchannel.Table, notificationchannel.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, reminderrule.ChannelsTable, reminderrule.ChannelsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReminderRule entity from the query.
+// Returns a *NotFoundError when no ReminderRule was found.
+func (rrq *ReminderRuleQuery) First(ctx context.Context) (*ReminderRule, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{reminderrule.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) FirstX(ctx context.Context) *ReminderRule {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReminderRule ID from the query.
+// Returns a *NotFoundError when no ReminderRule ID was found.
+func (rrq *ReminderRuleQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{reminderrule.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReminderRule entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReminderRule entity is found.
+// Returns a *NotFoundError when no ReminderRule entities are found.
+func (rrq *ReminderRuleQuery) Only(ctx context.Context) (*ReminderRule, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{reminderrule.Label}
+	default:
+		return nil, &NotSingularError{reminderrule.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) OnlyX(ctx context.Context) *ReminderRule {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReminderRule ID in the query.
+// Returns a *NotSingularError when more than one ReminderRule ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *ReminderRuleQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{reminderrule.Label}
+	default:
+		err = &NotSingularError{reminderrule.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReminderRules.
+func (rrq *ReminderRuleQuery) All(ctx context.Context) ([]*ReminderRule, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryAll)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReminderRule, *ReminderRuleQuery]()
+	return withInterceptors[[]*ReminderRule](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) AllX(ctx context.Context) []*ReminderRule {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReminderRule IDs.
+func (rrq *ReminderRuleQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryIDs)
+	if err = rrq.Select(reminderrule.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *ReminderRuleQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryCount)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*ReminderRuleQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *ReminderRuleQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryExist)
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *ReminderRuleQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReminderRuleQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *ReminderRuleQuery) Clone() *ReminderRuleQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &ReminderRuleQuery{
+		config:         rrq.config,
+		ctx:            rrq.ctx.Clone(),
+		order:          append([]reminderrule.OrderOption{}, rrq.order...),
+		inters:         append([]Interceptor{}, rrq.inters...),
+		predicates:     append([]predicate.ReminderRule{}, rrq.predicates...),
+		withAssetClass: rrq.withAssetClass.Clone(),
+		withChannels:   rrq.withChannels.Clone(),
+		// clone intermediate query.
+		sql:  rrq.sql.Clone(),
+		path: rrq.path,
+	}
+}
+
+// WithAssetClass tells the query-builder to eager-load the nodes that are connected to
+// the "asset_class" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ReminderRuleQuery) WithAssetClass(opts ...func(*AssetClassQuery)) *ReminderRuleQuery {
+	query := (&AssetClassClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withAssetClass = query
+	return rrq
+}
+
+// WithChannels tells the query-builder to eager-load the nodes that are connected to
+// the "channels" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ReminderRuleQuery) WithChannels(opts ...func(*NotificationChannelQuery)) *ReminderRuleQuery {
+	query := (&NotificationChannelClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withChannels = query
+	return rrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AttributeKey string `json:"attribute_key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReminderRule.Query().
+//		GroupBy(reminderrule.FieldAttributeKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *ReminderRuleQuery) GroupBy(field string, fields ...string) *ReminderRuleGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReminderRuleGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = reminderrule.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AttributeKey string `json:"attribute_key,omitempty"`
+//	}
+//
+//	client.ReminderRule.Query().
+//		Select(reminderrule.FieldAttributeKey).
+//		Scan(ctx, &v)
+func (rrq *ReminderRuleQuery) Select(fields ...string) *ReminderRuleSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &ReminderRuleSelect{ReminderRuleQuery: rrq}
+	sbuild.label = reminderrule.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReminderRuleSelect configured with the given aggregations.
+func (rrq *ReminderRuleQuery) Aggregate(fns ...AggregateFunc) *ReminderRuleSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *ReminderRuleQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !reminderrule.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *ReminderRuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReminderRule, error) {
+	var (
+		nodes       = []*ReminderRule{}
+		withFKs     = rrq.withFKs
+		_spec       = rrq.querySpec()
+		loadedTypes = [2]bool{
+			rrq.withAssetClass != nil,
+			rrq.withChannels != nil,
+		}
+	)
+	if rrq.withAssetClass != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderrule.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReminderRule).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReminderRule{config: rrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrq.withAssetClass; query != nil {
+		if err := rrq.loadAssetClass(ctx, query, nodes, nil,
+			func(n *ReminderRule, e *AssetClass) { n.Edges.AssetClass = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rrq.withChannels; query != nil {
+		if err := rrq.loadChannels(ctx, query, nodes,
+			func(n *ReminderRule) { n.Edges.Channels = []*NotificationChannel{} },
+			func(n *ReminderRule, e *NotificationChannel) { n.Edges.Channels = append(n.Edges.Channels, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrq *ReminderRuleQuery) loadAssetClass(ctx context.Context, query *AssetClassQuery, nodes []*ReminderRule, init func(*ReminderRule), assign func(*ReminderRule, *AssetClass)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ReminderRule)
+	for i := range nodes {
+		if nodes[i].reminder_rule_asset_class == nil {
+			continue
+		}
+		fk := *nodes[i].reminder_rule_asset_class
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(assetclass.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reminder_rule_asset_class" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rrq *ReminderRuleQuery) loadChannels(ctx context.Context, query *NotificationChannelQuery, nodes []*ReminderRule, init func(*ReminderRule), assign func(*ReminderRule, *NotificationChannel)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ReminderRule)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.NotificationChannel(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(reminderrule.ChannelsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.reminder_rule_channels
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "reminder_rule_channels" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reminder_rule_channels" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rrq *ReminderRuleQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *ReminderRuleQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(reminderrule.Table, reminderrule.Columns, sqlgraph.NewFieldSpec(reminderrule.FieldID, field.TypeUUID))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderrule.FieldID)
+		for i := range fields {
+			if fields[i] != reminderrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *ReminderRuleQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(reminderrule.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = reminderrule.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReminderRuleGroupBy is the group-by builder for ReminderRule entities.
+type ReminderRuleGroupBy struct {
+	selector
+	build *ReminderRuleQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *ReminderRuleGroupBy) Aggregate(fns ...AggregateFunc) *ReminderRuleGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *ReminderRuleGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReminderRuleQuery, *ReminderRuleGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *ReminderRuleGroupBy) sqlScan(ctx context.Context, root *ReminderRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReminderRuleSelect is the builder for selecting fields of ReminderRule entities.
+type ReminderRuleSelect struct {
+	*ReminderRuleQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *ReminderRuleSelect) Aggregate(fns ...AggregateFunc) *ReminderRuleSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *ReminderRuleSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, ent.OpQuerySelect)
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReminderRuleQuery, *ReminderRuleSelect](ctx, rrs.ReminderRuleQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *ReminderRuleSelect) sqlScan(ctx context.Context, root *ReminderRuleQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/reminderrule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReminderRuleUpdate is the builder for updating ReminderRule entities.
+type ReminderRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderRuleMutation
+}
+
+// Where appends a list predicates to the ReminderRuleUpdate builder.
+func (rru *ReminderRuleUpdate) Where(ps ...predicate.ReminderRule) *ReminderRuleUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetAttributeKey sets the "attribute_key" field.
+func (rru *ReminderRuleUpdate) SetAttributeKey(s string) *ReminderRuleUpdate {
+	rru.mutation.SetAttributeKey(s)
+	return rru
+}
+
+// SetNillableAttributeKey sets the "attribute_key" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableAttributeKey(s *string) *ReminderRuleUpdate {
+	if s != nil {
+		rru.SetAttributeKey(*s)
+	}
+	return rru
+}
+
+// ClearAttributeKey clears the value of the "attribute_key" field.
+func (rru *ReminderRuleUpdate) ClearAttributeKey() *ReminderRuleUpdate {
+	rru.mutation.ClearAttributeKey()
+	return rru
+}
+
+// SetDays sets the "days" field.
+func (rru *ReminderRuleUpdate) SetDays(i []int) *ReminderRuleUpdate {
+	rru.mutation.SetDays(i)
+	return rru
+}
+
+// AppendDays appends i to the "days" field.
+func (rru *ReminderRuleUpdate) AppendDays(i []int) *ReminderRuleUpdate {
+	rru.mutation.AppendDays(i)
+	return rru
+}
+
+// SetEnabled sets the "enabled" field.
+func (rru *ReminderRuleUpdate) SetEnabled(b bool) *ReminderRuleUpdate {
+	rru.mutation.SetEnabled(b)
+	return rru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableEnabled(b *bool) *ReminderRuleUpdate {
+	if b != nil {
+		rru.SetEnabled(*b)
+	}
+	return rru
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rru *ReminderRuleUpdate) SetCreatedBy(s string) *ReminderRuleUpdate {
+	rru.mutation.SetCreatedBy(s)
+	return rru
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableCreatedBy(s *string) *ReminderRuleUpdate {
+	if s != nil {
+		rru.SetCreatedBy(*s)
+	}
+	return rru
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rru *ReminderRuleUpdate) SetUpdatedBy(s string) *ReminderRuleUpdate {
+	rru.mutation.SetUpdatedBy(s)
+	return rru
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableUpdatedBy(s *string) *ReminderRuleUpdate {
+	if s != nil {
+		rru.SetUpdatedBy(*s)
+	}
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *ReminderRuleUpdate) SetUpdatedAt(t time.Time) *ReminderRuleUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (rru *ReminderRuleUpdate) SetDeletedBy(s string) *ReminderRuleUpdate {
+	rru.mutation.SetDeletedBy(s)
+	return rru
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableDeletedBy(s *string) *ReminderRuleUpdate {
+	if s != nil {
+		rru.SetDeletedBy(*s)
+	}
+	return rru
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (rru *ReminderRuleUpdate) ClearDeletedBy() *ReminderRuleUpdate {
+	rru.mutation.ClearDeletedBy()
+	return rru
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rru *ReminderRuleUpdate) SetDeletedAt(t time.Time) *ReminderRuleUpdate {
+	rru.mutation.SetDeletedAt(t)
+	return rru
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rru *ReminderRuleUpdate) SetNillableDeletedAt(t *time.Time) *ReminderRuleUpdate {
+	if t != nil {
+		rru.SetDeletedAt(*t)
+	}
+	return rru
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rru *ReminderRuleUpdate) ClearDeletedAt() *ReminderRuleUpdate {
+	rru.mutation.ClearDeletedAt()
+	return rru
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (rru *ReminderRuleUpdate) SetAssetClassID(id uuid.UUID) *ReminderRuleUpdate {
+	rru.mutation.SetAssetClassID(id)
+	return rru
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (rru *ReminderRuleUpdate) SetAssetClass(a *AssetClass) *ReminderRuleUpdate {
+	return rru.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (rru *ReminderRuleUpdate) AddChannelIDs(ids ...uuid.UUID) *ReminderRuleUpdate {
+	rru.mutation.AddChannelIDs(ids...)
+	return rru
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (rru *ReminderRuleUpdate) AddChannels(n ...*NotificationChannel) *ReminderRuleUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return rru.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ReminderRuleMutation object of the builder.
+func (rru *ReminderRuleUpdate) Mutation() *ReminderRuleMutation {
+	return rru.mutation
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (rru *ReminderRuleUpdate) ClearAssetClass() *ReminderRuleUpdate {
+	rru.mutation.ClearAssetClass()
+	return rru
+}
+
+// ClearChannels clears all "channels" edges to the NotificationChannel entity.
+func (rru *ReminderRuleUpdate) ClearChannels() *ReminderRuleUpdate {
+	rru.mutation.ClearChannels()
+	return rru
+}
+
+// RemoveChannelIDs removes the "channels" edge to NotificationChannel entities by IDs.
+func (rru *ReminderRuleUpdate) RemoveChannelIDs(ids ...uuid.UUID) *ReminderRuleUpdate {
+	rru.mutation.RemoveChannelIDs(ids...)
+	return rru
+}
+
+// RemoveChannels removes "channels" edges to NotificationChannel entities.
+func (rru *ReminderRuleUpdate) RemoveChannels(n ...*NotificationChannel) *ReminderRuleUpdate {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return rru.RemoveChannelIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReminderRuleUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReminderRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReminderRuleUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReminderRuleUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *ReminderRuleUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := reminderrule.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *ReminderRuleUpdate) check() error {
+	if rru.mutation.AssetClassCleared() && len(rru.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderRule.asset_class"`)
+	}
+	return nil
+}
+
+func (rru *ReminderRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderrule.Table, reminderrule.Columns, sqlgraph.NewFieldSpec(reminderrule.FieldID, field.TypeUUID))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.AttributeKey(); ok {
+		_spec.SetField(reminderrule.FieldAttributeKey, field.TypeString, value)
+	}
+	if rru.mutation.AttributeKeyCleared() {
+		_spec.ClearField(reminderrule.FieldAttributeKey, field.TypeString)
+	}
+	if value, ok := rru.mutation.Days(); ok {
+		_spec.SetField(reminderrule.FieldDays, field.TypeJSON, value)
+	}
+	if value, ok := rru.mutation.AppendedDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reminderrule.FieldDays, value)
+		})
+	}
+	if value, ok := rru.mutation.Enabled(); ok {
+		_spec.SetField(reminderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rru.mutation.CreatedBy(); ok {
+		_spec.SetField(reminderrule.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.UpdatedBy(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.DeletedBy(); ok {
+		_spec.SetField(reminderrule.FieldDeletedBy, field.TypeString, value)
+	}
+	if rru.mutation.DeletedByCleared() {
+		_spec.ClearField(reminderrule.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := rru.mutation.DeletedAt(); ok {
+		_spec.SetField(reminderrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rru.mutation.DeletedAtCleared() {
+		_spec.ClearField(reminderrule.FieldDeletedAt, field.TypeTime)
+	}
+	if rru.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reminderrule.AssetClassTable,
+			Columns: []string{reminderrule.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reminderrule.AssetClassTable,
+			Columns: []string{reminderrule.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rru.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !rru.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReminderRuleUpdateOne is the builder for updating a single ReminderRule entity.
+type ReminderRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderRuleMutation
+}
+
+// SetAttributeKey sets the "attribute_key" field.
+func (rruo *ReminderRuleUpdateOne) SetAttributeKey(s string) *ReminderRuleUpdateOne {
+	rruo.mutation.SetAttributeKey(s)
+	return rruo
+}
+
+// SetNillableAttributeKey sets the "attribute_key" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableAttributeKey(s *string) *ReminderRuleUpdateOne {
+	if s != nil {
+		rruo.SetAttributeKey(*s)
+	}
+	return rruo
+}
+
+// ClearAttributeKey clears the value of the "attribute_key" field.
+func (rruo *ReminderRuleUpdateOne) ClearAttributeKey() *ReminderRuleUpdateOne {
+	rruo.mutation.ClearAttributeKey()
+	return rruo
+}
+
+// SetDays sets the "days" field.
+func (rruo *ReminderRuleUpdateOne) SetDays(i []int) *ReminderRuleUpdateOne {
+	rruo.mutation.SetDays(i)
+	return rruo
+}
+
+// AppendDays appends i to the "days" field.
+func (rruo *ReminderRuleUpdateOne) AppendDays(i []int) *ReminderRuleUpdateOne {
+	rruo.mutation.AppendDays(i)
+	return rruo
+}
+
+// SetEnabled sets the "enabled" field.
+func (rruo *ReminderRuleUpdateOne) SetEnabled(b bool) *ReminderRuleUpdateOne {
+	rruo.mutation.SetEnabled(b)
+	return rruo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableEnabled(b *bool) *ReminderRuleUpdateOne {
+	if b != nil {
+		rruo.SetEnabled(*b)
+	}
+	return rruo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (rruo *ReminderRuleUpdateOne) SetCreatedBy(s string) *ReminderRuleUpdateOne {
+	rruo.mutation.SetCreatedBy(s)
+	return rruo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableCreatedBy(s *string) *ReminderRuleUpdateOne {
+	if s != nil {
+		rruo.SetCreatedBy(*s)
+	}
+	return rruo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (rruo *ReminderRuleUpdateOne) SetUpdatedBy(s string) *ReminderRuleUpdateOne {
+	rruo.mutation.SetUpdatedBy(s)
+	return rruo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableUpdatedBy(s *string) *ReminderRuleUpdateOne {
+	if s != nil {
+		rruo.SetUpdatedBy(*s)
+	}
+	return rruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *ReminderRuleUpdateOne) SetUpdatedAt(t time.Time) *ReminderRuleUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (rruo *ReminderRuleUpdateOne) SetDeletedBy(s string) *ReminderRuleUpdateOne {
+	rruo.mutation.SetDeletedBy(s)
+	return rruo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableDeletedBy(s *string) *ReminderRuleUpdateOne {
+	if s != nil {
+		rruo.SetDeletedBy(*s)
+	}
+	return rruo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (rruo *ReminderRuleUpdateOne) ClearDeletedBy() *ReminderRuleUpdateOne {
+	rruo.mutation.ClearDeletedBy()
+	return rruo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (rruo *ReminderRuleUpdateOne) SetDeletedAt(t time.Time) *ReminderRuleUpdateOne {
+	rruo.mutation.SetDeletedAt(t)
+	return rruo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (rruo *ReminderRuleUpdateOne) SetNillableDeletedAt(t *time.Time) *ReminderRuleUpdateOne {
+	if t != nil {
+		rruo.SetDeletedAt(*t)
+	}
+	return rruo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (rruo *ReminderRuleUpdateOne) ClearDeletedAt() *ReminderRuleUpdateOne {
+	rruo.mutation.ClearDeletedAt()
+	return rruo
+}
+
+// SetAssetClassID sets the "asset_class" edge to the AssetClass entity by ID.
+func (rruo *ReminderRuleUpdateOne) SetAssetClassID(id uuid.UUID) *ReminderRuleUpdateOne {
+	rruo.mutation.SetAssetClassID(id)
+	return rruo
+}
+
+// SetAssetClass sets the "asset_class" edge to the AssetClass entity.
+func (rruo *ReminderRuleUpdateOne) SetAssetClass(a *AssetClass) *ReminderRuleUpdateOne {
+	return rruo.SetAssetClassID(a.ID)
+}
+
+// AddChannelIDs adds the "channels" edge to the NotificationChannel entity by IDs.
+func (rruo *ReminderRuleUpdateOne) AddChannelIDs(ids ...uuid.UUID) *ReminderRuleUpdateOne {
+	rruo.mutation.AddChannelIDs(ids...)
+	return rruo
+}
+
+// AddChannels adds the "channels" edges to the NotificationChannel entity.
+func (rruo *ReminderRuleUpdateOne) AddChannels(n ...*NotificationChannel) *ReminderRuleUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return rruo.AddChannelIDs(ids...)
+}
+
+// Mutation returns the ReminderRuleMutation object of the builder.
+func (rruo *ReminderRuleUpdateOne) Mutation() *ReminderRuleMutation {
+	return rruo.mutation
+}
+
+// ClearAssetClass clears the "asset_class" edge to the AssetClass entity.
+func (rruo *ReminderRuleUpdateOne) ClearAssetClass() *ReminderRuleUpdateOne {
+	rruo.mutation.ClearAssetClass()
+	return rruo
+}
+
+// ClearChannels clears all "channels" edges to the NotificationChannel entity.
+func (rruo *ReminderRuleUpdateOne) ClearChannels() *ReminderRuleUpdateOne {
+	rruo.mutation.ClearChannels()
+	return rruo
+}
+
+// RemoveChannelIDs removes the "channels" edge to NotificationChannel entities by IDs.
+func (rruo *ReminderRuleUpdateOne) RemoveChannelIDs(ids ...uuid.UUID) *ReminderRuleUpdateOne {
+	rruo.mutation.RemoveChannelIDs(ids...)
+	return rruo
+}
+
+// RemoveChannels removes "channels" edges to NotificationChannel entities.
+func (rruo *ReminderRuleUpdateOne) RemoveChannels(n ...*NotificationChannel) *ReminderRuleUpdateOne {
+	ids := make([]uuid.UUID, len(n))
+	for i := range n {
+		ids[i] = n[i].ID
+	}
+	return rruo.RemoveChannelIDs(ids...)
+}
+
+// Where appends a list predicates to the ReminderRuleUpdate builder.
+func (rruo *ReminderRuleUpdateOne) Where(ps ...predicate.ReminderRule) *ReminderRuleUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReminderRuleUpdateOne) Select(field string, fields ...string) *ReminderRuleUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReminderRule entity.
+func (rruo *ReminderRuleUpdateOne) Save(ctx context.Context) (*ReminderRule, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReminderRuleUpdateOne) SaveX(ctx context.Context) *ReminderRule {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReminderRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReminderRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *ReminderRuleUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := reminderrule.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *ReminderRuleUpdateOne) check() error {
+	if rruo.mutation.AssetClassCleared() && len(rruo.mutation.AssetClassIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReminderRule.asset_class"`)
+	}
+	return nil
+}
+
+func (rruo *ReminderRuleUpdateOne) sqlSave(ctx context.Context) (_node *ReminderRule, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminderrule.Table, reminderrule.Columns, sqlgraph.NewFieldSpec(reminderrule.FieldID, field.TypeUUID))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReminderRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminderrule.FieldID)
+		for _, f := range fields {
+			if !reminderrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminderrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.AttributeKey(); ok {
+		_spec.SetField(reminderrule.FieldAttributeKey, field.TypeString, value)
+	}
+	if rruo.mutation.AttributeKeyCleared() {
+		_spec.ClearField(reminderrule.FieldAttributeKey, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Days(); ok {
+		_spec.SetField(reminderrule.FieldDays, field.TypeJSON, value)
+	}
+	if value, ok := rruo.mutation.AppendedDays(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, reminderrule.FieldDays, value)
+		})
+	}
+	if value, ok := rruo.mutation.Enabled(); ok {
+		_spec.SetField(reminderrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := rruo.mutation.CreatedBy(); ok {
+		_spec.SetField(reminderrule.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.UpdatedBy(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(reminderrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.DeletedBy(); ok {
+		_spec.SetField(reminderrule.FieldDeletedBy, field.TypeString, value)
+	}
+	if rruo.mutation.DeletedByCleared() {
+		_spec.ClearField(reminderrule.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := rruo.mutation.DeletedAt(); ok {
+		_spec.SetField(reminderrule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.DeletedAtCleared() {
+		_spec.ClearField(reminderrule.FieldDeletedAt, field.TypeTime)
+	}
+	if rruo.mutation.AssetClassCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reminderrule.AssetClassTable,
+			Columns: []string{reminderrule.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.AssetClassIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reminderrule.AssetClassTable,
+			Columns: []string{reminderrule.AssetClassColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(assetclass.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rruo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.RemovedChannelsIDs(); len(nodes) > 0 && !rruo.mutation.ChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.ChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   reminderrule.ChannelsTable,
+			Columns: []string{reminderrule.ChannelsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(notificationchannel.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReminderRule{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminderrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
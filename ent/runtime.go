@@ -4,10 +4,31 @@ package ent
 
 import (
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/driftreport"
 	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/notificationdelivery"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"dig-inv/ent/provideraccount"
+	"dig-inv/ent/reachability"
+	"dig-inv/ent/reminderrule"
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/schedule"
 	"dig-inv/ent/schema"
 	"dig-inv/ent/tag"
 	"dig-inv/ent/usergroup"
+	"dig-inv/ent/webhook"
+	"dig-inv/ent/webhookdelivery"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,11 +49,11 @@ func init() {
 	// assetclass.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	assetclass.NameValidator = assetclassDescName.Validators[0].(func(string) error)
 	// assetclassDescCreatedAt is the schema descriptor for created_at field.
-	assetclassDescCreatedAt := assetclassFields[8].Descriptor()
+	assetclassDescCreatedAt := assetclassFields[9].Descriptor()
 	// assetclass.DefaultCreatedAt holds the default value on creation for the created_at field.
 	assetclass.DefaultCreatedAt = assetclassDescCreatedAt.Default.(func() time.Time)
 	// assetclassDescUpdatedAt is the schema descriptor for updated_at field.
-	assetclassDescUpdatedAt := assetclassFields[10].Descriptor()
+	assetclassDescUpdatedAt := assetclassFields[11].Descriptor()
 	// assetclass.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	assetclass.DefaultUpdatedAt = assetclassDescUpdatedAt.Default.(func() time.Time)
 	// assetclass.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -41,18 +62,222 @@ func init() {
 	assetclassDescID := assetclassFields[0].Descriptor()
 	// assetclass.DefaultID holds the default value on creation for the id field.
 	assetclass.DefaultID = assetclassDescID.Default.(func() uuid.UUID)
+	attachmentFields := schema.Attachment{}.Fields()
+	_ = attachmentFields
+	// attachmentDescFilename is the schema descriptor for filename field.
+	attachmentDescFilename := attachmentFields[1].Descriptor()
+	// attachment.FilenameValidator is a validator for the "filename" field. It is called by the builders before save.
+	attachment.FilenameValidator = attachmentDescFilename.Validators[0].(func(string) error)
+	// attachmentDescContentType is the schema descriptor for content_type field.
+	attachmentDescContentType := attachmentFields[2].Descriptor()
+	// attachment.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	attachment.ContentTypeValidator = attachmentDescContentType.Validators[0].(func(string) error)
+	// attachmentDescSize is the schema descriptor for size field.
+	attachmentDescSize := attachmentFields[3].Descriptor()
+	// attachment.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	attachment.SizeValidator = attachmentDescSize.Validators[0].(func(int64) error)
+	// attachmentDescStorageKey is the schema descriptor for storage_key field.
+	attachmentDescStorageKey := attachmentFields[5].Descriptor()
+	// attachment.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	attachment.StorageKeyValidator = attachmentDescStorageKey.Validators[0].(func(string) error)
+	// attachmentDescCreatedAt is the schema descriptor for created_at field.
+	attachmentDescCreatedAt := attachmentFields[8].Descriptor()
+	// attachment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attachment.DefaultCreatedAt = attachmentDescCreatedAt.Default.(func() time.Time)
+	// attachmentDescUpdatedAt is the schema descriptor for updated_at field.
+	attachmentDescUpdatedAt := attachmentFields[10].Descriptor()
+	// attachment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attachment.DefaultUpdatedAt = attachmentDescUpdatedAt.Default.(func() time.Time)
+	// attachment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attachment.UpdateDefaultUpdatedAt = attachmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attachmentDescID is the schema descriptor for id field.
+	attachmentDescID := attachmentFields[0].Descriptor()
+	// attachment.DefaultID holds the default value on creation for the id field.
+	attachment.DefaultID = attachmentDescID.Default.(func() uuid.UUID)
+	attributedefinitionFields := schema.AttributeDefinition{}.Fields()
+	_ = attributedefinitionFields
+	// attributedefinitionDescKey is the schema descriptor for key field.
+	attributedefinitionDescKey := attributedefinitionFields[1].Descriptor()
+	// attributedefinition.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	attributedefinition.KeyValidator = func() func(string) error {
+		validators := attributedefinitionDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// attributedefinitionDescName is the schema descriptor for name field.
+	attributedefinitionDescName := attributedefinitionFields[2].Descriptor()
+	// attributedefinition.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	attributedefinition.NameValidator = attributedefinitionDescName.Validators[0].(func(string) error)
+	// attributedefinitionDescRequired is the schema descriptor for required field.
+	attributedefinitionDescRequired := attributedefinitionFields[5].Descriptor()
+	// attributedefinition.DefaultRequired holds the default value on creation for the required field.
+	attributedefinition.DefaultRequired = attributedefinitionDescRequired.Default.(bool)
+	// attributedefinitionDescSecret is the schema descriptor for secret field.
+	attributedefinitionDescSecret := attributedefinitionFields[8].Descriptor()
+	// attributedefinition.DefaultSecret holds the default value on creation for the secret field.
+	attributedefinition.DefaultSecret = attributedefinitionDescSecret.Default.(bool)
+	// attributedefinitionDescOrder is the schema descriptor for order field.
+	attributedefinitionDescOrder := attributedefinitionFields[9].Descriptor()
+	// attributedefinition.DefaultOrder holds the default value on creation for the order field.
+	attributedefinition.DefaultOrder = attributedefinitionDescOrder.Default.(int)
+	// attributedefinitionDescCreatedAt is the schema descriptor for created_at field.
+	attributedefinitionDescCreatedAt := attributedefinitionFields[11].Descriptor()
+	// attributedefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	attributedefinition.DefaultCreatedAt = attributedefinitionDescCreatedAt.Default.(func() time.Time)
+	// attributedefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	attributedefinitionDescUpdatedAt := attributedefinitionFields[13].Descriptor()
+	// attributedefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	attributedefinition.DefaultUpdatedAt = attributedefinitionDescUpdatedAt.Default.(func() time.Time)
+	// attributedefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	attributedefinition.UpdateDefaultUpdatedAt = attributedefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// attributedefinitionDescID is the schema descriptor for id field.
+	attributedefinitionDescID := attributedefinitionFields[0].Descriptor()
+	// attributedefinition.DefaultID holds the default value on creation for the id field.
+	attributedefinition.DefaultID = attributedefinitionDescID.Default.(func() uuid.UUID)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescEntityType is the schema descriptor for entity_type field.
+	auditlogDescEntityType := auditlogFields[1].Descriptor()
+	// auditlog.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	auditlog.EntityTypeValidator = auditlogDescEntityType.Validators[0].(func(string) error)
+	// auditlogDescTimestamp is the schema descriptor for timestamp field.
+	auditlogDescTimestamp := auditlogFields[5].Descriptor()
+	// auditlog.DefaultTimestamp holds the default value on creation for the timestamp field.
+	auditlog.DefaultTimestamp = auditlogDescTimestamp.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	commentFields := schema.Comment{}.Fields()
+	_ = commentFields
+	// commentDescBody is the schema descriptor for body field.
+	commentDescBody := commentFields[1].Descriptor()
+	// comment.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	comment.BodyValidator = commentDescBody.Validators[0].(func(string) error)
+	// commentDescCreatedAt is the schema descriptor for created_at field.
+	commentDescCreatedAt := commentFields[6].Descriptor()
+	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
+	// commentDescUpdatedAt is the schema descriptor for updated_at field.
+	commentDescUpdatedAt := commentFields[8].Descriptor()
+	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
+	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	comment.UpdateDefaultUpdatedAt = commentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentDescID is the schema descriptor for id field.
+	commentDescID := commentFields[0].Descriptor()
+	// comment.DefaultID holds the default value on creation for the id field.
+	comment.DefaultID = commentDescID.Default.(func() uuid.UUID)
+	commentmentionFields := schema.CommentMention{}.Fields()
+	_ = commentmentionFields
+	// commentmentionDescSubject is the schema descriptor for subject field.
+	commentmentionDescSubject := commentmentionFields[1].Descriptor()
+	// commentmention.SubjectValidator is a validator for the "subject" field. It is called by the builders before save.
+	commentmention.SubjectValidator = commentmentionDescSubject.Validators[0].(func(string) error)
+	// commentmentionDescCreatedAt is the schema descriptor for created_at field.
+	commentmentionDescCreatedAt := commentmentionFields[3].Descriptor()
+	// commentmention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentmention.DefaultCreatedAt = commentmentionDescCreatedAt.Default.(func() time.Time)
+	// commentmentionDescUpdatedAt is the schema descriptor for updated_at field.
+	commentmentionDescUpdatedAt := commentmentionFields[5].Descriptor()
+	// commentmention.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commentmention.DefaultUpdatedAt = commentmentionDescUpdatedAt.Default.(func() time.Time)
+	// commentmention.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commentmention.UpdateDefaultUpdatedAt = commentmentionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// commentmentionDescID is the schema descriptor for id field.
+	commentmentionDescID := commentmentionFields[0].Descriptor()
+	// commentmention.DefaultID holds the default value on creation for the id field.
+	commentmention.DefaultID = commentmentionDescID.Default.(func() uuid.UUID)
+	dnscheckFields := schema.DnsCheck{}.Fields()
+	_ = dnscheckFields
+	// dnscheckDescRecordName is the schema descriptor for record_name field.
+	dnscheckDescRecordName := dnscheckFields[1].Descriptor()
+	// dnscheck.RecordNameValidator is a validator for the "record_name" field. It is called by the builders before save.
+	dnscheck.RecordNameValidator = dnscheckDescRecordName.Validators[0].(func(string) error)
+	// dnscheckDescCreatedAt is the schema descriptor for created_at field.
+	dnscheckDescCreatedAt := dnscheckFields[8].Descriptor()
+	// dnscheck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dnscheck.DefaultCreatedAt = dnscheckDescCreatedAt.Default.(func() time.Time)
+	// dnscheckDescUpdatedAt is the schema descriptor for updated_at field.
+	dnscheckDescUpdatedAt := dnscheckFields[10].Descriptor()
+	// dnscheck.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dnscheck.DefaultUpdatedAt = dnscheckDescUpdatedAt.Default.(func() time.Time)
+	// dnscheck.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dnscheck.UpdateDefaultUpdatedAt = dnscheckDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// dnscheckDescID is the schema descriptor for id field.
+	dnscheckDescID := dnscheckFields[0].Descriptor()
+	// dnscheck.DefaultID holds the default value on creation for the id field.
+	dnscheck.DefaultID = dnscheckDescID.Default.(func() uuid.UUID)
+	domainlookupFields := schema.DomainLookup{}.Fields()
+	_ = domainlookupFields
+	// domainlookupDescDomain is the schema descriptor for domain field.
+	domainlookupDescDomain := domainlookupFields[1].Descriptor()
+	// domainlookup.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	domainlookup.DomainValidator = domainlookupDescDomain.Validators[0].(func(string) error)
+	// domainlookupDescCreatedAt is the schema descriptor for created_at field.
+	domainlookupDescCreatedAt := domainlookupFields[11].Descriptor()
+	// domainlookup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	domainlookup.DefaultCreatedAt = domainlookupDescCreatedAt.Default.(func() time.Time)
+	// domainlookupDescUpdatedAt is the schema descriptor for updated_at field.
+	domainlookupDescUpdatedAt := domainlookupFields[13].Descriptor()
+	// domainlookup.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	domainlookup.DefaultUpdatedAt = domainlookupDescUpdatedAt.Default.(func() time.Time)
+	// domainlookup.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	domainlookup.UpdateDefaultUpdatedAt = domainlookupDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// domainlookupDescID is the schema descriptor for id field.
+	domainlookupDescID := domainlookupFields[0].Descriptor()
+	// domainlookup.DefaultID holds the default value on creation for the id field.
+	domainlookup.DefaultID = domainlookupDescID.Default.(func() uuid.UUID)
+	driftreportFields := schema.DriftReport{}.Fields()
+	_ = driftreportFields
+	// driftreportDescSource is the schema descriptor for source field.
+	driftreportDescSource := driftreportFields[1].Descriptor()
+	// driftreport.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	driftreport.SourceValidator = driftreportDescSource.Validators[0].(func(string) error)
+	// driftreportDescCreatedAt is the schema descriptor for created_at field.
+	driftreportDescCreatedAt := driftreportFields[7].Descriptor()
+	// driftreport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	driftreport.DefaultCreatedAt = driftreportDescCreatedAt.Default.(func() time.Time)
+	// driftreportDescUpdatedAt is the schema descriptor for updated_at field.
+	driftreportDescUpdatedAt := driftreportFields[9].Descriptor()
+	// driftreport.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	driftreport.DefaultUpdatedAt = driftreportDescUpdatedAt.Default.(func() time.Time)
+	// driftreport.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	driftreport.UpdateDefaultUpdatedAt = driftreportDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// driftreportDescID is the schema descriptor for id field.
+	driftreportDescID := driftreportFields[0].Descriptor()
+	// driftreport.DefaultID holds the default value on creation for the id field.
+	driftreport.DefaultID = driftreportDescID.Default.(func() uuid.UUID)
 	itemFields := schema.Item{}.Fields()
 	_ = itemFields
 	// itemDescName is the schema descriptor for name field.
 	itemDescName := itemFields[1].Descriptor()
 	// item.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	item.NameValidator = itemDescName.Validators[0].(func(string) error)
+	// itemDescCostAmount is the schema descriptor for cost_amount field.
+	itemDescCostAmount := itemFields[5].Descriptor()
+	// item.CostAmountValidator is a validator for the "cost_amount" field. It is called by the builders before save.
+	item.CostAmountValidator = itemDescCostAmount.Validators[0].(func(float64) error)
+	// itemDescCostCurrency is the schema descriptor for cost_currency field.
+	itemDescCostCurrency := itemFields[6].Descriptor()
+	// item.CostCurrencyValidator is a validator for the "cost_currency" field. It is called by the builders before save.
+	item.CostCurrencyValidator = itemDescCostCurrency.Validators[0].(func(string) error)
 	// itemDescCreatedAt is the schema descriptor for created_at field.
-	itemDescCreatedAt := itemFields[4].Descriptor()
+	itemDescCreatedAt := itemFields[10].Descriptor()
 	// item.DefaultCreatedAt holds the default value on creation for the created_at field.
 	item.DefaultCreatedAt = itemDescCreatedAt.Default.(func() time.Time)
 	// itemDescUpdatedAt is the schema descriptor for updated_at field.
-	itemDescUpdatedAt := itemFields[6].Descriptor()
+	itemDescUpdatedAt := itemFields[12].Descriptor()
 	// item.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() time.Time)
 	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -61,6 +286,292 @@ func init() {
 	itemDescID := itemFields[0].Descriptor()
 	// item.DefaultID holds the default value on creation for the id field.
 	item.DefaultID = itemDescID.Default.(func() uuid.UUID)
+	itemrelationFields := schema.ItemRelation{}.Fields()
+	_ = itemrelationFields
+	// itemrelationDescCreatedAt is the schema descriptor for created_at field.
+	itemrelationDescCreatedAt := itemrelationFields[4].Descriptor()
+	// itemrelation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	itemrelation.DefaultCreatedAt = itemrelationDescCreatedAt.Default.(func() time.Time)
+	// itemrelationDescUpdatedAt is the schema descriptor for updated_at field.
+	itemrelationDescUpdatedAt := itemrelationFields[6].Descriptor()
+	// itemrelation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	itemrelation.DefaultUpdatedAt = itemrelationDescUpdatedAt.Default.(func() time.Time)
+	// itemrelation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	itemrelation.UpdateDefaultUpdatedAt = itemrelationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemrelationDescID is the schema descriptor for id field.
+	itemrelationDescID := itemrelationFields[0].Descriptor()
+	// itemrelation.DefaultID holds the default value on creation for the id field.
+	itemrelation.DefaultID = itemrelationDescID.Default.(func() uuid.UUID)
+	jobrunFields := schema.JobRun{}.Fields()
+	_ = jobrunFields
+	// jobrunDescType is the schema descriptor for type field.
+	jobrunDescType := jobrunFields[1].Descriptor()
+	// jobrun.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	jobrun.TypeValidator = jobrunDescType.Validators[0].(func(string) error)
+	// jobrunDescProgress is the schema descriptor for progress field.
+	jobrunDescProgress := jobrunFields[4].Descriptor()
+	// jobrun.DefaultProgress holds the default value on creation for the progress field.
+	jobrun.DefaultProgress = jobrunDescProgress.Default.(int)
+	// jobrun.ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	jobrun.ProgressValidator = jobrunDescProgress.Validators[0].(func(int) error)
+	// jobrunDescCancelRequested is the schema descriptor for cancel_requested field.
+	jobrunDescCancelRequested := jobrunFields[6].Descriptor()
+	// jobrun.DefaultCancelRequested holds the default value on creation for the cancel_requested field.
+	jobrun.DefaultCancelRequested = jobrunDescCancelRequested.Default.(bool)
+	// jobrunDescCreatedAt is the schema descriptor for created_at field.
+	jobrunDescCreatedAt := jobrunFields[15].Descriptor()
+	// jobrun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	jobrun.DefaultCreatedAt = jobrunDescCreatedAt.Default.(func() time.Time)
+	// jobrunDescUpdatedAt is the schema descriptor for updated_at field.
+	jobrunDescUpdatedAt := jobrunFields[17].Descriptor()
+	// jobrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	jobrun.DefaultUpdatedAt = jobrunDescUpdatedAt.Default.(func() time.Time)
+	// jobrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	jobrun.UpdateDefaultUpdatedAt = jobrunDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobrunDescID is the schema descriptor for id field.
+	jobrunDescID := jobrunFields[0].Descriptor()
+	// jobrun.DefaultID holds the default value on creation for the id field.
+	jobrun.DefaultID = jobrunDescID.Default.(func() uuid.UUID)
+	notificationchannelFields := schema.NotificationChannel{}.Fields()
+	_ = notificationchannelFields
+	// notificationchannelDescName is the schema descriptor for name field.
+	notificationchannelDescName := notificationchannelFields[1].Descriptor()
+	// notificationchannel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	notificationchannel.NameValidator = notificationchannelDescName.Validators[0].(func(string) error)
+	// notificationchannelDescEnabled is the schema descriptor for enabled field.
+	notificationchannelDescEnabled := notificationchannelFields[4].Descriptor()
+	// notificationchannel.DefaultEnabled holds the default value on creation for the enabled field.
+	notificationchannel.DefaultEnabled = notificationchannelDescEnabled.Default.(bool)
+	// notificationchannelDescCreatedAt is the schema descriptor for created_at field.
+	notificationchannelDescCreatedAt := notificationchannelFields[6].Descriptor()
+	// notificationchannel.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationchannel.DefaultCreatedAt = notificationchannelDescCreatedAt.Default.(func() time.Time)
+	// notificationchannelDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationchannelDescUpdatedAt := notificationchannelFields[8].Descriptor()
+	// notificationchannel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationchannel.DefaultUpdatedAt = notificationchannelDescUpdatedAt.Default.(func() time.Time)
+	// notificationchannel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationchannel.UpdateDefaultUpdatedAt = notificationchannelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationchannelDescID is the schema descriptor for id field.
+	notificationchannelDescID := notificationchannelFields[0].Descriptor()
+	// notificationchannel.DefaultID holds the default value on creation for the id field.
+	notificationchannel.DefaultID = notificationchannelDescID.Default.(func() uuid.UUID)
+	notificationdeliveryFields := schema.NotificationDelivery{}.Fields()
+	_ = notificationdeliveryFields
+	// notificationdeliveryDescAttributeKey is the schema descriptor for attribute_key field.
+	notificationdeliveryDescAttributeKey := notificationdeliveryFields[1].Descriptor()
+	// notificationdelivery.AttributeKeyValidator is a validator for the "attribute_key" field. It is called by the builders before save.
+	notificationdelivery.AttributeKeyValidator = notificationdeliveryDescAttributeKey.Validators[0].(func(string) error)
+	// notificationdeliveryDescDueOn is the schema descriptor for due_on field.
+	notificationdeliveryDescDueOn := notificationdeliveryFields[2].Descriptor()
+	// notificationdelivery.DueOnValidator is a validator for the "due_on" field. It is called by the builders before save.
+	notificationdelivery.DueOnValidator = notificationdeliveryDescDueOn.Validators[0].(func(string) error)
+	// notificationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	notificationdeliveryDescCreatedAt := notificationdeliveryFields[5].Descriptor()
+	// notificationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notificationdelivery.DefaultCreatedAt = notificationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// notificationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	notificationdeliveryDescUpdatedAt := notificationdeliveryFields[7].Descriptor()
+	// notificationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	notificationdelivery.DefaultUpdatedAt = notificationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// notificationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	notificationdelivery.UpdateDefaultUpdatedAt = notificationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// notificationdeliveryDescID is the schema descriptor for id field.
+	notificationdeliveryDescID := notificationdeliveryFields[0].Descriptor()
+	// notificationdelivery.DefaultID holds the default value on creation for the id field.
+	notificationdelivery.DefaultID = notificationdeliveryDescID.Default.(func() uuid.UUID)
+	probeFields := schema.Probe{}.Fields()
+	_ = probeFields
+	// probeDescName is the schema descriptor for name field.
+	probeDescName := probeFields[1].Descriptor()
+	// probe.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	probe.NameValidator = probeDescName.Validators[0].(func(string) error)
+	// probeDescPort is the schema descriptor for port field.
+	probeDescPort := probeFields[4].Descriptor()
+	// probe.DefaultPort holds the default value on creation for the port field.
+	probe.DefaultPort = probeDescPort.Default.(int)
+	// probe.PortValidator is a validator for the "port" field. It is called by the builders before save.
+	probe.PortValidator = probeDescPort.Validators[0].(func(int) error)
+	// probeDescExpectedStatus is the schema descriptor for expected_status field.
+	probeDescExpectedStatus := probeFields[6].Descriptor()
+	// probe.DefaultExpectedStatus holds the default value on creation for the expected_status field.
+	probe.DefaultExpectedStatus = probeDescExpectedStatus.Default.(int)
+	// probe.ExpectedStatusValidator is a validator for the "expected_status" field. It is called by the builders before save.
+	probe.ExpectedStatusValidator = probeDescExpectedStatus.Validators[0].(func(int) error)
+	// probeDescTimeout is the schema descriptor for timeout field.
+	probeDescTimeout := probeFields[7].Descriptor()
+	// probe.DefaultTimeout holds the default value on creation for the timeout field.
+	probe.DefaultTimeout = probeDescTimeout.Default.(int)
+	// probe.TimeoutValidator is a validator for the "timeout" field. It is called by the builders before save.
+	probe.TimeoutValidator = probeDescTimeout.Validators[0].(func(int) error)
+	// probeDescEnabled is the schema descriptor for enabled field.
+	probeDescEnabled := probeFields[8].Descriptor()
+	// probe.DefaultEnabled holds the default value on creation for the enabled field.
+	probe.DefaultEnabled = probeDescEnabled.Default.(bool)
+	// probeDescCreatedAt is the schema descriptor for created_at field.
+	probeDescCreatedAt := probeFields[10].Descriptor()
+	// probe.DefaultCreatedAt holds the default value on creation for the created_at field.
+	probe.DefaultCreatedAt = probeDescCreatedAt.Default.(func() time.Time)
+	// probeDescUpdatedAt is the schema descriptor for updated_at field.
+	probeDescUpdatedAt := probeFields[12].Descriptor()
+	// probe.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	probe.DefaultUpdatedAt = probeDescUpdatedAt.Default.(func() time.Time)
+	// probe.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	probe.UpdateDefaultUpdatedAt = probeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// probeDescID is the schema descriptor for id field.
+	probeDescID := probeFields[0].Descriptor()
+	// probe.DefaultID holds the default value on creation for the id field.
+	probe.DefaultID = probeDescID.Default.(func() uuid.UUID)
+	proberesultFields := schema.ProbeResult{}.Fields()
+	_ = proberesultFields
+	// proberesultDescTarget is the schema descriptor for target field.
+	proberesultDescTarget := proberesultFields[1].Descriptor()
+	// proberesult.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	proberesult.TargetValidator = proberesultDescTarget.Validators[0].(func(string) error)
+	// proberesultDescLatencyMs is the schema descriptor for latency_ms field.
+	proberesultDescLatencyMs := proberesultFields[3].Descriptor()
+	// proberesult.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	proberesult.DefaultLatencyMs = proberesultDescLatencyMs.Default.(float64)
+	// proberesultDescCreatedAt is the schema descriptor for created_at field.
+	proberesultDescCreatedAt := proberesultFields[7].Descriptor()
+	// proberesult.DefaultCreatedAt holds the default value on creation for the created_at field.
+	proberesult.DefaultCreatedAt = proberesultDescCreatedAt.Default.(func() time.Time)
+	// proberesultDescUpdatedAt is the schema descriptor for updated_at field.
+	proberesultDescUpdatedAt := proberesultFields[9].Descriptor()
+	// proberesult.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	proberesult.DefaultUpdatedAt = proberesultDescUpdatedAt.Default.(func() time.Time)
+	// proberesult.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	proberesult.UpdateDefaultUpdatedAt = proberesultDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// proberesultDescID is the schema descriptor for id field.
+	proberesultDescID := proberesultFields[0].Descriptor()
+	// proberesult.DefaultID holds the default value on creation for the id field.
+	proberesult.DefaultID = proberesultDescID.Default.(func() uuid.UUID)
+	provideraccountFields := schema.ProviderAccount{}.Fields()
+	_ = provideraccountFields
+	// provideraccountDescName is the schema descriptor for name field.
+	provideraccountDescName := provideraccountFields[1].Descriptor()
+	// provideraccount.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	provideraccount.NameValidator = provideraccountDescName.Validators[0].(func(string) error)
+	// provideraccountDescProvider is the schema descriptor for provider field.
+	provideraccountDescProvider := provideraccountFields[2].Descriptor()
+	// provideraccount.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	provideraccount.ProviderValidator = provideraccountDescProvider.Validators[0].(func(string) error)
+	// provideraccountDescCreatedAt is the schema descriptor for created_at field.
+	provideraccountDescCreatedAt := provideraccountFields[7].Descriptor()
+	// provideraccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	provideraccount.DefaultCreatedAt = provideraccountDescCreatedAt.Default.(func() time.Time)
+	// provideraccountDescUpdatedAt is the schema descriptor for updated_at field.
+	provideraccountDescUpdatedAt := provideraccountFields[9].Descriptor()
+	// provideraccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	provideraccount.DefaultUpdatedAt = provideraccountDescUpdatedAt.Default.(func() time.Time)
+	// provideraccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	provideraccount.UpdateDefaultUpdatedAt = provideraccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// provideraccountDescID is the schema descriptor for id field.
+	provideraccountDescID := provideraccountFields[0].Descriptor()
+	// provideraccount.DefaultID holds the default value on creation for the id field.
+	provideraccount.DefaultID = provideraccountDescID.Default.(func() uuid.UUID)
+	reachabilityFields := schema.Reachability{}.Fields()
+	_ = reachabilityFields
+	// reachabilityDescCreatedAt is the schema descriptor for created_at field.
+	reachabilityDescCreatedAt := reachabilityFields[5].Descriptor()
+	// reachability.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reachability.DefaultCreatedAt = reachabilityDescCreatedAt.Default.(func() time.Time)
+	// reachabilityDescUpdatedAt is the schema descriptor for updated_at field.
+	reachabilityDescUpdatedAt := reachabilityFields[7].Descriptor()
+	// reachability.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reachability.DefaultUpdatedAt = reachabilityDescUpdatedAt.Default.(func() time.Time)
+	// reachability.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reachability.UpdateDefaultUpdatedAt = reachabilityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reachabilityDescID is the schema descriptor for id field.
+	reachabilityDescID := reachabilityFields[0].Descriptor()
+	// reachability.DefaultID holds the default value on creation for the id field.
+	reachability.DefaultID = reachabilityDescID.Default.(func() uuid.UUID)
+	reminderruleFields := schema.ReminderRule{}.Fields()
+	_ = reminderruleFields
+	// reminderruleDescEnabled is the schema descriptor for enabled field.
+	reminderruleDescEnabled := reminderruleFields[3].Descriptor()
+	// reminderrule.DefaultEnabled holds the default value on creation for the enabled field.
+	reminderrule.DefaultEnabled = reminderruleDescEnabled.Default.(bool)
+	// reminderruleDescCreatedAt is the schema descriptor for created_at field.
+	reminderruleDescCreatedAt := reminderruleFields[5].Descriptor()
+	// reminderrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminderrule.DefaultCreatedAt = reminderruleDescCreatedAt.Default.(func() time.Time)
+	// reminderruleDescUpdatedAt is the schema descriptor for updated_at field.
+	reminderruleDescUpdatedAt := reminderruleFields[7].Descriptor()
+	// reminderrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reminderrule.DefaultUpdatedAt = reminderruleDescUpdatedAt.Default.(func() time.Time)
+	// reminderrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reminderrule.UpdateDefaultUpdatedAt = reminderruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reminderruleDescID is the schema descriptor for id field.
+	reminderruleDescID := reminderruleFields[0].Descriptor()
+	// reminderrule.DefaultID holds the default value on creation for the id field.
+	reminderrule.DefaultID = reminderruleDescID.Default.(func() uuid.UUID)
+	savedviewFields := schema.SavedView{}.Fields()
+	_ = savedviewFields
+	// savedviewDescName is the schema descriptor for name field.
+	savedviewDescName := savedviewFields[1].Descriptor()
+	// savedview.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedview.NameValidator = savedviewDescName.Validators[0].(func(string) error)
+	// savedviewDescOwner is the schema descriptor for owner field.
+	savedviewDescOwner := savedviewFields[3].Descriptor()
+	// savedview.OwnerValidator is a validator for the "owner" field. It is called by the builders before save.
+	savedview.OwnerValidator = savedviewDescOwner.Validators[0].(func(string) error)
+	// savedviewDescCreatedAt is the schema descriptor for created_at field.
+	savedviewDescCreatedAt := savedviewFields[8].Descriptor()
+	// savedview.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedview.DefaultCreatedAt = savedviewDescCreatedAt.Default.(func() time.Time)
+	// savedviewDescUpdatedAt is the schema descriptor for updated_at field.
+	savedviewDescUpdatedAt := savedviewFields[10].Descriptor()
+	// savedview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedview.DefaultUpdatedAt = savedviewDescUpdatedAt.Default.(func() time.Time)
+	// savedview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedview.UpdateDefaultUpdatedAt = savedviewDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedviewDescID is the schema descriptor for id field.
+	savedviewDescID := savedviewFields[0].Descriptor()
+	// savedview.DefaultID holds the default value on creation for the id field.
+	savedview.DefaultID = savedviewDescID.Default.(func() uuid.UUID)
+	scheduleFields := schema.Schedule{}.Fields()
+	_ = scheduleFields
+	// scheduleDescName is the schema descriptor for name field.
+	scheduleDescName := scheduleFields[1].Descriptor()
+	// schedule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	schedule.NameValidator = scheduleDescName.Validators[0].(func(string) error)
+	// scheduleDescJobType is the schema descriptor for job_type field.
+	scheduleDescJobType := scheduleFields[2].Descriptor()
+	// schedule.JobTypeValidator is a validator for the "job_type" field. It is called by the builders before save.
+	schedule.JobTypeValidator = scheduleDescJobType.Validators[0].(func(string) error)
+	// scheduleDescCron is the schema descriptor for cron field.
+	scheduleDescCron := scheduleFields[4].Descriptor()
+	// schedule.CronValidator is a validator for the "cron" field. It is called by the builders before save.
+	schedule.CronValidator = scheduleDescCron.Validators[0].(func(string) error)
+	// scheduleDescTimezone is the schema descriptor for timezone field.
+	scheduleDescTimezone := scheduleFields[5].Descriptor()
+	// schedule.DefaultTimezone holds the default value on creation for the timezone field.
+	schedule.DefaultTimezone = scheduleDescTimezone.Default.(string)
+	// scheduleDescJitter is the schema descriptor for jitter field.
+	scheduleDescJitter := scheduleFields[6].Descriptor()
+	// schedule.DefaultJitter holds the default value on creation for the jitter field.
+	schedule.DefaultJitter = scheduleDescJitter.Default.(int)
+	// schedule.JitterValidator is a validator for the "jitter" field. It is called by the builders before save.
+	schedule.JitterValidator = scheduleDescJitter.Validators[0].(func(int) error)
+	// scheduleDescPaused is the schema descriptor for paused field.
+	scheduleDescPaused := scheduleFields[7].Descriptor()
+	// schedule.DefaultPaused holds the default value on creation for the paused field.
+	schedule.DefaultPaused = scheduleDescPaused.Default.(bool)
+	// scheduleDescCreatedAt is the schema descriptor for created_at field.
+	scheduleDescCreatedAt := scheduleFields[11].Descriptor()
+	// schedule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	schedule.DefaultCreatedAt = scheduleDescCreatedAt.Default.(func() time.Time)
+	// scheduleDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduleDescUpdatedAt := scheduleFields[13].Descriptor()
+	// schedule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	schedule.DefaultUpdatedAt = scheduleDescUpdatedAt.Default.(func() time.Time)
+	// schedule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	schedule.UpdateDefaultUpdatedAt = scheduleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduleDescID is the schema descriptor for id field.
+	scheduleDescID := scheduleFields[0].Descriptor()
+	// schedule.DefaultID holds the default value on creation for the id field.
+	schedule.DefaultID = scheduleDescID.Default.(func() uuid.UUID)
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
@@ -105,4 +616,62 @@ func init() {
 	usergroupDescID := usergroupFields[0].Descriptor()
 	// usergroup.DefaultID holds the default value on creation for the id field.
 	usergroup.DefaultID = usergroupDescID.Default.(func() uuid.UUID)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescName is the schema descriptor for name field.
+	webhookDescName := webhookFields[1].Descriptor()
+	// webhook.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	webhook.NameValidator = webhookDescName.Validators[0].(func(string) error)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[2].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func(string) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[6].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[8].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	// webhookDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookDescUpdatedAt := webhookFields[10].Descriptor()
+	// webhook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhook.DefaultUpdatedAt = webhookDescUpdatedAt.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhook.UpdateDefaultUpdatedAt = webhookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEntityType is the schema descriptor for entity_type field.
+	webhookdeliveryDescEntityType := webhookdeliveryFields[2].Descriptor()
+	// webhookdelivery.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	webhookdelivery.EntityTypeValidator = webhookdeliveryDescEntityType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[6].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[12].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
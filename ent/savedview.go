@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/usergroup"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SavedView is the model entity for the SavedView schema.
+type SavedView struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the saved view. This is a UUID that is generated when the view is created.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The name of the view, which is shown in the list of views.
+	Name string `json:"name,omitempty"`
+	// A description of the view, which can be used to explain which items it contains.
+	Description string `json:"description,omitempty"`
+	// The subject of the user who owns the view. Only the owner can change or delete the view.
+	Owner string `json:"owner,omitempty"`
+	// The filter of the view as a query language expression, e.g. `class:server tag:prod location=fsn1`. An empty query matches all items.
+	Query string `json:"query,omitempty"`
+	// The sort order of the view as a list of fields, a leading minus sorts the field in descending order, e.g. ["-updated", "name"].
+	Sort []string `json:"sort,omitempty"`
+	// The columns that are shown in the item list when the view is used, in the order they are displayed.
+	Columns []string `json:"columns,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SavedViewQuery when eager-loading is set.
+	Edges                 SavedViewEdges `json:"edges"`
+	saved_view_user_group *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// SavedViewEdges holds the relations/edges for other nodes in the graph.
+type SavedViewEdges struct {
+	// The user group the view is shared with. Members of the group can use the view, views without a group are only visible to their owner.
+	UserGroup *UserGroup `json:"user_group,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserGroupOrErr returns the UserGroup value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SavedViewEdges) UserGroupOrErr() (*UserGroup, error) {
+	if e.UserGroup != nil {
+		return e.UserGroup, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: usergroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "user_group"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SavedView) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case savedview.FieldSort, savedview.FieldColumns:
+			values[i] = new([]byte)
+		case savedview.FieldName, savedview.FieldDescription, savedview.FieldOwner, savedview.FieldQuery, savedview.FieldCreatedBy, savedview.FieldUpdatedBy, savedview.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case savedview.FieldCreatedAt, savedview.FieldUpdatedAt, savedview.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case savedview.FieldID:
+			values[i] = new(uuid.UUID)
+		case savedview.ForeignKeys[0]: // saved_view_user_group
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SavedView fields.
+func (sv *SavedView) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case savedview.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sv.ID = *value
+			}
+		case savedview.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sv.Name = value.String
+			}
+		case savedview.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				sv.Description = value.String
+			}
+		case savedview.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				sv.Owner = value.String
+			}
+		case savedview.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				sv.Query = value.String
+			}
+		case savedview.FieldSort:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sv.Sort); err != nil {
+					return fmt.Errorf("unmarshal field sort: %w", err)
+				}
+			}
+		case savedview.FieldColumns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field columns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sv.Columns); err != nil {
+					return fmt.Errorf("unmarshal field columns: %w", err)
+				}
+			}
+		case savedview.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				sv.CreatedBy = value.String
+			}
+		case savedview.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sv.CreatedAt = value.Time
+			}
+		case savedview.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				sv.UpdatedBy = value.String
+			}
+		case savedview.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sv.UpdatedAt = value.Time
+			}
+		case savedview.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				sv.DeletedBy = value.String
+			}
+		case savedview.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				sv.DeletedAt = new(time.Time)
+				*sv.DeletedAt = value.Time
+			}
+		case savedview.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field saved_view_user_group", values[i])
+			} else if value.Valid {
+				sv.saved_view_user_group = new(uuid.UUID)
+				*sv.saved_view_user_group = *value.S.(*uuid.UUID)
+			}
+		default:
+			sv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SavedView.
+// This includes values selected through modifiers, order, etc.
+func (sv *SavedView) Value(name string) (ent.Value, error) {
+	return sv.selectValues.Get(name)
+}
+
+// QueryUserGroup queries the "user_group" edge of the SavedView entity.
+func (sv *SavedView) QueryUserGroup() *UserGroupQuery {
+	return NewSavedViewClient(sv.config).QueryUserGroup(sv)
+}
+
+// Update returns a builder for updating this SavedView.
+// Note that you need to call SavedView.Unwrap() before calling this method if this SavedView
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sv *SavedView) Update() *SavedViewUpdateOne {
+	return NewSavedViewClient(sv.config).UpdateOne(sv)
+}
+
+// Unwrap unwraps the SavedView entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sv *SavedView) Unwrap() *SavedView {
+	_tx, ok := sv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SavedView is not a transactional entity")
+	}
+	sv.config.driver = _tx.drv
+	return sv
+}
+
+// String implements the fmt.Stringer.
+func (sv *SavedView) String() string {
+	var builder strings.Builder
+	builder.WriteString("SavedView(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sv.ID))
+	builder.WriteString("name=")
+	builder.WriteString(sv.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(sv.Description)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(sv.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("query=")
+	builder.WriteString(sv.Query)
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(fmt.Sprintf("%v", sv.Sort))
+	builder.WriteString(", ")
+	builder.WriteString("columns=")
+	builder.WriteString(fmt.Sprintf("%v", sv.Columns))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(sv.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(sv.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(sv.DeletedBy)
+	builder.WriteString(", ")
+	if v := sv.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SavedViews is a parsable slice of SavedView.
+type SavedViews []*SavedView
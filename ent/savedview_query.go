@@ -0,0 +1,615 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/usergroup"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SavedViewQuery is the builder for querying SavedView entities.
+type SavedViewQuery struct {
+	config
+	ctx           *QueryContext
+	order         []savedview.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.SavedView
+	withUserGroup *UserGroupQuery
+	withFKs       bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SavedViewQuery builder.
+func (svq *SavedViewQuery) Where(ps ...predicate.SavedView) *SavedViewQuery {
+	svq.predicates = append(svq.predicates, ps...)
+	return svq
+}
+
+// Limit the number of records to be returned by this query.
+func (svq *SavedViewQuery) Limit(limit int) *SavedViewQuery {
+	svq.ctx.Limit = &limit
+	return svq
+}
+
+// Offset to start from.
+func (svq *SavedViewQuery) Offset(offset int) *SavedViewQuery {
+	svq.ctx.Offset = &offset
+	return svq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (svq *SavedViewQuery) Unique(unique bool) *SavedViewQuery {
+	svq.ctx.Unique = &unique
+	return svq
+}
+
+// Order specifies how the records should be ordered.
+func (svq *SavedViewQuery) Order(o ...savedview.OrderOption) *SavedViewQuery {
+	svq.order = append(svq.order, o...)
+	return svq
+}
+
+// QueryUserGroup chains the current query on the "user_group" edge.
+func (svq *SavedViewQuery) QueryUserGroup() *UserGroupQuery {
+	query := (&UserGroupClient{config: svq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := svq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := svq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedview.Table, savedview.FieldID, selector),
+			sqlgraph.To(usergroup.Table, usergroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, savedview.UserGroupTable, savedview.UserGroupColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(svq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SavedView entity from the query.
+// Returns a *NotFoundError when no SavedView was found.
+func (svq *SavedViewQuery) First(ctx context.Context) (*SavedView, error) {
+	nodes, err := svq.Limit(1).All(setContextOp(ctx, svq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{savedview.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (svq *SavedViewQuery) FirstX(ctx context.Context) *SavedView {
+	node, err := svq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SavedView ID from the query.
+// Returns a *NotFoundError when no SavedView ID was found.
+func (svq *SavedViewQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = svq.Limit(1).IDs(setContextOp(ctx, svq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{savedview.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (svq *SavedViewQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := svq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SavedView entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SavedView entity is found.
+// Returns a *NotFoundError when no SavedView entities are found.
+func (svq *SavedViewQuery) Only(ctx context.Context) (*SavedView, error) {
+	nodes, err := svq.Limit(2).All(setContextOp(ctx, svq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{savedview.Label}
+	default:
+		return nil, &NotSingularError{savedview.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (svq *SavedViewQuery) OnlyX(ctx context.Context) *SavedView {
+	node, err := svq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SavedView ID in the query.
+// Returns a *NotSingularError when more than one SavedView ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (svq *SavedViewQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = svq.Limit(2).IDs(setContextOp(ctx, svq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{savedview.Label}
+	default:
+		err = &NotSingularError{savedview.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (svq *SavedViewQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := svq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SavedViews.
+func (svq *SavedViewQuery) All(ctx context.Context) ([]*SavedView, error) {
+	ctx = setContextOp(ctx, svq.ctx, ent.OpQueryAll)
+	if err := svq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SavedView, *SavedViewQuery]()
+	return withInterceptors[[]*SavedView](ctx, svq, qr, svq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (svq *SavedViewQuery) AllX(ctx context.Context) []*SavedView {
+	nodes, err := svq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SavedView IDs.
+func (svq *SavedViewQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if svq.ctx.Unique == nil && svq.path != nil {
+		svq.Unique(true)
+	}
+	ctx = setContextOp(ctx, svq.ctx, ent.OpQueryIDs)
+	if err = svq.Select(savedview.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (svq *SavedViewQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := svq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (svq *SavedViewQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, svq.ctx, ent.OpQueryCount)
+	if err := svq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, svq, querierCount[*SavedViewQuery](), svq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (svq *SavedViewQuery) CountX(ctx context.Context) int {
+	count, err := svq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (svq *SavedViewQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, svq.ctx, ent.OpQueryExist)
+	switch _, err := svq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (svq *SavedViewQuery) ExistX(ctx context.Context) bool {
+	exist, err := svq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SavedViewQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (svq *SavedViewQuery) Clone() *SavedViewQuery {
+	if svq == nil {
+		return nil
+	}
+	return &SavedViewQuery{
+		config:        svq.config,
+		ctx:           svq.ctx.Clone(),
+		order:         append([]savedview.OrderOption{}, svq.order...),
+		inters:        append([]Interceptor{}, svq.inters...),
+		predicates:    append([]predicate.SavedView{}, svq.predicates...),
+		withUserGroup: svq.withUserGroup.Clone(),
+		// clone intermediate query.
+		sql:  svq.sql.Clone(),
+		path: svq.path,
+	}
+}
+
+// WithUserGroup tells the query-builder to eager-load the nodes that are connected to
+// the "user_group" edge. The optional arguments are used to configure the query builder of the edge.
+func (svq *SavedViewQuery) WithUserGroup(opts ...func(*UserGroupQuery)) *SavedViewQuery {
+	query := (&UserGroupClient{config: svq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	svq.withUserGroup = query
+	return svq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SavedView.Query().
+//		GroupBy(savedview.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (svq *SavedViewQuery) GroupBy(field string, fields ...string) *SavedViewGroupBy {
+	svq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SavedViewGroupBy{build: svq}
+	grbuild.flds = &svq.ctx.Fields
+	grbuild.label = savedview.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SavedView.Query().
+//		Select(savedview.FieldName).
+//		Scan(ctx, &v)
+func (svq *SavedViewQuery) Select(fields ...string) *SavedViewSelect {
+	svq.ctx.Fields = append(svq.ctx.Fields, fields...)
+	sbuild := &SavedViewSelect{SavedViewQuery: svq}
+	sbuild.label = savedview.Label
+	sbuild.flds, sbuild.scan = &svq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SavedViewSelect configured with the given aggregations.
+func (svq *SavedViewQuery) Aggregate(fns ...AggregateFunc) *SavedViewSelect {
+	return svq.Select().Aggregate(fns...)
+}
+
+func (svq *SavedViewQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range svq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, svq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range svq.ctx.Fields {
+		if !savedview.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if svq.path != nil {
+		prev, err := svq.path(ctx)
+		if err != nil {
+			return err
+		}
+		svq.sql = prev
+	}
+	return nil
+}
+
+func (svq *SavedViewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SavedView, error) {
+	var (
+		nodes       = []*SavedView{}
+		withFKs     = svq.withFKs
+		_spec       = svq.querySpec()
+		loadedTypes = [1]bool{
+			svq.withUserGroup != nil,
+		}
+	)
+	if svq.withUserGroup != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, savedview.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SavedView).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SavedView{config: svq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, svq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := svq.withUserGroup; query != nil {
+		if err := svq.loadUserGroup(ctx, query, nodes, nil,
+			func(n *SavedView, e *UserGroup) { n.Edges.UserGroup = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (svq *SavedViewQuery) loadUserGroup(ctx context.Context, query *UserGroupQuery, nodes []*SavedView, init func(*SavedView), assign func(*SavedView, *UserGroup)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SavedView)
+	for i := range nodes {
+		if nodes[i].saved_view_user_group == nil {
+			continue
+		}
+		fk := *nodes[i].saved_view_user_group
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(usergroup.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "saved_view_user_group" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (svq *SavedViewQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := svq.querySpec()
+	_spec.Node.Columns = svq.ctx.Fields
+	if len(svq.ctx.Fields) > 0 {
+		_spec.Unique = svq.ctx.Unique != nil && *svq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, svq.driver, _spec)
+}
+
+func (svq *SavedViewQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	_spec.From = svq.sql
+	if unique := svq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if svq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := svq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedview.FieldID)
+		for i := range fields {
+			if fields[i] != savedview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := svq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := svq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := svq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := svq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (svq *SavedViewQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(svq.driver.Dialect())
+	t1 := builder.Table(savedview.Table)
+	columns := svq.ctx.Fields
+	if len(columns) == 0 {
+		columns = savedview.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if svq.sql != nil {
+		selector = svq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if svq.ctx.Unique != nil && *svq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range svq.predicates {
+		p(selector)
+	}
+	for _, p := range svq.order {
+		p(selector)
+	}
+	if offset := svq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := svq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SavedViewGroupBy is the group-by builder for SavedView entities.
+type SavedViewGroupBy struct {
+	selector
+	build *SavedViewQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (svgb *SavedViewGroupBy) Aggregate(fns ...AggregateFunc) *SavedViewGroupBy {
+	svgb.fns = append(svgb.fns, fns...)
+	return svgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (svgb *SavedViewGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, svgb.build.ctx, ent.OpQueryGroupBy)
+	if err := svgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedViewQuery, *SavedViewGroupBy](ctx, svgb.build, svgb, svgb.build.inters, v)
+}
+
+func (svgb *SavedViewGroupBy) sqlScan(ctx context.Context, root *SavedViewQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(svgb.fns))
+	for _, fn := range svgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*svgb.flds)+len(svgb.fns))
+		for _, f := range *svgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*svgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := svgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SavedViewSelect is the builder for selecting fields of SavedView entities.
+type SavedViewSelect struct {
+	*SavedViewQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (svs *SavedViewSelect) Aggregate(fns ...AggregateFunc) *SavedViewSelect {
+	svs.fns = append(svs.fns, fns...)
+	return svs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (svs *SavedViewSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, svs.ctx, ent.OpQuerySelect)
+	if err := svs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SavedViewQuery, *SavedViewSelect](ctx, svs.SavedViewQuery, svs, svs.inters, v)
+}
+
+func (svs *SavedViewSelect) sqlScan(ctx context.Context, root *SavedViewQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(svs.fns))
+	for _, fn := range svs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*svs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := svs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,775 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/usergroup"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SavedViewUpdate is the builder for updating SavedView entities.
+type SavedViewUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SavedViewMutation
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (svu *SavedViewUpdate) Where(ps ...predicate.SavedView) *SavedViewUpdate {
+	svu.mutation.Where(ps...)
+	return svu
+}
+
+// SetName sets the "name" field.
+func (svu *SavedViewUpdate) SetName(s string) *SavedViewUpdate {
+	svu.mutation.SetName(s)
+	return svu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableName(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetName(*s)
+	}
+	return svu
+}
+
+// SetDescription sets the "description" field.
+func (svu *SavedViewUpdate) SetDescription(s string) *SavedViewUpdate {
+	svu.mutation.SetDescription(s)
+	return svu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableDescription(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetDescription(*s)
+	}
+	return svu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (svu *SavedViewUpdate) ClearDescription() *SavedViewUpdate {
+	svu.mutation.ClearDescription()
+	return svu
+}
+
+// SetQuery sets the "query" field.
+func (svu *SavedViewUpdate) SetQuery(s string) *SavedViewUpdate {
+	svu.mutation.SetQuery(s)
+	return svu
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableQuery(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetQuery(*s)
+	}
+	return svu
+}
+
+// ClearQuery clears the value of the "query" field.
+func (svu *SavedViewUpdate) ClearQuery() *SavedViewUpdate {
+	svu.mutation.ClearQuery()
+	return svu
+}
+
+// SetSort sets the "sort" field.
+func (svu *SavedViewUpdate) SetSort(s []string) *SavedViewUpdate {
+	svu.mutation.SetSort(s)
+	return svu
+}
+
+// AppendSort appends s to the "sort" field.
+func (svu *SavedViewUpdate) AppendSort(s []string) *SavedViewUpdate {
+	svu.mutation.AppendSort(s)
+	return svu
+}
+
+// ClearSort clears the value of the "sort" field.
+func (svu *SavedViewUpdate) ClearSort() *SavedViewUpdate {
+	svu.mutation.ClearSort()
+	return svu
+}
+
+// SetColumns sets the "columns" field.
+func (svu *SavedViewUpdate) SetColumns(s []string) *SavedViewUpdate {
+	svu.mutation.SetColumns(s)
+	return svu
+}
+
+// AppendColumns appends s to the "columns" field.
+func (svu *SavedViewUpdate) AppendColumns(s []string) *SavedViewUpdate {
+	svu.mutation.AppendColumns(s)
+	return svu
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (svu *SavedViewUpdate) ClearColumns() *SavedViewUpdate {
+	svu.mutation.ClearColumns()
+	return svu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (svu *SavedViewUpdate) SetCreatedBy(s string) *SavedViewUpdate {
+	svu.mutation.SetCreatedBy(s)
+	return svu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableCreatedBy(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetCreatedBy(*s)
+	}
+	return svu
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (svu *SavedViewUpdate) SetUpdatedBy(s string) *SavedViewUpdate {
+	svu.mutation.SetUpdatedBy(s)
+	return svu
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableUpdatedBy(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetUpdatedBy(*s)
+	}
+	return svu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svu *SavedViewUpdate) SetUpdatedAt(t time.Time) *SavedViewUpdate {
+	svu.mutation.SetUpdatedAt(t)
+	return svu
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (svu *SavedViewUpdate) SetDeletedBy(s string) *SavedViewUpdate {
+	svu.mutation.SetDeletedBy(s)
+	return svu
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableDeletedBy(s *string) *SavedViewUpdate {
+	if s != nil {
+		svu.SetDeletedBy(*s)
+	}
+	return svu
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (svu *SavedViewUpdate) ClearDeletedBy() *SavedViewUpdate {
+	svu.mutation.ClearDeletedBy()
+	return svu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (svu *SavedViewUpdate) SetDeletedAt(t time.Time) *SavedViewUpdate {
+	svu.mutation.SetDeletedAt(t)
+	return svu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableDeletedAt(t *time.Time) *SavedViewUpdate {
+	if t != nil {
+		svu.SetDeletedAt(*t)
+	}
+	return svu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (svu *SavedViewUpdate) ClearDeletedAt() *SavedViewUpdate {
+	svu.mutation.ClearDeletedAt()
+	return svu
+}
+
+// SetUserGroupID sets the "user_group" edge to the UserGroup entity by ID.
+func (svu *SavedViewUpdate) SetUserGroupID(id uuid.UUID) *SavedViewUpdate {
+	svu.mutation.SetUserGroupID(id)
+	return svu
+}
+
+// SetNillableUserGroupID sets the "user_group" edge to the UserGroup entity by ID if the given value is not nil.
+func (svu *SavedViewUpdate) SetNillableUserGroupID(id *uuid.UUID) *SavedViewUpdate {
+	if id != nil {
+		svu = svu.SetUserGroupID(*id)
+	}
+	return svu
+}
+
+// SetUserGroup sets the "user_group" edge to the UserGroup entity.
+func (svu *SavedViewUpdate) SetUserGroup(u *UserGroup) *SavedViewUpdate {
+	return svu.SetUserGroupID(u.ID)
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (svu *SavedViewUpdate) Mutation() *SavedViewMutation {
+	return svu.mutation
+}
+
+// ClearUserGroup clears the "user_group" edge to the UserGroup entity.
+func (svu *SavedViewUpdate) ClearUserGroup() *SavedViewUpdate {
+	svu.mutation.ClearUserGroup()
+	return svu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (svu *SavedViewUpdate) Save(ctx context.Context) (int, error) {
+	svu.defaults()
+	return withHooks(ctx, svu.sqlSave, svu.mutation, svu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svu *SavedViewUpdate) SaveX(ctx context.Context) int {
+	affected, err := svu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (svu *SavedViewUpdate) Exec(ctx context.Context) error {
+	_, err := svu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svu *SavedViewUpdate) ExecX(ctx context.Context) {
+	if err := svu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svu *SavedViewUpdate) defaults() {
+	if _, ok := svu.mutation.UpdatedAt(); !ok {
+		v := savedview.UpdateDefaultUpdatedAt()
+		svu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svu *SavedViewUpdate) check() error {
+	if v, ok := svu.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (svu *SavedViewUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := svu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	if ps := svu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svu.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := svu.mutation.Description(); ok {
+		_spec.SetField(savedview.FieldDescription, field.TypeString, value)
+	}
+	if svu.mutation.DescriptionCleared() {
+		_spec.ClearField(savedview.FieldDescription, field.TypeString)
+	}
+	if value, ok := svu.mutation.Query(); ok {
+		_spec.SetField(savedview.FieldQuery, field.TypeString, value)
+	}
+	if svu.mutation.QueryCleared() {
+		_spec.ClearField(savedview.FieldQuery, field.TypeString)
+	}
+	if value, ok := svu.mutation.Sort(); ok {
+		_spec.SetField(savedview.FieldSort, field.TypeJSON, value)
+	}
+	if value, ok := svu.mutation.AppendedSort(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedview.FieldSort, value)
+		})
+	}
+	if svu.mutation.SortCleared() {
+		_spec.ClearField(savedview.FieldSort, field.TypeJSON)
+	}
+	if value, ok := svu.mutation.Columns(); ok {
+		_spec.SetField(savedview.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := svu.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedview.FieldColumns, value)
+		})
+	}
+	if svu.mutation.ColumnsCleared() {
+		_spec.ClearField(savedview.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := svu.mutation.CreatedBy(); ok {
+		_spec.SetField(savedview.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := svu.mutation.UpdatedBy(); ok {
+		_spec.SetField(savedview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := svu.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := svu.mutation.DeletedBy(); ok {
+		_spec.SetField(savedview.FieldDeletedBy, field.TypeString, value)
+	}
+	if svu.mutation.DeletedByCleared() {
+		_spec.ClearField(savedview.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := svu.mutation.DeletedAt(); ok {
+		_spec.SetField(savedview.FieldDeletedAt, field.TypeTime, value)
+	}
+	if svu.mutation.DeletedAtCleared() {
+		_spec.ClearField(savedview.FieldDeletedAt, field.TypeTime)
+	}
+	if svu.mutation.UserGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedview.UserGroupTable,
+			Columns: []string{savedview.UserGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := svu.mutation.UserGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedview.UserGroupTable,
+			Columns: []string{savedview.UserGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, svu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	svu.mutation.done = true
+	return n, nil
+}
+
+// SavedViewUpdateOne is the builder for updating a single SavedView entity.
+type SavedViewUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SavedViewMutation
+}
+
+// SetName sets the "name" field.
+func (svuo *SavedViewUpdateOne) SetName(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetName(s)
+	return svuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableName(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetName(*s)
+	}
+	return svuo
+}
+
+// SetDescription sets the "description" field.
+func (svuo *SavedViewUpdateOne) SetDescription(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetDescription(s)
+	return svuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableDescription(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetDescription(*s)
+	}
+	return svuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (svuo *SavedViewUpdateOne) ClearDescription() *SavedViewUpdateOne {
+	svuo.mutation.ClearDescription()
+	return svuo
+}
+
+// SetQuery sets the "query" field.
+func (svuo *SavedViewUpdateOne) SetQuery(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetQuery(s)
+	return svuo
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableQuery(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetQuery(*s)
+	}
+	return svuo
+}
+
+// ClearQuery clears the value of the "query" field.
+func (svuo *SavedViewUpdateOne) ClearQuery() *SavedViewUpdateOne {
+	svuo.mutation.ClearQuery()
+	return svuo
+}
+
+// SetSort sets the "sort" field.
+func (svuo *SavedViewUpdateOne) SetSort(s []string) *SavedViewUpdateOne {
+	svuo.mutation.SetSort(s)
+	return svuo
+}
+
+// AppendSort appends s to the "sort" field.
+func (svuo *SavedViewUpdateOne) AppendSort(s []string) *SavedViewUpdateOne {
+	svuo.mutation.AppendSort(s)
+	return svuo
+}
+
+// ClearSort clears the value of the "sort" field.
+func (svuo *SavedViewUpdateOne) ClearSort() *SavedViewUpdateOne {
+	svuo.mutation.ClearSort()
+	return svuo
+}
+
+// SetColumns sets the "columns" field.
+func (svuo *SavedViewUpdateOne) SetColumns(s []string) *SavedViewUpdateOne {
+	svuo.mutation.SetColumns(s)
+	return svuo
+}
+
+// AppendColumns appends s to the "columns" field.
+func (svuo *SavedViewUpdateOne) AppendColumns(s []string) *SavedViewUpdateOne {
+	svuo.mutation.AppendColumns(s)
+	return svuo
+}
+
+// ClearColumns clears the value of the "columns" field.
+func (svuo *SavedViewUpdateOne) ClearColumns() *SavedViewUpdateOne {
+	svuo.mutation.ClearColumns()
+	return svuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (svuo *SavedViewUpdateOne) SetCreatedBy(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetCreatedBy(s)
+	return svuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableCreatedBy(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetCreatedBy(*s)
+	}
+	return svuo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (svuo *SavedViewUpdateOne) SetUpdatedBy(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetUpdatedBy(s)
+	return svuo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableUpdatedBy(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetUpdatedBy(*s)
+	}
+	return svuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (svuo *SavedViewUpdateOne) SetUpdatedAt(t time.Time) *SavedViewUpdateOne {
+	svuo.mutation.SetUpdatedAt(t)
+	return svuo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (svuo *SavedViewUpdateOne) SetDeletedBy(s string) *SavedViewUpdateOne {
+	svuo.mutation.SetDeletedBy(s)
+	return svuo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableDeletedBy(s *string) *SavedViewUpdateOne {
+	if s != nil {
+		svuo.SetDeletedBy(*s)
+	}
+	return svuo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (svuo *SavedViewUpdateOne) ClearDeletedBy() *SavedViewUpdateOne {
+	svuo.mutation.ClearDeletedBy()
+	return svuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (svuo *SavedViewUpdateOne) SetDeletedAt(t time.Time) *SavedViewUpdateOne {
+	svuo.mutation.SetDeletedAt(t)
+	return svuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableDeletedAt(t *time.Time) *SavedViewUpdateOne {
+	if t != nil {
+		svuo.SetDeletedAt(*t)
+	}
+	return svuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (svuo *SavedViewUpdateOne) ClearDeletedAt() *SavedViewUpdateOne {
+	svuo.mutation.ClearDeletedAt()
+	return svuo
+}
+
+// SetUserGroupID sets the "user_group" edge to the UserGroup entity by ID.
+func (svuo *SavedViewUpdateOne) SetUserGroupID(id uuid.UUID) *SavedViewUpdateOne {
+	svuo.mutation.SetUserGroupID(id)
+	return svuo
+}
+
+// SetNillableUserGroupID sets the "user_group" edge to the UserGroup entity by ID if the given value is not nil.
+func (svuo *SavedViewUpdateOne) SetNillableUserGroupID(id *uuid.UUID) *SavedViewUpdateOne {
+	if id != nil {
+		svuo = svuo.SetUserGroupID(*id)
+	}
+	return svuo
+}
+
+// SetUserGroup sets the "user_group" edge to the UserGroup entity.
+func (svuo *SavedViewUpdateOne) SetUserGroup(u *UserGroup) *SavedViewUpdateOne {
+	return svuo.SetUserGroupID(u.ID)
+}
+
+// Mutation returns the SavedViewMutation object of the builder.
+func (svuo *SavedViewUpdateOne) Mutation() *SavedViewMutation {
+	return svuo.mutation
+}
+
+// ClearUserGroup clears the "user_group" edge to the UserGroup entity.
+func (svuo *SavedViewUpdateOne) ClearUserGroup() *SavedViewUpdateOne {
+	svuo.mutation.ClearUserGroup()
+	return svuo
+}
+
+// Where appends a list predicates to the SavedViewUpdate builder.
+func (svuo *SavedViewUpdateOne) Where(ps ...predicate.SavedView) *SavedViewUpdateOne {
+	svuo.mutation.Where(ps...)
+	return svuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (svuo *SavedViewUpdateOne) Select(field string, fields ...string) *SavedViewUpdateOne {
+	svuo.fields = append([]string{field}, fields...)
+	return svuo
+}
+
+// Save executes the query and returns the updated SavedView entity.
+func (svuo *SavedViewUpdateOne) Save(ctx context.Context) (*SavedView, error) {
+	svuo.defaults()
+	return withHooks(ctx, svuo.sqlSave, svuo.mutation, svuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (svuo *SavedViewUpdateOne) SaveX(ctx context.Context) *SavedView {
+	node, err := svuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (svuo *SavedViewUpdateOne) Exec(ctx context.Context) error {
+	_, err := svuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (svuo *SavedViewUpdateOne) ExecX(ctx context.Context) {
+	if err := svuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (svuo *SavedViewUpdateOne) defaults() {
+	if _, ok := svuo.mutation.UpdatedAt(); !ok {
+		v := savedview.UpdateDefaultUpdatedAt()
+		svuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (svuo *SavedViewUpdateOne) check() error {
+	if v, ok := svuo.mutation.Name(); ok {
+		if err := savedview.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedView.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (svuo *SavedViewUpdateOne) sqlSave(ctx context.Context) (_node *SavedView, err error) {
+	if err := svuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedview.Table, savedview.Columns, sqlgraph.NewFieldSpec(savedview.FieldID, field.TypeUUID))
+	id, ok := svuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedView.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := svuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedview.FieldID)
+		for _, f := range fields {
+			if !savedview.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedview.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := svuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := svuo.mutation.Name(); ok {
+		_spec.SetField(savedview.FieldName, field.TypeString, value)
+	}
+	if value, ok := svuo.mutation.Description(); ok {
+		_spec.SetField(savedview.FieldDescription, field.TypeString, value)
+	}
+	if svuo.mutation.DescriptionCleared() {
+		_spec.ClearField(savedview.FieldDescription, field.TypeString)
+	}
+	if value, ok := svuo.mutation.Query(); ok {
+		_spec.SetField(savedview.FieldQuery, field.TypeString, value)
+	}
+	if svuo.mutation.QueryCleared() {
+		_spec.ClearField(savedview.FieldQuery, field.TypeString)
+	}
+	if value, ok := svuo.mutation.Sort(); ok {
+		_spec.SetField(savedview.FieldSort, field.TypeJSON, value)
+	}
+	if value, ok := svuo.mutation.AppendedSort(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedview.FieldSort, value)
+		})
+	}
+	if svuo.mutation.SortCleared() {
+		_spec.ClearField(savedview.FieldSort, field.TypeJSON)
+	}
+	if value, ok := svuo.mutation.Columns(); ok {
+		_spec.SetField(savedview.FieldColumns, field.TypeJSON, value)
+	}
+	if value, ok := svuo.mutation.AppendedColumns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, savedview.FieldColumns, value)
+		})
+	}
+	if svuo.mutation.ColumnsCleared() {
+		_spec.ClearField(savedview.FieldColumns, field.TypeJSON)
+	}
+	if value, ok := svuo.mutation.CreatedBy(); ok {
+		_spec.SetField(savedview.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := svuo.mutation.UpdatedBy(); ok {
+		_spec.SetField(savedview.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := svuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedview.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := svuo.mutation.DeletedBy(); ok {
+		_spec.SetField(savedview.FieldDeletedBy, field.TypeString, value)
+	}
+	if svuo.mutation.DeletedByCleared() {
+		_spec.ClearField(savedview.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := svuo.mutation.DeletedAt(); ok {
+		_spec.SetField(savedview.FieldDeletedAt, field.TypeTime, value)
+	}
+	if svuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(savedview.FieldDeletedAt, field.TypeTime)
+	}
+	if svuo.mutation.UserGroupCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedview.UserGroupTable,
+			Columns: []string{savedview.UserGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := svuo.mutation.UserGroupIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   savedview.UserGroupTable,
+			Columns: []string{savedview.UserGroupColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(usergroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SavedView{config: svuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, svuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedview.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	svuo.mutation.done = true
+	return _node, nil
+}
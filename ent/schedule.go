@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"dig-inv/ent/schedule"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Schedule is the model entity for the Schedule schema.
+type Schedule struct {
+	config `json:"-"`
+	// ID of the ent.
+	// The unique identifier for the schedule. This is a UUID that is generated when the schedule is created.
+	ID uuid.UUID `json:"id,omitempty"`
+	// The name of the schedule, which is used to identify it in the user interface and the logs.
+	Name string `json:"name,omitempty"`
+	// The type of the queued job runs, which determines the handler that executes them, e.g. `export`.
+	JobType string `json:"job_type,omitempty"`
+	// The parameters passed to every queued job run.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// The cron expression of the schedule with five fields, e.g. `0 3 * * *`, or a descriptor like `@daily`.
+	Cron string `json:"cron,omitempty"`
+	// The IANA name of the timezone the cron expression is evaluated in, e.g. `Europe/Berlin`.
+	Timezone string `json:"timezone,omitempty"`
+	// The maximum number of seconds a run is delayed by a random amount, to spread the load of schedules with the same expression.
+	Jitter int `json:"jitter,omitempty"`
+	// Whether the schedule is paused. Paused schedules don't queue runs, but can still be run manually.
+	Paused bool `json:"paused,omitempty"`
+	// The time when the next run is queued, including the jitter. Empty while the schedule is paused.
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	// The time when the last run was queued, either by a worker or manually.
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+	// The user who created the resource in the inventory system. This is used for auditing purposes and to track who added the resource.
+	CreatedBy string `json:"created_by,omitempty"`
+	// When the resource was created in the inventory system.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// The user who last updated the resource in the inventory system. This is used for auditing purposes and to track who modified the resource.
+	UpdatedBy string `json:"updated_by,omitempty"`
+	// The time when the resource was last updated in the inventory system.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The user who deleted the resource from the inventory system. This is used for auditing purposes and to track who removed the resource.
+	DeletedBy string `json:"deleted_by,omitempty"`
+	// The time when the resource was deleted from the inventory system.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ScheduleQuery when eager-loading is set.
+	Edges        ScheduleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ScheduleEdges holds the relations/edges for other nodes in the graph.
+type ScheduleEdges struct {
+	// The job runs queued by the schedule.
+	Runs []*JobRun `json:"runs,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunsOrErr returns the Runs value or an error if the edge
+// was not loaded in eager-loading.
+func (e ScheduleEdges) RunsOrErr() ([]*JobRun, error) {
+	if e.loadedTypes[0] {
+		return e.Runs, nil
+	}
+	return nil, &NotLoadedError{edge: "runs"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Schedule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldParameters:
+			values[i] = new([]byte)
+		case schedule.FieldPaused:
+			values[i] = new(sql.NullBool)
+		case schedule.FieldJitter:
+			values[i] = new(sql.NullInt64)
+		case schedule.FieldName, schedule.FieldJobType, schedule.FieldCron, schedule.FieldTimezone, schedule.FieldCreatedBy, schedule.FieldUpdatedBy, schedule.FieldDeletedBy:
+			values[i] = new(sql.NullString)
+		case schedule.FieldNextRunAt, schedule.FieldLastRunAt, schedule.FieldCreatedAt, schedule.FieldUpdatedAt, schedule.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case schedule.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Schedule fields.
+func (s *Schedule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case schedule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				s.ID = *value
+			}
+		case schedule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case schedule.FieldJobType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field job_type", values[i])
+			} else if value.Valid {
+				s.JobType = value.String
+			}
+		case schedule.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case schedule.FieldCron:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron", values[i])
+			} else if value.Valid {
+				s.Cron = value.String
+			}
+		case schedule.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				s.Timezone = value.String
+			}
+		case schedule.FieldJitter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jitter", values[i])
+			} else if value.Valid {
+				s.Jitter = int(value.Int64)
+			}
+		case schedule.FieldPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paused", values[i])
+			} else if value.Valid {
+				s.Paused = value.Bool
+			}
+		case schedule.FieldNextRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_run_at", values[i])
+			} else if value.Valid {
+				s.NextRunAt = new(time.Time)
+				*s.NextRunAt = value.Time
+			}
+		case schedule.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				s.LastRunAt = new(time.Time)
+				*s.LastRunAt = value.Time
+			}
+		case schedule.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				s.CreatedBy = value.String
+			}
+		case schedule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case schedule.FieldUpdatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_by", values[i])
+			} else if value.Valid {
+				s.UpdatedBy = value.String
+			}
+		case schedule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case schedule.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				s.DeletedBy = value.String
+			}
+		case schedule.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				s.DeletedAt = new(time.Time)
+				*s.DeletedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Schedule.
+// This includes values selected through modifiers, order, etc.
+func (s *Schedule) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryRuns queries the "runs" edge of the Schedule entity.
+func (s *Schedule) QueryRuns() *JobRunQuery {
+	return NewScheduleClient(s.config).QueryRuns(s)
+}
+
+// Update returns a builder for updating this Schedule.
+// Note that you need to call Schedule.Unwrap() before calling this method if this Schedule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Schedule) Update() *ScheduleUpdateOne {
+	return NewScheduleClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Schedule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Schedule) Unwrap() *Schedule {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Schedule is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Schedule) String() string {
+	var builder strings.Builder
+	builder.WriteString("Schedule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("job_type=")
+	builder.WriteString(s.JobType)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", s.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("cron=")
+	builder.WriteString(s.Cron)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(s.Timezone)
+	builder.WriteString(", ")
+	builder.WriteString("jitter=")
+	builder.WriteString(fmt.Sprintf("%v", s.Jitter))
+	builder.WriteString(", ")
+	builder.WriteString("paused=")
+	builder.WriteString(fmt.Sprintf("%v", s.Paused))
+	builder.WriteString(", ")
+	if v := s.NextRunAt; v != nil {
+		builder.WriteString("next_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(s.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_by=")
+	builder.WriteString(s.UpdatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(s.DeletedBy)
+	builder.WriteString(", ")
+	if v := s.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Schedules is a parsable slice of Schedule.
+type Schedules []*Schedule
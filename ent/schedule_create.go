@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/schedule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduleCreate is the builder for creating a Schedule entity.
+type ScheduleCreate struct {
+	config
+	mutation *ScheduleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sc *ScheduleCreate) SetName(s string) *ScheduleCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetJobType sets the "job_type" field.
+func (sc *ScheduleCreate) SetJobType(s string) *ScheduleCreate {
+	sc.mutation.SetJobType(s)
+	return sc
+}
+
+// SetParameters sets the "parameters" field.
+func (sc *ScheduleCreate) SetParameters(m map[string]string) *ScheduleCreate {
+	sc.mutation.SetParameters(m)
+	return sc
+}
+
+// SetCron sets the "cron" field.
+func (sc *ScheduleCreate) SetCron(s string) *ScheduleCreate {
+	sc.mutation.SetCron(s)
+	return sc
+}
+
+// SetTimezone sets the "timezone" field.
+func (sc *ScheduleCreate) SetTimezone(s string) *ScheduleCreate {
+	sc.mutation.SetTimezone(s)
+	return sc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableTimezone(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetTimezone(*s)
+	}
+	return sc
+}
+
+// SetJitter sets the "jitter" field.
+func (sc *ScheduleCreate) SetJitter(i int) *ScheduleCreate {
+	sc.mutation.SetJitter(i)
+	return sc
+}
+
+// SetNillableJitter sets the "jitter" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableJitter(i *int) *ScheduleCreate {
+	if i != nil {
+		sc.SetJitter(*i)
+	}
+	return sc
+}
+
+// SetPaused sets the "paused" field.
+func (sc *ScheduleCreate) SetPaused(b bool) *ScheduleCreate {
+	sc.mutation.SetPaused(b)
+	return sc
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillablePaused(b *bool) *ScheduleCreate {
+	if b != nil {
+		sc.SetPaused(*b)
+	}
+	return sc
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (sc *ScheduleCreate) SetNextRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetNextRunAt(t)
+	return sc
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableNextRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetNextRunAt(*t)
+	}
+	return sc
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (sc *ScheduleCreate) SetLastRunAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetLastRunAt(t)
+	return sc
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableLastRunAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetLastRunAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (sc *ScheduleCreate) SetCreatedBy(s string) *ScheduleCreate {
+	sc.mutation.SetCreatedBy(s)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *ScheduleCreate) SetCreatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableCreatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (sc *ScheduleCreate) SetUpdatedBy(s string) *ScheduleCreate {
+	sc.mutation.SetUpdatedBy(s)
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *ScheduleCreate) SetUpdatedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableUpdatedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (sc *ScheduleCreate) SetDeletedBy(s string) *ScheduleCreate {
+	sc.mutation.SetDeletedBy(s)
+	return sc
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableDeletedBy(s *string) *ScheduleCreate {
+	if s != nil {
+		sc.SetDeletedBy(*s)
+	}
+	return sc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (sc *ScheduleCreate) SetDeletedAt(t time.Time) *ScheduleCreate {
+	sc.mutation.SetDeletedAt(t)
+	return sc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableDeletedAt(t *time.Time) *ScheduleCreate {
+	if t != nil {
+		sc.SetDeletedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the "id" field.
+func (sc *ScheduleCreate) SetID(u uuid.UUID) *ScheduleCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sc *ScheduleCreate) SetNillableID(u *uuid.UUID) *ScheduleCreate {
+	if u != nil {
+		sc.SetID(*u)
+	}
+	return sc
+}
+
+// AddRunIDs adds the "runs" edge to the JobRun entity by IDs.
+func (sc *ScheduleCreate) AddRunIDs(ids ...uuid.UUID) *ScheduleCreate {
+	sc.mutation.AddRunIDs(ids...)
+	return sc
+}
+
+// AddRuns adds the "runs" edges to the JobRun entity.
+func (sc *ScheduleCreate) AddRuns(j ...*JobRun) *ScheduleCreate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return sc.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (sc *ScheduleCreate) Mutation() *ScheduleMutation {
+	return sc.mutation
+}
+
+// Save creates the Schedule in the database.
+func (sc *ScheduleCreate) Save(ctx context.Context) (*Schedule, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ScheduleCreate) SaveX(ctx context.Context) *Schedule {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *ScheduleCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *ScheduleCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *ScheduleCreate) defaults() {
+	if _, ok := sc.mutation.Timezone(); !ok {
+		v := schedule.DefaultTimezone
+		sc.mutation.SetTimezone(v)
+	}
+	if _, ok := sc.mutation.Jitter(); !ok {
+		v := schedule.DefaultJitter
+		sc.mutation.SetJitter(v)
+	}
+	if _, ok := sc.mutation.Paused(); !ok {
+		v := schedule.DefaultPaused
+		sc.mutation.SetPaused(v)
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := schedule.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := schedule.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sc.mutation.ID(); !ok {
+		v := schedule.DefaultID()
+		sc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *ScheduleCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Schedule.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.JobType(); !ok {
+		return &ValidationError{Name: "job_type", err: errors.New(`ent: missing required field "Schedule.job_type"`)}
+	}
+	if v, ok := sc.mutation.JobType(); ok {
+		if err := schedule.JobTypeValidator(v); err != nil {
+			return &ValidationError{Name: "job_type", err: fmt.Errorf(`ent: validator failed for field "Schedule.job_type": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Cron(); !ok {
+		return &ValidationError{Name: "cron", err: errors.New(`ent: missing required field "Schedule.cron"`)}
+	}
+	if v, ok := sc.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "Schedule.timezone"`)}
+	}
+	if _, ok := sc.mutation.Jitter(); !ok {
+		return &ValidationError{Name: "jitter", err: errors.New(`ent: missing required field "Schedule.jitter"`)}
+	}
+	if v, ok := sc.mutation.Jitter(); ok {
+		if err := schedule.JitterValidator(v); err != nil {
+			return &ValidationError{Name: "jitter", err: fmt.Errorf(`ent: validator failed for field "Schedule.jitter": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Paused(); !ok {
+		return &ValidationError{Name: "paused", err: errors.New(`ent: missing required field "Schedule.paused"`)}
+	}
+	if _, ok := sc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Schedule.created_by"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Schedule.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedBy(); !ok {
+		return &ValidationError{Name: "updated_by", err: errors.New(`ent: missing required field "Schedule.updated_by"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Schedule.updated_at"`)}
+	}
+	return nil
+}
+
+func (sc *ScheduleCreate) sqlSave(ctx context.Context) (*Schedule, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *ScheduleCreate) createSpec() (*Schedule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Schedule{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(schedule.Table, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID))
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.JobType(); ok {
+		_spec.SetField(schedule.FieldJobType, field.TypeString, value)
+		_node.JobType = value
+	}
+	if value, ok := sc.mutation.Parameters(); ok {
+		_spec.SetField(schedule.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := sc.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+		_node.Cron = value
+	}
+	if value, ok := sc.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := sc.mutation.Jitter(); ok {
+		_spec.SetField(schedule.FieldJitter, field.TypeInt, value)
+		_node.Jitter = value
+	}
+	if value, ok := sc.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+		_node.Paused = value
+	}
+	if value, ok := sc.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+		_node.NextRunAt = &value
+	}
+	if value, ok := sc.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := sc.mutation.CreatedBy(); ok {
+		_spec.SetField(schedule.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(schedule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedBy(); ok {
+		_spec.SetField(schedule.FieldUpdatedBy, field.TypeString, value)
+		_node.UpdatedBy = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.DeletedBy(); ok {
+		_spec.SetField(schedule.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
+	if value, ok := sc.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if nodes := sc.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ScheduleCreateBulk is the builder for creating many Schedule entities in bulk.
+type ScheduleCreateBulk struct {
+	config
+	err      error
+	builders []*ScheduleCreate
+}
+
+// Save creates the Schedule entities in the database.
+func (scb *ScheduleCreateBulk) Save(ctx context.Context) ([]*Schedule, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Schedule, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) SaveX(ctx context.Context) []*Schedule {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *ScheduleCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *ScheduleCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,968 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schedule"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ScheduleUpdate is the builder for updating Schedule entities.
+type ScheduleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (su *ScheduleUpdate) Where(ps ...predicate.Schedule) *ScheduleUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *ScheduleUpdate) SetName(s string) *ScheduleUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableName(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetJobType sets the "job_type" field.
+func (su *ScheduleUpdate) SetJobType(s string) *ScheduleUpdate {
+	su.mutation.SetJobType(s)
+	return su
+}
+
+// SetNillableJobType sets the "job_type" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableJobType(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetJobType(*s)
+	}
+	return su
+}
+
+// SetParameters sets the "parameters" field.
+func (su *ScheduleUpdate) SetParameters(m map[string]string) *ScheduleUpdate {
+	su.mutation.SetParameters(m)
+	return su
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (su *ScheduleUpdate) ClearParameters() *ScheduleUpdate {
+	su.mutation.ClearParameters()
+	return su
+}
+
+// SetCron sets the "cron" field.
+func (su *ScheduleUpdate) SetCron(s string) *ScheduleUpdate {
+	su.mutation.SetCron(s)
+	return su
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableCron(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetCron(*s)
+	}
+	return su
+}
+
+// SetTimezone sets the "timezone" field.
+func (su *ScheduleUpdate) SetTimezone(s string) *ScheduleUpdate {
+	su.mutation.SetTimezone(s)
+	return su
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableTimezone(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetTimezone(*s)
+	}
+	return su
+}
+
+// SetJitter sets the "jitter" field.
+func (su *ScheduleUpdate) SetJitter(i int) *ScheduleUpdate {
+	su.mutation.ResetJitter()
+	su.mutation.SetJitter(i)
+	return su
+}
+
+// SetNillableJitter sets the "jitter" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableJitter(i *int) *ScheduleUpdate {
+	if i != nil {
+		su.SetJitter(*i)
+	}
+	return su
+}
+
+// AddJitter adds i to the "jitter" field.
+func (su *ScheduleUpdate) AddJitter(i int) *ScheduleUpdate {
+	su.mutation.AddJitter(i)
+	return su
+}
+
+// SetPaused sets the "paused" field.
+func (su *ScheduleUpdate) SetPaused(b bool) *ScheduleUpdate {
+	su.mutation.SetPaused(b)
+	return su
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillablePaused(b *bool) *ScheduleUpdate {
+	if b != nil {
+		su.SetPaused(*b)
+	}
+	return su
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (su *ScheduleUpdate) SetNextRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetNextRunAt(t)
+	return su
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableNextRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetNextRunAt(*t)
+	}
+	return su
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (su *ScheduleUpdate) ClearNextRunAt() *ScheduleUpdate {
+	su.mutation.ClearNextRunAt()
+	return su
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (su *ScheduleUpdate) SetLastRunAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetLastRunAt(t)
+	return su
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableLastRunAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetLastRunAt(*t)
+	}
+	return su
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (su *ScheduleUpdate) ClearLastRunAt() *ScheduleUpdate {
+	su.mutation.ClearLastRunAt()
+	return su
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (su *ScheduleUpdate) SetCreatedBy(s string) *ScheduleUpdate {
+	su.mutation.SetCreatedBy(s)
+	return su
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableCreatedBy(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetCreatedBy(*s)
+	}
+	return su
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (su *ScheduleUpdate) SetUpdatedBy(s string) *ScheduleUpdate {
+	su.mutation.SetUpdatedBy(s)
+	return su
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableUpdatedBy(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetUpdatedBy(*s)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ScheduleUpdate) SetUpdatedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (su *ScheduleUpdate) SetDeletedBy(s string) *ScheduleUpdate {
+	su.mutation.SetDeletedBy(s)
+	return su
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableDeletedBy(s *string) *ScheduleUpdate {
+	if s != nil {
+		su.SetDeletedBy(*s)
+	}
+	return su
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (su *ScheduleUpdate) ClearDeletedBy() *ScheduleUpdate {
+	su.mutation.ClearDeletedBy()
+	return su
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (su *ScheduleUpdate) SetDeletedAt(t time.Time) *ScheduleUpdate {
+	su.mutation.SetDeletedAt(t)
+	return su
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (su *ScheduleUpdate) SetNillableDeletedAt(t *time.Time) *ScheduleUpdate {
+	if t != nil {
+		su.SetDeletedAt(*t)
+	}
+	return su
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (su *ScheduleUpdate) ClearDeletedAt() *ScheduleUpdate {
+	su.mutation.ClearDeletedAt()
+	return su
+}
+
+// AddRunIDs adds the "runs" edge to the JobRun entity by IDs.
+func (su *ScheduleUpdate) AddRunIDs(ids ...uuid.UUID) *ScheduleUpdate {
+	su.mutation.AddRunIDs(ids...)
+	return su
+}
+
+// AddRuns adds the "runs" edges to the JobRun entity.
+func (su *ScheduleUpdate) AddRuns(j ...*JobRun) *ScheduleUpdate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return su.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (su *ScheduleUpdate) Mutation() *ScheduleMutation {
+	return su.mutation
+}
+
+// ClearRuns clears all "runs" edges to the JobRun entity.
+func (su *ScheduleUpdate) ClearRuns() *ScheduleUpdate {
+	su.mutation.ClearRuns()
+	return su
+}
+
+// RemoveRunIDs removes the "runs" edge to JobRun entities by IDs.
+func (su *ScheduleUpdate) RemoveRunIDs(ids ...uuid.UUID) *ScheduleUpdate {
+	su.mutation.RemoveRunIDs(ids...)
+	return su
+}
+
+// RemoveRuns removes "runs" edges to JobRun entities.
+func (su *ScheduleUpdate) RemoveRuns(j ...*JobRun) *ScheduleUpdate {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return su.RemoveRunIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ScheduleUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ScheduleUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ScheduleUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ScheduleUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ScheduleUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ScheduleUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.JobType(); ok {
+		if err := schedule.JobTypeValidator(v); err != nil {
+			return &ValidationError{Name: "job_type", err: fmt.Errorf(`ent: validator failed for field "Schedule.job_type": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Jitter(); ok {
+		if err := schedule.JitterValidator(v); err != nil {
+			return &ValidationError{Name: "jitter", err: fmt.Errorf(`ent: validator failed for field "Schedule.jitter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ScheduleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.JobType(); ok {
+		_spec.SetField(schedule.FieldJobType, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Parameters(); ok {
+		_spec.SetField(schedule.FieldParameters, field.TypeJSON, value)
+	}
+	if su.mutation.ParametersCleared() {
+		_spec.ClearField(schedule.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := su.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Jitter(); ok {
+		_spec.SetField(schedule.FieldJitter, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.AddedJitter(); ok {
+		_spec.AddField(schedule.FieldJitter, field.TypeInt, value)
+	}
+	if value, ok := su.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := su.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if su.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if su.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.CreatedBy(); ok {
+		_spec.SetField(schedule.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UpdatedBy(); ok {
+		_spec.SetField(schedule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.DeletedBy(); ok {
+		_spec.SetField(schedule.FieldDeletedBy, field.TypeString, value)
+	}
+	if su.mutation.DeletedByCleared() {
+		_spec.ClearField(schedule.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := su.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if su.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedule.FieldDeletedAt, field.TypeTime)
+	}
+	if su.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedRunsIDs(); len(nodes) > 0 && !su.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ScheduleUpdateOne is the builder for updating a single Schedule entity.
+type ScheduleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduleMutation
+}
+
+// SetName sets the "name" field.
+func (suo *ScheduleUpdateOne) SetName(s string) *ScheduleUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableName(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetJobType sets the "job_type" field.
+func (suo *ScheduleUpdateOne) SetJobType(s string) *ScheduleUpdateOne {
+	suo.mutation.SetJobType(s)
+	return suo
+}
+
+// SetNillableJobType sets the "job_type" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableJobType(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetJobType(*s)
+	}
+	return suo
+}
+
+// SetParameters sets the "parameters" field.
+func (suo *ScheduleUpdateOne) SetParameters(m map[string]string) *ScheduleUpdateOne {
+	suo.mutation.SetParameters(m)
+	return suo
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (suo *ScheduleUpdateOne) ClearParameters() *ScheduleUpdateOne {
+	suo.mutation.ClearParameters()
+	return suo
+}
+
+// SetCron sets the "cron" field.
+func (suo *ScheduleUpdateOne) SetCron(s string) *ScheduleUpdateOne {
+	suo.mutation.SetCron(s)
+	return suo
+}
+
+// SetNillableCron sets the "cron" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableCron(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetCron(*s)
+	}
+	return suo
+}
+
+// SetTimezone sets the "timezone" field.
+func (suo *ScheduleUpdateOne) SetTimezone(s string) *ScheduleUpdateOne {
+	suo.mutation.SetTimezone(s)
+	return suo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableTimezone(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetTimezone(*s)
+	}
+	return suo
+}
+
+// SetJitter sets the "jitter" field.
+func (suo *ScheduleUpdateOne) SetJitter(i int) *ScheduleUpdateOne {
+	suo.mutation.ResetJitter()
+	suo.mutation.SetJitter(i)
+	return suo
+}
+
+// SetNillableJitter sets the "jitter" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableJitter(i *int) *ScheduleUpdateOne {
+	if i != nil {
+		suo.SetJitter(*i)
+	}
+	return suo
+}
+
+// AddJitter adds i to the "jitter" field.
+func (suo *ScheduleUpdateOne) AddJitter(i int) *ScheduleUpdateOne {
+	suo.mutation.AddJitter(i)
+	return suo
+}
+
+// SetPaused sets the "paused" field.
+func (suo *ScheduleUpdateOne) SetPaused(b bool) *ScheduleUpdateOne {
+	suo.mutation.SetPaused(b)
+	return suo
+}
+
+// SetNillablePaused sets the "paused" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillablePaused(b *bool) *ScheduleUpdateOne {
+	if b != nil {
+		suo.SetPaused(*b)
+	}
+	return suo
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (suo *ScheduleUpdateOne) SetNextRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetNextRunAt(t)
+	return suo
+}
+
+// SetNillableNextRunAt sets the "next_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableNextRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetNextRunAt(*t)
+	}
+	return suo
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (suo *ScheduleUpdateOne) ClearNextRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearNextRunAt()
+	return suo
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (suo *ScheduleUpdateOne) SetLastRunAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetLastRunAt(t)
+	return suo
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableLastRunAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetLastRunAt(*t)
+	}
+	return suo
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (suo *ScheduleUpdateOne) ClearLastRunAt() *ScheduleUpdateOne {
+	suo.mutation.ClearLastRunAt()
+	return suo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (suo *ScheduleUpdateOne) SetCreatedBy(s string) *ScheduleUpdateOne {
+	suo.mutation.SetCreatedBy(s)
+	return suo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableCreatedBy(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetCreatedBy(*s)
+	}
+	return suo
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (suo *ScheduleUpdateOne) SetUpdatedBy(s string) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedBy(s)
+	return suo
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableUpdatedBy(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetUpdatedBy(*s)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ScheduleUpdateOne) SetUpdatedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (suo *ScheduleUpdateOne) SetDeletedBy(s string) *ScheduleUpdateOne {
+	suo.mutation.SetDeletedBy(s)
+	return suo
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableDeletedBy(s *string) *ScheduleUpdateOne {
+	if s != nil {
+		suo.SetDeletedBy(*s)
+	}
+	return suo
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (suo *ScheduleUpdateOne) ClearDeletedBy() *ScheduleUpdateOne {
+	suo.mutation.ClearDeletedBy()
+	return suo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (suo *ScheduleUpdateOne) SetDeletedAt(t time.Time) *ScheduleUpdateOne {
+	suo.mutation.SetDeletedAt(t)
+	return suo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (suo *ScheduleUpdateOne) SetNillableDeletedAt(t *time.Time) *ScheduleUpdateOne {
+	if t != nil {
+		suo.SetDeletedAt(*t)
+	}
+	return suo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (suo *ScheduleUpdateOne) ClearDeletedAt() *ScheduleUpdateOne {
+	suo.mutation.ClearDeletedAt()
+	return suo
+}
+
+// AddRunIDs adds the "runs" edge to the JobRun entity by IDs.
+func (suo *ScheduleUpdateOne) AddRunIDs(ids ...uuid.UUID) *ScheduleUpdateOne {
+	suo.mutation.AddRunIDs(ids...)
+	return suo
+}
+
+// AddRuns adds the "runs" edges to the JobRun entity.
+func (suo *ScheduleUpdateOne) AddRuns(j ...*JobRun) *ScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return suo.AddRunIDs(ids...)
+}
+
+// Mutation returns the ScheduleMutation object of the builder.
+func (suo *ScheduleUpdateOne) Mutation() *ScheduleMutation {
+	return suo.mutation
+}
+
+// ClearRuns clears all "runs" edges to the JobRun entity.
+func (suo *ScheduleUpdateOne) ClearRuns() *ScheduleUpdateOne {
+	suo.mutation.ClearRuns()
+	return suo
+}
+
+// RemoveRunIDs removes the "runs" edge to JobRun entities by IDs.
+func (suo *ScheduleUpdateOne) RemoveRunIDs(ids ...uuid.UUID) *ScheduleUpdateOne {
+	suo.mutation.RemoveRunIDs(ids...)
+	return suo
+}
+
+// RemoveRuns removes "runs" edges to JobRun entities.
+func (suo *ScheduleUpdateOne) RemoveRuns(j ...*JobRun) *ScheduleUpdateOne {
+	ids := make([]uuid.UUID, len(j))
+	for i := range j {
+		ids[i] = j[i].ID
+	}
+	return suo.RemoveRunIDs(ids...)
+}
+
+// Where appends a list predicates to the ScheduleUpdate builder.
+func (suo *ScheduleUpdateOne) Where(ps ...predicate.Schedule) *ScheduleUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ScheduleUpdateOne) Select(field string, fields ...string) *ScheduleUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Schedule entity.
+func (suo *ScheduleUpdateOne) Save(ctx context.Context) (*Schedule, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) SaveX(ctx context.Context) *Schedule {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ScheduleUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ScheduleUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ScheduleUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := schedule.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ScheduleUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := schedule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Schedule.name": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.JobType(); ok {
+		if err := schedule.JobTypeValidator(v); err != nil {
+			return &ValidationError{Name: "job_type", err: fmt.Errorf(`ent: validator failed for field "Schedule.job_type": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Cron(); ok {
+		if err := schedule.CronValidator(v); err != nil {
+			return &ValidationError{Name: "cron", err: fmt.Errorf(`ent: validator failed for field "Schedule.cron": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Jitter(); ok {
+		if err := schedule.JitterValidator(v); err != nil {
+			return &ValidationError{Name: "jitter", err: fmt.Errorf(`ent: validator failed for field "Schedule.jitter": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ScheduleUpdateOne) sqlSave(ctx context.Context) (_node *Schedule, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(schedule.Table, schedule.Columns, sqlgraph.NewFieldSpec(schedule.FieldID, field.TypeUUID))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Schedule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, schedule.FieldID)
+		for _, f := range fields {
+			if !schedule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != schedule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(schedule.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.JobType(); ok {
+		_spec.SetField(schedule.FieldJobType, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Parameters(); ok {
+		_spec.SetField(schedule.FieldParameters, field.TypeJSON, value)
+	}
+	if suo.mutation.ParametersCleared() {
+		_spec.ClearField(schedule.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := suo.mutation.Cron(); ok {
+		_spec.SetField(schedule.FieldCron, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Timezone(); ok {
+		_spec.SetField(schedule.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Jitter(); ok {
+		_spec.SetField(schedule.FieldJitter, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.AddedJitter(); ok {
+		_spec.AddField(schedule.FieldJitter, field.TypeInt, value)
+	}
+	if value, ok := suo.mutation.Paused(); ok {
+		_spec.SetField(schedule.FieldPaused, field.TypeBool, value)
+	}
+	if value, ok := suo.mutation.NextRunAt(); ok {
+		_spec.SetField(schedule.FieldNextRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.NextRunAtCleared() {
+		_spec.ClearField(schedule.FieldNextRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.LastRunAt(); ok {
+		_spec.SetField(schedule.FieldLastRunAt, field.TypeTime, value)
+	}
+	if suo.mutation.LastRunAtCleared() {
+		_spec.ClearField(schedule.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CreatedBy(); ok {
+		_spec.SetField(schedule.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UpdatedBy(); ok {
+		_spec.SetField(schedule.FieldUpdatedBy, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(schedule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.DeletedBy(); ok {
+		_spec.SetField(schedule.FieldDeletedBy, field.TypeString, value)
+	}
+	if suo.mutation.DeletedByCleared() {
+		_spec.ClearField(schedule.FieldDeletedBy, field.TypeString)
+	}
+	if value, ok := suo.mutation.DeletedAt(); ok {
+		_spec.SetField(schedule.FieldDeletedAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeletedAtCleared() {
+		_spec.ClearField(schedule.FieldDeletedAt, field.TypeTime)
+	}
+	if suo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedRunsIDs(); len(nodes) > 0 && !suo.mutation.RunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   schedule.RunsTable,
+			Columns: []string{schedule.RunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(jobrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Schedule{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{schedule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
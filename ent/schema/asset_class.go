@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -37,9 +38,16 @@ func (AssetClass) Fields() []ent.Field {
 		field.String("provider").
 			Optional().
 			Comment("The provider of the asset class, which is used to identify the provider of the asset class. This is used to determine which provider's table to use for storing additional information about the asset class."),
+		field.Enum("drift_policy").
+			Values("auto_apply", "approve").
+			Default("auto_apply").
+			Comment("How changes found by provider syncs are handled: applied to the items right away, or recorded in a drift report that has to be approved first."),
 	})
 }
 
 func (AssetClass) Edges() []ent.Edge {
-	return []ent.Edge{}
+	return []ent.Edge{
+		edge.To("attributes", AttributeDefinition.Type).
+			Comment("The custom attributes that are defined for items of this asset class."),
+	}
 }
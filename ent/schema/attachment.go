@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// An Attachment is a file attached to an item, e.g. an invoice, a contract or a network diagram. Only the metadata is
+// stored in the database, the content is kept in the configured blob storage under the storage key.
+
+type Attachment struct {
+	ent.Schema
+}
+
+func (Attachment) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the attachment."),
+		field.String("filename").
+			NotEmpty().
+			Comment("The name of the uploaded file without directories, which is used when the attachment is downloaded."),
+		field.String("content_type").
+			NotEmpty().
+			Immutable().
+			Comment("The media type of the file as detected from its content, the type sent by the client is not trusted."),
+		field.Int64("size").
+			NonNegative().
+			Immutable().
+			Comment("The size of the file in bytes."),
+		field.String("sha256").
+			Immutable().
+			Comment("The hex encoded SHA-256 checksum of the file."),
+		field.String("storage_key").
+			NotEmpty().
+			Immutable().
+			Comment("The key under which the file is stored in the blob storage."),
+		field.Time("purged_at").
+			Optional().
+			Nillable().
+			Comment("When the file was removed from the blob storage, after the attachment or its item was deleted."),
+	})
+}
+
+func (Attachment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The item the file is attached to."),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// An AttributeDefinition describes a custom attribute of an asset class, e.g. the seat count and expiry date of a
+// license. Items of the asset class store validated values for the defined attributes.
+
+type AttributeDefinition struct {
+	ent.Schema
+}
+
+func (AttributeDefinition) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the attribute definition."),
+		field.String("key").
+			NotEmpty().
+			Match(attributeKeyPattern).
+			Comment("The key under which the attribute value is stored on the items of the asset class. Only lowercase letters, digits and underscores are allowed."),
+		field.String("name").
+			NotEmpty().
+			Comment("The human readable name of the attribute, which is displayed in the user interface."),
+		field.String("description").
+			Optional().
+			Comment("A description of the attribute, which can be used to provide additional information about the expected value."),
+		field.Enum("type").
+			Values("string", "number", "date", "bool", "enum", "url", "reference").
+			Comment("The type of the attribute value. Values are validated against this type before they are stored."),
+		field.Bool("required").
+			Default(false).
+			Comment("Whether items of the asset class must provide a value for the attribute."),
+		field.String("default_value").
+			Optional().
+			Comment("The default value of the attribute in its textual representation, which is used if an item does not provide a value."),
+		field.Strings("enum_values").
+			Optional().
+			Comment("The allowed values of an attribute with the enum type."),
+		field.Bool("secret").
+			Default(false).
+			Immutable().
+			Comment("Whether the attribute holds a secret like a password. Secret values are stored encrypted and masked in all responses. The flag can not be changed after creation, as existing values would have to be moved between plaintext and encrypted storage."),
+		field.Int("order").
+			Default(0).
+			Comment("The order of the attribute, which is used to determine the order in which attributes are displayed in the user interface."),
+	})
+}
+
+func (AttributeDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("asset_class", AssetClass.Type).
+			Ref("attributes").
+			Unique().
+			Required().
+			Comment("The asset class that defines this attribute."),
+	}
+}
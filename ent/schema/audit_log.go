@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"time"
+)
+
+// An AuditLog entry is an immutable record of a single mutation of an entity in the inventory system.
+// Entries are written by a runtime hook registered in the store package and are never updated or deleted,
+// which allows reconstructing who changed what and when.
+
+type AuditLog struct {
+	ent.Schema
+}
+
+// AuditChange describes the change of a single field or edge within an audited mutation.
+// For fields Before and After hold the previous and the new value, for edges Added and Removed
+// hold the IDs of the entities that were attached or detached.
+type AuditChange struct {
+	Field   string   `json:"field"`
+	Edge    bool     `json:"edge,omitempty"`
+	Before  any      `json:"before,omitempty"`
+	After   any      `json:"after,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	Cleared bool     `json:"cleared,omitempty"`
+}
+
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the audit log entry."),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable().
+			Comment("The type of the entity that was changed, e.g. Item or Tag."),
+		field.UUID("entity_id", uuid.UUID{}).
+			Immutable().
+			Comment("The identifier of the entity that was changed."),
+		field.Enum("operation").
+			Values("create", "update", "delete", "reveal").
+			Immutable().
+			Comment("The operation that was performed on the entity. Reveal entries record that a secret attribute was read and do not change the entity."),
+		field.String("actor").
+			Optional().
+			Immutable().
+			Comment("The user who performed the change. This is taken from the created_by or updated_by field of the mutation."),
+		field.Time("timestamp").
+			Default(time.Now).
+			Immutable().
+			Comment("When the change was performed."),
+		field.JSON("changes", []AuditChange{}).
+			Optional().
+			Immutable().
+			Comment("The fields and edges that were changed together with their previous and new values."),
+	}
+}
+
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("actor"),
+		index.Fields("timestamp"),
+	}
+}
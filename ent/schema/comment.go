@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"time"
+)
+
+// A Comment is a markdown note on an item, e.g. "rebooted after kernel panic, see ticket X". Comments can reply to
+// other comments of the same item, which forms threads, and mention users with @subject. Edits keep the previous
+// bodies, deleted comments are soft deleted so the replies stay in their thread.
+
+type Comment struct {
+	ent.Schema
+}
+
+// CommentEdit is a previous body of a comment together with the edit that replaced it.
+type CommentEdit struct {
+	Body     string    `json:"body"`
+	EditedAt time.Time `json:"edited_at"`
+	EditedBy string    `json:"edited_by"`
+}
+
+func (Comment) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the comment."),
+		field.Text("body").
+			NotEmpty().
+			Comment("The markdown text of the comment, which is rendered by the user interface."),
+		field.Strings("mentions").
+			Optional().
+			Comment("The subjects of the users mentioned in the body with @subject, in the order of their first mention."),
+		field.JSON("edits", []CommentEdit{}).
+			Optional().
+			Comment("The previous versions of the body, oldest first."),
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			Comment("When the body was last edited, empty if it was never edited."),
+	})
+}
+
+func (Comment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The item the comment is about."),
+		edge.To("mentioned", CommentMention.Type).
+			Comment("The users mentioned in the body, which are looked up by the mentions of a user."),
+		edge.To("replies", Comment.Type).
+			From("parent").
+			Unique().
+			Comment("The comment this comment replies to, empty for comments that start a thread. Replies belong to the same item as their parent."),
+	}
+}
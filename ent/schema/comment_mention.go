@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// A CommentMention records that a comment mentions a user, so the comments mentioning a user can be found through an
+// index instead of searching the mentions of all comments. The mentions are replaced whenever the comment is edited.
+
+type CommentMention struct {
+	ent.Schema
+}
+
+func (CommentMention) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the mention."),
+		field.String("subject").
+			NotEmpty().
+			Immutable().
+			Comment("The subject of the mentioned user."),
+	})
+}
+
+func (CommentMention) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("comment", Comment.Type).
+			Ref("mentioned").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The comment with the mention."),
+	}
+}
+
+func (CommentMention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("subject").
+			Edges("comment").
+			Unique(),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A DnsCheck is the live resolution of a recorded DNS record against the configured resolvers. Checks are kept as
+// history, so it can be reviewed since when a record no longer resolves to its recorded value.
+
+type DnsCheck struct {
+	ent.Schema
+}
+
+// DnsAnswer is the response of a single resolver to the query of a DNS record.
+type DnsAnswer struct {
+	Resolver string `json:"resolver"`
+	// Rcode is the response code, e.g. NOERROR or NXDOMAIN, empty if the resolver did not respond.
+	Rcode  string   `json:"rcode,omitempty"`
+	Values []string `json:"values,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+func (DnsCheck) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the check."),
+		field.String("record_name").
+			NotEmpty().
+			Immutable().
+			Comment("The fully qualified name of the record that was resolved."),
+		field.Enum("record_type").
+			Values("A", "AAAA", "CNAME", "MX", "TXT").
+			Immutable().
+			Comment("The type of the record that was resolved."),
+		field.String("expected").
+			Optional().
+			Immutable().
+			Comment("The recorded value of the record in its presentation format, e.g. `10 mail.example.org` for MX records."),
+		field.Enum("status").
+			Values("ok", "mismatch", "nxdomain", "dangling", "error").
+			Immutable().
+			Comment("The result of the check. A mismatch means the recorded value is not among the answers, a dangling CNAME points to a name that does not resolve or to a decommissioned server. Resolvers that disagree result in the worst status."),
+		field.JSON("answers", []DnsAnswer{}).
+			Optional().
+			Immutable().
+			Comment("The answers of every resolver that was queried."),
+		field.String("message").
+			Optional().
+			Immutable().
+			Comment("Why the check did not succeed."),
+	})
+}
+
+func (DnsCheck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The DNS record item that was checked."),
+	}
+}
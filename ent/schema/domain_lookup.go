@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A DomainLookup is the registration data of a domain as reported by its registry over RDAP or WHOIS. Lookups are
+// kept as history, so the discrepancies between the registry and the values stored on the domain item can be
+// reviewed after the item was updated.
+
+type DomainLookup struct {
+	ent.Schema
+}
+
+// LookupDiscrepancy is an attribute of a domain whose stored value differs from the value reported by the registry.
+type LookupDiscrepancy struct {
+	Attribute string `json:"attribute"`
+	Stored    any    `json:"stored"`
+	Actual    any    `json:"actual"`
+}
+
+func (DomainLookup) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the lookup."),
+		field.String("domain").
+			NotEmpty().
+			Immutable().
+			Comment("The domain name that was looked up."),
+		field.Enum("source").
+			Values("rdap", "whois").
+			Immutable().
+			Comment("The protocol the registration data was retrieved with. WHOIS is only used for registries without RDAP server or if RDAP fails."),
+		field.String("server").
+			Optional().
+			Immutable().
+			Comment("The RDAP base URL or WHOIS server that answered the lookup."),
+		field.String("registrar").
+			Optional().
+			Immutable().
+			Comment("The name of the registrar of the domain."),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The time the registration of the domain expires."),
+		field.JSON("statuses", []string{}).
+			Optional().
+			Immutable().
+			Comment("The status codes of the domain in RDAP notation, e.g. `client transfer prohibited`."),
+		field.JSON("nameservers", []string{}).
+			Optional().
+			Immutable().
+			Comment("The lowercase host names of the name servers of the domain."),
+		field.JSON("discrepancies", []LookupDiscrepancy{}).
+			Optional().
+			Immutable().
+			Comment("The attributes of the domain item that differed from the registration data before the item was updated."),
+		field.String("error").
+			Optional().
+			Immutable().
+			Comment("Why the lookup failed. Failed lookups do not change the domain item."),
+	})
+}
+
+func (DomainLookup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The domain item that was looked up."),
+	}
+}
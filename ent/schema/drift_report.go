@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A DriftReport holds the differences between the items of an asset class and their state at a provider, as found by
+// a sync, e.g. the certificate check. Depending on the drift policy of the asset class the changes are applied right
+// away or wait for a user to approve them, so syncs do not silently overwrite what users entered.
+
+type DriftReport struct {
+	ent.Schema
+}
+
+// DriftChange is an item that was added, changed or removed at the provider.
+type DriftChange struct {
+	// Kind is new, changed or vanished
+	Kind   string `json:"kind"`
+	ItemID string `json:"item_id,omitempty"`
+	// Key is the value of the attribute identifying the item at the provider
+	Key  string `json:"key,omitempty"`
+	Name string `json:"name"`
+	// Attributes are the values reported by the provider, which are written to the item when the change is applied
+	Attributes map[string]any `json:"attributes,omitempty"`
+	// Cost is the price reported by providers that expose pricing, which replaces the cost of the item when the
+	// change is applied
+	Cost *Cost `json:"cost,omitempty"`
+	// StoredCost is the cost of the item when the provider reported a different price
+	StoredCost *Cost            `json:"stored_cost,omitempty"`
+	Diffs      []AttributeDrift `json:"diffs,omitempty"`
+}
+
+// Cost is the recurring price of an item at its provider.
+type Cost struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+	// Period is monthly, quarterly or yearly
+	Period string `json:"period"`
+}
+
+// AttributeDrift is an attribute whose stored value differs from the value reported by the provider.
+type AttributeDrift struct {
+	Attribute string `json:"attribute"`
+	Stored    any    `json:"stored"`
+	Actual    any    `json:"actual"`
+}
+
+func (DriftReport) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the drift report."),
+		field.String("source").
+			NotEmpty().
+			Immutable().
+			Comment("The job type of the sync that found the drift, e.g. `certificate_check`."),
+		field.Enum("status").
+			Values("pending", "applied", "rejected", "superseded").
+			Default("pending").
+			Comment("Whether the changes wait for approval, were applied to the items or were rejected. Pending reports are superseded by the next report of the same sync, which holds the current state of the provider."),
+		field.JSON("changes", []DriftChange{}).
+			Immutable().
+			Comment("The new, changed and vanished items found by the sync."),
+		field.String("reviewed_by").
+			Optional().
+			Comment("The user who approved or rejected the report, or the worker for reports that were applied automatically."),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("The time the report was applied or rejected."),
+	})
+}
+
+func (DriftReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("asset_class", AssetClass.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The asset class whose items drifted."),
+	}
+}
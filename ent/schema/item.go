@@ -5,8 +5,12 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+	"regexp"
 )
 
+// currencyPattern matches ISO 4217 currency codes.
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
 // An Item holds a single asset, which can be a physical item, a digital asset, or any other entity that can be tracked.
 // providers are meant to save asset specific information, such as the location, condition, or any other relevant metadata in
 // their own table.
@@ -29,6 +33,30 @@ func (Item) Fields() []ent.Field {
 		field.String("description").
 			Optional().
 			Comment("A description of the item, which can be used to provide additional information about the item."),
+		field.JSON("attributes", map[string]any{}).
+			Optional().
+			Comment("The values of the custom attributes defined by the asset class of the item, keyed by the attribute key."),
+		field.JSON("secrets", map[string]EncryptedValue{}).
+			Optional().
+			Sensitive().
+			Comment("The encrypted values of the secret attributes of the item, keyed by the attribute key. Secret values are kept apart from the other attributes, so they are never searched, exported or returned, and can only be read through the RevealSecret RPC."),
+		field.Float("cost_amount").
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("The recurring cost of the item per billing period, e.g. the monthly price of a server. Empty for items without known cost."),
+		field.String("cost_currency").
+			Optional().
+			Match(currencyPattern).
+			Comment("The ISO 4217 code of the currency of the cost amount, e.g. EUR."),
+		field.Enum("cost_period").
+			Values("monthly", "quarterly", "yearly").
+			Optional().
+			Nillable().
+			Comment("The billing period the cost amount is charged for, e.g. yearly for domain renewals."),
+		field.String("cost_center").
+			Optional().
+			Comment("The cost center the item is billed to, which is used to group spend reports."),
 	})
 }
 
@@ -39,7 +67,27 @@ func (Item) Edges() []ent.Edge {
 		edge.To("user_groups", UserGroup.Type).
 			Comment("The user groups that are associated with this item. This edge represents the many-to-many relationship between items and user groups, allowing multiple user groups to be associated with a single item and vice versa."),
 		edge.To("asset_class", AssetClass.Type).
+			Unique().
 			Required().
 			Comment("The asset class that this item belongs to. This edge represents the many-to-one relationship between items and asset classes, allowing multiple items to be associated with a single asset class. The asset class is defined in the AssetClass schema."),
+		edge.To("children", Item.Type).
+			From("parent").
+			Unique().
+			Comment("The parent item this item is nested in, e.g. the rack holding a server or the zone holding a DNS record. Items without a parent are root items. The children edge holds the items that are directly nested in this item."),
+		edge.From("outgoing_relations", ItemRelation.Type).
+			Ref("source").
+			Comment("The relations that start at this item."),
+		edge.From("incoming_relations", ItemRelation.Type).
+			Ref("target").
+			Comment("The relations that point to this item."),
+		edge.From("attachments", Attachment.Type).
+			Ref("item").
+			Comment("The files attached to this item."),
+		edge.From("comments", Comment.Type).
+			Ref("item").
+			Comment("The comments on this item."),
+		edge.To("reachability", Reachability.Type).
+			Unique().
+			Comment("The up or down state of the item, as determined by its probes. Empty for items that are not probed."),
 	}
 }
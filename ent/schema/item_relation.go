@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// An ItemRelation is a directed, typed relationship between two items, e.g. a domain that resolves to a server or
+// a virtual machine that runs on a host. Relations are used to answer which items are affected if an item goes away.
+
+type ItemRelation struct {
+	ent.Schema
+}
+
+func (ItemRelation) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the relation."),
+		field.Enum("type").
+			Values("hosts", "depends_on", "resolves_to", "backs_up").
+			Comment("The type of the relation, read as: source <type> target, e.g. a host hosts a virtual machine."),
+		field.String("description").
+			Optional().
+			Comment("A description of the relation, which can be used to provide additional information about the relation."),
+	})
+}
+
+func (ItemRelation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("source", Item.Type).
+			Unique().
+			Required().
+			Comment("The item the relation starts at."),
+		edge.To("target", Item.Type).
+			Unique().
+			Required().
+			Comment("The item the relation points to."),
+	}
+}
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A JobRun is a unit of work that is queued by the server and executed by a worker, e.g. a large export. Workers
+// claim queued runs one at a time, so several workers can share the same queue without running a job twice.
+
+type JobRun struct {
+	ent.Schema
+}
+
+func (JobRun) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the job run. This is a UUID that is generated when the job is queued."),
+		field.String("type").
+			NotEmpty().
+			Immutable().
+			Comment("The type of the job, which determines the handler that executes it, e.g. `export`."),
+		field.JSON("parameters", map[string]string{}).
+			Optional().
+			Comment("The parameters of the job, which are passed to the handler. The meaning of the parameters depends on the type of the job."),
+		field.Enum("status").
+			Values("queued", "running", "succeeded", "failed", "canceled").
+			Default("queued").
+			Comment("The status of the job run. Queued runs are picked up by the next available worker."),
+		field.Int("progress").
+			Range(0, 100).
+			Default(0).
+			Comment("The progress of a running job in percent, as reported by its handler."),
+		field.Text("log").
+			Optional().
+			Comment("The last lines logged by the handler of the job, to find out why a run failed without access to the worker logs."),
+		field.Bool("cancel_requested").
+			Default(false).
+			Comment("Whether a user asked to cancel the running job. The worker executing it stops the handler on its next poll."),
+		field.UUID("retry_of", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The failed or canceled run this run retries."),
+		field.Time("run_after").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("The earliest time a worker executes the queued run, e.g. the next retry of a webhook delivery. Empty to run it right away."),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("The time when a worker started to execute the job."),
+		field.Time("heartbeat_at").
+			Optional().
+			Nillable().
+			Comment("The last time the worker executing the run reported that it is still alive. Running runs without a heartbeat for longer than the lease of the workers are failed, as their worker stopped."),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("The time when the job succeeded or failed."),
+		field.String("result").
+			Optional().
+			Comment("The result of a successful run, e.g. the name of the file written by an export."),
+		field.String("error").
+			Optional().
+			Comment("The error of a failed run."),
+	})
+}
+
+func (JobRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("schedule", Schedule.Type).
+			Ref("runs").
+			Unique().
+			Comment("The schedule that queued the run, empty for runs queued by a user."),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A NotificationChannel is a destination for notifications, e.g. the mailbox of the operations team or a Slack
+// channel. Reminder rules decide which channels are told about which items.
+
+type NotificationChannel struct {
+	ent.Schema
+}
+
+func (NotificationChannel) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the channel."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the channel, which is shown when choosing the channels of a reminder rule."),
+		field.Enum("type").
+			Values("smtp", "webhook", "slack").
+			Comment("How notifications are delivered: as email via SMTP, as JSON posted to a webhook, or as message posted to a Slack-compatible incoming webhook."),
+		field.JSON("config", map[string]string{}).
+			Optional().
+			Sensitive().
+			Comment("The settings of the channel, which depend on its type, e.g. `host`, `port`, `username`, `password`, `from` and `to` for SMTP or `url` for webhooks."),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether notifications are sent through the channel."),
+	})
+}
+
+func (NotificationChannel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("probes", Probe.Type).
+			Ref("channels").
+			Comment("The reachability probes that notify the channel, a channel can be shared by many probes."),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// A NotificationDelivery records a reminder that was sent to a channel. Reminders are only sent if there is no
+// delivery for the same item, date and number of days yet, so every reminder goes out once, no matter how often the
+// notification job runs.
+
+type NotificationDelivery struct {
+	ent.Schema
+}
+
+func (NotificationDelivery) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the delivery."),
+		field.String("attribute_key").
+			NotEmpty().
+			Immutable().
+			Comment("The key of the date attribute the reminder was sent for."),
+		field.String("due_on").
+			NotEmpty().
+			Immutable().
+			Comment("The date the reminder was sent for, in the format YYYY-MM-DD. A changed date, e.g. after a renewal, is reminded again."),
+		field.Int("days").
+			Immutable().
+			Comment("The number of days before the date of the reminder rule that triggered the reminder."),
+	})
+}
+
+func (NotificationDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The item the reminder was sent for."),
+		edge.To("channel", NotificationChannel.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The channel the reminder was sent to."),
+	}
+}
+
+func (NotificationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attribute_key", "due_on", "days").
+			Edges("item", "channel").
+			Unique(),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A Probe checks whether a server is reachable, either by opening a TCP connection to a port or by requesting a URL
+// over HTTP(S). Probes belong to a single item or to an asset class, in which case every item of the class is probed
+// at its host name. The probes are run by the reachability_probe job, usually from a schedule every few minutes.
+
+type Probe struct {
+	ent.Schema
+}
+
+func (Probe) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the probe."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the probe, e.g. `SSH` or `Health endpoint`, which is shown in the results and notifications."),
+		field.Enum("type").
+			Values("tcp", "http", "https").
+			Comment("How the server is probed: a TCP connection to the port, or a GET request to the path over HTTP or HTTPS."),
+		field.String("host").
+			Optional().
+			Comment("The host to probe. If empty, the hostname, fqdn or ip_address attribute of the item is used, or its name."),
+		field.Int("port").
+			Range(0, 65535).
+			Default(0).
+			Comment("The port to probe, required for TCP probes. HTTP probes default to port 80 and HTTPS probes to port 443."),
+		field.String("path").
+			Optional().
+			Comment("The path requested by HTTP probes, `/` if empty."),
+		field.Int("expected_status").
+			Range(0, 599).
+			Default(0).
+			Comment("The status code HTTP probes expect. If 0, every status below 400 counts as up."),
+		field.Int("timeout").
+			Positive().
+			Default(5).
+			Comment("The number of seconds after which an unanswered probe counts as down."),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether the probe is run."),
+	})
+}
+
+func (Probe) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Comment("The item that is probed. Either the item or the asset class is set."),
+		edge.To("asset_class", AssetClass.Type).
+			Unique().
+			Comment("The asset class whose items are probed."),
+		edge.To("channels", NotificationChannel.Type).
+			Comment("The channels that are told when a probed item goes down or comes back up."),
+	}
+}
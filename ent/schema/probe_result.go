@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A ProbeResult records a single run of a probe against an item. Results are kept as history of the latency and
+// availability of the item.
+
+type ProbeResult struct {
+	ent.Schema
+}
+
+func (ProbeResult) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the result."),
+		field.String("target").
+			NotEmpty().
+			Immutable().
+			Comment("The host:port or URL that was probed."),
+		field.Enum("status").
+			Values("up", "down").
+			Immutable().
+			Comment("Whether the target answered as expected within the timeout of the probe."),
+		field.Float("latency_ms").
+			Default(0).
+			Immutable().
+			Comment("The number of milliseconds until the connection was established or the response headers were received."),
+		field.Int("status_code").
+			Optional().
+			Immutable().
+			Comment("The status code of the response to HTTP probes."),
+		field.String("error").
+			Optional().
+			Immutable().
+			Comment("Why the target was down."),
+	})
+}
+
+func (ProbeResult) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("probe", Probe.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The probe that was run."),
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Immutable().
+			Comment("The item that was probed."),
+	}
+}
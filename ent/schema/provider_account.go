@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A ProviderAccount holds the credentials provider integrations use to access an account at a provider, e.g. the API
+// token of a Hetzner project. Secrets are encrypted with envelope encryption: every value is encrypted with its own
+// data key, which is encrypted with the master key of the installation.
+
+type ProviderAccount struct {
+	ent.Schema
+}
+
+// EncryptedValue is a secret encrypted with AES-256-GCM under a random data key. The data key is stored encrypted
+// with the master key identified by KeyID, so rotating the master key only requires re-encrypting the data keys.
+type EncryptedValue struct {
+	KeyID      string `json:"key_id"`
+	DataKey    []byte `json:"data_key"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func (ProviderAccount) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the provider account."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the account, e.g. `Hetzner production`."),
+		field.String("provider").
+			NotEmpty().
+			Comment("The provider integration that uses the account, e.g. `hetzner`."),
+		field.JSON("config", map[string]string{}).
+			Optional().
+			Comment("The settings of the account that are not secret, e.g. the region or project."),
+		field.JSON("secrets", map[string]EncryptedValue{}).
+			Optional().
+			Sensitive().
+			Comment("The encrypted secrets of the account keyed by their name, e.g. `token`. Secrets are never returned by the API."),
+		field.Time("rotated_at").
+			Optional().
+			Nillable().
+			Comment("The time the secrets were last replaced."),
+	})
+}
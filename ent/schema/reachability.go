@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A Reachability is the current up or down state of a probed item. It is kept apart from the item, so the frequent
+// probe runs do not add entries to the audit log or trigger webhooks of the item.
+
+type Reachability struct {
+	ent.Schema
+}
+
+func (Reachability) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the reachability."),
+		field.Enum("state").
+			Values("up", "down").
+			Comment("Whether all probes of the item succeeded in their last run. An item is down as soon as one probe fails."),
+		field.Time("changed_at").
+			Comment("The time the item went down or came back up."),
+		field.Time("last_seen_at").
+			Optional().
+			Nillable().
+			Comment("The last time any probe of the item succeeded. Empty if the item has never been up."),
+	})
+}
+
+func (Reachability) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("reachability").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The probed item. Every item has at most one reachability."),
+	}
+}
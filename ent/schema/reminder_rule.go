@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A ReminderRule tells notification channels about dates of the items of an asset class that are coming up, e.g.
+// 60, 30 and 7 days before a domain or certificate expires or a contract has to be cancelled.
+
+type ReminderRule struct {
+	ent.Schema
+}
+
+func (ReminderRule) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the rule."),
+		field.String("attribute_key").
+			Optional().
+			Comment("The key of the date attribute the rule watches. Rules without a key watch all date attributes of the asset class."),
+		field.JSON("days", []int{}).
+			Comment("The number of days before the date at which a reminder is sent, e.g. [60, 30, 7]."),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether reminders are sent for the rule."),
+	})
+}
+
+func (ReminderRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("asset_class", AssetClass.Type).
+			Unique().
+			Required().
+			Comment("The asset class whose items are watched."),
+		edge.To("channels", NotificationChannel.Type).
+			Comment("The channels reminders are sent to."),
+	}
+}
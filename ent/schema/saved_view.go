@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A SavedView stores a named item filter together with the sort order and the columns shown in the item list,
+// so commonly used lists such as "prod servers in Falkenstein" don't have to be rebuilt. Views are private to their
+// owner unless they are shared with a user group, whose members can then use but not change them.
+
+type SavedView struct {
+	ent.Schema
+}
+
+func (SavedView) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the saved view. This is a UUID that is generated when the view is created."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the view, which is shown in the list of views."),
+		field.String("description").
+			Optional().
+			Comment("A description of the view, which can be used to explain which items it contains."),
+		field.String("owner").
+			NotEmpty().
+			Immutable().
+			Comment("The subject of the user who owns the view. Only the owner can change or delete the view."),
+		field.String("query").
+			Optional().
+			Comment("The filter of the view as a query language expression, e.g. `class:server tag:prod location=fsn1`. An empty query matches all items."),
+		field.Strings("sort").
+			Optional().
+			Comment("The sort order of the view as a list of fields, a leading minus sorts the field in descending order, e.g. [\"-updated\", \"name\"]."),
+		field.Strings("columns").
+			Optional().
+			Comment("The columns that are shown in the item list when the view is used, in the order they are displayed."),
+	})
+}
+
+func (SavedView) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user_group", UserGroup.Type).
+			Unique().
+			Comment("The user group the view is shared with. Members of the group can use the view, views without a group are only visible to their owner."),
+	}
+}
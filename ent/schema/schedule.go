@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A Schedule queues job runs of a type periodically, e.g. to purge the trash every night. Workers check for due
+// schedules on every poll and move the next run time forward before queueing a run, which only succeeds for one
+// worker, so every scheduled run is queued exactly once when several workers are deployed.
+
+type Schedule struct {
+	ent.Schema
+}
+
+func (Schedule) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the schedule. This is a UUID that is generated when the schedule is created."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the schedule, which is used to identify it in the user interface and the logs."),
+		field.String("job_type").
+			NotEmpty().
+			Comment("The type of the queued job runs, which determines the handler that executes them, e.g. `export`."),
+		field.JSON("parameters", map[string]string{}).
+			Optional().
+			Comment("The parameters passed to every queued job run."),
+		field.String("cron").
+			NotEmpty().
+			Comment("The cron expression of the schedule with five fields, e.g. `0 3 * * *`, or a descriptor like `@daily`."),
+		field.String("timezone").
+			Default("UTC").
+			Comment("The IANA name of the timezone the cron expression is evaluated in, e.g. `Europe/Berlin`."),
+		field.Int("jitter").
+			NonNegative().
+			Default(0).
+			Comment("The maximum number of seconds a run is delayed by a random amount, to spread the load of schedules with the same expression."),
+		field.Bool("paused").
+			Default(false).
+			Comment("Whether the schedule is paused. Paused schedules don't queue runs, but can still be run manually."),
+		field.Time("next_run_at").
+			Optional().
+			Nillable().
+			Comment("The time when the next run is queued, including the jitter. Empty while the schedule is paused."),
+		field.Time("last_run_at").
+			Optional().
+			Nillable().
+			Comment("The time when the last run was queued, either by a worker or manually."),
+	})
+}
+
+func (Schedule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("runs", JobRun.Type).
+			Comment("The job runs queued by the schedule."),
+	}
+}
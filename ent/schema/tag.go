@@ -28,7 +28,8 @@ func (Tag) Fields() []ent.Field {
 
 func (Tag) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("items", Item.Type).
+		edge.From("items", Item.Type).
+			Ref("tags").
 			Comment("The items that are associated with this tag. This edge represents the many-to-many relationship between tags and items, allowing multiple items to be associated with a single tag and vice versa."),
 	}
 }
@@ -35,7 +35,8 @@ func (UserGroup) Fields() []ent.Field {
 
 func (UserGroup) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("items", Item.Type).
+		edge.From("items", Item.Type).
+			Ref("user_groups").
 			Comment("The items that are assigned to this user group. This edge represents the many-to-many relationship between user groups and items, allowing multiple items to be associated with a single user group and vice versa."),
 	}
 }
@@ -3,10 +3,13 @@ package schema
 import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
+	"regexp"
 	"slices"
 	"time"
 )
 
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
 func withDefaults(pre []ent.Field) []ent.Field {
 	return slices.Concat(pre, []ent.Field{
 		field.String("created_by").
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A Webhook subscribes an external URL to changes of the inventory. Every matching change is posted to the URL by
+// the worker, signed with the secret of the webhook, so the receiver can verify that the request is genuine.
+
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the webhook."),
+		field.String("name").
+			NotEmpty().
+			Comment("The name of the webhook, e.g. the tool that receives the events."),
+		field.String("url").
+			NotEmpty().
+			Comment("The http or https URL the events are posted to."),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("The key of the HMAC-SHA256 signature of the request bodies, which is sent in the X-Signature-256 header."),
+		field.JSON("entity_types", []string{}).
+			Optional().
+			Comment("The entity types the webhook receives events for, e.g. [\"Item\"]. Empty for all types."),
+		field.JSON("events", []string{}).
+			Optional().
+			Comment("The events the webhook receives, any of create, update and delete. Empty for all events."),
+		field.Bool("enabled").
+			Default(true).
+			Comment("Whether events are sent to the webhook."),
+	})
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("asset_classes", AssetClass.Type).
+			Comment("The asset classes whose items, attribute definitions and relations are sent. Empty for all classes, entities that do not belong to an asset class, e.g. tags, are always sent."),
+		edge.To("deliveries", WebhookDelivery.Type).
+			Comment("The events sent to the webhook."),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// A WebhookDelivery is an event that is sent to a webhook. Deliveries are created together with the change that
+// triggered them and sent by the worker, failed attempts are retried with exponential backoff.
+
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return withDefaults([]ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable().
+			Comment("The unique identifier for the delivery, which is sent in the X-Delivery header."),
+		field.Enum("event").
+			Values("create", "update", "delete").
+			Immutable().
+			Comment("The kind of change. Soft deletes are sent as delete events."),
+		field.String("entity_type").
+			NotEmpty().
+			Immutable().
+			Comment("The type of the changed entity, e.g. `Item`."),
+		field.UUID("entity_id", uuid.UUID{}).
+			Immutable().
+			Comment("The ID of the changed entity."),
+		field.Text("payload").
+			Immutable().
+			Comment("The JSON body that is posted to the webhook. It is stored as sent, so the signature of redeliveries does not change."),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("The status of the delivery. Failed deliveries were given up after the last retry and can be redelivered manually."),
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("The number of requests sent to the webhook."),
+		field.Int("response_status").
+			Optional().
+			Comment("The HTTP status the webhook responded with to the last attempt, 0 if it could not be reached."),
+		field.String("error").
+			Optional().
+			Comment("The error of the last failed attempt."),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("The time of the next automatic retry of a pending delivery."),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("The time the webhook accepted the delivery."),
+	})
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The webhook the event is sent to."),
+	}
+}
@@ -38,7 +38,6 @@ type Tag struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
 	Edges        TagEdges `json:"edges"`
-	item_tags    *uuid.UUID
 	selectValues sql.SelectValues
 }
 
@@ -71,8 +70,6 @@ func (*Tag) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case tag.FieldID:
 			values[i] = new(uuid.UUID)
-		case tag.ForeignKeys[0]: // item_tags
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -143,13 +140,6 @@ func (t *Tag) assignValues(columns []string, values []any) error {
 				t.DeletedAt = new(time.Time)
 				*t.DeletedAt = value.Time
 			}
-		case tag.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field item_tags", values[i])
-			} else if value.Valid {
-				t.item_tags = new(uuid.UUID)
-				*t.item_tags = *value.S.(*uuid.UUID)
-			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
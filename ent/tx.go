@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -14,12 +16,54 @@ type Tx struct {
 	config
 	// AssetClass is the client for interacting with the AssetClass builders.
 	AssetClass *AssetClassClient
+	// Attachment is the client for interacting with the Attachment builders.
+	Attachment *AttachmentClient
+	// AttributeDefinition is the client for interacting with the AttributeDefinition builders.
+	AttributeDefinition *AttributeDefinitionClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Comment is the client for interacting with the Comment builders.
+	Comment *CommentClient
+	// CommentMention is the client for interacting with the CommentMention builders.
+	CommentMention *CommentMentionClient
+	// DnsCheck is the client for interacting with the DnsCheck builders.
+	DnsCheck *DnsCheckClient
+	// DomainLookup is the client for interacting with the DomainLookup builders.
+	DomainLookup *DomainLookupClient
+	// DriftReport is the client for interacting with the DriftReport builders.
+	DriftReport *DriftReportClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// ItemRelation is the client for interacting with the ItemRelation builders.
+	ItemRelation *ItemRelationClient
+	// JobRun is the client for interacting with the JobRun builders.
+	JobRun *JobRunClient
+	// NotificationChannel is the client for interacting with the NotificationChannel builders.
+	NotificationChannel *NotificationChannelClient
+	// NotificationDelivery is the client for interacting with the NotificationDelivery builders.
+	NotificationDelivery *NotificationDeliveryClient
+	// Probe is the client for interacting with the Probe builders.
+	Probe *ProbeClient
+	// ProbeResult is the client for interacting with the ProbeResult builders.
+	ProbeResult *ProbeResultClient
+	// ProviderAccount is the client for interacting with the ProviderAccount builders.
+	ProviderAccount *ProviderAccountClient
+	// Reachability is the client for interacting with the Reachability builders.
+	Reachability *ReachabilityClient
+	// ReminderRule is the client for interacting with the ReminderRule builders.
+	ReminderRule *ReminderRuleClient
+	// SavedView is the client for interacting with the SavedView builders.
+	SavedView *SavedViewClient
+	// Schedule is the client for interacting with the Schedule builders.
+	Schedule *ScheduleClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
 	// UserGroup is the client for interacting with the UserGroup builders.
 	UserGroup *UserGroupClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -152,9 +196,30 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.AssetClass = NewAssetClassClient(tx.config)
+	tx.Attachment = NewAttachmentClient(tx.config)
+	tx.AttributeDefinition = NewAttributeDefinitionClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Comment = NewCommentClient(tx.config)
+	tx.CommentMention = NewCommentMentionClient(tx.config)
+	tx.DnsCheck = NewDnsCheckClient(tx.config)
+	tx.DomainLookup = NewDomainLookupClient(tx.config)
+	tx.DriftReport = NewDriftReportClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.ItemRelation = NewItemRelationClient(tx.config)
+	tx.JobRun = NewJobRunClient(tx.config)
+	tx.NotificationChannel = NewNotificationChannelClient(tx.config)
+	tx.NotificationDelivery = NewNotificationDeliveryClient(tx.config)
+	tx.Probe = NewProbeClient(tx.config)
+	tx.ProbeResult = NewProbeResultClient(tx.config)
+	tx.ProviderAccount = NewProviderAccountClient(tx.config)
+	tx.Reachability = NewReachabilityClient(tx.config)
+	tx.ReminderRule = NewReminderRuleClient(tx.config)
+	tx.SavedView = NewSavedViewClient(tx.config)
+	tx.Schedule = NewScheduleClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
 	tx.UserGroup = NewUserGroupClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -217,3 +282,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
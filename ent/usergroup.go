@@ -39,9 +39,8 @@ type UserGroup struct {
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserGroupQuery when eager-loading is set.
-	Edges            UserGroupEdges `json:"edges"`
-	item_user_groups *uuid.UUID
-	selectValues     sql.SelectValues
+	Edges        UserGroupEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // UserGroupEdges holds the relations/edges for other nodes in the graph.
@@ -73,8 +72,6 @@ func (*UserGroup) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullTime)
 		case usergroup.FieldID:
 			values[i] = new(uuid.UUID)
-		case usergroup.ForeignKeys[0]: // item_user_groups
-			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -151,13 +148,6 @@ func (ug *UserGroup) assignValues(columns []string, values []any) error {
 				ug.DeletedAt = new(time.Time)
 				*ug.DeletedAt = value.Time
 			}
-		case usergroup.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullScanner); !ok {
-				return fmt.Errorf("unexpected type %T for field item_user_groups", values[i])
-			} else if value.Valid {
-				ug.item_user_groups = new(uuid.UUID)
-				*ug.item_user_groups = *value.S.(*uuid.UUID)
-			}
 		default:
 			ug.selectValues.Set(columns[i], values[i])
 		}
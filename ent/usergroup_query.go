@@ -26,7 +26,6 @@ type UserGroupQuery struct {
 	inters     []Interceptor
 	predicates []predicate.UserGroup
 	withItems  *ItemQuery
-	withFKs    bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -77,7 +76,7 @@ func (ugq *UserGroupQuery) QueryItems() *ItemQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(usergroup.Table, usergroup.FieldID, selector),
 			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, usergroup.ItemsTable, usergroup.ItemsColumn),
+			sqlgraph.Edge(sqlgraph.M2M, true, usergroup.ItemsTable, usergroup.ItemsPrimaryKey...),
 		)
 		fromU = sqlgraph.SetNeighbors(ugq.driver.Dialect(), step)
 		return fromU, nil
@@ -372,15 +371,11 @@ func (ugq *UserGroupQuery) prepareQuery(ctx context.Context) error {
 func (ugq *UserGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserGroup, error) {
 	var (
 		nodes       = []*UserGroup{}
-		withFKs     = ugq.withFKs
 		_spec       = ugq.querySpec()
 		loadedTypes = [1]bool{
 			ugq.withItems != nil,
 		}
 	)
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, usergroup.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserGroup).scanValues(nil, columns)
 	}
@@ -410,33 +405,63 @@ func (ugq *UserGroupQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*U
 }
 
 func (ugq *UserGroupQuery) loadItems(ctx context.Context, query *ItemQuery, nodes []*UserGroup, init func(*UserGroup), assign func(*UserGroup, *Item)) error {
-	fks := make([]driver.Value, 0, len(nodes))
-	nodeids := make(map[uuid.UUID]*UserGroup)
-	for i := range nodes {
-		fks = append(fks, nodes[i].ID)
-		nodeids[nodes[i].ID] = nodes[i]
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*UserGroup)
+	nids := make(map[uuid.UUID]map[*UserGroup]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
 		if init != nil {
-			init(nodes[i])
+			init(node)
 		}
 	}
-	query.withFKs = true
-	query.Where(predicate.Item(func(s *sql.Selector) {
-		s.Where(sql.InValues(s.C(usergroup.ItemsColumn), fks...))
-	}))
-	neighbors, err := query.All(ctx)
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(usergroup.ItemsTable)
+		s.Join(joinT).On(s.C(item.FieldID), joinT.C(usergroup.ItemsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(usergroup.ItemsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(usergroup.ItemsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*UserGroup]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Item](ctx, query, qr, query.inters)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		fk := n.user_group_items
-		if fk == nil {
-			return fmt.Errorf(`foreign-key "user_group_items" is nil for node %v`, n.ID)
-		}
-		node, ok := nodeids[*fk]
+		nodes, ok := nids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "user_group_items" returned %v for node %v`, *fk, n.ID)
+			return fmt.Errorf(`unexpected "items" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
 		}
-		assign(node, n)
 	}
 	return nil
 }
@@ -2,7 +2,10 @@ package env
 
 import (
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 func getEnv(key, defaultValue string) string {
@@ -14,6 +17,31 @@ func getEnv(key, defaultValue string) string {
 	return value
 }
 
+func getBoolEnv(key, defaultValue string) bool {
+	value := getEnv(key, defaultValue)
+
+	return value == "true" || value == "1"
+}
+
+// getDurationEnv parses durations such as 90s or 24h, invalid values fall back to the default.
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(getEnv(key, ""))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(getEnv(key, ""))
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
 func GetPort() string {
 	return getEnv("PORT", "8080")
 }
@@ -22,14 +50,140 @@ func GetListenAddress() string {
 	return getEnv("LISTEN_ADDRESS", "0.0.0.0")
 }
 
+// GetStoreDriver returns the database driver of the store, either sqlite3 or postgres.
+func GetStoreDriver() string {
+	return getEnv("STORE_DRIVER", "sqlite3")
+}
+
+func GetStoreDSN() string {
+	return getEnv("STORE_DSN", "file:ent?mode=memory&cache=shared&_fk=1")
+}
+
 func GetIsDevelopmentMode() bool {
-	value := getEnv("DEVELOPMENT", "false")
+	return getBoolEnv("DEVELOPMENT", "false")
+}
+
+// GetIsWorkerEmbedded returns whether the server also executes queued jobs. It is disabled by default, as jobs are
+// executed by separate workers sharing the store of the server.
+func GetIsWorkerEmbedded() bool {
+	return getBoolEnv("WORKER_EMBEDDED", "false")
+}
+
+// GetWorkerPollInterval returns how often workers look for queued jobs.
+func GetWorkerPollInterval() time.Duration {
+	return getDurationEnv("WORKER_POLL_INTERVAL", 5*time.Second)
+}
+
+// GetWorkerLease returns how long a running job is kept without a heartbeat of its worker, before it is failed by
+// another worker. Workers send heartbeats at least three times per lease.
+func GetWorkerLease() time.Duration {
+	return getDurationEnv("WORKER_LEASE", 5*time.Minute)
+}
+
+// GetExportDir returns the directory export jobs write their files to. Workers and the server need to share it.
+func GetExportDir() string {
+	return getEnv("EXPORT_DIR", filepath.Join(os.TempDir(), "dig-inv-exports"))
+}
+
+// GetExportTTL returns how long the file of an export job can be downloaded after the job finished.
+func GetExportTTL() time.Duration {
+	return getDurationEnv("EXPORT_TTL", 24*time.Hour)
+}
+
+// GetExportInlineLimit returns the maximum number of items that can be exported without a worker job.
+func GetExportInlineLimit() int {
+	return getIntEnv("EXPORT_INLINE_LIMIT", 10000)
+}
 
-	if value == "true" || value == "1" {
-		return true
+// GetRdapBootstrapURL returns the bootstrap file that maps top-level domains to the RDAP servers of their registries.
+func GetRdapBootstrapURL() string {
+	return getEnv("RDAP_BOOTSTRAP_URL", "https://data.iana.org/rdap/dns.json")
+}
+
+// GetWhoisServer returns the WHOIS server that is asked for domains without RDAP server. It usually refers to the
+// WHOIS server of the registry, which is asked next.
+func GetWhoisServer() string {
+	return getEnv("WHOIS_SERVER", "whois.iana.org:43")
+}
+
+// GetRegistryRequestInterval returns the minimum time between two lookups at the same registry.
+func GetRegistryRequestInterval() time.Duration {
+	return getDurationEnv("REGISTRY_REQUEST_INTERVAL", 2*time.Second)
+}
+
+// GetMasterKey returns the comma-separated base64 encoded 256-bit master keys that encrypt stored secrets. The first
+// key encrypts new secrets, further keys are only used to decrypt secrets until the rotate-key command re-encrypted
+// them.
+func GetMasterKey() string {
+	return getEnv("MASTER_KEY", "")
+}
+
+// GetMasterKeyFile returns the file holding the master keys, one per line, which is used if MASTER_KEY is not set.
+func GetMasterKeyFile() string {
+	return getEnv("MASTER_KEY_FILE", "")
+}
+
+// GetAttachmentStorage returns where attachment files are stored, either local or s3.
+func GetAttachmentStorage() string {
+	return getEnv("ATTACHMENT_STORAGE", "local")
+}
+
+// GetAttachmentDir returns the directory attachment files are stored in by the local storage. Workers and the server
+// need to share it.
+func GetAttachmentDir() string {
+	return getEnv("ATTACHMENT_DIR", filepath.Join(os.TempDir(), "dig-inv-attachments"))
+}
+
+// GetAttachmentMaxSize returns the maximum size of an attachment in bytes.
+func GetAttachmentMaxSize() int64 {
+	return int64(getIntEnv("ATTACHMENT_MAX_SIZE", 25<<20))
+}
+
+// GetS3Endpoint returns the URL of the S3 compatible service attachments are stored in, e.g. https://s3.eu-central-1.amazonaws.com.
+// Objects are addressed in path style, so the bucket is not part of the URL.
+func GetS3Endpoint() string {
+	return getEnv("S3_ENDPOINT", "")
+}
+
+func GetS3Bucket() string {
+	return getEnv("S3_BUCKET", "")
+}
+
+func GetS3Region() string {
+	return getEnv("S3_REGION", "us-east-1")
+}
+
+func GetS3AccessKeyID() string {
+	return getEnv("S3_ACCESS_KEY_ID", "")
+}
+
+func GetS3SecretAccessKey() string {
+	return getEnv("S3_SECRET_ACCESS_KEY", "")
+}
+
+// GetCostCurrency returns the ISO 4217 code of the currency spend reports are made in by default.
+func GetCostCurrency() string {
+	return getEnv("COST_CURRENCY", "EUR")
+}
+
+// GetCurrencyRates returns the conversion rates of the spend reports, e.g. `USD=0.92, GBP=1.17`. Every rate is the
+// value of one unit of the currency in the COST_CURRENCY.
+func GetCurrencyRates() string {
+	return getEnv("CURRENCY_RATES", "")
+}
+
+// GetDnsResolvers returns the host:port of the resolvers DNS records are checked against. Without resolvers, the
+// name servers of the system are used.
+func GetDnsResolvers() []string {
+	resolvers := make([]string, 0)
+	for _, resolver := range strings.Split(getEnv("DNS_RESOLVERS", ""), ",") {
+		resolver = strings.TrimSpace(resolver)
+		if resolver != "" {
+			resolvers = append(resolvers, resolver)
+		}
 	}
 
-	return false
+	return resolvers
 }
 
 func getOidcEnv(key, defaultValue string) string {
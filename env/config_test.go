@@ -2,7 +2,10 @@ package env
 
 import (
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func setEnvDeferrable(
@@ -52,6 +55,34 @@ func TestEnvironmentVariables(t *testing.T) {
 			}
 			return "false"
 		}, "true"},
+		{"WORKER_EMBEDDED", func() string {
+			if GetIsWorkerEmbedded() {
+				return "true"
+			}
+			return "false"
+		}, "true"},
+		{"WORKER_POLL_INTERVAL", func() string { return GetWorkerPollInterval().String() }, "1m0s"},
+		{"WORKER_LEASE", func() string { return GetWorkerLease().String() }, "10m0s"},
+		{"EXPORT_DIR", GetExportDir, "/var/lib/dig-inv/exports"},
+		{"EXPORT_TTL", func() string { return GetExportTTL().String() }, "2h0m0s"},
+		{"RDAP_BOOTSTRAP_URL", GetRdapBootstrapURL, "http://localhost:8081/dns.json"},
+		{"WHOIS_SERVER", GetWhoisServer, "whois.example.com:43"},
+		{"REGISTRY_REQUEST_INTERVAL", func() string { return GetRegistryRequestInterval().String() }, "500ms"},
+		{"DNS_RESOLVERS", func() string { return strings.Join(GetDnsResolvers(), ",") }, "192.0.2.53:53,[2001:db8::53]:53"},
+		{"MASTER_KEY", GetMasterKey, "c2VjcmV0"},
+		{"MASTER_KEY_FILE", GetMasterKeyFile, "/run/secrets/master-key"},
+		{"ATTACHMENT_STORAGE", GetAttachmentStorage, "s3"},
+		{"ATTACHMENT_DIR", GetAttachmentDir, "/var/lib/dig-inv/attachments"},
+		{"ATTACHMENT_MAX_SIZE", func() string { return strconv.FormatInt(GetAttachmentMaxSize(), 10) }, "1048576"},
+		{"S3_ENDPOINT", GetS3Endpoint, "http://localhost:9000"},
+		{"S3_BUCKET", GetS3Bucket, "dig-inv"},
+		{"S3_REGION", GetS3Region, "eu-central-1"},
+		{"S3_ACCESS_KEY_ID", GetS3AccessKeyID, "minio"},
+		{"S3_SECRET_ACCESS_KEY", GetS3SecretAccessKey, "minio-secret"},
+		{"COST_CURRENCY", GetCostCurrency, "USD"},
+		{"CURRENCY_RATES", GetCurrencyRates, "EUR=1.08"},
+		{"STORE_DRIVER", GetStoreDriver, "postgres"},
+		{"STORE_DSN", GetStoreDSN, "postgres://dig-inv@localhost/dig-inv"},
 		{"OIDC_CLIENT_ID", GetOidcClientID, "test-client-id"},
 		{"OIDC_CLIENT_SECRET", GetOidcClientSecret, "test-client-secret"},
 		{"OIDC_REDIRECT_URL", GetOidcRedirectURL, "http://localhost:8080/auth/callback"},
@@ -111,3 +142,15 @@ func TestGetAllowedCorsOriginsEmpty(t *testing.T) {
 		t.Error("Expected empty allowed CORS origins")
 	}
 }
+
+func TestGetDurationDefaults(t *testing.T) {
+	defer setEnvDeferrable(t, "EXPORT_TTL", "tomorrow")()
+
+	if ttl := GetExportTTL(); ttl != 24*time.Hour {
+		t.Errorf("Expected invalid export TTL to fall back to 24h, got %s", ttl)
+	}
+
+	if limit := GetExportInlineLimit(); limit != 10000 {
+		t.Errorf("Expected default export inline limit 10000, got %d", limit)
+	}
+}
@@ -0,0 +1,191 @@
+package export
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/usergroup"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+	FormatYAML  = "yaml"
+	FormatXLSX  = "xlsx"
+)
+
+var Formats = []string{FormatCSV, FormatJSONL, FormatYAML, FormatXLSX}
+
+// AttributePrefix prefixes the columns of custom attributes, so they can't collide with the built-in columns.
+const AttributePrefix = "attributes."
+
+// Columns are the built-in columns of every export, followed by one column per custom attribute.
+var Columns = []string{
+	"id",
+	"name",
+	"description",
+	"asset_class",
+	"parent_id",
+	"tags",
+	"groups",
+	"cost_amount",
+	"cost_currency",
+	"cost_period",
+	"cost_center",
+	"created_at",
+	"created_by",
+	"updated_at",
+	"updated_by",
+}
+
+// items are loaded in pages, so large exports don't have to be held in memory
+const pageSize = 500
+
+// ContentType returns the MIME type of the format.
+func ContentType(format string) string {
+	switch format {
+	case FormatCSV:
+		return "text/csv"
+	case FormatJSONL:
+		return "application/jsonl"
+	case FormatYAML:
+		return "application/yaml"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "application/octet-stream"
+}
+
+// Write writes the items matched by the query in the given format. Attributes are flattened into one column per key,
+// items of asset classes without the attribute leave the column empty.
+func Write(ctx context.Context, w io.Writer, format string, query *ent.ItemQuery) error {
+	rw, err := newRecordWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	keys, err := attributeKeys(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	columns := slices.Clone(Columns)
+	for _, key := range keys {
+		columns = append(columns, AttributePrefix+key)
+	}
+
+	if err := rw.header(columns); err != nil {
+		return fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for offset := 0; ; offset += pageSize {
+		items, err := query.Clone().
+			WithAssetClass().
+			WithTags(func(q *ent.TagQuery) { q.Where(tag.DeletedAtIsNil()).Order(tag.ByName()) }).
+			WithUserGroups(func(q *ent.UserGroupQuery) {
+				q.Where(usergroup.DeletedAtIsNil()).Order(usergroup.ByName())
+			}).
+			WithParent().
+			Order(item.ByName(), item.ByID()).
+			Offset(offset).
+			Limit(pageSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query items: %w", err)
+		}
+
+		for _, i := range items {
+			if err := rw.row(record(i, keys)); err != nil {
+				return fmt.Errorf("failed to write item %s: %w", i.ID, err)
+			}
+		}
+
+		if len(items) < pageSize {
+			break
+		}
+	}
+
+	return rw.close()
+}
+
+// attributeKeys returns the sorted keys of the attributes defined by the asset classes of the matched items. Secret
+// attributes are never exported.
+func attributeKeys(ctx context.Context, query *ent.ItemQuery) ([]string, error) {
+	keys, err := query.Clone().
+		QueryAssetClass().
+		QueryAttributes().
+		Where(attributedefinition.DeletedAtIsNil(), attributedefinition.Secret(false)).
+		Unique(true).
+		Select(attributedefinition.FieldKey).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attribute definitions: %w", err)
+	}
+
+	slices.Sort(keys)
+
+	return slices.Compact(keys), nil
+}
+
+func record(i *ent.Item, keys []string) []any {
+	assetClass := ""
+	if i.Edges.AssetClass != nil {
+		assetClass = i.Edges.AssetClass.Name
+	}
+
+	parent := ""
+	if i.Edges.Parent != nil {
+		parent = i.Edges.Parent.ID.String()
+	}
+
+	tags := make([]string, 0, len(i.Edges.Tags))
+	for _, t := range i.Edges.Tags {
+		tags = append(tags, t.Name)
+	}
+
+	groups := make([]string, 0, len(i.Edges.UserGroups))
+	for _, group := range i.Edges.UserGroups {
+		groups = append(groups, group.Name)
+	}
+
+	var amount any
+	if i.CostAmount != nil {
+		amount = *i.CostAmount
+	}
+
+	period := ""
+	if i.CostPeriod != nil {
+		period = string(*i.CostPeriod)
+	}
+
+	values := []any{
+		i.ID.String(),
+		i.Name,
+		i.Description,
+		assetClass,
+		parent,
+		tags,
+		groups,
+		amount,
+		i.CostCurrency,
+		period,
+		i.CostCenter,
+		i.CreatedAt.Format(time.RFC3339),
+		i.CreatedBy,
+		i.UpdatedAt.Format(time.RFC3339),
+		i.UpdatedBy,
+	}
+
+	for _, key := range keys {
+		values = append(values, i.Attributes[key])
+	}
+
+	return values
+}
@@ -0,0 +1,189 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/store/storetest"
+	"encoding/csv"
+	"encoding/json"
+	"github.com/xuri/excelize/v2"
+	"gopkg.in/yaml.v3"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func createExportTestItems(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+
+	server := client.AssetClass.Create().SetName("Server").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+	license := client.AssetClass.Create().SetName("License").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+
+	client.AttributeDefinition.Create().
+		SetKey("hostname").
+		SetName("Hostname").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(server).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("seats").
+		SetName("Seats").
+		SetType(attributedefinition.TypeNumber).
+		SetAssetClass(license).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+
+	prod := client.Tag.Create().SetName("prod").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+	eu := client.Tag.Create().SetName("eu").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+
+	client.Item.Create().
+		SetName("web-1").
+		SetDescription("frontend, primary").
+		SetAssetClass(server).
+		AddTags(prod, eu).
+		SetAttributes(map[string]any{"hostname": "web-1.example"}).
+		SetCostAmount(12.5).
+		SetCostCurrency("EUR").
+		SetCostPeriod(item.CostPeriodMonthly).
+		SetCostCenter("ops").
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	client.Item.Create().
+		SetName("ide").
+		SetAssetClass(license).
+		SetAttributes(map[string]any{"seats": float64(25)}).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+}
+
+func TestWrite(t *testing.T) {
+	client := storetest.OpenClient(t, "export")
+	createExportTestItems(t, client)
+	ctx := context.Background()
+
+	columns := slices.Concat(Columns, []string{"attributes.hostname", "attributes.seats"})
+
+	t.Run(FormatCSV, func(t *testing.T) {
+		var b bytes.Buffer
+		if err := Write(ctx, &b, FormatCSV, client.Item.Query()); err != nil {
+			t.Fatalf("Failed to write export: %v", err)
+		}
+
+		rows, err := csv.NewReader(&b).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to read export: %v", err)
+		}
+
+		if len(rows) != 3 || !slices.Equal(rows[0], columns) {
+			t.Fatalf("Unexpected CSV export: %v", rows)
+		}
+
+		// items are sorted by name
+		if rows[1][1] != "ide" || rows[1][len(columns)-1] != "25" || rows[1][len(columns)-2] != "" {
+			t.Errorf("Unexpected first row: %v", rows[1])
+		}
+
+		if rows[2][2] != "frontend, primary" || rows[2][3] != "Server" || rows[2][5] != "eu;prod" || rows[2][len(columns)-2] != "web-1.example" {
+			t.Errorf("Unexpected second row: %v", rows[2])
+		}
+
+		if cost := rows[2][7:11]; !slices.Equal(cost, []string{"12.5", "EUR", "monthly", "ops"}) || rows[1][7] != "" {
+			t.Errorf("Unexpected cost columns: %v", cost)
+		}
+	})
+
+	t.Run(FormatJSONL, func(t *testing.T) {
+		var b bytes.Buffer
+		if err := Write(ctx, &b, FormatJSONL, client.Item.Query()); err != nil {
+			t.Fatalf("Failed to write export: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("Expected 2 lines, got %d", len(lines))
+		}
+
+		var record map[string]any
+		if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+			t.Fatalf("Failed to parse line: %v", err)
+		}
+
+		if record["name"] != "ide" || record["attributes.seats"] != float64(25) || record["attributes.hostname"] != nil ||
+			record["cost_amount"] != nil {
+			t.Errorf("Unexpected record: %v", record)
+		}
+
+		if !strings.HasPrefix(lines[1], `{"id":`) {
+			t.Errorf("Expected keys in column order, got %s", lines[1])
+		}
+	})
+
+	t.Run(FormatYAML, func(t *testing.T) {
+		var b bytes.Buffer
+		if err := Write(ctx, &b, FormatYAML, client.Item.Query()); err != nil {
+			t.Fatalf("Failed to write export: %v", err)
+		}
+
+		var records []map[string]any
+		if err := yaml.Unmarshal(b.Bytes(), &records); err != nil {
+			t.Fatalf("Failed to parse export: %v", err)
+		}
+
+		if len(records) != 2 || records[1]["attributes.hostname"] != "web-1.example" {
+			t.Fatalf("Unexpected YAML export: %v", records)
+		}
+
+		if tags, ok := records[1]["tags"].([]any); !ok || len(tags) != 2 {
+			t.Errorf("Expected tags as list, got %v", records[1]["tags"])
+		}
+	})
+
+	t.Run(FormatXLSX, func(t *testing.T) {
+		var b bytes.Buffer
+		if err := Write(ctx, &b, FormatXLSX, client.Item.Query()); err != nil {
+			t.Fatalf("Failed to write export: %v", err)
+		}
+
+		file, err := excelize.OpenReader(&b)
+		if err != nil {
+			t.Fatalf("Failed to open workbook: %v", err)
+		}
+
+		rows, err := file.GetRows(xlsxSheet)
+		if err != nil {
+			t.Fatalf("Failed to read rows: %v", err)
+		}
+
+		if len(rows) != 3 || !slices.Equal(rows[0], columns) || rows[1][len(columns)-1] != "25" {
+			t.Errorf("Unexpected XLSX export: %v", rows)
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		if err := Write(ctx, &bytes.Buffer{}, "pdf", client.Item.Query()); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+	})
+}
+
+func TestWriteEmpty(t *testing.T) {
+	client := storetest.OpenClient(t, "export")
+	ctx := context.Background()
+
+	var b bytes.Buffer
+	if err := Write(ctx, &b, FormatYAML, client.Item.Query().Where(item.Name("missing"))); err != nil {
+		t.Fatalf("Failed to write export: %v", err)
+	}
+
+	if b.String() != "[]\n" {
+		t.Errorf("Expected empty list, got %q", b.String())
+	}
+}
@@ -0,0 +1,92 @@
+package export
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/jobrun"
+	"dig-inv/env"
+	"dig-inv/log"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// JobType is the type of the job runs that write exports to files.
+const JobType = "export"
+
+// FileName returns the name of the file an export job writes.
+func FileName(run *ent.JobRun) string {
+	return run.ID.String() + "." + run.Parameters["format"]
+}
+
+// FilePath returns the path of a file written by an export job.
+func FilePath(name string) string {
+	return filepath.Join(env.GetExportDir(), filepath.Base(name))
+}
+
+// ExpiresAt returns when the file of a finished export job can no longer be downloaded.
+func ExpiresAt(run *ent.JobRun) time.Time {
+	if run.FinishedAt == nil {
+		return time.Time{}
+	}
+
+	return run.FinishedAt.Add(env.GetExportTTL())
+}
+
+// WriteFile writes the items matched by the query to the file of the export job and returns the file name.
+func WriteFile(ctx context.Context, run *ent.JobRun, query *ent.ItemQuery) (string, error) {
+	if err := os.MkdirAll(env.GetExportDir(), 0o750); err != nil {
+		return "", fmt.Errorf("failed to create export directory: %w", err)
+	}
+
+	name := FileName(run)
+	file, err := os.OpenFile(FilePath(name), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o640)
+	if err != nil {
+		return "", fmt.Errorf("failed to create export file: %w", err)
+	}
+
+	err = Write(ctx, file, run.Parameters["format"], query)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(FilePath(name))
+		return "", err
+	}
+
+	return name, nil
+}
+
+// PurgeExpired removes the files of export jobs whose download expired.
+func PurgeExpired(ctx context.Context, client *ent.Client) error {
+	runs, err := client.JobRun.Query().
+		Where(
+			jobrun.Type(JobType),
+			jobrun.StatusEQ(jobrun.StatusSucceeded),
+			jobrun.ResultNEQ(""),
+			jobrun.FinishedAtLT(time.Now().Add(-env.GetExportTTL())),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query expired exports: %w", err)
+	}
+
+	for _, run := range runs {
+		if err := os.Remove(FilePath(run.Result)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove export file: %w", err)
+		}
+
+		// an empty result marks the file as removed
+		if err := client.JobRun.UpdateOne(run).SetResult("").SetUpdatedBy(run.UpdatedBy).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update expired export: %w", err)
+		}
+
+		log.S.Debugw("Removed expired export", "id", run.ID, "file", run.Result)
+	}
+
+	return nil
+}
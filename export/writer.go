@@ -0,0 +1,231 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// separates the tags and groups of an item in formats without lists
+const listSeparator = ";"
+
+type recordWriter interface {
+	header(columns []string) error
+	row(values []any) error
+	close() error
+}
+
+func newRecordWriter(format string, w io.Writer) (recordWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatJSONL:
+		return &jsonlWriter{w: w}, nil
+	case FormatYAML:
+		return &yamlWriter{w: w}, nil
+	case FormatXLSX:
+		return newXlsxWriter(w)
+	}
+
+	return nil, fmt.Errorf("unsupported export format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// text converts a value to its textual representation in CSV and XLSX cells.
+func text(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, listSeparator)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) header(columns []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvWriter) row(values []any) error {
+	cells := make([]string, 0, len(values))
+	for _, value := range values {
+		cells = append(cells, text(value))
+	}
+
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonlWriter writes one JSON object per line, with the keys in the order of the columns.
+type jsonlWriter struct {
+	w       io.Writer
+	columns []string
+}
+
+func (j *jsonlWriter) header(columns []string) error {
+	j.columns = columns
+	return nil
+}
+
+func (j *jsonlWriter) row(values []any) error {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		key, err := json.Marshal(j.columns[i])
+		if err != nil {
+			return err
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(encoded)
+	}
+	b.WriteString("}\n")
+
+	_, err := j.w.Write(b.Bytes())
+	return err
+}
+
+func (j *jsonlWriter) close() error {
+	return nil
+}
+
+// yamlWriter writes a list of mappings. Every item is written as a list with a single element, which concatenated
+// form a single list.
+type yamlWriter struct {
+	w       io.Writer
+	columns []string
+	rows    int
+}
+
+func (y *yamlWriter) header(columns []string) error {
+	y.columns = columns
+	return nil
+}
+
+func (y *yamlWriter) row(values []any) error {
+	mapping := &yaml.Node{Kind: yaml.MappingNode}
+	for i, value := range values {
+		var node yaml.Node
+		if err := node.Encode(value); err != nil {
+			return err
+		}
+
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: y.columns[i]}, &node)
+	}
+
+	out, err := yaml.Marshal([]*yaml.Node{mapping})
+	if err != nil {
+		return err
+	}
+
+	y.rows++
+	_, err = y.w.Write(out)
+	return err
+}
+
+func (y *yamlWriter) close() error {
+	if y.rows > 0 {
+		return nil
+	}
+
+	_, err := io.WriteString(y.w, "[]\n")
+	return err
+}
+
+const xlsxSheet = "Items"
+
+// xlsxWriter streams the rows into a single worksheet, the workbook is written to w on close.
+type xlsxWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	rows   int
+}
+
+func newXlsxWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+	if err := file.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return nil, err
+	}
+
+	stream, err := file.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{w: w, file: file, stream: stream}, nil
+}
+
+func (x *xlsxWriter) header(columns []string) error {
+	cells := make([]any, 0, len(columns))
+	for _, column := range columns {
+		cells = append(cells, column)
+	}
+
+	return x.write(cells)
+}
+
+func (x *xlsxWriter) row(values []any) error {
+	cells := make([]any, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case float64, bool:
+			cells = append(cells, v)
+		default:
+			cells = append(cells, text(v))
+		}
+	}
+
+	return x.write(cells)
+}
+
+func (x *xlsxWriter) write(cells []any) error {
+	x.rows++
+
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+
+	return x.stream.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) close() error {
+	defer func() {
+		_ = x.file.Close()
+	}()
+
+	if err := x.stream.Flush(); err != nil {
+		return err
+	}
+
+	return x.file.Write(x.w)
+}
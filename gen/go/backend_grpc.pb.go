@@ -235,22 +235,37 @@ var OpenIdAuthService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ItemService_GetItem_FullMethodName    = "/dig_inv.ItemService/GetItem"
-	ItemService_GetItems_FullMethodName   = "/dig_inv.ItemService/GetItems"
-	ItemService_CreateItem_FullMethodName = "/dig_inv.ItemService/CreateItem"
-	ItemService_UpdateItem_FullMethodName = "/dig_inv.ItemService/UpdateItem"
-	ItemService_DeleteItem_FullMethodName = "/dig_inv.ItemService/DeleteItem"
+	ItemService_GetItem_FullMethodName           = "/dig_inv.ItemService/GetItem"
+	ItemService_GetItems_FullMethodName          = "/dig_inv.ItemService/GetItems"
+	ItemService_CreateItem_FullMethodName        = "/dig_inv.ItemService/CreateItem"
+	ItemService_UpdateItem_FullMethodName        = "/dig_inv.ItemService/UpdateItem"
+	ItemService_DeleteItem_FullMethodName        = "/dig_inv.ItemService/DeleteItem"
+	ItemService_MoveItem_FullMethodName          = "/dig_inv.ItemService/MoveItem"
+	ItemService_GetSubtree_FullMethodName        = "/dig_inv.ItemService/GetSubtree"
+	ItemService_GetRelations_FullMethodName      = "/dig_inv.ItemService/GetRelations"
+	ItemService_CreateRelation_FullMethodName    = "/dig_inv.ItemService/CreateRelation"
+	ItemService_DeleteRelation_FullMethodName    = "/dig_inv.ItemService/DeleteRelation"
+	ItemService_TraverseRelations_FullMethodName = "/dig_inv.ItemService/TraverseRelations"
+	ItemService_RevealSecret_FullMethodName      = "/dig_inv.ItemService/RevealSecret"
 )
 
 // ItemServiceClient is the client API for ItemService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ItemServiceClient interface {
-	GetItem(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Item, error)
-	GetItems(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Items, error)
+	GetItem(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Item, error)
+	GetItems(ctx context.Context, in *ItemFilter, opts ...grpc.CallOption) (*Items, error)
 	CreateItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*Item, error)
 	UpdateItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*Item, error)
-	DeleteItem(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	MoveItem(ctx context.Context, in *MoveItemRequest, opts ...grpc.CallOption) (*Item, error)
+	GetSubtree(ctx context.Context, in *SubtreeRequest, opts ...grpc.CallOption) (*ItemSubtree, error)
+	GetRelations(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ItemRelations, error)
+	CreateRelation(ctx context.Context, in *ItemRelation, opts ...grpc.CallOption) (*ItemRelation, error)
+	DeleteRelation(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	TraverseRelations(ctx context.Context, in *RelationTraversalRequest, opts ...grpc.CallOption) (*RelationTraversal, error)
+	// requires membership in one of the groups of the item, every reveal is recorded in the audit log
+	RevealSecret(ctx context.Context, in *RevealSecretRequest, opts ...grpc.CallOption) (*RevealedSecret, error)
 }
 
 type itemServiceClient struct {
@@ -261,7 +276,7 @@ func NewItemServiceClient(cc grpc.ClientConnInterface) ItemServiceClient {
 	return &itemServiceClient{cc}
 }
 
-func (c *itemServiceClient) GetItem(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Item, error) {
+func (c *itemServiceClient) GetItem(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Item, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Item)
 	err := c.cc.Invoke(ctx, ItemService_GetItem_FullMethodName, in, out, cOpts...)
@@ -271,7 +286,7 @@ func (c *itemServiceClient) GetItem(ctx context.Context, in *EmptyMessage, opts
 	return out, nil
 }
 
-func (c *itemServiceClient) GetItems(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Items, error) {
+func (c *itemServiceClient) GetItems(ctx context.Context, in *ItemFilter, opts ...grpc.CallOption) (*Items, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Items)
 	err := c.cc.Invoke(ctx, ItemService_GetItems_FullMethodName, in, out, cOpts...)
@@ -301,7 +316,7 @@ func (c *itemServiceClient) UpdateItem(ctx context.Context, in *Item, opts ...gr
 	return out, nil
 }
 
-func (c *itemServiceClient) DeleteItem(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+func (c *itemServiceClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, ItemService_DeleteItem_FullMethodName, in, out, cOpts...)
@@ -311,15 +326,93 @@ func (c *itemServiceClient) DeleteItem(ctx context.Context, in *ElementId, opts
 	return out, nil
 }
 
+func (c *itemServiceClient) MoveItem(ctx context.Context, in *MoveItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, ItemService_MoveItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) GetSubtree(ctx context.Context, in *SubtreeRequest, opts ...grpc.CallOption) (*ItemSubtree, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemSubtree)
+	err := c.cc.Invoke(ctx, ItemService_GetSubtree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) GetRelations(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ItemRelations, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemRelations)
+	err := c.cc.Invoke(ctx, ItemService_GetRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) CreateRelation(ctx context.Context, in *ItemRelation, opts ...grpc.CallOption) (*ItemRelation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemRelation)
+	err := c.cc.Invoke(ctx, ItemService_CreateRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) DeleteRelation(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, ItemService_DeleteRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) TraverseRelations(ctx context.Context, in *RelationTraversalRequest, opts ...grpc.CallOption) (*RelationTraversal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelationTraversal)
+	err := c.cc.Invoke(ctx, ItemService_TraverseRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) RevealSecret(ctx context.Context, in *RevealSecretRequest, opts ...grpc.CallOption) (*RevealedSecret, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealedSecret)
+	err := c.cc.Invoke(ctx, ItemService_RevealSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemServiceServer is the server API for ItemService service.
 // All implementations must embed UnimplementedItemServiceServer
 // for forward compatibility.
 type ItemServiceServer interface {
-	GetItem(context.Context, *EmptyMessage) (*Item, error)
-	GetItems(context.Context, *EmptyMessage) (*Items, error)
+	GetItem(context.Context, *ElementId) (*Item, error)
+	GetItems(context.Context, *ItemFilter) (*Items, error)
 	CreateItem(context.Context, *Item) (*Item, error)
 	UpdateItem(context.Context, *Item) (*Item, error)
-	DeleteItem(context.Context, *ElementId) (*EmptyMessage, error)
+	DeleteItem(context.Context, *DeleteItemRequest) (*EmptyMessage, error)
+	MoveItem(context.Context, *MoveItemRequest) (*Item, error)
+	GetSubtree(context.Context, *SubtreeRequest) (*ItemSubtree, error)
+	GetRelations(context.Context, *ElementId) (*ItemRelations, error)
+	CreateRelation(context.Context, *ItemRelation) (*ItemRelation, error)
+	DeleteRelation(context.Context, *ElementId) (*EmptyMessage, error)
+	TraverseRelations(context.Context, *RelationTraversalRequest) (*RelationTraversal, error)
+	// requires membership in one of the groups of the item, every reveal is recorded in the audit log
+	RevealSecret(context.Context, *RevealSecretRequest) (*RevealedSecret, error)
 	mustEmbedUnimplementedItemServiceServer()
 }
 
@@ -330,10 +423,10 @@ type ItemServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedItemServiceServer struct{}
 
-func (UnimplementedItemServiceServer) GetItem(context.Context, *EmptyMessage) (*Item, error) {
+func (UnimplementedItemServiceServer) GetItem(context.Context, *ElementId) (*Item, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
 }
-func (UnimplementedItemServiceServer) GetItems(context.Context, *EmptyMessage) (*Items, error) {
+func (UnimplementedItemServiceServer) GetItems(context.Context, *ItemFilter) (*Items, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItems not implemented")
 }
 func (UnimplementedItemServiceServer) CreateItem(context.Context, *Item) (*Item, error) {
@@ -342,9 +435,30 @@ func (UnimplementedItemServiceServer) CreateItem(context.Context, *Item) (*Item,
 func (UnimplementedItemServiceServer) UpdateItem(context.Context, *Item) (*Item, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
 }
-func (UnimplementedItemServiceServer) DeleteItem(context.Context, *ElementId) (*EmptyMessage, error) {
+func (UnimplementedItemServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
+func (UnimplementedItemServiceServer) MoveItem(context.Context, *MoveItemRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveItem not implemented")
+}
+func (UnimplementedItemServiceServer) GetSubtree(context.Context, *SubtreeRequest) (*ItemSubtree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubtree not implemented")
+}
+func (UnimplementedItemServiceServer) GetRelations(context.Context, *ElementId) (*ItemRelations, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelations not implemented")
+}
+func (UnimplementedItemServiceServer) CreateRelation(context.Context, *ItemRelation) (*ItemRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelation not implemented")
+}
+func (UnimplementedItemServiceServer) DeleteRelation(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelation not implemented")
+}
+func (UnimplementedItemServiceServer) TraverseRelations(context.Context, *RelationTraversalRequest) (*RelationTraversal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TraverseRelations not implemented")
+}
+func (UnimplementedItemServiceServer) RevealSecret(context.Context, *RevealSecretRequest) (*RevealedSecret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSecret not implemented")
+}
 func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
 func (UnimplementedItemServiceServer) testEmbeddedByValue()                     {}
 
@@ -367,7 +481,7 @@ func RegisterItemServiceServer(s grpc.ServiceRegistrar, srv ItemServiceServer) {
 }
 
 func _ItemService_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+	in := new(ElementId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -379,13 +493,13 @@ func _ItemService_GetItem_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: ItemService_GetItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItemServiceServer).GetItem(ctx, req.(*EmptyMessage))
+		return srv.(ItemServiceServer).GetItem(ctx, req.(*ElementId))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _ItemService_GetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyMessage)
+	in := new(ItemFilter)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -397,7 +511,7 @@ func _ItemService_GetItems_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: ItemService_GetItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItemServiceServer).GetItems(ctx, req.(*EmptyMessage))
+		return srv.(ItemServiceServer).GetItems(ctx, req.(*ItemFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -439,7 +553,7 @@ func _ItemService_UpdateItem_Handler(srv interface{}, ctx context.Context, dec f
 }
 
 func _ItemService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ElementId)
+	in := new(DeleteItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -451,7 +565,133 @@ func _ItemService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: ItemService_DeleteItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ItemServiceServer).DeleteItem(ctx, req.(*ElementId))
+		return srv.(ItemServiceServer).DeleteItem(ctx, req.(*DeleteItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_MoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).MoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_MoveItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).MoveItem(ctx, req.(*MoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_GetSubtree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetSubtree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_GetSubtree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetSubtree(ctx, req.(*SubtreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_GetRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_GetRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetRelations(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_CreateRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemRelation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).CreateRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_CreateRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).CreateRelation(ctx, req.(*ItemRelation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_DeleteRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).DeleteRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_DeleteRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).DeleteRelation(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_TraverseRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationTraversalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).TraverseRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_TraverseRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).TraverseRelations(ctx, req.(*RelationTraversalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_RevealSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).RevealSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_RevealSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).RevealSecret(ctx, req.(*RevealSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -483,6 +723,34 @@ var ItemService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteItem",
 			Handler:    _ItemService_DeleteItem_Handler,
 		},
+		{
+			MethodName: "MoveItem",
+			Handler:    _ItemService_MoveItem_Handler,
+		},
+		{
+			MethodName: "GetSubtree",
+			Handler:    _ItemService_GetSubtree_Handler,
+		},
+		{
+			MethodName: "GetRelations",
+			Handler:    _ItemService_GetRelations_Handler,
+		},
+		{
+			MethodName: "CreateRelation",
+			Handler:    _ItemService_CreateRelation_Handler,
+		},
+		{
+			MethodName: "DeleteRelation",
+			Handler:    _ItemService_DeleteRelation_Handler,
+		},
+		{
+			MethodName: "TraverseRelations",
+			Handler:    _ItemService_TraverseRelations_Handler,
+		},
+		{
+			MethodName: "RevealSecret",
+			Handler:    _ItemService_RevealSecret_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend.proto",
@@ -732,48 +1000,3442 @@ func _UserGroupService_AddItemToGroup_Handler(srv interface{}, ctx context.Conte
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserGroupServiceServer).AddItemToGroup(ctx, in)
+		return srv.(UserGroupServiceServer).AddItemToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserGroupService_AddItemToGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserGroupServiceServer).AddItemToGroup(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserGroupService_ServiceDesc is the grpc.ServiceDesc for UserGroupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserGroupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.UserGroupService",
+	HandlerType: (*UserGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGroup",
+			Handler:    _UserGroupService_GetGroup_Handler,
+		},
+		{
+			MethodName: "GetGroups",
+			Handler:    _UserGroupService_GetGroups_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _UserGroupService_CreateGroup_Handler,
+		},
+		{
+			MethodName: "UpdateGroup",
+			Handler:    _UserGroupService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _UserGroupService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "AddItemToGroup",
+			Handler:    _UserGroupService_AddItemToGroup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	SavedViewService_GetView_FullMethodName    = "/dig_inv.SavedViewService/GetView"
+	SavedViewService_GetViews_FullMethodName   = "/dig_inv.SavedViewService/GetViews"
+	SavedViewService_CreateView_FullMethodName = "/dig_inv.SavedViewService/CreateView"
+	SavedViewService_UpdateView_FullMethodName = "/dig_inv.SavedViewService/UpdateView"
+	SavedViewService_DeleteView_FullMethodName = "/dig_inv.SavedViewService/DeleteView"
+)
+
+// SavedViewServiceClient is the client API for SavedViewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SavedViewServiceClient interface {
+	GetView(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*SavedView, error)
+	GetViews(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SavedViews, error)
+	CreateView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error)
+	UpdateView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error)
+	DeleteView(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type savedViewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSavedViewServiceClient(cc grpc.ClientConnInterface) SavedViewServiceClient {
+	return &savedViewServiceClient{cc}
+}
+
+func (c *savedViewServiceClient) GetView(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, SavedViewService_GetView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedViewServiceClient) GetViews(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*SavedViews, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedViews)
+	err := c.cc.Invoke(ctx, SavedViewService_GetViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedViewServiceClient) CreateView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, SavedViewService_CreateView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedViewServiceClient) UpdateView(ctx context.Context, in *SavedView, opts ...grpc.CallOption) (*SavedView, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedView)
+	err := c.cc.Invoke(ctx, SavedViewService_UpdateView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *savedViewServiceClient) DeleteView(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, SavedViewService_DeleteView_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SavedViewServiceServer is the server API for SavedViewService service.
+// All implementations must embed UnimplementedSavedViewServiceServer
+// for forward compatibility.
+type SavedViewServiceServer interface {
+	GetView(context.Context, *ElementId) (*SavedView, error)
+	GetViews(context.Context, *EmptyMessage) (*SavedViews, error)
+	CreateView(context.Context, *SavedView) (*SavedView, error)
+	UpdateView(context.Context, *SavedView) (*SavedView, error)
+	DeleteView(context.Context, *ElementId) (*EmptyMessage, error)
+	mustEmbedUnimplementedSavedViewServiceServer()
+}
+
+// UnimplementedSavedViewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSavedViewServiceServer struct{}
+
+func (UnimplementedSavedViewServiceServer) GetView(context.Context, *ElementId) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetView not implemented")
+}
+func (UnimplementedSavedViewServiceServer) GetViews(context.Context, *EmptyMessage) (*SavedViews, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetViews not implemented")
+}
+func (UnimplementedSavedViewServiceServer) CreateView(context.Context, *SavedView) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateView not implemented")
+}
+func (UnimplementedSavedViewServiceServer) UpdateView(context.Context, *SavedView) (*SavedView, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateView not implemented")
+}
+func (UnimplementedSavedViewServiceServer) DeleteView(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteView not implemented")
+}
+func (UnimplementedSavedViewServiceServer) mustEmbedUnimplementedSavedViewServiceServer() {}
+func (UnimplementedSavedViewServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeSavedViewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SavedViewServiceServer will
+// result in compilation errors.
+type UnsafeSavedViewServiceServer interface {
+	mustEmbedUnimplementedSavedViewServiceServer()
+}
+
+func RegisterSavedViewServiceServer(s grpc.ServiceRegistrar, srv SavedViewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSavedViewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SavedViewService_ServiceDesc, srv)
+}
+
+func _SavedViewService_GetView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedViewServiceServer).GetView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedViewService_GetView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedViewServiceServer).GetView(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedViewService_GetViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedViewServiceServer).GetViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedViewService_GetViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedViewServiceServer).GetViews(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedViewService_CreateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedView)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedViewServiceServer).CreateView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedViewService_CreateView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedViewServiceServer).CreateView(ctx, req.(*SavedView))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedViewService_UpdateView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavedView)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedViewServiceServer).UpdateView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedViewService_UpdateView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedViewServiceServer).UpdateView(ctx, req.(*SavedView))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SavedViewService_DeleteView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SavedViewServiceServer).DeleteView(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SavedViewService_DeleteView_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SavedViewServiceServer).DeleteView(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SavedViewService_ServiceDesc is the grpc.ServiceDesc for SavedViewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SavedViewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.SavedViewService",
+	HandlerType: (*SavedViewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetView",
+			Handler:    _SavedViewService_GetView_Handler,
+		},
+		{
+			MethodName: "GetViews",
+			Handler:    _SavedViewService_GetViews_Handler,
+		},
+		{
+			MethodName: "CreateView",
+			Handler:    _SavedViewService_CreateView_Handler,
+		},
+		{
+			MethodName: "UpdateView",
+			Handler:    _SavedViewService_UpdateView_Handler,
+		},
+		{
+			MethodName: "DeleteView",
+			Handler:    _SavedViewService_DeleteView_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	SearchService_Search_FullMethodName = "/dig_inv.SearchService/Search"
+)
+
+// SearchServiceClient is the client API for SearchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SearchServiceClient interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
+}
+
+type searchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
+	return &searchServiceClient{cc}
+}
+
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, SearchService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceServer is the server API for SearchService service.
+// All implementations must embed UnimplementedSearchServiceServer
+// for forward compatibility.
+type SearchServiceServer interface {
+	Search(context.Context, *SearchRequest) (*SearchResults, error)
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+// UnimplementedSearchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSearchServiceServer struct{}
+
+func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
+func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSearchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SearchServiceServer will
+// result in compilation errors.
+type UnsafeSearchServiceServer interface {
+	mustEmbedUnimplementedSearchServiceServer()
+}
+
+func RegisterSearchServiceServer(s grpc.ServiceRegistrar, srv SearchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSearchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SearchService_ServiceDesc, srv)
+}
+
+func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SearchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.SearchService",
+	HandlerType: (*SearchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchService_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	ExportService_CreateExport_FullMethodName = "/dig_inv.ExportService/CreateExport"
+	ExportService_GetExport_FullMethodName    = "/dig_inv.ExportService/GetExport"
+)
+
+// ExportServiceClient is the client API for ExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// small exports can be downloaded directly from /exports/items, large ones run as worker jobs
+type ExportServiceClient interface {
+	CreateExport(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportJob, error)
+	GetExport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ExportJob, error)
+}
+
+type exportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExportServiceClient(cc grpc.ClientConnInterface) ExportServiceClient {
+	return &exportServiceClient{cc}
+}
+
+func (c *exportServiceClient) CreateExport(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, ExportService_CreateExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exportServiceClient) GetExport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ExportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportJob)
+	err := c.cc.Invoke(ctx, ExportService_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportServiceServer is the server API for ExportService service.
+// All implementations must embed UnimplementedExportServiceServer
+// for forward compatibility.
+//
+// small exports can be downloaded directly from /exports/items, large ones run as worker jobs
+type ExportServiceServer interface {
+	CreateExport(context.Context, *ExportRequest) (*ExportJob, error)
+	GetExport(context.Context, *ElementId) (*ExportJob, error)
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+// UnimplementedExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExportServiceServer struct{}
+
+func (UnimplementedExportServiceServer) CreateExport(context.Context, *ExportRequest) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExport not implemented")
+}
+func (UnimplementedExportServiceServer) GetExport(context.Context, *ElementId) (*ExportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedExportServiceServer) mustEmbedUnimplementedExportServiceServer() {}
+func (UnimplementedExportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExportServiceServer will
+// result in compilation errors.
+type UnsafeExportServiceServer interface {
+	mustEmbedUnimplementedExportServiceServer()
+}
+
+func RegisterExportServiceServer(s grpc.ServiceRegistrar, srv ExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExportService_ServiceDesc, srv)
+}
+
+func _ExportService_CreateExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).CreateExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_CreateExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).CreateExport(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExportService_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExportServiceServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExportService_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExportServiceServer).GetExport(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExportService_ServiceDesc is the grpc.ServiceDesc for ExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.ExportService",
+	HandlerType: (*ExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExport",
+			Handler:    _ExportService_CreateExport_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _ExportService_GetExport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	ScheduleService_GetSchedule_FullMethodName    = "/dig_inv.ScheduleService/GetSchedule"
+	ScheduleService_GetSchedules_FullMethodName   = "/dig_inv.ScheduleService/GetSchedules"
+	ScheduleService_CreateSchedule_FullMethodName = "/dig_inv.ScheduleService/CreateSchedule"
+	ScheduleService_UpdateSchedule_FullMethodName = "/dig_inv.ScheduleService/UpdateSchedule"
+	ScheduleService_DeleteSchedule_FullMethodName = "/dig_inv.ScheduleService/DeleteSchedule"
+	ScheduleService_PauseSchedule_FullMethodName  = "/dig_inv.ScheduleService/PauseSchedule"
+	ScheduleService_ResumeSchedule_FullMethodName = "/dig_inv.ScheduleService/ResumeSchedule"
+	ScheduleService_RunSchedule_FullMethodName    = "/dig_inv.ScheduleService/RunSchedule"
+)
+
+// ScheduleServiceClient is the client API for ScheduleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// schedules queue job runs periodically, they are executed by the workers
+type ScheduleServiceClient interface {
+	GetSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error)
+	GetSchedules(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Schedules, error)
+	CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	UpdateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	DeleteSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	PauseSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error)
+	ResumeSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error)
+	// queues a run immediately, independent of the cron expression and whether the schedule is paused
+	RunSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ScheduledRun, error)
+}
+
+type scheduleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleServiceClient(cc grpc.ClientConnInterface) ScheduleServiceClient {
+	return &scheduleServiceClient{cc}
+}
+
+func (c *scheduleServiceClient) GetSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetSchedules(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Schedules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedules)
+	err := c.cc.Invoke(ctx, ScheduleService_GetSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) UpdateSchedule(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_UpdateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeleteSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, ScheduleService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) PauseSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_PauseSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ResumeSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_ResumeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) RunSchedule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*ScheduledRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledRun)
+	err := c.cc.Invoke(ctx, ScheduleService_RunSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleServiceServer is the server API for ScheduleService service.
+// All implementations must embed UnimplementedScheduleServiceServer
+// for forward compatibility.
+//
+// schedules queue job runs periodically, they are executed by the workers
+type ScheduleServiceServer interface {
+	GetSchedule(context.Context, *ElementId) (*Schedule, error)
+	GetSchedules(context.Context, *EmptyMessage) (*Schedules, error)
+	CreateSchedule(context.Context, *Schedule) (*Schedule, error)
+	UpdateSchedule(context.Context, *Schedule) (*Schedule, error)
+	DeleteSchedule(context.Context, *ElementId) (*EmptyMessage, error)
+	PauseSchedule(context.Context, *ElementId) (*Schedule, error)
+	ResumeSchedule(context.Context, *ElementId) (*Schedule, error)
+	// queues a run immediately, independent of the cron expression and whether the schedule is paused
+	RunSchedule(context.Context, *ElementId) (*ScheduledRun, error)
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+// UnimplementedScheduleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScheduleServiceServer struct{}
+
+func (UnimplementedScheduleServiceServer) GetSchedule(context.Context, *ElementId) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetSchedules(context.Context, *EmptyMessage) (*Schedules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) UpdateSchedule(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeleteSchedule(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) PauseSchedule(context.Context, *ElementId) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) ResumeSchedule(context.Context, *ElementId) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) RunSchedule(context.Context, *ElementId) (*ScheduledRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) mustEmbedUnimplementedScheduleServiceServer() {}
+func (UnimplementedScheduleServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleServiceServer will
+// result in compilation errors.
+type UnsafeScheduleServiceServer interface {
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+func RegisterScheduleServiceServer(s grpc.ServiceRegistrar, srv ScheduleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedScheduleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScheduleService_ServiceDesc, srv)
+}
+
+func _ScheduleService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetSchedule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetSchedules(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_UpdateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).UpdateSchedule(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeleteSchedule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_PauseSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).PauseSchedule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ResumeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ResumeSchedule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_RunSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).RunSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_RunSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).RunSchedule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.ScheduleService",
+	HandlerType: (*ScheduleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedule",
+			Handler:    _ScheduleService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _ScheduleService_GetSchedules_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _ScheduleService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _ScheduleService_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _ScheduleService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _ScheduleService_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _ScheduleService_ResumeSchedule_Handler,
+		},
+		{
+			MethodName: "RunSchedule",
+			Handler:    _ScheduleService_RunSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	JobService_GetJobRuns_FullMethodName   = "/dig_inv.JobService/GetJobRuns"
+	JobService_GetJobRun_FullMethodName    = "/dig_inv.JobService/GetJobRun"
+	JobService_CancelJobRun_FullMethodName = "/dig_inv.JobService/CancelJobRun"
+	JobService_RetryJobRun_FullMethodName  = "/dig_inv.JobService/RetryJobRun"
+	JobService_WatchJobRun_FullMethodName  = "/dig_inv.JobService/WatchJobRun"
+)
+
+// JobServiceClient is the client API for JobService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type JobServiceClient interface {
+	GetJobRuns(ctx context.Context, in *JobRunFilter, opts ...grpc.CallOption) (*JobRuns, error)
+	GetJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error)
+	// queued runs are canceled immediately, running ones when their worker polls next
+	CancelJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error)
+	// queues a new run with the parameters of a failed or canceled run
+	RetryJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error)
+	// sends the run whenever it changes until it is finished; over HTTP available as newline-delimited JSON from
+	// /jobs/{id}/watch
+	WatchJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobRun], error)
+}
+
+type jobServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewJobServiceClient(cc grpc.ClientConnInterface) JobServiceClient {
+	return &jobServiceClient{cc}
+}
+
+func (c *jobServiceClient) GetJobRuns(ctx context.Context, in *JobRunFilter, opts ...grpc.CallOption) (*JobRuns, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobRuns)
+	err := c.cc.Invoke(ctx, JobService_GetJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) GetJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobRun)
+	err := c.cc.Invoke(ctx, JobService_GetJobRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) CancelJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobRun)
+	err := c.cc.Invoke(ctx, JobService_CancelJobRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) RetryJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*JobRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobRun)
+	err := c.cc.Invoke(ctx, JobService_RetryJobRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *jobServiceClient) WatchJobRun(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[JobRun], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &JobService_ServiceDesc.Streams[0], JobService_WatchJobRun_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ElementId, JobRun]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_WatchJobRunClient = grpc.ServerStreamingClient[JobRun]
+
+// JobServiceServer is the server API for JobService service.
+// All implementations must embed UnimplementedJobServiceServer
+// for forward compatibility.
+type JobServiceServer interface {
+	GetJobRuns(context.Context, *JobRunFilter) (*JobRuns, error)
+	GetJobRun(context.Context, *ElementId) (*JobRun, error)
+	// queued runs are canceled immediately, running ones when their worker polls next
+	CancelJobRun(context.Context, *ElementId) (*JobRun, error)
+	// queues a new run with the parameters of a failed or canceled run
+	RetryJobRun(context.Context, *ElementId) (*JobRun, error)
+	// sends the run whenever it changes until it is finished; over HTTP available as newline-delimited JSON from
+	// /jobs/{id}/watch
+	WatchJobRun(*ElementId, grpc.ServerStreamingServer[JobRun]) error
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+// UnimplementedJobServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedJobServiceServer struct{}
+
+func (UnimplementedJobServiceServer) GetJobRuns(context.Context, *JobRunFilter) (*JobRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRuns not implemented")
+}
+func (UnimplementedJobServiceServer) GetJobRun(context.Context, *ElementId) (*JobRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobRun not implemented")
+}
+func (UnimplementedJobServiceServer) CancelJobRun(context.Context, *ElementId) (*JobRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJobRun not implemented")
+}
+func (UnimplementedJobServiceServer) RetryJobRun(context.Context, *ElementId) (*JobRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJobRun not implemented")
+}
+func (UnimplementedJobServiceServer) WatchJobRun(*ElementId, grpc.ServerStreamingServer[JobRun]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobRun not implemented")
+}
+func (UnimplementedJobServiceServer) mustEmbedUnimplementedJobServiceServer() {}
+func (UnimplementedJobServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeJobServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to JobServiceServer will
+// result in compilation errors.
+type UnsafeJobServiceServer interface {
+	mustEmbedUnimplementedJobServiceServer()
+}
+
+func RegisterJobServiceServer(s grpc.ServiceRegistrar, srv JobServiceServer) {
+	// If the following call pancis, it indicates UnimplementedJobServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&JobService_ServiceDesc, srv)
+}
+
+func _JobService_GetJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRunFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobRuns(ctx, req.(*JobRunFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_GetJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).GetJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_GetJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).GetJobRun(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_CancelJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).CancelJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_CancelJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).CancelJobRun(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_RetryJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JobServiceServer).RetryJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JobService_RetryJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JobServiceServer).RetryJobRun(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _JobService_WatchJobRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ElementId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(JobServiceServer).WatchJobRun(m, &grpc.GenericServerStream[ElementId, JobRun]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type JobService_WatchJobRunServer = grpc.ServerStreamingServer[JobRun]
+
+// JobService_ServiceDesc is the grpc.ServiceDesc for JobService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var JobService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.JobService",
+	HandlerType: (*JobServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetJobRuns",
+			Handler:    _JobService_GetJobRuns_Handler,
+		},
+		{
+			MethodName: "GetJobRun",
+			Handler:    _JobService_GetJobRun_Handler,
+		},
+		{
+			MethodName: "CancelJobRun",
+			Handler:    _JobService_CancelJobRun_Handler,
+		},
+		{
+			MethodName: "RetryJobRun",
+			Handler:    _JobService_RetryJobRun_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchJobRun",
+			Handler:       _JobService_WatchJobRun_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "backend.proto",
+}
+
+const (
+	NotificationService_GetChannels_FullMethodName        = "/dig_inv.NotificationService/GetChannels"
+	NotificationService_CreateChannel_FullMethodName      = "/dig_inv.NotificationService/CreateChannel"
+	NotificationService_UpdateChannel_FullMethodName      = "/dig_inv.NotificationService/UpdateChannel"
+	NotificationService_DeleteChannel_FullMethodName      = "/dig_inv.NotificationService/DeleteChannel"
+	NotificationService_TestChannel_FullMethodName        = "/dig_inv.NotificationService/TestChannel"
+	NotificationService_GetReminderRules_FullMethodName   = "/dig_inv.NotificationService/GetReminderRules"
+	NotificationService_CreateReminderRule_FullMethodName = "/dig_inv.NotificationService/CreateReminderRule"
+	NotificationService_UpdateReminderRule_FullMethodName = "/dig_inv.NotificationService/UpdateReminderRule"
+	NotificationService_DeleteReminderRule_FullMethodName = "/dig_inv.NotificationService/DeleteReminderRule"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// reminders are sent by the expiry_notifications job, which is usually run by a daily schedule
+type NotificationServiceClient interface {
+	GetChannels(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*NotificationChannels, error)
+	CreateChannel(ctx context.Context, in *NotificationChannel, opts ...grpc.CallOption) (*NotificationChannel, error)
+	UpdateChannel(ctx context.Context, in *NotificationChannel, opts ...grpc.CallOption) (*NotificationChannel, error)
+	DeleteChannel(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	// sends a test message through the channel
+	TestChannel(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetReminderRules(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ReminderRules, error)
+	CreateReminderRule(ctx context.Context, in *ReminderRule, opts ...grpc.CallOption) (*ReminderRule, error)
+	UpdateReminderRule(ctx context.Context, in *ReminderRule, opts ...grpc.CallOption) (*ReminderRule, error)
+	DeleteReminderRule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) GetChannels(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*NotificationChannels, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationChannels)
+	err := c.cc.Invoke(ctx, NotificationService_GetChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateChannel(ctx context.Context, in *NotificationChannel, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, NotificationService_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateChannel(ctx context.Context, in *NotificationChannel, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteChannel(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) TestChannel(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, NotificationService_TestChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetReminderRules(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ReminderRules, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderRules)
+	err := c.cc.Invoke(ctx, NotificationService_GetReminderRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateReminderRule(ctx context.Context, in *ReminderRule, opts ...grpc.CallOption) (*ReminderRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderRule)
+	err := c.cc.Invoke(ctx, NotificationService_CreateReminderRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdateReminderRule(ctx context.Context, in *ReminderRule, opts ...grpc.CallOption) (*ReminderRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReminderRule)
+	err := c.cc.Invoke(ctx, NotificationService_UpdateReminderRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteReminderRule(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteReminderRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+//
+// reminders are sent by the expiry_notifications job, which is usually run by a daily schedule
+type NotificationServiceServer interface {
+	GetChannels(context.Context, *EmptyMessage) (*NotificationChannels, error)
+	CreateChannel(context.Context, *NotificationChannel) (*NotificationChannel, error)
+	UpdateChannel(context.Context, *NotificationChannel) (*NotificationChannel, error)
+	DeleteChannel(context.Context, *ElementId) (*EmptyMessage, error)
+	// sends a test message through the channel
+	TestChannel(context.Context, *ElementId) (*EmptyMessage, error)
+	GetReminderRules(context.Context, *EmptyMessage) (*ReminderRules, error)
+	CreateReminderRule(context.Context, *ReminderRule) (*ReminderRule, error)
+	UpdateReminderRule(context.Context, *ReminderRule) (*ReminderRule, error)
+	DeleteReminderRule(context.Context, *ElementId) (*EmptyMessage, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) GetChannels(context.Context, *EmptyMessage) (*NotificationChannels, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannels not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateChannel(context.Context, *NotificationChannel) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateChannel(context.Context, *NotificationChannel) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteChannel(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) TestChannel(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestChannel not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetReminderRules(context.Context, *EmptyMessage) (*ReminderRules, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReminderRules not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateReminderRule(context.Context, *ReminderRule) (*ReminderRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReminderRule not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdateReminderRule(context.Context, *ReminderRule) (*ReminderRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReminderRule not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteReminderRule(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReminderRule not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_GetChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetChannels(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateChannel(ctx, req.(*NotificationChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateChannel(ctx, req.(*NotificationChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteChannel(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_TestChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).TestChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_TestChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).TestChannel(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetReminderRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetReminderRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetReminderRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetReminderRules(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateReminderRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReminderRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateReminderRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_CreateReminderRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateReminderRule(ctx, req.(*ReminderRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdateReminderRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReminderRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateReminderRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_UpdateReminderRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateReminderRule(ctx, req.(*ReminderRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteReminderRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteReminderRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteReminderRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteReminderRule(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetChannels",
+			Handler:    _NotificationService_GetChannels_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _NotificationService_CreateChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _NotificationService_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _NotificationService_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "TestChannel",
+			Handler:    _NotificationService_TestChannel_Handler,
+		},
+		{
+			MethodName: "GetReminderRules",
+			Handler:    _NotificationService_GetReminderRules_Handler,
+		},
+		{
+			MethodName: "CreateReminderRule",
+			Handler:    _NotificationService_CreateReminderRule_Handler,
+		},
+		{
+			MethodName: "UpdateReminderRule",
+			Handler:    _NotificationService_UpdateReminderRule_Handler,
+		},
+		{
+			MethodName: "DeleteReminderRule",
+			Handler:    _NotificationService_DeleteReminderRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	WebhookService_GetWebhooks_FullMethodName          = "/dig_inv.WebhookService/GetWebhooks"
+	WebhookService_CreateWebhook_FullMethodName        = "/dig_inv.WebhookService/CreateWebhook"
+	WebhookService_UpdateWebhook_FullMethodName        = "/dig_inv.WebhookService/UpdateWebhook"
+	WebhookService_DeleteWebhook_FullMethodName        = "/dig_inv.WebhookService/DeleteWebhook"
+	WebhookService_GetWebhookDeliveries_FullMethodName = "/dig_inv.WebhookService/GetWebhookDeliveries"
+	WebhookService_RedeliverWebhook_FullMethodName     = "/dig_inv.WebhookService/RedeliverWebhook"
+)
+
+// WebhookServiceClient is the client API for WebhookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// events are sent by the webhook_delivery jobs, which are queued for every matching change
+type WebhookServiceClient interface {
+	GetWebhooks(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Webhooks, error)
+	CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveryFilter, opts ...grpc.CallOption) (*WebhookDeliveries, error)
+	// queues another attempt of a delivery, e.g. after the webhook failed for longer than the automatic retries
+	RedeliverWebhook(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*WebhookDelivery, error)
+}
+
+type webhookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookServiceClient(cc grpc.ClientConnInterface) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) GetWebhooks(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Webhooks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhooks)
+	err := c.cc.Invoke(ctx, WebhookService_GetWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) CreateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, WebhookService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) UpdateWebhook(ctx context.Context, in *Webhook, opts ...grpc.CallOption) (*Webhook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Webhook)
+	err := c.cc.Invoke(ctx, WebhookService_UpdateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhook(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, WebhookService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetWebhookDeliveries(ctx context.Context, in *WebhookDeliveryFilter, opts ...grpc.CallOption) (*WebhookDeliveries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDeliveries)
+	err := c.cc.Invoke(ctx, WebhookService_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) RedeliverWebhook(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, WebhookService_RedeliverWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookServiceServer is the server API for WebhookService service.
+// All implementations must embed UnimplementedWebhookServiceServer
+// for forward compatibility.
+//
+// events are sent by the webhook_delivery jobs, which are queued for every matching change
+type WebhookServiceServer interface {
+	GetWebhooks(context.Context, *EmptyMessage) (*Webhooks, error)
+	CreateWebhook(context.Context, *Webhook) (*Webhook, error)
+	UpdateWebhook(context.Context, *Webhook) (*Webhook, error)
+	DeleteWebhook(context.Context, *ElementId) (*EmptyMessage, error)
+	GetWebhookDeliveries(context.Context, *WebhookDeliveryFilter) (*WebhookDeliveries, error)
+	// queues another attempt of a delivery, e.g. after the webhook failed for longer than the automatic retries
+	RedeliverWebhook(context.Context, *ElementId) (*WebhookDelivery, error)
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+// UnimplementedWebhookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWebhookServiceServer struct{}
+
+func (UnimplementedWebhookServiceServer) GetWebhooks(context.Context, *EmptyMessage) (*Webhooks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) CreateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) UpdateWebhook(context.Context, *Webhook) (*Webhook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhook(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetWebhookDeliveries(context.Context, *WebhookDeliveryFilter) (*WebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedWebhookServiceServer) RedeliverWebhook(context.Context, *ElementId) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
+func (UnimplementedWebhookServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeWebhookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookServiceServer will
+// result in compilation errors.
+type UnsafeWebhookServiceServer interface {
+	mustEmbedUnimplementedWebhookServiceServer()
+}
+
+func RegisterWebhookServiceServer(s grpc.ServiceRegistrar, srv WebhookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWebhookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WebhookService_ServiceDesc, srv)
+}
+
+func _WebhookService_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhooks(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Webhook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_UpdateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).UpdateWebhook(ctx, req.(*Webhook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhook(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookDeliveryFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhookDeliveries(ctx, req.(*WebhookDeliveryFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_RedeliverWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RedeliverWebhook(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWebhooks",
+			Handler:    _WebhookService_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _WebhookService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "UpdateWebhook",
+			Handler:    _WebhookService_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _WebhookService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _WebhookService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _WebhookService_RedeliverWebhook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	DomainService_GetDomainLookups_FullMethodName = "/dig_inv.DomainService/GetDomainLookups"
+	DomainService_GetDnsChecks_FullMethodName     = "/dig_inv.DomainService/GetDnsChecks"
+)
+
+// DomainServiceClient is the client API for DomainService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// lookups are made by the domain_lookup job, which updates the registrar, expiry date, status and name servers of
+// the domain items; checks are made by the dns_check job, which resolves the recorded DNS records
+type DomainServiceClient interface {
+	// returns the lookups of a domain item, newest first
+	GetDomainLookups(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DomainLookups, error)
+	// returns the checks of a DNS record item, newest first
+	GetDnsChecks(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DnsChecks, error)
+}
+
+type domainServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDomainServiceClient(cc grpc.ClientConnInterface) DomainServiceClient {
+	return &domainServiceClient{cc}
+}
+
+func (c *domainServiceClient) GetDomainLookups(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DomainLookups, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DomainLookups)
+	err := c.cc.Invoke(ctx, DomainService_GetDomainLookups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *domainServiceClient) GetDnsChecks(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DnsChecks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DnsChecks)
+	err := c.cc.Invoke(ctx, DomainService_GetDnsChecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DomainServiceServer is the server API for DomainService service.
+// All implementations must embed UnimplementedDomainServiceServer
+// for forward compatibility.
+//
+// lookups are made by the domain_lookup job, which updates the registrar, expiry date, status and name servers of
+// the domain items; checks are made by the dns_check job, which resolves the recorded DNS records
+type DomainServiceServer interface {
+	// returns the lookups of a domain item, newest first
+	GetDomainLookups(context.Context, *ElementId) (*DomainLookups, error)
+	// returns the checks of a DNS record item, newest first
+	GetDnsChecks(context.Context, *ElementId) (*DnsChecks, error)
+	mustEmbedUnimplementedDomainServiceServer()
+}
+
+// UnimplementedDomainServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDomainServiceServer struct{}
+
+func (UnimplementedDomainServiceServer) GetDomainLookups(context.Context, *ElementId) (*DomainLookups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDomainLookups not implemented")
+}
+func (UnimplementedDomainServiceServer) GetDnsChecks(context.Context, *ElementId) (*DnsChecks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDnsChecks not implemented")
+}
+func (UnimplementedDomainServiceServer) mustEmbedUnimplementedDomainServiceServer() {}
+func (UnimplementedDomainServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeDomainServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DomainServiceServer will
+// result in compilation errors.
+type UnsafeDomainServiceServer interface {
+	mustEmbedUnimplementedDomainServiceServer()
+}
+
+func RegisterDomainServiceServer(s grpc.ServiceRegistrar, srv DomainServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDomainServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DomainService_ServiceDesc, srv)
+}
+
+func _DomainService_GetDomainLookups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).GetDomainLookups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_GetDomainLookups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).GetDomainLookups(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DomainService_GetDnsChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DomainServiceServer).GetDnsChecks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DomainService_GetDnsChecks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DomainServiceServer).GetDnsChecks(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DomainService_ServiceDesc is the grpc.ServiceDesc for DomainService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DomainService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.DomainService",
+	HandlerType: (*DomainServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDomainLookups",
+			Handler:    _DomainService_GetDomainLookups_Handler,
+		},
+		{
+			MethodName: "GetDnsChecks",
+			Handler:    _DomainService_GetDnsChecks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	ProbeService_GetProbes_FullMethodName       = "/dig_inv.ProbeService/GetProbes"
+	ProbeService_CreateProbe_FullMethodName     = "/dig_inv.ProbeService/CreateProbe"
+	ProbeService_UpdateProbe_FullMethodName     = "/dig_inv.ProbeService/UpdateProbe"
+	ProbeService_DeleteProbe_FullMethodName     = "/dig_inv.ProbeService/DeleteProbe"
+	ProbeService_GetProbeResults_FullMethodName = "/dig_inv.ProbeService/GetProbeResults"
+)
+
+// ProbeServiceClient is the client API for ProbeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// probes are run by the reachability_probe job, usually from a schedule every few minutes; the up or down state and
+// last seen time of the probed items are returned with the items
+type ProbeServiceClient interface {
+	GetProbes(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Probes, error)
+	CreateProbe(ctx context.Context, in *Probe, opts ...grpc.CallOption) (*Probe, error)
+	UpdateProbe(ctx context.Context, in *Probe, opts ...grpc.CallOption) (*Probe, error)
+	DeleteProbe(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetProbeResults(ctx context.Context, in *ProbeResultFilter, opts ...grpc.CallOption) (*ProbeResults, error)
+}
+
+type probeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProbeServiceClient(cc grpc.ClientConnInterface) ProbeServiceClient {
+	return &probeServiceClient{cc}
+}
+
+func (c *probeServiceClient) GetProbes(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Probes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Probes)
+	err := c.cc.Invoke(ctx, ProbeService_GetProbes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *probeServiceClient) CreateProbe(ctx context.Context, in *Probe, opts ...grpc.CallOption) (*Probe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Probe)
+	err := c.cc.Invoke(ctx, ProbeService_CreateProbe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *probeServiceClient) UpdateProbe(ctx context.Context, in *Probe, opts ...grpc.CallOption) (*Probe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Probe)
+	err := c.cc.Invoke(ctx, ProbeService_UpdateProbe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *probeServiceClient) DeleteProbe(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, ProbeService_DeleteProbe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *probeServiceClient) GetProbeResults(ctx context.Context, in *ProbeResultFilter, opts ...grpc.CallOption) (*ProbeResults, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProbeResults)
+	err := c.cc.Invoke(ctx, ProbeService_GetProbeResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProbeServiceServer is the server API for ProbeService service.
+// All implementations must embed UnimplementedProbeServiceServer
+// for forward compatibility.
+//
+// probes are run by the reachability_probe job, usually from a schedule every few minutes; the up or down state and
+// last seen time of the probed items are returned with the items
+type ProbeServiceServer interface {
+	GetProbes(context.Context, *EmptyMessage) (*Probes, error)
+	CreateProbe(context.Context, *Probe) (*Probe, error)
+	UpdateProbe(context.Context, *Probe) (*Probe, error)
+	DeleteProbe(context.Context, *ElementId) (*EmptyMessage, error)
+	GetProbeResults(context.Context, *ProbeResultFilter) (*ProbeResults, error)
+	mustEmbedUnimplementedProbeServiceServer()
+}
+
+// UnimplementedProbeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProbeServiceServer struct{}
+
+func (UnimplementedProbeServiceServer) GetProbes(context.Context, *EmptyMessage) (*Probes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProbes not implemented")
+}
+func (UnimplementedProbeServiceServer) CreateProbe(context.Context, *Probe) (*Probe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProbe not implemented")
+}
+func (UnimplementedProbeServiceServer) UpdateProbe(context.Context, *Probe) (*Probe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProbe not implemented")
+}
+func (UnimplementedProbeServiceServer) DeleteProbe(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProbe not implemented")
+}
+func (UnimplementedProbeServiceServer) GetProbeResults(context.Context, *ProbeResultFilter) (*ProbeResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProbeResults not implemented")
+}
+func (UnimplementedProbeServiceServer) mustEmbedUnimplementedProbeServiceServer() {}
+func (UnimplementedProbeServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeProbeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProbeServiceServer will
+// result in compilation errors.
+type UnsafeProbeServiceServer interface {
+	mustEmbedUnimplementedProbeServiceServer()
+}
+
+func RegisterProbeServiceServer(s grpc.ServiceRegistrar, srv ProbeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProbeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProbeService_ServiceDesc, srv)
+}
+
+func _ProbeService_GetProbes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProbeServiceServer).GetProbes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProbeService_GetProbes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProbeServiceServer).GetProbes(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProbeService_CreateProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Probe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProbeServiceServer).CreateProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProbeService_CreateProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProbeServiceServer).CreateProbe(ctx, req.(*Probe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProbeService_UpdateProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Probe)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProbeServiceServer).UpdateProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProbeService_UpdateProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProbeServiceServer).UpdateProbe(ctx, req.(*Probe))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProbeService_DeleteProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProbeServiceServer).DeleteProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProbeService_DeleteProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProbeServiceServer).DeleteProbe(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProbeService_GetProbeResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeResultFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProbeServiceServer).GetProbeResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProbeService_GetProbeResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProbeServiceServer).GetProbeResults(ctx, req.(*ProbeResultFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProbeService_ServiceDesc is the grpc.ServiceDesc for ProbeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProbeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.ProbeService",
+	HandlerType: (*ProbeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProbes",
+			Handler:    _ProbeService_GetProbes_Handler,
+		},
+		{
+			MethodName: "CreateProbe",
+			Handler:    _ProbeService_CreateProbe_Handler,
+		},
+		{
+			MethodName: "UpdateProbe",
+			Handler:    _ProbeService_UpdateProbe_Handler,
+		},
+		{
+			MethodName: "DeleteProbe",
+			Handler:    _ProbeService_DeleteProbe_Handler,
+		},
+		{
+			MethodName: "GetProbeResults",
+			Handler:    _ProbeService_GetProbeResults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	DriftService_GetDriftReports_FullMethodName    = "/dig_inv.DriftService/GetDriftReports"
+	DriftService_ApproveDriftReport_FullMethodName = "/dig_inv.DriftService/ApproveDriftReport"
+	DriftService_RejectDriftReport_FullMethodName  = "/dig_inv.DriftService/RejectDriftReport"
+)
+
+// DriftServiceClient is the client API for DriftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// provider syncs store the new, changed and vanished items they find as drift reports; reports of asset classes with
+// the approve drift policy are only applied once they are approved
+type DriftServiceClient interface {
+	GetDriftReports(ctx context.Context, in *DriftReportFilter, opts ...grpc.CallOption) (*DriftReports, error)
+	ApproveDriftReport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DriftReport, error)
+	RejectDriftReport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DriftReport, error)
+}
+
+type driftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDriftServiceClient(cc grpc.ClientConnInterface) DriftServiceClient {
+	return &driftServiceClient{cc}
+}
+
+func (c *driftServiceClient) GetDriftReports(ctx context.Context, in *DriftReportFilter, opts ...grpc.CallOption) (*DriftReports, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriftReports)
+	err := c.cc.Invoke(ctx, DriftService_GetDriftReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driftServiceClient) ApproveDriftReport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DriftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriftReport)
+	err := c.cc.Invoke(ctx, DriftService_ApproveDriftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driftServiceClient) RejectDriftReport(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*DriftReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriftReport)
+	err := c.cc.Invoke(ctx, DriftService_RejectDriftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DriftServiceServer is the server API for DriftService service.
+// All implementations must embed UnimplementedDriftServiceServer
+// for forward compatibility.
+//
+// provider syncs store the new, changed and vanished items they find as drift reports; reports of asset classes with
+// the approve drift policy are only applied once they are approved
+type DriftServiceServer interface {
+	GetDriftReports(context.Context, *DriftReportFilter) (*DriftReports, error)
+	ApproveDriftReport(context.Context, *ElementId) (*DriftReport, error)
+	RejectDriftReport(context.Context, *ElementId) (*DriftReport, error)
+	mustEmbedUnimplementedDriftServiceServer()
+}
+
+// UnimplementedDriftServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDriftServiceServer struct{}
+
+func (UnimplementedDriftServiceServer) GetDriftReports(context.Context, *DriftReportFilter) (*DriftReports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriftReports not implemented")
+}
+func (UnimplementedDriftServiceServer) ApproveDriftReport(context.Context, *ElementId) (*DriftReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDriftReport not implemented")
+}
+func (UnimplementedDriftServiceServer) RejectDriftReport(context.Context, *ElementId) (*DriftReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectDriftReport not implemented")
+}
+func (UnimplementedDriftServiceServer) mustEmbedUnimplementedDriftServiceServer() {}
+func (UnimplementedDriftServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeDriftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DriftServiceServer will
+// result in compilation errors.
+type UnsafeDriftServiceServer interface {
+	mustEmbedUnimplementedDriftServiceServer()
+}
+
+func RegisterDriftServiceServer(s grpc.ServiceRegistrar, srv DriftServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDriftServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DriftService_ServiceDesc, srv)
+}
+
+func _DriftService_GetDriftReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriftReportFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriftServiceServer).GetDriftReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriftService_GetDriftReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriftServiceServer).GetDriftReports(ctx, req.(*DriftReportFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriftService_ApproveDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriftServiceServer).ApproveDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriftService_ApproveDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriftServiceServer).ApproveDriftReport(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriftService_RejectDriftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriftServiceServer).RejectDriftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriftService_RejectDriftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriftServiceServer).RejectDriftReport(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DriftService_ServiceDesc is the grpc.ServiceDesc for DriftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DriftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.DriftService",
+	HandlerType: (*DriftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDriftReports",
+			Handler:    _DriftService_GetDriftReports_Handler,
+		},
+		{
+			MethodName: "ApproveDriftReport",
+			Handler:    _DriftService_ApproveDriftReport_Handler,
+		},
+		{
+			MethodName: "RejectDriftReport",
+			Handler:    _DriftService_RejectDriftReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	ProviderAccountService_GetProviderAccounts_FullMethodName   = "/dig_inv.ProviderAccountService/GetProviderAccounts"
+	ProviderAccountService_CreateProviderAccount_FullMethodName = "/dig_inv.ProviderAccountService/CreateProviderAccount"
+	ProviderAccountService_UpdateProviderAccount_FullMethodName = "/dig_inv.ProviderAccountService/UpdateProviderAccount"
+	ProviderAccountService_RotateProviderAccount_FullMethodName = "/dig_inv.ProviderAccountService/RotateProviderAccount"
+	ProviderAccountService_DeleteProviderAccount_FullMethodName = "/dig_inv.ProviderAccountService/DeleteProviderAccount"
+)
+
+// ProviderAccountServiceClient is the client API for ProviderAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// provider accounts hold the credentials of provider integrations, their secrets are encrypted with the master key
+// and can only be written
+type ProviderAccountServiceClient interface {
+	GetProviderAccounts(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ProviderAccounts, error)
+	CreateProviderAccount(ctx context.Context, in *ProviderAccount, opts ...grpc.CallOption) (*ProviderAccount, error)
+	// changes the name and config, secrets are only changed by rotating them
+	UpdateProviderAccount(ctx context.Context, in *ProviderAccount, opts ...grpc.CallOption) (*ProviderAccount, error)
+	RotateProviderAccount(ctx context.Context, in *RotateProviderAccountRequest, opts ...grpc.CallOption) (*ProviderAccount, error)
+	DeleteProviderAccount(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type providerAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProviderAccountServiceClient(cc grpc.ClientConnInterface) ProviderAccountServiceClient {
+	return &providerAccountServiceClient{cc}
+}
+
+func (c *providerAccountServiceClient) GetProviderAccounts(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*ProviderAccounts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAccounts)
+	err := c.cc.Invoke(ctx, ProviderAccountService_GetProviderAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAccountServiceClient) CreateProviderAccount(ctx context.Context, in *ProviderAccount, opts ...grpc.CallOption) (*ProviderAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAccount)
+	err := c.cc.Invoke(ctx, ProviderAccountService_CreateProviderAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAccountServiceClient) UpdateProviderAccount(ctx context.Context, in *ProviderAccount, opts ...grpc.CallOption) (*ProviderAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAccount)
+	err := c.cc.Invoke(ctx, ProviderAccountService_UpdateProviderAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAccountServiceClient) RotateProviderAccount(ctx context.Context, in *RotateProviderAccountRequest, opts ...grpc.CallOption) (*ProviderAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProviderAccount)
+	err := c.cc.Invoke(ctx, ProviderAccountService_RotateProviderAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerAccountServiceClient) DeleteProviderAccount(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, ProviderAccountService_DeleteProviderAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProviderAccountServiceServer is the server API for ProviderAccountService service.
+// All implementations must embed UnimplementedProviderAccountServiceServer
+// for forward compatibility.
+//
+// provider accounts hold the credentials of provider integrations, their secrets are encrypted with the master key
+// and can only be written
+type ProviderAccountServiceServer interface {
+	GetProviderAccounts(context.Context, *EmptyMessage) (*ProviderAccounts, error)
+	CreateProviderAccount(context.Context, *ProviderAccount) (*ProviderAccount, error)
+	// changes the name and config, secrets are only changed by rotating them
+	UpdateProviderAccount(context.Context, *ProviderAccount) (*ProviderAccount, error)
+	RotateProviderAccount(context.Context, *RotateProviderAccountRequest) (*ProviderAccount, error)
+	DeleteProviderAccount(context.Context, *ElementId) (*EmptyMessage, error)
+	mustEmbedUnimplementedProviderAccountServiceServer()
+}
+
+// UnimplementedProviderAccountServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProviderAccountServiceServer struct{}
+
+func (UnimplementedProviderAccountServiceServer) GetProviderAccounts(context.Context, *EmptyMessage) (*ProviderAccounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderAccounts not implemented")
+}
+func (UnimplementedProviderAccountServiceServer) CreateProviderAccount(context.Context, *ProviderAccount) (*ProviderAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProviderAccount not implemented")
+}
+func (UnimplementedProviderAccountServiceServer) UpdateProviderAccount(context.Context, *ProviderAccount) (*ProviderAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProviderAccount not implemented")
+}
+func (UnimplementedProviderAccountServiceServer) RotateProviderAccount(context.Context, *RotateProviderAccountRequest) (*ProviderAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateProviderAccount not implemented")
+}
+func (UnimplementedProviderAccountServiceServer) DeleteProviderAccount(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProviderAccount not implemented")
+}
+func (UnimplementedProviderAccountServiceServer) mustEmbedUnimplementedProviderAccountServiceServer() {
+}
+func (UnimplementedProviderAccountServiceServer) testEmbeddedByValue() {}
+
+// UnsafeProviderAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProviderAccountServiceServer will
+// result in compilation errors.
+type UnsafeProviderAccountServiceServer interface {
+	mustEmbedUnimplementedProviderAccountServiceServer()
+}
+
+func RegisterProviderAccountServiceServer(s grpc.ServiceRegistrar, srv ProviderAccountServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProviderAccountServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProviderAccountService_ServiceDesc, srv)
+}
+
+func _ProviderAccountService_GetProviderAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAccountServiceServer).GetProviderAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAccountService_GetProviderAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAccountServiceServer).GetProviderAccounts(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAccountService_CreateProviderAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAccountServiceServer).CreateProviderAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAccountService_CreateProviderAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAccountServiceServer).CreateProviderAccount(ctx, req.(*ProviderAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAccountService_UpdateProviderAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAccountServiceServer).UpdateProviderAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAccountService_UpdateProviderAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAccountServiceServer).UpdateProviderAccount(ctx, req.(*ProviderAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAccountService_RotateProviderAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateProviderAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAccountServiceServer).RotateProviderAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAccountService_RotateProviderAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAccountServiceServer).RotateProviderAccount(ctx, req.(*RotateProviderAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProviderAccountService_DeleteProviderAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderAccountServiceServer).DeleteProviderAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProviderAccountService_DeleteProviderAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderAccountServiceServer).DeleteProviderAccount(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProviderAccountService_ServiceDesc is the grpc.ServiceDesc for ProviderAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProviderAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.ProviderAccountService",
+	HandlerType: (*ProviderAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProviderAccounts",
+			Handler:    _ProviderAccountService_GetProviderAccounts_Handler,
+		},
+		{
+			MethodName: "CreateProviderAccount",
+			Handler:    _ProviderAccountService_CreateProviderAccount_Handler,
+		},
+		{
+			MethodName: "UpdateProviderAccount",
+			Handler:    _ProviderAccountService_UpdateProviderAccount_Handler,
+		},
+		{
+			MethodName: "RotateProviderAccount",
+			Handler:    _ProviderAccountService_RotateProviderAccount_Handler,
+		},
+		{
+			MethodName: "DeleteProviderAccount",
+			Handler:    _ProviderAccountService_DeleteProviderAccount_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	CommentService_GetComments_FullMethodName   = "/dig_inv.CommentService/GetComments"
+	CommentService_GetMentions_FullMethodName   = "/dig_inv.CommentService/GetMentions"
+	CommentService_CreateComment_FullMethodName = "/dig_inv.CommentService/CreateComment"
+	CommentService_UpdateComment_FullMethodName = "/dig_inv.CommentService/UpdateComment"
+	CommentService_DeleteComment_FullMethodName = "/dig_inv.CommentService/DeleteComment"
+)
+
+// CommentServiceClient is the client API for CommentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentServiceClient interface {
+	// takes the ID of the item and returns its comments oldest first
+	GetComments(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Comments, error)
+	// returns the comments mentioning the authenticated user, newest first
+	GetMentions(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Comments, error)
+	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	// changes the body, only the author can edit a comment
+	UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type commentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentServiceClient(cc grpc.ClientConnInterface) CommentServiceClient {
+	return &commentServiceClient{cc}
+}
+
+func (c *commentServiceClient) GetComments(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Comments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comments)
+	err := c.cc.Invoke(ctx, CommentService_GetComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetMentions(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*Comments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comments)
+	err := c.cc.Invoke(ctx, CommentService_GetMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) DeleteComment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, CommentService_DeleteComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentServiceServer is the server API for CommentService service.
+// All implementations must embed UnimplementedCommentServiceServer
+// for forward compatibility.
+type CommentServiceServer interface {
+	// takes the ID of the item and returns its comments oldest first
+	GetComments(context.Context, *ElementId) (*Comments, error)
+	// returns the comments mentioning the authenticated user, newest first
+	GetMentions(context.Context, *EmptyMessage) (*Comments, error)
+	CreateComment(context.Context, *Comment) (*Comment, error)
+	// changes the body, only the author can edit a comment
+	UpdateComment(context.Context, *Comment) (*Comment, error)
+	DeleteComment(context.Context, *ElementId) (*EmptyMessage, error)
+	mustEmbedUnimplementedCommentServiceServer()
+}
+
+// UnimplementedCommentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCommentServiceServer struct{}
+
+func (UnimplementedCommentServiceServer) GetComments(context.Context, *ElementId) (*Comments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
+}
+func (UnimplementedCommentServiceServer) GetMentions(context.Context, *EmptyMessage) (*Comments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentions not implemented")
+}
+func (UnimplementedCommentServiceServer) CreateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) UpdateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
+func (UnimplementedCommentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentServiceServer will
+// result in compilation errors.
+type UnsafeCommentServiceServer interface {
+	mustEmbedUnimplementedCommentServiceServer()
+}
+
+func RegisterCommentServiceServer(s grpc.ServiceRegistrar, srv CommentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCommentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CommentService_ServiceDesc, srv)
+}
+
+func _CommentService_GetComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetComments(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetMentions(ctx, req.(*EmptyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CreateComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).UpdateComment(ctx, req.(*Comment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).DeleteComment(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.CommentService",
+	HandlerType: (*CommentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetComments",
+			Handler:    _CommentService_GetComments_Handler,
+		},
+		{
+			MethodName: "GetMentions",
+			Handler:    _CommentService_GetMentions_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _CommentService_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _CommentService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentService_DeleteComment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	CostService_GetSpendReport_FullMethodName = "/dig_inv.CostService/GetSpendReport"
+)
+
+// CostServiceClient is the client API for CostService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CostServiceClient interface {
+	// sums up the recurring cost of the visible items per month and year
+	GetSpendReport(ctx context.Context, in *SpendReportRequest, opts ...grpc.CallOption) (*SpendReport, error)
+}
+
+type costServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCostServiceClient(cc grpc.ClientConnInterface) CostServiceClient {
+	return &costServiceClient{cc}
+}
+
+func (c *costServiceClient) GetSpendReport(ctx context.Context, in *SpendReportRequest, opts ...grpc.CallOption) (*SpendReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpendReport)
+	err := c.cc.Invoke(ctx, CostService_GetSpendReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CostServiceServer is the server API for CostService service.
+// All implementations must embed UnimplementedCostServiceServer
+// for forward compatibility.
+type CostServiceServer interface {
+	// sums up the recurring cost of the visible items per month and year
+	GetSpendReport(context.Context, *SpendReportRequest) (*SpendReport, error)
+	mustEmbedUnimplementedCostServiceServer()
+}
+
+// UnimplementedCostServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCostServiceServer struct{}
+
+func (UnimplementedCostServiceServer) GetSpendReport(context.Context, *SpendReportRequest) (*SpendReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendReport not implemented")
+}
+func (UnimplementedCostServiceServer) mustEmbedUnimplementedCostServiceServer() {}
+func (UnimplementedCostServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCostServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CostServiceServer will
+// result in compilation errors.
+type UnsafeCostServiceServer interface {
+	mustEmbedUnimplementedCostServiceServer()
+}
+
+func RegisterCostServiceServer(s grpc.ServiceRegistrar, srv CostServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCostServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CostService_ServiceDesc, srv)
+}
+
+func _CostService_GetSpendReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CostServiceServer).GetSpendReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CostService_GetSpendReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CostServiceServer).GetSpendReport(ctx, req.(*SpendReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CostService_ServiceDesc is the grpc.ServiceDesc for CostService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CostService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.CostService",
+	HandlerType: (*CostServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSpendReport",
+			Handler:    _CostService_GetSpendReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	AttachmentService_GetAttachments_FullMethodName     = "/dig_inv.AttachmentService/GetAttachments"
+	AttachmentService_UploadAttachment_FullMethodName   = "/dig_inv.AttachmentService/UploadAttachment"
+	AttachmentService_DownloadAttachment_FullMethodName = "/dig_inv.AttachmentService/DownloadAttachment"
+	AttachmentService_DeleteAttachment_FullMethodName   = "/dig_inv.AttachmentService/DeleteAttachment"
+)
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// files can also be uploaded as multipart form field "file" to /items/{id}/attachments and downloaded from
+// /attachments/{id}/download, as the gateway does not support streaming
+type AttachmentServiceClient interface {
+	// takes the ID of the item
+	GetAttachments(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Attachments, error)
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AttachmentChunk, Attachment], error)
+	DownloadAttachment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error)
+	DeleteAttachment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) GetAttachments(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*Attachments, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachments)
+	err := c.cc.Invoke(ctx, AttachmentService_GetAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AttachmentChunk, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], AttachmentService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AttachmentChunk, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_UploadAttachmentClient = grpc.ClientStreamingClient[AttachmentChunk, Attachment]
+
+func (c *attachmentServiceClient) DownloadAttachment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttachmentChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[1], AttachmentService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ElementId, AttachmentChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_DownloadAttachmentClient = grpc.ServerStreamingClient[AttachmentChunk]
+
+func (c *attachmentServiceClient) DeleteAttachment(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, AttachmentService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility.
+//
+// files can also be uploaded as multipart form field "file" to /items/{id}/attachments and downloaded from
+// /attachments/{id}/download, as the gateway does not support streaming
+type AttachmentServiceServer interface {
+	// takes the ID of the item
+	GetAttachments(context.Context, *ElementId) (*Attachments, error)
+	UploadAttachment(grpc.ClientStreamingServer[AttachmentChunk, Attachment]) error
+	DownloadAttachment(*ElementId, grpc.ServerStreamingServer[AttachmentChunk]) error
+	DeleteAttachment(context.Context, *ElementId) (*EmptyMessage, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttachmentServiceServer struct{}
+
+func (UnimplementedAttachmentServiceServer) GetAttachments(context.Context, *ElementId) (*Attachments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) UploadAttachment(grpc.ClientStreamingServer[AttachmentChunk, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DownloadAttachment(*ElementId, grpc.ServerStreamingServer[AttachmentChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DeleteAttachment(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+func (UnimplementedAttachmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttachmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_GetAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).GetAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_GetAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).GetAttachments(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttachmentServiceServer).UploadAttachment(&grpc.GenericServerStream[AttachmentChunk, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_UploadAttachmentServer = grpc.ClientStreamingServer[AttachmentChunk, Attachment]
+
+func _AttachmentService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ElementId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[ElementId, AttachmentChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttachmentService_DownloadAttachmentServer = grpc.ServerStreamingServer[AttachmentChunk]
+
+func _AttachmentService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).DeleteAttachment(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttachments",
+			Handler:    _AttachmentService_GetAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _AttachmentService_DeleteAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _AttachmentService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _AttachmentService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "backend.proto",
+}
+
+const (
+	ImportService_ImportItems_FullMethodName = "/dig_inv.ImportService/ImportItems"
+)
+
+// ImportServiceClient is the client API for ImportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ImportServiceClient interface {
+	ImportItems(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error)
+}
+
+type importServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewImportServiceClient(cc grpc.ClientConnInterface) ImportServiceClient {
+	return &importServiceClient{cc}
+}
+
+func (c *importServiceClient) ImportItems(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportResult)
+	err := c.cc.Invoke(ctx, ImportService_ImportItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportServiceServer is the server API for ImportService service.
+// All implementations must embed UnimplementedImportServiceServer
+// for forward compatibility.
+type ImportServiceServer interface {
+	ImportItems(context.Context, *ImportRequest) (*ImportResult, error)
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+// UnimplementedImportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedImportServiceServer struct{}
+
+func (UnimplementedImportServiceServer) ImportItems(context.Context, *ImportRequest) (*ImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportItems not implemented")
+}
+func (UnimplementedImportServiceServer) mustEmbedUnimplementedImportServiceServer() {}
+func (UnimplementedImportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeImportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ImportServiceServer will
+// result in compilation errors.
+type UnsafeImportServiceServer interface {
+	mustEmbedUnimplementedImportServiceServer()
+}
+
+func RegisterImportServiceServer(s grpc.ServiceRegistrar, srv ImportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedImportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ImportService_ServiceDesc, srv)
+}
+
+func _ImportService_ImportItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImportServiceServer).ImportItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserGroupService_AddItemToGroup_FullMethodName,
+		FullMethod: ImportService_ImportItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserGroupServiceServer).AddItemToGroup(ctx, req.(*ElementId))
+		return srv.(ImportServiceServer).ImportItems(ctx, req.(*ImportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserGroupService_ServiceDesc is the grpc.ServiceDesc for UserGroupService service.
+// ImportService_ServiceDesc is the grpc.ServiceDesc for ImportService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var UserGroupService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "dig_inv.UserGroupService",
-	HandlerType: (*UserGroupServiceServer)(nil),
+var ImportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.ImportService",
+	HandlerType: (*ImportServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetGroup",
-			Handler:    _UserGroupService_GetGroup_Handler,
-		},
-		{
-			MethodName: "GetGroups",
-			Handler:    _UserGroupService_GetGroups_Handler,
-		},
-		{
-			MethodName: "CreateGroup",
-			Handler:    _UserGroupService_CreateGroup_Handler,
-		},
-		{
-			MethodName: "UpdateGroup",
-			Handler:    _UserGroupService_UpdateGroup_Handler,
-		},
-		{
-			MethodName: "DeleteGroup",
-			Handler:    _UserGroupService_DeleteGroup_Handler,
-		},
-		{
-			MethodName: "AddItemToGroup",
-			Handler:    _UserGroupService_AddItemToGroup_Handler,
+			MethodName: "ImportItems",
+			Handler:    _ImportService_ImportItems_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1175,11 +4837,15 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AssetClassService_GetAssetClass_FullMethodName    = "/dig_inv.AssetClassService/GetAssetClass"
-	AssetClassService_GetAssetClasses_FullMethodName  = "/dig_inv.AssetClassService/GetAssetClasses"
-	AssetClassService_CreateAssetClass_FullMethodName = "/dig_inv.AssetClassService/CreateAssetClass"
-	AssetClassService_UpdateAssetClass_FullMethodName = "/dig_inv.AssetClassService/UpdateAssetClass"
-	AssetClassService_DeleteAssetClass_FullMethodName = "/dig_inv.AssetClassService/DeleteAssetClass"
+	AssetClassService_GetAssetClass_FullMethodName             = "/dig_inv.AssetClassService/GetAssetClass"
+	AssetClassService_GetAssetClasses_FullMethodName           = "/dig_inv.AssetClassService/GetAssetClasses"
+	AssetClassService_CreateAssetClass_FullMethodName          = "/dig_inv.AssetClassService/CreateAssetClass"
+	AssetClassService_UpdateAssetClass_FullMethodName          = "/dig_inv.AssetClassService/UpdateAssetClass"
+	AssetClassService_DeleteAssetClass_FullMethodName          = "/dig_inv.AssetClassService/DeleteAssetClass"
+	AssetClassService_GetAttributeDefinitions_FullMethodName   = "/dig_inv.AssetClassService/GetAttributeDefinitions"
+	AssetClassService_CreateAttributeDefinition_FullMethodName = "/dig_inv.AssetClassService/CreateAttributeDefinition"
+	AssetClassService_UpdateAttributeDefinition_FullMethodName = "/dig_inv.AssetClassService/UpdateAttributeDefinition"
+	AssetClassService_DeleteAttributeDefinition_FullMethodName = "/dig_inv.AssetClassService/DeleteAttributeDefinition"
 )
 
 // AssetClassServiceClient is the client API for AssetClassService service.
@@ -1191,6 +4857,10 @@ type AssetClassServiceClient interface {
 	CreateAssetClass(ctx context.Context, in *AssetClass, opts ...grpc.CallOption) (*AssetClass, error)
 	UpdateAssetClass(ctx context.Context, in *AssetClass, opts ...grpc.CallOption) (*AssetClass, error)
 	DeleteAssetClass(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
+	GetAttributeDefinitions(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*AttributeDefinitions, error)
+	CreateAttributeDefinition(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeDefinition, error)
+	UpdateAttributeDefinition(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeDefinition, error)
+	DeleteAttributeDefinition(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error)
 }
 
 type assetClassServiceClient struct {
@@ -1251,6 +4921,46 @@ func (c *assetClassServiceClient) DeleteAssetClass(ctx context.Context, in *Elem
 	return out, nil
 }
 
+func (c *assetClassServiceClient) GetAttributeDefinitions(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*AttributeDefinitions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeDefinitions)
+	err := c.cc.Invoke(ctx, AssetClassService_GetAttributeDefinitions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClassServiceClient) CreateAttributeDefinition(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeDefinition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeDefinition)
+	err := c.cc.Invoke(ctx, AssetClassService_CreateAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClassServiceClient) UpdateAttributeDefinition(ctx context.Context, in *AttributeDefinition, opts ...grpc.CallOption) (*AttributeDefinition, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeDefinition)
+	err := c.cc.Invoke(ctx, AssetClassService_UpdateAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetClassServiceClient) DeleteAttributeDefinition(ctx context.Context, in *ElementId, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, AssetClassService_DeleteAttributeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AssetClassServiceServer is the server API for AssetClassService service.
 // All implementations must embed UnimplementedAssetClassServiceServer
 // for forward compatibility.
@@ -1260,6 +4970,10 @@ type AssetClassServiceServer interface {
 	CreateAssetClass(context.Context, *AssetClass) (*AssetClass, error)
 	UpdateAssetClass(context.Context, *AssetClass) (*AssetClass, error)
 	DeleteAssetClass(context.Context, *ElementId) (*EmptyMessage, error)
+	GetAttributeDefinitions(context.Context, *ElementId) (*AttributeDefinitions, error)
+	CreateAttributeDefinition(context.Context, *AttributeDefinition) (*AttributeDefinition, error)
+	UpdateAttributeDefinition(context.Context, *AttributeDefinition) (*AttributeDefinition, error)
+	DeleteAttributeDefinition(context.Context, *ElementId) (*EmptyMessage, error)
 	mustEmbedUnimplementedAssetClassServiceServer()
 }
 
@@ -1285,6 +4999,18 @@ func (UnimplementedAssetClassServiceServer) UpdateAssetClass(context.Context, *A
 func (UnimplementedAssetClassServiceServer) DeleteAssetClass(context.Context, *ElementId) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssetClass not implemented")
 }
+func (UnimplementedAssetClassServiceServer) GetAttributeDefinitions(context.Context, *ElementId) (*AttributeDefinitions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeDefinitions not implemented")
+}
+func (UnimplementedAssetClassServiceServer) CreateAttributeDefinition(context.Context, *AttributeDefinition) (*AttributeDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttributeDefinition not implemented")
+}
+func (UnimplementedAssetClassServiceServer) UpdateAttributeDefinition(context.Context, *AttributeDefinition) (*AttributeDefinition, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttributeDefinition not implemented")
+}
+func (UnimplementedAssetClassServiceServer) DeleteAttributeDefinition(context.Context, *ElementId) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeDefinition not implemented")
+}
 func (UnimplementedAssetClassServiceServer) mustEmbedUnimplementedAssetClassServiceServer() {}
 func (UnimplementedAssetClassServiceServer) testEmbeddedByValue()                           {}
 
@@ -1396,6 +5122,78 @@ func _AssetClassService_DeleteAssetClass_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AssetClassService_GetAttributeDefinitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetClassServiceServer).GetAttributeDefinitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetClassService_GetAttributeDefinitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetClassServiceServer).GetAttributeDefinitions(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetClassService_CreateAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetClassServiceServer).CreateAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetClassService_CreateAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetClassServiceServer).CreateAttributeDefinition(ctx, req.(*AttributeDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetClassService_UpdateAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetClassServiceServer).UpdateAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetClassService_UpdateAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetClassServiceServer).UpdateAttributeDefinition(ctx, req.(*AttributeDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetClassService_DeleteAttributeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElementId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetClassServiceServer).DeleteAttributeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetClassService_DeleteAttributeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetClassServiceServer).DeleteAttributeDefinition(ctx, req.(*ElementId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AssetClassService_ServiceDesc is the grpc.ServiceDesc for AssetClassService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1423,6 +5221,238 @@ var AssetClassService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAssetClass",
 			Handler:    _AssetClassService_DeleteAssetClass_Handler,
 		},
+		{
+			MethodName: "GetAttributeDefinitions",
+			Handler:    _AssetClassService_GetAttributeDefinitions_Handler,
+		},
+		{
+			MethodName: "CreateAttributeDefinition",
+			Handler:    _AssetClassService_CreateAttributeDefinition_Handler,
+		},
+		{
+			MethodName: "UpdateAttributeDefinition",
+			Handler:    _AssetClassService_UpdateAttributeDefinition_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeDefinition",
+			Handler:    _AssetClassService_DeleteAttributeDefinition_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "backend.proto",
+}
+
+const (
+	AuditService_GetEntityHistory_FullMethodName = "/dig_inv.AuditService/GetEntityHistory"
+	AuditService_GetActivityFeed_FullMethodName  = "/dig_inv.AuditService/GetActivityFeed"
+	AuditService_GetItemAsOf_FullMethodName      = "/dig_inv.AuditService/GetItemAsOf"
+	AuditService_GetItemDiff_FullMethodName      = "/dig_inv.AuditService/GetItemDiff"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditServiceClient interface {
+	GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*AuditEntries, error)
+	GetActivityFeed(ctx context.Context, in *ActivityFeedRequest, opts ...grpc.CallOption) (*AuditEntries, error)
+	GetItemAsOf(ctx context.Context, in *ItemAsOfRequest, opts ...grpc.CallOption) (*ItemSnapshot, error)
+	GetItemDiff(ctx context.Context, in *ItemDiffRequest, opts ...grpc.CallOption) (*ItemDiff, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) GetEntityHistory(ctx context.Context, in *EntityHistoryRequest, opts ...grpc.CallOption) (*AuditEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, AuditService_GetEntityHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) GetActivityFeed(ctx context.Context, in *ActivityFeedRequest, opts ...grpc.CallOption) (*AuditEntries, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditEntries)
+	err := c.cc.Invoke(ctx, AuditService_GetActivityFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) GetItemAsOf(ctx context.Context, in *ItemAsOfRequest, opts ...grpc.CallOption) (*ItemSnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemSnapshot)
+	err := c.cc.Invoke(ctx, AuditService_GetItemAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) GetItemDiff(ctx context.Context, in *ItemDiffRequest, opts ...grpc.CallOption) (*ItemDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ItemDiff)
+	err := c.cc.Invoke(ctx, AuditService_GetItemDiff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+type AuditServiceServer interface {
+	GetEntityHistory(context.Context, *EntityHistoryRequest) (*AuditEntries, error)
+	GetActivityFeed(context.Context, *ActivityFeedRequest) (*AuditEntries, error)
+	GetItemAsOf(context.Context, *ItemAsOfRequest) (*ItemSnapshot, error)
+	GetItemDiff(context.Context, *ItemDiffRequest) (*ItemDiff, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) GetEntityHistory(context.Context, *EntityHistoryRequest) (*AuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntityHistory not implemented")
+}
+func (UnimplementedAuditServiceServer) GetActivityFeed(context.Context, *ActivityFeedRequest) (*AuditEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityFeed not implemented")
+}
+func (UnimplementedAuditServiceServer) GetItemAsOf(context.Context, *ItemAsOfRequest) (*ItemSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemAsOf not implemented")
+}
+func (UnimplementedAuditServiceServer) GetItemDiff(context.Context, *ItemDiffRequest) (*ItemDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemDiff not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_GetEntityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EntityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetEntityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetEntityHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetEntityHistory(ctx, req.(*EntityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_GetActivityFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivityFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetActivityFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetActivityFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetActivityFeed(ctx, req.(*ActivityFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_GetItemAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetItemAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetItemAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetItemAsOf(ctx, req.(*ItemAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_GetItemDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).GetItemDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_GetItemDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).GetItemDiff(ctx, req.(*ItemDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "dig_inv.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEntityHistory",
+			Handler:    _AuditService_GetEntityHistory_Handler,
+		},
+		{
+			MethodName: "GetActivityFeed",
+			Handler:    _AuditService_GetActivityFeed_Handler,
+		},
+		{
+			MethodName: "GetItemAsOf",
+			Handler:    _AuditService_GetItemAsOf_Handler,
+		},
+		{
+			MethodName: "GetItemDiff",
+			Handler:    _AuditService_GetItemDiff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "backend.proto",
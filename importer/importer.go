@@ -0,0 +1,668 @@
+package importer
+
+import (
+	"cmp"
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/usergroup"
+	"dig-inv/export"
+	"dig-inv/log"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const attributePrefix = export.AttributePrefix
+
+// columns that can be imported, attribute columns are prefixed with attributes.
+var writableColumns = []string{
+	"id",
+	"name",
+	"description",
+	"asset_class",
+	"parent_id",
+	"tags",
+	"groups",
+	"cost_amount",
+	"cost_currency",
+	"cost_period",
+	"cost_center",
+}
+
+// columns written by exports that are managed by the inventory, so exported files can be imported again
+var ignoredColumns = []string{"created_at", "created_by", "updated_at", "updated_by"}
+
+// separates tags and groups in CSV cells
+const listSeparator = ";"
+
+type Options struct {
+	// Format is either csv or json.
+	Format string
+	// Key is the column that matches rows to existing items, either id, name or attributes.<key>. Defaults to id.
+	Key string
+	// Mapping renames columns of the file to item columns. Columns mapped to an empty string are ignored.
+	Mapping map[string]string
+	// DryRun validates all rows without committing the changes.
+	DryRun bool
+	// User is recorded as creator and updater of the imported entities.
+	User string
+	// Scope optionally limits the existing items that can be updated.
+	Scope predicate.Item
+}
+
+// RowError is a validation error of a row. Row is the 1-based row of the data, 0 for errors of the header.
+type RowError struct {
+	Row     int
+	Column  string
+	Message string
+}
+
+func (e RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("row %d: %s", e.Row, e.Message)
+	}
+
+	return fmt.Sprintf("row %d, column %s: %s", e.Row, e.Column, e.Message)
+}
+
+type Result struct {
+	Created int
+	Updated int
+	Errors  []RowError
+	// Applied is set if the changes were committed.
+	Applied bool
+}
+
+// Import creates or updates an item per row. All rows are imported in a single transaction, which is rolled back
+// if any row is invalid or the import is a dry run. Invalid rows are reported in the result, the returned error is
+// reserved for failures of the store.
+func Import(ctx context.Context, client *ent.Client, r io.Reader, opts Options) (*Result, error) {
+	res := &Result{Errors: make([]RowError, 0)}
+
+	if opts.Key == "" {
+		opts.Key = "id"
+	}
+
+	if opts.Key != "id" && opts.Key != "name" && !strings.HasPrefix(opts.Key, attributePrefix) {
+		res.Errors = append(res.Errors, RowError{Column: opts.Key, Message: "key has to be id, name or an attribute column"})
+		return res, nil
+	}
+
+	columns, rows, err := readRows(opts.Format, r)
+	if err != nil {
+		res.Errors = append(res.Errors, RowError{Message: err.Error()})
+		return res, nil
+	}
+
+	mapping, headerErrors := mapColumns(columns, opts.Mapping)
+	if len(headerErrors) > 0 {
+		res.Errors = headerErrors
+		return res, nil
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	i := &importer{client: tx.Client(), opts: opts, assetClasses: make(map[string]*ent.AssetClass)}
+	for _, row := range rows {
+		values := make(map[string]any, len(row.values))
+		for column, value := range row.values {
+			if target := mapping[column]; target != "" {
+				values[target] = value
+			}
+		}
+
+		created, rowErrors, err := i.importRow(ctx, row.number, values)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if len(rowErrors) > 0 {
+			res.Errors = append(res.Errors, rowErrors...)
+		} else if created {
+			res.Created++
+		} else {
+			res.Updated++
+		}
+	}
+
+	if len(res.Errors) > 0 || opts.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back import: %w", err)
+		}
+
+		log.S.Debugw("Rolled back import", "rows", len(rows), "errors", len(res.Errors), "dryRun", opts.DryRun)
+		return res, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit import: %w", err)
+	}
+
+	res.Applied = true
+	log.S.Infow("Imported items", "created", res.Created, "updated", res.Updated)
+
+	return res, nil
+}
+
+// mapColumns returns the item column of every column of the file, an empty string for ignored columns.
+func mapColumns(columns []string, mapping map[string]string) (map[string]string, []RowError) {
+	res := make(map[string]string, len(columns))
+	errs := make([]RowError, 0)
+	targets := make(map[string]string)
+
+	for _, column := range columns {
+		target, mapped := mapping[column]
+		if !mapped {
+			target = column
+		}
+
+		if target == "" || (!mapped && slices.Contains(ignoredColumns, target)) {
+			continue
+		}
+
+		if !slices.Contains(writableColumns, target) && !strings.HasPrefix(target, attributePrefix) {
+			errs = append(errs, RowError{Column: column, Message: fmt.Sprintf("unknown column %q", target)})
+			continue
+		}
+
+		if previous, ok := targets[target]; ok {
+			errs = append(errs, RowError{Column: column, Message: fmt.Sprintf("column %q is already mapped from %q", target, previous)})
+			continue
+		}
+
+		targets[target] = column
+		res[column] = target
+	}
+
+	return res, errs
+}
+
+type importer struct {
+	client       *ent.Client
+	opts         Options
+	assetClasses map[string]*ent.AssetClass
+}
+
+// importRow creates or updates the item of a row and reports whether it was created.
+func (i *importer) importRow(ctx context.Context, number int, values map[string]any) (bool, []RowError, error) {
+	errs := make([]RowError, 0)
+	invalid := func(column, format string, args ...any) {
+		errs = append(errs, RowError{Row: number, Column: column, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var id uuid.UUID
+	if value, ok := textValue(values, "id"); ok {
+		var err error
+		if id, err = uuid.Parse(value); err != nil {
+			return false, []RowError{{Row: number, Column: "id", Message: fmt.Sprintf("%q is not an item ID", value)}}, nil
+		}
+	}
+
+	existing, err := i.existingItem(ctx, id, values)
+	var rowErr *RowError
+	if errors.As(err, &rowErr) {
+		rowErr.Row = number
+		return false, []RowError{*rowErr}, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+
+	name, _ := textValue(values, "name")
+	if existing == nil && name == "" {
+		invalid("name", "name is required for new items")
+	}
+
+	var class *ent.AssetClass
+	if value, ok := textValue(values, "asset_class"); ok {
+		if class, err = i.assetClass(ctx, value); err != nil {
+			return false, nil, err
+		}
+		if class == nil {
+			invalid("asset_class", "asset class %q does not exist", value)
+		}
+	} else if existing != nil {
+		class = existing.Edges.AssetClass
+	} else {
+		invalid("asset_class", "asset class is required for new items")
+	}
+
+	var parent uuid.UUID
+	if value, ok := textValue(values, "parent_id"); ok {
+		if parent, err = i.parent(ctx, existing, value); err != nil {
+			var parentErr *RowError
+			if !errors.As(err, &parentErr) {
+				return false, nil, err
+			}
+			invalid("parent_id", "%s", parentErr.Message)
+		}
+	}
+
+	tags, err := i.tags(ctx, listValue(values["tags"]))
+	if err != nil {
+		return false, nil, err
+	}
+
+	groups, missing, err := i.groups(ctx, listValue(values["groups"]))
+	if err != nil {
+		return false, nil, err
+	}
+	for _, group := range missing {
+		invalid("groups", "user group %q does not exist", group)
+	}
+
+	var attributeValues map[string]any
+	if class != nil {
+		var messages []string
+		if attributeValues, messages, err = i.attributes(ctx, class, existing, values); err != nil {
+			return false, nil, err
+		}
+		for _, message := range messages {
+			invalid("attributes", "%s", message)
+		}
+	}
+
+	cost, costErrors := rowCost(existing, values)
+	for _, e := range costErrors {
+		e.Row = number
+		errs = append(errs, e)
+	}
+
+	if len(errs) > 0 {
+		return false, errs, nil
+	}
+
+	if existing == nil {
+		create := i.client.Item.Create().
+			SetName(name).
+			SetAttributes(attributeValues).
+			SetAssetClass(class).
+			AddTagIDs(tags...).
+			AddUserGroupIDs(groups...).
+			SetCreatedBy(i.opts.User).
+			SetUpdatedBy(i.opts.User)
+		if id != uuid.Nil {
+			create = create.SetID(id)
+		}
+		if description, ok := textValue(values, "description"); ok {
+			create = create.SetDescription(description)
+		}
+		if parent != uuid.Nil {
+			create = create.SetParentID(parent)
+		}
+		if cost.amount != nil {
+			create = create.SetCostAmount(*cost.amount).SetCostCurrency(cost.currency).SetCostPeriod(cost.period)
+		}
+		if cost.center != "" {
+			create = create.SetCostCenter(cost.center)
+		}
+
+		if err := create.Exec(ctx); err != nil {
+			return false, nil, fmt.Errorf("failed to create item in row %d: %w", number, err)
+		}
+
+		return true, nil, nil
+	}
+
+	update := i.client.Item.UpdateOne(existing).
+		SetAttributes(attributeValues).
+		SetAssetClass(class).
+		SetUpdatedBy(i.opts.User)
+	if name != "" {
+		update = update.SetName(name)
+	}
+	if description, ok := textValue(values, "description"); ok {
+		update = update.SetDescription(description)
+	}
+	if _, ok := values["tags"]; ok {
+		update = update.ClearTags().AddTagIDs(tags...)
+	}
+	if _, ok := values["groups"]; ok {
+		update = update.ClearUserGroups().AddUserGroupIDs(groups...)
+	}
+	if parent != uuid.Nil {
+		update = update.SetParentID(parent)
+	}
+	if cost.amount != nil {
+		update = update.SetCostAmount(*cost.amount).SetCostCurrency(cost.currency).SetCostPeriod(cost.period)
+	}
+	if cost.center != "" {
+		update = update.SetCostCenter(cost.center)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return false, nil, fmt.Errorf("failed to update item in row %d: %w", number, err)
+	}
+
+	return false, nil, nil
+}
+
+// existingItem returns the item matched by the key column of the row, or nil if the row creates a new item.
+// Invalid keys are returned as *RowError.
+func (i *importer) existingItem(ctx context.Context, id uuid.UUID, values map[string]any) (*ent.Item, error) {
+	value, ok := values[i.opts.Key]
+	if !ok {
+		if i.opts.Key != "id" {
+			return nil, &RowError{Column: i.opts.Key, Message: "key column is empty"}
+		}
+		return nil, nil
+	}
+
+	var match predicate.Item
+	switch {
+	case i.opts.Key == "id":
+		match = item.ID(id)
+	case i.opts.Key == "name":
+		match = item.Name(fmt.Sprint(value))
+	default:
+		key := strings.TrimPrefix(i.opts.Key, attributePrefix)
+		def, err := i.client.AttributeDefinition.Query().
+			Where(attributedefinition.Key(key), attributedefinition.DeletedAtIsNil()).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, &RowError{Column: i.opts.Key, Message: fmt.Sprintf("attribute %q is not defined", key)}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query attribute definition: %w", err)
+		}
+
+		coerced, err := attributes.Coerce(def, value)
+		if err != nil {
+			return nil, &RowError{Column: i.opts.Key, Message: err.Error()}
+		}
+
+		if match, err = attributes.Predicate(def, attributes.OperatorEQ, coerced); err != nil {
+			return nil, &RowError{Column: i.opts.Key, Message: err.Error()}
+		}
+	}
+
+	query := i.client.Item.Query().Where(item.DeletedAtIsNil(), match).WithAssetClass()
+	if i.opts.Scope != nil {
+		query = query.Where(i.opts.Scope)
+	}
+
+	items, err := query.Limit(2).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing items: %w", err)
+	}
+
+	switch len(items) {
+	case 0:
+		return nil, nil
+	case 1:
+		return items[0], nil
+	}
+
+	return nil, &RowError{Column: i.opts.Key, Message: fmt.Sprintf("%v matches several items", value)}
+}
+
+// assetClass returns the asset class with the given name or ID, or nil if it doesn't exist.
+func (i *importer) assetClass(ctx context.Context, value string) (*ent.AssetClass, error) {
+	if class, ok := i.assetClasses[value]; ok {
+		return class, nil
+	}
+
+	match := assetclass.NameEqualFold(value)
+	if id, err := uuid.Parse(value); err == nil {
+		match = assetclass.ID(id)
+	}
+
+	class, err := i.client.AssetClass.Query().Where(match, assetclass.DeletedAtIsNil()).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query asset class: %w", err)
+	}
+
+	i.assetClasses[value] = class
+	return class, nil
+}
+
+// parent returns the ID of the parent item, which must not be the item itself or one of its descendants.
+// Invalid parents are returned as *RowError.
+func (i *importer) parent(ctx context.Context, existing *ent.Item, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, &RowError{Message: fmt.Sprintf("%q is not an item ID", value)}
+	}
+
+	for ancestor := id; ancestor != uuid.Nil; {
+		if existing != nil && ancestor == existing.ID {
+			return uuid.Nil, &RowError{Message: "an item can't be nested below itself"}
+		}
+
+		current, err := i.client.Item.Query().Where(item.ID(ancestor), item.DeletedAtIsNil()).WithParent().Only(ctx)
+		if ent.IsNotFound(err) {
+			return uuid.Nil, &RowError{Message: fmt.Sprintf("parent item %s does not exist", ancestor)}
+		}
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to query parent item: %w", err)
+		}
+
+		ancestor = uuid.Nil
+		if current.Edges.Parent != nil {
+			ancestor = current.Edges.Parent.ID
+		}
+	}
+
+	return id, nil
+}
+
+// tags returns the IDs of the tags with the given names, missing tags are created.
+func (i *importer) tags(ctx context.Context, names []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(names))
+	for _, name := range names {
+		t, err := i.client.Tag.Query().Where(tag.NameEqualFold(name), tag.DeletedAtIsNil()).First(ctx)
+		if ent.IsNotFound(err) {
+			t, err = i.client.Tag.Create().SetName(name).SetCreatedBy(i.opts.User).SetUpdatedBy(i.opts.User).Save(ctx)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag %q: %w", name, err)
+		}
+
+		ids = append(ids, t.ID)
+	}
+
+	return ids, nil
+}
+
+// groups returns the IDs of the user groups with the given names or OIDC scopes and the names of missing groups.
+// Groups are not created, as their scope has to match the identity provider.
+func (i *importer) groups(ctx context.Context, names []string) ([]uuid.UUID, []string, error) {
+	ids := make([]uuid.UUID, 0, len(names))
+	missing := make([]string, 0)
+	for _, name := range names {
+		group, err := i.client.UserGroup.Query().
+			Where(
+				usergroup.Or(usergroup.NameEqualFold(name), usergroup.OidcScope(name)),
+				usergroup.DeletedAtIsNil(),
+			).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			missing = append(missing, name)
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get user group %q: %w", name, err)
+		}
+
+		ids = append(ids, group.ID)
+	}
+
+	return ids, missing, nil
+}
+
+// attributes validates the attribute columns of a row against the asset class and returns the canonical values and
+// the validation errors. Updated items keep the attributes that are not part of the row, unless the asset class
+// changes.
+func (i *importer) attributes(ctx context.Context, class *ent.AssetClass, existing *ent.Item, values map[string]any) (map[string]any, []string, error) {
+	defs, err := i.client.AttributeDefinition.Query().
+		Where(
+			attributedefinition.HasAssetClassWith(assetclass.ID(class.ID)),
+			attributedefinition.DeletedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query attribute definitions: %w", err)
+	}
+
+	raw := make(map[string]any)
+	if existing != nil && existing.Edges.AssetClass != nil && existing.Edges.AssetClass.ID == class.ID {
+		maps.Copy(raw, existing.Attributes)
+	}
+
+	for column, value := range values {
+		if key, ok := strings.CutPrefix(column, attributePrefix); ok {
+			raw[key] = value
+		}
+	}
+
+	res, err := attributes.Validate(defs, raw)
+	if err != nil {
+		// Validate joins the errors of all attributes
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			messages := make([]string, 0)
+			for _, e := range joined.Unwrap() {
+				messages = append(messages, e.Error())
+			}
+			return nil, messages, nil
+		}
+		return nil, []string{err.Error()}, nil
+	}
+
+	messages := make([]string, 0)
+	for _, reference := range attributes.References(defs, res) {
+		exists, err := i.client.Item.Query().Where(item.ID(reference), item.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to query referenced item: %w", err)
+		}
+
+		if !exists {
+			messages = append(messages, fmt.Sprintf("referenced item %s does not exist", reference))
+		}
+	}
+
+	return res, messages, nil
+}
+
+// itemCost is the cost of an imported item, amount is nil for items without known cost.
+type itemCost struct {
+	amount   *float64
+	currency string
+	period   item.CostPeriod
+	center   string
+}
+
+// rowCost validates the cost columns of a row. Updated items keep the parts of their cost that are not part of the
+// row. Amounts require a currency, the period defaults to monthly.
+func rowCost(existing *ent.Item, values map[string]any) (*itemCost, []RowError) {
+	res := &itemCost{}
+	if existing != nil {
+		res.amount = existing.CostAmount
+		res.currency = existing.CostCurrency
+		if existing.CostPeriod != nil {
+			res.period = *existing.CostPeriod
+		}
+		res.center = existing.CostCenter
+	}
+
+	if value, ok := values["cost_amount"]; ok {
+		amount, err := numberValue(value)
+		if err != nil || amount < 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+			return nil, []RowError{{Column: "cost_amount", Message: fmt.Sprintf("%v is not a valid cost amount", value)}}
+		}
+		res.amount = &amount
+	}
+
+	currency, hasCurrency := textValue(values, "cost_currency")
+	if hasCurrency {
+		res.currency = strings.ToUpper(currency)
+	}
+
+	period, hasPeriod := textValue(values, "cost_period")
+	if hasPeriod {
+		res.period = item.CostPeriod(strings.ToLower(period))
+	}
+
+	if center, ok := textValue(values, "cost_center"); ok {
+		res.center = center
+	}
+
+	if res.amount == nil {
+		if hasCurrency || hasPeriod {
+			return nil, []RowError{{Column: "cost_amount", Message: "cost amount is required with a currency or period"}}
+		}
+		return res, nil
+	}
+
+	errs := make([]RowError, 0)
+	res.period = cmp.Or(res.period, item.CostPeriodMonthly)
+	if err := item.CostCurrencyValidator(res.currency); err != nil {
+		errs = append(errs, RowError{Column: "cost_currency", Message: fmt.Sprintf("invalid cost currency %q, expected an ISO 4217 code such as EUR", res.currency)})
+	}
+	if err := item.CostPeriodValidator(res.period); err != nil {
+		errs = append(errs, RowError{Column: "cost_period", Message: fmt.Sprintf("invalid cost period %q, expected monthly, quarterly or yearly", res.period)})
+	}
+
+	return res, errs
+}
+
+// numberValue returns the value of a number column, CSV cells are parsed.
+func numberValue(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+
+	return 0, fmt.Errorf("%v is not a number", value)
+}
+
+// textValue returns the value of a column as text.
+func textValue(values map[string]any, column string) (string, bool) {
+	value, ok := values[column]
+	if !ok {
+		return "", false
+	}
+
+	if s, ok := value.(string); ok {
+		return s, true
+	}
+
+	return fmt.Sprint(value), true
+}
+
+// listValue splits a CSV cell into its elements, JSON lists are used as is.
+func listValue(value any) []string {
+	var elements []string
+	switch v := value.(type) {
+	case []string:
+		elements = v
+	case string:
+		elements = strings.Split(v, listSeparator)
+	}
+
+	res := make([]string, 0, len(elements))
+	for _, element := range elements {
+		if element = strings.TrimSpace(element); element != "" && !slices.Contains(res, element) {
+			res = append(res, element)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,292 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/export"
+	"dig-inv/store/storetest"
+	"strings"
+	"testing"
+)
+
+func openImportTestClient(t *testing.T, name string) *ent.Client {
+	client := storetest.OpenClient(t, name)
+
+	ctx := context.Background()
+	server := client.AssetClass.Create().SetName("Server").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("hostname").
+		SetName("Hostname").
+		SetType(attributedefinition.TypeString).
+		SetRequired(true).
+		SetAssetClass(server).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("cores").
+		SetName("Cores").
+		SetType(attributedefinition.TypeNumber).
+		SetAssetClass(server).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	client.UserGroup.Create().SetName("Ops").SetOidcScope("ops").SetCreatedBy("tester").SetUpdatedBy("tester").SaveX(ctx)
+
+	return client
+}
+
+func TestImport_CSV(t *testing.T) {
+	client := openImportTestClient(t, "import_csv")
+	ctx := context.Background()
+
+	data := "Name,Class,Host,cores,tags,groups\n" +
+		"web-1,server,web-1.example,4,prod;eu,Ops\n" +
+		"web-2,Server,web-2.example,,prod,\n"
+
+	opts := Options{
+		Format:  FormatCSV,
+		Key:     "name",
+		Mapping: map[string]string{"Name": "name", "Class": "asset_class", "Host": "attributes.hostname", "cores": "attributes.cores"},
+		User:    "importer",
+		DryRun:  true,
+	}
+
+	res, err := Import(ctx, client, strings.NewReader(data), opts)
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if res.Applied || res.Created != 2 || len(res.Errors) != 0 {
+		t.Errorf("Unexpected dry run result: %+v", res)
+	}
+
+	if count := client.Item.Query().CountX(ctx); count != 0 {
+		t.Errorf("Expected dry run not to create items, got %d", count)
+	}
+
+	opts.DryRun = false
+	res, err = Import(ctx, client, strings.NewReader(data), opts)
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Created != 2 || res.Updated != 0 {
+		t.Errorf("Unexpected import result: %+v", res)
+	}
+
+	web1 := client.Item.Query().Where(item.Name("web-1")).WithTags().WithUserGroups().WithAssetClass().OnlyX(ctx)
+	if web1.Attributes["hostname"] != "web-1.example" || web1.Attributes["cores"] != float64(4) {
+		t.Errorf("Unexpected attributes: %v", web1.Attributes)
+	}
+
+	if len(web1.Edges.Tags) != 2 || len(web1.Edges.UserGroups) != 1 || web1.Edges.AssetClass.Name != "Server" {
+		t.Errorf("Unexpected edges: %+v", web1.Edges)
+	}
+
+	// rows matching the key update the existing items and keep attributes that are not part of the file
+	res, err = Import(ctx, client, strings.NewReader("name,description\nweb-1,primary frontend\n"), Options{
+		Format: FormatCSV,
+		Key:    "name",
+		User:   "importer",
+	})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Updated != 1 {
+		t.Errorf("Unexpected update result: %+v", res)
+	}
+
+	web1 = client.Item.GetX(ctx, web1.ID)
+	if web1.Description != "primary frontend" || web1.Attributes["cores"] != float64(4) {
+		t.Errorf("Unexpected updated item: %v", web1)
+	}
+}
+
+func TestImport_Errors(t *testing.T) {
+	client := openImportTestClient(t, "import_errors")
+	ctx := context.Background()
+
+	data := "name,asset_class,attributes.hostname,attributes.cores,groups\n" +
+		"db-1,Server,db-1.example,8,\n" +
+		"db-2,Server,,many,\n" +
+		",Printer,,,Finance\n"
+
+	res, err := Import(ctx, client, strings.NewReader(data), Options{Format: FormatCSV, User: "importer"})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if res.Applied {
+		t.Error("Expected import with errors not to be applied")
+	}
+
+	expected := []RowError{
+		{Row: 2, Column: "attributes"},
+		{Row: 2, Column: "attributes"},
+		{Row: 3, Column: "name"},
+		{Row: 3, Column: "asset_class"},
+		{Row: 3, Column: "groups"},
+	}
+	if len(res.Errors) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), res.Errors)
+	}
+
+	for i, e := range expected {
+		if res.Errors[i].Row != e.Row || res.Errors[i].Column != e.Column {
+			t.Errorf("Expected error %d in row %d column %s, got %v", i, e.Row, e.Column, res.Errors[i])
+		}
+	}
+
+	// the valid first row is rolled back as well
+	if count := client.Item.Query().CountX(ctx); count != 0 {
+		t.Errorf("Expected no items after failed import, got %d", count)
+	}
+
+	res, err = Import(ctx, client, strings.NewReader("name,serial\nx,1\n"), Options{Format: FormatCSV})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if len(res.Errors) != 1 || res.Errors[0].Row != 0 || res.Errors[0].Column != "serial" {
+		t.Errorf("Expected unknown column to be reported, got %v", res.Errors)
+	}
+}
+
+func TestImport_JSON(t *testing.T) {
+	client := openImportTestClient(t, "import_json")
+	ctx := context.Background()
+
+	data := `[
+		{"name": "mail", "asset_class": "Server", "tags": ["prod"], "attributes": {"hostname": "mail.example", "cores": 2}},
+		{"name": "dns", "asset_class": "Server", "attributes.hostname": "dns.example"}
+	]`
+
+	res, err := Import(ctx, client, strings.NewReader(data), Options{Format: FormatJSON, User: "importer"})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Created != 2 {
+		t.Fatalf("Unexpected import result: %+v", res)
+	}
+
+	mail := client.Item.Query().Where(item.Name("mail")).OnlyX(ctx)
+
+	// upsert by attribute
+	res, err = Import(ctx, client, strings.NewReader(`[{"attributes": {"hostname": "mail.example", "cores": 6}}]`), Options{
+		Format: FormatJSON,
+		Key:    "attributes.hostname",
+		User:   "importer",
+	})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Updated != 1 {
+		t.Fatalf("Unexpected upsert result: %+v", res)
+	}
+
+	if mail = client.Item.GetX(ctx, mail.ID); mail.Attributes["cores"] != float64(6) {
+		t.Errorf("Expected cores to be updated, got %v", mail.Attributes)
+	}
+}
+
+func TestImport_ExportRoundTrip(t *testing.T) {
+	client := openImportTestClient(t, "import_round_trip")
+	ctx := context.Background()
+
+	data := "name,asset_class,attributes.hostname,tags,cost_amount,cost_currency\nproxy,Server,proxy.example,edge,7.5,EUR\n"
+	if _, err := Import(ctx, client, strings.NewReader(data), Options{Format: FormatCSV, User: "importer"}); err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := export.Write(ctx, &b, export.FormatCSV, client.Item.Query()); err != nil {
+		t.Fatalf("Failed to export: %v", err)
+	}
+
+	res, err := Import(ctx, client, &b, Options{Format: FormatCSV, User: "importer"})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Updated != 1 || res.Created != 0 {
+		t.Errorf("Expected exported items to be updated, got %+v", res)
+	}
+
+	if proxy := client.Item.Query().OnlyX(ctx); *proxy.CostAmount != 7.5 || proxy.CostCurrency != "EUR" {
+		t.Errorf("Unexpected cost after round trip: %v %s", *proxy.CostAmount, proxy.CostCurrency)
+	}
+}
+
+func TestImport_Cost(t *testing.T) {
+	client := openImportTestClient(t, "import_cost")
+	ctx := context.Background()
+
+	data := "name,asset_class,attributes.hostname,cost_amount,cost_currency,cost_period,cost_center\n" +
+		"web-1,Server,web-1.example,12.50,eur,,ops\n" +
+		"web-2,Server,web-2.example,,,,\n"
+	res, err := Import(ctx, client, strings.NewReader(data), Options{Format: FormatCSV, User: "importer"})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	if !res.Applied || res.Created != 2 {
+		t.Fatalf("Unexpected import result: %+v", res)
+	}
+
+	web1 := client.Item.Query().Where(item.Name("web-1")).OnlyX(ctx)
+	if web1.CostAmount == nil || *web1.CostAmount != 12.5 || web1.CostCurrency != "EUR" ||
+		*web1.CostPeriod != item.CostPeriodMonthly || web1.CostCenter != "ops" {
+		t.Errorf("Unexpected cost: %v %s %v %s", web1.CostAmount, web1.CostCurrency, web1.CostPeriod, web1.CostCenter)
+	}
+
+	if web2 := client.Item.Query().Where(item.Name("web-2")).OnlyX(ctx); web2.CostAmount != nil {
+		t.Errorf("Expected no cost, got %v", *web2.CostAmount)
+	}
+
+	// updates keep the parts of the cost that are not part of the row
+	res, err = Import(ctx, client, strings.NewReader(`[{"name": "web-1", "cost_amount": 140, "cost_period": "yearly"}]`), Options{
+		Format: FormatJSON,
+		Key:    "name",
+		User:   "importer",
+	})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	web1 = client.Item.GetX(ctx, web1.ID)
+	if !res.Applied || *web1.CostAmount != 140 || web1.CostCurrency != "EUR" || *web1.CostPeriod != item.CostPeriodYearly {
+		t.Errorf("Unexpected updated cost: %+v %v %s %v", res, *web1.CostAmount, web1.CostCurrency, *web1.CostPeriod)
+	}
+
+	data = "name,cost_amount,cost_currency,cost_period\n" +
+		"web-1,-1,,\n" +
+		"web-2,5,euro,weekly\n" +
+		"web-2,,EUR,\n"
+	res, err = Import(ctx, client, strings.NewReader(data), Options{Format: FormatCSV, Key: "name", User: "importer"})
+	if err != nil {
+		t.Fatalf("Failed to import: %v", err)
+	}
+
+	expected := []RowError{
+		{Row: 1, Column: "cost_amount"},
+		{Row: 2, Column: "cost_currency"},
+		{Row: 2, Column: "cost_period"},
+		{Row: 3, Column: "cost_amount"},
+	}
+	if res.Applied || len(res.Errors) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), res.Errors)
+	}
+
+	for i, e := range expected {
+		if res.Errors[i].Row != e.Row || res.Errors[i].Column != e.Column {
+			t.Errorf("Expected error %d in row %d column %s, got %v", i, e.Row, e.Column, res.Errors[i])
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	FormatCSV  = "csv"
+	FormatJSON = "json"
+)
+
+var Formats = []string{FormatCSV, FormatJSON}
+
+// row holds the non-empty values of a row by column. Values are strings, lists of strings, numbers or booleans.
+type row struct {
+	number int
+	values map[string]any
+}
+
+// readRows reads the columns and rows of a file. CSV files start with a header, JSON files contain a list of objects,
+// whose attributes can either be flattened with the attributes. prefix or nested in an attributes object.
+func readRows(format string, r io.Reader) ([]string, []row, error) {
+	switch format {
+	case FormatCSV:
+		return readCSV(r)
+	case FormatJSON:
+		return readJSON(r)
+	}
+
+	return nil, nil, fmt.Errorf("unsupported import format %q, expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+func readCSV(r io.Reader) ([]string, []row, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	for i, column := range header {
+		// spreadsheet applications like to start files with a byte order mark
+		header[i] = strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))
+	}
+
+	rows := make([]row, 0)
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read row %d: %w", number, err)
+		}
+
+		values := make(map[string]any, len(record))
+		for i, value := range record {
+			if value = strings.TrimSpace(value); value != "" {
+				values[header[i]] = value
+			}
+		}
+
+		rows = append(rows, row{number: number, values: values})
+	}
+
+	return header, rows, nil
+}
+
+func readJSON(r io.Reader) ([]string, []row, error) {
+	var objects []map[string]any
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return nil, nil, fmt.Errorf("failed to read JSON: %w", err)
+	}
+
+	columns := make([]string, 0)
+	seen := make(map[string]bool)
+	addColumn := func(column string) {
+		if !seen[column] {
+			seen[column] = true
+			columns = append(columns, column)
+		}
+	}
+
+	rows := make([]row, 0, len(objects))
+	for i, object := range objects {
+		values := make(map[string]any, len(object))
+		for key, value := range object {
+			if nested, ok := value.(map[string]any); ok && key == "attributes" {
+				for attribute, attributeValue := range nested {
+					addColumn(attributePrefix + attribute)
+					setJSONValue(values, attributePrefix+attribute, attributeValue)
+				}
+				continue
+			}
+
+			addColumn(key)
+			setJSONValue(values, key, value)
+		}
+
+		rows = append(rows, row{number: i + 1, values: values})
+	}
+
+	return columns, rows, nil
+}
+
+func setJSONValue(values map[string]any, column string, value any) {
+	switch v := value.(type) {
+	case nil:
+		return
+	case string:
+		if v = strings.TrimSpace(v); v == "" {
+			return
+		}
+		values[column] = v
+	case []any:
+		list := make([]string, 0, len(v))
+		for _, element := range v {
+			list = append(list, fmt.Sprint(element))
+		}
+		values[column] = list
+	default:
+		values[column] = v
+	}
+}
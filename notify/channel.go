@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"dig-inv/ent"
+	"dig-inv/ent/notificationchannel"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+const sendTimeout = 30 * time.Second
+
+// Message is a notification about an item.
+type Message struct {
+	// Event is sent to webhooks, reminder if empty.
+	Event   string
+	Subject string
+	Text    string
+	// the remaining fields are empty for test messages
+	ItemID     string
+	ItemName   string
+	AssetClass string
+	Attribute  string
+	DueOn      string
+	DaysLeft   int
+	// State is the up or down state of reachability messages.
+	State string
+}
+
+// Sender delivers messages through a notification channel.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender returns the sender of a channel, after validating its configuration.
+func NewSender(channel *ent.NotificationChannel) (Sender, error) {
+	config := channel.Config
+	switch channel.Type {
+	case notificationchannel.TypeSMTP:
+		if config["host"] == "" || config["from"] == "" || config["to"] == "" {
+			return nil, errors.New("smtp channels require a host, a from and a to address")
+		}
+		return smtpSender{config: config}, nil
+	case notificationchannel.TypeWebhook, notificationchannel.TypeSlack:
+		if !strings.HasPrefix(config["url"], "http://") && !strings.HasPrefix(config["url"], "https://") {
+			return nil, fmt.Errorf("%s channels require an http or https url", channel.Type)
+		}
+		return webhookSender{url: config["url"], slack: channel.Type == notificationchannel.TypeSlack}, nil
+	}
+
+	return nil, fmt.Errorf("unknown channel type %s", channel.Type)
+}
+
+type smtpSender struct {
+	config map[string]string
+}
+
+// Send delivers the message as plain text email. STARTTLS is used if the server supports it.
+func (s smtpSender) Send(ctx context.Context, msg Message) error {
+	host, port := s.config["host"], s.config["port"]
+	if port == "" {
+		port = "25"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	defer func() {
+		_ = client.Close()
+	}()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return fmt.Errorf("failed to start tls: %w", err)
+		}
+	}
+
+	if s.config["username"] != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config["username"], s.config["password"], host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	recipients := strings.FieldsFunc(s.config["to"], func(r rune) bool { return r == ',' || r == ' ' })
+	if err := client.Mail(s.config["from"]); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("failed to send mail to %s: %w", recipient, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + headerValue(s.config["from"]) + "\r\n")
+	b.WriteString("To: " + headerValue(strings.Join(recipients, ", ")) + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", headerValue(msg.Subject)) + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Text, "\n", "\r\n") + "\r\n")
+
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send mail: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// headerValue removes line breaks from a mail header value, as item names end up in the subject and must not add
+// headers of their own.
+func headerValue(value string) string {
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool { return r == '\r' || r == '\n' }), " ")
+}
+
+type webhookSender struct {
+	url   string
+	slack bool
+}
+
+// webhookPayload is posted to generic webhooks.
+type webhookPayload struct {
+	Event      string `json:"event"`
+	Subject    string `json:"subject"`
+	Text       string `json:"text"`
+	ItemID     string `json:"item_id,omitempty"`
+	ItemName   string `json:"item_name,omitempty"`
+	AssetClass string `json:"asset_class,omitempty"`
+	Attribute  string `json:"attribute,omitempty"`
+	DueOn      string `json:"due_on,omitempty"`
+	DaysLeft   int    `json:"days_left"`
+	State      string `json:"state,omitempty"`
+}
+
+// Send posts the message as JSON. Slack-compatible webhooks only receive the text.
+func (s webhookSender) Send(ctx context.Context, msg Message) error {
+	event := msg.Event
+	if event == "" {
+		event = "reminder"
+	}
+
+	var payload any = webhookPayload{
+		Event:      event,
+		Subject:    msg.Subject,
+		Text:       msg.Text,
+		ItemID:     msg.ItemID,
+		ItemName:   msg.ItemName,
+		AssetClass: msg.AssetClass,
+		Attribute:  msg.Attribute,
+		DueOn:      msg.DueOn,
+		DaysLeft:   msg.DaysLeft,
+		State:      msg.State,
+	}
+	if s.slack {
+		payload = map[string]string{"text": "*" + msg.Subject + "*\n" + msg.Text}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return nil
+}
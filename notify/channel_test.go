@@ -0,0 +1,186 @@
+package notify
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/notificationchannel"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+// startSMTPServer runs a minimal SMTP server that accepts all mails and returns the received messages.
+func startSMTPServer(t *testing.T) (string, string, <-chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	messages := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, messages)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, messages
+}
+
+func serveSMTP(conn net.Conn, messages chan<- string) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	text := textproto.NewConn(conn)
+	_ = text.PrintfLine("220 localhost ready")
+
+	var envelope strings.Builder
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(strings.Fields(line + " ")[0])
+		switch command {
+		case "EHLO", "HELO":
+			_ = text.PrintfLine("250 localhost")
+		case "MAIL", "RCPT":
+			envelope.WriteString(line + "\n")
+			_ = text.PrintfLine("250 OK")
+		case "DATA":
+			_ = text.PrintfLine("354 send data")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			messages <- envelope.String() + string(data)
+			_ = text.PrintfLine("250 OK")
+		case "QUIT":
+			_ = text.PrintfLine("221 bye")
+			return
+		default:
+			_ = text.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	sender, err := NewSender(&ent.NotificationChannel{
+		Type:   notificationchannel.TypeSMTP,
+		Config: map[string]string{"host": host, "port": port, "from": "inventory@example.com", "to": "ops@example.com, noc@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sender: %v", err)
+	}
+
+	err = sender.Send(context.Background(), Message{Subject: "example.com: Expires in 7 days", Text: "Expires of Domain example.com is 2026-01-08."})
+	if err != nil {
+		t.Fatalf("Failed to send: %v", err)
+	}
+
+	message := <-messages
+	for _, expected := range []string{
+		"MAIL FROM:<inventory@example.com>",
+		"RCPT TO:<ops@example.com>",
+		"RCPT TO:<noc@example.com>",
+		"Subject: example.com: Expires in 7 days",
+		"Expires of Domain example.com is 2026-01-08.",
+	} {
+		if !strings.Contains(message, expected) {
+			t.Errorf("Expected message to contain %q, got %q", expected, message)
+		}
+	}
+}
+
+func TestSMTPSender_HeaderInjection(t *testing.T) {
+	host, port, messages := startSMTPServer(t)
+
+	sender, err := NewSender(&ent.NotificationChannel{
+		Type:   notificationchannel.TypeSMTP,
+		Config: map[string]string{"host": host, "port": port, "from": "inventory@example.com", "to": "ops@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sender: %v", err)
+	}
+
+	err = sender.Send(context.Background(), Message{Subject: "evil\r\nBcc: victim@example.com\r\n: Expires in 7 days – café", Text: "text"})
+	if err != nil {
+		t.Fatalf("Failed to send: %v", err)
+	}
+
+	message := <-messages
+	if strings.Contains(message, "\r\nBcc:") {
+		t.Errorf("Expected the item name not to add headers, got %q", message)
+	}
+
+	if !strings.Contains(message, "Subject: =?utf-8?q?evil_Bcc:_victim@example.com_:_Expires_in_7_days_") {
+		t.Errorf("Expected an encoded subject on a single line, got %q", message)
+	}
+}
+
+func TestWebhookSender(t *testing.T) {
+	bodies := make(chan map[string]any, 2)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		bodies <- body
+
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer receiver.Close()
+
+	msg := Message{Subject: "db-1: Contract end in 30 days", Text: "text", ItemName: "db-1", DueOn: "2026-02-01", DaysLeft: 30}
+
+	webhook, err := NewSender(&ent.NotificationChannel{Type: notificationchannel.TypeWebhook, Config: map[string]string{"url": receiver.URL + "/hook"}})
+	if err != nil {
+		t.Fatalf("Failed to create sender: %v", err)
+	}
+
+	if err := webhook.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Failed to send: %v", err)
+	}
+
+	body := <-bodies
+	if body["item_name"] != "db-1" || body["due_on"] != "2026-02-01" || body["days_left"] != float64(30) {
+		t.Errorf("Unexpected webhook payload: %v", body)
+	}
+
+	slack, err := NewSender(&ent.NotificationChannel{Type: notificationchannel.TypeSlack, Config: map[string]string{"url": receiver.URL + "/broken"}})
+	if err != nil {
+		t.Fatalf("Failed to create sender: %v", err)
+	}
+
+	if err := slack.Send(context.Background(), msg); err == nil {
+		t.Error("Expected error for failing webhook")
+	}
+
+	body = <-bodies
+	if body["text"] != "*db-1: Contract end in 30 days*\ntext" || len(body) != 1 {
+		t.Errorf("Unexpected slack payload: %v", body)
+	}
+
+	if _, err := NewSender(&ent.NotificationChannel{Type: notificationchannel.TypeSlack, Config: map[string]string{"url": "ftp://example.com"}}); err == nil {
+		t.Error("Expected error for invalid url")
+	}
+}
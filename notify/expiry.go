@@ -0,0 +1,197 @@
+package notify
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/notificationdelivery"
+	"dig-inv/ent/reminderrule"
+	"dig-inv/worker"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// JobType is the type of the job runs that send reminders about upcoming dates.
+const JobType = "expiry_notifications"
+
+// RunExpiryJob is the worker handler of expiry notification jobs. Every item whose watched date is reached within
+// the largest number of days of a rule gets one reminder per channel for the smallest number of days that is not
+// yet exceeded, e.g. an item that expires in 20 days gets the 30 days reminder of a rule with 60, 30 and 7 days.
+func RunExpiryJob(ctx context.Context, client *ent.Client, _ *ent.JobRun) (string, error) {
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	rules, err := client.ReminderRule.Query().
+		Where(
+			reminderrule.Enabled(true),
+			reminderrule.DeletedAtIsNil(),
+			reminderrule.HasAssetClassWith(assetclass.DeletedAtIsNil()),
+		).
+		WithAssetClass(func(q *ent.AssetClassQuery) {
+			q.WithAttributes(func(q *ent.AttributeDefinitionQuery) {
+				q.Where(
+					attributedefinition.TypeEQ(attributedefinition.TypeDate),
+					attributedefinition.DeletedAtIsNil(),
+				)
+			})
+		}).
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.Enabled(true), notificationchannel.DeletedAtIsNil())
+		}).
+		All(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query reminder rules: %w", err)
+	}
+
+	n := &notifier{client: client, senders: make(map[uuid.UUID]Sender)}
+	for i, rule := range rules {
+		n.runRule(ctx, rule, today)
+		worker.ReportProgress(ctx, (i+1)*100/len(rules))
+	}
+
+	result := fmt.Sprintf("sent %d reminders", n.sent)
+	if len(n.errs) > 0 {
+		return "", fmt.Errorf("%s, %d failed: %w", result, len(n.errs), errors.Join(n.errs...))
+	}
+
+	return result, nil
+}
+
+type notifier struct {
+	client  *ent.Client
+	senders map[uuid.UUID]Sender
+	sent    int
+	errs    []error
+}
+
+func (n *notifier) runRule(ctx context.Context, rule *ent.ReminderRule, today time.Time) {
+	days := slices.DeleteFunc(slices.Clone(rule.Days), func(d int) bool { return d < 0 })
+	if len(days) == 0 || len(rule.Edges.Channels) == 0 {
+		return
+	}
+	slices.Sort(days)
+
+	class := rule.Edges.AssetClass
+	for _, def := range class.Edges.Attributes {
+		if rule.AttributeKey != "" && def.Key != rule.AttributeKey {
+			continue
+		}
+
+		from, err := attributes.Predicate(def, attributes.OperatorGTE, today.Format(attributes.DateLayout))
+		if err != nil {
+			n.errs = append(n.errs, err)
+			continue
+		}
+
+		to, err := attributes.Predicate(def, attributes.OperatorLTE, today.AddDate(0, 0, days[len(days)-1]).Format(attributes.DateLayout))
+		if err != nil {
+			n.errs = append(n.errs, err)
+			continue
+		}
+
+		items, err := n.client.Item.Query().
+			Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(class.ID)), from, to).
+			Order(item.ByName(), item.ByID()).
+			All(ctx)
+		if err != nil {
+			n.errs = append(n.errs, fmt.Errorf("failed to query items of %s: %w", class.Name, err))
+			continue
+		}
+
+		for _, i := range items {
+			dueOn, _ := i.Attributes[def.Key].(string)
+			due, err := time.Parse(attributes.DateLayout, dueOn)
+			if err != nil {
+				continue
+			}
+
+			left := int(due.Sub(today).Hours() / 24)
+			threshold := days[slices.IndexFunc(days, func(d int) bool { return left <= d })]
+
+			for _, channel := range rule.Edges.Channels {
+				n.remind(ctx, channel, i, class, def, dueOn, left, threshold)
+			}
+		}
+	}
+}
+
+// remind sends a reminder unless it was already sent to the channel.
+func (n *notifier) remind(ctx context.Context, channel *ent.NotificationChannel, i *ent.Item, class *ent.AssetClass, def *ent.AttributeDefinition, dueOn string, left, threshold int) {
+	sent, err := n.client.NotificationDelivery.Query().
+		Where(
+			notificationdelivery.AttributeKey(def.Key),
+			notificationdelivery.DueOn(dueOn),
+			notificationdelivery.Days(threshold),
+			notificationdelivery.HasItemWith(item.ID(i.ID)),
+			notificationdelivery.HasChannelWith(notificationchannel.ID(channel.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		n.errs = append(n.errs, fmt.Errorf("failed to query deliveries: %w", err))
+		return
+	}
+	if sent {
+		return
+	}
+
+	sender, ok := n.senders[channel.ID]
+	if !ok {
+		if sender, err = NewSender(channel); err != nil {
+			n.errs = append(n.errs, fmt.Errorf("channel %s: %w", channel.Name, err))
+			return
+		}
+		n.senders[channel.ID] = sender
+	}
+
+	if err := sender.Send(ctx, ReminderMessage(i, class, def, dueOn, left)); err != nil {
+		worker.Logf(ctx, "Failed to send reminder for %s to %s: %v", i.Name, channel.Name, err)
+		n.errs = append(n.errs, fmt.Errorf("channel %s: %w", channel.Name, err))
+		return
+	}
+
+	err = n.client.NotificationDelivery.Create().
+		SetAttributeKey(def.Key).
+		SetDueOn(dueOn).
+		SetDays(threshold).
+		SetItem(i).
+		SetChannel(channel).
+		SetCreatedBy(worker.Subject).
+		SetUpdatedBy(worker.Subject).
+		Exec(ctx)
+	if err != nil {
+		n.errs = append(n.errs, fmt.Errorf("failed to record delivery: %w", err))
+		return
+	}
+
+	worker.Logf(ctx, "Sent %d days reminder for %s %s to %s", threshold, i.Name, def.Key, channel.Name)
+	n.sent++
+}
+
+// ReminderMessage describes an upcoming date of an item.
+func ReminderMessage(i *ent.Item, class *ent.AssetClass, def *ent.AttributeDefinition, dueOn string, left int) Message {
+	when := fmt.Sprintf("in %d days", left)
+	switch left {
+	case 0:
+		when = "today"
+	case 1:
+		when = "tomorrow"
+	}
+
+	return Message{
+		Subject:    fmt.Sprintf("%s: %s %s", i.Name, def.Name, when),
+		Text:       fmt.Sprintf("%s of %s %s is %s, %s.", def.Name, class.Name, i.Name, dueOn, when),
+		ItemID:     i.ID.String(),
+		ItemName:   i.Name,
+		AssetClass: class.Name,
+		Attribute:  def.Key,
+		DueOn:      dueOn,
+		DaysLeft:   left,
+	}
+}
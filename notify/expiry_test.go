@@ -0,0 +1,169 @@
+package notify
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/store/storetest"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunExpiryJob(t *testing.T) {
+	client := storetest.OpenClient(t, "notify")
+	ctx := context.Background()
+
+	host, port, messages := startSMTPServer(t)
+
+	hooks := make(chan map[string]any, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		hooks <- body
+	}))
+	defer receiver.Close()
+
+	in := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, days).Format(attributes.DateLayout)
+	}
+
+	domain := client.AssetClass.Create().SetName("Domain").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("expires").
+		SetName("Expires").
+		SetType(attributedefinition.TypeDate).
+		SetAssetClass(domain).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("registered").
+		SetName("Registered").
+		SetType(attributedefinition.TypeDate).
+		SetAssetClass(domain).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	for name, attrs := range map[string]map[string]any{
+		"soon.example":    {"expires": in(5), "registered": in(6)},
+		"month.example":   {"expires": in(25)},
+		"later.example":   {"expires": in(90)},
+		"expired.example": {"expires": in(-1)},
+	} {
+		client.Item.Create().SetName(name).SetAssetClass(domain).SetAttributes(attrs).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	}
+
+	mail := client.NotificationChannel.Create().
+		SetName("Ops mail").
+		SetType(notificationchannel.TypeSMTP).
+		SetConfig(map[string]string{"host": host, "port": port, "from": "inventory@example.com", "to": "ops@example.com"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	hook := client.NotificationChannel.Create().
+		SetName("Hook").
+		SetType(notificationchannel.TypeWebhook).
+		SetConfig(map[string]string{"url": receiver.URL}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.ReminderRule.Create().
+		SetAssetClass(domain).
+		SetAttributeKey("expires").
+		SetDays([]int{60, 30, 7}).
+		AddChannels(mail, hook).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	result, err := RunExpiryJob(ctx, client, nil)
+	if err != nil {
+		t.Fatalf("Failed to run expiry job: %v", err)
+	}
+
+	if result != "sent 4 reminders" {
+		t.Errorf("Unexpected result: %s", result)
+	}
+
+	received := make(map[string]float64)
+	for range 2 {
+		body := <-hooks
+		received[body["item_name"].(string)] = body["days_left"].(float64)
+	}
+	if received["soon.example"] != 5 || received["month.example"] != 25 {
+		t.Errorf("Unexpected webhook reminders: %v", received)
+	}
+
+	for range 2 {
+		if message := <-messages; !strings.Contains(message, "Subject: soon.example: Expires in 5 days") &&
+			!strings.Contains(message, "Subject: month.example: Expires in 25 days") {
+			t.Errorf("Unexpected mail: %s", message)
+		}
+	}
+
+	// every reminder is only sent once
+	result, err = RunExpiryJob(ctx, client, nil)
+	if err != nil || result != "sent 0 reminders" {
+		t.Errorf("Expected no reminders on second run, got %q, %v", result, err)
+	}
+
+	// changed dates are reminded again, only through enabled channels
+	client.Item.Update().SetAttributes(map[string]any{"expires": in(20)}).SetUpdatedBy("a").ExecX(ctx)
+	client.NotificationChannel.UpdateOne(mail).SetEnabled(false).SetUpdatedBy("a").ExecX(ctx)
+
+	result, err = RunExpiryJob(ctx, client, nil)
+	if err != nil || result != "sent 4 reminders" {
+		t.Errorf("Expected reminders for the changed dates, got %q, %v", result, err)
+	}
+}
+
+func TestRunExpiryJob_FailedChannel(t *testing.T) {
+	client := storetest.OpenClient(t, "notify")
+	ctx := context.Background()
+
+	class := client.AssetClass.Create().SetName("Certificate").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("not_after").
+		SetName("Not after").
+		SetType(attributedefinition.TypeDate).
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.Item.Create().
+		SetName("www").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"not_after": time.Now().UTC().Format(attributes.DateLayout)}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	broken := client.NotificationChannel.Create().
+		SetName("Broken").
+		SetType(notificationchannel.TypeSlack).
+		SetConfig(map[string]string{"url": "http://127.0.0.1:1/hook"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.ReminderRule.Create().
+		SetAssetClass(class).
+		SetDays([]int{14}).
+		AddChannels(broken).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	if _, err := RunExpiryJob(ctx, client, nil); err == nil || !strings.Contains(err.Error(), "channel Broken") {
+		t.Errorf("Expected failed channel to fail the job, got %v", err)
+	}
+
+	// failed reminders are retried on the next run
+	if count := client.NotificationDelivery.Query().CountX(ctx); count != 0 {
+		t.Errorf("Expected no deliveries, got %d", count)
+	}
+}
@@ -0,0 +1,306 @@
+package probe
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"dig-inv/ent/reachability"
+	"dig-inv/notify"
+	"dig-inv/worker"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JobType is the type of the job runs that probe the reachability of items. The comma-separated `probes` parameter
+// lists the IDs of the probes to run, by default all enabled probes are run.
+const JobType = "reachability_probe"
+
+// the number of targets that are probed at the same time
+const parallelProbes = 16
+
+type target struct {
+	probe  *ent.Probe
+	item   *ent.Item
+	target string
+	result Result
+}
+
+// RunProbeJob is the worker handler of reachability probes. Every result is recorded, and the reachability of the
+// probed items is updated: an item is down if one of its probes failed. The channels of the probes are told when an
+// item goes down or comes back up. Targets that are down do not fail the job.
+func RunProbeJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	targets, err := probeTargets(ctx, client, run.Parameters["probes"])
+	if err != nil {
+		return "", err
+	}
+
+	var done int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelProbes)
+	for _, t := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			t.result = Run(ctx, t.probe, t.target)
+
+			mu.Lock()
+			done++
+			worker.ReportProgress(ctx, done*100/len(targets))
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	byItem := make(map[uuid.UUID][]*target)
+	var order []uuid.UUID
+	for _, t := range targets {
+		err := client.ProbeResult.Create().
+			SetProbe(t.probe).
+			SetItem(t.item).
+			SetTarget(t.target).
+			SetStatus(resultStatus(t.result)).
+			SetLatencyMs(float64(t.result.Latency.Microseconds()) / 1000).
+			SetStatusCode(t.result.StatusCode).
+			SetError(t.result.Error).
+			SetCreatedBy(worker.Subject).
+			SetUpdatedBy(worker.Subject).
+			Exec(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to record result of %s: %w", t.target, err)
+		}
+
+		if _, ok := byItem[t.item.ID]; !ok {
+			order = append(order, t.item.ID)
+		}
+		byItem[t.item.ID] = append(byItem[t.item.ID], t)
+	}
+
+	var up, down, changed int
+	for _, id := range order {
+		state, changedNow, err := updateReachability(ctx, client, byItem[id])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if state == reachability.StateUp {
+			up++
+		} else {
+			down++
+		}
+
+		if changedNow {
+			changed++
+			errs = append(errs, notifyChange(ctx, byItem[id], state)...)
+		}
+	}
+
+	result := fmt.Sprintf("probed %d items, %d up, %d down, %d state changes", len(order), up, down, changed)
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%s, %d failed: %w", result, len(errs), errors.Join(errs...))
+	}
+
+	return result, nil
+}
+
+// probeTargets returns the targets of the enabled probes, one for every probed item.
+func probeTargets(ctx context.Context, client *ent.Client, ids string) ([]*target, error) {
+	query := client.Probe.Query().
+		Where(probe.Enabled(true), probe.DeletedAtIsNil()).
+		WithItem(func(q *ent.ItemQuery) {
+			q.Where(item.DeletedAtIsNil()).WithAssetClass()
+		}).
+		WithAssetClass(func(q *ent.AssetClassQuery) {
+			q.Where(assetclass.DeletedAtIsNil())
+		}).
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.Enabled(true), notificationchannel.DeletedAtIsNil())
+		})
+
+	if ids != "" {
+		probeIds := make([]uuid.UUID, 0)
+		for _, id := range strings.FieldsFunc(ids, func(r rune) bool { return r == ',' || r == ' ' }) {
+			probeId, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("invalid probe %q: %w", id, err)
+			}
+			probeIds = append(probeIds, probeId)
+		}
+		query = query.Where(probe.IDIn(probeIds...))
+	}
+
+	probes, err := query.Order(probe.ByName(), probe.ByID()).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query probes: %w", err)
+	}
+
+	var targets []*target
+	for _, p := range probes {
+		items := make([]*ent.Item, 0)
+		if p.Edges.Item != nil {
+			items = append(items, p.Edges.Item)
+		}
+
+		if class := p.Edges.AssetClass; class != nil {
+			classItems, err := client.Item.Query().
+				Where(item.DeletedAtIsNil(), item.HasAssetClassWith(assetclass.ID(class.ID))).
+				WithAssetClass().
+				Order(item.ByName(), item.ByID()).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query items of %s: %w", class.Name, err)
+			}
+			items = append(items, classItems...)
+		}
+
+		for _, i := range items {
+			t, err := Target(p, i)
+			if err != nil {
+				worker.Logf(ctx, "Skipped %s of %s: %v", p.Name, i.Name, err)
+				continue
+			}
+			targets = append(targets, &target{probe: p, item: i, target: t})
+		}
+	}
+
+	return targets, nil
+}
+
+// updateReachability stores the state of the item and returns whether it changed. The first state of an item only
+// counts as change if the item is down.
+func updateReachability(ctx context.Context, client *ent.Client, targets []*target) (reachability.State, bool, error) {
+	i := targets[0].item
+	now := time.Now()
+
+	state := reachability.StateUp
+	seen := false
+	for _, t := range targets {
+		if t.result.Up {
+			seen = true
+		} else {
+			state = reachability.StateDown
+		}
+	}
+
+	current, err := client.Reachability.Query().Where(reachability.HasItemWith(item.ID(i.ID))).Only(ctx)
+	if ent.IsNotFound(err) {
+		create := client.Reachability.Create().
+			SetItem(i).
+			SetState(state).
+			SetChangedAt(now).
+			SetCreatedBy(worker.Subject).
+			SetUpdatedBy(worker.Subject)
+		if seen {
+			create.SetLastSeenAt(now)
+		}
+		if err := create.Exec(ctx); err != nil {
+			return state, false, fmt.Errorf("failed to create reachability of %s: %w", i.Name, err)
+		}
+		return state, state == reachability.StateDown, nil
+	}
+	if err != nil {
+		return state, false, fmt.Errorf("failed to query reachability of %s: %w", i.Name, err)
+	}
+
+	update := client.Reachability.UpdateOne(current).
+		SetState(state).
+		SetUpdatedBy(worker.Subject)
+	if current.State != state {
+		update.SetChangedAt(now)
+	}
+	if seen {
+		update.SetLastSeenAt(now)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return state, false, fmt.Errorf("failed to update reachability of %s: %w", i.Name, err)
+	}
+
+	return state, current.State != state, nil
+}
+
+// notifyChange tells the channels of the probes of the item about its new state.
+func notifyChange(ctx context.Context, targets []*target, state reachability.State) []error {
+	msg := StateMessage(targets[0].item, state, failures(targets))
+
+	var errs []error
+	sent := make(map[uuid.UUID]bool)
+	for _, t := range targets {
+		for _, channel := range t.probe.Edges.Channels {
+			if sent[channel.ID] {
+				continue
+			}
+			sent[channel.ID] = true
+
+			sender, err := notify.NewSender(channel)
+			if err == nil {
+				err = sender.Send(ctx, msg)
+			}
+			if err != nil {
+				worker.Logf(ctx, "Failed to send state of %s to %s: %v", msg.ItemName, channel.Name, err)
+				errs = append(errs, fmt.Errorf("channel %s: %w", channel.Name, err))
+				continue
+			}
+
+			worker.Logf(ctx, "Sent %s state of %s to %s", state, msg.ItemName, channel.Name)
+		}
+	}
+
+	return errs
+}
+
+func failures(targets []*target) []string {
+	var res []string
+	for _, t := range targets {
+		if !t.result.Up {
+			res = append(res, fmt.Sprintf("%s (%s): %s", t.probe.Name, t.target, t.result.Error))
+		}
+	}
+
+	return res
+}
+
+// StateMessage describes that an item went down, with the failed probes, or came back up.
+func StateMessage(i *ent.Item, state reachability.State, failures []string) notify.Message {
+	class := ""
+	if i.Edges.AssetClass != nil {
+		class = i.Edges.AssetClass.Name
+	}
+
+	msg := notify.Message{
+		Event:      "reachability",
+		Subject:    fmt.Sprintf("%s is up again", i.Name),
+		Text:       fmt.Sprintf("%s %s is reachable again.", class, i.Name),
+		ItemID:     i.ID.String(),
+		ItemName:   i.Name,
+		AssetClass: class,
+		State:      string(state),
+	}
+
+	if state == reachability.StateDown {
+		msg.Subject = fmt.Sprintf("%s is down", i.Name)
+		msg.Text = fmt.Sprintf("%s %s is not reachable:\n%s", class, i.Name, strings.Join(failures, "\n"))
+	}
+
+	return msg
+}
+
+func resultStatus(res Result) proberesult.Status {
+	if res.Up {
+		return proberesult.StatusUp
+	}
+
+	return proberesult.StatusDown
+}
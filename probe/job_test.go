@@ -0,0 +1,205 @@
+package probe
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	"dig-inv/ent/reachability"
+	"dig-inv/store/storetest"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func port(t *testing.T, addr string) int {
+	_, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		t.Fatalf("Invalid address %s: %v", addr, err)
+	}
+
+	res, _ := strconv.Atoi(p)
+	return res
+}
+
+func TestRunProbeJob(t *testing.T) {
+	client := storetest.OpenClient(t, "probe")
+	ctx := context.Background()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.Close()
+		}
+	}()
+
+	var healthy atomic.Bool
+	healthy.Store(true)
+	service := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer service.Close()
+
+	hooks := make(chan map[string]any, 10)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		hooks <- body
+	}))
+	defer receiver.Close()
+
+	servers := client.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	web := client.Item.Create().
+		SetName("web-1").
+		SetAssetClass(servers).
+		SetAttributes(map[string]any{"hostname": "127.0.0.1"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	db := client.Item.Create().
+		SetName("db-1").
+		SetAssetClass(servers).
+		SetAttributes(map[string]any{"ip_address": "127.0.0.1"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	// not a host name
+	client.Item.Create().SetName("Rack 4").SetAssetClass(servers).SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+	client.Item.Create().
+		SetName("old-1").
+		SetAssetClass(servers).
+		SetDeletedAt(time.Now()).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	hook := client.NotificationChannel.Create().
+		SetName("Hook").
+		SetType(notificationchannel.TypeWebhook).
+		SetConfig(map[string]string{"url": receiver.URL}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	client.Probe.Create().
+		SetName("SSH").
+		SetType(probe.TypeTCP).
+		SetPort(port(t, listener.Addr().String())).
+		SetAssetClass(servers).
+		AddChannels(hook).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	client.Probe.Create().
+		SetName("Health").
+		SetType(probe.TypeHTTP).
+		SetPort(port(t, service.Listener.Addr().String())).
+		SetPath("/healthz").
+		SetItem(web).
+		AddChannels(hook).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	client.Probe.Create().
+		SetName("Disabled").
+		SetType(probe.TypeTCP).
+		SetPort(1).
+		SetItem(db).
+		SetEnabled(false).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	run := func(expected string) {
+		t.Helper()
+		result, err := RunProbeJob(ctx, client, &ent.JobRun{Parameters: map[string]string{}})
+		if err != nil {
+			t.Fatalf("Failed to run probes: %v", err)
+		}
+
+		if result != expected {
+			t.Errorf("Expected %q, got %q", expected, result)
+		}
+	}
+
+	// items that are up from the start are not announced
+	run("probed 2 items, 2 up, 0 down, 0 state changes")
+
+	healthy.Store(false)
+	run("probed 2 items, 1 up, 1 down, 1 state changes")
+
+	body := <-hooks
+	if body["event"] != "reachability" || body["state"] != "down" || body["item_name"] != "web-1" {
+		t.Errorf("Unexpected notification: %v", body)
+	}
+
+	down := client.Reachability.Query().Where(reachability.HasItemWith(item.ID(web.ID))).OnlyX(ctx)
+	if down.State != reachability.StateDown || down.LastSeenAt == nil {
+		t.Errorf("Unexpected reachability: %+v", down)
+	}
+
+	// only changes are announced
+	run("probed 2 items, 1 up, 1 down, 0 state changes")
+	if unchanged := client.Reachability.GetX(ctx, down.ID); !unchanged.ChangedAt.Equal(down.ChangedAt) {
+		t.Errorf("Expected change time to be kept, got %v", unchanged.ChangedAt)
+	}
+
+	healthy.Store(true)
+	run("probed 2 items, 2 up, 0 down, 1 state changes")
+
+	body = <-hooks
+	if body["state"] != "up" || body["item_name"] != "web-1" {
+		t.Errorf("Unexpected notification: %v", body)
+	}
+
+	select {
+	case body := <-hooks:
+		t.Errorf("Unexpected notification: %v", body)
+	default:
+	}
+
+	results := client.ProbeResult.Query().Where(proberesult.HasItemWith(item.ID(web.ID))).AllX(ctx)
+	if len(results) != 8 {
+		t.Errorf("Expected 8 results of web-1, got %d", len(results))
+	}
+
+	failed := client.ProbeResult.Query().Where(proberesult.StatusEQ(proberesult.StatusDown)).AllX(ctx)
+	if len(failed) != 2 || failed[0].StatusCode != http.StatusServiceUnavailable || failed[0].Error == "" {
+		t.Errorf("Unexpected failed results: %+v", failed)
+	}
+
+	if n := client.ProbeResult.Query().Where(proberesult.HasItemWith(item.ID(db.ID))).CountX(ctx); n != 4 {
+		t.Errorf("Expected 4 results of db-1, got %d", n)
+	}
+}
+
+func TestStateMessage(t *testing.T) {
+	i := &ent.Item{Name: "web-1", Edges: ent.ItemEdges{AssetClass: &ent.AssetClass{Name: "Server"}}}
+
+	msg := StateMessage(i, reachability.StateDown, []string{"SSH (web-1:22): connection refused"})
+	if msg.Subject != "web-1 is down" || msg.Text != "Server web-1 is not reachable:\nSSH (web-1:22): connection refused" {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+
+	msg = StateMessage(i, reachability.StateUp, nil)
+	if msg.Subject != "web-1 is up again" || msg.State != "up" || msg.Event != "reachability" {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+}
@@ -0,0 +1,134 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"dig-inv/ent"
+	"dig-inv/ent/probe"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// hostAttributes hold the host of an item, probes without host use the first one that is set or the item name
+var hostAttributes = []string{"hostname", "fqdn", "ip_address"}
+
+// httpClient does not follow redirects, as a redirect already shows that the server is up. Certificates are not
+// verified, untrusted certificates are reported by the certificate check instead.
+var httpClient = &http.Client{
+	Transport: &http.Transport{
+		TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+		DisableKeepAlives: true,
+	},
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// Result is the outcome of probing a target once.
+type Result struct {
+	Target     string
+	Up         bool
+	Latency    time.Duration
+	StatusCode int
+	Error      string
+}
+
+// Target returns the host:port of TCP probes or the URL of HTTP probes of the item.
+func Target(p *ent.Probe, i *ent.Item) (string, error) {
+	host := strings.TrimSpace(p.Host)
+	for _, key := range hostAttributes {
+		if host != "" {
+			break
+		}
+		host, _ = i.Attributes[key].(string)
+		host = strings.TrimSpace(host)
+	}
+	if host == "" {
+		host = strings.TrimSpace(i.Name)
+	}
+	host = strings.Trim(host, "[]")
+
+	if host == "" || strings.ContainsAny(host, " /") {
+		return "", fmt.Errorf("invalid host %q", host)
+	}
+
+	port := p.Port
+	switch p.Type {
+	case probe.TypeTCP:
+		if port == 0 {
+			return "", errors.New("tcp probes require a port")
+		}
+		return net.JoinHostPort(host, strconv.Itoa(port)), nil
+	case probe.TypeHTTP, probe.TypeHTTPS:
+		u := url.URL{Scheme: string(p.Type), Host: host, Path: "/"}
+		if strings.Contains(host, ":") {
+			// IPv6 addresses have to be bracketed
+			u.Host = "[" + host + "]"
+		}
+		if port != 0 {
+			u.Host = net.JoinHostPort(host, strconv.Itoa(port))
+		}
+		if p.Path != "" {
+			u.Path = "/" + strings.TrimPrefix(p.Path, "/")
+		}
+		return u.String(), nil
+	}
+
+	return "", fmt.Errorf("unknown probe type %s", p.Type)
+}
+
+// Run probes the target and measures the time until the connection is established or the response headers are
+// received.
+func Run(ctx context.Context, p *ent.Probe, target string) Result {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(p.Timeout)*time.Second)
+	defer cancel()
+
+	res := Result{Target: target}
+	start := time.Now()
+
+	if p.Type == probe.TypeTCP {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", target)
+		res.Latency = time.Since(start)
+		if err != nil {
+			res.Error = err.Error()
+			return res
+		}
+		_ = conn.Close()
+
+		res.Up = true
+		return res
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	response, err := httpClient.Do(req)
+	res.Latency = time.Since(start)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+	_ = response.Body.Close()
+
+	res.StatusCode = response.StatusCode
+	switch {
+	case p.ExpectedStatus != 0 && response.StatusCode != p.ExpectedStatus:
+		res.Error = fmt.Sprintf("responded with %s, expected %d", response.Status, p.ExpectedStatus)
+	case p.ExpectedStatus == 0 && response.StatusCode >= 400:
+		res.Error = "responded with " + response.Status
+	default:
+		res.Up = true
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+package probe
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/probe"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// closedPort returns a local address nothing listens on.
+func closedPort(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	return addr
+}
+
+func TestTarget(t *testing.T) {
+	server := &ent.Item{Name: "web-1", Attributes: map[string]any{"fqdn": "web-1.example.org"}}
+	unnamed := &ent.Item{Name: "Rack 4"}
+	ipv6 := &ent.Item{Name: "db-1", Attributes: map[string]any{"ip_address": "2001:db8::1"}}
+
+	tests := []struct {
+		probe    *ent.Probe
+		item     *ent.Item
+		expected string
+	}{
+		{&ent.Probe{Type: probe.TypeTCP, Port: 22}, server, "web-1.example.org:22"},
+		{&ent.Probe{Type: probe.TypeTCP, Port: 22, Host: "10.0.0.1"}, server, "10.0.0.1:22"},
+		{&ent.Probe{Type: probe.TypeTCP, Port: 5432}, ipv6, "[2001:db8::1]:5432"},
+		{&ent.Probe{Type: probe.TypeHTTPS}, server, "https://web-1.example.org/"},
+		{&ent.Probe{Type: probe.TypeHTTP, Port: 8080, Path: "healthz"}, server, "http://web-1.example.org:8080/healthz"},
+		{&ent.Probe{Type: probe.TypeHTTP}, ipv6, "http://[2001:db8::1]/"},
+		{&ent.Probe{Type: probe.TypeTCP}, server, ""},
+		{&ent.Probe{Type: probe.TypeTCP, Port: 22}, unnamed, ""},
+	}
+
+	for _, test := range tests {
+		target, err := Target(test.probe, test.item)
+		if test.expected == "" {
+			if err == nil {
+				t.Errorf("Expected %s probe of %s to fail, got %s", test.probe.Type, test.item.Name, target)
+			}
+			continue
+		}
+
+		if err != nil || target != test.expected {
+			t.Errorf("Expected %s, got %s (%v)", test.expected, target, err)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/healthz":
+			w.WriteHeader(http.StatusNoContent)
+		case "/old":
+			http.Redirect(w, r, "/healthz", http.StatusMovedPermanently)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	addr := strings.TrimPrefix(server.URL, "http://")
+
+	tests := []struct {
+		probe      *ent.Probe
+		target     string
+		up         bool
+		statusCode int
+	}{
+		{&ent.Probe{Type: probe.TypeTCP, Timeout: 1}, addr, true, 0},
+		{&ent.Probe{Type: probe.TypeTCP, Timeout: 1}, closedPort(t), false, 0},
+		{&ent.Probe{Type: probe.TypeHTTP, Timeout: 1}, server.URL + "/healthz", true, http.StatusNoContent},
+		{&ent.Probe{Type: probe.TypeHTTP, Timeout: 1}, server.URL + "/old", true, http.StatusMovedPermanently},
+		{&ent.Probe{Type: probe.TypeHTTP, Timeout: 1}, server.URL + "/missing", false, http.StatusNotFound},
+		{&ent.Probe{Type: probe.TypeHTTP, Timeout: 1, ExpectedStatus: 200}, server.URL + "/healthz", false, http.StatusNoContent},
+		{&ent.Probe{Type: probe.TypeHTTP, Timeout: 1}, "http://" + closedPort(t) + "/", false, 0},
+	}
+
+	for _, test := range tests {
+		res := Run(ctx, test.probe, test.target)
+		if res.Up != test.up || res.StatusCode != test.statusCode || res.Target != test.target {
+			t.Errorf("Unexpected result of %s: %+v", test.target, res)
+		}
+
+		if res.Up != (res.Error == "") {
+			t.Errorf("Expected error only for failed probes: %+v", res)
+		}
+
+		if res.Latency <= 0 {
+			t.Errorf("Expected latency to be measured: %+v", res)
+		}
+	}
+}
+
+func TestRunHTTPS(t *testing.T) {
+	// the self-signed certificate of the test server does not make it unreachable
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	res := Run(context.Background(), &ent.Probe{Type: probe.TypeHTTPS, Timeout: 1}, server.URL+"/")
+	if !res.Up || res.StatusCode != http.StatusOK {
+		t.Errorf("Unexpected result: %+v", res)
+	}
+}
@@ -0,0 +1,321 @@
+package querylang
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/usergroup"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// relative points in time such as 30d or -7d, resolved against the current time
+var relativeTimePattern = regexp.MustCompile(`^([+-]?\d+)([hdwy])$`)
+
+// ItemPredicate parses a query and compiles it against the attribute definitions of all asset classes.
+// Syntax and type errors are returned as *Error.
+func ItemPredicate(ctx context.Context, client *ent.Client, input string) (predicate.Item, error) {
+	node, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	defs, err := client.AttributeDefinition.Query().
+		Where(
+			attributedefinition.DeletedAtIsNil(),
+			attributedefinition.HasAssetClassWith(assetclass.DeletedAtIsNil()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attribute definitions: %w", err)
+	}
+
+	return Compile(node, defs, time.Now())
+}
+
+type compiler struct {
+	defs map[string][]*ent.AttributeDefinition
+	now  time.Time
+}
+
+// Compile type-checks a parsed query against the attribute definitions and converts it to an item predicate.
+// Fields that are not built in refer to the attribute with that key.
+func Compile(node Node, defs []*ent.AttributeDefinition, now time.Time) (predicate.Item, error) {
+	c := &compiler{
+		defs: make(map[string][]*ent.AttributeDefinition),
+		now:  now,
+	}
+
+	for _, def := range defs {
+		c.defs[def.Key] = append(c.defs[def.Key], def)
+	}
+
+	return c.compile(node)
+}
+
+func (c *compiler) compile(node Node) (predicate.Item, error) {
+	switch n := node.(type) {
+	case *And:
+		terms, err := c.compileAll(n.Terms)
+		if err != nil {
+			return nil, err
+		}
+		return item.And(terms...), nil
+	case *Or:
+		terms, err := c.compileAll(n.Terms)
+		if err != nil {
+			return nil, err
+		}
+		return item.Or(terms...), nil
+	case *Not:
+		term, err := c.compile(n.Term)
+		if err != nil {
+			return nil, err
+		}
+		return item.Not(term), nil
+	case *Text:
+		return item.Or(item.NameContainsFold(n.Value), item.DescriptionContainsFold(n.Value)), nil
+	case *Comparison:
+		return c.comparison(n)
+	}
+
+	return nil, errorAt(node.Offset(), "unsupported query element")
+}
+
+func (c *compiler) compileAll(nodes []Node) ([]predicate.Item, error) {
+	res := make([]predicate.Item, 0, len(nodes))
+	for _, node := range nodes {
+		p, err := c.compile(node)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+func (c *compiler) comparison(n *Comparison) (predicate.Item, error) {
+	switch n.Field {
+	case "name":
+		return textComparison(n, item.NameContainsFold, item.NameEqualFold)
+	case "description":
+		return textComparison(n, item.DescriptionContainsFold, item.DescriptionEqualFold)
+	case "class":
+		return nameComparison(n, func(v string) predicate.Item {
+			return item.HasAssetClassWith(assetclass.NameEqualFold(v))
+		})
+	case "tag":
+		return nameComparison(n, func(v string) predicate.Item {
+			return item.HasTagsWith(tag.NameEqualFold(v), tag.DeletedAtIsNil())
+		})
+	case "group":
+		return nameComparison(n, func(v string) predicate.Item {
+			return item.HasUserGroupsWith(usergroup.NameEqualFold(v), usergroup.DeletedAtIsNil())
+		})
+	case "parent":
+		return nameComparison(n, func(v string) predicate.Item {
+			return item.HasParentWith(item.NameEqualFold(v))
+		})
+	case "created":
+		return c.timeComparison(n, item.CreatedAtGTE, item.CreatedAtLT, item.CreatedAtGT, item.CreatedAtLTE)
+	case "updated":
+		return c.timeComparison(n, item.UpdatedAtGTE, item.UpdatedAtLT, item.UpdatedAtGT, item.UpdatedAtLTE)
+	case "has":
+		if n.Operator != OperatorMatch {
+			return nil, errorAt(n.Offset(), "has only supports %s", OperatorMatch)
+		}
+
+		def, err := c.attribute(n.Value, n.ValueOffset())
+		if err != nil {
+			return nil, err
+		}
+		return attributes.Predicate(def, attributes.OperatorExists, nil)
+	}
+
+	return c.attributeComparison(n)
+}
+
+func textComparison(n *Comparison, contains, equal func(string) predicate.Item) (predicate.Item, error) {
+	switch n.Operator {
+	case OperatorMatch:
+		return contains(n.Value), nil
+	case OperatorEQ:
+		return equal(n.Value), nil
+	case OperatorNEQ:
+		return item.Not(equal(n.Value)), nil
+	}
+
+	return nil, unsupportedOperator(n, "text")
+}
+
+func nameComparison(n *Comparison, matches func(string) predicate.Item) (predicate.Item, error) {
+	switch n.Operator {
+	case OperatorMatch, OperatorEQ:
+		return matches(n.Value), nil
+	case OperatorNEQ:
+		return item.Not(matches(n.Value)), nil
+	}
+
+	return nil, unsupportedOperator(n, "name")
+}
+
+func (c *compiler) timeComparison(n *Comparison, gte, lt, gt, lte func(time.Time) predicate.Item) (predicate.Item, error) {
+	at, err := c.parseTime(n)
+	if err != nil {
+		return nil, err
+	}
+
+	// dates match whole days in the location of the server
+	at = at.Local()
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.Local)
+
+	switch n.Operator {
+	case OperatorMatch, OperatorEQ:
+		return item.And(gte(day), lt(day.AddDate(0, 0, 1))), nil
+	case OperatorNEQ:
+		return item.Not(item.And(gte(day), lt(day.AddDate(0, 0, 1)))), nil
+	case OperatorLT:
+		return lt(at), nil
+	case OperatorLTE:
+		return lte(at), nil
+	case OperatorGT:
+		return gt(at), nil
+	case OperatorGTE:
+		return gte(at), nil
+	}
+
+	return nil, unsupportedOperator(n, "time")
+}
+
+// parseTime accepts dates, RFC 3339 timestamps and relative times such as 30d or -7d.
+func (c *compiler) parseTime(n *Comparison) (time.Time, error) {
+	if at, ok := c.relativeTime(n.Value); ok {
+		return at, nil
+	}
+
+	if at, err := time.ParseInLocation(attributes.DateLayout, n.Value, time.Local); err == nil {
+		return at, nil
+	}
+
+	if at, err := time.Parse(time.RFC3339, n.Value); err == nil {
+		return at, nil
+	}
+
+	return time.Time{}, errorAt(n.ValueOffset(), "%q is not a date, timestamp or relative time such as 30d", n.Value)
+}
+
+func (c *compiler) relativeTime(value string) (time.Time, bool) {
+	match := relativeTimePattern.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, false
+	}
+
+	amount, err := strconv.Atoi(match[1])
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	switch match[2] {
+	case "h":
+		return c.now.Add(time.Duration(amount) * time.Hour), true
+	case "d":
+		return c.now.AddDate(0, 0, amount), true
+	case "w":
+		return c.now.AddDate(0, 0, 7*amount), true
+	default:
+		return c.now.AddDate(amount, 0, 0), true
+	}
+}
+
+// attribute returns the definition of the attribute with the given key. Asset classes may define the same key,
+// as long as they agree on its type.
+func (c *compiler) attribute(key string, offset int) (*ent.AttributeDefinition, error) {
+	defs := c.defs[key]
+	if len(defs) == 0 {
+		return nil, errorAt(offset, "unknown field %q", key)
+	}
+
+	merged := *defs[0]
+	merged.EnumValues = slices.Clone(merged.EnumValues)
+	for _, def := range defs[1:] {
+		if def.Type != merged.Type {
+			return nil, errorAt(offset, "attribute %q is defined as %s and %s", key, merged.Type, def.Type)
+		}
+
+		for _, value := range def.EnumValues {
+			if !slices.Contains(merged.EnumValues, value) {
+				merged.EnumValues = append(merged.EnumValues, value)
+			}
+		}
+	}
+
+	return &merged, nil
+}
+
+func (c *compiler) attributeComparison(n *Comparison) (predicate.Item, error) {
+	def, err := c.attribute(n.Field, n.Offset())
+	if err != nil {
+		return nil, err
+	}
+
+	ordered := def.Type == attributedefinition.TypeNumber || def.Type == attributedefinition.TypeDate
+	textual := def.Type == attributedefinition.TypeString || def.Type == attributedefinition.TypeURL
+
+	var operator string
+	switch n.Operator {
+	case OperatorMatch:
+		operator = attributes.OperatorEQ
+		if textual {
+			operator = attributes.OperatorContains
+		}
+	case OperatorEQ:
+		operator = attributes.OperatorEQ
+	case OperatorNEQ:
+		operator = attributes.OperatorNEQ
+	case OperatorLT:
+		operator = attributes.OperatorLT
+	case OperatorLTE:
+		operator = attributes.OperatorLTE
+	case OperatorGT:
+		operator = attributes.OperatorGT
+	case OperatorGTE:
+		operator = attributes.OperatorGTE
+	}
+
+	if !ordered && n.Operator != OperatorMatch && n.Operator != OperatorEQ && n.Operator != OperatorNEQ {
+		return nil, unsupportedOperator(n, string(def.Type))
+	}
+
+	var value any = n.Value
+	if def.Type == attributedefinition.TypeDate {
+		if at, ok := c.relativeTime(n.Value); ok {
+			value = at.Format(attributes.DateLayout)
+		}
+	}
+
+	if operator != attributes.OperatorContains {
+		if value, err = attributes.Coerce(def, value); err != nil {
+			return nil, errorAt(n.ValueOffset(), "%v", err)
+		}
+	}
+
+	p, err := attributes.Predicate(def, operator, value)
+	if err != nil {
+		return nil, errorAt(n.ValueOffset(), "%v", err)
+	}
+
+	return p, nil
+}
+
+func unsupportedOperator(n *Comparison, kind string) *Error {
+	return errorAt(n.Offset(), "operator %s is not supported for %s field %q", n.Operator, kind, n.Field)
+}
@@ -0,0 +1,188 @@
+package querylang
+
+import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/store/storetest"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type compileTest struct {
+	Query    string
+	Expected string
+	ErrorPos int
+}
+
+func TestItemPredicate(t *testing.T) {
+	client := storetest.OpenClient(t, "querylang")
+	ctx := context.Background()
+	now := time.Now()
+	in := func(days int) string { return now.AddDate(0, 0, days).Format(attributes.DateLayout) }
+
+	server := client.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	domain := client.AssetClass.Create().SetName("Domain").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	for _, def := range []*ent.AttributeDefinition{
+		{Key: "location", Type: attributedefinition.TypeString},
+		{Key: "expires", Type: attributedefinition.TypeDate},
+		{Key: "seats", Type: attributedefinition.TypeNumber},
+		{Key: "tier", Type: attributedefinition.TypeEnum, EnumValues: []string{"std", "ent"}},
+	} {
+		client.AttributeDefinition.Create().
+			SetKey(def.Key).
+			SetName(def.Key).
+			SetType(def.Type).
+			SetEnumValues(def.EnumValues).
+			SetAssetClass(server).
+			SetCreatedBy("a").
+			SetUpdatedBy("a").
+			SaveX(ctx)
+	}
+	client.AttributeDefinition.Create().
+		SetKey("expires").
+		SetName("Expires").
+		SetType(attributedefinition.TypeDate).
+		SetAssetClass(domain).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetKey("seats").
+		SetName("Seats").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(domain).
+		SetDeletedAt(now).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	prod := client.Tag.Create().SetName("prod").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	ops := client.UserGroup.Create().SetName("ops").SetOidcScope("ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	rack := client.Item.Create().SetName("rack-a").SetAssetClass(server).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.Item.Create().
+		SetName("web-1").
+		SetAssetClass(server).
+		AddTags(prod).
+		SetAttributes(map[string]any{"location": "fsn1", "expires": in(10), "seats": 4.0, "tier": "std"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.Item.Create().
+		SetName("web-2").
+		SetDescription("staging web server").
+		SetAssetClass(server).
+		SetParent(rack).
+		AddUserGroups(ops).
+		SetAttributes(map[string]any{"location": "nbg1", "expires": in(90), "seats": 16.0, "tier": "ent"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.Item.Create().
+		SetName("example.com").
+		SetDescription("primary domain").
+		SetAssetClass(domain).
+		SetAttributes(map[string]any{"expires": in(20)}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	tests := []compileTest{
+		{"web", "web-1 web-2", 0},
+		{"domain", "example.com", 0},
+		{`"staging web"`, "web-2", 0},
+		{"class:server", "rack-a web-1 web-2", 0},
+		{"class:SERVER -tag:prod", "rack-a web-2", 0},
+		{"class!=server", "example.com", 0},
+		{"tag:prod", "web-1", 0},
+		{"group:ops", "web-2", 0},
+		{"parent:rack-a", "web-2", 0},
+		{"name=web-1", "web-1", 0},
+		{"name:WEB", "web-1 web-2", 0},
+		{"description:primary", "example.com", 0},
+		{"location=fsn1", "web-1", 0},
+		{"location:fsn", "web-1", 0},
+		{"location!=fsn1", "web-2", 0},
+		{"expires<30d", "example.com web-1", 0},
+		{"expires>=" + in(20), "example.com web-2", 0},
+		{"class:server expires<30d", "web-1", 0},
+		{"seats>4", "web-2", 0},
+		{"seats>=4 seats<=16", "web-1 web-2", 0},
+		{"tier:ent OR tag:prod", "web-1 web-2", 0},
+		{"has:tier", "web-1 web-2", 0},
+		{"created>-1h", "example.com rack-a web-1 web-2", 0},
+		{"created:" + now.Format(attributes.DateLayout), "example.com rack-a web-1 web-2", 0},
+		{"updated<-1d", "", 0},
+		{"colour:red", "", 1},
+		{"web colour:red", "", 5},
+		{"seats>many", "", 7},
+		{"tier:gold", "", 6},
+		{"location<fsn1", "", 1},
+		{"tag>prod", "", 1},
+		{"created>soon", "", 9},
+		{"has=tier", "", 1},
+		{"has:colour", "", 5},
+		{"expires<30x", "", 9},
+	}
+
+	for _, test := range tests {
+		p, err := ItemPredicate(ctx, client, test.Query)
+		if test.ErrorPos > 0 {
+			var queryErr *Error
+			if !errors.As(err, &queryErr) {
+				t.Errorf("Expected error for %q, got %v", test.Query, err)
+				continue
+			}
+
+			if queryErr.Pos != test.ErrorPos {
+				t.Errorf("Expected error at position %d for %q, got %v", test.ErrorPos, test.Query, queryErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", test.Query, err)
+			continue
+		}
+
+		items, err := client.Item.Query().Where(p).Order(item.ByName()).All(ctx)
+		if err != nil {
+			t.Errorf("Failed to run %q: %v", test.Query, err)
+			continue
+		}
+
+		names := make([]string, 0, len(items))
+		for _, i := range items {
+			names = append(names, i.Name)
+		}
+		slices.Sort(names)
+
+		if strings.Join(names, " ") != test.Expected {
+			t.Errorf("Expected %q for %q, got %q", test.Expected, test.Query, strings.Join(names, " "))
+		}
+	}
+}
+
+func TestCompileConflictingAttributeTypes(t *testing.T) {
+	node, err := Parse("size:1")
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+
+	_, err = Compile(node, []*ent.AttributeDefinition{
+		{Key: "size", Type: attributedefinition.TypeNumber},
+		{Key: "size", Type: attributedefinition.TypeString},
+	}, time.Now())
+
+	var queryErr *Error
+	if !errors.As(err, &queryErr) || queryErr.Pos != 1 {
+		t.Errorf("Expected type conflict at position 1, got %v", err)
+	}
+}
@@ -0,0 +1,344 @@
+package querylang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error is a syntax or type error in a query. Pos is the 1-based position of the offending character.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Pos, e.Msg)
+}
+
+func errorAt(offset int, format string, args ...any) *Error {
+	return &Error{Pos: offset + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+const (
+	OperatorMatch = ":"
+	OperatorEQ    = "="
+	OperatorNEQ   = "!="
+	OperatorLT    = "<"
+	OperatorLTE   = "<="
+	OperatorGT    = ">"
+	OperatorGTE   = ">="
+)
+
+// Node is an element of a parsed query.
+type Node interface {
+	// Offset returns the 0-based byte offset of the node in the query.
+	Offset() int
+	String() string
+}
+
+type And struct {
+	Terms []Node
+}
+
+type Or struct {
+	Terms []Node
+}
+
+type Not struct {
+	Term   Node
+	offset int
+}
+
+// Comparison compares a field of an item with a value, e.g. class:server or expires<30d.
+type Comparison struct {
+	Field       string
+	Operator    string
+	Value       string
+	offset      int
+	valueOffset int
+}
+
+// Text matches a word or quoted phrase in the name or description of an item.
+type Text struct {
+	Value  string
+	offset int
+}
+
+func (n *And) Offset() int        { return n.Terms[0].Offset() }
+func (n *Or) Offset() int         { return n.Terms[0].Offset() }
+func (n *Not) Offset() int        { return n.offset }
+func (n *Comparison) Offset() int { return n.offset }
+func (n *Text) Offset() int       { return n.offset }
+
+// ValueOffset returns the 0-based byte offset of the value of the comparison.
+func (n *Comparison) ValueOffset() int { return n.valueOffset }
+
+func (n *And) String() string { return joinNodes("and", n.Terms) }
+func (n *Or) String() string  { return joinNodes("or", n.Terms) }
+func (n *Not) String() string { return "(not " + n.Term.String() + ")" }
+func (n *Comparison) String() string {
+	return fmt.Sprintf("(%s %s %q)", n.Field, n.Operator, n.Value)
+}
+func (n *Text) String() string { return fmt.Sprintf("%q", n.Value) }
+
+func joinNodes(kind string, nodes []Node) string {
+	parts := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		parts = append(parts, node.String())
+	}
+
+	return "(" + kind + " " + strings.Join(parts, " ") + ")"
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenMinus
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	offset int
+}
+
+// characters that end a word
+const specialCharacters = `()":<>=!`
+
+func lex(input string) ([]token, error) {
+	tokens := make([]token, 0)
+
+	for i := 0; i < len(input); {
+		c := input[i]
+
+		switch {
+		case isSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, value: "(", offset: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, value: ")", offset: i})
+			i++
+		case c == '"':
+			var b strings.Builder
+			start := i
+			i++
+			for {
+				if i >= len(input) {
+					return nil, errorAt(start, "unterminated string")
+				}
+				if input[i] == '\\' && i+1 < len(input) {
+					b.WriteByte(input[i+1])
+					i += 2
+					continue
+				}
+				if input[i] == '"' {
+					i++
+					break
+				}
+				b.WriteByte(input[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, value: b.String(), offset: start})
+		case strings.IndexByte(":<>=!", c) >= 0:
+			operator := string(c)
+			if i+1 < len(input) && input[i+1] == '=' && c != ':' && c != '=' {
+				operator += "="
+			}
+			if operator == "!" {
+				return nil, errorAt(i, "unexpected %q, did you mean !=", "!")
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: operator, offset: i})
+			i += len(operator)
+		case c == '-' && (len(tokens) == 0 || tokens[len(tokens)-1].kind != tokenOperator):
+			// a leading minus negates the following term, values such as -7d directly follow an operator
+			tokens = append(tokens, token{kind: tokenMinus, value: "-", offset: i})
+			i++
+		default:
+			start := i
+			for i < len(input) && !isSpace(input[i]) && strings.IndexByte(specialCharacters, input[i]) < 0 {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, value: input[start:i], offset: start})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, offset: len(input)}), nil
+}
+
+// only ASCII whitespace separates terms, the input is scanned byte by byte
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse parses a query such as `class:server tag:prod expires<30d location=fsn1`.
+// Terms separated by whitespace must all match, OR combines alternatives, NOT or a leading minus negates a term
+// and parentheses group terms.
+func Parse(input string) (Node, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, errorAt(0, "empty query")
+	}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, errorAt(t.offset, "unexpected %q", t.value)
+	}
+
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func isKeyword(t token, keyword string) bool {
+	return t.kind == tokenWord && t.value == keyword
+}
+
+func (p *parser) parseOr() (Node, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []Node{first}
+	for isKeyword(p.peek(), "OR") {
+		p.next()
+
+		term, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+
+	if len(terms) == 1 {
+		return first, nil
+	}
+
+	return &Or{Terms: terms}, nil
+}
+
+func (p *parser) parseAnd() (Node, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	terms := []Node{first}
+	for {
+		t := p.peek()
+		if t.kind == tokenEOF || t.kind == tokenRParen || isKeyword(t, "OR") {
+			break
+		}
+
+		if isKeyword(t, "AND") {
+			p.next()
+		}
+
+		term, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+
+	if len(terms) == 1 {
+		return first, nil
+	}
+
+	return &And{Terms: terms}, nil
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	t := p.peek()
+	if t.kind == tokenMinus || isKeyword(t, "NOT") {
+		p.next()
+
+		term, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Not{Term: term, offset: t.offset}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, errorAt(closing.offset, "missing closing parenthesis for %q at position %d", "(", t.offset+1)
+		}
+
+		return node, nil
+	case tokenString:
+		return &Text{Value: t.value, offset: t.offset}, nil
+	case tokenWord:
+		if t.value == "AND" || t.value == "OR" {
+			return nil, errorAt(t.offset, "unexpected %s", t.value)
+		}
+
+		operator := p.peek()
+		if operator.kind != tokenOperator {
+			return &Text{Value: t.value, offset: t.offset}, nil
+		}
+		p.next()
+
+		value := p.next()
+		if value.kind != tokenWord && value.kind != tokenString {
+			return nil, errorAt(value.offset, "missing value for %s", t.value)
+		}
+
+		return &Comparison{
+			Field:       strings.ToLower(t.value),
+			Operator:    operator.value,
+			Value:       value.value,
+			offset:      t.offset,
+			valueOffset: value.offset,
+		}, nil
+	case tokenEOF:
+		return nil, errorAt(t.offset, "unexpected end of query")
+	default:
+		return nil, errorAt(t.offset, "unexpected %q", t.value)
+	}
+}
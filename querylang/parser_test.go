@@ -0,0 +1,68 @@
+package querylang
+
+import (
+	"errors"
+	"testing"
+)
+
+type parseTest struct {
+	Input    string
+	Expected string
+	ErrorPos int
+}
+
+func TestParse(t *testing.T) {
+	tests := []parseTest{
+		{"server", `"server"`, 0},
+		{`"web server"`, `"web server"`, 0},
+		{"class:server", `(class : "server")`, 0},
+		{"Class:Server", `(class : "Server")`, 0},
+		{"class:server tag:prod expires<30d location=fsn1", `(and (class : "server") (tag : "prod") (expires < "30d") (location = "fsn1"))`, 0},
+		{"created>=-7d", `(created >= "-7d")`, 0},
+		{"seats<=10 seats>2 seats!=5", `(and (seats <= "10") (seats > "2") (seats != "5"))`, 0},
+		{"tag:prod OR tag:staging", `(or (tag : "prod") (tag : "staging"))`, 0},
+		{"a b OR c", `(or (and "a" "b") "c")`, 0},
+		{"a AND b", `(and "a" "b")`, 0},
+		{"-tag:prod", `(not (tag : "prod"))`, 0},
+		{"NOT (tag:prod OR tag:dev) host-1", `(and (not (or (tag : "prod") (tag : "dev"))) "host-1")`, 0},
+		{`name:"web 1"`, `(name : "web 1")`, 0},
+		{`"say \"hi\""`, `"say \"hi\""`, 0},
+		{"ip:10.0.0.1", `(ip : "10.0.0.1")`, 0},
+		{"größe:groß", `(größe : "groß")`, 0},
+		{"", "", 1},
+		{"   ", "", 1},
+		{"class:", "", 7},
+		{"class:(", "", 7},
+		{"(tag:prod", "", 10},
+		{"tag:prod)", "", 9},
+		{`"open`, "", 1},
+		{"a OR", "", 5},
+		{"OR a", "", 1},
+		{"a ! b", "", 3},
+	}
+
+	for _, test := range tests {
+		node, err := Parse(test.Input)
+		if test.ErrorPos > 0 {
+			var queryErr *Error
+			if !errors.As(err, &queryErr) {
+				t.Errorf("Expected error for %q, got %v", test.Input, node)
+				continue
+			}
+
+			if queryErr.Pos != test.ErrorPos {
+				t.Errorf("Expected error at position %d for %q, got %v", test.ErrorPos, test.Input, queryErr)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Unexpected error for %q: %v", test.Input, err)
+			continue
+		}
+
+		if node.String() != test.Expected {
+			t.Errorf("Expected %s for %q, got %s", test.Expected, test.Input, node.String())
+		}
+	}
+}
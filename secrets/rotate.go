@@ -0,0 +1,87 @@
+package secrets
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"fmt"
+)
+
+// Rotate re-encrypts all stored secrets of provider accounts and secret item attributes, including those of deleted
+// accounts and items, with new data keys under the current master key. Either all secrets are re-encrypted or none, so previous master keys can be removed once it succeeded.
+// It returns the number of re-encrypted secrets.
+func Rotate(ctx context.Context, client *ent.Client, keyring *Keyring, user string) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	count, err := rotate(ctx, tx.Client(), keyring, user)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit re-encrypted secrets: %w", err)
+	}
+
+	return count, nil
+}
+
+func rotate(ctx context.Context, client *ent.Client, keyring *Keyring, user string) (int, error) {
+	accounts, err := client.ProviderAccount.Query().All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query provider accounts: %w", err)
+	}
+
+	var count int
+	for _, account := range accounts {
+		if len(account.Secrets) == 0 {
+			continue
+		}
+
+		encrypted, err := keyring.reencrypt(account.Secrets)
+		if err != nil {
+			return 0, fmt.Errorf("account %s: %w", account.Name, err)
+		}
+
+		if err := client.ProviderAccount.UpdateOne(account).SetSecrets(encrypted).SetUpdatedBy(user).Exec(ctx); err != nil {
+			return 0, fmt.Errorf("failed to update account %s: %w", account.Name, err)
+		}
+		count += len(encrypted)
+	}
+
+	items, err := client.Item.Query().Select(item.FieldSecrets).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query items: %w", err)
+	}
+
+	for _, i := range items {
+		if len(i.Secrets) == 0 {
+			continue
+		}
+
+		encrypted, err := keyring.reencrypt(i.Secrets)
+		if err != nil {
+			return 0, fmt.Errorf("item %s: %w", i.ID, err)
+		}
+
+		if err := client.Item.UpdateOneID(i.ID).SetSecrets(encrypted).SetUpdatedBy(user).Exec(ctx); err != nil {
+			return 0, fmt.Errorf("failed to update item %s: %w", i.ID, err)
+		}
+		count += len(encrypted)
+	}
+
+	return count, nil
+}
+
+func (k *Keyring) reencrypt(values map[string]schema.EncryptedValue) (map[string]schema.EncryptedValue, error) {
+	plaintext, err := k.DecryptAll(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.EncryptAll(plaintext)
+}
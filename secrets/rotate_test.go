@@ -0,0 +1,79 @@
+package secrets
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/schema"
+	"dig-inv/store/storetest"
+	"testing"
+	"time"
+)
+
+func TestRotate(t *testing.T) {
+	client := storetest.OpenClient(t, "secrets")
+	ctx := context.Background()
+
+	old, _ := NewKeyring(testKey('a'))
+	current, _ := NewKeyring(testKey('b'), testKey('a'))
+
+	encrypted, err := old.EncryptAll(map[string]string{"token": "hcloud-token", "password": "s3cret"})
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	active := client.ProviderAccount.Create().
+		SetName("Hetzner").
+		SetProvider("hetzner").
+		SetSecrets(encrypted).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	deleted := client.ProviderAccount.Create().
+		SetName("Old Hetzner").
+		SetProvider("hetzner").
+		SetSecrets(map[string]schema.EncryptedValue{"token": encrypted["token"]}).
+		SetDeletedAt(time.Now()).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	client.ProviderAccount.Create().SetName("Empty").SetProvider("aws").SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+
+	class := client.AssetClass.Create().SetName("Servers").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	ilo := client.Item.Create().
+		SetName("ilo-1").
+		SetAssetClass(class).
+		SetSecrets(map[string]schema.EncryptedValue{"password": encrypted["password"]}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	// secrets of unknown keys abort the rotation
+	other, _ := NewKeyring(testKey('c'))
+	if _, err := Rotate(ctx, client, other, "cli"); err == nil {
+		t.Errorf("Expected error for unknown master key")
+	}
+
+	count, err := Rotate(ctx, client, current, "cli")
+	if err != nil {
+		t.Fatalf("Failed to rotate: %v", err)
+	}
+
+	if count != 4 {
+		t.Errorf("Expected 4 re-encrypted secrets, got %d", count)
+	}
+
+	// the previous key is no longer needed
+	rotated, _ := NewKeyring(testKey('b'))
+	for _, account := range []*ent.ProviderAccount{active, deleted} {
+		account = client.ProviderAccount.GetX(ctx, account.ID)
+		plaintext, err := rotated.DecryptAll(account.Secrets)
+		if err != nil || plaintext["token"] != "hcloud-token" || account.UpdatedBy != "cli" {
+			t.Errorf("Unexpected secrets of %s: %v, %v", account.Name, plaintext, err)
+		}
+	}
+
+	ilo = client.Item.GetX(ctx, ilo.ID)
+	if plaintext, err := rotated.Decrypt(ilo.Secrets["password"]); err != nil || plaintext != "s3cret" {
+		t.Errorf("Unexpected item secret: %q, %v", plaintext, err)
+	}
+}
@@ -0,0 +1,208 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"dig-inv/ent/schema"
+	"dig-inv/env"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize is the size of master and data keys in bytes.
+const KeySize = 32
+
+// ErrNoMasterKey is returned if secrets are encrypted or decrypted without configured master key.
+var ErrNoMasterKey = errors.New("no master key configured, set MASTER_KEY or MASTER_KEY_FILE")
+
+// ErrUnknownKey is returned for secrets encrypted with a master key that is not part of the keyring.
+var ErrUnknownKey = errors.New("secret was encrypted with an unknown master key")
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// A Keyring holds the master keys. The first key encrypts new secrets, the others are only used to decrypt secrets
+// that were encrypted before the master key was rotated.
+type Keyring struct {
+	keys []masterKey
+}
+
+// LoadKeyring returns the keyring of the master keys configured by MASTER_KEY or MASTER_KEY_FILE.
+func LoadKeyring() (*Keyring, error) {
+	if keys := env.GetMasterKey(); keys != "" {
+		return NewKeyring(strings.Split(keys, ",")...)
+	}
+
+	file := env.GetMasterKeyFile()
+	if file == "" {
+		return nil, ErrNoMasterKey
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read master key file: %w", err)
+	}
+
+	return NewKeyring(strings.Split(string(data), "\n")...)
+}
+
+// NewKeyring returns a keyring of the base64 encoded master keys, empty keys are ignored.
+func NewKeyring(keys ...string) (*Keyring, error) {
+	k := &Keyring{}
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("master key is not base64 encoded: %w", err)
+		}
+		if len(raw) != KeySize {
+			return nil, fmt.Errorf("master key has %d bytes, expected %d", len(raw), KeySize)
+		}
+
+		aead, err := newAEAD(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(raw)
+		k.keys = append(k.keys, masterKey{id: hex.EncodeToString(sum[:8]), aead: aead})
+	}
+
+	if len(k.keys) == 0 {
+		return nil, ErrNoMasterKey
+	}
+
+	return k, nil
+}
+
+// KeyID returns the ID of the master key new secrets are encrypted with.
+func (k *Keyring) KeyID() string {
+	return k.keys[0].id
+}
+
+// Encrypt encrypts the plaintext with a new data key, which is encrypted with the current master key.
+func (k *Keyring) Encrypt(plaintext string) (schema.EncryptedValue, error) {
+	dataKey := make([]byte, KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return schema.EncryptedValue{}, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return schema.EncryptedValue{}, err
+	}
+
+	nonce, ciphertext, err := seal(aead, []byte(plaintext))
+	if err != nil {
+		return schema.EncryptedValue{}, err
+	}
+
+	current := k.keys[0]
+	keyNonce, wrapped, err := seal(current.aead, dataKey)
+	if err != nil {
+		return schema.EncryptedValue{}, err
+	}
+
+	return schema.EncryptedValue{
+		KeyID:      current.id,
+		DataKey:    append(keyNonce, wrapped...),
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+// Decrypt decrypts the data key of the value with the master key it was encrypted with, and the value with the data
+// key.
+func (k *Keyring) Decrypt(value schema.EncryptedValue) (string, error) {
+	var master *masterKey
+	for i := range k.keys {
+		if k.keys[i].id == value.KeyID {
+			master = &k.keys[i]
+			break
+		}
+	}
+	if master == nil {
+		return "", fmt.Errorf("%w %s", ErrUnknownKey, value.KeyID)
+	}
+
+	size := master.aead.NonceSize()
+	if len(value.DataKey) < size {
+		return "", errors.New("invalid data key")
+	}
+
+	dataKey, err := master.aead.Open(nil, value.DataKey[:size], value.DataKey[size:], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := aead.Open(nil, value.Nonce, value.Ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret: %w", err)
+	}
+
+	return string(plaintext), nil
+}
+
+// EncryptAll encrypts every value of the map.
+func (k *Keyring) EncryptAll(values map[string]string) (map[string]schema.EncryptedValue, error) {
+	res := make(map[string]schema.EncryptedValue, len(values))
+	for name, value := range values {
+		encrypted, err := k.Encrypt(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt %s: %w", name, err)
+		}
+		res[name] = encrypted
+	}
+
+	return res, nil
+}
+
+// DecryptAll decrypts every value of the map, which is how provider integrations retrieve the secrets of their
+// accounts.
+func (k *Keyring) DecryptAll(values map[string]schema.EncryptedValue) (map[string]string, error) {
+	res := make(map[string]string, len(values))
+	for name, value := range values {
+		plaintext, err := k.Decrypt(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %w", name, err)
+		}
+		res[name] = plaintext
+	}
+
+	return res, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, []byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return nonce, aead.Seal(nil, nonce, plaintext, nil), nil
+}
@@ -0,0 +1,112 @@
+package secrets
+
+import (
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(rune(b)), KeySize)))
+}
+
+func TestNewKeyring(t *testing.T) {
+	for _, keys := range [][]string{
+		{},
+		{" ", ""},
+		{"not base64!"},
+		{base64.StdEncoding.EncodeToString([]byte("too short"))},
+	} {
+		if _, err := NewKeyring(keys...); err == nil {
+			t.Errorf("Expected error for keys %v", keys)
+		}
+	}
+
+	keyring, err := NewKeyring(" "+testKey('a')+" ", testKey('b'))
+	if err != nil {
+		t.Fatalf("Failed to create keyring: %v", err)
+	}
+
+	if len(keyring.keys) != 2 || keyring.KeyID() == keyring.keys[1].id || len(keyring.KeyID()) != 16 {
+		t.Errorf("Unexpected keyring: %v", keyring.keys)
+	}
+}
+
+func TestEncrypt(t *testing.T) {
+	old, _ := NewKeyring(testKey('a'))
+	current, _ := NewKeyring(testKey('b'), testKey('a'))
+
+	encrypted, err := old.Encrypt("hcloud-token")
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	if strings.Contains(string(encrypted.Ciphertext), "hcloud-token") || encrypted.KeyID != old.KeyID() {
+		t.Errorf("Unexpected encrypted value: %+v", encrypted)
+	}
+
+	// values are encrypted with a new data key every time
+	again, _ := old.Encrypt("hcloud-token")
+	if string(again.Ciphertext) == string(encrypted.Ciphertext) || string(again.DataKey) == string(encrypted.DataKey) {
+		t.Errorf("Expected different ciphertexts for the same plaintext")
+	}
+
+	// previous keys still decrypt
+	for _, keyring := range []*Keyring{old, current} {
+		if plaintext, err := keyring.Decrypt(encrypted); err != nil || plaintext != "hcloud-token" {
+			t.Errorf("Expected plaintext, got %q, %v", plaintext, err)
+		}
+	}
+
+	other, _ := NewKeyring(testKey('c'))
+	if _, err := other.Decrypt(encrypted); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Expected unknown key error, got %v", err)
+	}
+
+	tampered := encrypted
+	tampered.Ciphertext = append([]byte{}, encrypted.Ciphertext...)
+	tampered.Ciphertext[0] ^= 1
+	if _, err := old.Decrypt(tampered); err == nil {
+		t.Errorf("Expected error for tampered ciphertext")
+	}
+
+	all, err := current.EncryptAll(map[string]string{"token": "a", "password": "b"})
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	plaintext, err := current.DecryptAll(all)
+	if err != nil || plaintext["token"] != "a" || plaintext["password"] != "b" || all["token"].KeyID != current.KeyID() {
+		t.Errorf("Unexpected decrypted values: %v, %v", plaintext, err)
+	}
+}
+
+func TestLoadKeyring(t *testing.T) {
+	t.Setenv("MASTER_KEY", "")
+	t.Setenv("MASTER_KEY_FILE", "")
+
+	if _, err := LoadKeyring(); !errors.Is(err, ErrNoMasterKey) {
+		t.Errorf("Expected missing master key, got %v", err)
+	}
+
+	file := filepath.Join(t.TempDir(), "master-key")
+	if err := os.WriteFile(file, []byte(testKey('b')+"\n"+testKey('a')+"\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write key file: %v", err)
+	}
+	t.Setenv("MASTER_KEY_FILE", file)
+
+	fromFile, err := LoadKeyring()
+	if err != nil || len(fromFile.keys) != 2 {
+		t.Fatalf("Failed to load keyring from file: %v", err)
+	}
+
+	// the environment takes precedence over the file
+	t.Setenv("MASTER_KEY", testKey('a')+","+testKey('b'))
+	fromEnv, err := LoadKeyring()
+	if err != nil || len(fromEnv.keys) != 2 || fromEnv.KeyID() != fromFile.keys[1].id {
+		t.Errorf("Unexpected keyring from environment: %v", err)
+	}
+}
@@ -2,7 +2,10 @@ package services
 
 import (
 	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
 	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
 	gw "dig-inv/gen/go"
 	"dig-inv/log"
 	"dig-inv/store"
@@ -28,7 +31,9 @@ func (a assetClassServer) GetAssetClasses(ctx context.Context, message *gw.Empty
 	classes, err := client.AssetClass.Query().Where(
 		assetclass.DeletedAtIsNil()).Order(
 		assetclass.ByOrder(sql.OrderDesc()),
-	).All(ctx)
+	).WithAttributes(func(query *ent.AttributeDefinitionQuery) {
+		query.Where(attributedefinition.DeletedAtIsNil()).Order(attributedefinition.ByOrder()).WithAssetClass()
+	}).All(ctx)
 	if err != nil {
 		grpclog.Errorf("Failed to query asset classes: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to query asset classes: %v", err)
@@ -46,6 +51,8 @@ func (a assetClassServer) GetAssetClasses(ctx context.Context, message *gw.Empty
 			Order:       int32(class.Order),
 			Icon:        class.Icon,
 			Color:       class.Color,
+			Attributes:  toAttributeDefinitions(class.Edges.Attributes),
+			DriftPolicy: string(class.DriftPolicy),
 		})
 	}
 
@@ -63,6 +70,11 @@ func (a assetClassServer) CreateAssetClass(ctx context.Context, class *gw.AssetC
 
 	user := ctx.Value(AuthenticatedSubjectKey).(string)
 
+	policy, err := validateDriftPolicy(class.DriftPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	newClass, err := client.AssetClass.Create().
 		SetName(class.Name).
 		SetDescription(class.Description).
@@ -70,6 +82,7 @@ func (a assetClassServer) CreateAssetClass(ctx context.Context, class *gw.AssetC
 		SetProvider(class.Provider).
 		SetIcon(class.Icon).
 		SetColor(class.Color).
+		SetNillableDriftPolicy(policy).
 		SetCreatedBy(user).
 		SetUpdatedBy(user).
 		Save(ctx)
@@ -82,6 +95,7 @@ func (a assetClassServer) CreateAssetClass(ctx context.Context, class *gw.AssetC
 		Id:          newClass.ID.String(),
 		Name:        newClass.Name,
 		Description: newClass.Description,
+		DriftPolicy: string(newClass.DriftPolicy),
 	}, nil
 
 }
@@ -101,6 +115,11 @@ func (a assetClassServer) UpdateAssetClass(ctx context.Context, class *gw.AssetC
 		return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
 	}
 
+	policy, err := validateDriftPolicy(class.DriftPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	updatedClass, err := client.AssetClass.UpdateOneID(assetClassUuid).
 		SetName(class.Name).
 		SetDescription(class.Description).
@@ -108,6 +127,7 @@ func (a assetClassServer) UpdateAssetClass(ctx context.Context, class *gw.AssetC
 		SetProvider(class.Provider).
 		SetIcon(class.Icon).
 		SetColor(class.Color).
+		SetNillableDriftPolicy(policy).
 		SetUpdatedBy(user).
 		Save(ctx)
 	if err != nil {
@@ -122,6 +142,7 @@ func (a assetClassServer) UpdateAssetClass(ctx context.Context, class *gw.AssetC
 		Order:       int32(updatedClass.Order),
 		Icon:        updatedClass.Icon,
 		Color:       updatedClass.Color,
+		DriftPolicy: string(updatedClass.DriftPolicy),
 	}, nil
 }
 
@@ -153,6 +174,248 @@ func (a assetClassServer) DeleteAssetClass(ctx context.Context, elementId *gw.El
 	return &gw.EmptyMessage{}, nil
 }
 
+func (a assetClassServer) GetAttributeDefinitions(ctx context.Context, elementId *gw.ElementId) (*gw.AttributeDefinitions, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	assetClassUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for asset class ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+	}
+
+	defs, err := activeAttributeDefinitions(ctx, client, assetClassUuid)
+	if err != nil {
+		grpclog.Errorf("Failed to query attribute definitions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query attribute definitions: %v", err)
+	}
+
+	log.S.Debugw("Retrieved attribute definitions", "assetClass", assetClassUuid, "count", len(defs))
+
+	return &gw.AttributeDefinitions{
+		Attributes: toAttributeDefinitions(defs),
+	}, nil
+}
+
+func (a assetClassServer) CreateAttributeDefinition(ctx context.Context, def *gw.AttributeDefinition) (*gw.AttributeDefinition, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	assetClassUuid, err := uuid.Parse(def.AssetClassId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for asset class ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+	}
+
+	if err := validateAttributeDefinition(ctx, client, assetClassUuid, uuid.Nil, def); err != nil {
+		return nil, err
+	}
+
+	newDef, err := client.AttributeDefinition.Create().
+		SetAssetClassID(assetClassUuid).
+		SetKey(def.Key).
+		SetName(def.Name).
+		SetDescription(def.Description).
+		SetType(attributedefinition.Type(def.Type)).
+		SetRequired(def.Required).
+		SetDefaultValue(def.DefaultValue).
+		SetEnumValues(def.EnumValues).
+		SetOrder(int(def.Order)).
+		SetSecret(def.Secret).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create attribute definition: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create attribute definition: %v", err)
+	}
+	log.S.Debugw("Created new attribute definition", "id", newDef.ID, "key", newDef.Key)
+
+	newDef.Edges.AssetClass = &ent.AssetClass{ID: assetClassUuid}
+	return toAttributeDefinition(newDef), nil
+}
+
+func (a assetClassServer) UpdateAttributeDefinition(ctx context.Context, def *gw.AttributeDefinition) (*gw.AttributeDefinition, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	defUuid, err := uuid.Parse(def.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for attribute definition ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute definition ID format: %v", err)
+	}
+
+	existing, err := client.AttributeDefinition.Query().
+		Where(attributedefinition.ID(defUuid)).
+		WithAssetClass().
+		Only(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query attribute definition: %v", err)
+		return nil, status.Errorf(codes.NotFound, "attribute definition not found: %v", err)
+	}
+	assetClassUuid := existing.Edges.AssetClass.ID
+
+	// stored values would have to be moved between the plaintext attributes and the encrypted secrets
+	if def.Secret != existing.Secret {
+		return nil, status.Errorf(codes.InvalidArgument, "whether an attribute is secret can not be changed")
+	}
+
+	if err := validateAttributeDefinition(ctx, client, assetClassUuid, defUuid, def); err != nil {
+		return nil, err
+	}
+
+	updatedDef, err := client.AttributeDefinition.UpdateOneID(defUuid).
+		SetKey(def.Key).
+		SetName(def.Name).
+		SetDescription(def.Description).
+		SetType(attributedefinition.Type(def.Type)).
+		SetRequired(def.Required).
+		SetDefaultValue(def.DefaultValue).
+		SetEnumValues(def.EnumValues).
+		SetOrder(int(def.Order)).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to update attribute definition: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update attribute definition: %v", err)
+	}
+	log.S.Debugw("Updated attribute definition", "id", updatedDef.ID, "key", updatedDef.Key)
+
+	updatedDef.Edges.AssetClass = &ent.AssetClass{ID: assetClassUuid}
+	return toAttributeDefinition(updatedDef), nil
+}
+
+func (a assetClassServer) DeleteAttributeDefinition(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	defUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for attribute definition ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attribute definition ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.AttributeDefinition.UpdateOneID(defUuid).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete attribute definition: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete attribute definition: %v", err)
+	}
+	log.S.Debugw("Deleted attribute definition", "id", defUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+// validateAttributeDefinition checks the type, default value and key uniqueness of an attribute definition.
+func validateAttributeDefinition(ctx context.Context, client *ent.Client, assetClassUuid, defUuid uuid.UUID, def *gw.AttributeDefinition) error {
+	if err := attributedefinition.TypeValidator(attributedefinition.Type(def.Type)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attribute type: %v", err)
+	}
+
+	if err := attributes.ValidateDefinition(&ent.AttributeDefinition{
+		Type:         attributedefinition.Type(def.Type),
+		DefaultValue: def.DefaultValue,
+		EnumValues:   def.EnumValues,
+		Secret:       def.Secret,
+	}); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid attribute definition: %v", err)
+	}
+
+	exists, err := client.AttributeDefinition.Query().
+		Where(
+			attributedefinition.Key(def.Key),
+			attributedefinition.IDNEQ(defUuid),
+			attributedefinition.DeletedAtIsNil(),
+			attributedefinition.HasAssetClassWith(assetclass.ID(assetClassUuid)),
+		).
+		Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query attribute definitions: %v", err)
+		return status.Errorf(codes.Internal, "failed to query attribute definitions: %v", err)
+	}
+
+	if exists {
+		return status.Errorf(codes.AlreadyExists, "attribute %q is already defined for this asset class", def.Key)
+	}
+
+	return nil
+}
+
+// validateDriftPolicy returns the drift policy to store, or nil to keep the current or default policy.
+func validateDriftPolicy(policy string) (*assetclass.DriftPolicy, error) {
+	if policy == "" {
+		return nil, nil
+	}
+
+	res := assetclass.DriftPolicy(policy)
+	if err := assetclass.DriftPolicyValidator(res); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid drift policy %q, expected auto_apply or approve", policy)
+	}
+
+	return &res, nil
+}
+
+func activeAttributeDefinitions(ctx context.Context, client *ent.Client, assetClassUuid uuid.UUID) ([]*ent.AttributeDefinition, error) {
+	return client.AttributeDefinition.Query().
+		Where(
+			attributedefinition.DeletedAtIsNil(),
+			attributedefinition.HasAssetClassWith(assetclass.ID(assetClassUuid)),
+		).
+		WithAssetClass().
+		Order(attributedefinition.ByOrder()).
+		All(ctx)
+}
+
+func toAttributeDefinition(def *ent.AttributeDefinition) *gw.AttributeDefinition {
+	res := &gw.AttributeDefinition{
+		Id:           def.ID.String(),
+		Key:          def.Key,
+		Name:         def.Name,
+		Description:  def.Description,
+		Type:         def.Type.String(),
+		Required:     def.Required,
+		DefaultValue: def.DefaultValue,
+		EnumValues:   def.EnumValues,
+		Order:        int32(def.Order),
+		Secret:       def.Secret,
+	}
+
+	if def.Edges.AssetClass != nil {
+		res.AssetClassId = def.Edges.AssetClass.ID.String()
+	}
+
+	return res
+}
+
+func toAttributeDefinitions(defs []*ent.AttributeDefinition) []*gw.AttributeDefinition {
+	res := make([]*gw.AttributeDefinition, 0, len(defs))
+	for _, def := range defs {
+		res = append(res, toAttributeDefinition(def))
+	}
+
+	return res
+}
+
 func NewAssetClassServer() gw.AssetClassServiceServer {
 	return &assetClassServer{}
 }
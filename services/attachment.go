@@ -0,0 +1,591 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"dig-inv/blob"
+	"dig-inv/ent"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/item"
+	"dig-inv/env"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	attachmentUploadPath   = "/items/{id}/attachments"
+	attachmentDownloadPath = "/attachments/{id}/download"
+)
+
+// attachmentChunkSize is the size of the data sent in a single message of a download.
+const attachmentChunkSize = 64 << 10
+
+type attachmentServer struct {
+	gw.UnimplementedAttachmentServiceServer
+}
+
+func (s attachmentServer) GetAttachments(ctx context.Context, elementId *gw.ElementId) (*gw.Attachments, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := attachmentItem(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := client.Attachment.Query().
+		Where(attachment.HasItemWith(item.ID(itemUuid)), attachment.DeletedAtIsNil()).
+		WithItem().
+		Order(attachment.ByCreatedAt(), attachment.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query attachments: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query attachments: %v", err)
+	}
+
+	res := make([]*gw.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		res = append(res, toAttachment(a))
+	}
+
+	return &gw.Attachments{
+		Attachments: res,
+	}, nil
+}
+
+func (s attachmentServer) UploadAttachment(stream grpc.ClientStreamingServer[gw.AttachmentChunk, gw.Attachment]) error {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	ctx := stream.Context()
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to receive attachment info: %v", err)
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message has to carry the attachment info")
+	}
+
+	filename := attachmentFilename(info.Filename)
+	if filename == "" {
+		return status.Errorf(codes.InvalidArgument, "attachment filename must not be empty")
+	}
+
+	itemUuid, err := attachmentItem(ctx, client, info.ItemId)
+	if err != nil {
+		return err
+	}
+
+	upload, err := receiveAttachment(stream, first.Data)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = upload.file.Close()
+		_ = os.Remove(upload.file.Name())
+	}()
+
+	storage, err := blob.Open()
+	if err != nil {
+		grpclog.Errorf("Failed to open attachment storage: %v", err)
+		return status.Errorf(codes.Internal, "failed to open attachment storage: %v", err)
+	}
+
+	id := uuid.New()
+	key := path.Join(itemUuid.String(), id.String())
+	if err := storage.Put(ctx, key, upload.file, upload.size); err != nil {
+		grpclog.Errorf("Failed to store attachment: %v", err)
+		return status.Errorf(codes.Internal, "failed to store attachment: %v", err)
+	}
+
+	created, err := client.Attachment.Create().
+		SetID(id).
+		SetItemID(itemUuid).
+		SetFilename(filename).
+		SetContentType(upload.contentType).
+		SetSize(upload.size).
+		SetSha256(upload.sha256).
+		SetStorageKey(key).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		// the file is useless without its attachment
+		if err := storage.Delete(ctx, key); err != nil {
+			log.S.Errorw("Failed to delete stored attachment", "key", key, "error", err)
+		}
+		grpclog.Errorf("Failed to create attachment: %v", err)
+		return status.Errorf(codes.Internal, "failed to create attachment: %v", err)
+	}
+	log.S.Debugw("Created new attachment", "id", created.ID, "item", itemUuid, "size", created.Size, "contentType", created.ContentType)
+
+	created.Edges.Item = &ent.Item{ID: itemUuid}
+	return stream.SendAndClose(toAttachment(created))
+}
+
+func (s attachmentServer) DownloadAttachment(elementId *gw.ElementId, stream grpc.ServerStreamingServer[gw.AttachmentChunk]) error {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	ctx := stream.Context()
+
+	a, err := visibleAttachment(ctx, client, elementId.Id)
+	if err != nil {
+		return err
+	}
+
+	storage, err := blob.Open()
+	if err != nil {
+		grpclog.Errorf("Failed to open attachment storage: %v", err)
+		return status.Errorf(codes.Internal, "failed to open attachment storage: %v", err)
+	}
+
+	r, err := storage.Get(ctx, a.StorageKey)
+	if errors.Is(err, blob.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "attachment file not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to read attachment: %v", err)
+		return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+
+	if err := stream.Send(&gw.AttachmentChunk{Info: toAttachment(a)}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&gw.AttachmentChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to read attachment: %v", err)
+			return status.Errorf(codes.Internal, "failed to read attachment: %v", err)
+		}
+	}
+}
+
+// DeleteAttachment soft deletes the attachment, its file is removed from the storage by PurgeDeletedAttachments.
+func (s attachmentServer) DeleteAttachment(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	a, err := visibleAttachment(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Attachment.UpdateOne(a).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Exec(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete attachment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete attachment: %v", err)
+	}
+	log.S.Debugw("Deleted attachment", "id", a.ID)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+// PurgeDeletedAttachments removes the files of deleted attachments and of the attachments of deleted items from the
+// storage. It is run by the workers, the attachments are kept to record when their files were purged.
+func PurgeDeletedAttachments(ctx context.Context, client *ent.Client) error {
+	attachments, err := client.Attachment.Query().
+		Where(
+			attachment.PurgedAtIsNil(),
+			attachment.Or(attachment.DeletedAtNotNil(), attachment.HasItemWith(item.DeletedAtNotNil())),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query deleted attachments: %w", err)
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	storage, err := blob.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open attachment storage: %w", err)
+	}
+
+	for _, a := range attachments {
+		if err := storage.Delete(ctx, a.StorageKey); err != nil {
+			return fmt.Errorf("failed to delete stored attachment: %w", err)
+		}
+
+		if err := client.Attachment.UpdateOne(a).SetPurgedAt(time.Now()).SetUpdatedBy(worker.Subject).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update purged attachment: %w", err)
+		}
+
+		log.S.Debugw("Purged deleted attachment", "id", a.ID, "key", a.StorageKey)
+	}
+
+	return nil
+}
+
+// attachmentItem returns the ID of the item, if it exists and is visible to the authenticated user.
+func attachmentItem(ctx context.Context, client *ent.Client, id string) (uuid.UUID, error) {
+	itemUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	exists, err := client.Item.Query().Where(item.ID(itemUuid), item.DeletedAtIsNil(), visibleItems(ctx)).Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query item: %v", err)
+		return uuid.Nil, status.Errorf(codes.Internal, "failed to query item: %v", err)
+	}
+
+	if !exists {
+		return uuid.Nil, status.Errorf(codes.NotFound, "item not found")
+	}
+
+	return itemUuid, nil
+}
+
+// visibleAttachment returns the attachment with the given ID, if its item is visible to the authenticated user.
+func visibleAttachment(ctx context.Context, client *ent.Client, id string) (*ent.Attachment, error) {
+	attachmentUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for attachment ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment ID format: %v", err)
+	}
+
+	a, err := client.Attachment.Query().
+		Where(
+			attachment.ID(attachmentUuid),
+			attachment.DeletedAtIsNil(),
+			attachment.HasItemWith(item.DeletedAtIsNil(), visibleItems(ctx)),
+		).
+		WithItem().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "attachment not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query attachment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query attachment: %v", err)
+	}
+
+	return a, nil
+}
+
+// attachmentFilename strips directories and control characters from the name of an uploaded file.
+func attachmentFilename(name string) string {
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, name)
+
+	return strings.TrimSpace(name)
+}
+
+// spooledAttachment is an uploaded file that was received completely, before it is put into the storage.
+type spooledAttachment struct {
+	file        *os.File
+	size        int64
+	sha256      string
+	contentType string
+}
+
+// receiveAttachment writes the data of the stream to a temporary file, which is closed and removed by the caller.
+// Uploads larger than ATTACHMENT_MAX_SIZE are rejected while they are received.
+func receiveAttachment(stream grpc.ClientStreamingServer[gw.AttachmentChunk, gw.Attachment], data []byte) (*spooledAttachment, error) {
+	file, err := os.CreateTemp("", "dig-inv-attachment-*")
+	if err != nil {
+		grpclog.Errorf("Failed to create temporary file: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create temporary file: %v", err)
+	}
+
+	upload := &spooledAttachment{file: file}
+	fail := func(err error) (*spooledAttachment, error) {
+		_ = file.Close()
+		_ = os.Remove(file.Name())
+		return nil, err
+	}
+
+	maxSize := env.GetAttachmentMaxSize()
+	hash := sha256.New()
+	// the content type is detected from the first 512 bytes
+	sniff := make([]byte, 0, 512)
+
+	for {
+		if upload.size+int64(len(data)) > maxSize {
+			return fail(status.Errorf(codes.ResourceExhausted, "attachment exceeds the maximum size of %d bytes", maxSize))
+		}
+
+		if _, err := file.Write(data); err != nil {
+			grpclog.Errorf("Failed to write temporary file: %v", err)
+			return fail(status.Errorf(codes.Internal, "failed to write temporary file: %v", err))
+		}
+		hash.Write(data)
+		sniff = append(sniff, data[:min(len(data), cap(sniff)-len(sniff))]...)
+		upload.size += int64(len(data))
+
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fail(err)
+		}
+		data = chunk.Data
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fail(status.Errorf(codes.Internal, "failed to read temporary file: %v", err))
+	}
+
+	upload.sha256 = hex.EncodeToString(hash.Sum(nil))
+	upload.contentType = http.DetectContentType(sniff)
+
+	return upload, nil
+}
+
+// attachmentUploadHandler uploads the file of the multipart form field "file" to the item.
+func attachmentUploadHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	// the size is checked while the file is received, this only limits the rest of the form
+	r.Body = http.MaxBytesReader(w, r.Body, env.GetAttachmentMaxSize()+1<<20)
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid upload: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			http.Error(w, "invalid upload: missing form field \"file\"", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid upload: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		if part.FormName() != "file" {
+			_ = part.Close()
+			continue
+		}
+
+		stream := &attachmentHTTPUploadStream{
+			ctx:  r.Context(),
+			w:    w,
+			info: &gw.Attachment{ItemId: pathParams["id"], Filename: part.FileName()},
+			part: part,
+		}
+		if err := NewAttachmentServer().UploadAttachment(stream); err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				http.Error(w, status.Convert(err).Message(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			writeStatusError(w, err)
+		}
+		return
+	}
+}
+
+// attachmentDownloadHandler serves the file of the attachment.
+func attachmentDownloadHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	stream := &attachmentHTTPDownloadStream{ctx: r.Context(), w: w}
+
+	err := NewAttachmentServer().DownloadAttachment(&gw.ElementId{Id: pathParams["id"]}, stream)
+	if err == nil {
+		return
+	}
+
+	// once the file is being sent, the status can't be changed anymore
+	if !stream.sent {
+		writeStatusError(w, err)
+		return
+	}
+
+	log.S.Errorw("Failed to download attachment", "id", pathParams["id"], "error", err)
+}
+
+// attachmentHTTPUploadStream receives the file of a multipart form as UploadAttachment stream, as the in-process
+// gateway doesn't support streaming.
+type attachmentHTTPUploadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	w    http.ResponseWriter
+	info *gw.Attachment
+	part *multipart.Part
+}
+
+func (s *attachmentHTTPUploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *attachmentHTTPUploadStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *attachmentHTTPUploadStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *attachmentHTTPUploadStream) SetTrailer(metadata.MD) {
+}
+
+func (s *attachmentHTTPUploadStream) Recv() (*gw.AttachmentChunk, error) {
+	if s.info != nil {
+		info := s.info
+		s.info = nil
+		return &gw.AttachmentChunk{Info: info}, nil
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	n, err := s.part.Read(buf)
+	if n > 0 {
+		return &gw.AttachmentChunk{Data: buf[:n]}, nil
+	}
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read upload: %v", err)
+	}
+
+	return &gw.AttachmentChunk{}, nil
+}
+
+func (s *attachmentHTTPUploadStream) SendAndClose(a *gw.Attachment) error {
+	encoded, err := protojson.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("failed to encode attachment: %w", err)
+	}
+
+	s.w.Header().Set("Content-Type", "application/json")
+	_, err = s.w.Write(encoded)
+	return err
+}
+
+// attachmentHTTPDownloadStream sends the file of a DownloadAttachment stream as plain HTTP response.
+type attachmentHTTPDownloadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	w    http.ResponseWriter
+	sent bool
+}
+
+func (s *attachmentHTTPDownloadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *attachmentHTTPDownloadStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *attachmentHTTPDownloadStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *attachmentHTTPDownloadStream) SetTrailer(metadata.MD) {
+}
+
+func (s *attachmentHTTPDownloadStream) Send(chunk *gw.AttachmentChunk) error {
+	if info := chunk.GetInfo(); info != nil {
+		// files are never rendered by the browser, as they could contain scripts running on the gateway's origin
+		s.w.Header().Set("Content-Type", info.ContentType)
+		s.w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+		s.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": info.Filename}))
+		s.w.Header().Set("X-Content-Type-Options", "nosniff")
+		s.sent = true
+		return nil
+	}
+
+	_, err := s.w.Write(chunk.Data)
+	return err
+}
+
+func toAttachment(a *ent.Attachment) *gw.Attachment {
+	res := &gw.Attachment{
+		Id:          a.ID.String(),
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.Sha256,
+		DownloadUrl: strings.Replace(attachmentDownloadPath, "{id}", a.ID.String(), 1),
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+		CreatedBy:   a.CreatedBy,
+	}
+
+	if a.Edges.Item != nil {
+		res.ItemId = a.Edges.Item.ID.String()
+	}
+
+	return res
+}
+
+func registerAttachmentHandlers(ctx context.Context, mux *runtime.ServeMux) error {
+	if err := gw.RegisterAttachmentServiceHandlerServer(ctx, mux, NewAttachmentServer()); err != nil {
+		return err
+	}
+
+	if err := mux.HandlePath(http.MethodPost, attachmentUploadPath, attachmentUploadHandler); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, attachmentDownloadPath, attachmentDownloadHandler)
+}
+
+func NewAttachmentServer() gw.AttachmentServiceServer {
+	return &attachmentServer{}
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// uploadTestAttachment posts the file as multipart form to the upload route of the item.
+func uploadTestAttachment(t *testing.T, ctx context.Context, itemId, field, filename string, content []byte) *httptest.ResponseRecorder {
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+	if err := form.WriteField("comment", "ignored"); err != nil {
+		t.Fatalf("Failed to write form: %v", err)
+	}
+
+	part, err := form.CreateFormFile(field, filename)
+	if err != nil {
+		t.Fatalf("Failed to write form: %v", err)
+	}
+	_, _ = part.Write(content)
+	_ = form.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/items/"+itemId+"/attachments", body).WithContext(ctx)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	res := httptest.NewRecorder()
+	attachmentUploadHandler(res, req, map[string]string{"id": itemId})
+
+	return res
+}
+
+func downloadTestAttachment(ctx context.Context, id string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/attachments/"+id+"/download", nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	attachmentDownloadHandler(res, req, map[string]string{"id": id})
+
+	return res
+}
+
+func TestAttachmentServer(t *testing.T) {
+	t.Setenv("ATTACHMENT_STORAGE", "local")
+	t.Setenv("ATTACHMENT_DIR", t.TempDir())
+	t.Setenv("ATTACHMENT_MAX_SIZE", "1024")
+
+	ctx := getAuthenticatedTestContext(t, "attachment_tester")
+	items := NewItemServer()
+	attachments := NewAttachmentServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	class := createTestAssetClass(t, ctx, "Attached")
+	rack, err := items.CreateItem(ctx, &gw.Item{Name: "attached rack", AssetClassId: class.Id})
+	expectNoError(t, err)
+
+	diagram := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 100)...)
+	res := uploadTestAttachment(t, ctx, rack.Id, "file", `C:\diagrams\..\rack.png`, diagram)
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected upload to succeed, got %d: %s", res.Code, res.Body)
+	}
+
+	uploaded := &gw.Attachment{}
+	if err := protojson.Unmarshal(res.Body.Bytes(), uploaded); err != nil {
+		t.Fatalf("Failed to decode attachment: %v", err)
+	}
+
+	sum := sha256.Sum256(diagram)
+	if uploaded.Filename != "rack.png" || uploaded.ContentType != "image/png" || uploaded.Size != int64(len(diagram)) ||
+		uploaded.Sha256 != hex.EncodeToString(sum[:]) || uploaded.ItemId != rack.Id || uploaded.CreatedBy != "attachment_tester" {
+		t.Errorf("Unexpected attachment: %v", uploaded)
+	}
+
+	// the content type is detected instead of taken from the client
+	res = uploadTestAttachment(t, ctx, rack.Id, "file", "notes.txt", []byte("<html><script>alert(1)</script></html>"))
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected upload to succeed, got %d: %s", res.Code, res.Body)
+	}
+
+	notes := &gw.Attachment{}
+	_ = protojson.Unmarshal(res.Body.Bytes(), notes)
+	if !strings.HasPrefix(notes.ContentType, "text/html") {
+		t.Errorf("Expected detected HTML, got %s", notes.ContentType)
+	}
+
+	list, err := attachments.GetAttachments(ctx, &gw.ElementId{Id: rack.Id})
+	expectNoError(t, err)
+
+	if len(list.Attachments) != 2 || list.Attachments[0].Id != uploaded.Id || list.Attachments[0].DownloadUrl != "/attachments/"+uploaded.Id+"/download" {
+		t.Errorf("Unexpected attachments: %v", list.Attachments)
+	}
+
+	res = downloadTestAttachment(ctx, uploaded.Id)
+	if res.Code != http.StatusOK || !bytes.Equal(res.Body.Bytes(), diagram) {
+		t.Fatalf("Expected downloaded file, got %d", res.Code)
+	}
+
+	if res.Header().Get("Content-Type") != "image/png" || res.Header().Get("Content-Disposition") != `attachment; filename=rack.png` ||
+		res.Header().Get("X-Content-Type-Options") != "nosniff" {
+		t.Errorf("Unexpected download headers: %v", res.Header())
+	}
+
+	res = uploadTestAttachment(t, ctx, rack.Id, "file", "huge.bin", bytes.Repeat([]byte{1}, 2048))
+	if res.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected too large upload to be rejected, got %d", res.Code)
+	}
+
+	res = uploadTestAttachment(t, ctx, rack.Id, "document", "rack.png", diagram)
+	if res.Code != http.StatusBadRequest {
+		t.Errorf("Expected upload without file field to be rejected, got %d", res.Code)
+	}
+
+	res = uploadTestAttachment(t, ctx, rack.Id, "file", "", diagram)
+	if res.Code != http.StatusBadRequest {
+		t.Errorf("Expected upload without filename to be rejected, got %d", res.Code)
+	}
+
+	// attachments of items in groups are only visible to their members
+	group := client.UserGroup.Create().SetName("Attachment ops").SetOidcScope("attachment-ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	rack.GroupIds = []string{group.ID.String()}
+	_, err = items.UpdateItem(ctx, rack)
+	expectNoError(t, err)
+
+	if res = downloadTestAttachment(ctx, uploaded.Id); res.Code != http.StatusNotFound {
+		t.Errorf("Expected attachment of hidden item to be not found, got %d", res.Code)
+	}
+
+	if res = uploadTestAttachment(t, ctx, rack.Id, "file", "rack.png", diagram); res.Code != http.StatusNotFound {
+		t.Errorf("Expected upload to hidden item to be not found, got %d", res.Code)
+	}
+
+	member := context.WithValue(ctx, AuthenticatedScopesKey, []string{"attachment-ops"})
+	_, err = attachments.DeleteAttachment(member, &gw.ElementId{Id: uploaded.Id})
+	expectNoError(t, err)
+
+	if res = downloadTestAttachment(member, uploaded.Id); res.Code != http.StatusNotFound {
+		t.Errorf("Expected deleted attachment to be not found, got %d", res.Code)
+	}
+
+	list, err = attachments.GetAttachments(member, &gw.ElementId{Id: rack.Id})
+	expectNoError(t, err)
+
+	if len(list.Attachments) != 1 || list.Attachments[0].Id != notes.Id {
+		t.Errorf("Expected only the remaining attachment, got %v", list.Attachments)
+	}
+
+	// the files of deleted attachments and of the attachments of deleted items are purged by the workers
+	_, err = items.DeleteItem(member, &gw.DeleteItemRequest{Id: rack.Id})
+	expectNoError(t, err)
+
+	expectNoError(t, PurgeDeletedAttachments(ctx, client))
+
+	for _, id := range []string{uploaded.Id, notes.Id} {
+		a := client.Attachment.GetX(ctx, uuid.MustParse(id))
+		if a.PurgedAt == nil {
+			t.Errorf("Expected attachment %s to be purged", a.Filename)
+		}
+
+		if _, err := os.Stat(filepath.Join(os.Getenv("ATTACHMENT_DIR"), a.StorageKey)); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Expected file of attachment %s to be removed, got %v", a.Filename, err)
+		}
+	}
+
+	_, err = attachments.GetAttachments(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attachment"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schema"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"time"
+)
+
+const (
+	defaultActivityFeedLimit = 100
+	maxActivityFeedLimit     = 1000
+)
+
+type auditServer struct {
+	gw.UnimplementedAuditServiceServer
+}
+
+func (a auditServer) GetEntityHistory(ctx context.Context, req *gw.EntityHistoryRequest) (*gw.AuditEntries, error) {
+	if !slices.Contains(store.AuditedTypes, req.EntityType) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown entity type: %s", req.EntityType)
+	}
+
+	entityUuid, err := uuid.Parse(req.EntityId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for entity ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entity ID format: %v", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	history := auditlog.And(auditlog.EntityType(req.EntityType), auditlog.EntityID(entityUuid))
+
+	// comments belong to the timeline of their item, including the deleted ones
+	if req.EntityType == ent.TypeItem {
+		commentIds, err := client.Comment.Query().Where(comment.HasItemWith(item.ID(entityUuid))).IDs(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query comments: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to query comments: %v", err)
+		}
+
+		if len(commentIds) > 0 {
+			history = auditlog.Or(history, auditlog.And(auditlog.EntityType(ent.TypeComment), auditlog.EntityIDIn(commentIds...)))
+		}
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(history, visibleAuditEntries(ctx)).
+		Order(auditlog.ByTimestamp(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query entity history: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query entity history: %v", err)
+	}
+
+	log.S.Debugw("Retrieved entity history", "type", req.EntityType, "id", entityUuid, "count", len(entries))
+
+	return toAuditEntries(entries)
+}
+
+func (a auditServer) GetActivityFeed(ctx context.Context, req *gw.ActivityFeedRequest) (*gw.AuditEntries, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultActivityFeedLimit
+	}
+	limit = min(limit, maxActivityFeedLimit)
+
+	predicates := []predicate.AuditLog{visibleAuditEntries(ctx)}
+	if req.Actor != "" {
+		predicates = append(predicates, auditlog.Actor(req.Actor))
+	}
+	if req.EntityType != "" {
+		predicates = append(predicates, auditlog.EntityType(req.EntityType))
+	}
+	if req.From != nil {
+		predicates = append(predicates, auditlog.TimestampGTE(req.From.AsTime()))
+	}
+	if req.To != nil {
+		predicates = append(predicates, auditlog.TimestampLTE(req.To.AsTime()))
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(predicates...).
+		Order(auditlog.ByTimestamp(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query activity feed: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query activity feed: %v", err)
+	}
+
+	log.S.Debugw("Retrieved activity feed", "actor", req.Actor, "count", len(entries))
+
+	return toAuditEntries(entries)
+}
+
+func (a auditServer) GetItemAsOf(ctx context.Context, req *gw.ItemAsOfRequest) (*gw.ItemSnapshot, error) {
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	if req.At == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing point in time")
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	if err := checkItemVisible(ctx, client, itemUuid); err != nil {
+		return nil, err
+	}
+
+	_, snapshot, err := itemSnapshot(ctx, client, itemUuid, req.At.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (a auditServer) GetItemDiff(ctx context.Context, req *gw.ItemDiffRequest) (*gw.ItemDiff, error) {
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	if req.From == nil || req.To == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing points in time")
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	if err := checkItemVisible(ctx, client, itemUuid); err != nil {
+		return nil, err
+	}
+
+	fromRevision, fromSnapshot, err := itemSnapshot(ctx, client, itemUuid, req.From.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	toRevision, toSnapshot, err := itemSnapshot(ctx, client, itemUuid, req.To.AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := toAuditChanges(store.DiffRevisions(fromRevision, toRevision))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gw.ItemDiff{
+		From:    fromSnapshot,
+		To:      toSnapshot,
+		Changes: changes,
+	}, nil
+}
+
+// checkItemVisible fails with not found for items hidden from the authenticated user by their groups. Like the
+// history, deleted items and items without a row remain visible.
+func checkItemVisible(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	hidden, err := client.Item.Query().Where(item.ID(id), item.Not(visibleItems(ctx))).Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query item: %v", err)
+		return status.Errorf(codes.Internal, "failed to query item: %v", err)
+	}
+
+	if hidden {
+		return status.Errorf(codes.NotFound, "item not found")
+	}
+
+	return nil
+}
+
+// itemSnapshot rebuilds an item and the entities it is related to at the given point in time.
+func itemSnapshot(ctx context.Context, client *ent.Client, id uuid.UUID, at time.Time) (*store.Revision, *gw.ItemSnapshot, error) {
+	revision, err := store.RevisionAt(ctx, client, ent.TypeItem, id, at)
+	if err != nil {
+		grpclog.Errorf("Failed to rebuild item: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to rebuild item: %v", err)
+	}
+
+	fields, err := structpb.NewStruct(revision.Fields)
+	if err != nil {
+		grpclog.Errorf("Failed to convert item fields: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to convert item fields: %v", err)
+	}
+
+	snapshot := &gw.ItemSnapshot{
+		Id:      id.String(),
+		At:      timestamppb.New(at),
+		Exists:  revision.Exists,
+		Deleted: revision.Deleted,
+		Fields:  fields,
+		Tags:    make([]*gw.Tag, 0),
+		Groups:  make([]*gw.UserGroup, 0),
+	}
+
+	related, err := relatedRevisions(ctx, client, ent.TypeTag, revision.Edges["tags"], at)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, tag := range related {
+		snapshot.Tags = append(snapshot.Tags, &gw.Tag{
+			Id:   tag.ID.String(),
+			Name: revisionString(tag, "name"),
+		})
+	}
+
+	related, err = relatedRevisions(ctx, client, ent.TypeUserGroup, revision.Edges["user_groups"], at)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, group := range related {
+		snapshot.Groups = append(snapshot.Groups, &gw.UserGroup{
+			Id:   group.ID.String(),
+			Name: revisionString(group, "name"),
+		})
+	}
+
+	related, err = relatedRevisions(ctx, client, ent.TypeAssetClass, revision.Edges["asset_class"], at)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, class := range related {
+		order, _ := class.Fields["order"].(float64)
+		snapshot.AssetClass = &gw.AssetClass{
+			Id:          class.ID.String(),
+			Name:        revisionString(class, "name"),
+			Description: revisionString(class, "description"),
+			Icon:        revisionString(class, "icon"),
+			Color:       revisionString(class, "color"),
+			Order:       int32(order),
+			Provider:    revisionString(class, "provider"),
+		}
+	}
+
+	return revision, snapshot, nil
+}
+
+// relatedRevisions rebuilds the related entities with the given IDs, skipping those that did not exist at that time.
+func relatedRevisions(ctx context.Context, client *ent.Client, entityType string, ids []string, at time.Time) ([]*store.Revision, error) {
+	res := make([]*store.Revision, 0, len(ids))
+	for _, id := range ids {
+		relatedUuid, err := uuid.Parse(id)
+		if err != nil {
+			grpclog.Errorf("Invalid UUID format in audit log: %v", err)
+			return nil, status.Errorf(codes.Internal, "invalid UUID format in audit log: %v", err)
+		}
+
+		revision, err := store.RevisionAt(ctx, client, entityType, relatedUuid, at)
+		if err != nil {
+			grpclog.Errorf("Failed to rebuild related %s: %v", entityType, err)
+			return nil, status.Errorf(codes.Internal, "failed to rebuild related %s: %v", entityType, err)
+		}
+
+		if revision.Exists {
+			res = append(res, revision)
+		}
+	}
+
+	return res, nil
+}
+
+func revisionString(revision *store.Revision, field string) string {
+	value, _ := revision.Fields[field].(string)
+	return value
+}
+
+// visibleAuditEntries leaves out the entries of items hidden from the authenticated user by their groups, and of the
+// comments and attachments of such items.
+func visibleAuditEntries(ctx context.Context) predicate.AuditLog {
+	hidden := item.Not(visibleItems(ctx))
+
+	return auditlog.And(
+		auditlog.Not(auditEntitiesIn(ent.TypeItem, item.Table, item.FieldID, hidden)),
+		auditlog.Not(auditEntitiesIn(ent.TypeComment, comment.Table, comment.FieldID, comment.HasItemWith(hidden))),
+		auditlog.Not(auditEntitiesIn(ent.TypeAttachment, attachment.Table, attachment.FieldID, attachment.HasItemWith(hidden))),
+	)
+}
+
+// auditEntitiesIn matches the entries of the entities of a type that match the predicate, which is evaluated in a
+// subquery of the entity table.
+func auditEntitiesIn[P ~func(*sql.Selector)](entityType, table, column string, p P) predicate.AuditLog {
+	return func(s *sql.Selector) {
+		t := sql.Table(table)
+		entities := sql.Select(t.C(column)).From(t)
+		p(entities)
+
+		s.Where(sql.And(
+			sql.EQ(s.C(auditlog.FieldEntityType), entityType),
+			sql.In(s.C(auditlog.FieldEntityID), entities),
+		))
+	}
+}
+
+func toAuditChanges(changes []schema.AuditChange) ([]*gw.AuditChange, error) {
+	res := make([]*gw.AuditChange, 0, len(changes))
+	for _, change := range changes {
+		before, err := structpb.NewValue(change.Before)
+		if err != nil {
+			grpclog.Errorf("Failed to convert audit value: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert audit value: %v", err)
+		}
+
+		after, err := structpb.NewValue(change.After)
+		if err != nil {
+			grpclog.Errorf("Failed to convert audit value: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert audit value: %v", err)
+		}
+
+		res = append(res, &gw.AuditChange{
+			Field:   change.Field,
+			Before:  before,
+			After:   after,
+			Added:   change.Added,
+			Removed: change.Removed,
+			Cleared: change.Cleared,
+			Edge:    change.Edge,
+		})
+	}
+
+	return res, nil
+}
+
+func toAuditEntries(entries []*ent.AuditLog) (*gw.AuditEntries, error) {
+	res := make([]*gw.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		changes, err := toAuditChanges(entry.Changes)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &gw.AuditEntry{
+			Id:         entry.ID.String(),
+			EntityType: entry.EntityType,
+			EntityId:   entry.EntityID.String(),
+			Operation:  entry.Operation.String(),
+			Actor:      entry.Actor,
+			Timestamp:  timestamppb.New(entry.Timestamp),
+			Changes:    changes,
+		})
+	}
+
+	return &gw.AuditEntries{
+		Entries: res,
+	}, nil
+}
+
+func NewAuditServer() gw.AuditServiceServer {
+	return &auditServer{}
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func getAuthenticatedTestContext(t *testing.T, subject string) context.Context {
+	ctx := context.Background()
+
+	if err := store.InitializeSchema(ctx); err != nil {
+		t.Fatalf("Failed to initialize schema: %v", err)
+	}
+
+	return context.WithValue(ctx, AuthenticatedSubjectKey, subject)
+}
+
+func TestAuditServer_GetEntityHistory(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "audit_tester")
+	classes := NewAssetClassServer()
+
+	class, err := classes.CreateAssetClass(ctx, &gw.AssetClass{Name: "Audited"})
+	expectNoError(t, err)
+
+	_, err = classes.UpdateAssetClass(ctx, &gw.AssetClass{Id: class.Id, Name: "Audited Class"})
+	expectNoError(t, err)
+
+	history, err := NewAuditServer().GetEntityHistory(ctx, &gw.EntityHistoryRequest{
+		EntityType: "AssetClass",
+		EntityId:   class.Id,
+	})
+	expectNoError(t, err)
+
+	if len(history.Entries) != 2 {
+		t.Fatalf("Expected 2 history entries, got %d", len(history.Entries))
+	}
+
+	if history.Entries[0].Operation != "create" || history.Entries[1].Operation != "update" {
+		t.Errorf("Unexpected operations: %s, %s", history.Entries[0].Operation, history.Entries[1].Operation)
+	}
+
+	for _, change := range history.Entries[1].Changes {
+		if change.Field == "name" && (change.Before.GetStringValue() != "Audited" || change.After.GetStringValue() != "Audited Class") {
+			t.Errorf("Unexpected name change: %v", change)
+		}
+	}
+}
+
+func TestAuditServer_GetEntityHistoryInvalid(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "audit_tester")
+
+	_, err := NewAuditServer().GetEntityHistory(ctx, &gw.EntityHistoryRequest{EntityType: "Unknown", EntityId: "x"})
+	expectError(t, err)
+
+	_, err = NewAuditServer().GetEntityHistory(ctx, &gw.EntityHistoryRequest{EntityType: "Item", EntityId: "invalid"})
+	expectError(t, err)
+}
+
+func TestAuditServer_GetActivityFeed(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "feed_tester")
+	start := time.Now().Add(-time.Second)
+
+	_, err := NewAssetClassServer().CreateAssetClass(ctx, &gw.AssetClass{Name: "Feed"})
+	expectNoError(t, err)
+
+	feed, err := NewAuditServer().GetActivityFeed(ctx, &gw.ActivityFeedRequest{
+		Actor: "feed_tester",
+		From:  timestamppb.New(start),
+		To:    timestamppb.Now(),
+	})
+	expectNoError(t, err)
+
+	if len(feed.Entries) != 1 || feed.Entries[0].Actor != "feed_tester" {
+		t.Errorf("Unexpected activity feed: %v", feed.Entries)
+	}
+
+	feed, err = NewAuditServer().GetActivityFeed(ctx, &gw.ActivityFeedRequest{
+		Actor: "feed_tester",
+		From:  timestamppb.New(time.Now().Add(time.Hour)),
+	})
+	expectNoError(t, err)
+
+	if len(feed.Entries) != 0 {
+		t.Errorf("Expected no entries in the future, got %d", len(feed.Entries))
+	}
+}
+
+func TestAuditServer_GetItemAsOf(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "snapshot_tester")
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	class := client.AssetClass.Create().SetName("Snapshot").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	tag := client.Tag.Create().SetName("snapshot").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	item := client.Item.Create().
+		SetName("before").
+		SetAssetClass(class).
+		AddTags(tag).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	created := time.Now()
+
+	client.Item.UpdateOneID(item.ID).SetName("after").ClearTags().SetUpdatedBy("b").SaveX(ctx)
+
+	snapshot, err := NewAuditServer().GetItemAsOf(ctx, &gw.ItemAsOfRequest{
+		Id: item.ID.String(),
+		At: timestamppb.New(created),
+	})
+	expectNoError(t, err)
+
+	if !snapshot.Exists || snapshot.Fields.Fields["name"].GetStringValue() != "before" {
+		t.Errorf("Unexpected snapshot: %v", snapshot)
+	}
+	if len(snapshot.Tags) != 1 || snapshot.Tags[0].Name != "snapshot" {
+		t.Errorf("Unexpected snapshot tags: %v", snapshot.Tags)
+	}
+	if snapshot.AssetClass == nil || snapshot.AssetClass.Name != "Snapshot" {
+		t.Errorf("Unexpected snapshot asset class: %v", snapshot.AssetClass)
+	}
+
+	diff, err := NewAuditServer().GetItemDiff(ctx, &gw.ItemDiffRequest{
+		Id:   item.ID.String(),
+		From: timestamppb.New(created),
+		To:   timestamppb.Now(),
+	})
+	expectNoError(t, err)
+
+	if len(diff.Changes) != 2 || diff.Changes[0].Field != "name" || diff.Changes[1].Field != "tags" {
+		t.Errorf("Unexpected diff: %v", diff.Changes)
+	}
+
+	_, err = NewAuditServer().GetItemAsOf(ctx, &gw.ItemAsOfRequest{Id: item.ID.String()})
+	expectError(t, err)
+}
+
+func TestAuditServer_GetItemAsOfHidden(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "snapshot_tester")
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	class := client.AssetClass.Create().SetName("Hidden snapshot").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	group := client.UserGroup.Create().SetName("Snapshot ops").SetOidcScope("snapshot-ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	item := client.Item.Create().
+		SetName("secret").
+		SetAssetClass(class).
+		AddUserGroups(group).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	audit := NewAuditServer()
+	_, err = audit.GetItemAsOf(ctx, &gw.ItemAsOfRequest{Id: item.ID.String(), At: timestamppb.Now()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected snapshot of hidden item to be not found, got %v", err)
+	}
+
+	_, err = audit.GetItemDiff(ctx, &gw.ItemDiffRequest{Id: item.ID.String(), From: timestamppb.Now(), To: timestamppb.Now()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected diff of hidden item to be not found, got %v", err)
+	}
+
+	member := context.WithValue(ctx, AuthenticatedScopesKey, []string{"snapshot-ops"})
+	snapshot, err := audit.GetItemAsOf(member, &gw.ItemAsOfRequest{Id: item.ID.String(), At: timestamppb.Now()})
+	expectNoError(t, err)
+
+	if !snapshot.Exists || snapshot.Fields.Fields["name"].GetStringValue() != "secret" {
+		t.Errorf("Expected members to see the snapshot, got %v", snapshot)
+	}
+}
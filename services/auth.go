@@ -26,6 +26,9 @@ type AuthenticatedSubjectContextKey string
 
 const AuthenticatedSubjectKey AuthenticatedSubjectContextKey = "authenticatedSubject"
 
+// AuthenticatedScopesKey holds the group scopes of the authenticated user, without the configured scope prefix
+const AuthenticatedScopesKey AuthenticatedSubjectContextKey = "authenticatedScopes"
+
 const (
 	TokenCookieName    = "token"
 	VerifierCookieName = "verifier"
@@ -286,9 +289,40 @@ func verifyAuthenticationMiddleware(next runtime.HandlerFunc) runtime.HandlerFun
 			return
 		}
 
+		scopes, err := tokenGroupScopes(token)
+		if err != nil {
+			log.S.Errorw("Failed to read access token claims", "error", err)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
 		ctx = context.WithValue(ctx, AuthenticatedSubjectKey, token.Subject)
+		ctx = context.WithValue(ctx, AuthenticatedScopesKey, scopes)
 		r = r.WithContext(ctx)
 
 		next(w, r, pathParams)
 	}
 }
+
+// tokenGroupScopes returns the scopes and groups of the token that start with the configured scope prefix,
+// with the prefix removed, so they can be matched against the OIDC scopes of the user groups.
+func tokenGroupScopes(token *oidc.IDToken) ([]string, error) {
+	var claims struct {
+		Scope  string   `json:"scope"`
+		Groups []string `json:"groups"`
+	}
+
+	if err := token.Claims(&claims); err != nil {
+		return nil, err
+	}
+
+	prefix := env.GetOidcScopePrefix()
+	scopes := make([]string, 0)
+	for _, scope := range append(strings.Fields(claims.Scope), claims.Groups...) {
+		if scope, ok := strings.CutPrefix(scope, prefix); ok && scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes, nil
+}
@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/comment"
+	"dig-inv/ent/commentmention"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// maxCommentLength is the maximum number of bytes of a comment body.
+const maxCommentLength = 10000
+
+// mentionPattern matches @subject at the start of the body or after whitespace or an opening bracket, so e-mail
+// addresses in the text are not taken for mentions. Subjects may contain e-mail like characters, but have to end with
+// a letter or digit, so punctuation after a mention is not part of it.
+var mentionPattern = regexp.MustCompile(`(?:^|[\s(\[])@([\w.+\-|@]*\w)`)
+
+type commentServer struct {
+	gw.UnimplementedCommentServiceServer
+}
+
+func (s commentServer) GetComments(ctx context.Context, elementId *gw.ElementId) (*gw.Comments, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := attachmentItem(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// deleted comments are part of the result, so their replies keep their place in the thread
+	comments, err := client.Comment.Query().
+		Where(comment.HasItemWith(item.ID(itemUuid))).
+		WithItem().
+		WithParent().
+		Order(comment.ByCreatedAt(), comment.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query comments: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query comments: %v", err)
+	}
+
+	return toComments(comments), nil
+}
+
+func (s commentServer) GetMentions(ctx context.Context, _ *gw.EmptyMessage) (*gw.Comments, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	comments, err := client.Comment.Query().
+		Where(
+			comment.DeletedAtIsNil(),
+			comment.HasMentionedWith(commentmention.Subject(user)),
+			comment.HasItemWith(item.DeletedAtIsNil(), visibleItems(ctx)),
+		).
+		WithItem().
+		WithParent().
+		Order(comment.ByCreatedAt(sql.OrderDesc()), comment.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query comments: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query comments: %v", err)
+	}
+
+	log.S.Debugw("Retrieved mentions", "user", user, "count", len(comments))
+
+	return toComments(comments), nil
+}
+
+func (s commentServer) CreateComment(ctx context.Context, c *gw.Comment) (*gw.Comment, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if err := validateCommentBody(c.Body); err != nil {
+		return nil, err
+	}
+
+	itemUuid, err := attachmentItem(ctx, client, c.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentUuid uuid.UUID
+	if c.ParentId != "" {
+		if parentUuid, err = uuid.Parse(c.ParentId); err != nil {
+			grpclog.Errorf("Invalid UUID format for parent ID: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent ID format: %v", err)
+		}
+
+		// replies to deleted comments are allowed, the thread is still shown
+		exists, err := client.Comment.Query().
+			Where(comment.ID(parentUuid), comment.HasItemWith(item.ID(itemUuid))).
+			Exist(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query parent comment: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to query parent comment: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.InvalidArgument, "parent comment %s not found on item %s", parentUuid, itemUuid)
+		}
+	}
+
+	mentions := parseMentions(c.Body)
+	created, err := writeComment(ctx, client, mentions, user, func(tx *ent.Client) (*ent.Comment, error) {
+		create := tx.Comment.Create().
+			SetItemID(itemUuid).
+			SetBody(c.Body).
+			SetMentions(mentions).
+			SetCreatedBy(user).
+			SetUpdatedBy(user)
+		if parentUuid != uuid.Nil {
+			create.SetParentID(parentUuid)
+		}
+
+		return create.Save(ctx)
+	})
+	if err != nil {
+		grpclog.Errorf("Failed to create comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create comment: %v", err)
+	}
+	log.S.Debugw("Created comment", "id", created.ID, "item", itemUuid)
+
+	return s.reload(ctx, client, created.ID)
+}
+
+func (s commentServer) UpdateComment(ctx context.Context, c *gw.Comment) (*gw.Comment, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if err := validateCommentBody(c.Body); err != nil {
+		return nil, err
+	}
+
+	existing, err := ownComment(ctx, client, c.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Body == c.Body {
+		return toComment(existing), nil
+	}
+
+	now := time.Now()
+	edits := append(existing.Edits, schema.CommentEdit{
+		Body:     existing.Body,
+		EditedAt: now,
+		EditedBy: user,
+	})
+
+	mentions := parseMentions(c.Body)
+	_, err = writeComment(ctx, client, mentions, user, func(tx *ent.Client) (*ent.Comment, error) {
+		return tx.Comment.UpdateOne(existing).
+			SetBody(c.Body).
+			SetMentions(mentions).
+			SetEdits(edits).
+			SetEditedAt(now).
+			SetUpdatedBy(user).
+			Save(ctx)
+	})
+	if err != nil {
+		grpclog.Errorf("Failed to update comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update comment: %v", err)
+	}
+	log.S.Debugw("Updated comment", "id", existing.ID)
+
+	return s.reload(ctx, client, existing.ID)
+}
+
+func (s commentServer) DeleteComment(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	existing, err := ownComment(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Comment.UpdateOne(existing).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Exec(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+	}
+	log.S.Debugw("Deleted comment", "id", existing.ID)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s commentServer) reload(ctx context.Context, client *ent.Client, id uuid.UUID) (*gw.Comment, error) {
+	c, err := client.Comment.Query().Where(comment.ID(id)).WithItem().WithParent().Only(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query comment: %v", err)
+	}
+
+	return toComment(c), nil
+}
+
+// ownComment returns the comment with the given ID, if it is not deleted, its item is visible to the authenticated
+// user and the user wrote it. Comments of others are shown but cannot be changed.
+func ownComment(ctx context.Context, client *ent.Client, id string) (*ent.Comment, error) {
+	commentUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for comment ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid comment ID format: %v", err)
+	}
+
+	c, err := client.Comment.Query().
+		Where(
+			comment.ID(commentUuid),
+			comment.DeletedAtIsNil(),
+			comment.HasItemWith(item.DeletedAtIsNil(), visibleItems(ctx)),
+		).
+		WithItem().
+		WithParent().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "comment not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query comment: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query comment: %v", err)
+	}
+
+	if c.CreatedBy != ctx.Value(AuthenticatedSubjectKey).(string) {
+		return nil, status.Errorf(codes.PermissionDenied, "only the author can change a comment")
+	}
+
+	return c, nil
+}
+
+// writeComment creates or changes a comment and replaces its mentions in one transaction.
+func writeComment(ctx context.Context, client *ent.Client, mentions []string, user string, write func(tx *ent.Client) (*ent.Comment, error)) (*ent.Comment, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	c, err := write(tx.Client())
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if _, err := tx.CommentMention.Delete().Where(commentmention.HasCommentWith(comment.ID(c.ID))).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to delete mentions: %w", err)
+	}
+
+	builders := make([]*ent.CommentMentionCreate, 0, len(mentions))
+	for _, subject := range mentions {
+		builders = append(builders, tx.CommentMention.Create().
+			SetSubject(subject).
+			SetCommentID(c.ID).
+			SetCreatedBy(user).
+			SetUpdatedBy(user))
+	}
+	if err := tx.CommentMention.CreateBulk(builders...).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("failed to create mentions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit comment: %w", err)
+	}
+
+	return c, nil
+}
+
+func validateCommentBody(body string) error {
+	if body == "" {
+		return status.Errorf(codes.InvalidArgument, "comment body must not be empty")
+	}
+	if len(body) > maxCommentLength {
+		return status.Errorf(codes.InvalidArgument, "comment body must not be longer than %d bytes", maxCommentLength)
+	}
+	return nil
+}
+
+// parseMentions returns the mentioned subjects of a comment body, each once in the order of their first mention.
+func parseMentions(body string) []string {
+	mentions := make([]string, 0)
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		if !slices.Contains(mentions, match[1]) {
+			mentions = append(mentions, match[1])
+		}
+	}
+	return mentions
+}
+
+func toComments(comments []*ent.Comment) *gw.Comments {
+	res := make([]*gw.Comment, 0, len(comments))
+	for _, c := range comments {
+		res = append(res, toComment(c))
+	}
+	return &gw.Comments{
+		Comments: res,
+	}
+}
+
+func toComment(c *ent.Comment) *gw.Comment {
+	res := &gw.Comment{
+		Id:        c.ID.String(),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		CreatedBy: c.CreatedBy,
+	}
+	if c.Edges.Item != nil {
+		res.ItemId = c.Edges.Item.ID.String()
+	}
+	if c.Edges.Parent != nil {
+		res.ParentId = c.Edges.Parent.ID.String()
+	}
+
+	if c.DeletedAt != nil {
+		res.Deleted = true
+		return res
+	}
+
+	res.Body = c.Body
+	res.Mentions = c.Mentions
+	if c.EditedAt != nil {
+		res.EditedAt = timestamppb.New(*c.EditedAt)
+	}
+	for _, edit := range c.Edits {
+		res.Edits = append(res.Edits, &gw.CommentEdit{
+			Body:     edit.Body,
+			EditedAt: timestamppb.New(edit.EditedAt),
+			EditedBy: edit.EditedBy,
+		})
+	}
+
+	return res
+}
+
+func NewCommentServer() gw.CommentServiceServer {
+	return &commentServer{}
+}
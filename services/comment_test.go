@@ -0,0 +1,202 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		body     string
+		expected []string
+	}{
+		{"no mentions", []string{}},
+		{"@alice please check", []string{"alice"}},
+		{"ask @bob.smith@example.com, then @alice.", []string{"bob.smith@example.com", "alice"}},
+		{"(@carol) and [@dave] and @carol again", []string{"carol", "dave"}},
+		{"mail ops@example.com", []string{}},
+		{"line\n@eve", []string{"eve"}},
+	}
+
+	for _, test := range tests {
+		if mentions := parseMentions(test.body); !slices.Equal(mentions, test.expected) {
+			t.Errorf("Expected %v for %q, got %v", test.expected, test.body, mentions)
+		}
+	}
+}
+
+func TestCommentServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "comment_tester")
+	other := getAuthenticatedTestContext(t, "other_commenter")
+	items := NewItemServer()
+	comments := NewCommentServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	class := createTestAssetClass(t, ctx, "Commented")
+	server, err := items.CreateItem(ctx, &gw.Item{Name: "commented server", AssetClassId: class.Id})
+	expectNoError(t, err)
+	switchItem, err := items.CreateItem(ctx, &gw.Item{Name: "commented switch", AssetClassId: class.Id})
+	expectNoError(t, err)
+
+	note, err := comments.CreateComment(ctx, &gw.Comment{ItemId: server.Id, Body: "Rebooted after **kernel panic**, @other_commenter please check"})
+	expectNoError(t, err)
+
+	if note.ItemId != server.Id || note.CreatedBy != "comment_tester" || note.ParentId != "" ||
+		!slices.Equal(note.Mentions, []string{"other_commenter"}) || note.EditedAt != nil {
+		t.Errorf("Unexpected comment: %v", note)
+	}
+
+	reply, err := comments.CreateComment(other, &gw.Comment{ItemId: server.Id, ParentId: note.Id, Body: "Looks fine now"})
+	expectNoError(t, err)
+
+	if reply.ParentId != note.Id || reply.CreatedBy != "other_commenter" {
+		t.Errorf("Unexpected reply: %v", reply)
+	}
+
+	_, err = comments.CreateComment(ctx, &gw.Comment{ItemId: switchItem.Id, ParentId: note.Id, Body: "wrong thread"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected reply to a comment of another item to be rejected, got %v", err)
+	}
+
+	_, err = comments.CreateComment(ctx, &gw.Comment{ItemId: server.Id, Body: ""})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected empty comment to be rejected, got %v", err)
+	}
+
+	_, err = comments.CreateComment(ctx, &gw.Comment{ItemId: server.Id, Body: strings.Repeat("a", maxCommentLength+1)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected too long comment to be rejected, got %v", err)
+	}
+
+	mentions, err := comments.GetMentions(other, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	if len(mentions.Comments) != 1 || mentions.Comments[0].Id != note.Id {
+		t.Errorf("Expected the mentioning comment, got %v", mentions.Comments)
+	}
+
+	// only the author can change a comment
+	_, err = comments.UpdateComment(other, &gw.Comment{Id: note.Id, Body: "hijacked"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected permission denied, got %v", err)
+	}
+
+	_, err = comments.DeleteComment(other, &gw.ElementId{Id: note.Id})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected permission denied, got %v", err)
+	}
+
+	edited, err := comments.UpdateComment(ctx, &gw.Comment{Id: note.Id, Body: "Rebooted after kernel panic, fixed by firmware update"})
+	expectNoError(t, err)
+
+	if edited.EditedAt == nil || len(edited.Edits) != 1 || edited.Edits[0].Body != note.Body ||
+		edited.Edits[0].EditedBy != "comment_tester" || len(edited.Mentions) != 0 {
+		t.Errorf("Unexpected edited comment: %v", edited)
+	}
+
+	mentions, err = comments.GetMentions(other, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	if len(mentions.Comments) != 0 {
+		t.Errorf("Expected the mention to be removed by the edit, got %v", mentions.Comments)
+	}
+
+	_, err = comments.DeleteComment(ctx, &gw.ElementId{Id: note.Id})
+	expectNoError(t, err)
+
+	list, err := comments.GetComments(ctx, &gw.ElementId{Id: server.Id})
+	expectNoError(t, err)
+
+	// the deleted comment stays in the thread without its content
+	if len(list.Comments) != 2 || list.Comments[0].Id != note.Id || !list.Comments[0].Deleted ||
+		list.Comments[0].Body != "" || len(list.Comments[0].Edits) != 0 || list.Comments[1].ParentId != note.Id {
+		t.Errorf("Unexpected comments: %v", list.Comments)
+	}
+
+	_, err = comments.UpdateComment(ctx, &gw.Comment{Id: note.Id, Body: "undeleted"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected deleted comment to be not found, got %v", err)
+	}
+
+	// the comments are part of the item timeline
+	history, err := NewAuditServer().GetEntityHistory(ctx, &gw.EntityHistoryRequest{EntityType: ent.TypeItem, EntityId: server.Id})
+	expectNoError(t, err)
+
+	commentEntries := 0
+	for _, entry := range history.Entries {
+		if entry.EntityType == ent.TypeComment {
+			commentEntries++
+		}
+	}
+	// created, replied, edited and deleted
+	if commentEntries != 4 {
+		t.Errorf("Expected 4 comment entries in the item history, got %d", commentEntries)
+	}
+
+	// comments of items in groups are only visible to their members
+	group := client.UserGroup.Create().SetName("Comment ops").SetOidcScope("comment-ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	server.GroupIds = []string{group.ID.String()}
+	_, err = items.UpdateItem(ctx, server)
+	expectNoError(t, err)
+
+	_, err = comments.GetComments(other, &gw.ElementId{Id: server.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected comments of hidden item to be not found, got %v", err)
+	}
+
+	_, err = comments.UpdateComment(other, &gw.Comment{Id: reply.Id, Body: "still mine"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected comment of hidden item to be not found, got %v", err)
+	}
+
+	// neither the history nor the activity feed shows the comments of hidden items
+	audit := NewAuditServer()
+	history, err = audit.GetEntityHistory(other, &gw.EntityHistoryRequest{EntityType: ent.TypeItem, EntityId: server.Id})
+	expectNoError(t, err)
+
+	if len(history.Entries) != 0 {
+		t.Errorf("Expected no history of the hidden item, got %d entries", len(history.Entries))
+	}
+
+	history, err = audit.GetEntityHistory(other, &gw.EntityHistoryRequest{EntityType: ent.TypeComment, EntityId: reply.Id})
+	expectNoError(t, err)
+
+	if len(history.Entries) != 0 {
+		t.Errorf("Expected no history of the comment of the hidden item, got %d entries", len(history.Entries))
+	}
+
+	feed, err := audit.GetActivityFeed(other, &gw.ActivityFeedRequest{EntityType: ent.TypeComment, Limit: maxActivityFeedLimit})
+	expectNoError(t, err)
+
+	for _, entry := range feed.Entries {
+		if entry.EntityId == note.Id || entry.EntityId == reply.Id {
+			t.Errorf("Expected no feed entries of the comments of the hidden item, got %v", entry)
+		}
+	}
+
+	member := context.WithValue(other, AuthenticatedScopesKey, []string{"comment-ops"})
+	feed, err = audit.GetActivityFeed(member, &gw.ActivityFeedRequest{EntityType: ent.TypeComment, Limit: maxActivityFeedLimit})
+	expectNoError(t, err)
+
+	if !slices.ContainsFunc(feed.Entries, func(entry *gw.AuditEntry) bool { return entry.EntityId == reply.Id }) {
+		t.Errorf("Expected members to see the comments in the feed")
+	}
+
+	_, err = comments.UpdateComment(member, &gw.Comment{Id: reply.Id, Body: "still mine"})
+	expectNoError(t, err)
+
+	_, err = comments.GetComments(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"dig-inv/costs"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/usergroup"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"math"
+	"slices"
+	"strings"
+)
+
+const (
+	spendByAssetClass = "asset_class"
+	spendByTag        = "tag"
+	spendByGroup      = "group"
+	spendByProvider   = "provider"
+	spendByCostCenter = "cost_center"
+)
+
+type costServer struct {
+	gw.UnimplementedCostServiceServer
+}
+
+func (s costServer) GetSpendReport(ctx context.Context, req *gw.SpendReportRequest) (*gw.SpendReport, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	groupBy := cmp.Or(req.GroupBy, spendByAssetClass)
+	if !slices.Contains([]string{spendByAssetClass, spendByTag, spendByGroup, spendByProvider, spendByCostCenter}, groupBy) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grouping %q", req.GroupBy)
+	}
+
+	rates, err := costs.LoadRates()
+	if err != nil {
+		grpclog.Errorf("Failed to load currency rates: %v", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load currency rates: %v", err)
+	}
+
+	currency := strings.ToUpper(cmp.Or(strings.TrimSpace(req.Currency), rates.Base()))
+	if !rates.Has(currency) {
+		return nil, status.Errorf(codes.InvalidArgument, "no conversion rate for %s", currency)
+	}
+
+	predicates, err := itemFilterPredicates(ctx, client, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetFilter().GetViewId() != "" {
+		viewPredicates, _, err := savedViewItemQuery(ctx, client, req.Filter.ViewId)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, viewPredicates...)
+	}
+
+	items, err := client.Item.Query().
+		Where(item.DeletedAtIsNil(), item.CostAmountNotNil(), visibleItems(ctx)).
+		Where(predicates...).
+		WithAssetClass().
+		WithTags(func(q *ent.TagQuery) { q.Where(tag.DeletedAtIsNil()) }).
+		WithUserGroups(func(q *ent.UserGroupQuery) { q.Where(usergroup.DeletedAtIsNil()) }).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query items: %v", err)
+	}
+
+	res := &gw.SpendReport{
+		Currency: currency,
+		GroupBy:  groupBy,
+	}
+
+	lines := make(map[string]*gw.SpendLine)
+	var monthlyTotal float64
+	for _, i := range items {
+		converted, err := rates.Convert(*i.CostAmount, i.CostCurrency, currency)
+		if err != nil {
+			res.UnconvertedItems++
+			if !slices.Contains(res.UnconvertedCurrencies, i.CostCurrency) {
+				res.UnconvertedCurrencies = append(res.UnconvertedCurrencies, i.CostCurrency)
+			}
+			continue
+		}
+
+		monthly := costs.Monthly(converted, string(*i.CostPeriod))
+		monthlyTotal += monthly
+
+		for key, name := range spendKeys(i, groupBy) {
+			line, ok := lines[key]
+			if !ok {
+				line = &gw.SpendLine{Key: key, Name: name}
+				lines[key] = line
+			}
+			line.Monthly += monthly
+			line.Items++
+		}
+	}
+
+	for _, line := range lines {
+		line.Yearly = costs.Round(line.Monthly * 12)
+		line.Monthly = costs.Round(line.Monthly)
+		res.Lines = append(res.Lines, line)
+	}
+	slices.SortFunc(res.Lines, func(a, b *gw.SpendLine) int {
+		return cmp.Or(cmp.Compare(b.Yearly, a.Yearly), strings.Compare(a.Name, b.Name), strings.Compare(a.Key, b.Key))
+	})
+	slices.Sort(res.UnconvertedCurrencies)
+
+	res.MonthlyTotal = costs.Round(monthlyTotal)
+	res.YearlyTotal = costs.Round(monthlyTotal * 12)
+
+	log.S.Debugw("Created spend report", "groupBy", groupBy, "currency", currency, "items", len(items))
+
+	return res, nil
+}
+
+// spendKeys returns the keys and names of the report lines the item is part of. Items without tags, groups, provider
+// or cost center are part of the line with the empty key.
+func spendKeys(i *ent.Item, groupBy string) map[string]string {
+	keys := make(map[string]string)
+	switch groupBy {
+	case spendByAssetClass:
+		if class := i.Edges.AssetClass; class != nil {
+			keys[class.ID.String()] = class.Name
+		}
+	case spendByProvider:
+		if class := i.Edges.AssetClass; class != nil && class.Provider != "" {
+			keys[class.Provider] = class.Provider
+		}
+	case spendByTag:
+		for _, t := range i.Edges.Tags {
+			keys[t.ID.String()] = t.Name
+		}
+	case spendByGroup:
+		for _, group := range i.Edges.UserGroups {
+			keys[group.ID.String()] = group.Name
+		}
+	case spendByCostCenter:
+		if i.CostCenter != "" {
+			keys[i.CostCenter] = i.CostCenter
+		}
+	}
+
+	if len(keys) == 0 {
+		keys[""] = ""
+	}
+
+	return keys
+}
+
+// validateItemCost normalizes the cost of an item and checks it against the cost fields, before the other changes of
+// the item are made.
+func validateItemCost(cost *gw.Cost) error {
+	if cost == nil {
+		return nil
+	}
+
+	cost.Currency = strings.ToUpper(strings.TrimSpace(cost.Currency))
+	cost.Period = cmp.Or(cost.Period, string(item.CostPeriodMonthly))
+	cost.CostCenter = strings.TrimSpace(cost.CostCenter)
+
+	if math.IsNaN(cost.Amount) || math.IsInf(cost.Amount, 0) {
+		return status.Errorf(codes.InvalidArgument, "invalid cost amount")
+	}
+	if err := item.CostAmountValidator(cost.Amount); err != nil {
+		return status.Errorf(codes.InvalidArgument, "cost amount must not be negative")
+	}
+	if err := item.CostCurrencyValidator(cost.Currency); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cost currency %q, expected an ISO 4217 code such as EUR", cost.Currency)
+	}
+	if err := item.CostPeriodValidator(item.CostPeriod(cost.Period)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cost period %q, expected monthly, quarterly or yearly", cost.Period)
+	}
+
+	return nil
+}
+
+// setItemCost writes the validated cost to the item, nil clears it.
+func setItemCost(m *ent.ItemMutation, cost *gw.Cost) {
+	if cost == nil {
+		m.ClearCostAmount()
+		m.ClearCostCurrency()
+		m.ClearCostPeriod()
+		m.ClearCostCenter()
+		return
+	}
+
+	m.SetCostAmount(cost.Amount)
+	m.SetCostCurrency(cost.Currency)
+	m.SetCostPeriod(item.CostPeriod(cost.Period))
+	m.SetCostCenter(cost.CostCenter)
+}
+
+func toItemCost(i *ent.Item) *gw.Cost {
+	if i.CostAmount == nil {
+		return nil
+	}
+
+	res := &gw.Cost{
+		Amount:     *i.CostAmount,
+		Currency:   i.CostCurrency,
+		CostCenter: i.CostCenter,
+	}
+	if i.CostPeriod != nil {
+		res.Period = string(*i.CostPeriod)
+	}
+
+	return res
+}
+
+func toCost(cost *schema.Cost) *gw.Cost {
+	if cost == nil {
+		return nil
+	}
+
+	return &gw.Cost{
+		Amount:   cost.Amount,
+		Currency: cost.Currency,
+		Period:   cost.Period,
+	}
+}
+
+func NewCostServer() gw.CostServiceServer {
+	return &costServer{}
+}
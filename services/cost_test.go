@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestItemServer_Cost(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "cost_tester")
+	items := NewItemServer()
+	class := createTestAssetClass(t, ctx, "Costed")
+
+	created, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "costed server",
+		AssetClassId: class.Id,
+		Cost:         &gw.Cost{Amount: 39.9, Currency: " eur", CostCenter: "platform "},
+	})
+	expectNoError(t, err)
+
+	if c := created.Cost; c == nil || c.Amount != 39.9 || c.Currency != "EUR" || c.Period != "monthly" || c.CostCenter != "platform" {
+		t.Errorf("Unexpected cost: %v", created.Cost)
+	}
+
+	for _, cost := range []*gw.Cost{
+		{Amount: -1, Currency: "EUR"},
+		{Amount: 1, Currency: "euro"},
+		{Amount: 1, Currency: "EUR", Period: "weekly"},
+	} {
+		created.Cost = cost
+		if _, err := items.UpdateItem(ctx, created); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid cost %v to be rejected, got %v", cost, err)
+		}
+	}
+
+	created.Cost = nil
+	updated, err := items.UpdateItem(ctx, created)
+	expectNoError(t, err)
+
+	if updated.Cost != nil {
+		t.Errorf("Expected cost to be cleared, got %v", updated.Cost)
+	}
+}
+
+func TestCostServer_GetSpendReport(t *testing.T) {
+	t.Setenv("COST_CURRENCY", "EUR")
+	t.Setenv("CURRENCY_RATES", "USD=0.5")
+
+	ctx := getAuthenticatedTestContext(t, "spend_tester")
+	items := NewItemServer()
+	reports := NewCostServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	servers := createTestAssetClass(t, ctx, "Spend servers")
+	domains := createTestAssetClass(t, ctx, "Spend domains")
+	prod := client.Tag.Create().SetName("spend-prod").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	eu := client.Tag.Create().SetName("spend-eu").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	group := client.UserGroup.Create().SetName("Spend ops").SetOidcScope("spend-ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	for _, i := range []*gw.Item{
+		{Name: "spend web", AssetClassId: servers.Id, TagIds: []string{prod.ID.String(), eu.ID.String()},
+			Cost: &gw.Cost{Amount: 10, Currency: "EUR", CostCenter: "web"}},
+		{Name: "spend db", AssetClassId: servers.Id, TagIds: []string{prod.ID.String()},
+			Cost: &gw.Cost{Amount: 60, Currency: "USD", Period: "quarterly", CostCenter: "data"}},
+		{Name: "spend domain", AssetClassId: domains.Id,
+			Cost: &gw.Cost{Amount: 24, Currency: "EUR", Period: "yearly"}},
+		{Name: "spend license", AssetClassId: domains.Id,
+			Cost: &gw.Cost{Amount: 100, Currency: "CHF"}},
+		{Name: "spend secret", AssetClassId: servers.Id, GroupIds: []string{group.ID.String()},
+			Cost: &gw.Cost{Amount: 1000, Currency: "EUR"}},
+		{Name: "spend free", AssetClassId: servers.Id},
+	} {
+		_, err := items.CreateItem(ctx, i)
+		expectNoError(t, err)
+	}
+
+	filter := &gw.ItemFilter{Query: "spend"}
+	report, err := reports.GetSpendReport(ctx, &gw.SpendReportRequest{Filter: filter})
+	expectNoError(t, err)
+
+	// 10 EUR + 60 USD per quarter + 24 EUR per year, the group item is hidden and CHF has no rate
+	if report.Currency != "EUR" || report.GroupBy != "asset_class" || report.MonthlyTotal != 22 || report.YearlyTotal != 264 ||
+		report.UnconvertedItems != 1 || len(report.UnconvertedCurrencies) != 1 || report.UnconvertedCurrencies[0] != "CHF" {
+		t.Errorf("Unexpected report: %v", report)
+	}
+
+	if len(report.Lines) != 2 || report.Lines[0].Key != servers.Id || report.Lines[0].Monthly != 20 || report.Lines[0].Items != 2 ||
+		report.Lines[1].Name != "Spend domains" || report.Lines[1].Yearly != 24 {
+		t.Errorf("Unexpected lines: %v", report.Lines)
+	}
+
+	report, err = reports.GetSpendReport(ctx, &gw.SpendReportRequest{GroupBy: "tag", Currency: "usd", Filter: filter})
+	expectNoError(t, err)
+
+	// items with several tags are part of each of their lines
+	lines := make(map[string]*gw.SpendLine)
+	for _, line := range report.Lines {
+		lines[line.Name] = line
+	}
+	if report.MonthlyTotal != 44 || lines["spend-prod"].Monthly != 40 || lines["spend-eu"].Monthly != 20 || lines[""].Yearly != 48 {
+		t.Errorf("Unexpected report by tag: %v", report)
+	}
+
+	member := context.WithValue(ctx, AuthenticatedScopesKey, []string{"spend-ops"})
+	report, err = reports.GetSpendReport(member, &gw.SpendReportRequest{GroupBy: "cost_center", Filter: filter})
+	expectNoError(t, err)
+
+	if report.MonthlyTotal != 1022 || len(report.Lines) != 3 || report.Lines[0].Key != "" || report.Lines[0].Monthly != 1002 {
+		t.Errorf("Unexpected report by cost center: %v", report)
+	}
+
+	report, err = reports.GetSpendReport(member, &gw.SpendReportRequest{GroupBy: "group", Filter: filter})
+	expectNoError(t, err)
+
+	if len(report.Lines) != 2 || report.Lines[0].Key != group.ID.String() || report.Lines[0].Monthly != 1000 {
+		t.Errorf("Unexpected report by group: %v", report)
+	}
+
+	_, err = reports.GetSpendReport(ctx, &gw.SpendReportRequest{GroupBy: "color"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid grouping to be rejected, got %v", err)
+	}
+
+	_, err = reports.GetSpendReport(ctx, &gw.SpendReportRequest{Currency: "CHF"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected currency without rate to be rejected, got %v", err)
+	}
+
+	t.Setenv("CURRENCY_RATES", "USD=none")
+	_, err = reports.GetSpendReport(ctx, &gw.SpendReportRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected invalid rates to fail, got %v", err)
+	}
+}
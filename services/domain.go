@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type domainServer struct {
+	gw.UnimplementedDomainServiceServer
+}
+
+func (s domainServer) GetDomainLookups(ctx context.Context, req *gw.ElementId) (*gw.DomainLookups, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	lookups, err := client.DomainLookup.Query().
+		Where(domainlookup.HasItemWith(item.ID(itemUuid))).
+		Order(domainlookup.ByCreatedAt(sql.OrderDesc()), domainlookup.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query domain lookups: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query domain lookups: %v", err)
+	}
+
+	log.S.Debugw("Retrieved domain lookups", "item", itemUuid, "count", len(lookups))
+
+	res := make([]*gw.DomainLookup, 0, len(lookups))
+	for _, lookup := range lookups {
+		l, err := toDomainLookup(lookup, itemUuid)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, l)
+	}
+
+	return &gw.DomainLookups{
+		Lookups: res,
+	}, nil
+}
+
+func toDomainLookup(lookup *ent.DomainLookup, itemId uuid.UUID) (*gw.DomainLookup, error) {
+	res := &gw.DomainLookup{
+		Id:          lookup.ID.String(),
+		ItemId:      itemId.String(),
+		Domain:      lookup.Domain,
+		Source:      string(lookup.Source),
+		Server:      lookup.Server,
+		Registrar:   lookup.Registrar,
+		Statuses:    lookup.Statuses,
+		Nameservers: lookup.Nameservers,
+		Error:       lookup.Error,
+		CreatedAt:   timestamppb.New(lookup.CreatedAt),
+	}
+
+	if lookup.ExpiresAt != nil {
+		res.ExpiresAt = timestamppb.New(*lookup.ExpiresAt)
+	}
+
+	for _, d := range lookup.Discrepancies {
+		stored, err := structpb.NewValue(d.Stored)
+		if err != nil {
+			grpclog.Errorf("Failed to convert lookup value: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert lookup value: %v", err)
+		}
+
+		actual, err := structpb.NewValue(d.Actual)
+		if err != nil {
+			grpclog.Errorf("Failed to convert lookup value: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert lookup value: %v", err)
+		}
+
+		res.Discrepancies = append(res.Discrepancies, &gw.LookupDiscrepancy{
+			Attribute: d.Attribute,
+			Stored:    stored,
+			Actual:    actual,
+		})
+	}
+
+	return res, nil
+}
+
+func (s domainServer) GetDnsChecks(ctx context.Context, req *gw.ElementId) (*gw.DnsChecks, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	checks, err := client.DnsCheck.Query().
+		Where(dnscheck.HasItemWith(item.ID(itemUuid))).
+		Order(dnscheck.ByCreatedAt(sql.OrderDesc()), dnscheck.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query DNS checks: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query DNS checks: %v", err)
+	}
+
+	log.S.Debugw("Retrieved DNS checks", "item", itemUuid, "count", len(checks))
+
+	res := make([]*gw.DnsCheck, 0, len(checks))
+	for _, check := range checks {
+		res = append(res, toDnsCheck(check, itemUuid))
+	}
+
+	return &gw.DnsChecks{
+		Checks: res,
+	}, nil
+}
+
+func toDnsCheck(check *ent.DnsCheck, itemId uuid.UUID) *gw.DnsCheck {
+	res := &gw.DnsCheck{
+		Id:         check.ID.String(),
+		ItemId:     itemId.String(),
+		RecordName: check.RecordName,
+		RecordType: string(check.RecordType),
+		Expected:   check.Expected,
+		Status:     string(check.Status),
+		Message:    check.Message,
+		CreatedAt:  timestamppb.New(check.CreatedAt),
+	}
+
+	for _, answer := range check.Answers {
+		res.Answers = append(res.Answers, &gw.DnsAnswer{
+			Resolver: answer.Resolver,
+			Rcode:    answer.Rcode,
+			Values:   answer.Values,
+			Error:    answer.Error,
+		})
+	}
+
+	return res
+}
+
+func NewDomainServer() gw.DomainServiceServer {
+	return &domainServer{}
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/schema"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDomainServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "domain_tester")
+	domains := NewDomainServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	class := client.AssetClass.Create().SetName("Domain lookup class").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	domain := client.Item.Create().SetName("example.org").SetAssetClass(class).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	expiresAt := time.Date(2030, 5, 1, 0, 0, 0, 0, time.UTC)
+	client.DomainLookup.Create().
+		SetItem(domain).
+		SetDomain("example.org").
+		SetSource(domainlookup.SourceRdap).
+		SetError("domain not found").
+		SetCreatedAt(time.Now().Add(-time.Hour)).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	client.DomainLookup.Create().
+		SetItem(domain).
+		SetDomain("example.org").
+		SetSource(domainlookup.SourceRdap).
+		SetServer("https://rdap.example.org/").
+		SetRegistrar("Example Registrar").
+		SetExpiresAt(expiresAt).
+		SetNameservers([]string{"ns1.example.net"}).
+		SetDiscrepancies([]schema.LookupDiscrepancy{{Attribute: "registrar", Stored: "Old Registrar", Actual: "Example Registrar"}}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	res, err := domains.GetDomainLookups(ctx, &gw.ElementId{Id: domain.ID.String()})
+	expectNoError(t, err)
+
+	if len(res.Lookups) != 2 {
+		t.Fatalf("Expected 2 lookups, got %d", len(res.Lookups))
+	}
+
+	latest := res.Lookups[0]
+	if latest.Registrar != "Example Registrar" || latest.Source != "rdap" || latest.ItemId != domain.ID.String() ||
+		!latest.ExpiresAt.AsTime().Equal(expiresAt) {
+		t.Errorf("Unexpected latest lookup: %v", latest)
+	}
+
+	if len(latest.Discrepancies) != 1 || latest.Discrepancies[0].Stored.GetStringValue() != "Old Registrar" {
+		t.Errorf("Unexpected discrepancies: %v", latest.Discrepancies)
+	}
+
+	if res.Lookups[1].Error != "domain not found" || res.Lookups[1].ExpiresAt != nil {
+		t.Errorf("Unexpected failed lookup: %v", res.Lookups[1])
+	}
+
+	_, err = domains.GetDomainLookups(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+}
+
+func TestDomainServerDnsChecks(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "domain_tester")
+	domains := NewDomainServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	class := client.AssetClass.Create().SetName("DNS check class").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	record := client.Item.Create().SetName("www.example.org").SetAssetClass(class).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	client.DnsCheck.Create().
+		SetItem(record).
+		SetRecordName("www.example.org").
+		SetRecordType(dnscheck.RecordTypeCNAME).
+		SetExpected("web.example.org").
+		SetStatus(dnscheck.StatusDangling).
+		SetAnswers([]schema.DnsAnswer{{Resolver: "192.0.2.53:53", Rcode: "NOERROR", Values: []string{"web.example.org"}}}).
+		SetMessage("192.0.2.53:53: www.example.org points to web.example.org, which does not resolve").
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	res, err := domains.GetDnsChecks(ctx, &gw.ElementId{Id: record.ID.String()})
+	expectNoError(t, err)
+
+	if len(res.Checks) != 1 {
+		t.Fatalf("Expected 1 check, got %d", len(res.Checks))
+	}
+
+	check := res.Checks[0]
+	if check.Status != "dangling" || check.RecordType != "CNAME" || check.ItemId != record.ID.String() ||
+		len(check.Answers) != 1 || check.Answers[0].Values[0] != "web.example.org" {
+		t.Errorf("Unexpected check: %v", check)
+	}
+
+	_, err = domains.GetDnsChecks(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+}
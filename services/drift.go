@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"dig-inv/drift"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/driftreport"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type driftServer struct {
+	gw.UnimplementedDriftServiceServer
+}
+
+func (s driftServer) GetDriftReports(ctx context.Context, req *gw.DriftReportFilter) (*gw.DriftReports, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	query := client.DriftReport.Query().Where(driftreport.DeletedAtIsNil())
+
+	if req.AssetClassId != "" {
+		classUuid, err := uuid.Parse(req.AssetClassId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+		}
+		query = query.Where(driftreport.HasAssetClassWith(assetclass.ID(classUuid)))
+	}
+
+	if req.Status != "" {
+		if err := driftreport.StatusValidator(driftreport.Status(req.Status)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid status %q, expected pending, applied, rejected or superseded", req.Status)
+		}
+		query = query.Where(driftreport.StatusEQ(driftreport.Status(req.Status)))
+	}
+
+	reports, err := query.
+		WithAssetClass().
+		Order(driftreport.ByCreatedAt(sql.OrderDesc()), driftreport.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query drift reports: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query drift reports: %v", err)
+	}
+
+	log.S.Debugw("Retrieved drift reports", "assetClass", req.AssetClassId, "status", req.Status, "count", len(reports))
+
+	res := make([]*gw.DriftReport, 0, len(reports))
+	for _, report := range reports {
+		r, err := toDriftReport(report)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, r)
+	}
+
+	return &gw.DriftReports{
+		Reports: res,
+	}, nil
+}
+
+func (s driftServer) ApproveDriftReport(ctx context.Context, elementId *gw.ElementId) (*gw.DriftReport, error) {
+	return reviewDriftReport(ctx, elementId, drift.Apply)
+}
+
+func (s driftServer) RejectDriftReport(ctx context.Context, elementId *gw.ElementId) (*gw.DriftReport, error) {
+	return reviewDriftReport(ctx, elementId, drift.Reject)
+}
+
+// reviewDriftReport applies or rejects a pending drift report.
+func reviewDriftReport(ctx context.Context, elementId *gw.ElementId, review func(context.Context, *ent.Client, uuid.UUID, string) (*ent.DriftReport, error)) (*gw.DriftReport, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	reportUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for drift report ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid drift report ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	report, err := review(ctx, client, reportUuid, user)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "drift report not found")
+	}
+	if errors.Is(err, drift.ErrNotPending) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to review drift report: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to review drift report: %v", err)
+	}
+	log.S.Debugw("Reviewed drift report", "id", reportUuid, "status", report.Status)
+
+	return toDriftReport(report)
+}
+
+func toDriftReport(report *ent.DriftReport) (*gw.DriftReport, error) {
+	res := &gw.DriftReport{
+		Id:         report.ID.String(),
+		Source:     report.Source,
+		Status:     string(report.Status),
+		ReviewedBy: report.ReviewedBy,
+		CreatedAt:  timestamppb.New(report.CreatedAt),
+	}
+
+	if report.Edges.AssetClass != nil {
+		res.AssetClassId = report.Edges.AssetClass.ID.String()
+	}
+
+	if report.ReviewedAt != nil {
+		res.ReviewedAt = timestamppb.New(*report.ReviewedAt)
+	}
+
+	for _, change := range report.Changes {
+		values := make(map[string]*structpb.Value, len(change.Attributes))
+		for key, value := range change.Attributes {
+			converted, err := structpb.NewValue(value)
+			if err != nil {
+				grpclog.Errorf("Failed to convert attribute value: %v", err)
+				return nil, status.Errorf(codes.Internal, "failed to convert attribute value: %v", err)
+			}
+			values[key] = converted
+		}
+
+		c := &gw.DriftChange{
+			Kind:       change.Kind,
+			ItemId:     change.ItemID,
+			Key:        change.Key,
+			Name:       change.Name,
+			Attributes: values,
+			Cost:       toCost(change.Cost),
+			StoredCost: toCost(change.StoredCost),
+		}
+
+		for _, d := range change.Diffs {
+			stored, err := structpb.NewValue(d.Stored)
+			if err != nil {
+				grpclog.Errorf("Failed to convert attribute value: %v", err)
+				return nil, status.Errorf(codes.Internal, "failed to convert attribute value: %v", err)
+			}
+
+			actual, err := structpb.NewValue(d.Actual)
+			if err != nil {
+				grpclog.Errorf("Failed to convert attribute value: %v", err)
+				return nil, status.Errorf(codes.Internal, "failed to convert attribute value: %v", err)
+			}
+
+			c.Diffs = append(c.Diffs, &gw.AttributeDrift{
+				Attribute: d.Attribute,
+				Stored:    stored,
+				Actual:    actual,
+			})
+		}
+
+		res.Changes = append(res.Changes, c)
+	}
+
+	return res, nil
+}
+
+func NewDriftServer() gw.DriftServiceServer {
+	return &driftServer{}
+}
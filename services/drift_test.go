@@ -0,0 +1,131 @@
+package services
+
+import (
+	"dig-inv/drift"
+	"dig-inv/ent/attributedefinition"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDriftServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "drift_tester")
+	drifts := NewDriftServer()
+	classes := NewAssetClassServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	_, err = classes.CreateAssetClass(ctx, &gw.AssetClass{Name: "Drifting", DriftPolicy: "sometimes"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unknown drift policy, got %v", err)
+	}
+
+	created, err := classes.CreateAssetClass(ctx, &gw.AssetClass{Name: "Drifting"})
+	expectNoError(t, err)
+
+	if created.DriftPolicy != "auto_apply" {
+		t.Errorf("Expected drift policy to default to auto_apply, got %s", created.DriftPolicy)
+	}
+
+	updated, err := classes.UpdateAssetClass(ctx, &gw.AssetClass{Id: created.Id, Name: "Drifting", DriftPolicy: "approve"})
+	expectNoError(t, err)
+
+	// the policy is kept if it is not set
+	updated, err = classes.UpdateAssetClass(ctx, &gw.AssetClass{Id: created.Id, Name: "Drifting servers"})
+	expectNoError(t, err)
+
+	if updated.DriftPolicy != "approve" {
+		t.Errorf("Expected drift policy to be kept, got %s", updated.DriftPolicy)
+	}
+
+	class := client.AssetClass.GetX(ctx, uuid.MustParse(created.Id))
+	client.AttributeDefinition.Create().
+		SetKey("ip_address").
+		SetName("IP address").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+	web := client.Item.Create().
+		SetName("drifting-web-1").
+		SetAssetClass(class).
+		SetAttributes(map[string]any{"ip_address": "10.0.0.1"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	sync := drift.Sync{
+		Source:   "server_sync",
+		Class:    class,
+		Observed: []drift.Observed{{ItemID: web.ID, Name: web.Name, Attributes: map[string]any{"ip_address": "10.0.0.2"}}},
+	}
+	first, err := drift.Reconcile(ctx, client, sync, "worker")
+	expectNoError(t, err)
+
+	res, err := drifts.GetDriftReports(ctx, &gw.DriftReportFilter{AssetClassId: created.Id, Status: "pending"})
+	expectNoError(t, err)
+
+	if len(res.Reports) != 1 {
+		t.Fatalf("Expected 1 pending report, got %d", len(res.Reports))
+	}
+
+	report := res.Reports[0]
+	if report.Id != first.ID.String() || report.AssetClassId != created.Id || report.Source != "server_sync" ||
+		len(report.Changes) != 1 || report.Changes[0].Kind != "changed" || report.Changes[0].ItemId != web.ID.String() ||
+		report.Changes[0].Diffs[0].Actual.GetStringValue() != "10.0.0.2" ||
+		report.Changes[0].Attributes["ip_address"].GetStringValue() != "10.0.0.2" {
+		t.Errorf("Unexpected report: %v", report)
+	}
+
+	approved, err := drifts.ApproveDriftReport(ctx, &gw.ElementId{Id: report.Id})
+	expectNoError(t, err)
+
+	if approved.Status != "applied" || approved.ReviewedBy != "drift_tester" || approved.ReviewedAt == nil {
+		t.Errorf("Unexpected approved report: %v", approved)
+	}
+
+	if ip := client.Item.GetX(ctx, web.ID).Attributes["ip_address"]; ip != "10.0.0.2" {
+		t.Errorf("Expected approved change to be applied, got %v", ip)
+	}
+
+	_, err = drifts.RejectDriftReport(ctx, &gw.ElementId{Id: report.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition for applied report, got %v", err)
+	}
+
+	_, err = drifts.ApproveDriftReport(ctx, &gw.ElementId{Id: uuid.NewString()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found, got %v", err)
+	}
+
+	_, err = drifts.GetDriftReports(ctx, &gw.DriftReportFilter{Status: "done"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+
+	sync.Observed[0].Attributes["ip_address"] = "10.0.0.3"
+	second, err := drift.Reconcile(ctx, client, sync, "worker")
+	expectNoError(t, err)
+
+	rejected, err := drifts.RejectDriftReport(ctx, &gw.ElementId{Id: second.ID.String()})
+	expectNoError(t, err)
+
+	if rejected.Status != "rejected" {
+		t.Errorf("Unexpected rejected report: %v", rejected)
+	}
+
+	res, err = drifts.GetDriftReports(ctx, &gw.DriftReportFilter{AssetClassId: created.Id})
+	expectNoError(t, err)
+
+	if len(res.Reports) != 2 || res.Reports[0].Id != second.ID.String() {
+		t.Errorf("Expected reports newest first, got %v", res.Reports)
+	}
+}
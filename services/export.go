@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/jobrun"
+	"dig-inv/env"
+	"dig-inv/export"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	exportItemsPath    = "/exports/items"
+	exportDownloadPath = "/exports/{id}/download"
+)
+
+type exportServer struct {
+	gw.UnimplementedExportServiceServer
+}
+
+func (s exportServer) CreateExport(ctx context.Context, req *gw.ExportRequest) (*gw.ExportJob, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+	scopes, _ := ctx.Value(AuthenticatedScopesKey).([]string)
+
+	// fail early on invalid filters instead of in the worker
+	if _, err := exportQuery(ctx, client, req); err != nil {
+		return nil, err
+	}
+
+	filter, err := protojson.Marshal(req.GetFilter())
+	if err != nil {
+		grpclog.Errorf("Failed to encode export filter: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to encode export filter: %v", err)
+	}
+
+	// the worker runs the export with the visibility of the requesting user
+	run, err := worker.Enqueue(ctx, client, export.JobType, map[string]string{
+		"format": req.Format,
+		"filter": string(filter),
+		"scopes": strings.Join(scopes, " "),
+	}, user)
+	if err != nil {
+		grpclog.Errorf("Failed to queue export: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to queue export: %v", err)
+	}
+	log.S.Debugw("Queued export", "id", run.ID, "format", req.Format)
+
+	return toExportJob(run), nil
+}
+
+func (s exportServer) GetExport(ctx context.Context, elementId *gw.ElementId) (*gw.ExportJob, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	run, err := exportJob(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toExportJob(run), nil
+}
+
+// RunExportJob is the worker handler of export jobs.
+func RunExportJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	filter := &gw.ItemFilter{}
+	if err := protojson.Unmarshal([]byte(run.Parameters["filter"]), filter); err != nil {
+		return "", fmt.Errorf("invalid export filter: %w", err)
+	}
+
+	ctx = context.WithValue(ctx, AuthenticatedSubjectKey, run.CreatedBy)
+	ctx = context.WithValue(ctx, AuthenticatedScopesKey, strings.Fields(run.Parameters["scopes"]))
+
+	query, err := exportQuery(ctx, client, &gw.ExportRequest{Format: run.Parameters["format"], Filter: filter})
+	if err != nil {
+		return "", err
+	}
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count exported items: %w", err)
+	}
+	worker.Logf(ctx, "Exporting %d items as %s", count, run.Parameters["format"])
+
+	return export.WriteFile(ctx, run, query)
+}
+
+// exportQuery validates an export request and returns the query of the items to export.
+func exportQuery(ctx context.Context, client *ent.Client, req *gw.ExportRequest) (*ent.ItemQuery, error) {
+	if !slices.Contains(export.Formats, req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format %q, expected one of %s",
+			req.Format, strings.Join(export.Formats, ", "))
+	}
+
+	predicates, err := itemFilterPredicates(ctx, client, req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetFilter().GetViewId() != "" {
+		viewPredicates, _, err := savedViewItemQuery(ctx, client, req.Filter.ViewId)
+		if err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, viewPredicates...)
+	}
+
+	return client.Item.Query().
+		Where(item.DeletedAtIsNil(), visibleItems(ctx)).
+		Where(predicates...), nil
+}
+
+// exportJob returns the export job with the given ID, if it was created by the authenticated user.
+func exportJob(ctx context.Context, client *ent.Client, id string) (*ent.JobRun, error) {
+	runUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for export ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid export ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	run, err := client.JobRun.Query().
+		Where(jobrun.ID(runUuid), jobrun.Type(export.JobType), jobrun.CreatedBy(user), jobrun.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "export not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query export: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query export: %v", err)
+	}
+
+	return run, nil
+}
+
+// exportItemsHandler streams the matching items directly, without a worker job. The request is an ExportRequest
+// in query parameters, e.g. /exports/items?format=csv&filter.tag_ids=...
+func exportItemsHandler(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	ctx := r.Context()
+
+	req := &gw.ExportRequest{}
+	if err := runtime.PopulateQueryParameters(req, r.URL.Query(), &utilities.DoubleArray{}); err != nil {
+		http.Error(w, fmt.Sprintf("invalid export request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		log.S.Errorw("Failed to get store client", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	query, err := exportQuery(ctx, client, req)
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	count, err := query.Clone().Count(ctx)
+	if err != nil {
+		log.S.Errorw("Failed to count exported items", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	if limit := env.GetExportInlineLimit(); count > limit {
+		http.Error(w, fmt.Sprintf("exports of more than %d items have to be created as export jobs", limit),
+			http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(req.Format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"items.%s\"", req.Format))
+
+	// the status is sent with the first row, later errors can only be logged
+	if err := export.Write(ctx, w, req.Format, query); err != nil {
+		log.S.Errorw("Failed to export items", "error", err)
+	}
+}
+
+// exportDownloadHandler serves the file of a finished export job to the user who created it.
+func exportDownloadHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	ctx := r.Context()
+
+	client, err := store.GetClient()
+	if err != nil {
+		log.S.Errorw("Failed to get store client", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	run, err := exportJob(ctx, client, pathParams["id"])
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+
+	if run.Status != jobrun.StatusSucceeded {
+		http.Error(w, fmt.Sprintf("export is %s", run.Status), http.StatusConflict)
+		return
+	}
+
+	if run.Result == "" || time.Now().After(export.ExpiresAt(run)) {
+		http.Error(w, "export has expired", http.StatusGone)
+		return
+	}
+
+	file, err := os.Open(export.FilePath(run.Result))
+	if err != nil {
+		log.S.Errorw("Failed to open export file", "id", run.ID, "error", err)
+		http.Error(w, "export file not found", http.StatusGone)
+		return
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	w.Header().Set("Content-Type", export.ContentType(run.Parameters["format"]))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", run.Result))
+	http.ServeContent(w, r, run.Result, *run.FinishedAt, file)
+}
+
+// writeStatusError writes a gRPC status error as plain HTTP error.
+func writeStatusError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
+
+func toExportJob(run *ent.JobRun) *gw.ExportJob {
+	res := &gw.ExportJob{
+		Id:     run.ID.String(),
+		Format: run.Parameters["format"],
+		Status: string(run.Status),
+		Error:  run.Error,
+	}
+
+	if run.Status == jobrun.StatusSucceeded && run.Result != "" {
+		expiresAt := export.ExpiresAt(run)
+		res.ExpiresAt = timestamppb.New(expiresAt)
+
+		if time.Now().Before(expiresAt) {
+			res.DownloadUrl = strings.Replace(exportDownloadPath, "{id}", run.ID.String(), 1)
+		}
+	}
+
+	return res
+}
+
+func registerExportHandlers(ctx context.Context, mux *runtime.ServeMux) error {
+	if err := gw.RegisterExportServiceHandlerServer(ctx, mux, NewExportServer()); err != nil {
+		return err
+	}
+
+	if err := mux.HandlePath(http.MethodGet, exportItemsPath, exportItemsHandler); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, exportDownloadPath, exportDownloadHandler)
+}
+
+func NewExportServer() gw.ExportServiceServer {
+	return &exportServer{}
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"dig-inv/export"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"encoding/csv"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestExportServer_CreateExport(t *testing.T) {
+	t.Setenv("EXPORT_DIR", t.TempDir())
+
+	ctx := getAuthenticatedTestContext(t, "export_tester")
+	items := NewItemServer()
+	exports := NewExportServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	class := createTestAssetClass(t, ctx, "Exportable",
+		&gw.AttributeDefinition{Key: "rack", Name: "Rack", Type: "string"},
+	)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "exported switch",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"rack": structpb.NewStringValue("r12")},
+	})
+	expectNoError(t, err)
+
+	job, err := exports.CreateExport(ctx, &gw.ExportRequest{
+		Format: export.FormatCSV,
+		Filter: &gw.ItemFilter{AssetClassId: class.Id},
+	})
+	expectNoError(t, err)
+
+	if job.Status != "queued" || job.DownloadUrl != "" {
+		t.Errorf("Expected queued export without download, got %v", job)
+	}
+
+	_, err = exports.CreateExport(ctx, &gw.ExportRequest{Format: "pdf"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unsupported format, got %v", err)
+	}
+
+	w := worker.New(map[string]worker.Handler{export.JobType: RunExportJob})
+	_, err = w.RunPending(ctx, client)
+	expectNoError(t, err)
+
+	job, err = exports.GetExport(ctx, &gw.ElementId{Id: job.Id})
+	expectNoError(t, err)
+
+	if job.Status != "succeeded" || job.DownloadUrl != "/exports/"+job.Id+"/download" || job.ExpiresAt == nil {
+		t.Fatalf("Expected downloadable export, got %v", job)
+	}
+
+	other := context.WithValue(ctx, AuthenticatedSubjectKey, "export_stranger")
+	_, err = exports.GetExport(other, &gw.ElementId{Id: job.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected exports of other users to be not found, got %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, job.DownloadUrl, nil).WithContext(ctx)
+	res := httptest.NewRecorder()
+	exportDownloadHandler(res, req, map[string]string{"id": job.Id})
+
+	if res.Code != http.StatusOK || res.Header().Get("Content-Type") != "text/csv" {
+		t.Fatalf("Unexpected download response %d: %s", res.Code, res.Body.String())
+	}
+
+	rows, err := csv.NewReader(res.Body).ReadAll()
+	expectNoError(t, err)
+
+	if len(rows) != 2 || rows[1][1] != "exported switch" || rows[1][len(rows[1])-1] != "r12" {
+		t.Errorf("Unexpected exported rows: %v", rows)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, job.DownloadUrl, nil).WithContext(other)
+	res = httptest.NewRecorder()
+	exportDownloadHandler(res, req, map[string]string{"id": job.Id})
+
+	if res.Code != http.StatusNotFound {
+		t.Errorf("Expected download of other users to be not found, got %d", res.Code)
+	}
+}
+
+func TestExportItemsHandler(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "inline_export_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Inline Exportable")
+
+	for _, name := range []string{"inline one", "inline two"} {
+		_, err := items.CreateItem(ctx, &gw.Item{Name: name, AssetClassId: class.Id})
+		expectNoError(t, err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/exports/items?format=jsonl&filter.asset_class_id="+class.Id, nil).
+		WithContext(ctx)
+	res := httptest.NewRecorder()
+	exportItemsHandler(res, req, nil)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Unexpected response %d: %s", res.Code, res.Body.String())
+	}
+
+	if lines := strings.Split(strings.TrimSpace(res.Body.String()), "\n"); len(lines) != 2 {
+		t.Errorf("Expected 2 exported items, got %v", lines)
+	}
+
+	t.Setenv("EXPORT_INLINE_LIMIT", "1")
+
+	res = httptest.NewRecorder()
+	exportItemsHandler(res, req, nil)
+
+	if res.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected large export to be refused, got %d", res.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/exports/items?format=pdf", nil).WithContext(ctx)
+	res = httptest.NewRecorder()
+	exportItemsHandler(res, req, nil)
+
+	if res.Code != http.StatusBadRequest {
+		t.Errorf("Expected unsupported format to be a bad request, got %d", res.Code)
+	}
+}
@@ -22,6 +22,51 @@ var serviceInitializer = []func(ctx context.Context, mux *runtime.ServeMux) erro
 	func(ctx context.Context, mux *runtime.ServeMux) error {
 		return gw.RegisterAssetClassServiceHandlerServer(ctx, mux, NewAssetClassServer())
 	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterItemServiceHandlerServer(ctx, mux, NewItemServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterAuditServiceHandlerServer(ctx, mux, NewAuditServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterSearchServiceHandlerServer(ctx, mux, NewSearchServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterSavedViewServiceHandlerServer(ctx, mux, NewSavedViewServer())
+	},
+	registerExportHandlers,
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterImportServiceHandlerServer(ctx, mux, NewImportServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterScheduleServiceHandlerServer(ctx, mux, NewScheduleServer())
+	},
+	registerJobHandlers,
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterNotificationServiceHandlerServer(ctx, mux, NewNotificationServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterWebhookServiceHandlerServer(ctx, mux, NewWebhookServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterDomainServiceHandlerServer(ctx, mux, NewDomainServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterProbeServiceHandlerServer(ctx, mux, NewProbeServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterDriftServiceHandlerServer(ctx, mux, NewDriftServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterProviderAccountServiceHandlerServer(ctx, mux, NewProviderAccountServer())
+	},
+	registerAttachmentHandlers,
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterCommentServiceHandlerServer(ctx, mux, NewCommentServer())
+	},
+	func(ctx context.Context, mux *runtime.ServeMux) error {
+		return gw.RegisterCostServiceHandlerServer(ctx, mux, NewCostServer())
+	},
 }
 
 type Server struct {
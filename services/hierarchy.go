@@ -0,0 +1,190 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"maps"
+	"slices"
+)
+
+func (i itemServer) MoveItem(ctx context.Context, req *gw.MoveItemRequest) (*gw.Item, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	var parentUuid uuid.UUID
+	if req.ParentId != "" {
+		if parentUuid, err = uuid.Parse(req.ParentId); err != nil {
+			grpclog.Errorf("Invalid UUID format for parent ID: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent ID format: %v", err)
+		}
+	}
+
+	if err := checkItemParent(ctx, client, itemUuid, parentUuid); err != nil {
+		return nil, err
+	}
+
+	// descendants stay attached to the moved item, so only the item itself has to be updated
+	update := client.Item.UpdateOneID(itemUuid).
+		Where(item.DeletedAtIsNil()).
+		SetUpdatedBy(user)
+	if parentUuid != uuid.Nil {
+		update = update.SetParentID(parentUuid)
+	} else {
+		update = update.ClearParent()
+	}
+
+	_, err = update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to move item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to move item: %v", err)
+	}
+	log.S.Debugw("Moved item", "id", itemUuid, "parent", parentUuid)
+
+	return i.GetItem(ctx, &gw.ElementId{Id: itemUuid.String()})
+}
+
+func (i itemServer) GetSubtree(ctx context.Context, req *gw.SubtreeRequest) (*gw.ItemSubtree, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	rootUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	depths, err := itemDescendants(ctx, client, rootUuid, int(req.Depth))
+	if err != nil {
+		return nil, err
+	}
+	depths[rootUuid] = 0
+
+	items, err := itemQuery(client).Where(item.IDIn(slices.Collect(maps.Keys(depths))...)).All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query subtree: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query subtree: %v", err)
+	}
+
+	if !slices.ContainsFunc(items, func(i *ent.Item) bool { return i.ID == rootUuid }) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+
+	slices.SortFunc(items, func(a, b *ent.Item) int {
+		return cmp.Or(cmp.Compare(depths[a.ID], depths[b.ID]), cmp.Compare(a.Name, b.Name))
+	})
+
+	res := &gw.ItemSubtree{
+		Items: make([]*gw.TraversedItem, 0, len(items)),
+	}
+
+	for _, i := range items {
+		converted, err := toItem(i)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items = append(res.Items, &gw.TraversedItem{
+			Item:  converted,
+			Depth: int32(depths[i.ID]),
+		})
+	}
+
+	log.S.Debugw("Retrieved subtree", "id", rootUuid, "count", len(res.Items))
+
+	return res, nil
+}
+
+// checkItemParent checks that the parent exists and that nesting the item in it does not create a cycle.
+// Use uuid.Nil as item for new items and as parent for root items.
+func checkItemParent(ctx context.Context, client *ent.Client, itemUuid, parentUuid uuid.UUID) error {
+	if parentUuid == uuid.Nil {
+		return nil
+	}
+
+	exists, err := client.Item.Query().Where(item.ID(parentUuid), item.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query parent item: %v", err)
+		return status.Errorf(codes.Internal, "failed to query parent item: %v", err)
+	}
+
+	if !exists {
+		return status.Errorf(codes.NotFound, "parent item not found")
+	}
+
+	visited := make(map[uuid.UUID]bool)
+	for current := parentUuid; current != uuid.Nil; {
+		if current == itemUuid || visited[current] {
+			return status.Errorf(codes.FailedPrecondition, "an item can not be nested in itself or its descendants")
+		}
+		visited[current] = true
+
+		current, err = client.Item.Query().Where(item.HasChildrenWith(item.ID(current))).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to query parent item: %v", err)
+			return status.Errorf(codes.Internal, "failed to query parent item: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// itemDescendants returns the IDs of all items that are not deleted and nested below the given item with their depth.
+// A maxDepth of 0 returns the whole subtree.
+func itemDescendants(ctx context.Context, client *ent.Client, itemUuid uuid.UUID, maxDepth int) (map[uuid.UUID]int, error) {
+	depths := make(map[uuid.UUID]int)
+	frontier := []uuid.UUID{itemUuid}
+
+	for depth := 1; len(frontier) > 0 && (maxDepth <= 0 || depth <= maxDepth); depth++ {
+		children, err := client.Item.Query().
+			Where(
+				item.DeletedAtIsNil(),
+				item.HasParentWith(item.IDIn(frontier...)),
+			).
+			IDs(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query nested items: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to query nested items: %v", err)
+		}
+
+		frontier = make([]uuid.UUID, 0, len(children))
+		for _, child := range children {
+			if _, visited := depths[child]; visited || child == itemUuid {
+				continue
+			}
+
+			depths[child] = depth
+			frontier = append(frontier, child)
+		}
+	}
+
+	return depths, nil
+}
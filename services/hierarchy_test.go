@@ -0,0 +1,76 @@
+package services
+
+import (
+	gw "dig-inv/gen/go"
+	"testing"
+)
+
+func TestItemServer_Hierarchy(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "hierarchy_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Hierarchy")
+
+	create := func(name string, parent *gw.Item) *gw.Item {
+		req := &gw.Item{Name: name, AssetClassId: class.Id}
+		if parent != nil {
+			req.ParentId = parent.Id
+		}
+
+		item, err := items.CreateItem(ctx, req)
+		expectNoError(t, err)
+		return item
+	}
+
+	dc := create("dc", nil)
+	rack := create("rack", dc)
+	server := create("server", rack)
+	other := create("other rack", dc)
+
+	if server.ParentId != rack.Id {
+		t.Errorf("Expected server to be nested in rack, got %q", server.ParentId)
+	}
+
+	_, err := items.MoveItem(ctx, &gw.MoveItemRequest{Id: dc.Id, ParentId: server.Id})
+	expectError(t, err)
+
+	_, err = items.MoveItem(ctx, &gw.MoveItemRequest{Id: rack.Id, ParentId: rack.Id})
+	expectError(t, err)
+
+	moved, err := items.MoveItem(ctx, &gw.MoveItemRequest{Id: rack.Id, ParentId: other.Id})
+	expectNoError(t, err)
+
+	if moved.ParentId != other.Id {
+		t.Errorf("Expected rack to be moved, got parent %q", moved.ParentId)
+	}
+
+	subtree, err := items.GetSubtree(ctx, &gw.SubtreeRequest{Id: other.Id})
+	expectNoError(t, err)
+
+	if len(subtree.Items) != 3 || subtree.Items[2].Item.Id != server.Id || subtree.Items[2].Depth != 2 {
+		t.Errorf("Unexpected subtree: %v", subtree.Items)
+	}
+
+	subtree, err = items.GetSubtree(ctx, &gw.SubtreeRequest{Id: dc.Id, Depth: 1})
+	expectNoError(t, err)
+
+	if len(subtree.Items) != 2 {
+		t.Errorf("Expected 2 items up to depth 1, got %d", len(subtree.Items))
+	}
+
+	_, err = items.DeleteItem(ctx, &gw.DeleteItemRequest{Id: other.Id})
+	expectError(t, err)
+
+	_, err = items.DeleteItem(ctx, &gw.DeleteItemRequest{Id: other.Id, Cascade: true})
+	expectNoError(t, err)
+
+	_, err = items.GetItem(ctx, &gw.ElementId{Id: server.Id})
+	expectError(t, err)
+
+	root, err := items.MoveItem(ctx, &gw.MoveItemRequest{Id: dc.Id})
+	expectNoError(t, err)
+
+	if root.ParentId != "" {
+		t.Errorf("Expected root item, got parent %q", root.ParentId)
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	gw "dig-inv/gen/go"
+	"dig-inv/importer"
+	"dig-inv/log"
+	"dig-inv/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"slices"
+	"strings"
+)
+
+type importServer struct {
+	gw.UnimplementedImportServiceServer
+}
+
+func (s importServer) ImportItems(ctx context.Context, req *gw.ImportRequest) (*gw.ImportResult, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if !slices.Contains(importer.Formats, req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported import format %q, expected one of %s",
+			req.Format, strings.Join(importer.Formats, ", "))
+	}
+
+	// only items visible to the user can be updated
+	res, err := importer.Import(ctx, client, bytes.NewReader(req.Data), importer.Options{
+		Format:  req.Format,
+		Key:     req.Key,
+		Mapping: req.Mapping,
+		DryRun:  req.DryRun,
+		User:    user,
+		Scope:   visibleItems(ctx),
+	})
+	if err != nil {
+		grpclog.Errorf("Failed to import items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to import items: %v", err)
+	}
+	log.S.Debugw("Imported items", "created", res.Created, "updated", res.Updated, "errors", len(res.Errors), "applied", res.Applied)
+
+	return toImportResult(res), nil
+}
+
+func toImportResult(res *importer.Result) *gw.ImportResult {
+	errs := make([]*gw.ImportError, 0, len(res.Errors))
+	for _, rowErr := range res.Errors {
+		errs = append(errs, &gw.ImportError{
+			Row:     int32(rowErr.Row),
+			Column:  rowErr.Column,
+			Message: rowErr.Message,
+		})
+	}
+
+	return &gw.ImportResult{
+		Created: int32(res.Created),
+		Updated: int32(res.Updated),
+		Errors:  errs,
+		Applied: res.Applied,
+	}
+}
+
+func NewImportServer() gw.ImportServiceServer {
+	return &importServer{}
+}
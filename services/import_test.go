@@ -0,0 +1,72 @@
+package services
+
+import (
+	gw "dig-inv/gen/go"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImportServer_ImportItems(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "import_tester")
+	imports := NewImportServer()
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Importable",
+		&gw.AttributeDefinition{Key: "serial", Name: "Serial", Type: "string", Required: true},
+	)
+
+	data := []byte("Name,Serial\nimported laptop,SN-1\nimported phone,SN-2\n")
+	req := &gw.ImportRequest{
+		Format:  "csv",
+		Data:    data,
+		Key:     "attributes.serial",
+		Mapping: map[string]string{"Name": "name", "Serial": "attributes.serial"},
+	}
+
+	res, err := imports.ImportItems(ctx, req)
+	expectNoError(t, err)
+
+	if res.Applied || len(res.Errors) != 2 || res.Errors[0].Column != "asset_class" {
+		t.Fatalf("Expected missing asset class to be reported for every row, got %v", res)
+	}
+
+	req.Data = []byte("Name,Serial,Class\nimported laptop,SN-1,Importable\nimported phone,SN-2,Importable\n")
+	req.Mapping["Class"] = "asset_class"
+	req.DryRun = true
+
+	res, err = imports.ImportItems(ctx, req)
+	expectNoError(t, err)
+
+	if res.Applied || res.Created != 2 || len(res.Errors) != 0 {
+		t.Fatalf("Unexpected dry run result: %v", res)
+	}
+
+	req.DryRun = false
+	res, err = imports.ImportItems(ctx, req)
+	expectNoError(t, err)
+
+	if !res.Applied || res.Created != 2 {
+		t.Fatalf("Unexpected import result: %v", res)
+	}
+
+	list, err := items.GetItems(ctx, &gw.ItemFilter{AssetClassId: class.Id})
+	expectNoError(t, err)
+
+	if len(list.Items) != 2 || list.Items[0].Name != "imported laptop" {
+		t.Errorf("Unexpected imported items: %v", list.Items)
+	}
+
+	res, err = imports.ImportItems(ctx, req)
+	expectNoError(t, err)
+
+	if !res.Applied || res.Updated != 2 || res.Created != 0 {
+		t.Errorf("Expected second import to update the items, got %v", res)
+	}
+
+	_, err = imports.ImportItems(ctx, &gw.ImportRequest{Format: "xml"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unsupported format, got %v", err)
+	}
+}
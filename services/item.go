@@ -1,13 +1,557 @@
 package services
 
 import (
+	"context"
+	"dig-inv/attributes"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schema"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/usergroup"
 	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/querylang"
+	"dig-inv/store"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"maps"
+	"slices"
+	"time"
 )
 
 type itemServer struct {
 	gw.UnimplementedItemServiceServer
 }
 
+func (i itemServer) GetItem(ctx context.Context, elementId *gw.ElementId) (*gw.Item, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	res, err := itemQuery(client).Where(item.ID(itemUuid)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query item: %v", err)
+	}
+
+	return toItem(res)
+}
+
+func (i itemServer) GetItems(ctx context.Context, filter *gw.ItemFilter) (*gw.Items, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	predicates, err := itemFilterPredicates(ctx, client, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	order := []item.OrderOption{item.ByName()}
+	if filter.GetViewId() != "" {
+		viewPredicates, viewOrder, err := savedViewItemQuery(ctx, client, filter.ViewId)
+		if err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, viewPredicates...)
+		order = viewOrder
+	}
+
+	items, err := itemQuery(client).Where(predicates...).Order(order...).All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query items: %v", err)
+	}
+
+	log.S.Debugw("Retrieved items", "count", len(items))
+
+	return toItems(items)
+}
+
+func (i itemServer) CreateItem(ctx context.Context, req *gw.Item) (*gw.Item, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	edges, err := parseItemEdges(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateItemCost(req.Cost); err != nil {
+		return nil, err
+	}
+
+	values, secrets, err := validateItemAttributes(ctx, client, edges.assetClass, req.Attributes, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkItemParent(ctx, client, uuid.Nil, edges.parent); err != nil {
+		return nil, err
+	}
+
+	create := client.Item.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetAttributes(values).
+		SetSecrets(secrets).
+		SetAssetClassID(edges.assetClass).
+		AddTagIDs(edges.tags...).
+		AddUserGroupIDs(edges.groups...).
+		SetCreatedBy(user).
+		SetUpdatedBy(user)
+	if edges.parent != uuid.Nil {
+		create = create.SetParentID(edges.parent)
+	}
+	if req.Cost != nil {
+		setItemCost(create.Mutation(), req.Cost)
+	}
+
+	newItem, err := create.Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create item: %v", err)
+	}
+	log.S.Debugw("Created new item", "id", newItem.ID, "name", newItem.Name)
+
+	return i.GetItem(ctx, &gw.ElementId{Id: newItem.ID.String()})
+}
+
+func (i itemServer) UpdateItem(ctx context.Context, req *gw.Item) (*gw.Item, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	edges, err := parseItemEdges(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateItemCost(req.Cost); err != nil {
+		return nil, err
+	}
+
+	stored, err := client.Item.Query().
+		Where(item.ID(itemUuid), item.DeletedAtIsNil()).
+		Select(item.FieldSecrets).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query item: %v", err)
+	}
+
+	values, secrets, err := validateItemAttributes(ctx, client, edges.assetClass, req.Attributes, stored.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkItemParent(ctx, client, itemUuid, edges.parent); err != nil {
+		return nil, err
+	}
+
+	update := client.Item.UpdateOneID(itemUuid).
+		Where(item.DeletedAtIsNil()).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetAttributes(values).
+		SetSecrets(secrets).
+		SetAssetClassID(edges.assetClass).
+		ClearTags().
+		AddTagIDs(edges.tags...).
+		ClearUserGroups().
+		AddUserGroupIDs(edges.groups...).
+		SetUpdatedBy(user)
+	if edges.parent != uuid.Nil {
+		update = update.SetParentID(edges.parent)
+	} else {
+		update = update.ClearParent()
+	}
+	setItemCost(update.Mutation(), req.Cost)
+
+	updatedItem, err := update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update item: %v", err)
+	}
+	log.S.Debugw("Updated item", "id", updatedItem.ID, "name", updatedItem.Name)
+
+	return i.GetItem(ctx, &gw.ElementId{Id: updatedItem.ID.String()})
+}
+
+func (i itemServer) DeleteItem(ctx context.Context, req *gw.DeleteItemRequest) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	descendants, err := itemDescendants(ctx, client, itemUuid, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(descendants) > 0 && !req.Cascade {
+		return nil, status.Errorf(codes.FailedPrecondition, "item has %d nested items", len(descendants))
+	}
+
+	// the nested items and the item are deleted together, so no nested item outlives a failed deletion
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to start transaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start transaction: %v", err)
+	}
+
+	now := time.Now()
+	if len(descendants) > 0 {
+		_, err = tx.Item.Update().
+			Where(item.IDIn(slices.Collect(maps.Keys(descendants))...)).
+			SetDeletedAt(now).
+			SetUpdatedBy(user).
+			Save(ctx)
+		if err != nil {
+			_ = tx.Rollback()
+			grpclog.Errorf("Failed to delete nested items: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to delete nested items: %v", err)
+		}
+	}
+
+	_, err = tx.Item.UpdateOneID(itemUuid).
+		SetDeletedAt(now).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		grpclog.Errorf("Failed to delete item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete item: %v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		grpclog.Errorf("Failed to commit deletion: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to commit deletion: %v", err)
+	}
+	log.S.Debugw("Deleted item", "id", itemUuid, "descendants", len(descendants))
+
+	return &gw.EmptyMessage{}, nil
+}
+
+// itemQuery returns a query for all items that are not deleted including their edges.
+func itemQuery(client *ent.Client) *ent.ItemQuery {
+	return client.Item.Query().
+		Where(item.DeletedAtIsNil()).
+		WithAssetClass().
+		WithTags().
+		WithUserGroups().
+		WithParent().
+		WithReachability()
+}
+
+// visibleItems limits items to those visible to the authenticated user. Items without groups are visible to everyone,
+// all others only to members of one of their groups.
+func visibleItems(ctx context.Context) predicate.Item {
+	scopes, _ := ctx.Value(AuthenticatedScopesKey).([]string)
+
+	return item.Or(
+		item.Not(item.HasUserGroups()),
+		item.HasUserGroupsWith(usergroup.OidcScopeIn(scopes...), usergroup.DeletedAtIsNil()),
+	)
+}
+
+// itemFilterPredicates converts an item filter to predicates on the item query.
+func itemFilterPredicates(ctx context.Context, client *ent.Client, filter *gw.ItemFilter) ([]predicate.Item, error) {
+	predicates := make([]predicate.Item, 0)
+	if filter == nil {
+		return predicates, nil
+	}
+
+	var assetClassUuid uuid.UUID
+	if filter.AssetClassId != "" {
+		var err error
+		if assetClassUuid, err = uuid.Parse(filter.AssetClassId); err != nil {
+			grpclog.Errorf("Invalid UUID format for asset class ID: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+		}
+
+		predicates = append(predicates, item.HasAssetClassWith(assetclass.ID(assetClassUuid)))
+	}
+
+	tagUuids, err := parseUuids(filter.TagIds, "tag")
+	if err != nil {
+		return nil, err
+	}
+	for _, tagUuid := range tagUuids {
+		predicates = append(predicates, item.HasTagsWith(tag.ID(tagUuid)))
+	}
+
+	groupUuids, err := parseUuids(filter.GroupIds, "group")
+	if err != nil {
+		return nil, err
+	}
+	if len(groupUuids) > 0 {
+		predicates = append(predicates, item.HasUserGroupsWith(usergroup.IDIn(groupUuids...)))
+	}
+
+	for _, attributeFilter := range filter.Attributes {
+		query := client.AttributeDefinition.Query().
+			Where(
+				attributedefinition.Key(attributeFilter.Key),
+				attributedefinition.DeletedAtIsNil(),
+			)
+		if assetClassUuid != uuid.Nil {
+			query = query.Where(attributedefinition.HasAssetClassWith(assetclass.ID(assetClassUuid)))
+		}
+
+		def, err := query.First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "attribute %q is not defined", attributeFilter.Key)
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to query attribute definition: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to query attribute definition: %v", err)
+		}
+
+		p, err := attributes.Predicate(def, attributeFilter.Operator, attributeFilter.Value.AsInterface())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attribute filter: %v", err)
+		}
+
+		predicates = append(predicates, p)
+	}
+
+	if filter.Query != "" {
+		p, err := querylang.ItemPredicate(ctx, client, filter.Query)
+		var queryErr *querylang.Error
+		if errors.As(err, &queryErr) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", queryErr)
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to compile query: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to compile query: %v", err)
+		}
+
+		predicates = append(predicates, p)
+	}
+
+	return predicates, nil
+}
+
+type itemEdges struct {
+	assetClass uuid.UUID
+	tags       []uuid.UUID
+	groups     []uuid.UUID
+	// uuid.Nil for root items
+	parent uuid.UUID
+}
+
+func parseItemEdges(req *gw.Item) (*itemEdges, error) {
+	assetClassUuid, err := uuid.Parse(req.AssetClassId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for asset class ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+	}
+
+	tags, err := parseUuids(req.TagIds, "tag")
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := parseUuids(req.GroupIds, "group")
+	if err != nil {
+		return nil, err
+	}
+
+	var parentUuid uuid.UUID
+	if req.ParentId != "" {
+		if parentUuid, err = uuid.Parse(req.ParentId); err != nil {
+			grpclog.Errorf("Invalid UUID format for parent ID: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent ID format: %v", err)
+		}
+	}
+
+	return &itemEdges{
+		assetClass: assetClassUuid,
+		tags:       tags,
+		groups:     groups,
+		parent:     parentUuid,
+	}, nil
+}
+
+// validateItemAttributes validates the attribute values of an item against the definitions of its asset class
+// and checks that referenced items exist. The values of secret attributes are encrypted and returned separately.
+func validateItemAttributes(ctx context.Context, client *ent.Client, assetClassUuid uuid.UUID, values map[string]*structpb.Value, stored map[string]schema.EncryptedValue) (map[string]any, map[string]schema.EncryptedValue, error) {
+	defs, err := activeAttributeDefinitions(ctx, client, assetClassUuid)
+	if err != nil {
+		grpclog.Errorf("Failed to query attribute definitions: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to query attribute definitions: %v", err)
+	}
+
+	raw := make(map[string]any, len(values))
+	for key, value := range values {
+		raw[key] = value.AsInterface()
+	}
+
+	secrets, err := splitItemSecrets(defs, raw, stored)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := attributes.Validate(defs, raw)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid attributes: %v", err)
+	}
+
+	references := attributes.References(defs, res)
+	slices.SortFunc(references, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+	references = slices.Compact(references)
+
+	if len(references) > 0 {
+		count, err := client.Item.Query().Where(item.IDIn(references...), item.DeletedAtIsNil()).Count(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query referenced items: %v", err)
+			return nil, nil, status.Errorf(codes.Internal, "failed to query referenced items: %v", err)
+		}
+
+		if count != len(references) {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid attributes: referenced item does not exist")
+		}
+	}
+
+	return res, secrets, nil
+}
+
+func parseUuids(ids []string, kind string) ([]uuid.UUID, error) {
+	res := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			grpclog.Errorf("Invalid UUID format for %s ID: %v", kind, err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s ID format: %v", kind, err)
+		}
+
+		res = append(res, parsed)
+	}
+
+	return res, nil
+}
+
+func toItem(i *ent.Item) (*gw.Item, error) {
+	values := make(map[string]*structpb.Value, len(i.Attributes))
+	for key, value := range i.Attributes {
+		converted, err := structpb.NewValue(value)
+		if err != nil {
+			grpclog.Errorf("Failed to convert attribute value: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to convert attribute value: %v", err)
+		}
+
+		values[key] = converted
+	}
+
+	for key := range i.Secrets {
+		values[key] = structpb.NewStringValue(secretMask)
+	}
+
+	res := &gw.Item{
+		Id:          i.ID.String(),
+		Name:        i.Name,
+		Description: i.Description,
+		TagIds:      make([]string, 0, len(i.Edges.Tags)),
+		GroupIds:    make([]string, 0, len(i.Edges.UserGroups)),
+		Attributes:  values,
+		Cost:        toItemCost(i),
+	}
+
+	if i.Edges.AssetClass != nil {
+		res.AssetClassId = i.Edges.AssetClass.ID.String()
+	}
+
+	if i.Edges.Parent != nil {
+		res.ParentId = i.Edges.Parent.ID.String()
+	}
+
+	if r := i.Edges.Reachability; r != nil {
+		res.Reachability = string(r.State)
+		if r.LastSeenAt != nil {
+			res.LastSeenAt = timestamppb.New(*r.LastSeenAt)
+		}
+	}
+
+	for _, t := range i.Edges.Tags {
+		res.TagIds = append(res.TagIds, t.ID.String())
+	}
+
+	for _, group := range i.Edges.UserGroups {
+		res.GroupIds = append(res.GroupIds, group.ID.String())
+	}
+
+	return res, nil
+}
+
+func toItems(items []*ent.Item) (*gw.Items, error) {
+	res := make([]*gw.Item, 0, len(items))
+	for _, i := range items {
+		converted, err := toItem(i)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, converted)
+	}
+
+	return &gw.Items{
+		Items: res,
+	}, nil
+}
+
 func NewItemServer() gw.ItemServiceServer {
 	return &itemServer{}
 }
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	gw "dig-inv/gen/go"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func createTestAssetClass(t *testing.T, ctx context.Context, name string, defs ...*gw.AttributeDefinition) *gw.AssetClass {
+	classes := NewAssetClassServer()
+
+	class, err := classes.CreateAssetClass(ctx, &gw.AssetClass{Name: name})
+	if err != nil {
+		t.Fatalf("Failed to create asset class: %v", err)
+	}
+
+	for _, def := range defs {
+		def.AssetClassId = class.Id
+		if _, err := classes.CreateAttributeDefinition(ctx, def); err != nil {
+			t.Fatalf("Failed to create attribute definition: %v", err)
+		}
+	}
+
+	return class
+}
+
+func TestItemServer_Attributes(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "item_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "License",
+		&gw.AttributeDefinition{Key: "seats", Name: "Seats", Type: "number", Required: true},
+		&gw.AttributeDefinition{Key: "expires", Name: "Expires", Type: "date"},
+		&gw.AttributeDefinition{Key: "edition", Name: "Edition", Type: "enum", EnumValues: []string{"std", "ent"}, DefaultValue: "std"},
+	)
+
+	small, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "Small license",
+		AssetClassId: class.Id,
+		Attributes: map[string]*structpb.Value{
+			"seats":   structpb.NewNumberValue(5),
+			"expires": structpb.NewStringValue("2030-01-01"),
+		},
+	})
+	expectNoError(t, err)
+
+	if small.Attributes["edition"].GetStringValue() != "std" {
+		t.Errorf("Expected default edition, got %v", small.Attributes["edition"])
+	}
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "Large license",
+		AssetClassId: class.Id,
+		Attributes: map[string]*structpb.Value{
+			"seats":   structpb.NewNumberValue(500),
+			"edition": structpb.NewStringValue("ent"),
+		},
+	})
+	expectNoError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{Name: "Invalid license", AssetClassId: class.Id})
+	expectError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "Invalid license",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"seats": structpb.NewStringValue("many")},
+	})
+	expectError(t, err)
+
+	res, err := items.GetItems(ctx, &gw.ItemFilter{
+		AssetClassId: class.Id,
+		Attributes: []*gw.AttributeFilter{
+			{Key: "seats", Operator: "gt", Value: structpb.NewNumberValue(10)},
+		},
+	})
+	expectNoError(t, err)
+
+	if len(res.Items) != 1 || res.Items[0].Name != "Large license" {
+		t.Errorf("Unexpected filter result: %v", res.Items)
+	}
+
+	res, err = items.GetItems(ctx, &gw.ItemFilter{
+		AssetClassId: class.Id,
+		Attributes: []*gw.AttributeFilter{
+			{Key: "expires", Operator: "exists"},
+		},
+	})
+	expectNoError(t, err)
+
+	if len(res.Items) != 1 || res.Items[0].Id != small.Id {
+		t.Errorf("Unexpected filter result: %v", res.Items)
+	}
+
+	_, err = items.GetItems(ctx, &gw.ItemFilter{
+		Attributes: []*gw.AttributeFilter{{Key: "unknown_attribute", Operator: "eq"}},
+	})
+	expectError(t, err)
+}
+
+func TestItemServer_CRUD(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "item_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Server")
+	target := createTestAssetClass(t, ctx, "Domain",
+		&gw.AttributeDefinition{Key: "server", Name: "Server", Type: "reference"},
+	)
+
+	server, err := items.CreateItem(ctx, &gw.Item{Name: "web-1", AssetClassId: class.Id})
+	expectNoError(t, err)
+
+	domain, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "example.com",
+		AssetClassId: target.Id,
+		Attributes:   map[string]*structpb.Value{"server": structpb.NewStringValue(server.Id)},
+	})
+	expectNoError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "example.org",
+		AssetClassId: target.Id,
+		Attributes:   map[string]*structpb.Value{"server": structpb.NewStringValue(domain.AssetClassId)},
+	})
+	expectError(t, err)
+
+	domain.Description = "main domain"
+	updated, err := items.UpdateItem(ctx, domain)
+	expectNoError(t, err)
+
+	if updated.Description != "main domain" || updated.Attributes["server"].GetStringValue() != server.Id {
+		t.Errorf("Unexpected updated item: %v", updated)
+	}
+
+	_, err = items.DeleteItem(ctx, &gw.DeleteItemRequest{Id: domain.Id})
+	expectNoError(t, err)
+
+	_, err = items.GetItem(ctx, &gw.ElementId{Id: domain.Id})
+	expectError(t, err)
+}
+
+func TestAssetClassServer_AttributeDefinitions(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "class_tester")
+	classes := NewAssetClassServer()
+
+	class := createTestAssetClass(t, ctx, "Certificate",
+		&gw.AttributeDefinition{Key: "issuer", Name: "Issuer", Type: "string"},
+	)
+
+	_, err := classes.CreateAttributeDefinition(ctx, &gw.AttributeDefinition{AssetClassId: class.Id, Key: "issuer", Name: "Issuer", Type: "string"})
+	expectError(t, err)
+
+	_, err = classes.CreateAttributeDefinition(ctx, &gw.AttributeDefinition{AssetClassId: class.Id, Key: "kind", Name: "Kind", Type: "color"})
+	expectError(t, err)
+
+	defs, err := classes.GetAttributeDefinitions(ctx, &gw.ElementId{Id: class.Id})
+	expectNoError(t, err)
+
+	if len(defs.Attributes) != 1 || defs.Attributes[0].AssetClassId != class.Id {
+		t.Fatalf("Unexpected attribute definitions: %v", defs.Attributes)
+	}
+
+	def := defs.Attributes[0]
+	def.Name = "Certificate issuer"
+	updated, err := classes.UpdateAttributeDefinition(ctx, def)
+	expectNoError(t, err)
+
+	if updated.Name != "Certificate issuer" {
+		t.Errorf("Unexpected updated attribute definition: %v", updated)
+	}
+
+	_, err = classes.DeleteAttributeDefinition(ctx, &gw.ElementId{Id: def.Id})
+	expectNoError(t, err)
+
+	defs, err = classes.GetAttributeDefinitions(ctx, &gw.ElementId{Id: class.Id})
+	expectNoError(t, err)
+
+	if len(defs.Attributes) != 0 {
+		t.Errorf("Expected deleted attribute definition to be hidden, got %v", defs.Attributes)
+	}
+}
+
+func TestItemServer_GetItemsQuery(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "query_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Queried",
+		&gw.AttributeDefinition{Key: "datacenter", Name: "Datacenter", Type: "string"},
+	)
+
+	_, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "queried-1",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"datacenter": structpb.NewStringValue("fsn1")},
+	})
+	expectNoError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "queried-2",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"datacenter": structpb.NewStringValue("nbg1")},
+	})
+	expectNoError(t, err)
+
+	res, err := items.GetItems(ctx, &gw.ItemFilter{Query: "class:queried datacenter=fsn1"})
+	expectNoError(t, err)
+
+	if len(res.Items) != 1 || res.Items[0].Name != "queried-1" {
+		t.Errorf("Unexpected query result: %v", res.Items)
+	}
+
+	_, err = items.GetItems(ctx, &gw.ItemFilter{Query: "class:queried datacenter<fsn1"})
+	expectError(t, err)
+}
@@ -0,0 +1,382 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schedule"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"maps"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const jobWatchPath = "/jobs/{id}/watch"
+
+const (
+	defaultJobRunLimit = 50
+	maxJobRunLimit     = 500
+)
+
+// jobWatchInterval is how often a watched job run is checked for changes.
+var jobWatchInterval = time.Second
+
+type jobServer struct {
+	gw.UnimplementedJobServiceServer
+}
+
+func (s jobServer) GetJobRuns(ctx context.Context, filter *gw.JobRunFilter) (*gw.JobRuns, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	predicates := []predicate.JobRun{jobrun.DeletedAtIsNil()}
+	if filter.Type != "" {
+		predicates = append(predicates, jobrun.Type(filter.Type))
+	}
+
+	if filter.Status != "" {
+		if err := jobrun.StatusValidator(jobrun.Status(filter.Status)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid job status %q", filter.Status)
+		}
+		predicates = append(predicates, jobrun.StatusEQ(jobrun.Status(filter.Status)))
+	}
+
+	if filter.ScheduleId != "" {
+		scheduleUuid, err := uuid.Parse(filter.ScheduleId)
+		if err != nil {
+			grpclog.Errorf("Invalid UUID format for schedule ID: %v", err)
+			return nil, status.Errorf(codes.InvalidArgument, "invalid schedule ID format: %v", err)
+		}
+		predicates = append(predicates, jobrun.HasScheduleWith(schedule.ID(scheduleUuid)))
+	}
+
+	limit := int(filter.Limit)
+	if limit <= 0 {
+		limit = defaultJobRunLimit
+	}
+	limit = min(limit, maxJobRunLimit)
+
+	runs, err := client.JobRun.Query().
+		Where(predicates...).
+		WithSchedule().
+		Order(jobrun.ByCreatedAt(sql.OrderDesc()), jobrun.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query job runs: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query job runs: %v", err)
+	}
+
+	log.S.Debugw("Retrieved job runs", "count", len(runs))
+
+	res := make([]*gw.JobRun, 0, len(runs))
+	for _, run := range runs {
+		res = append(res, toJobRun(run))
+	}
+
+	return &gw.JobRuns{
+		Runs: res,
+	}, nil
+}
+
+func (s jobServer) GetJobRun(ctx context.Context, elementId *gw.ElementId) (*gw.JobRun, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	run, err := jobRun(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toJobRun(run), nil
+}
+
+func (s jobServer) CancelJobRun(ctx context.Context, elementId *gw.ElementId) (*gw.JobRun, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	run, err := jobRun(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the status is only changed if no worker claimed the run in the meantime
+	canceled, err := client.JobRun.Update().
+		Where(jobrun.ID(run.ID), jobrun.StatusEQ(jobrun.StatusQueued)).
+		SetStatus(jobrun.StatusCanceled).
+		SetCancelRequested(true).
+		SetFinishedAt(time.Now()).
+		SetError("canceled by user").
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to cancel job run: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel job run: %v", err)
+	}
+
+	if canceled == 0 {
+		requested, err := client.JobRun.Update().
+			Where(jobrun.ID(run.ID), jobrun.StatusEQ(jobrun.StatusRunning)).
+			SetCancelRequested(true).
+			SetUpdatedBy(user).
+			Save(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to cancel job run: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to cancel job run: %v", err)
+		}
+
+		if requested == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "job run is already finished")
+		}
+	}
+	log.S.Debugw("Requested cancellation of job run", "id", run.ID, "queued", canceled > 0)
+
+	return s.GetJobRun(ctx, elementId)
+}
+
+func (s jobServer) RetryJobRun(ctx context.Context, elementId *gw.ElementId) (*gw.JobRun, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	run, err := jobRun(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if run.Status != jobrun.StatusFailed && run.Status != jobrun.StatusCanceled {
+		return nil, status.Errorf(codes.FailedPrecondition, "only failed or canceled job runs can be retried, the run is %s", run.Status)
+	}
+
+	// the retry runs with the visibility of the retrying user, not of the original one
+	parameters := maps.Clone(run.Parameters)
+	if _, ok := parameters["scopes"]; ok {
+		scopes, _ := ctx.Value(AuthenticatedScopesKey).([]string)
+		parameters["scopes"] = strings.Join(scopes, " ")
+	}
+
+	create := client.JobRun.Create().
+		SetType(run.Type).
+		SetParameters(parameters).
+		SetRetryOf(run.ID).
+		SetCreatedBy(user).
+		SetUpdatedBy(user)
+	if run.Edges.Schedule != nil {
+		create = create.SetSchedule(run.Edges.Schedule)
+	}
+
+	retry, err := create.Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to queue job run: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to queue job run: %v", err)
+	}
+	log.S.Debugw("Queued retry of job run", "id", retry.ID, "retryOf", run.ID)
+
+	return s.GetJobRun(ctx, &gw.ElementId{Id: retry.ID.String()})
+}
+
+func (s jobServer) WatchJobRun(elementId *gw.ElementId, stream grpc.ServerStreamingServer[gw.JobRun]) error {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	ctx := stream.Context()
+
+	var updatedAt time.Time
+	for {
+		run, err := jobRun(ctx, client, elementId.Id)
+		if err != nil {
+			return err
+		}
+
+		if !run.UpdatedAt.Equal(updatedAt) {
+			if err := stream.Send(toJobRun(run)); err != nil {
+				return err
+			}
+			updatedAt = run.UpdatedAt
+		}
+
+		if run.FinishedAt != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(jobWatchInterval):
+		}
+	}
+}
+
+func jobRun(ctx context.Context, client *ent.Client, id string) (*ent.JobRun, error) {
+	runUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for job run ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid job run ID format: %v", err)
+	}
+
+	run, err := client.JobRun.Query().
+		Where(jobrun.ID(runUuid), jobrun.DeletedAtIsNil()).
+		WithSchedule().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "job run not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query job run: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query job run: %v", err)
+	}
+
+	return run, nil
+}
+
+// jobRunHTTPStream sends the messages of WatchJobRun as newline-delimited JSON, as the in-process gateway doesn't
+// support streaming.
+type jobRunHTTPStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	w    http.ResponseWriter
+	sent bool
+}
+
+func (s *jobRunHTTPStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *jobRunHTTPStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *jobRunHTTPStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *jobRunHTTPStream) SetTrailer(metadata.MD) {
+}
+
+func (s *jobRunHTTPStream) Send(run *gw.JobRun) error {
+	encoded, err := protojson.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to encode job run: %w", err)
+	}
+
+	if !s.sent {
+		s.w.Header().Set("Content-Type", "application/x-ndjson")
+		s.sent = true
+	}
+
+	if _, err := fmt.Fprintf(s.w, "{\"result\":%s}\n", encoded); err != nil {
+		return err
+	}
+
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+func jobWatchHandler(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	stream := &jobRunHTTPStream{ctx: r.Context(), w: w}
+
+	err := NewJobServer().WatchJobRun(&gw.ElementId{Id: pathParams["id"]}, stream)
+	if err == nil {
+		return
+	}
+
+	// once the first message was sent, the status can't be changed anymore
+	if !stream.sent {
+		writeStatusError(w, err)
+		return
+	}
+
+	log.S.Errorw("Failed to watch job run", "id", pathParams["id"], "error", err)
+	encoded, _ := protojson.Marshal(status.Convert(err).Proto())
+	_, _ = fmt.Fprintf(w, "{\"error\":%s}\n", encoded)
+}
+
+func toJobRun(run *ent.JobRun) *gw.JobRun {
+	res := &gw.JobRun{
+		Id:              run.ID.String(),
+		Type:            run.Type,
+		Parameters:      run.Parameters,
+		Status:          string(run.Status),
+		Progress:        int32(run.Progress),
+		Log:             run.Log,
+		Error:           run.Error,
+		Result:          run.Result,
+		CancelRequested: run.CancelRequested,
+		CreatedAt:       timestamppb.New(run.CreatedAt),
+		CreatedBy:       run.CreatedBy,
+	}
+
+	if run.StartedAt != nil {
+		res.StartedAt = timestamppb.New(*run.StartedAt)
+	}
+
+	if run.HeartbeatAt != nil {
+		res.HeartbeatAt = timestamppb.New(*run.HeartbeatAt)
+	}
+
+	if run.FinishedAt != nil {
+		res.FinishedAt = timestamppb.New(*run.FinishedAt)
+	}
+
+	if run.Edges.Schedule != nil {
+		res.ScheduleId = run.Edges.Schedule.ID.String()
+	}
+
+	if run.RetryOf != nil {
+		res.RetryOf = run.RetryOf.String()
+	}
+
+	if run.RunAfter != nil {
+		res.RunAfter = timestamppb.New(*run.RunAfter)
+	}
+
+	return res
+}
+
+func registerJobHandlers(ctx context.Context, mux *runtime.ServeMux) error {
+	if err := gw.RegisterJobServiceHandlerServer(ctx, mux, NewJobServer()); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodGet, jobWatchPath, jobWatchHandler)
+}
+
+func NewJobServer() gw.JobServiceServer {
+	return &jobServer{}
+}
@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestJobServer_CancelAndRetry(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "job_tester")
+	jobs := NewJobServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	queued, err := worker.Enqueue(ctx, client, "cancel_test", map[string]string{"provider": "hetzner"}, "job_tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	canceled, err := jobs.CancelJobRun(ctx, &gw.ElementId{Id: queued.ID.String()})
+	expectNoError(t, err)
+
+	if canceled.Status != string(jobrun.StatusCanceled) || canceled.FinishedAt == nil {
+		t.Errorf("Expected queued run to be canceled immediately, got %v", canceled)
+	}
+
+	_, err = jobs.CancelJobRun(ctx, &gw.ElementId{Id: queued.ID.String()})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition for finished run, got %v", err)
+	}
+
+	retry, err := jobs.RetryJobRun(ctx, &gw.ElementId{Id: queued.ID.String()})
+	expectNoError(t, err)
+
+	if retry.Status != string(jobrun.StatusQueued) || retry.RetryOf != queued.ID.String() || retry.Parameters["provider"] != "hetzner" {
+		t.Errorf("Unexpected retry: %v", retry)
+	}
+
+	_, err = jobs.RetryJobRun(ctx, &gw.ElementId{Id: retry.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition for retry of queued run, got %v", err)
+	}
+
+	// running runs are canceled by their worker
+	client.JobRun.UpdateOneID(uuid.MustParse(retry.Id)).SetStatus(jobrun.StatusRunning).SetUpdatedBy("worker").ExecX(ctx)
+	requested, err := jobs.CancelJobRun(ctx, &gw.ElementId{Id: retry.Id})
+	expectNoError(t, err)
+
+	if requested.Status != string(jobrun.StatusRunning) || !requested.CancelRequested {
+		t.Errorf("Expected cancellation of running run to be requested, got %v", requested)
+	}
+
+	runs, err := jobs.GetJobRuns(ctx, &gw.JobRunFilter{Type: "cancel_test", Status: string(jobrun.StatusCanceled)})
+	expectNoError(t, err)
+
+	if len(runs.Runs) != 1 || runs.Runs[0].Id != queued.ID.String() {
+		t.Errorf("Unexpected filtered runs: %v", runs.Runs)
+	}
+
+	// a retry must not inherit the visibility of another user
+	scoped, err := worker.Enqueue(ctx, client, "cancel_test", map[string]string{"scopes": "admin"}, "other_tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+	client.JobRun.UpdateOneID(scoped.ID).SetStatus(jobrun.StatusFailed).SetUpdatedBy("worker").ExecX(ctx)
+
+	scopedRetry, err := jobs.RetryJobRun(context.WithValue(ctx, AuthenticatedScopesKey, []string{"staff"}), &gw.ElementId{Id: scoped.ID.String()})
+	expectNoError(t, err)
+
+	if scopedRetry.Parameters["scopes"] != "staff" {
+		t.Errorf("Expected retry to run with the scopes of the retrying user, got %v", scopedRetry.Parameters)
+	}
+
+	_, err = jobs.GetJobRuns(ctx, &gw.JobRunFilter{Status: "exploded"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unknown status, got %v", err)
+	}
+
+	_, err = jobs.GetJobRun(ctx, &gw.ElementId{Id: uuid.NewString()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for unknown run, got %v", err)
+	}
+}
+
+func TestJobServer_WatchJobRun(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "job_watcher")
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	jobWatchInterval = 10 * time.Millisecond
+	t.Cleanup(func() {
+		jobWatchInterval = time.Second
+	})
+
+	run, err := worker.Enqueue(ctx, client, "watch_test", nil, "job_watcher")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		client.JobRun.UpdateOneID(run.ID).SetStatus(jobrun.StatusRunning).SetProgress(40).SetUpdatedBy("worker").ExecX(ctx)
+		time.Sleep(50 * time.Millisecond)
+		client.JobRun.UpdateOneID(run.ID).
+			SetStatus(jobrun.StatusSucceeded).
+			SetProgress(100).
+			SetFinishedAt(time.Now()).
+			SetUpdatedBy("worker").
+			ExecX(ctx)
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "/jobs/"+run.ID.String()+"/watch", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	jobWatchHandler(w, req, map[string]string{"id": run.ID.String()})
+
+	lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 updates, got %q", lines)
+	}
+
+	if !strings.Contains(lines[1], `"progress":40`) || !strings.Contains(lines[2], `"status":"succeeded"`) {
+		t.Errorf("Unexpected updates: %q", lines)
+	}
+
+	w = httptest.NewRecorder()
+	jobWatchHandler(w, req.WithContext(context.Background()), map[string]string{"id": "invalid"})
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Expected bad request for invalid ID, got %d", w.Code)
+	}
+}
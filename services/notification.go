@@ -0,0 +1,491 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/reminderrule"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/notify"
+	"dig-inv/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"maps"
+	"time"
+)
+
+// channel settings that are never returned by the API
+var secretChannelConfig = []string{"password"}
+
+type notificationServer struct {
+	gw.UnimplementedNotificationServiceServer
+}
+
+func (s notificationServer) GetChannels(ctx context.Context, _ *gw.EmptyMessage) (*gw.NotificationChannels, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	channels, err := client.NotificationChannel.Query().
+		Where(notificationchannel.DeletedAtIsNil()).
+		Order(notificationchannel.ByName()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query notification channels: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query notification channels: %v", err)
+	}
+
+	log.S.Debugw("Retrieved notification channels", "count", len(channels))
+
+	res := make([]*gw.NotificationChannel, 0, len(channels))
+	for _, channel := range channels {
+		res = append(res, toNotificationChannel(channel))
+	}
+
+	return &gw.NotificationChannels{
+		Channels: res,
+	}, nil
+}
+
+func (s notificationServer) CreateChannel(ctx context.Context, req *gw.NotificationChannel) (*gw.NotificationChannel, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if err := validateChannel(req.Name, req.Type, req.Config); err != nil {
+		return nil, err
+	}
+
+	channel, err := client.NotificationChannel.Create().
+		SetName(req.Name).
+		SetType(notificationchannel.Type(req.Type)).
+		SetConfig(req.Config).
+		SetEnabled(req.Enabled).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create notification channel: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create notification channel: %v", err)
+	}
+	log.S.Debugw("Created new notification channel", "id", channel.ID, "type", channel.Type)
+
+	return toNotificationChannel(channel), nil
+}
+
+func (s notificationServer) UpdateChannel(ctx context.Context, req *gw.NotificationChannel) (*gw.NotificationChannel, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	channel, err := activeChannel(ctx, client, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// secrets are not returned, so clients send them empty to keep them
+	config := maps.Clone(req.Config)
+	if config == nil {
+		config = make(map[string]string)
+	}
+	for _, key := range secretChannelConfig {
+		if config[key] == "" && channel.Config[key] != "" {
+			config[key] = channel.Config[key]
+		}
+	}
+
+	if err := validateChannel(req.Name, req.Type, config); err != nil {
+		return nil, err
+	}
+
+	channel, err = client.NotificationChannel.UpdateOne(channel).
+		SetName(req.Name).
+		SetType(notificationchannel.Type(req.Type)).
+		SetConfig(config).
+		SetEnabled(req.Enabled).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to update notification channel: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update notification channel: %v", err)
+	}
+	log.S.Debugw("Updated notification channel", "id", channel.ID)
+
+	return toNotificationChannel(channel), nil
+}
+
+func (s notificationServer) DeleteChannel(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	channel, err := activeChannel(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.NotificationChannel.UpdateOne(channel).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Exec(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete notification channel: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete notification channel: %v", err)
+	}
+	log.S.Debugw("Deleted notification channel", "id", channel.ID)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s notificationServer) TestChannel(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	channel, err := activeChannel(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := notify.NewSender(channel)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "invalid channel: %v", err)
+	}
+
+	err = sender.Send(ctx, notify.Message{
+		Subject: "Test notification",
+		Text:    "This is a test notification of the channel " + channel.Name + ".",
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to send test notification: %v", err)
+	}
+	log.S.Debugw("Sent test notification", "id", channel.ID)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s notificationServer) GetReminderRules(ctx context.Context, _ *gw.EmptyMessage) (*gw.ReminderRules, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	rules, err := client.ReminderRule.Query().
+		Where(reminderrule.DeletedAtIsNil()).
+		WithAssetClass().
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.DeletedAtIsNil())
+		}).
+		Order(reminderrule.ByCreatedAt(), reminderrule.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query reminder rules: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query reminder rules: %v", err)
+	}
+
+	log.S.Debugw("Retrieved reminder rules", "count", len(rules))
+
+	res := make([]*gw.ReminderRule, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, toReminderRule(rule))
+	}
+
+	return &gw.ReminderRules{
+		Rules: res,
+	}, nil
+}
+
+func (s notificationServer) CreateReminderRule(ctx context.Context, req *gw.ReminderRule) (*gw.ReminderRule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	classUuid, channelUuids, days, err := validateReminderRule(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := client.ReminderRule.Create().
+		SetAssetClassID(classUuid).
+		SetAttributeKey(req.AttributeKey).
+		SetDays(days).
+		AddChannelIDs(channelUuids...).
+		SetEnabled(req.Enabled).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create reminder rule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create reminder rule: %v", err)
+	}
+	log.S.Debugw("Created new reminder rule", "id", rule.ID, "assetClass", classUuid)
+
+	return reminderRule(ctx, client, rule.ID)
+}
+
+func (s notificationServer) UpdateReminderRule(ctx context.Context, req *gw.ReminderRule) (*gw.ReminderRule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	ruleUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for reminder rule ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reminder rule ID format: %v", err)
+	}
+
+	classUuid, channelUuids, days, err := validateReminderRule(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.ReminderRule.UpdateOneID(ruleUuid).
+		Where(reminderrule.DeletedAtIsNil()).
+		SetAssetClassID(classUuid).
+		SetAttributeKey(req.AttributeKey).
+		SetDays(days).
+		ClearChannels().
+		AddChannelIDs(channelUuids...).
+		SetEnabled(req.Enabled).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "reminder rule not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update reminder rule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update reminder rule: %v", err)
+	}
+	log.S.Debugw("Updated reminder rule", "id", ruleUuid)
+
+	return reminderRule(ctx, client, ruleUuid)
+}
+
+func (s notificationServer) DeleteReminderRule(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	ruleUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for reminder rule ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reminder rule ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.ReminderRule.UpdateOneID(ruleUuid).
+		Where(reminderrule.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "reminder rule not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to delete reminder rule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete reminder rule: %v", err)
+	}
+	log.S.Debugw("Deleted reminder rule", "id", ruleUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func activeChannel(ctx context.Context, client *ent.Client, id string) (*ent.NotificationChannel, error) {
+	channelUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for notification channel ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid notification channel ID format: %v", err)
+	}
+
+	channel, err := client.NotificationChannel.Query().
+		Where(notificationchannel.ID(channelUuid), notificationchannel.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "notification channel not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query notification channel: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query notification channel: %v", err)
+	}
+
+	return channel, nil
+}
+
+func reminderRule(ctx context.Context, client *ent.Client, id uuid.UUID) (*gw.ReminderRule, error) {
+	rule, err := client.ReminderRule.Query().
+		Where(reminderrule.ID(id)).
+		WithAssetClass().
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.DeletedAtIsNil())
+		}).
+		Only(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query reminder rule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query reminder rule: %v", err)
+	}
+
+	return toReminderRule(rule), nil
+}
+
+func validateChannel(name, channelType string, config map[string]string) error {
+	if name == "" {
+		return status.Errorf(codes.InvalidArgument, "channel name must not be empty")
+	}
+
+	if err := notificationchannel.TypeValidator(notificationchannel.Type(channelType)); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid channel type %q, expected smtp, webhook or slack", channelType)
+	}
+
+	if _, err := notify.NewSender(&ent.NotificationChannel{Type: notificationchannel.Type(channelType), Config: config}); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid channel: %v", err)
+	}
+
+	return nil
+}
+
+// validateReminderRule checks that the asset class, its date attribute and the channels exist.
+func validateReminderRule(ctx context.Context, client *ent.Client, req *gw.ReminderRule) (uuid.UUID, []uuid.UUID, []int, error) {
+	classUuid, err := uuid.Parse(req.AssetClassId)
+	if err != nil {
+		return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+	}
+
+	exists, err := client.AssetClass.Query().Where(assetclass.ID(classUuid), assetclass.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query asset class: %v", err)
+		return uuid.Nil, nil, nil, status.Errorf(codes.Internal, "failed to query asset class: %v", err)
+	}
+	if !exists {
+		return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "asset class not found")
+	}
+
+	if req.AttributeKey != "" {
+		exists, err := client.AttributeDefinition.Query().
+			Where(
+				attributedefinition.Key(req.AttributeKey),
+				attributedefinition.TypeEQ(attributedefinition.TypeDate),
+				attributedefinition.DeletedAtIsNil(),
+				attributedefinition.HasAssetClassWith(assetclass.ID(classUuid)),
+			).
+			Exist(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query attribute definition: %v", err)
+			return uuid.Nil, nil, nil, status.Errorf(codes.Internal, "failed to query attribute definition: %v", err)
+		}
+		if !exists {
+			return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "asset class has no date attribute %q", req.AttributeKey)
+		}
+	}
+
+	if len(req.Days) == 0 {
+		return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "at least one number of days is required")
+	}
+
+	days := make([]int, 0, len(req.Days))
+	for _, d := range req.Days {
+		if d < 0 {
+			return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "days must not be negative")
+		}
+		days = append(days, int(d))
+	}
+
+	channelUuids := make([]uuid.UUID, 0, len(req.ChannelIds))
+	for _, id := range req.ChannelIds {
+		channelUuid, err := uuid.Parse(id)
+		if err != nil {
+			return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid notification channel ID format: %v", err)
+		}
+		channelUuids = append(channelUuids, channelUuid)
+	}
+
+	count, err := client.NotificationChannel.Query().
+		Where(notificationchannel.IDIn(channelUuids...), notificationchannel.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query notification channels: %v", err)
+		return uuid.Nil, nil, nil, status.Errorf(codes.Internal, "failed to query notification channels: %v", err)
+	}
+	if count != len(channelUuids) {
+		return uuid.Nil, nil, nil, status.Errorf(codes.InvalidArgument, "notification channel not found")
+	}
+
+	return classUuid, channelUuids, days, nil
+}
+
+func toNotificationChannel(channel *ent.NotificationChannel) *gw.NotificationChannel {
+	config := maps.Clone(channel.Config)
+	for _, key := range secretChannelConfig {
+		delete(config, key)
+	}
+
+	return &gw.NotificationChannel{
+		Id:      channel.ID.String(),
+		Name:    channel.Name,
+		Type:    string(channel.Type),
+		Config:  config,
+		Enabled: channel.Enabled,
+	}
+}
+
+func toReminderRule(rule *ent.ReminderRule) *gw.ReminderRule {
+	days := make([]int32, 0, len(rule.Days))
+	for _, d := range rule.Days {
+		days = append(days, int32(d))
+	}
+
+	channelIds := make([]string, 0, len(rule.Edges.Channels))
+	for _, channel := range rule.Edges.Channels {
+		channelIds = append(channelIds, channel.ID.String())
+	}
+
+	res := &gw.ReminderRule{
+		Id:           rule.ID.String(),
+		AttributeKey: rule.AttributeKey,
+		Days:         days,
+		ChannelIds:   channelIds,
+		Enabled:      rule.Enabled,
+	}
+
+	if rule.Edges.AssetClass != nil {
+		res.AssetClassId = rule.Edges.AssetClass.ID.String()
+	}
+
+	return res
+}
+
+func NewNotificationServer() gw.NotificationServiceServer {
+	return &notificationServer{}
+}
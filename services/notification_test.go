@@ -0,0 +1,158 @@
+package services
+
+import (
+	"dig-inv/ent/attributedefinition"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNotificationServer_Channels(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "notification_tester")
+	notifications := NewNotificationServer()
+
+	calls := 0
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	}))
+	defer receiver.Close()
+
+	mail, err := notifications.CreateChannel(ctx, &gw.NotificationChannel{
+		Name:    "Ops mail",
+		Type:    "smtp",
+		Config:  map[string]string{"host": "mail.example.com", "from": "inv@example.com", "to": "ops@example.com", "username": "inv", "password": "secret"},
+		Enabled: true,
+	})
+	expectNoError(t, err)
+
+	if _, ok := mail.Config["password"]; ok || mail.Config["username"] != "inv" {
+		t.Errorf("Expected password to be hidden, got %v", mail.Config)
+	}
+
+	mail.Config["to"] = "noc@example.com"
+	_, err = notifications.UpdateChannel(ctx, mail)
+	expectNoError(t, err)
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	stored := client.NotificationChannel.GetX(ctx, uuid.MustParse(mail.Id))
+	if stored.Config["password"] != "secret" || stored.Config["to"] != "noc@example.com" {
+		t.Errorf("Expected password to be kept on update, got %v", stored.Config)
+	}
+
+	invalid := []*gw.NotificationChannel{
+		{Name: "", Type: "webhook", Config: map[string]string{"url": receiver.URL}},
+		{Name: "Pager", Type: "pager", Config: map[string]string{"url": receiver.URL}},
+		{Name: "Hook", Type: "webhook", Config: map[string]string{"url": "example.com"}},
+		{Name: "Mail", Type: "smtp", Config: map[string]string{"host": "mail.example.com"}},
+	}
+	for _, req := range invalid {
+		_, err = notifications.CreateChannel(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for %v, got %v", req, err)
+		}
+	}
+
+	hook, err := notifications.CreateChannel(ctx, &gw.NotificationChannel{Name: "Hook", Type: "webhook", Config: map[string]string{"url": receiver.URL}})
+	expectNoError(t, err)
+
+	_, err = notifications.TestChannel(ctx, &gw.ElementId{Id: hook.Id})
+	expectNoError(t, err)
+
+	if calls != 1 {
+		t.Errorf("Expected test notification to be sent once, got %d", calls)
+	}
+
+	_, err = notifications.DeleteChannel(ctx, &gw.ElementId{Id: hook.Id})
+	expectNoError(t, err)
+
+	_, err = notifications.TestChannel(ctx, &gw.ElementId{Id: hook.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for deleted channel, got %v", err)
+	}
+
+	channels, err := notifications.GetChannels(ctx, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	for _, channel := range channels.Channels {
+		if channel.Id == hook.Id {
+			t.Errorf("Expected deleted channel to be hidden")
+		}
+	}
+}
+
+func TestNotificationServer_ReminderRules(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "notification_tester")
+	notifications := NewNotificationServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	class := client.AssetClass.Create().SetName("Certificate").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	client.AttributeDefinition.Create().
+		SetName("Not after").
+		SetKey("not_after").
+		SetType(attributedefinition.TypeDate).
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	channel, err := notifications.CreateChannel(ctx, &gw.NotificationChannel{Name: "Rules hook", Type: "slack", Config: map[string]string{"url": "https://hooks.example.com/x"}, Enabled: true})
+	expectNoError(t, err)
+
+	rule, err := notifications.CreateReminderRule(ctx, &gw.ReminderRule{
+		AssetClassId: class.ID.String(),
+		AttributeKey: "not_after",
+		Days:         []int32{30, 7},
+		ChannelIds:   []string{channel.Id},
+		Enabled:      true,
+	})
+	expectNoError(t, err)
+
+	if rule.AssetClassId != class.ID.String() || len(rule.ChannelIds) != 1 || len(rule.Days) != 2 {
+		t.Errorf("Unexpected rule: %v", rule)
+	}
+
+	rule.Days = []int32{1}
+	rule.ChannelIds = nil
+	updated, err := notifications.UpdateReminderRule(ctx, rule)
+	expectNoError(t, err)
+
+	if len(updated.ChannelIds) != 0 || len(updated.Days) != 1 || updated.Days[0] != 1 {
+		t.Errorf("Unexpected updated rule: %v", updated)
+	}
+
+	invalid := []*gw.ReminderRule{
+		{AssetClassId: uuid.NewString(), Days: []int32{1}},
+		{AssetClassId: class.ID.String(), AttributeKey: "unknown", Days: []int32{1}},
+		{AssetClassId: class.ID.String(), Days: []int32{-1}},
+		{AssetClassId: class.ID.String()},
+		{AssetClassId: class.ID.String(), Days: []int32{1}, ChannelIds: []string{uuid.NewString()}},
+	}
+	for _, req := range invalid {
+		_, err = notifications.CreateReminderRule(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for %v, got %v", req, err)
+		}
+	}
+
+	_, err = notifications.DeleteReminderRule(ctx, &gw.ElementId{Id: rule.Id})
+	expectNoError(t, err)
+
+	_, err = notifications.DeleteReminderRule(ctx, &gw.ElementId{Id: rule.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for deleted rule, got %v", err)
+	}
+}
@@ -0,0 +1,398 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/item"
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/probe"
+	"dig-inv/ent/proberesult"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// the number of probe results returned if the filter sets no limit
+const defaultProbeResultLimit = 100
+
+type probeServer struct {
+	gw.UnimplementedProbeServiceServer
+}
+
+func (s probeServer) GetProbes(ctx context.Context, _ *gw.EmptyMessage) (*gw.Probes, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	probes, err := client.Probe.Query().
+		Where(probe.DeletedAtIsNil()).
+		WithItem().
+		WithAssetClass().
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.DeletedAtIsNil())
+		}).
+		Order(probe.ByName(), probe.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query probes: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query probes: %v", err)
+	}
+
+	log.S.Debugw("Retrieved probes", "count", len(probes))
+
+	res := make([]*gw.Probe, 0, len(probes))
+	for _, p := range probes {
+		res = append(res, toProbe(p))
+	}
+
+	return &gw.Probes{
+		Probes: res,
+	}, nil
+}
+
+func (s probeServer) CreateProbe(ctx context.Context, req *gw.Probe) (*gw.Probe, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	itemUuid, classUuid, channelUuids, err := validateProbe(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := client.Probe.Create().
+		SetName(req.Name).
+		SetType(probe.Type(req.Type)).
+		SetHost(strings.TrimSpace(req.Host)).
+		SetPort(int(req.Port)).
+		SetPath(req.Path).
+		SetExpectedStatus(int(req.ExpectedStatus)).
+		SetTimeout(probeTimeout(req)).
+		SetEnabled(req.Enabled).
+		SetNillableItemID(itemUuid).
+		SetNillableAssetClassID(classUuid).
+		AddChannelIDs(channelUuids...).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create probe: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create probe: %v", err)
+	}
+	log.S.Debugw("Created new probe", "id", p.ID, "name", p.Name)
+
+	return probeById(ctx, client, p.ID)
+}
+
+func (s probeServer) UpdateProbe(ctx context.Context, req *gw.Probe) (*gw.Probe, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	probeUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for probe ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid probe ID format: %v", err)
+	}
+
+	itemUuid, classUuid, channelUuids, err := validateProbe(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Probe.UpdateOneID(probeUuid).
+		Where(probe.DeletedAtIsNil()).
+		SetName(req.Name).
+		SetType(probe.Type(req.Type)).
+		SetHost(strings.TrimSpace(req.Host)).
+		SetPort(int(req.Port)).
+		SetPath(req.Path).
+		SetExpectedStatus(int(req.ExpectedStatus)).
+		SetTimeout(probeTimeout(req)).
+		SetEnabled(req.Enabled).
+		ClearItem().
+		SetNillableItemID(itemUuid).
+		ClearAssetClass().
+		SetNillableAssetClassID(classUuid).
+		ClearChannels().
+		AddChannelIDs(channelUuids...).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "probe not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update probe: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update probe: %v", err)
+	}
+	log.S.Debugw("Updated probe", "id", probeUuid)
+
+	return probeById(ctx, client, probeUuid)
+}
+
+func (s probeServer) DeleteProbe(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	probeUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for probe ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid probe ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.Probe.UpdateOneID(probeUuid).
+		Where(probe.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "probe not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to delete probe: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete probe: %v", err)
+	}
+	log.S.Debugw("Deleted probe", "id", probeUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s probeServer) GetProbeResults(ctx context.Context, req *gw.ProbeResultFilter) (*gw.ProbeResults, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	query := client.ProbeResult.Query().WithProbe().WithItem()
+
+	if req.ItemId != "" {
+		itemUuid, err := uuid.Parse(req.ItemId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+		}
+		query = query.Where(proberesult.HasItemWith(item.ID(itemUuid)))
+	}
+
+	if req.ProbeId != "" {
+		probeUuid, err := uuid.Parse(req.ProbeId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid probe ID format: %v", err)
+		}
+		query = query.Where(proberesult.HasProbeWith(probe.ID(probeUuid)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultProbeResultLimit
+	}
+
+	results, err := query.
+		Order(proberesult.ByCreatedAt(sql.OrderDesc()), proberesult.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query probe results: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query probe results: %v", err)
+	}
+
+	log.S.Debugw("Retrieved probe results", "item", req.ItemId, "probe", req.ProbeId, "count", len(results))
+
+	res := make([]*gw.ProbeResult, 0, len(results))
+	for _, r := range results {
+		res = append(res, toProbeResult(r))
+	}
+
+	return &gw.ProbeResults{
+		Results: res,
+	}, nil
+}
+
+func probeById(ctx context.Context, client *ent.Client, id uuid.UUID) (*gw.Probe, error) {
+	p, err := client.Probe.Query().
+		Where(probe.ID(id)).
+		WithItem().
+		WithAssetClass().
+		WithChannels(func(q *ent.NotificationChannelQuery) {
+			q.Where(notificationchannel.DeletedAtIsNil())
+		}).
+		Only(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query probe: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query probe: %v", err)
+	}
+
+	return toProbe(p), nil
+}
+
+func probeTimeout(req *gw.Probe) int {
+	if req.Timeout == 0 {
+		return probe.DefaultTimeout
+	}
+
+	return int(req.Timeout)
+}
+
+// validateProbe checks the settings of the probe and that either its item or its asset class exists, as well as its
+// channels.
+func validateProbe(ctx context.Context, client *ent.Client, req *gw.Probe) (*uuid.UUID, *uuid.UUID, []uuid.UUID, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "probe name must not be empty")
+	}
+
+	if err := probe.TypeValidator(probe.Type(req.Type)); err != nil {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid probe type %q, expected tcp, http or https", req.Type)
+	}
+
+	if req.Port < 0 || req.Port > 65535 {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid port %d", req.Port)
+	}
+	if req.Type == string(probe.TypeTCP) && req.Port == 0 {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "tcp probes require a port")
+	}
+
+	if req.ExpectedStatus != 0 && (req.ExpectedStatus < 100 || req.ExpectedStatus > 599) {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid expected status %d", req.ExpectedStatus)
+	}
+
+	if req.Timeout < 0 {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "timeout must not be negative")
+	}
+
+	if (req.ItemId == "") == (req.AssetClassId == "") {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "either an item or an asset class is required")
+	}
+
+	var itemUuid, classUuid *uuid.UUID
+	if req.ItemId != "" {
+		id, err := uuid.Parse(req.ItemId)
+		if err != nil {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+		}
+
+		exists, err := client.Item.Query().Where(item.ID(id), item.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query item: %v", err)
+			return nil, nil, nil, status.Errorf(codes.Internal, "failed to query item: %v", err)
+		}
+		if !exists {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "item not found")
+		}
+		itemUuid = &id
+	} else {
+		id, err := uuid.Parse(req.AssetClassId)
+		if err != nil {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+		}
+
+		exists, err := client.AssetClass.Query().Where(assetclass.ID(id), assetclass.DeletedAtIsNil()).Exist(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query asset class: %v", err)
+			return nil, nil, nil, status.Errorf(codes.Internal, "failed to query asset class: %v", err)
+		}
+		if !exists {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "asset class not found")
+		}
+		classUuid = &id
+	}
+
+	channelUuids := make([]uuid.UUID, 0, len(req.ChannelIds))
+	for _, id := range req.ChannelIds {
+		channelUuid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid notification channel ID format: %v", err)
+		}
+		channelUuids = append(channelUuids, channelUuid)
+	}
+
+	count, err := client.NotificationChannel.Query().
+		Where(notificationchannel.IDIn(channelUuids...), notificationchannel.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query notification channels: %v", err)
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to query notification channels: %v", err)
+	}
+	if count != len(channelUuids) {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "notification channel not found")
+	}
+
+	return itemUuid, classUuid, channelUuids, nil
+}
+
+func toProbe(p *ent.Probe) *gw.Probe {
+	channelIds := make([]string, 0, len(p.Edges.Channels))
+	for _, channel := range p.Edges.Channels {
+		channelIds = append(channelIds, channel.ID.String())
+	}
+
+	res := &gw.Probe{
+		Id:             p.ID.String(),
+		Name:           p.Name,
+		Type:           string(p.Type),
+		Host:           p.Host,
+		Port:           int32(p.Port),
+		Path:           p.Path,
+		ExpectedStatus: int32(p.ExpectedStatus),
+		Timeout:        int32(p.Timeout),
+		Enabled:        p.Enabled,
+		ChannelIds:     channelIds,
+	}
+
+	if p.Edges.Item != nil {
+		res.ItemId = p.Edges.Item.ID.String()
+	}
+	if p.Edges.AssetClass != nil {
+		res.AssetClassId = p.Edges.AssetClass.ID.String()
+	}
+
+	return res
+}
+
+func toProbeResult(r *ent.ProbeResult) *gw.ProbeResult {
+	res := &gw.ProbeResult{
+		Id:         r.ID.String(),
+		Target:     r.Target,
+		Status:     string(r.Status),
+		LatencyMs:  r.LatencyMs,
+		StatusCode: int32(r.StatusCode),
+		Error:      r.Error,
+		CreatedAt:  timestamppb.New(r.CreatedAt),
+	}
+
+	if r.Edges.Probe != nil {
+		res.ProbeId = r.Edges.Probe.ID.String()
+	}
+	if r.Edges.Item != nil {
+		res.ItemId = r.Edges.Item.ID.String()
+	}
+
+	return res
+}
+
+func NewProbeServer() gw.ProbeServiceServer {
+	return &probeServer{}
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"dig-inv/ent/notificationchannel"
+	"dig-inv/ent/proberesult"
+	"dig-inv/ent/reachability"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProbeServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "probe_tester")
+	probes := NewProbeServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	class := client.AssetClass.Create().SetName("Probed class").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	web := client.Item.Create().SetName("probed-web-1").SetAssetClass(class).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	channel := client.NotificationChannel.Create().
+		SetName("Probe hook").
+		SetType(notificationchannel.TypeWebhook).
+		SetConfig(map[string]string{"url": "http://127.0.0.1:1/"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	invalid := []*gw.Probe{
+		{Name: "", Type: "tcp", Port: 22, AssetClassId: class.ID.String()},
+		{Name: "Ping", Type: "icmp", AssetClassId: class.ID.String()},
+		{Name: "SSH", Type: "tcp", AssetClassId: class.ID.String()},
+		{Name: "SSH", Type: "tcp", Port: 70000, AssetClassId: class.ID.String()},
+		{Name: "Web", Type: "http", ExpectedStatus: 42, AssetClassId: class.ID.String()},
+		{Name: "Web", Type: "http"},
+		{Name: "Web", Type: "http", ItemId: web.ID.String(), AssetClassId: class.ID.String()},
+		{Name: "Web", Type: "http", ItemId: class.ID.String()},
+		{Name: "Web", Type: "http", ItemId: web.ID.String(), ChannelIds: []string{web.ID.String()}},
+	}
+	for _, req := range invalid {
+		if _, err := probes.CreateProbe(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for %v, got %v", req, err)
+		}
+	}
+
+	ssh, err := probes.CreateProbe(ctx, &gw.Probe{
+		Name:         "SSH",
+		Type:         "tcp",
+		Port:         22,
+		Enabled:      true,
+		AssetClassId: class.ID.String(),
+		ChannelIds:   []string{channel.ID.String()},
+	})
+	expectNoError(t, err)
+
+	if ssh.Timeout != 5 || ssh.AssetClassId != class.ID.String() || ssh.ItemId != "" || len(ssh.ChannelIds) != 1 {
+		t.Errorf("Unexpected probe: %v", ssh)
+	}
+
+	health, err := probes.CreateProbe(ctx, &gw.Probe{
+		Name:       "Health",
+		Type:       "https",
+		Path:       "/healthz",
+		Timeout:    2,
+		Enabled:    true,
+		ItemId:     web.ID.String(),
+		ChannelIds: []string{channel.ID.String()},
+	})
+	expectNoError(t, err)
+
+	// probes are moved between items and classes on update
+	health.ItemId = ""
+	health.AssetClassId = class.ID.String()
+	health.ExpectedStatus = 204
+	health.ChannelIds = nil
+	updated, err := probes.UpdateProbe(ctx, health)
+	expectNoError(t, err)
+
+	if updated.ItemId != "" || updated.AssetClassId != class.ID.String() || updated.ExpectedStatus != 204 ||
+		len(updated.ChannelIds) != 0 {
+		t.Errorf("Unexpected updated probe: %v", updated)
+	}
+
+	_, err = probes.DeleteProbe(ctx, &gw.ElementId{Id: health.Id})
+	expectNoError(t, err)
+
+	_, err = probes.UpdateProbe(ctx, health)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected deleted probe to be not found, got %v", err)
+	}
+
+	all, err := probes.GetProbes(ctx, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	for _, p := range all.Probes {
+		if p.Id == health.Id {
+			t.Errorf("Expected deleted probe to be hidden")
+		}
+	}
+
+	p := client.Probe.GetX(ctx, uuid.MustParse(ssh.Id))
+	for i, s := range []proberesult.Status{proberesult.StatusUp, proberesult.StatusDown} {
+		client.ProbeResult.Create().
+			SetProbe(p).
+			SetItem(web).
+			SetTarget("probed-web-1:22").
+			SetStatus(s).
+			SetLatencyMs(1.5).
+			SetCreatedAt(time.Now().Add(time.Duration(i) * time.Minute)).
+			SetCreatedBy("a").
+			SetUpdatedBy("a").
+			ExecX(ctx)
+	}
+
+	results, err := probes.GetProbeResults(ctx, &gw.ProbeResultFilter{ItemId: web.ID.String()})
+	expectNoError(t, err)
+
+	if len(results.Results) != 2 || results.Results[0].Status != "down" || results.Results[0].ProbeId != ssh.Id ||
+		results.Results[1].LatencyMs != 1.5 {
+		t.Errorf("Unexpected results: %v", results.Results)
+	}
+
+	results, err = probes.GetProbeResults(ctx, &gw.ProbeResultFilter{ProbeId: ssh.Id, Limit: 1})
+	expectNoError(t, err)
+
+	if len(results.Results) != 1 || results.Results[0].Status != "down" {
+		t.Errorf("Unexpected limited results: %v", results.Results)
+	}
+
+	_, err = probes.GetProbeResults(ctx, &gw.ProbeResultFilter{ItemId: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+
+	// the state is returned with the item
+	lastSeen := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	client.Reachability.Create().
+		SetItem(web).
+		SetState(reachability.StateDown).
+		SetChangedAt(time.Now()).
+		SetLastSeenAt(lastSeen).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		ExecX(ctx)
+
+	i, err := NewItemServer().GetItem(ctx, &gw.ElementId{Id: web.ID.String()})
+	expectNoError(t, err)
+
+	if i.Reachability != "down" || !i.LastSeenAt.AsTime().Equal(lastSeen) {
+		t.Errorf("Unexpected reachability of item: %v", i)
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/provideraccount"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+type providerAccountServer struct {
+	gw.UnimplementedProviderAccountServiceServer
+}
+
+func (s providerAccountServer) GetProviderAccounts(ctx context.Context, _ *gw.EmptyMessage) (*gw.ProviderAccounts, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	accounts, err := client.ProviderAccount.Query().
+		Where(provideraccount.DeletedAtIsNil()).
+		Order(provideraccount.ByName(), provideraccount.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query provider accounts: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query provider accounts: %v", err)
+	}
+
+	log.S.Debugw("Retrieved provider accounts", "count", len(accounts))
+
+	res := make([]*gw.ProviderAccount, 0, len(accounts))
+	for _, account := range accounts {
+		res = append(res, toProviderAccount(account))
+	}
+
+	return &gw.ProviderAccounts{
+		Accounts: res,
+	}, nil
+}
+
+func (s providerAccountServer) CreateProviderAccount(ctx context.Context, req *gw.ProviderAccount) (*gw.ProviderAccount, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if err := validateProviderAccount(req); err != nil {
+		return nil, err
+	}
+
+	encrypted, err := encryptSecrets(nil, req.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	create := client.ProviderAccount.Create().
+		SetName(req.Name).
+		SetProvider(req.Provider).
+		SetConfig(req.Config).
+		SetSecrets(encrypted).
+		SetCreatedBy(user).
+		SetUpdatedBy(user)
+	if len(encrypted) > 0 {
+		create.SetRotatedAt(time.Now())
+	}
+
+	account, err := create.Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create provider account: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create provider account: %v", err)
+	}
+	log.S.Debugw("Created new provider account", "id", account.ID, "provider", account.Provider, "secrets", len(encrypted))
+
+	return toProviderAccount(account), nil
+}
+
+func (s providerAccountServer) UpdateProviderAccount(ctx context.Context, req *gw.ProviderAccount) (*gw.ProviderAccount, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	accountUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for provider account ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider account ID format: %v", err)
+	}
+
+	if err := validateProviderAccount(req); err != nil {
+		return nil, err
+	}
+
+	account, err := client.ProviderAccount.UpdateOneID(accountUuid).
+		Where(provideraccount.DeletedAtIsNil()).
+		SetName(req.Name).
+		SetProvider(req.Provider).
+		SetConfig(req.Config).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "provider account not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update provider account: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update provider account: %v", err)
+	}
+	log.S.Debugw("Updated provider account", "id", accountUuid)
+
+	return toProviderAccount(account), nil
+}
+
+func (s providerAccountServer) RotateProviderAccount(ctx context.Context, req *gw.RotateProviderAccountRequest) (*gw.ProviderAccount, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	accountUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for provider account ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider account ID format: %v", err)
+	}
+
+	if len(req.Secrets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one secret is required")
+	}
+
+	account, err := client.ProviderAccount.Query().
+		Where(provideraccount.ID(accountUuid), provideraccount.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "provider account not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query provider account: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query provider account: %v", err)
+	}
+
+	encrypted, err := encryptSecrets(account.Secrets, req.Secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err = client.ProviderAccount.UpdateOne(account).
+		SetSecrets(encrypted).
+		SetRotatedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to rotate provider account: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to rotate provider account: %v", err)
+	}
+	log.S.Debugw("Rotated provider account", "id", accountUuid, "secrets", slices.Sorted(maps.Keys(req.Secrets)))
+
+	return toProviderAccount(account), nil
+}
+
+func (s providerAccountServer) DeleteProviderAccount(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	accountUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for provider account ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider account ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.ProviderAccount.UpdateOneID(accountUuid).
+		Where(provideraccount.DeletedAtIsNil()).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "provider account not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to delete provider account: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete provider account: %v", err)
+	}
+	log.S.Debugw("Deleted provider account", "id", accountUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func validateProviderAccount(req *gw.ProviderAccount) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return status.Errorf(codes.InvalidArgument, "provider account name must not be empty")
+	}
+
+	if strings.TrimSpace(req.Provider) == "" {
+		return status.Errorf(codes.InvalidArgument, "provider must not be empty")
+	}
+
+	return nil
+}
+
+// toProviderAccount returns the account with the names of its secrets, but never their values.
+func toProviderAccount(account *ent.ProviderAccount) *gw.ProviderAccount {
+	res := &gw.ProviderAccount{
+		Id:          account.ID.String(),
+		Name:        account.Name,
+		Provider:    account.Provider,
+		Config:      account.Config,
+		SecretNames: slices.Sorted(maps.Keys(account.Secrets)),
+		CreatedAt:   timestamppb.New(account.CreatedAt),
+	}
+
+	if account.RotatedAt != nil {
+		res.RotatedAt = timestamppb.New(*account.RotatedAt)
+	}
+
+	return res
+}
+
+func NewProviderAccountServer() gw.ProviderAccountServiceServer {
+	return &providerAccountServer{}
+}
@@ -0,0 +1,113 @@
+package services
+
+import (
+	gw "dig-inv/gen/go"
+	"dig-inv/secrets"
+	"dig-inv/store"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestProviderAccountServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "provider_account_tester")
+	server := NewProviderAccountServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	t.Setenv("MASTER_KEY", "")
+	t.Setenv("MASTER_KEY_FILE", "")
+
+	_, err = server.CreateProviderAccount(ctx, &gw.ProviderAccount{Name: "Hetzner", Provider: "hetzner", Secrets: map[string]string{"token": "t"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition without master key, got %v", err)
+	}
+
+	t.Setenv("MASTER_KEY", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", secrets.KeySize))))
+
+	_, err = server.CreateProviderAccount(ctx, &gw.ProviderAccount{Name: " ", Provider: "hetzner"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for empty name, got %v", err)
+	}
+
+	created, err := server.CreateProviderAccount(ctx, &gw.ProviderAccount{
+		Name:     "Hetzner",
+		Provider: "hetzner",
+		Config:   map[string]string{"project": "prod"},
+		Secrets:  map[string]string{"token": "hcloud-token", "ssh_key": "key"},
+	})
+	expectNoError(t, err)
+
+	if len(created.Secrets) != 0 || !slices.Equal(created.SecretNames, []string{"ssh_key", "token"}) ||
+		created.Config["project"] != "prod" || created.RotatedAt == nil {
+		t.Errorf("Unexpected created account: %v", created)
+	}
+
+	keyring, err := secrets.LoadKeyring()
+	expectNoError(t, err)
+
+	// secrets are stored encrypted
+	account := client.ProviderAccount.GetX(ctx, uuid.MustParse(created.Id))
+	if string(account.Secrets["token"].Ciphertext) == "hcloud-token" {
+		t.Errorf("Expected secret to be encrypted")
+	}
+	if plaintext, err := keyring.DecryptAll(account.Secrets); err != nil || plaintext["token"] != "hcloud-token" {
+		t.Errorf("Unexpected decrypted secrets: %v, %v", plaintext, err)
+	}
+
+	updated, err := server.UpdateProviderAccount(ctx, &gw.ProviderAccount{Id: created.Id, Name: "Hetzner prod", Provider: "hetzner"})
+	expectNoError(t, err)
+
+	// updates keep the secrets
+	if updated.Name != "Hetzner prod" || len(updated.SecretNames) != 2 {
+		t.Errorf("Unexpected updated account: %v", updated)
+	}
+
+	_, err = server.RotateProviderAccount(ctx, &gw.RotateProviderAccountRequest{Id: created.Id})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument without secrets, got %v", err)
+	}
+
+	rotated, err := server.RotateProviderAccount(ctx, &gw.RotateProviderAccountRequest{
+		Id:      created.Id,
+		Secrets: map[string]string{"token": "new-token", "ssh_key": ""},
+	})
+	expectNoError(t, err)
+
+	if !slices.Equal(rotated.SecretNames, []string{"token"}) {
+		t.Errorf("Expected only the rotated token, got %v", rotated.SecretNames)
+	}
+
+	account = client.ProviderAccount.GetX(ctx, account.ID)
+	if plaintext, err := keyring.Decrypt(account.Secrets["token"]); err != nil || plaintext != "new-token" {
+		t.Errorf("Unexpected rotated token: %q, %v", plaintext, err)
+	}
+
+	res, err := server.GetProviderAccounts(ctx, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	if !slices.ContainsFunc(res.Accounts, func(a *gw.ProviderAccount) bool { return a.Id == created.Id }) {
+		t.Errorf("Expected account in list, got %v", res.Accounts)
+	}
+
+	_, err = server.DeleteProviderAccount(ctx, &gw.ElementId{Id: created.Id})
+	expectNoError(t, err)
+
+	_, err = server.RotateProviderAccount(ctx, &gw.RotateProviderAccountRequest{Id: created.Id, Secrets: map[string]string{"token": "x"}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for deleted account, got %v", err)
+	}
+
+	_, err = server.DeleteProviderAccount(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument, got %v", err)
+	}
+}
@@ -0,0 +1,334 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"slices"
+	"time"
+)
+
+const (
+	defaultTraversalDepth = 1
+	maxTraversalDepth     = 10
+)
+
+const (
+	TraversalOutgoing = "outgoing"
+	TraversalIncoming = "incoming"
+	TraversalBoth     = "both"
+	TraversalImpact   = "impact"
+)
+
+// relation types where the target is affected if the source goes away, for all other types the source is affected
+var impactFollowsOutgoing = []itemrelation.Type{itemrelation.TypeHosts, itemrelation.TypeBacksUp}
+
+func (i itemServer) GetRelations(ctx context.Context, elementId *gw.ElementId) (*gw.ItemRelations, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	itemUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	relations, err := relationQuery(client).
+		Where(itemrelation.Or(
+			itemrelation.HasSourceWith(item.ID(itemUuid)),
+			itemrelation.HasTargetWith(item.ID(itemUuid)),
+		)).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query relations: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query relations: %v", err)
+	}
+
+	log.S.Debugw("Retrieved relations", "item", itemUuid, "count", len(relations))
+
+	return &gw.ItemRelations{
+		Relations: toItemRelations(relations),
+	}, nil
+}
+
+func (i itemServer) CreateRelation(ctx context.Context, req *gw.ItemRelation) (*gw.ItemRelation, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	ids, err := parseUuids([]string{req.SourceId, req.TargetId}, "item")
+	if err != nil {
+		return nil, err
+	}
+	sourceUuid, targetUuid := ids[0], ids[1]
+
+	if sourceUuid == targetUuid {
+		return nil, status.Errorf(codes.InvalidArgument, "an item can not be related to itself")
+	}
+
+	relationType := itemrelation.Type(req.Type)
+	if err := itemrelation.TypeValidator(relationType); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relation type: %v", err)
+	}
+
+	count, err := client.Item.Query().Where(item.IDIn(sourceUuid, targetUuid), item.DeletedAtIsNil()).Count(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query related items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query related items: %v", err)
+	}
+
+	if count != 2 {
+		return nil, status.Errorf(codes.NotFound, "related item not found")
+	}
+
+	exists, err := client.ItemRelation.Query().
+		Where(
+			itemrelation.DeletedAtIsNil(),
+			itemrelation.TypeEQ(relationType),
+			itemrelation.HasSourceWith(item.ID(sourceUuid)),
+			itemrelation.HasTargetWith(item.ID(targetUuid)),
+		).
+		Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query relations: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query relations: %v", err)
+	}
+
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "relation already exists")
+	}
+
+	relation, err := client.ItemRelation.Create().
+		SetSourceID(sourceUuid).
+		SetTargetID(targetUuid).
+		SetType(relationType).
+		SetDescription(req.Description).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create relation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create relation: %v", err)
+	}
+	log.S.Debugw("Created new relation", "id", relation.ID, "source", sourceUuid, "target", targetUuid, "type", relationType)
+
+	relation.Edges.Source = &ent.Item{ID: sourceUuid}
+	relation.Edges.Target = &ent.Item{ID: targetUuid}
+
+	return toItemRelation(relation), nil
+}
+
+func (i itemServer) DeleteRelation(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	relationUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for relation ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relation ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.ItemRelation.UpdateOneID(relationUuid).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete relation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete relation: %v", err)
+	}
+	log.S.Debugw("Deleted relation", "id", relationUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (i itemServer) TraverseRelations(ctx context.Context, req *gw.RelationTraversalRequest) (*gw.RelationTraversal, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	startUuid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	depth := int(req.Depth)
+	if depth <= 0 {
+		depth = defaultTraversalDepth
+	}
+	depth = min(depth, maxTraversalDepth)
+
+	direction := req.Direction
+	if direction == "" {
+		direction = TraversalOutgoing
+	}
+	if !slices.Contains([]string{TraversalOutgoing, TraversalIncoming, TraversalBoth, TraversalImpact}, direction) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid traversal direction: %s", direction)
+	}
+
+	types := make([]itemrelation.Type, 0, len(req.Types))
+	for _, t := range req.Types {
+		if err := itemrelation.TypeValidator(itemrelation.Type(t)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid relation type: %v", err)
+		}
+		types = append(types, itemrelation.Type(t))
+	}
+
+	depths := map[uuid.UUID]int{startUuid: 0}
+	relations := make([]*ent.ItemRelation, 0)
+	frontier := []uuid.UUID{startUuid}
+
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		query := relationQuery(client).
+			Where(itemrelation.Or(
+				itemrelation.HasSourceWith(item.IDIn(frontier...)),
+				itemrelation.HasTargetWith(item.IDIn(frontier...)),
+			))
+		if len(types) > 0 {
+			query = query.Where(itemrelation.TypeIn(types...))
+		}
+
+		candidates, err := query.All(ctx)
+		if err != nil {
+			grpclog.Errorf("Failed to query relations: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to query relations: %v", err)
+		}
+
+		next := make([]uuid.UUID, 0)
+		for _, relation := range candidates {
+			neighbor := traversalNeighbor(relation, frontier, direction)
+			if neighbor == nil || neighbor.DeletedAt != nil {
+				continue
+			}
+
+			if !slices.ContainsFunc(relations, func(r *ent.ItemRelation) bool { return r.ID == relation.ID }) {
+				relations = append(relations, relation)
+			}
+
+			if _, visited := depths[neighbor.ID]; !visited {
+				depths[neighbor.ID] = hop
+				next = append(next, neighbor.ID)
+			}
+		}
+
+		frontier = next
+	}
+
+	delete(depths, startUuid)
+
+	items, err := itemQuery(client).Where(item.IDIn(slices.Collect(func(yield func(uuid.UUID) bool) {
+		for id := range depths {
+			if !yield(id) {
+				return
+			}
+		}
+	})...)).All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query related items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query related items: %v", err)
+	}
+
+	slices.SortFunc(items, func(a, b *ent.Item) int {
+		return cmp.Or(cmp.Compare(depths[a.ID], depths[b.ID]), cmp.Compare(a.Name, b.Name))
+	})
+
+	res := &gw.RelationTraversal{
+		Items:     make([]*gw.TraversedItem, 0, len(items)),
+		Relations: toItemRelations(relations),
+	}
+
+	for _, i := range items {
+		converted, err := toItem(i)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items = append(res.Items, &gw.TraversedItem{
+			Item:  converted,
+			Depth: int32(depths[i.ID]),
+		})
+	}
+
+	log.S.Debugw("Traversed relations", "item", startUuid, "direction", direction, "count", len(res.Items))
+
+	return res, nil
+}
+
+// traversalNeighbor returns the item that is reached by following the relation from the frontier in the given direction.
+func traversalNeighbor(relation *ent.ItemRelation, frontier []uuid.UUID, direction string) *ent.Item {
+	source, target := relation.Edges.Source, relation.Edges.Target
+	fromSource := slices.Contains(frontier, source.ID)
+	fromTarget := slices.Contains(frontier, target.ID)
+	outgoingImpact := slices.Contains(impactFollowsOutgoing, relation.Type)
+
+	switch {
+	case fromSource && (direction == TraversalOutgoing || direction == TraversalBoth):
+		return target
+	case fromTarget && (direction == TraversalIncoming || direction == TraversalBoth):
+		return source
+	case fromSource && direction == TraversalImpact && outgoingImpact:
+		return target
+	case fromTarget && direction == TraversalImpact && !outgoingImpact:
+		return source
+	}
+
+	return nil
+}
+
+// relationQuery returns a query for all relations that are not deleted including the related items.
+func relationQuery(client *ent.Client) *ent.ItemRelationQuery {
+	return client.ItemRelation.Query().
+		Where(itemrelation.DeletedAtIsNil()).
+		WithSource().
+		WithTarget()
+}
+
+func toItemRelation(relation *ent.ItemRelation) *gw.ItemRelation {
+	res := &gw.ItemRelation{
+		Id:          relation.ID.String(),
+		Type:        relation.Type.String(),
+		Description: relation.Description,
+	}
+
+	if relation.Edges.Source != nil {
+		res.SourceId = relation.Edges.Source.ID.String()
+	}
+
+	if relation.Edges.Target != nil {
+		res.TargetId = relation.Edges.Target.ID.String()
+	}
+
+	return res
+}
+
+func toItemRelations(relations []*ent.ItemRelation) []*gw.ItemRelation {
+	res := make([]*gw.ItemRelation, 0, len(relations))
+	for _, relation := range relations {
+		res = append(res, toItemRelation(relation))
+	}
+
+	return res
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	gw "dig-inv/gen/go"
+	"testing"
+)
+
+func TestItemServer_Relations(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "relation_tester")
+	items := NewItemServer()
+
+	class := createTestAssetClass(t, ctx, "Relation")
+
+	create := func(name string) *gw.Item {
+		item, err := items.CreateItem(ctx, &gw.Item{Name: name, AssetClassId: class.Id})
+		expectNoError(t, err)
+		return item
+	}
+
+	host := create("host")
+	vm := create("vm")
+	app := create("app")
+	domain := create("domain")
+
+	relate := func(source, target *gw.Item, relationType string) *gw.ItemRelation {
+		relation, err := items.CreateRelation(ctx, &gw.ItemRelation{SourceId: source.Id, TargetId: target.Id, Type: relationType})
+		expectNoError(t, err)
+		return relation
+	}
+
+	relate(host, vm, "hosts")
+	relate(app, vm, "depends_on")
+	domainRelation := relate(domain, app, "resolves_to")
+
+	_, err := items.CreateRelation(ctx, &gw.ItemRelation{SourceId: host.Id, TargetId: vm.Id, Type: "hosts"})
+	expectError(t, err)
+
+	_, err = items.CreateRelation(ctx, &gw.ItemRelation{SourceId: host.Id, TargetId: host.Id, Type: "hosts"})
+	expectError(t, err)
+
+	_, err = items.CreateRelation(ctx, &gw.ItemRelation{SourceId: host.Id, TargetId: app.Id, Type: "unknown"})
+	expectError(t, err)
+
+	relations, err := items.GetRelations(ctx, &gw.ElementId{Id: vm.Id})
+	expectNoError(t, err)
+
+	if len(relations.Relations) != 2 {
+		t.Errorf("Expected 2 relations, got %d", len(relations.Relations))
+	}
+
+	impact, err := items.TraverseRelations(ctx, &gw.RelationTraversalRequest{ItemId: host.Id, Depth: 5, Direction: "impact"})
+	expectNoError(t, err)
+
+	names := make([]string, 0)
+	for _, traversed := range impact.Items {
+		names = append(names, traversed.Item.Name)
+	}
+
+	if len(names) != 3 || names[0] != "vm" || names[1] != "app" || names[2] != "domain" {
+		t.Errorf("Unexpected impacted items: %v", names)
+	}
+
+	if impact.Items[2].Depth != 3 || len(impact.Relations) != 3 {
+		t.Errorf("Unexpected traversal: %v", impact)
+	}
+
+	outgoing, err := items.TraverseRelations(ctx, &gw.RelationTraversalRequest{ItemId: host.Id, Depth: 5})
+	expectNoError(t, err)
+
+	if len(outgoing.Items) != 1 || outgoing.Items[0].Item.Id != vm.Id {
+		t.Errorf("Unexpected outgoing traversal: %v", outgoing.Items)
+	}
+
+	_, err = items.DeleteRelation(ctx, &gw.ElementId{Id: domainRelation.Id})
+	expectNoError(t, err)
+
+	both, err := items.TraverseRelations(ctx, &gw.RelationTraversalRequest{ItemId: vm.Id, Depth: 5, Direction: "both"})
+	expectNoError(t, err)
+
+	if len(both.Items) != 2 || len(both.Relations) != 2 {
+		t.Errorf("Unexpected traversal after deleting a relation: %v", both)
+	}
+
+	_, err = items.TraverseRelations(ctx, &gw.RelationTraversalRequest{ItemId: vm.Id, Direction: "sideways"})
+	expectError(t, err)
+}
@@ -0,0 +1,347 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/savedview"
+	"dig-inv/ent/usergroup"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/querylang"
+	"dig-inv/store"
+	"entgo.io/ent/dialect/sql"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+// fields items can be sorted by in saved views
+var itemSortFields = map[string]func(opts ...sql.OrderTermOption) item.OrderOption{
+	"name":        item.ByName,
+	"description": item.ByDescription,
+	"created":     item.ByCreatedAt,
+	"updated":     item.ByUpdatedAt,
+}
+
+type savedViewServer struct {
+	gw.UnimplementedSavedViewServiceServer
+}
+
+func (s savedViewServer) GetView(ctx context.Context, elementId *gw.ElementId) (*gw.SavedView, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	view, err := accessibleView(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSavedView(view), nil
+}
+
+func (s savedViewServer) GetViews(ctx context.Context, _ *gw.EmptyMessage) (*gw.SavedViews, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	views, err := client.SavedView.Query().
+		Where(savedview.DeletedAtIsNil(), accessibleViews(ctx)).
+		WithUserGroup().
+		Order(savedview.ByName()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query saved views: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query saved views: %v", err)
+	}
+
+	log.S.Debugw("Retrieved saved views", "count", len(views))
+
+	res := make([]*gw.SavedView, 0, len(views))
+	for _, view := range views {
+		res = append(res, toSavedView(view))
+	}
+
+	return &gw.SavedViews{
+		Views: res,
+	}, nil
+}
+
+func (s savedViewServer) CreateView(ctx context.Context, req *gw.SavedView) (*gw.SavedView, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	groupUuid, err := validateSavedView(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	create := client.SavedView.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetOwner(user).
+		SetQuery(req.Query).
+		SetSort(req.Sort).
+		SetColumns(req.Columns).
+		SetCreatedBy(user).
+		SetUpdatedBy(user)
+	if groupUuid != uuid.Nil {
+		create = create.SetUserGroupID(groupUuid)
+	}
+
+	view, err := create.Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create saved view: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create saved view: %v", err)
+	}
+	log.S.Debugw("Created new saved view", "id", view.ID, "name", view.Name)
+
+	return s.GetView(ctx, &gw.ElementId{Id: view.ID.String()})
+}
+
+func (s savedViewServer) UpdateView(ctx context.Context, req *gw.SavedView) (*gw.SavedView, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	viewUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for saved view ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid saved view ID format: %v", err)
+	}
+
+	groupUuid, err := validateSavedView(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// shared views can only be changed by their owner
+	update := client.SavedView.UpdateOneID(viewUuid).
+		Where(savedview.DeletedAtIsNil(), savedview.Owner(user)).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetQuery(req.Query).
+		SetSort(req.Sort).
+		SetColumns(req.Columns).
+		SetUpdatedBy(user)
+	if groupUuid != uuid.Nil {
+		update = update.SetUserGroupID(groupUuid)
+	} else {
+		update = update.ClearUserGroup()
+	}
+
+	_, err = update.Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "saved view not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update saved view: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update saved view: %v", err)
+	}
+	log.S.Debugw("Updated saved view", "id", viewUuid, "name", req.Name)
+
+	return s.GetView(ctx, &gw.ElementId{Id: viewUuid.String()})
+}
+
+func (s savedViewServer) DeleteView(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	viewUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for saved view ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid saved view ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.SavedView.UpdateOneID(viewUuid).
+		Where(savedview.DeletedAtIsNil(), savedview.Owner(user)).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "saved view not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to delete saved view: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete saved view: %v", err)
+	}
+	log.S.Debugw("Deleted saved view", "id", viewUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+// accessibleViews limits saved views to those owned by the authenticated user or shared with one of their groups.
+func accessibleViews(ctx context.Context) predicate.SavedView {
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+	scopes, _ := ctx.Value(AuthenticatedScopesKey).([]string)
+
+	return savedview.Or(
+		savedview.Owner(user),
+		savedview.HasUserGroupWith(usergroup.OidcScopeIn(scopes...), usergroup.DeletedAtIsNil()),
+	)
+}
+
+func accessibleView(ctx context.Context, client *ent.Client, id string) (*ent.SavedView, error) {
+	viewUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for saved view ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid saved view ID format: %v", err)
+	}
+
+	view, err := client.SavedView.Query().
+		Where(savedview.ID(viewUuid), savedview.DeletedAtIsNil(), accessibleViews(ctx)).
+		WithUserGroup().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "saved view not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query saved view: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query saved view: %v", err)
+	}
+
+	return view, nil
+}
+
+// savedViewItemQuery returns the predicates and sort order of the saved view with the given ID.
+func savedViewItemQuery(ctx context.Context, client *ent.Client, id string) ([]predicate.Item, []item.OrderOption, error) {
+	view, err := accessibleView(ctx, client, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order, err := itemOrder(view.Sort)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "invalid saved view: %v", err)
+	}
+
+	if view.Query == "" {
+		return nil, order, nil
+	}
+
+	// attributes used by the query might have been removed since the view was saved
+	p, err := querylang.ItemPredicate(ctx, client, view.Query)
+	var queryErr *querylang.Error
+	if errors.As(err, &queryErr) {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "invalid saved view query: %v", queryErr)
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to compile saved view query: %v", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to compile saved view query: %v", err)
+	}
+
+	return []predicate.Item{p}, order, nil
+}
+
+// itemOrder converts a list of sort fields to order options, defaulting to the name.
+func itemOrder(sort []string) ([]item.OrderOption, error) {
+	res := make([]item.OrderOption, 0, len(sort)+1)
+	for _, field := range sort {
+		name, descending := strings.CutPrefix(field, "-")
+
+		by, ok := itemSortFields[name]
+		if !ok {
+			return nil, errors.New("unknown sort field " + name)
+		}
+
+		if descending {
+			res = append(res, by(sql.OrderDesc()))
+		} else {
+			res = append(res, by())
+		}
+	}
+
+	// a stable order for items with equal sort values
+	return append(res, item.ByName(), item.ByID()), nil
+}
+
+// validateSavedView checks the query and sort order of a view and returns the ID of the group it is shared with.
+func validateSavedView(ctx context.Context, client *ent.Client, req *gw.SavedView) (uuid.UUID, error) {
+	if req.Name == "" {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "missing saved view name")
+	}
+
+	if req.Query != "" {
+		_, err := querylang.ItemPredicate(ctx, client, req.Query)
+		var queryErr *querylang.Error
+		if errors.As(err, &queryErr) {
+			return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid query: %v", queryErr)
+		}
+		if err != nil {
+			grpclog.Errorf("Failed to compile query: %v", err)
+			return uuid.Nil, status.Errorf(codes.Internal, "failed to compile query: %v", err)
+		}
+	}
+
+	if _, err := itemOrder(req.Sort); err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid sort order: %v", err)
+	}
+
+	if req.GroupId == "" {
+		return uuid.Nil, nil
+	}
+
+	groupUuid, err := uuid.Parse(req.GroupId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for group ID: %v", err)
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid group ID format: %v", err)
+	}
+
+	exists, err := client.UserGroup.Query().Where(usergroup.ID(groupUuid), usergroup.DeletedAtIsNil()).Exist(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query user group: %v", err)
+		return uuid.Nil, status.Errorf(codes.Internal, "failed to query user group: %v", err)
+	}
+
+	if !exists {
+		return uuid.Nil, status.Errorf(codes.NotFound, "user group not found")
+	}
+
+	return groupUuid, nil
+}
+
+func toSavedView(view *ent.SavedView) *gw.SavedView {
+	res := &gw.SavedView{
+		Id:          view.ID.String(),
+		Name:        view.Name,
+		Description: view.Description,
+		Owner:       view.Owner,
+		Query:       view.Query,
+		Sort:        view.Sort,
+		Columns:     view.Columns,
+	}
+
+	if view.Edges.UserGroup != nil {
+		res.GroupId = view.Edges.UserGroup.ID.String()
+	}
+
+	return res
+}
+
+func NewSavedViewServer() gw.SavedViewServiceServer {
+	return &savedViewServer{}
+}
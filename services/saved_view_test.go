@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSavedViewServer_CRUD(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "view_owner")
+	views := NewSavedViewServer()
+
+	view, err := views.CreateView(ctx, &gw.SavedView{
+		Name:    "Production servers",
+		Query:   "tag:prod",
+		Sort:    []string{"-updated", "name"},
+		Columns: []string{"name", "hostname"},
+	})
+	expectNoError(t, err)
+
+	if view.Owner != "view_owner" || view.Query != "tag:prod" || len(view.Sort) != 2 || len(view.Columns) != 2 {
+		t.Errorf("Unexpected saved view: %v", view)
+	}
+
+	view.Name = "Prod"
+	view.Query = "tag:prod class:server"
+	updated, err := views.UpdateView(ctx, view)
+	expectNoError(t, err)
+
+	if updated.Name != "Prod" || updated.Query != "tag:prod class:server" {
+		t.Errorf("Unexpected updated saved view: %v", updated)
+	}
+
+	_, err = views.CreateView(ctx, &gw.SavedView{Name: "Broken", Query: "name:"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for broken query, got %v", err)
+	}
+
+	_, err = views.CreateView(ctx, &gw.SavedView{Name: "Unsorted", Sort: []string{"colour"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unknown sort field, got %v", err)
+	}
+
+	_, err = views.DeleteView(ctx, &gw.ElementId{Id: view.Id})
+	expectNoError(t, err)
+
+	_, err = views.GetView(ctx, &gw.ElementId{Id: view.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected deleted view to be not found, got %v", err)
+	}
+}
+
+func TestSavedViewServer_Sharing(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "view_sharer")
+	views := NewSavedViewServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	group := client.UserGroup.Create().
+		SetName("View Ops").
+		SetOidcScope("view-ops").
+		SetCreatedBy("view_sharer").
+		SetUpdatedBy("view_sharer").
+		SaveX(ctx)
+
+	private, err := views.CreateView(ctx, &gw.SavedView{Name: "Mine"})
+	expectNoError(t, err)
+
+	shared, err := views.CreateView(ctx, &gw.SavedView{Name: "Ours", GroupId: group.ID.String()})
+	expectNoError(t, err)
+
+	if shared.GroupId != group.ID.String() {
+		t.Errorf("Expected view to be shared with group %s, got %q", group.ID, shared.GroupId)
+	}
+
+	other := context.WithValue(ctx, AuthenticatedSubjectKey, "view_colleague")
+	_, err = views.GetView(other, &gw.ElementId{Id: shared.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected shared view to be hidden without group scope, got %v", err)
+	}
+
+	member := context.WithValue(other, AuthenticatedScopesKey, []string{"view-ops"})
+	res, err := views.GetViews(member, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	for _, view := range res.Views {
+		if view.Id == private.Id {
+			t.Errorf("Expected private view to be hidden from other users")
+		}
+	}
+
+	_, err = views.GetView(member, &gw.ElementId{Id: shared.Id})
+	expectNoError(t, err)
+
+	_, err = views.UpdateView(member, shared)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected only the owner to update a shared view, got %v", err)
+	}
+
+	_, err = views.DeleteView(member, &gw.ElementId{Id: shared.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected only the owner to delete a shared view, got %v", err)
+	}
+
+	shared.GroupId = ""
+	_, err = views.UpdateView(ctx, shared)
+	expectNoError(t, err)
+
+	_, err = views.GetView(member, &gw.ElementId{Id: shared.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected unshared view to be hidden from group members, got %v", err)
+	}
+}
+
+func TestItemServer_GetItemsView(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "view_item_tester")
+	items := NewItemServer()
+	views := NewSavedViewServer()
+
+	class := createTestAssetClass(t, ctx, "Viewable")
+
+	for _, name := range []string{"viewable alpha", "viewable beta", "viewable gamma"} {
+		_, err := items.CreateItem(ctx, &gw.Item{Name: name, AssetClassId: class.Id})
+		expectNoError(t, err)
+	}
+
+	view, err := views.CreateView(ctx, &gw.SavedView{
+		Name:  "Viewable",
+		Query: "class:Viewable -name:beta",
+		Sort:  []string{"-name"},
+	})
+	expectNoError(t, err)
+
+	res, err := items.GetItems(ctx, &gw.ItemFilter{ViewId: view.Id})
+	expectNoError(t, err)
+
+	if len(res.Items) != 2 || res.Items[0].Name != "viewable gamma" || res.Items[1].Name != "viewable alpha" {
+		t.Errorf("Unexpected items for saved view: %v", res.Items)
+	}
+
+	other := context.WithValue(ctx, AuthenticatedSubjectKey, "view_stranger")
+	_, err = items.GetItems(other, &gw.ItemFilter{ViewId: view.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected views of other users to be not found, got %v", err)
+	}
+}
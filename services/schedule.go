@@ -0,0 +1,372 @@
+package services
+
+import (
+	"context"
+	"dig-inv/certs"
+	"dig-inv/dnscheck"
+	"dig-inv/domains"
+	"dig-inv/ent"
+	"dig-inv/ent/schedule"
+	"dig-inv/export"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/notify"
+	"dig-inv/probe"
+	"dig-inv/store"
+	"dig-inv/webhook"
+	"dig-inv/worker"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// JobHandlers execute the queued job runs by type, schedules can only queue runs of these types.
+var JobHandlers = map[string]worker.Handler{
+	certs.JobType:    certs.RunCheckJob,
+	dnscheck.JobType: dnscheck.RunCheckJob,
+	domains.JobType:  domains.RunLookupJob,
+	export.JobType:   RunExportJob,
+	notify.JobType:   notify.RunExpiryJob,
+	probe.JobType:    probe.RunProbeJob,
+	webhook.JobType:  webhook.RunDeliveryJob,
+}
+
+type scheduleServer struct {
+	gw.UnimplementedScheduleServiceServer
+}
+
+func (s scheduleServer) GetSchedule(ctx context.Context, elementId *gw.ElementId) (*gw.Schedule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	sched, err := activeSchedule(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSchedule(sched), nil
+}
+
+func (s scheduleServer) GetSchedules(ctx context.Context, _ *gw.EmptyMessage) (*gw.Schedules, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	schedules, err := client.Schedule.Query().
+		Where(schedule.DeletedAtIsNil()).
+		Order(schedule.ByName()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query schedules: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query schedules: %v", err)
+	}
+
+	log.S.Debugw("Retrieved schedules", "count", len(schedules))
+
+	res := make([]*gw.Schedule, 0, len(schedules))
+	for _, sched := range schedules {
+		res = append(res, toSchedule(sched))
+	}
+
+	return &gw.Schedules{
+		Schedules: res,
+	}, nil
+}
+
+func (s scheduleServer) CreateSchedule(ctx context.Context, req *gw.Schedule) (*gw.Schedule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	if err := validateSchedule(req); err != nil {
+		return nil, err
+	}
+
+	sched, err := client.Schedule.Create().
+		SetName(req.Name).
+		SetJobType(req.JobType).
+		SetParameters(req.Parameters).
+		SetCron(req.Cron).
+		SetTimezone(scheduleTimezone(req)).
+		SetJitter(int(req.Jitter)).
+		SetPaused(req.Paused).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create schedule: %v", err)
+	}
+	log.S.Debugw("Created new schedule", "id", sched.ID, "name", sched.Name)
+
+	if sched, err = reschedule(ctx, client, sched, user); err != nil {
+		return nil, err
+	}
+
+	return toSchedule(sched), nil
+}
+
+func (s scheduleServer) UpdateSchedule(ctx context.Context, req *gw.Schedule) (*gw.Schedule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	scheduleUuid, err := uuid.Parse(req.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for schedule ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule ID format: %v", err)
+	}
+
+	if err := validateSchedule(req); err != nil {
+		return nil, err
+	}
+
+	sched, err := client.Schedule.UpdateOneID(scheduleUuid).
+		Where(schedule.DeletedAtIsNil()).
+		SetName(req.Name).
+		SetJobType(req.JobType).
+		SetParameters(req.Parameters).
+		SetCron(req.Cron).
+		SetTimezone(scheduleTimezone(req)).
+		SetJitter(int(req.Jitter)).
+		SetPaused(req.Paused).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "schedule not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to update schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update schedule: %v", err)
+	}
+	log.S.Debugw("Updated schedule", "id", sched.ID, "name", sched.Name)
+
+	// the expression or timezone might have changed
+	if sched, err = reschedule(ctx, client, sched, user); err != nil {
+		return nil, err
+	}
+
+	return toSchedule(sched), nil
+}
+
+func (s scheduleServer) DeleteSchedule(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	scheduleUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for schedule ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule ID format: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	_, err = client.Schedule.UpdateOneID(scheduleUuid).
+		Where(schedule.DeletedAtIsNil()).
+		SetPaused(true).
+		ClearNextRunAt().
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "schedule not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to delete schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete schedule: %v", err)
+	}
+	log.S.Debugw("Deleted schedule", "id", scheduleUuid)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s scheduleServer) PauseSchedule(ctx context.Context, elementId *gw.ElementId) (*gw.Schedule, error) {
+	return s.setPaused(ctx, elementId.Id, true)
+}
+
+func (s scheduleServer) ResumeSchedule(ctx context.Context, elementId *gw.ElementId) (*gw.Schedule, error) {
+	return s.setPaused(ctx, elementId.Id, false)
+}
+
+func (s scheduleServer) RunSchedule(ctx context.Context, elementId *gw.ElementId) (*gw.ScheduledRun, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	sched, err := activeSchedule(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := worker.EnqueueScheduled(ctx, client, sched, user)
+	if err != nil {
+		grpclog.Errorf("Failed to queue scheduled run: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to queue scheduled run: %v", err)
+	}
+	log.S.Debugw("Queued manual run of schedule", "id", sched.ID, "run", run.ID)
+
+	return &gw.ScheduledRun{
+		Id: run.ID.String(),
+	}, nil
+}
+
+func (s scheduleServer) setPaused(ctx context.Context, id string, paused bool) (*gw.Schedule, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	sched, err := activeSchedule(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err = client.Schedule.UpdateOne(sched).
+		SetPaused(paused).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to update schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update schedule: %v", err)
+	}
+	log.S.Debugw("Changed schedule", "id", sched.ID, "paused", paused)
+
+	if sched, err = reschedule(ctx, client, sched, user); err != nil {
+		return nil, err
+	}
+
+	return toSchedule(sched), nil
+}
+
+func activeSchedule(ctx context.Context, client *ent.Client, id string) (*ent.Schedule, error) {
+	scheduleUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for schedule ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule ID format: %v", err)
+	}
+
+	sched, err := client.Schedule.Query().
+		Where(schedule.ID(scheduleUuid), schedule.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "schedule not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query schedule: %v", err)
+	}
+
+	return sched, nil
+}
+
+// reschedule calculates the next run of an active schedule, paused schedules have none.
+func reschedule(ctx context.Context, client *ent.Client, sched *ent.Schedule, user string) (*ent.Schedule, error) {
+	update := client.Schedule.UpdateOne(sched).SetUpdatedBy(user)
+	if sched.Paused {
+		update = update.ClearNextRunAt()
+	} else {
+		next, err := worker.NextRun(sched, time.Now())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		update = update.SetNextRunAt(next)
+	}
+
+	sched, err := update.Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to update next run of schedule: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update next run of schedule: %v", err)
+	}
+
+	return sched, nil
+}
+
+func validateSchedule(req *gw.Schedule) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return status.Errorf(codes.InvalidArgument, "schedule name must not be empty")
+	}
+
+	if _, ok := JobHandlers[req.JobType]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown job type %q, expected one of %s",
+			req.JobType, strings.Join(slices.Sorted(maps.Keys(JobHandlers)), ", "))
+	}
+
+	// the visibility of a run is derived from the user who queued it, see CreateExport
+	if _, ok := req.Parameters["scopes"]; ok {
+		return status.Errorf(codes.InvalidArgument, "parameter \"scopes\" is reserved")
+	}
+
+	if req.Jitter < 0 {
+		return status.Errorf(codes.InvalidArgument, "jitter must not be negative")
+	}
+
+	if _, _, err := worker.ParseCron(req.Cron, req.Timezone); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return nil
+}
+
+func scheduleTimezone(req *gw.Schedule) string {
+	if req.Timezone == "" {
+		return "UTC"
+	}
+
+	return req.Timezone
+}
+
+func toSchedule(sched *ent.Schedule) *gw.Schedule {
+	res := &gw.Schedule{
+		Id:         sched.ID.String(),
+		Name:       sched.Name,
+		JobType:    sched.JobType,
+		Parameters: sched.Parameters,
+		Cron:       sched.Cron,
+		Timezone:   sched.Timezone,
+		Jitter:     int32(sched.Jitter),
+		Paused:     sched.Paused,
+	}
+
+	if sched.NextRunAt != nil {
+		res.NextRunAt = timestamppb.New(*sched.NextRunAt)
+	}
+
+	if sched.LastRunAt != nil {
+		res.LastRunAt = timestamppb.New(*sched.LastRunAt)
+	}
+
+	return res
+}
+
+func NewScheduleServer() gw.ScheduleServiceServer {
+	return &scheduleServer{}
+}
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"dig-inv/ent/jobrun"
+	"dig-inv/export"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduleServer_CRUD(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "schedule_tester")
+	schedules := NewScheduleServer()
+
+	sched, err := schedules.CreateSchedule(ctx, &gw.Schedule{
+		Name:       "Nightly export",
+		JobType:    export.JobType,
+		Parameters: map[string]string{"format": export.FormatCSV},
+		Cron:       "0 3 * * *",
+		Timezone:   "Europe/Berlin",
+		Jitter:     60,
+	})
+	expectNoError(t, err)
+
+	if sched.NextRunAt == nil || !sched.NextRunAt.AsTime().After(time.Now()) || sched.Timezone != "Europe/Berlin" {
+		t.Errorf("Unexpected schedule: %v", sched)
+	}
+
+	sched.Cron = "@hourly"
+	sched.Timezone = ""
+	updated, err := schedules.UpdateSchedule(ctx, sched)
+	expectNoError(t, err)
+
+	if updated.Cron != "@hourly" || updated.Timezone != "UTC" || updated.NextRunAt.AsTime().After(time.Now().Add(time.Hour+time.Minute)) {
+		t.Errorf("Unexpected updated schedule: %v", updated)
+	}
+
+	invalid := []*gw.Schedule{
+		{Name: "", JobType: export.JobType, Cron: "@daily"},
+		{Name: "Unknown", JobType: "unknown", Cron: "@daily"},
+		{Name: "Broken", JobType: export.JobType, Cron: "every night"},
+		{Name: "Nowhere", JobType: export.JobType, Cron: "@daily", Timezone: "Nowhere/Nothing"},
+		{Name: "Negative", JobType: export.JobType, Cron: "@daily", Jitter: -1},
+		{Name: "Scoped", JobType: export.JobType, Cron: "@daily", Parameters: map[string]string{"scopes": "admin"}},
+	}
+	for _, req := range invalid {
+		_, err = schedules.CreateSchedule(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for %v, got %v", req, err)
+		}
+	}
+
+	list, err := schedules.GetSchedules(ctx, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	found := false
+	for _, s := range list.Schedules {
+		found = found || s.Id == sched.Id
+	}
+	if !found {
+		t.Errorf("Expected schedule to be listed, got %v", list.Schedules)
+	}
+
+	_, err = schedules.DeleteSchedule(ctx, &gw.ElementId{Id: sched.Id})
+	expectNoError(t, err)
+
+	_, err = schedules.GetSchedule(ctx, &gw.ElementId{Id: sched.Id})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected deleted schedule to be not found, got %v", err)
+	}
+
+	_, err = schedules.GetSchedule(ctx, &gw.ElementId{Id: "invalid"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for invalid ID, got %v", err)
+	}
+}
+
+func TestScheduleServer_PauseAndRun(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "schedule_runner")
+	schedules := NewScheduleServer()
+
+	sched, err := schedules.CreateSchedule(ctx, &gw.Schedule{
+		Name:       "Weekly export",
+		JobType:    export.JobType,
+		Parameters: map[string]string{"format": export.FormatJSONL},
+		Cron:       "0 0 * * 0",
+	})
+	expectNoError(t, err)
+
+	paused, err := schedules.PauseSchedule(ctx, &gw.ElementId{Id: sched.Id})
+	expectNoError(t, err)
+
+	if !paused.Paused || paused.NextRunAt != nil {
+		t.Errorf("Expected paused schedule without next run, got %v", paused)
+	}
+
+	// paused schedules can still be run manually
+	run, err := schedules.RunSchedule(ctx, &gw.ElementId{Id: sched.Id})
+	expectNoError(t, err)
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	job := client.JobRun.Query().Where(jobrun.ID(uuid.MustParse(run.Id))).WithSchedule().OnlyX(ctx)
+	if job.Type != export.JobType || job.Parameters["format"] != export.FormatJSONL || job.Edges.Schedule == nil || job.CreatedBy != "schedule_runner" {
+		t.Errorf("Unexpected job run: %v", job)
+	}
+
+	resumed, err := schedules.ResumeSchedule(ctx, &gw.ElementId{Id: sched.Id})
+	expectNoError(t, err)
+
+	if resumed.Paused || resumed.NextRunAt == nil || resumed.LastRunAt == nil {
+		t.Errorf("Expected resumed schedule with next and last run, got %v", resumed)
+	}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"dig-inv/certs"
+	"dig-inv/dnscheck"
+	"dig-inv/domains"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	defaultSearchLimit = 50
+	maxSearchLimit     = 200
+)
+
+// the provider data of the items is indexed with them, so they can be found by e.g. the IPs their records resolve to
+func init() {
+	store.SearchContributors = append(store.SearchContributors, certs.SearchContent, dnscheck.SearchContent, domains.SearchContent)
+}
+
+type searchServer struct {
+	gw.UnimplementedSearchServiceServer
+}
+
+func (s searchServer) Search(ctx context.Context, req *gw.SearchRequest) (*gw.SearchResults, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing search query")
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	hits, err := store.Search(ctx, client, req.Query)
+	if err != nil {
+		grpclog.Errorf("Failed to search items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to search items: %v", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(hits))
+	for _, hit := range hits {
+		ids = append(ids, hit.ItemID)
+	}
+
+	// the index does not know about groups, so hidden items are removed after ranking
+	items, err := itemQuery(client).Where(item.IDIn(ids...), visibleItems(ctx)).All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query items: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query items: %v", err)
+	}
+
+	visible := make(map[uuid.UUID]*ent.Item, len(items))
+	for _, i := range items {
+		visible[i.ID] = i
+	}
+
+	res := &gw.SearchResults{
+		Results: make([]*gw.SearchResult, 0, min(limit, len(items))),
+	}
+
+	for _, hit := range hits {
+		i, ok := visible[hit.ItemID]
+		if !ok {
+			continue
+		}
+
+		converted, err := toItem(i)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Results = append(res.Results, &gw.SearchResult{
+			Item: converted,
+			Rank: hit.Rank,
+		})
+
+		if len(res.Results) == limit {
+			break
+		}
+	}
+
+	log.S.Debugw("Searched items", "query", req.Query, "hits", len(hits), "results", len(res.Results))
+
+	return res, nil
+}
+
+func NewSearchServer() gw.SearchServiceServer {
+	return &searchServer{}
+}
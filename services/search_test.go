@@ -0,0 +1,150 @@
+package services
+
+import (
+	"context"
+	"dig-inv/certs"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/schema"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSearchServer_Search(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "search_tester")
+	items := NewItemServer()
+	search := NewSearchServer()
+
+	client, err := store.GetClient()
+	expectNoError(t, err)
+
+	group := client.UserGroup.Create().
+		SetName("Search Ops").
+		SetOidcScope("search-ops").
+		SetCreatedBy("search_tester").
+		SetUpdatedBy("search_tester").
+		SaveX(ctx)
+
+	class := createTestAssetClass(t, ctx, "Searchable",
+		&gw.AttributeDefinition{Key: "hostname", Name: "Hostname", Type: "string"},
+	)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "zebrafish gateway",
+		AssetClassId: class.Id,
+	})
+	expectNoError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "mail relay",
+		Description:  "forwards zebrafish notifications",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"hostname": structpb.NewStringValue("relay.zebrafish.example")},
+	})
+	expectNoError(t, err)
+
+	_, err = items.CreateItem(ctx, &gw.Item{
+		Name:         "zebrafish vault",
+		AssetClassId: class.Id,
+		GroupIds:     []string{group.ID.String()},
+	})
+	expectNoError(t, err)
+
+	res, err := search.Search(ctx, &gw.SearchRequest{Query: "zebrafish"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 2 || res.Results[0].Item.Name != "zebrafish gateway" {
+		t.Errorf("Unexpected search results without group scopes: %v", res.Results)
+	}
+
+	scoped := context.WithValue(ctx, AuthenticatedScopesKey, []string{"search-ops"})
+	res, err = search.Search(scoped, &gw.SearchRequest{Query: "zebrafish"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 3 {
+		t.Errorf("Expected 3 results with group scope, got %d", len(res.Results))
+	}
+
+	res, err = search.Search(ctx, &gw.SearchRequest{Query: "relay.zebrafish.example"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 1 || res.Results[0].Item.Name != "mail relay" {
+		t.Errorf("Expected attribute values to be searchable, got %v", res.Results)
+	}
+
+	// provider data is indexed with the items
+	records := createTestAssetClass(t, ctx, "Searchable records")
+	record, err := items.CreateItem(ctx, &gw.Item{Name: "www.search.example", AssetClassId: records.Id})
+	expectNoError(t, err)
+
+	client.DnsCheck.Create().
+		SetItemID(uuid.MustParse(record.Id)).
+		SetRecordName("www.search.example").
+		SetRecordType(dnscheck.RecordTypeA).
+		SetExpected("192.0.2.80").
+		SetStatus(dnscheck.StatusMismatch).
+		SetAnswers([]schema.DnsAnswer{{Resolver: "192.0.2.53:53", Rcode: "NOERROR", Values: []string{"198.51.100.77"}}}).
+		SetCreatedBy("search_tester").
+		SetUpdatedBy("search_tester").
+		ExecX(ctx)
+
+	res, err = search.Search(ctx, &gw.SearchRequest{Query: "198.51.100.77"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 1 || res.Results[0].Item.Id != record.Id {
+		t.Errorf("Expected the resolved address to be searchable, got %v", res.Results)
+	}
+
+	domain, err := items.CreateItem(ctx, &gw.Item{Name: "search.example", AssetClassId: records.Id})
+	expectNoError(t, err)
+
+	client.DomainLookup.Create().
+		SetItemID(uuid.MustParse(domain.Id)).
+		SetDomain("search.example").
+		SetSource(domainlookup.SourceRdap).
+		SetNameservers([]string{"ns1.quagga-dns.example"}).
+		SetCreatedBy("search_tester").
+		SetUpdatedBy("search_tester").
+		ExecX(ctx)
+
+	res, err = search.Search(ctx, &gw.SearchRequest{Query: "ns1.quagga-dns.example"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 1 || res.Results[0].Item.Id != domain.Id {
+		t.Errorf("Expected the name servers to be searchable, got %v", res.Results)
+	}
+
+	certificates, err := certs.CertificateClass(ctx, client, "search_tester")
+	expectNoError(t, err)
+
+	certificate := client.Item.Create().
+		SetName("search.example:443").
+		SetAssetClass(certificates).
+		SetAttributes(map[string]any{"endpoint": "search.example:443", "sans": "search.example, 203.0.113.44"}).
+		SetCreatedBy("search_tester").
+		SetUpdatedBy("search_tester").
+		SaveX(ctx)
+	client.ItemRelation.Create().
+		SetType(itemrelation.TypeDependsOn).
+		SetSourceID(uuid.MustParse(domain.Id)).
+		SetTarget(certificate).
+		SetCreatedBy("search_tester").
+		SetUpdatedBy("search_tester").
+		ExecX(ctx)
+
+	res, err = search.Search(ctx, &gw.SearchRequest{Query: "203.0.113.44"})
+	expectNoError(t, err)
+
+	if len(res.Results) != 2 || !slices.ContainsFunc(res.Results, func(r *gw.SearchResult) bool { return r.Item.Id == domain.Id }) {
+		t.Errorf("Expected the names of served certificates to be searchable, got %v", res.Results)
+	}
+
+	_, err = search.Search(ctx, &gw.SearchRequest{Query: " "})
+	expectError(t, err)
+}
@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/item"
+	"dig-inv/ent/schema"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/secrets"
+	"dig-inv/store"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// secretMask replaces the values of secret attributes in responses. Sending it back unchanged keeps the stored value.
+const secretMask = "********"
+
+func (i itemServer) RevealSecret(ctx context.Context, req *gw.RevealSecretRequest) (*gw.RevealedSecret, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	itemUuid, err := uuid.Parse(req.ItemId)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for item ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid item ID format: %v", err)
+	}
+
+	res, err := client.Item.Query().
+		Where(item.ID(itemUuid), item.DeletedAtIsNil()).
+		WithUserGroups().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query item: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query item: %v", err)
+	}
+
+	// unlike reading the item, revealing a secret is never allowed for items without groups
+	scopes, _ := ctx.Value(AuthenticatedScopesKey).([]string)
+	member := slices.ContainsFunc(res.Edges.UserGroups, func(group *ent.UserGroup) bool {
+		return group.DeletedAt == nil && slices.Contains(scopes, group.OidcScope)
+	})
+	if !member {
+		return nil, status.Errorf(codes.PermissionDenied, "revealing secrets requires membership in one of the groups of the item")
+	}
+
+	encrypted, ok := res.Secrets[req.Key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %q not found", req.Key)
+	}
+
+	keyring, err := loadKeyring()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := keyring.Decrypt(encrypted)
+	if err != nil {
+		grpclog.Errorf("Failed to decrypt secret: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to decrypt secret: %v", err)
+	}
+
+	// the secret is only returned once the reveal is recorded
+	if err := store.AuditReveal(ctx, client, ent.TypeItem, itemUuid, req.Key, user); err != nil {
+		grpclog.Errorf("Failed to record revealed secret: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to record revealed secret: %v", err)
+	}
+	log.S.Debugw("Revealed secret", "item", itemUuid, "key", req.Key, "user", user)
+
+	return &gw.RevealedSecret{
+		Value: plaintext,
+	}, nil
+}
+
+// splitItemSecrets removes the values of secret attributes from the raw attribute values and returns the secrets to
+// store for the item. Secrets whose mask is sent back are kept, all others are replaced or removed if empty.
+func splitItemSecrets(defs []*ent.AttributeDefinition, raw map[string]any, stored map[string]schema.EncryptedValue) (map[string]schema.EncryptedValue, error) {
+	// secrets of attributes that are no longer defined are dropped
+	kept := make(map[string]schema.EncryptedValue)
+	plaintext := make(map[string]string)
+
+	for _, def := range defs {
+		if !def.Secret {
+			continue
+		}
+
+		value := raw[def.Key]
+		delete(raw, def.Key)
+
+		s, ok := value.(string)
+		if value != nil && !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attributes: attribute %q: secret values must be text", def.Key)
+		}
+
+		if current, exists := stored[def.Key]; exists && s == secretMask {
+			kept[def.Key] = current
+			continue
+		}
+
+		if s == "" && def.Required {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid attributes: attribute %q is required", def.Key)
+		}
+		plaintext[def.Key] = s
+	}
+
+	return encryptSecrets(kept, plaintext)
+}
+
+// encryptSecrets returns the stored secrets with the given plaintext secrets encrypted under the current master key.
+// Secrets with empty value are removed.
+func encryptSecrets(stored map[string]schema.EncryptedValue, plaintext map[string]string) (map[string]schema.EncryptedValue, error) {
+	res := maps.Clone(stored)
+	if res == nil {
+		res = make(map[string]schema.EncryptedValue)
+	}
+
+	var keyring *secrets.Keyring
+	for _, name := range slices.Sorted(maps.Keys(plaintext)) {
+		if strings.TrimSpace(name) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "secret name must not be empty")
+		}
+
+		value := plaintext[name]
+		if value == "" {
+			delete(res, name)
+			continue
+		}
+
+		// the master key is only required if there is something to encrypt
+		if keyring == nil {
+			var err error
+			if keyring, err = loadKeyring(); err != nil {
+				return nil, err
+			}
+		}
+
+		encrypted, err := keyring.Encrypt(value)
+		if err != nil {
+			grpclog.Errorf("Failed to encrypt secret: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to encrypt secret: %v", err)
+		}
+		res[name] = encrypted
+	}
+
+	return res, nil
+}
+
+func loadKeyring() (*secrets.Keyring, error) {
+	keyring, err := secrets.LoadKeyring()
+	if errors.Is(err, secrets.ErrNoMasterKey) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to load master key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to load master key: %v", err)
+	}
+
+	return keyring, nil
+}
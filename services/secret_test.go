@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"dig-inv/ent/auditlog"
+	gw "dig-inv/gen/go"
+	"dig-inv/secrets"
+	"dig-inv/store"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestItemServer_RevealSecret(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "secret_tester")
+	classes := NewAssetClassServer()
+	items := NewItemServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	t.Setenv("MASTER_KEY", base64.StdEncoding.EncodeToString([]byte(strings.Repeat("s", secrets.KeySize))))
+
+	class, err := classes.CreateAssetClass(ctx, &gw.AssetClass{Name: "Secret servers"})
+	expectNoError(t, err)
+
+	_, err = classes.CreateAttributeDefinition(ctx, &gw.AttributeDefinition{AssetClassId: class.Id, Key: "pin", Name: "PIN", Type: "number", Secret: true})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for secret number, got %v", err)
+	}
+
+	def, err := classes.CreateAttributeDefinition(ctx, &gw.AttributeDefinition{AssetClassId: class.Id, Key: "ilo_password", Name: "iLO password", Type: "string", Secret: true})
+	expectNoError(t, err)
+
+	_, err = classes.CreateAttributeDefinition(ctx, &gw.AttributeDefinition{AssetClassId: class.Id, Key: "ilo_user", Name: "iLO user", Type: "string"})
+	expectNoError(t, err)
+
+	def.Secret = false
+	_, err = classes.UpdateAttributeDefinition(ctx, def)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for changed secret flag, got %v", err)
+	}
+
+	group := client.UserGroup.Create().SetName("Secret ops").SetOidcScope("secret-ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	created, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "secret-web-1",
+		AssetClassId: class.Id,
+		GroupIds:     []string{group.ID.String()},
+		Attributes: map[string]*structpb.Value{
+			"ilo_user":     structpb.NewStringValue("admin"),
+			"ilo_password": structpb.NewStringValue("hunter2"),
+		},
+	})
+	expectNoError(t, err)
+
+	if created.Attributes["ilo_password"].GetStringValue() != secretMask || created.Attributes["ilo_user"].GetStringValue() != "admin" {
+		t.Errorf("Expected masked secret, got %v", created.Attributes)
+	}
+
+	stored := client.Item.GetX(ctx, uuid.MustParse(created.Id))
+	if _, ok := stored.Attributes["ilo_password"]; ok || string(stored.Secrets["ilo_password"].Ciphertext) == "hunter2" {
+		t.Errorf("Expected secret to be stored encrypted, got %v", stored.Attributes)
+	}
+
+	// sending the mask back keeps the secret
+	updated, err := items.UpdateItem(ctx, created)
+	expectNoError(t, err)
+
+	if updated.Attributes["ilo_password"].GetStringValue() != secretMask {
+		t.Errorf("Expected secret to be kept, got %v", updated.Attributes)
+	}
+
+	_, err = items.GetItems(ctx, &gw.ItemFilter{Attributes: []*gw.AttributeFilter{{Key: "ilo_password", Operator: "exists"}}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for filter on secret, got %v", err)
+	}
+
+	req := &gw.RevealSecretRequest{ItemId: created.Id, Key: "ilo_password"}
+	_, err = items.RevealSecret(ctx, req)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected permission denied without group membership, got %v", err)
+	}
+
+	member := context.WithValue(ctx, AuthenticatedScopesKey, []string{"secret-ops"})
+	revealed, err := items.RevealSecret(member, req)
+	expectNoError(t, err)
+
+	if revealed.Value != "hunter2" {
+		t.Errorf("Expected revealed secret, got %q", revealed.Value)
+	}
+
+	reveals := client.AuditLog.Query().
+		Where(auditlog.EntityID(uuid.MustParse(created.Id)), auditlog.OperationEQ(auditlog.OperationReveal)).
+		AllX(ctx)
+	if len(reveals) != 1 || reveals[0].Actor != "secret_tester" || reveals[0].Changes[0].Field != "ilo_password" {
+		t.Errorf("Expected reveal to be audited, got %v", reveals)
+	}
+
+	_, err = items.RevealSecret(member, &gw.RevealSecretRequest{ItemId: created.Id, Key: "ilo_user"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for plain attribute, got %v", err)
+	}
+
+	// new values replace the secret and empty values remove it
+	updated.Attributes["ilo_password"] = structpb.NewStringValue("correct horse")
+	_, err = items.UpdateItem(ctx, updated)
+	expectNoError(t, err)
+
+	revealed, err = items.RevealSecret(member, req)
+	expectNoError(t, err)
+
+	if revealed.Value != "correct horse" {
+		t.Errorf("Expected replaced secret, got %q", revealed.Value)
+	}
+
+	delete(updated.Attributes, "ilo_password")
+	updated, err = items.UpdateItem(ctx, updated)
+	expectNoError(t, err)
+
+	if _, ok := updated.Attributes["ilo_password"]; ok {
+		t.Errorf("Expected secret to be removed, got %v", updated.Attributes)
+	}
+
+	_, err = items.RevealSecret(member, req)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected not found for removed secret, got %v", err)
+	}
+
+	// items without groups can be read by everyone, their secrets by no one
+	ungrouped, err := items.CreateItem(ctx, &gw.Item{
+		Name:         "secret-web-2",
+		AssetClassId: class.Id,
+		Attributes:   map[string]*structpb.Value{"ilo_password": structpb.NewStringValue("hunter3")},
+	})
+	expectNoError(t, err)
+
+	_, err = items.RevealSecret(member, &gw.RevealSecretRequest{ItemId: ungrouped.Id, Key: "ilo_password"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected permission denied for item without groups, got %v", err)
+	}
+}
@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/webhook"
+	"dig-inv/ent/webhookdelivery"
+	gw "dig-inv/gen/go"
+	"dig-inv/log"
+	"dig-inv/store"
+	webhooks "dig-inv/webhook"
+	"encoding/hex"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"strings"
+	"time"
+)
+
+type webhookServer struct {
+	gw.UnimplementedWebhookServiceServer
+}
+
+func (s webhookServer) GetWebhooks(ctx context.Context, _ *gw.EmptyMessage) (*gw.Webhooks, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	hooks, err := client.Webhook.Query().
+		Where(webhook.DeletedAtIsNil()).
+		WithAssetClasses().
+		Order(webhook.ByName(), webhook.ByID()).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query webhooks: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query webhooks: %v", err)
+	}
+
+	log.S.Debugw("Retrieved webhooks", "count", len(hooks))
+
+	res := make([]*gw.Webhook, 0, len(hooks))
+	for _, w := range hooks {
+		res = append(res, toWebhook(w))
+	}
+
+	return &gw.Webhooks{
+		Webhooks: res,
+	}, nil
+}
+
+func (s webhookServer) CreateWebhook(ctx context.Context, req *gw.Webhook) (*gw.Webhook, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	classUuids, err := validateWebhook(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate secret: %v", err)
+		}
+		secret = hex.EncodeToString(key)
+	}
+
+	created, err := client.Webhook.Create().
+		SetName(req.Name).
+		SetURL(req.Url).
+		SetSecret(secret).
+		SetEntityTypes(req.EntityTypes).
+		SetEvents(req.Events).
+		AddAssetClassIDs(classUuids...).
+		SetEnabled(req.Enabled).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to create webhook: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create webhook: %v", err)
+	}
+	log.S.Debugw("Created new webhook", "id", created.ID)
+
+	res, err := activeWebhook(ctx, client, created.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// the secret is only returned once, so generated secrets can be configured at the receiver
+	w := toWebhook(res)
+	w.Secret = secret
+
+	return w, nil
+}
+
+func (s webhookServer) UpdateWebhook(ctx context.Context, req *gw.Webhook) (*gw.Webhook, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	existing, err := activeWebhook(ctx, client, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	classUuids, err := validateWebhook(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	update := client.Webhook.UpdateOne(existing).
+		SetName(req.Name).
+		SetURL(req.Url).
+		SetEntityTypes(req.EntityTypes).
+		SetEvents(req.Events).
+		ClearAssetClasses().
+		AddAssetClassIDs(classUuids...).
+		SetEnabled(req.Enabled).
+		SetUpdatedBy(user)
+	if req.Secret != "" {
+		update = update.SetSecret(req.Secret)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		grpclog.Errorf("Failed to update webhook: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+	log.S.Debugw("Updated webhook", "id", existing.ID)
+
+	updated, err := activeWebhook(ctx, client, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhook(updated), nil
+}
+
+func (s webhookServer) DeleteWebhook(ctx context.Context, elementId *gw.ElementId) (*gw.EmptyMessage, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	w, err := activeWebhook(ctx, client, elementId.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.Webhook.UpdateOne(w).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy(user).
+		Exec(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to delete webhook: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete webhook: %v", err)
+	}
+	log.S.Debugw("Deleted webhook", "id", w.ID)
+
+	return &gw.EmptyMessage{}, nil
+}
+
+func (s webhookServer) GetWebhookDeliveries(ctx context.Context, req *gw.WebhookDeliveryFilter) (*gw.WebhookDeliveries, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	query := client.WebhookDelivery.Query().WithWebhook()
+
+	if req.WebhookId != "" {
+		webhookUuid, err := uuid.Parse(req.WebhookId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid webhook ID format: %v", err)
+		}
+		query = query.Where(webhookdelivery.HasWebhookWith(webhook.ID(webhookUuid)))
+	}
+
+	if req.Status != "" {
+		if err := webhookdelivery.StatusValidator(webhookdelivery.Status(req.Status)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid delivery status %q", req.Status)
+		}
+		query = query.Where(webhookdelivery.StatusEQ(webhookdelivery.Status(req.Status)))
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 50
+	}
+
+	deliveries, err := query.
+		Order(webhookdelivery.ByCreatedAt(sql.OrderDesc()), webhookdelivery.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query webhook deliveries: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query webhook deliveries: %v", err)
+	}
+
+	log.S.Debugw("Retrieved webhook deliveries", "count", len(deliveries))
+
+	res := make([]*gw.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		res = append(res, toWebhookDelivery(delivery))
+	}
+
+	return &gw.WebhookDeliveries{
+		Deliveries: res,
+	}, nil
+}
+
+func (s webhookServer) RedeliverWebhook(ctx context.Context, elementId *gw.ElementId) (*gw.WebhookDelivery, error) {
+	client, err := store.GetClient()
+	if err != nil {
+		grpclog.Errorf("Failed to get store client: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get store client: %v", err)
+	}
+
+	user := ctx.Value(AuthenticatedSubjectKey).(string)
+
+	deliveryUuid, err := uuid.Parse(elementId.Id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for webhook delivery ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook delivery ID format: %v", err)
+	}
+
+	delivery, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.ID(deliveryUuid)).
+		WithWebhook().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query webhook delivery: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query webhook delivery: %v", err)
+	}
+
+	if delivery.Edges.Webhook.DeletedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "webhook was deleted")
+	}
+
+	if delivery.Status == webhookdelivery.StatusPending {
+		return nil, status.Errorf(codes.FailedPrecondition, "delivery is still pending")
+	}
+
+	w := delivery.Edges.Webhook
+	delivery, err = webhooks.Redeliver(ctx, client, delivery, user)
+	if err != nil {
+		grpclog.Errorf("Failed to redeliver webhook: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to redeliver webhook: %v", err)
+	}
+	delivery.Edges.Webhook = w
+	log.S.Debugw("Queued webhook redelivery", "id", delivery.ID)
+
+	return toWebhookDelivery(delivery), nil
+}
+
+func activeWebhook(ctx context.Context, client *ent.Client, id string) (*ent.Webhook, error) {
+	webhookUuid, err := uuid.Parse(id)
+	if err != nil {
+		grpclog.Errorf("Invalid UUID format for webhook ID: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook ID format: %v", err)
+	}
+
+	w, err := client.Webhook.Query().
+		Where(webhook.ID(webhookUuid), webhook.DeletedAtIsNil()).
+		WithAssetClasses().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		grpclog.Errorf("Failed to query webhook: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query webhook: %v", err)
+	}
+
+	return w, nil
+}
+
+// validateWebhook checks the URL and filters of a webhook and returns the IDs of its asset classes.
+func validateWebhook(ctx context.Context, client *ent.Client, req *gw.Webhook) ([]uuid.UUID, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook name must not be empty")
+	}
+
+	if !strings.HasPrefix(req.Url, "http://") && !strings.HasPrefix(req.Url, "https://") {
+		return nil, status.Errorf(codes.InvalidArgument, "webhook url must be an http or https url")
+	}
+
+	for _, entityType := range req.EntityTypes {
+		if !slices.Contains(store.WebhookTypes, entityType) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entity type %q, expected one of %s", entityType, strings.Join(store.WebhookTypes, ", "))
+		}
+	}
+
+	for _, event := range req.Events {
+		if err := webhookdelivery.EventValidator(webhookdelivery.Event(event)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event %q, expected create, update or delete", event)
+		}
+	}
+
+	classUuids := make([]uuid.UUID, 0, len(req.AssetClassIds))
+	for _, id := range req.AssetClassIds {
+		classUuid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid asset class ID format: %v", err)
+		}
+		classUuids = append(classUuids, classUuid)
+	}
+
+	count, err := client.AssetClass.Query().
+		Where(assetclass.IDIn(classUuids...), assetclass.DeletedAtIsNil()).
+		Count(ctx)
+	if err != nil {
+		grpclog.Errorf("Failed to query asset classes: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to query asset classes: %v", err)
+	}
+	if count != len(classUuids) {
+		return nil, status.Errorf(codes.InvalidArgument, "asset class not found")
+	}
+
+	return classUuids, nil
+}
+
+func toWebhook(w *ent.Webhook) *gw.Webhook {
+	classIds := make([]string, 0, len(w.Edges.AssetClasses))
+	for _, class := range w.Edges.AssetClasses {
+		classIds = append(classIds, class.ID.String())
+	}
+
+	return &gw.Webhook{
+		Id:            w.ID.String(),
+		Name:          w.Name,
+		Url:           w.URL,
+		EntityTypes:   w.EntityTypes,
+		Events:        w.Events,
+		AssetClassIds: classIds,
+		Enabled:       w.Enabled,
+	}
+}
+
+func toWebhookDelivery(delivery *ent.WebhookDelivery) *gw.WebhookDelivery {
+	res := &gw.WebhookDelivery{
+		Id:             delivery.ID.String(),
+		Event:          string(delivery.Event),
+		EntityType:     delivery.EntityType,
+		EntityId:       delivery.EntityID.String(),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		Error:          delivery.Error,
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+
+	if delivery.Edges.Webhook != nil {
+		res.WebhookId = delivery.Edges.Webhook.ID.String()
+	}
+
+	if delivery.NextAttemptAt != nil {
+		res.NextAttemptAt = timestamppb.New(*delivery.NextAttemptAt)
+	}
+
+	if delivery.DeliveredAt != nil {
+		res.DeliveredAt = timestamppb.New(*delivery.DeliveredAt)
+	}
+
+	return res
+}
+
+func NewWebhookServer() gw.WebhookServiceServer {
+	return &webhookServer{}
+}
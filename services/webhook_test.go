@@ -0,0 +1,111 @@
+package services
+
+import (
+	"dig-inv/ent"
+	"dig-inv/ent/webhookdelivery"
+	gw "dig-inv/gen/go"
+	"dig-inv/store"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestWebhookServer(t *testing.T) {
+	ctx := getAuthenticatedTestContext(t, "webhook_tester")
+	webhooks := NewWebhookServer()
+
+	client, err := store.GetClient()
+	if err != nil {
+		t.Fatalf("Failed to get store client: %v", err)
+	}
+
+	class := client.AssetClass.Create().SetName("Webhook class").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	created, err := webhooks.CreateWebhook(ctx, &gw.Webhook{
+		Name:          "CMDB",
+		Url:           "https://cmdb.example.com/hook",
+		EntityTypes:   []string{ent.TypeItem},
+		Events:        []string{"create", "delete"},
+		AssetClassIds: []string{class.ID.String()},
+		Enabled:       true,
+	})
+	expectNoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = webhooks.DeleteWebhook(ctx, &gw.ElementId{Id: created.Id})
+	})
+
+	if len(created.Secret) != 64 || len(created.AssetClassIds) != 1 {
+		t.Errorf("Expected generated secret and asset class, got %v", created)
+	}
+
+	created.Name = "Renamed"
+	created.Secret = ""
+	updated, err := webhooks.UpdateWebhook(ctx, created)
+	expectNoError(t, err)
+
+	if updated.Name != "Renamed" || updated.Secret != "" {
+		t.Errorf("Unexpected updated webhook: %v", updated)
+	}
+
+	stored := client.Webhook.GetX(ctx, uuid.MustParse(created.Id))
+	if len(stored.Secret) != 64 {
+		t.Errorf("Expected secret to be kept on update")
+	}
+
+	invalid := []*gw.Webhook{
+		{Name: "", Url: "https://example.com"},
+		{Name: "Ftp", Url: "ftp://example.com"},
+		{Name: "Type", Url: "https://example.com", EntityTypes: []string{"JobRun"}},
+		{Name: "Event", Url: "https://example.com", Events: []string{"restore"}},
+		{Name: "Class", Url: "https://example.com", AssetClassIds: []string{uuid.NewString()}},
+	}
+	for _, req := range invalid {
+		_, err = webhooks.CreateWebhook(ctx, req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for %v, got %v", req, err)
+		}
+	}
+
+	client.Item.Create().SetName("hooked").SetAssetClass(class).SetCreatedBy("webhook_tester").SetUpdatedBy("webhook_tester").ExecX(ctx)
+
+	deliveries, err := webhooks.GetWebhookDeliveries(ctx, &gw.WebhookDeliveryFilter{WebhookId: created.Id})
+	expectNoError(t, err)
+
+	if len(deliveries.Deliveries) != 1 || deliveries.Deliveries[0].Event != "create" || deliveries.Deliveries[0].WebhookId != created.Id {
+		t.Fatalf("Unexpected deliveries: %v", deliveries.Deliveries)
+	}
+
+	delivery := deliveries.Deliveries[0]
+	_, err = webhooks.RedeliverWebhook(ctx, &gw.ElementId{Id: delivery.Id})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected failed precondition for pending delivery, got %v", err)
+	}
+
+	client.WebhookDelivery.UpdateOneID(uuid.MustParse(delivery.Id)).SetStatus(webhookdelivery.StatusFailed).SetUpdatedBy("worker").ExecX(ctx)
+	redelivered, err := webhooks.RedeliverWebhook(ctx, &gw.ElementId{Id: delivery.Id})
+	expectNoError(t, err)
+
+	if redelivered.Status != string(webhookdelivery.StatusPending) {
+		t.Errorf("Expected redelivery to be pending, got %v", redelivered)
+	}
+
+	_, err = webhooks.GetWebhookDeliveries(ctx, &gw.WebhookDeliveryFilter{Status: "lost"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected invalid argument for unknown status, got %v", err)
+	}
+
+	_, err = webhooks.DeleteWebhook(ctx, &gw.ElementId{Id: created.Id})
+	expectNoError(t, err)
+
+	list, err := webhooks.GetWebhooks(ctx, &gw.EmptyMessage{})
+	expectNoError(t, err)
+
+	for _, w := range list.Webhooks {
+		if w.Id == created.Id {
+			t.Errorf("Expected deleted webhook to be hidden")
+		}
+	}
+}
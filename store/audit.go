@@ -0,0 +1,308 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/hook"
+	"dig-inv/ent/schema"
+	"fmt"
+	"github.com/google/uuid"
+	"reflect"
+	"slices"
+)
+
+// AuditedTypes lists the entity types whose mutations are recorded in the audit log.
+var AuditedTypes = []string{
+	ent.TypeItem,
+	ent.TypeTag,
+	ent.TypeUserGroup,
+	ent.TypeAssetClass,
+	ent.TypeAttributeDefinition,
+	ent.TypeItemRelation,
+	ent.TypeSavedView,
+	ent.TypeAttachment,
+	ent.TypeComment,
+}
+
+// fields that change with every mutation and are already represented by the actor and timestamp of an entry
+var auditIgnoredFields = []string{"created_by", "created_at", "updated_by", "updated_at"}
+
+// fields holding encrypted values, only the keys of their entries are recorded so the audit log never holds ciphertext
+// of secrets that could outlive a master key rotation
+var auditMaskedFields = []string{"secrets"}
+
+// auditableMutation is implemented by all generated mutations of the audited types.
+type auditableMutation interface {
+	ent.Mutation
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Client() *ent.Client
+	Tx() (*ent.Tx, error)
+}
+
+func registerAuditHooks(client *ent.Client) {
+	client.Use(auditHook)
+
+	// audit entries are immutable
+	client.AuditLog.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+func auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !slices.Contains(AuditedTypes, m.Type()) {
+			return next.Mutate(ctx, m)
+		}
+
+		am, ok := m.(auditableMutation)
+		if !ok {
+			return nil, fmt.Errorf("mutation %T can not be audited", m)
+		}
+
+		// the affected IDs and previous values have to be collected before the mutation is applied
+		var ids []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = am.IDs(ctx); err != nil {
+				return nil, fmt.Errorf("failed to determine audited IDs: %w", err)
+			}
+		}
+
+		client := hookClient(ctx, am)
+		changes := make(map[uuid.UUID][]schema.AuditChange, len(ids))
+		if m.Op().Is(ent.OpUpdate) {
+			// bulk updates have no previous values, they are loaded with a single entity update of every ID
+			for _, id := range ids {
+				old, err := auditUpdateOne(client, m.Type(), id)
+				if err != nil {
+					return nil, err
+				}
+
+				if changes[id], err = auditChanges(ctx, am, old); err != nil {
+					return nil, err
+				}
+			}
+		} else {
+			shared, err := auditChanges(ctx, am, am)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range ids {
+				changes[id] = shared
+			}
+			changes[uuid.Nil] = shared
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return value, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			id, exists := am.ID()
+			if !exists {
+				return value, fmt.Errorf("created %s has no ID", m.Type())
+			}
+			ids = []uuid.UUID{id}
+			changes[id] = changes[uuid.Nil]
+		}
+
+		if len(ids) == 0 {
+			return value, nil
+		}
+
+		builders := make([]*ent.AuditLogCreate, 0, len(ids))
+		for _, id := range ids {
+			builders = append(builders, client.AuditLog.Create().
+				SetEntityType(m.Type()).
+				SetEntityID(id).
+				SetOperation(auditOperation(m.Op())).
+				SetActor(auditActor(m)).
+				SetChanges(changes[id]))
+		}
+
+		if err := client.AuditLog.CreateBulk(builders...).Exec(ctx); err != nil {
+			return value, fmt.Errorf("failed to write audit log: %w", err)
+		}
+
+		return value, nil
+	})
+}
+
+// auditUpdateOne returns a single entity update of the entity, which only serves to load its previous values.
+func auditUpdateOne(client *ent.Client, entityType string, id uuid.UUID) (ent.Mutation, error) {
+	switch entityType {
+	case ent.TypeItem:
+		return client.Item.UpdateOneID(id).Mutation(), nil
+	case ent.TypeTag:
+		return client.Tag.UpdateOneID(id).Mutation(), nil
+	case ent.TypeUserGroup:
+		return client.UserGroup.UpdateOneID(id).Mutation(), nil
+	case ent.TypeAssetClass:
+		return client.AssetClass.UpdateOneID(id).Mutation(), nil
+	case ent.TypeAttributeDefinition:
+		return client.AttributeDefinition.UpdateOneID(id).Mutation(), nil
+	case ent.TypeItemRelation:
+		return client.ItemRelation.UpdateOneID(id).Mutation(), nil
+	case ent.TypeSavedView:
+		return client.SavedView.UpdateOneID(id).Mutation(), nil
+	case ent.TypeAttachment:
+		return client.Attachment.UpdateOneID(id).Mutation(), nil
+	case ent.TypeComment:
+		return client.Comment.UpdateOneID(id).Mutation(), nil
+	}
+
+	return nil, fmt.Errorf("previous values of %s can not be loaded", entityType)
+}
+
+func auditOperation(op ent.Op) auditlog.Operation {
+	switch {
+	case op.Is(ent.OpCreate):
+		return auditlog.OperationCreate
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return auditlog.OperationDelete
+	default:
+		return auditlog.OperationUpdate
+	}
+}
+
+// auditActor returns the user responsible for the mutation. All services set the updated_by and
+// created_by fields on every mutation, so they are used instead of threading the user through the context.
+func auditActor(m ent.Mutation) string {
+	for _, name := range []string{"updated_by", "created_by", "deleted_by"} {
+		if value, ok := m.Field(name); ok {
+			if actor, ok := value.(string); ok && actor != "" {
+				return actor
+			}
+		}
+	}
+
+	return ""
+}
+
+// auditChanges returns the changes of the mutation. The previous values are loaded through old, which is the mutation
+// itself for single entity updates, or a single entity update of one of the entities of a bulk update. Creates and
+// deletes only record the new values.
+func auditChanges(ctx context.Context, m auditableMutation, old ent.Mutation) ([]schema.AuditChange, error) {
+	changes := make([]schema.AuditChange, 0)
+
+	withBefore := old.Op().Is(ent.OpUpdateOne)
+
+	for _, name := range m.Fields() {
+		if slices.Contains(auditIgnoredFields, name) {
+			continue
+		}
+
+		after, _ := m.Field(name)
+		change := schema.AuditChange{Field: name, After: after}
+
+		if withBefore {
+			before, err := old.OldField(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load previous value of %s: %w", name, err)
+			}
+
+			if reflect.DeepEqual(derefValue(before), after) {
+				continue
+			}
+			change.Before = derefValue(before)
+		}
+
+		if slices.Contains(auditMaskedFields, name) {
+			change.Before, change.After = maskValue(change.Before), maskValue(change.After)
+		}
+
+		changes = append(changes, change)
+	}
+
+	for _, name := range m.ClearedFields() {
+		change := schema.AuditChange{Field: name, Cleared: true}
+
+		if withBefore {
+			before, err := old.OldField(ctx, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load previous value of %s: %w", name, err)
+			}
+
+			before = derefValue(before)
+			if before == nil {
+				continue
+			}
+			change.Before = before
+			if slices.Contains(auditMaskedFields, name) {
+				change.Before = maskValue(before)
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	edges := slices.Concat(m.AddedEdges(), m.RemovedEdges(), m.ClearedEdges())
+	slices.Sort(edges)
+	for _, name := range slices.Compact(edges) {
+		changes = append(changes, schema.AuditChange{
+			Field:   name,
+			Edge:    true,
+			Added:   auditIDs(m.AddedIDs(name)),
+			Removed: auditIDs(m.RemovedIDs(name)),
+			Cleared: m.EdgeCleared(name),
+		})
+	}
+
+	return changes, nil
+}
+
+func auditIDs(values []ent.Value) []string {
+	ids := make([]string, 0, len(values))
+	for _, value := range values {
+		ids = append(ids, fmt.Sprint(value))
+	}
+
+	return ids
+}
+
+// AuditReveal records that the actor read the secret attribute with the given key of an entity.
+func AuditReveal(ctx context.Context, client *ent.Client, entityType string, id uuid.UUID, key, actor string) error {
+	err := client.AuditLog.Create().
+		SetEntityType(entityType).
+		SetEntityID(id).
+		SetOperation(auditlog.OperationReveal).
+		SetActor(actor).
+		SetChanges([]schema.AuditChange{{Field: key}}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
+
+// maskValue returns the sorted keys of a map value, which tells which secrets changed without recording their values.
+func maskValue(value ent.Value) ent.Value {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return nil
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		keys = append(keys, fmt.Sprint(key.Interface()))
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// nillable fields return pointers as previous value
+func derefValue(value ent.Value) ent.Value {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Pointer {
+		return value
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+
+	return v.Elem().Interface()
+}
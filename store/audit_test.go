@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/hook"
+	"dig-inv/ent/schema"
+	"dig-inv/ent/tag"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAuditHook(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	class, err := client.AssetClass.Create().
+		SetName("Server").
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating asset class: %v", err)
+	}
+
+	_, err = client.AssetClass.UpdateOneID(class.ID).
+		SetName("Servers").
+		SetDescription("Dedicated and cloud servers").
+		SetUpdatedBy("bob").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed updating asset class: %v", err)
+	}
+
+	_, err = client.AssetClass.UpdateOneID(class.ID).
+		SetDeletedAt(time.Now()).
+		SetUpdatedBy("carol").
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed deleting asset class: %v", err)
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(auditlog.EntityID(class.ID)).
+		Order(auditlog.ByTimestamp()).
+		All(ctx)
+	if err != nil {
+		t.Fatalf("failed querying audit log: %v", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 audit entries, got %d", len(entries))
+	}
+
+	if entries[0].Operation != auditlog.OperationCreate || entries[0].Actor != "alice" {
+		t.Errorf("Unexpected create entry: %v", entries[0])
+	}
+
+	update := entries[1]
+	if update.Operation != auditlog.OperationUpdate || update.Actor != "bob" {
+		t.Errorf("Unexpected update entry: %v", update)
+	}
+
+	// unchanged values and bookkeeping fields are not recorded
+	if len(update.Changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %v", update.Changes)
+	}
+
+	for _, change := range update.Changes {
+		if change.Field == "name" && (change.Before != "Server" || change.After != "Servers") {
+			t.Errorf("Unexpected name change: %v", change)
+		}
+	}
+
+	if entries[2].Actor != "carol" || len(entries[2].Changes) != 1 || entries[2].Changes[0].Field != "deleted_at" {
+		t.Errorf("Unexpected soft delete entry: %v", entries[2])
+	}
+
+	if err := client.AssetClass.DeleteOneID(class.ID).Exec(ctx); err != nil {
+		t.Fatalf("failed deleting asset class: %v", err)
+	}
+
+	deleted, err := client.AuditLog.Query().
+		Where(auditlog.EntityID(class.ID), auditlog.OperationEQ(auditlog.OperationDelete)).
+		Count(ctx)
+	if err != nil || deleted != 1 {
+		t.Errorf("Expected a delete entry, got %d (%v)", deleted, err)
+	}
+}
+
+func TestAuditHookSecrets(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	class := client.AssetClass.Create().SetName("Secret servers").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	secret := schema.EncryptedValue{KeyID: "k1", Ciphertext: []byte("ciphertext")}
+	i := client.Item.Create().
+		SetName("ilo-1").
+		SetAssetClass(class).
+		SetSecrets(map[string]schema.EncryptedValue{"password": secret}).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		SaveX(ctx)
+
+	secret.Ciphertext = []byte("rotated")
+	client.Item.UpdateOne(i).
+		SetSecrets(map[string]schema.EncryptedValue{"password": secret, "login": secret}).
+		SetUpdatedBy("bob").
+		ExecX(ctx)
+
+	if err := AuditReveal(ctx, client, ent.TypeItem, i.ID, "password", "carol"); err != nil {
+		t.Fatalf("Failed to record reveal: %v", err)
+	}
+
+	entries := client.AuditLog.Query().Where(auditlog.EntityID(i.ID)).Order(auditlog.ByTimestamp()).AllX(ctx)
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 audit entries, got %d", len(entries))
+	}
+
+	// only the keys of the secrets are recorded
+	idx := slices.IndexFunc(entries[1].Changes, func(change schema.AuditChange) bool { return change.Field == "secrets" })
+	if idx < 0 {
+		t.Fatalf("Expected secrets change, got %+v", entries[1].Changes)
+	}
+	if change := entries[1].Changes[idx]; !reflect.DeepEqual(change.Before, []any{"password"}) ||
+		!reflect.DeepEqual(change.After, []any{"login", "password"}) {
+		t.Errorf("Unexpected secrets change: %+v", change)
+	}
+
+	reveal := entries[2]
+	if reveal.Operation != auditlog.OperationReveal || reveal.Actor != "carol" || reveal.Changes[0].Field != "password" {
+		t.Errorf("Unexpected reveal entry: %+v", reveal)
+	}
+
+	revision, err := RevisionAt(ctx, client, ent.TypeItem, i.ID, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to rebuild revision: %v", err)
+	}
+
+	if _, ok := revision.Fields["password"]; ok || revision.Fields["name"] != "ilo-1" {
+		t.Errorf("Expected reveal not to change the revision, got %v", revision.Fields)
+	}
+}
+
+func TestAuditHookEdges(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	tag := client.Tag.Create().SetName("prod").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	class := client.AssetClass.Create().SetName("Domain").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	item := client.Item.Create().
+		SetName("example.com").
+		AddTags(tag).
+		SetAssetClass(class).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		SaveX(ctx)
+
+	entry, err := client.AuditLog.Query().Where(auditlog.EntityID(item.ID)).Only(ctx)
+	if err != nil {
+		t.Fatalf("failed querying audit log: %v", err)
+	}
+
+	found := false
+	for _, change := range entry.Changes {
+		if change.Field == "tags" && len(change.Added) == 1 && change.Added[0] == tag.ID.String() {
+			found = true
+		}
+	}
+
+	if !found {
+		t.Errorf("Expected tag edge change, got %v", entry.Changes)
+	}
+}
+
+func TestAuditLogImmutable(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	client.Tag.Create().SetName("immutable").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+
+	if _, err := client.AuditLog.Delete().Exec(ctx); err == nil {
+		t.Error("Expected deleting audit entries to fail")
+	}
+}
+
+func TestAuditHookBulkUpdate(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	first := client.Tag.Create().SetName("bulk-a").SetDescription("first").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	second := client.Tag.Create().SetName("bulk-b").SetDescription("second").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+
+	client.Tag.Update().
+		Where(tag.IDIn(first.ID, second.ID)).
+		SetDescription("retired").
+		SetUpdatedBy("bob").
+		ExecX(ctx)
+
+	for _, expected := range []struct {
+		id     uuid.UUID
+		before string
+	}{{first.ID, "first"}, {second.ID, "second"}} {
+		entry := client.AuditLog.Query().
+			Where(auditlog.EntityID(expected.id), auditlog.OperationEQ(auditlog.OperationUpdate)).
+			OnlyX(ctx)
+
+		if len(entry.Changes) != 1 || entry.Changes[0].Before != expected.before || entry.Changes[0].After != "retired" {
+			t.Errorf("Expected the previous description of %s, got %+v", expected.id, entry.Changes)
+		}
+	}
+}
+
+func TestAuditHookTransaction(t *testing.T) {
+	client := openTestClient(t, "audit")
+	ctx := context.Background()
+
+	client.AuditLog.Use(hook.Reject(ent.OpCreate))
+
+	// a change without audit entry is not committed
+	if _, err := client.Tag.Create().SetName("unaudited").SetCreatedBy("alice").SetUpdatedBy("alice").Save(ctx); err == nil {
+		t.Fatal("Expected the change to fail with the audit log")
+	}
+
+	if exists := client.Tag.Query().Where(tag.Name("unaudited")).ExistX(ctx); exists {
+		t.Error("Expected the change to be rolled back")
+	}
+}
@@ -0,0 +1,551 @@
+package store
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	stdsql "database/sql"
+	"dig-inv/ent"
+	"dig-inv/ent/migrate"
+	"dig-inv/env"
+	"dig-inv/log"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+const (
+	BackupFormat  = "dig-inv-backup"
+	BackupVersion = 1
+)
+
+const backupManifestName = "manifest.json"
+
+// BackupManifest describes the tables of a backup archive in the order they have to be restored.
+type BackupManifest struct {
+	Format    string        `json:"format"`
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"created_at"`
+	Tables    []BackupTable `json:"tables"`
+}
+
+type BackupTable struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int      `json:"rows"`
+}
+
+// Backup writes all rows of all tables, including soft-deleted entities, edges and the audit log, to a gzipped tar
+// archive. Every table is stored as JSON Lines with values in a representation that doesn't depend on the database,
+// so a backup of SQLite can be restored to PostgreSQL and vice versa. The search index is rebuilt on restore.
+// All tables are read in a single transaction, so the backup is a consistent snapshot of the store. The tables are
+// streamed into the archive, they are read twice, as the manifest holding their row counts and every file header
+// holding its size precede the rows.
+func Backup(ctx context.Context, client *ent.Client, w io.Writer) error {
+	tables, err := restoreOrder(migrate.Tables)
+	if err != nil {
+		return err
+	}
+
+	// SQLite transactions are serializable, postgres needs to keep the snapshot of the first query
+	var opts *sql.TxOptions
+	if storeDialect() == dialect.Postgres {
+		opts = &sql.TxOptions{Isolation: stdsql.LevelRepeatableRead, ReadOnly: true}
+	}
+
+	tx, err := client.BeginTx(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("failed to start backup transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	manifest := BackupManifest{
+		Format:    BackupFormat,
+		Version:   BackupVersion,
+		CreatedAt: time.Now(),
+		Tables:    make([]BackupTable, 0, len(tables)),
+	}
+
+	sizes := make([]int64, 0, len(tables))
+	for _, table := range tables {
+		counter := &countingWriter{w: io.Discard}
+		rows, err := dumpTable(ctx, tx.Client(), table, counter)
+		if err != nil {
+			return err
+		}
+
+		manifest.Tables = append(manifest.Tables, BackupTable{Name: table.Name, Columns: columnNames(table), Rows: rows})
+		sizes = append(sizes, counter.n)
+	}
+
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode backup manifest: %w", err)
+	}
+
+	if err := writeArchiveFile(archive, backupManifestName, encoded); err != nil {
+		return err
+	}
+
+	// the snapshot of the transaction returns the same rows again, the archive fails on any other size
+	for i, table := range tables {
+		name := backupTableFile(table.Name)
+		header := &tar.Header{Name: name, Mode: 0o600, Size: sizes[i], ModTime: time.Now()}
+		if err := archive.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write %s to backup archive: %w", name, err)
+		}
+
+		if _, err := dumpTable(ctx, tx.Client(), table, archive); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("failed to close backup archive: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to end backup transaction: %w", err)
+	}
+
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress backup archive: %w", err)
+	}
+
+	log.S.Infow("Created backup", "tables", len(manifest.Tables))
+
+	return nil
+}
+
+// Restore loads a backup archive into an empty database. References between the restored rows are validated
+// before they are written, and all rows are restored in a single transaction.
+func Restore(ctx context.Context, client *ent.Client, r io.Reader) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to read backup archive: %w", err)
+	}
+
+	archive := tar.NewReader(gz)
+
+	var manifest BackupManifest
+	if err := readArchiveFile(archive, backupManifestName, &manifest); err != nil {
+		return err
+	}
+
+	if manifest.Format != BackupFormat || manifest.Version < 1 || manifest.Version > BackupVersion {
+		return fmt.Errorf("unsupported backup format %s version %d", manifest.Format, manifest.Version)
+	}
+
+	if err := checkEmpty(ctx, client); err != nil {
+		return err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// the primary keys of the restored tables, to validate references
+	keys := make(map[string]map[string]bool)
+
+	for _, entry := range manifest.Tables {
+		table, err := restoreTable(ctx, tx.Client(), archive, entry, keys)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		log.S.Debugw("Restored table", "table", table, "rows", entry.Rows)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit restore: %w", err)
+	}
+
+	if currentSearchMode != searchDisabled {
+		if err := RebuildSearchIndex(ctx, client); err != nil {
+			return err
+		}
+	}
+
+	log.S.Infow("Restored backup", "created", manifest.CreatedAt, "tables", len(manifest.Tables))
+
+	return nil
+}
+
+// restoreOrder sorts the tables so every table follows the tables it references.
+func restoreOrder(tables []*schema.Table) ([]*schema.Table, error) {
+	res := make([]*schema.Table, 0, len(tables))
+	added := make(map[string]bool)
+
+	for len(res) < len(tables) {
+		progress := false
+		for _, table := range tables {
+			if added[table.Name] {
+				continue
+			}
+
+			ready := true
+			for _, fk := range table.ForeignKeys {
+				if fk.RefTable.Name != table.Name && !added[fk.RefTable.Name] {
+					ready = false
+				}
+			}
+
+			if ready {
+				res = append(res, table)
+				added[table.Name] = true
+				progress = true
+			}
+		}
+
+		if !progress {
+			return nil, errors.New("tables reference each other in a cycle")
+		}
+	}
+
+	return res, nil
+}
+
+func storeDialect() string {
+	if env.GetStoreDriver() == dialect.Postgres {
+		return dialect.Postgres
+	}
+
+	return dialect.SQLite
+}
+
+func columnNames(table *schema.Table) []string {
+	res := make([]string, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		res = append(res, column.Name)
+	}
+
+	return res
+}
+
+func backupTableFile(table string) string {
+	return "tables/" + table + ".jsonl"
+}
+
+func writeArchiveFile(archive *tar.Writer, name string, content []byte) error {
+	header := &tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), ModTime: time.Now()}
+	if err := archive.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write %s to backup archive: %w", name, err)
+	}
+
+	if _, err := archive.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s to backup archive: %w", name, err)
+	}
+
+	return nil
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func readArchiveFile(archive *tar.Reader, name string, v any) error {
+	header, err := archive.Next()
+	if err != nil {
+		return fmt.Errorf("failed to read %s from backup archive: %w", name, err)
+	}
+
+	if header.Name != name {
+		return fmt.Errorf("expected %s in backup archive, found %s", name, header.Name)
+	}
+
+	if err := json.NewDecoder(archive).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func dumpTable(ctx context.Context, client *ent.Client, table *schema.Table, w io.Writer) (int, error) {
+	selector := sql.Dialect(storeDialect()).Select(columnNames(table)...).From(sql.Table(table.Name))
+	for _, column := range table.PrimaryKey {
+		selector.OrderBy(column.Name)
+	}
+	query, args := selector.Query()
+
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query %s: %w", table.Name, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	encoder := json.NewEncoder(w)
+	count := 0
+	for rows.Next() {
+		values := make([]any, len(table.Columns))
+		pointers := make([]any, len(table.Columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+
+		if err := rows.Scan(pointers...); err != nil {
+			return 0, fmt.Errorf("failed to read %s: %w", table.Name, err)
+		}
+
+		record := make(map[string]any, len(values))
+		for i, column := range table.Columns {
+			if record[column.Name], err = portableValue(column, values[i]); err != nil {
+				return 0, fmt.Errorf("failed to read %s.%s: %w", table.Name, column.Name, err)
+			}
+		}
+
+		if err := encoder.Encode(record); err != nil {
+			return 0, fmt.Errorf("failed to encode %s: %w", table.Name, err)
+		}
+		count++
+	}
+
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", table.Name, err)
+	}
+
+	return count, nil
+}
+
+// portableValue converts a value read by the database driver to its representation in the backup.
+func portableValue(column *schema.Column, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if b, ok := value.([]byte); ok && column.Type != field.TypeBytes {
+		value = string(b)
+	}
+
+	switch column.Type {
+	case field.TypeTime:
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("unexpected time value %v", value)
+		}
+		return t.Format(time.RFC3339Nano), nil
+	case field.TypeJSON:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected JSON value %v", value)
+		}
+		return json.RawMessage(s), nil
+	case field.TypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case int64:
+			return v != 0, nil
+		}
+		return nil, fmt.Errorf("unexpected bool value %v", value)
+	}
+
+	return value, nil
+}
+
+// databaseValue converts a value of the backup to an argument of an insert statement.
+func databaseValue(column *schema.Column, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	switch column.Type {
+	case field.TypeTime:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return nil, err
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	case field.TypeJSON:
+		return string(raw), nil
+	case field.TypeBool:
+		var b bool
+		err := json.Unmarshal(raw, &b)
+		return b, err
+	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		var i int64
+		err := json.Unmarshal(raw, &i)
+		return i, err
+	case field.TypeFloat32, field.TypeFloat64:
+		var f float64
+		err := json.Unmarshal(raw, &f)
+		return f, err
+	case field.TypeBytes:
+		var b []byte
+		err := json.Unmarshal(raw, &b)
+		return b, err
+	}
+
+	var s string
+	err := json.Unmarshal(raw, &s)
+	return s, err
+}
+
+func checkEmpty(ctx context.Context, client *ent.Client) error {
+	for _, table := range migrate.Tables {
+		query, args := sql.Dialect(storeDialect()).Select(sql.Count("*")).From(sql.Table(table.Name)).Query()
+
+		rows, err := client.QueryContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("failed to count %s: %w", table.Name, err)
+		}
+
+		count := 0
+		if rows.Next() {
+			err = rows.Scan(&count)
+		}
+		_ = rows.Close()
+		if err != nil {
+			return fmt.Errorf("failed to count %s: %w", table.Name, err)
+		}
+
+		if count > 0 {
+			return fmt.Errorf("restore requires an empty database, %s has %d rows", table.Name, count)
+		}
+	}
+
+	return nil
+}
+
+// restoreTable validates the references of the rows of a table and inserts them. Columns referencing the table
+// itself are set after all rows were inserted.
+func restoreTable(ctx context.Context, client *ent.Client, archive *tar.Reader, entry BackupTable, keys map[string]map[string]bool) (string, error) {
+	idx := slices.IndexFunc(migrate.Tables, func(t *schema.Table) bool { return t.Name == entry.Name })
+	if idx < 0 {
+		return "", fmt.Errorf("backup contains unknown table %s", entry.Name)
+	}
+	table := migrate.Tables[idx]
+
+	for _, name := range entry.Columns {
+		if !slices.ContainsFunc(table.Columns, func(c *schema.Column) bool { return c.Name == name }) {
+			return "", fmt.Errorf("backup contains unknown column %s.%s", table.Name, name)
+		}
+	}
+
+	header, err := archive.Next()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s from backup archive: %w", table.Name, err)
+	}
+	if header.Name != backupTableFile(table.Name) {
+		return "", fmt.Errorf("expected %s in backup archive, found %s", backupTableFile(table.Name), header.Name)
+	}
+
+	records := make([]map[string]json.RawMessage, 0, entry.Rows)
+	scanner := bufio.NewScanner(archive)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var record map[string]json.RawMessage
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return "", fmt.Errorf("failed to decode row %d of %s: %w", len(records)+1, table.Name, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", table.Name, err)
+	}
+
+	if len(records) != entry.Rows {
+		return "", fmt.Errorf("expected %d rows in %s, found %d", entry.Rows, table.Name, len(records))
+	}
+
+	if len(table.PrimaryKey) == 1 {
+		pk := table.PrimaryKey[0].Name
+		keys[table.Name] = make(map[string]bool, len(records))
+		for _, record := range records {
+			keys[table.Name][string(record[pk])] = true
+		}
+	}
+
+	deferred := make([]*schema.Column, 0)
+	for _, fk := range table.ForeignKeys {
+		column, refTable := fk.Columns[0], fk.RefTable.Name
+		if refTable == table.Name {
+			deferred = append(deferred, column)
+		}
+
+		for i, record := range records {
+			value := record[column.Name]
+			if len(value) == 0 || string(value) == "null" {
+				continue
+			}
+
+			if !keys[refTable][string(value)] {
+				return "", fmt.Errorf("row %d of %s references missing %s %s", i+1, table.Name, refTable, value)
+			}
+		}
+	}
+
+	d := storeDialect()
+	for i, record := range records {
+		insert := sql.Dialect(d).Insert(table.Name)
+		columns := make([]string, 0, len(entry.Columns))
+		values := make([]any, 0, len(entry.Columns))
+		for _, column := range table.Columns {
+			raw, ok := record[column.Name]
+			if !ok || slices.Contains(deferred, column) {
+				continue
+			}
+
+			value, err := databaseValue(column, raw)
+			if err != nil {
+				return "", fmt.Errorf("invalid value of %s.%s in row %d: %w", table.Name, column.Name, i+1, err)
+			}
+
+			columns = append(columns, column.Name)
+			values = append(values, value)
+		}
+
+		query, args := insert.Columns(columns...).Values(values...).Query()
+		if _, err := client.ExecContext(ctx, query, args...); err != nil {
+			return "", fmt.Errorf("failed to restore row %d of %s: %w", i+1, table.Name, err)
+		}
+	}
+
+	for _, column := range deferred {
+		pk := table.PrimaryKey[0]
+		for i, record := range records {
+			value, err := databaseValue(column, record[column.Name])
+			if err != nil || value == nil {
+				continue
+			}
+
+			id, err := databaseValue(pk, record[pk.Name])
+			if err != nil {
+				return "", fmt.Errorf("invalid value of %s.%s in row %d: %w", table.Name, pk.Name, i+1, err)
+			}
+
+			query, args := sql.Dialect(d).Update(table.Name).Set(column.Name, value).Where(sql.EQ(pk.Name, id)).Query()
+			if _, err := client.ExecContext(ctx, query, args...); err != nil {
+				return "", fmt.Errorf("failed to restore %s.%s of row %d: %w", table.Name, column.Name, i+1, err)
+			}
+		}
+	}
+
+	return table.Name, nil
+}
@@ -0,0 +1,208 @@
+package store
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/itemrelation"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func openBackupTestClient(t *testing.T, name string) *ent.Client {
+	client := openTestClient(t, name)
+	t.Cleanup(func() {
+		currentSearchMode = searchDisabled
+	})
+
+	if err := InitializeSearchIndex(context.Background(), client); err != nil {
+		t.Fatalf("failed initializing search index: %v", err)
+	}
+
+	return client
+}
+
+// archiveFiles returns the content of all files of a backup archive, except for the manifest with its timestamp.
+func archiveFiles(t *testing.T, b []byte) map[string]string {
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("failed reading backup: %v", err)
+	}
+
+	res := make(map[string]string)
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return res
+		}
+		if err != nil {
+			t.Fatalf("failed reading backup: %v", err)
+		}
+
+		content, err := io.ReadAll(archive)
+		if err != nil {
+			t.Fatalf("failed reading backup: %v", err)
+		}
+
+		if header.Name != backupManifestName {
+			res[header.Name] = string(content)
+		}
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	source := openBackupTestClient(t, "backup_source")
+	ctx := context.Background()
+
+	class := source.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	source.AttributeDefinition.Create().
+		SetKey("hostname").
+		SetName("Hostname").
+		SetType(attributedefinition.TypeString).
+		SetAssetClass(class).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	tag := source.Tag.Create().SetName("prod").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	group := source.UserGroup.Create().SetName("Ops").SetOidcScope("ops").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	rack := source.Item.Create().
+		SetName("rack").
+		SetAssetClass(class).
+		AddTags(tag).
+		AddUserGroups(group).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	host := source.Item.Create().
+		SetName("host").
+		SetAssetClass(class).
+		SetParent(rack).
+		SetAttributes(map[string]any{"hostname": "host.example"}).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	source.ItemRelation.Create().
+		SetType(itemrelation.TypeHosts).
+		SetSource(rack).
+		SetTarget(host).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+	source.Item.UpdateOneID(host.ID).SetDeletedAt(time.Now()).SetUpdatedBy("b").SaveX(ctx)
+
+	var backup bytes.Buffer
+	if err := Backup(ctx, source, &backup); err != nil {
+		t.Fatalf("failed creating backup: %v", err)
+	}
+
+	target := openBackupTestClient(t, "backup_target")
+	if err := Restore(ctx, target, bytes.NewReader(backup.Bytes())); err != nil {
+		t.Fatalf("failed restoring backup: %v", err)
+	}
+
+	restored := target.Item.Query().WithParent().WithTags().WithUserGroups().AllX(ctx)
+	if len(restored) != 2 {
+		t.Fatalf("expected 2 items including the deleted one, got %d", len(restored))
+	}
+
+	if audits := target.AuditLog.Query().CountX(ctx); audits == 0 || audits != source.AuditLog.Query().CountX(ctx) {
+		t.Errorf("expected audit history to be restored, got %d entries", audits)
+	}
+
+	var again bytes.Buffer
+	if err := Backup(ctx, target, &again); err != nil {
+		t.Fatalf("failed creating backup: %v", err)
+	}
+
+	expected, actual := archiveFiles(t, backup.Bytes()), archiveFiles(t, again.Bytes())
+	if len(expected) != len(actual) {
+		t.Fatalf("expected %d tables, got %d", len(expected), len(actual))
+	}
+	for name, content := range expected {
+		if actual[name] != content {
+			t.Errorf("restored %s differs:\n%s\n%s", name, content, actual[name])
+		}
+	}
+
+	hits, err := Search(ctx, target, "rack")
+	if err != nil || len(hits) != 1 {
+		t.Errorf("expected restored items to be searchable, got %v, %v", hits, err)
+	}
+
+	if err := Restore(ctx, target, bytes.NewReader(backup.Bytes())); err == nil || !strings.Contains(err.Error(), "empty database") {
+		t.Errorf("expected restore into a non-empty database to fail, got %v", err)
+	}
+}
+
+func TestRestoreInvalidReference(t *testing.T) {
+	source := openBackupTestClient(t, "backup_invalid_source")
+	ctx := context.Background()
+
+	class := source.AssetClass.Create().SetName("Server").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	source.Item.Create().SetName("host").SetAssetClass(class).SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+
+	var backup bytes.Buffer
+	if err := Backup(ctx, source, &backup); err != nil {
+		t.Fatalf("failed creating backup: %v", err)
+	}
+
+	// rewrite the archive without the asset classes
+	gz, err := gzip.NewReader(&backup)
+	if err != nil {
+		t.Fatalf("failed reading backup: %v", err)
+	}
+	archive := tar.NewReader(gz)
+
+	var broken bytes.Buffer
+	out := gzip.NewWriter(&broken)
+	writer := tar.NewWriter(out)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed reading backup: %v", err)
+		}
+
+		content, _ := io.ReadAll(archive)
+		if header.Name == backupTableFile("asset_classes") {
+			content = nil
+		}
+		if header.Name == backupManifestName {
+			var manifest BackupManifest
+			if err := json.Unmarshal(content, &manifest); err != nil {
+				t.Fatalf("failed decoding manifest: %v", err)
+			}
+			for i := range manifest.Tables {
+				if manifest.Tables[i].Name == "asset_classes" {
+					manifest.Tables[i].Rows = 0
+				}
+			}
+			content, _ = json.Marshal(manifest)
+		}
+		if err := writeArchiveFile(writer, header.Name, content); err != nil {
+			t.Fatalf("failed writing backup: %v", err)
+		}
+	}
+	_ = writer.Close()
+	_ = out.Close()
+
+	target := openBackupTestClient(t, "backup_invalid_target")
+	err = Restore(ctx, target, &broken)
+	if err == nil || !strings.Contains(err.Error(), "references missing asset_classes") {
+		t.Errorf("expected missing reference to be reported, got %v", err)
+	}
+
+	if count := target.Item.Query().CountX(ctx); count != 0 {
+		t.Errorf("expected failed restore to be rolled back, got %d items", count)
+	}
+}
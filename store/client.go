@@ -3,28 +3,46 @@ package store
 import (
 	"context"
 	"dig-inv/ent"
+	"dig-inv/env"
 	"dig-inv/log"
-	"entgo.io/ent/dialect"
+	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 var Client *ent.Client
 
+// hookInitializer registers the runtime hooks on a newly opened client, the transaction hook has to come first
+var hookInitializer = []func(client *ent.Client){
+	registerTransactionHooks,
+	registerAuditHooks,
+	registerSearchHooks,
+	registerWebhookHooks,
+}
+
 // GetClient @todo check docs if this does connection pooling etc?
 func GetClient() (*ent.Client, error) {
 	if Client != nil {
 		return Client, nil
 	}
 
-	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	client, err := openClient(env.GetStoreDriver(), env.GetStoreDSN())
 	if err != nil {
 		return nil, err
 	}
 
+	RegisterHooks(client)
+
 	Client = client
 	return Client, nil
 }
 
+// RegisterHooks registers all runtime hooks of the store on the given client.
+func RegisterHooks(client *ent.Client) {
+	for _, register := range hookInitializer {
+		register(client)
+	}
+}
+
 func InitializeSchema(ctx context.Context) error {
 	client, err := GetClient()
 	if err != nil {
@@ -37,6 +55,11 @@ func InitializeSchema(ctx context.Context) error {
 		return err
 	}
 
+	if err := InitializeSearchIndex(ctx, client); err != nil {
+		log.S.Errorw("Failed to initialize search index", "error", err)
+		return err
+	}
+
 	log.S.Infow("Schema initialized successfully")
 
 	return nil
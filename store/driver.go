@@ -0,0 +1,78 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"dig-inv/ent"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// openClient opens a client whose driver writes and compares all times in UTC. SQLite stores times as text in the
+// location of the written value and compares them as strings, so without normalizing them the results of time
+// predicates would depend on the timezone of the server.
+func openClient(driverName, dataSourceName string) (*ent.Client, error) {
+	db, err := sql.Open(driverName, dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return ent.NewClient(ent.Driver(&utcDriver{Conn: entsql.Conn{ExecQuerier: utcExecQuerier{db}}, db: db, dialect: driverName})), nil
+}
+
+type utcDriver struct {
+	entsql.Conn
+	db      *sql.DB
+	dialect string
+}
+
+func (d *utcDriver) Dialect() string {
+	return d.dialect
+}
+
+func (d *utcDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+func (d *utcDriver) BeginTx(ctx context.Context, opts *entsql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entsql.Tx{Conn: entsql.Conn{ExecQuerier: utcExecQuerier{tx}}, Tx: tx}, nil
+}
+
+func (d *utcDriver) Close() error {
+	return d.db.Close()
+}
+
+// utcExecQuerier converts the time arguments of all statements to UTC.
+type utcExecQuerier struct {
+	entsql.ExecQuerier
+}
+
+func (e utcExecQuerier) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return e.ExecQuerier.ExecContext(ctx, query, utcArgs(args)...)
+}
+
+func (e utcExecQuerier) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return e.ExecQuerier.QueryContext(ctx, query, utcArgs(args)...)
+}
+
+func utcArgs(args []any) []any {
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case time.Time:
+			args[i] = v.UTC()
+		case *time.Time:
+			if v != nil {
+				args[i] = v.UTC()
+			}
+		}
+	}
+
+	return args
+}
@@ -0,0 +1,305 @@
+package store
+
+import (
+	"cmp"
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/auditlog"
+	"dig-inv/ent/predicate"
+	"dig-inv/ent/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"maps"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// Revision is the state of an entity at a point in time, rebuilt by replaying its audit log.
+type Revision struct {
+	Type string
+	ID   uuid.UUID
+	At   time.Time
+	// Exists is true if the entity was created before the point in time and not hard deleted since.
+	Exists bool
+	// Deleted is true if the entity was hard or soft deleted at the point in time.
+	Deleted bool
+	Fields  map[string]any
+	Edges   map[string][]string
+}
+
+// edges that hold at most one entity, setting them replaces the previous value
+var uniqueEdges = map[string][]string{
+	ent.TypeItem: {"asset_class", "parent"},
+}
+
+// inverseEdge describes an edge that is stored on the other side of a relation,
+// e.g. adding an item to a tag changes the tags of that item.
+type inverseEdge struct {
+	Type  string
+	Field string
+}
+
+var inverseEdges = map[string]map[string]inverseEdge{
+	ent.TypeItem: {
+		"tags":        {Type: ent.TypeTag, Field: "items"},
+		"user_groups": {Type: ent.TypeUserGroup, Field: "items"},
+	},
+}
+
+// RevisionAt rebuilds the entity with the given type and ID as it was at the given point in time.
+func RevisionAt(ctx context.Context, client *ent.Client, entityType string, id uuid.UUID, at time.Time) (*Revision, error) {
+	entries, err := client.AuditLog.Query().
+		Where(
+			auditlog.EntityType(entityType),
+			auditlog.EntityID(id),
+			auditlog.TimestampLTE(at),
+		).
+		Order(auditlog.ByTimestamp(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+
+	inverse, err := inverseEntries(ctx, client, entityType, id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	entries = slices.Concat(entries, inverse)
+	slices.SortStableFunc(entries, func(a, b *ent.AuditLog) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	revision := &Revision{
+		Type:   entityType,
+		ID:     id,
+		At:     at,
+		Fields: make(map[string]any),
+		Edges:  make(map[string][]string),
+	}
+
+	for _, entry := range entries {
+		if entry.EntityType == entityType && entry.EntityID == id {
+			revision.apply(entry)
+			continue
+		}
+
+		revision.applyInverse(entry)
+	}
+
+	revision.Deleted = revision.Deleted || revision.Fields["deleted_at"] != nil
+
+	return revision, nil
+}
+
+// inverseEntries returns the audit entries of related entities that changed one of the inverse edges of the entity.
+// Only the history of the related entities whose entries mention the entity is loaded.
+func inverseEntries(ctx context.Context, client *ent.Client, entityType string, id uuid.UUID, at time.Time) ([]*ent.AuditLog, error) {
+	types := make([]string, 0)
+	for _, inverse := range inverseEdges[entityType] {
+		types = append(types, inverse.Type)
+	}
+
+	if len(types) == 0 {
+		return nil, nil
+	}
+
+	var relatedIds []uuid.UUID
+	err := client.AuditLog.Query().
+		Where(
+			auditlog.EntityTypeIn(types...),
+			auditlog.TimestampLTE(at),
+			changesMention(id),
+		).
+		Unique(true).
+		Select(auditlog.FieldEntityID).
+		Scan(ctx, &relatedIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query related entities: %w", err)
+	}
+
+	if len(relatedIds) == 0 {
+		return nil, nil
+	}
+
+	entries, err := client.AuditLog.Query().
+		Where(
+			auditlog.EntityTypeIn(types...),
+			auditlog.EntityIDIn(relatedIds...),
+			auditlog.TimestampLTE(at),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query related audit log: %w", err)
+	}
+
+	related := make([]*ent.AuditLog, 0)
+	for _, entry := range entries {
+		// deleting the related entity removes it from the edge
+		if entry.Operation == auditlog.OperationDelete {
+			related = append(related, entry)
+			continue
+		}
+
+		for _, change := range entry.Changes {
+			// entries created with the related entity can only add the entity to the edge
+			cleared := change.Cleared && entry.Operation != auditlog.OperationCreate
+			if cleared || slices.Contains(change.Added, id.String()) || slices.Contains(change.Removed, id.String()) {
+				related = append(related, entry)
+				break
+			}
+		}
+	}
+
+	return related, nil
+}
+
+// changesMention matches the audit entries whose changes contain the ID, e.g. as added or removed ID of an edge.
+func changesMention(id uuid.UUID) predicate.AuditLog {
+	return func(s *sql.Selector) {
+		column := s.C(auditlog.FieldChanges)
+		if s.Dialect() == dialect.Postgres {
+			column += "::text"
+		}
+
+		s.Where(sql.Like(column, "%"+id.String()+"%"))
+	}
+}
+
+func (r *Revision) apply(entry *ent.AuditLog) {
+	switch entry.Operation {
+	case auditlog.OperationCreate:
+		r.Exists = true
+	case auditlog.OperationDelete:
+		r.Exists = false
+		r.Deleted = true
+		return
+	case auditlog.OperationReveal:
+		// reading a secret does not change the entity
+		return
+	}
+
+	for _, change := range entry.Changes {
+		if !change.Edge {
+			if change.Cleared {
+				delete(r.Fields, change.Field)
+				continue
+			}
+
+			r.Fields[change.Field] = change.After
+			continue
+		}
+
+		ids := r.Edges[change.Field]
+		if change.Cleared || (len(change.Added) > 0 && slices.Contains(uniqueEdges[r.Type], change.Field)) {
+			ids = nil
+		}
+
+		r.Edges[change.Field] = applyEdgeChange(ids, change.Added, change.Removed)
+	}
+}
+
+func (r *Revision) applyInverse(entry *ent.AuditLog) {
+	for field, inverse := range inverseEdges[r.Type] {
+		if inverse.Type != entry.EntityType {
+			continue
+		}
+
+		related := entry.EntityID.String()
+		ids := r.Edges[field]
+
+		if entry.Operation == auditlog.OperationDelete {
+			r.Edges[field] = applyEdgeChange(ids, nil, []string{related})
+			continue
+		}
+
+		for _, change := range entry.Changes {
+			if change.Field != inverse.Field {
+				continue
+			}
+
+			var added, removed []string
+			if change.Cleared || slices.Contains(change.Removed, r.ID.String()) {
+				removed = []string{related}
+			}
+			if slices.Contains(change.Added, r.ID.String()) {
+				added = []string{related}
+			}
+
+			ids = applyEdgeChange(ids, added, removed)
+		}
+
+		r.Edges[field] = ids
+	}
+}
+
+func applyEdgeChange(ids, added, removed []string) []string {
+	res := slices.DeleteFunc(slices.Clone(ids), func(id string) bool {
+		return slices.Contains(removed, id)
+	})
+
+	for _, id := range added {
+		if !slices.Contains(res, id) {
+			res = append(res, id)
+		}
+	}
+
+	slices.Sort(res)
+	return res
+}
+
+// DiffRevisions returns the fields and edges that differ between two revisions of the same entity.
+func DiffRevisions(from, to *Revision) []schema.AuditChange {
+	changes := make([]schema.AuditChange, 0)
+
+	fields := slices.Sorted(maps.Keys(from.Fields))
+	for field := range maps.Keys(to.Fields) {
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		before, after := from.Fields[field], to.Fields[field]
+		if reflect.DeepEqual(before, after) {
+			continue
+		}
+
+		_, stillSet := to.Fields[field]
+		changes = append(changes, schema.AuditChange{
+			Field:   field,
+			Before:  before,
+			After:   after,
+			Cleared: !stillSet,
+		})
+	}
+
+	edges := slices.Concat(slices.Collect(maps.Keys(from.Edges)), slices.Collect(maps.Keys(to.Edges)))
+	slices.Sort(edges)
+	for _, edge := range slices.Compact(edges) {
+		before, after := from.Edges[edge], to.Edges[edge]
+
+		added := slices.DeleteFunc(slices.Clone(after), func(id string) bool { return slices.Contains(before, id) })
+		removed := slices.DeleteFunc(slices.Clone(before), func(id string) bool { return slices.Contains(after, id) })
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		changes = append(changes, schema.AuditChange{
+			Field:   edge,
+			Edge:    true,
+			Added:   added,
+			Removed: removed,
+		})
+	}
+
+	slices.SortStableFunc(changes, func(a, b schema.AuditChange) int {
+		return cmp.Compare(a.Field, b.Field)
+	})
+
+	return changes
+}
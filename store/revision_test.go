@@ -0,0 +1,132 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRevisionAt(t *testing.T) {
+	client := openTestClient(t, "revision")
+	ctx := context.Background()
+
+	class := client.AssetClass.Create().SetName("Server").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	otherClass := client.AssetClass.Create().SetName("VM").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	tag := client.Tag.Create().SetName("prod").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	beforeCreate := time.Now()
+
+	item := client.Item.Create().
+		SetName("web-1").
+		SetAssetClass(class).
+		AddTags(tag).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		SaveX(ctx)
+	afterCreate := time.Now()
+
+	client.Item.UpdateOneID(item.ID).
+		SetName("web-01").
+		SetAssetClass(otherClass).
+		SetUpdatedBy("bob").
+		SaveX(ctx)
+
+	// changing the relation from the tag side changes the tags of the item as well
+	group := client.UserGroup.Create().
+		SetName("ops").
+		SetOidcScope("ops").
+		AddItemIDs(item.ID).
+		SetCreatedBy("bob").
+		SetUpdatedBy("bob").
+		SaveX(ctx)
+	client.Tag.UpdateOneID(tag.ID).RemoveItemIDs(item.ID).SetUpdatedBy("bob").SaveX(ctx)
+	afterUpdate := time.Now()
+
+	revision, err := RevisionAt(ctx, client, ent.TypeItem, item.ID, beforeCreate)
+	if err != nil {
+		t.Fatalf("failed rebuilding revision: %v", err)
+	}
+	if revision.Exists {
+		t.Error("Expected item to not exist before creation")
+	}
+
+	revision, err = RevisionAt(ctx, client, ent.TypeItem, item.ID, afterCreate)
+	if err != nil {
+		t.Fatalf("failed rebuilding revision: %v", err)
+	}
+	if !revision.Exists || revision.Fields["name"] != "web-1" {
+		t.Errorf("Unexpected revision after creation: %v", revision)
+	}
+	if !slices.Equal(revision.Edges["tags"], []string{tag.ID.String()}) {
+		t.Errorf("Expected tag %s, got %v", tag.ID, revision.Edges["tags"])
+	}
+	if !slices.Equal(revision.Edges["asset_class"], []string{class.ID.String()}) {
+		t.Errorf("Expected asset class %s, got %v", class.ID, revision.Edges["asset_class"])
+	}
+
+	latest, err := RevisionAt(ctx, client, ent.TypeItem, item.ID, afterUpdate)
+	if err != nil {
+		t.Fatalf("failed rebuilding revision: %v", err)
+	}
+	if latest.Fields["name"] != "web-01" {
+		t.Errorf("Expected updated name, got %v", latest.Fields["name"])
+	}
+	if len(latest.Edges["tags"]) != 0 {
+		t.Errorf("Expected no tags, got %v", latest.Edges["tags"])
+	}
+	if !slices.Equal(latest.Edges["user_groups"], []string{group.ID.String()}) {
+		t.Errorf("Expected group %s, got %v", group.ID, latest.Edges["user_groups"])
+	}
+	if !slices.Equal(latest.Edges["asset_class"], []string{otherClass.ID.String()}) {
+		t.Errorf("Expected asset class %s, got %v", otherClass.ID, latest.Edges["asset_class"])
+	}
+
+	changes := DiffRevisions(revision, latest)
+	fields := make([]string, 0, len(changes))
+	for _, change := range changes {
+		fields = append(fields, change.Field)
+	}
+
+	if !slices.Equal(fields, []string{"asset_class", "name", "tags", "user_groups"}) {
+		t.Errorf("Unexpected diff: %v", changes)
+	}
+
+	// only the entries of related entities that mention the item are replayed
+	other := client.Tag.Create().SetName("staging").SetCreatedBy("bob").SetUpdatedBy("bob").SaveX(ctx)
+	client.Tag.UpdateOneID(other.ID).ClearItems().SetUpdatedBy("bob").SaveX(ctx)
+
+	entries, err := inverseEntries(ctx, client, ent.TypeItem, item.ID, time.Now())
+	if err != nil {
+		t.Fatalf("failed querying related entries: %v", err)
+	}
+	if len(entries) != 2 || slices.ContainsFunc(entries, func(entry *ent.AuditLog) bool { return entry.EntityID == other.ID }) {
+		t.Errorf("Expected the entries of the group and the tag, got %v", entries)
+	}
+}
+
+func TestRevisionAtDeleted(t *testing.T) {
+	client := openTestClient(t, "revision")
+	ctx := context.Background()
+
+	tag := client.Tag.Create().SetName("temporary").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	client.Tag.UpdateOneID(tag.ID).SetDeletedAt(time.Now()).SetUpdatedBy("alice").SaveX(ctx)
+
+	revision, err := RevisionAt(ctx, client, ent.TypeTag, tag.ID, time.Now())
+	if err != nil {
+		t.Fatalf("failed rebuilding revision: %v", err)
+	}
+	if !revision.Exists || !revision.Deleted {
+		t.Errorf("Expected soft deleted tag, got %v", revision)
+	}
+
+	client.Tag.DeleteOneID(tag.ID).ExecX(ctx)
+
+	revision, err = RevisionAt(ctx, client, ent.TypeTag, tag.ID, time.Now())
+	if err != nil {
+		t.Fatalf("failed rebuilding revision: %v", err)
+	}
+	if revision.Exists || !revision.Deleted {
+		t.Errorf("Expected hard deleted tag, got %v", revision)
+	}
+}
@@ -0,0 +1,442 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"dig-inv/ent"
+	"dig-inv/ent/assetclass"
+	"dig-inv/ent/dnscheck"
+	"dig-inv/ent/domainlookup"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/tag"
+	"dig-inv/env"
+	"dig-inv/log"
+	"entgo.io/ent/dialect"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// SearchHit is an item matching a search query, a higher rank is a better match.
+type SearchHit struct {
+	ItemID uuid.UUID
+	Rank   float64
+}
+
+type searchMode int
+
+const (
+	searchDisabled searchMode = iota
+	// sqlite built with the sqlite_fts5 tag
+	searchFTS5
+	// sqlite without FTS5 support, matches terms with LIKE
+	searchLike
+	// postgres full-text search
+	searchTsvector
+)
+
+// the index is shared by all clients of the store, it is disabled until InitializeSearchIndex was called
+var currentSearchMode = searchDisabled
+
+const maxSearchHits = 1000
+
+// SearchContributors return additional text that is indexed with an item, e.g. the hostnames, IPs or domain names
+// stored by its provider. They are registered by the services, as the providers depend on the store.
+var SearchContributors []func(ctx context.Context, client *ent.Client, i *ent.Item) ([]string, error)
+
+// searchItemEdges are the edges to the items whose index entries depend on the entities of a type: the names of tags
+// and the provider data the search contributors read.
+var searchItemEdges = map[string]string{
+	ent.TypeTag:          "items",
+	ent.TypeItemRelation: "source",
+	ent.TypeDnsCheck:     "item",
+	ent.TypeDomainLookup: "item",
+}
+
+// InitializeSearchIndex creates the search index for the backend of the store and fills it if it is empty.
+func InitializeSearchIndex(ctx context.Context, client *ent.Client) error {
+	mode, err := createSearchIndex(ctx, client)
+	if err != nil {
+		return err
+	}
+	currentSearchMode = mode
+
+	count, err := searchIndexSize(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return nil
+	}
+
+	return RebuildSearchIndex(ctx, client)
+}
+
+func searchIndexSize(ctx context.Context, client *ent.Client) (int, error) {
+	rows, err := client.QueryContext(ctx, "SELECT COUNT(*) FROM search_index")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count search index entries: %w", err)
+	}
+	defer rows.Close()
+
+	var count int
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, fmt.Errorf("failed to count search index entries: %w", err)
+		}
+	}
+
+	return count, rows.Err()
+}
+
+func createSearchIndex(ctx context.Context, client *ent.Client) (searchMode, error) {
+	if env.GetStoreDriver() == dialect.Postgres {
+		statements := []string{
+			`CREATE TABLE IF NOT EXISTS search_index (
+				item_id TEXT PRIMARY KEY,
+				name TEXT NOT NULL,
+				content TEXT NOT NULL,
+				document TSVECTOR GENERATED ALWAYS AS (
+					setweight(to_tsvector('simple', name), 'A') || setweight(to_tsvector('simple', content), 'B')
+				) STORED
+			)`,
+			"CREATE INDEX IF NOT EXISTS search_index_document ON search_index USING GIN (document)",
+		}
+
+		for _, statement := range statements {
+			if _, err := client.ExecContext(ctx, statement); err != nil {
+				return searchDisabled, fmt.Errorf("failed to create search index: %w", err)
+			}
+		}
+
+		return searchTsvector, nil
+	}
+
+	// an existing index keeps its mode, as creating the virtual table would be skipped for any existing table
+	existing, err := sqliteTableDefinition(ctx, client, "search_index")
+	if err != nil {
+		return searchDisabled, err
+	}
+	if existing != "" {
+		if strings.Contains(strings.ToLower(existing), "using fts5") {
+			return searchFTS5, nil
+		}
+		return searchLike, nil
+	}
+
+	_, err = client.ExecContext(ctx, "CREATE VIRTUAL TABLE search_index USING fts5(item_id UNINDEXED, name, content)")
+	if err == nil {
+		return searchFTS5, nil
+	}
+
+	if !strings.Contains(err.Error(), "no such module") {
+		return searchDisabled, fmt.Errorf("failed to create search index: %w", err)
+	}
+
+	log.S.Warnw("SQLite was built without FTS5 support, falling back to a simple search index. Build with the sqlite_fts5 tag to enable ranked full-text search.")
+
+	_, err = client.ExecContext(ctx, "CREATE TABLE search_index (item_id TEXT PRIMARY KEY, name TEXT NOT NULL, content TEXT NOT NULL)")
+	if err != nil {
+		return searchDisabled, fmt.Errorf("failed to create search index: %w", err)
+	}
+
+	return searchLike, nil
+}
+
+func sqliteTableDefinition(ctx context.Context, client *ent.Client, table string) (string, error) {
+	rows, err := client.QueryContext(ctx, "SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", table)
+	if err != nil {
+		return "", fmt.Errorf("failed to query table definition: %w", err)
+	}
+	defer rows.Close()
+
+	var definition string
+	if rows.Next() {
+		if err := rows.Scan(&definition); err != nil {
+			return "", fmt.Errorf("failed to query table definition: %w", err)
+		}
+	}
+
+	return definition, rows.Err()
+}
+
+// RebuildSearchIndex replaces the search index with the current state of all items.
+func RebuildSearchIndex(ctx context.Context, client *ent.Client) error {
+	if _, err := client.ExecContext(ctx, "DELETE FROM search_index"); err != nil {
+		return fmt.Errorf("failed to clear search index: %w", err)
+	}
+
+	ids, err := client.Item.Query().Where(item.DeletedAtIsNil()).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query items: %w", err)
+	}
+
+	return indexItems(ctx, client, ids)
+}
+
+// indexItems updates the search index entries of the given items, removing items that are deleted.
+func indexItems(ctx context.Context, client *ent.Client, ids []uuid.UUID) error {
+	for _, id := range ids {
+		if _, err := client.ExecContext(ctx, searchStatement("DELETE FROM search_index WHERE item_id = ?"), id.String()); err != nil {
+			return fmt.Errorf("failed to remove item from search index: %w", err)
+		}
+
+		i, err := client.Item.Query().
+			Where(item.ID(id), item.DeletedAtIsNil()).
+			WithTags(func(q *ent.TagQuery) { q.Where(tag.DeletedAtIsNil()) }).
+			WithAssetClass().
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load item for search index: %w", err)
+		}
+
+		content, err := searchContent(ctx, client, i)
+		if err != nil {
+			return err
+		}
+
+		_, err = client.ExecContext(ctx,
+			searchStatement("INSERT INTO search_index (item_id, name, content) VALUES (?, ?, ?)"),
+			id.String(), i.Name, content,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to add item to search index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// searchContent returns the indexed text of an item besides its name.
+func searchContent(ctx context.Context, client *ent.Client, i *ent.Item) (string, error) {
+	parts := []string{i.Description}
+
+	if i.Edges.AssetClass != nil {
+		parts = append(parts, i.Edges.AssetClass.Name)
+	}
+
+	for _, t := range i.Edges.Tags {
+		parts = append(parts, t.Name)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(i.Attributes)) {
+		if value := i.Attributes[key]; value != nil {
+			parts = append(parts, fmt.Sprint(value))
+		}
+	}
+
+	for _, contributor := range SearchContributors {
+		contributed, err := contributor(ctx, client, i)
+		if err != nil {
+			return "", fmt.Errorf("failed to collect search content: %w", err)
+		}
+
+		parts = append(parts, contributed...)
+	}
+
+	return strings.Join(slices.DeleteFunc(parts, func(part string) bool { return part == "" }), " "), nil
+}
+
+// Search returns the items matching all terms of the query, ordered by rank.
+func Search(ctx context.Context, client *ent.Client, query string) ([]SearchHit, error) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+
+	switch currentSearchMode {
+	case searchFTS5:
+		quoted := make([]string, 0, len(terms))
+		for _, term := range terms {
+			// every term is a prefix query, quoting prevents interpreting the input as FTS5 query syntax
+			quoted = append(quoted, `"`+strings.ReplaceAll(term, `"`, `""`)+`"*`)
+		}
+
+		rows, err = client.QueryContext(ctx,
+			"SELECT item_id, -bm25(search_index, 0.0, 10.0, 1.0) AS score FROM search_index WHERE search_index MATCH ? ORDER BY score DESC LIMIT ?",
+			strings.Join(quoted, " "), maxSearchHits,
+		)
+	case searchTsvector:
+		rows, err = client.QueryContext(ctx,
+			`SELECT item_id, ts_rank(document, websearch_to_tsquery('simple', $1)) AS score FROM search_index
+			WHERE document @@ websearch_to_tsquery('simple', $1) ORDER BY score DESC LIMIT $2`,
+			query, maxSearchHits,
+		)
+	case searchLike:
+		conditions := make([]string, 0, len(terms))
+		ranks := make([]string, 0, len(terms))
+		conditionArgs := make([]any, 0, 2*len(terms))
+		rankArgs := make([]any, 0, 2*len(terms))
+		for _, term := range terms {
+			pattern := "%" + likeEscaper.Replace(strings.ToLower(term)) + "%"
+			conditions = append(conditions, `(lower(name) LIKE ? ESCAPE '\' OR lower(content) LIKE ? ESCAPE '\')`)
+			ranks = append(ranks, `(CASE WHEN lower(name) LIKE ? ESCAPE '\' THEN 10 ELSE 0 END) + (CASE WHEN lower(content) LIKE ? ESCAPE '\' THEN 1 ELSE 0 END)`)
+			conditionArgs = append(conditionArgs, pattern, pattern)
+			rankArgs = append(rankArgs, pattern, pattern)
+		}
+
+		rows, err = client.QueryContext(ctx,
+			"SELECT item_id, "+strings.Join(ranks, " + ")+" AS score FROM search_index WHERE "+strings.Join(conditions, " AND ")+" ORDER BY score DESC LIMIT ?",
+			slices.Concat(rankArgs, conditionArgs, []any{maxSearchHits})...,
+		)
+	default:
+		return nil, errors.New("search index is not initialized")
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query search index: %w", err)
+	}
+	defer rows.Close()
+
+	hits := make([]SearchHit, 0)
+	for rows.Next() {
+		var (
+			id   string
+			rank float64
+		)
+		if err := rows.Scan(&id, &rank); err != nil {
+			return nil, fmt.Errorf("failed to read search hit: %w", err)
+		}
+
+		itemUuid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid item ID in search index: %w", err)
+		}
+
+		hits = append(hits, SearchHit{ItemID: itemUuid, Rank: rank})
+	}
+
+	return hits, rows.Err()
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// searchStatement converts the ? placeholders of a statement to the numbered placeholders of postgres.
+func searchStatement(statement string) string {
+	if currentSearchMode != searchTsvector {
+		return statement
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range statement {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func registerSearchHooks(client *ent.Client) {
+	client.Use(searchHook)
+}
+
+// searchHook keeps the search index up to date with the items and the entities whose names are indexed with them.
+func searchHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		_, indexed := searchItemEdges[m.Type()]
+		if currentSearchMode == searchDisabled || !indexed && m.Type() != ent.TypeItem && m.Type() != ent.TypeAssetClass {
+			return next.Mutate(ctx, m)
+		}
+
+		am, ok := m.(auditableMutation)
+		if !ok {
+			return nil, fmt.Errorf("mutation %T can not be indexed", m)
+		}
+
+		var ids []uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			if ids, err = am.IDs(ctx); err != nil {
+				return nil, fmt.Errorf("failed to determine indexed IDs: %w", err)
+			}
+		}
+
+		// items have to be collected before the mutation, as it might remove them from the entity
+		affected, err := searchAffectedItems(ctx, hookClient(ctx, am), m.Type(), ids)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return value, err
+		}
+
+		if m.Type() == ent.TypeItem && m.Op().Is(ent.OpCreate) {
+			id, exists := am.ID()
+			if !exists {
+				return value, fmt.Errorf("created %s has no ID", m.Type())
+			}
+			affected = append(affected, id)
+		}
+
+		if edge, ok := searchItemEdges[m.Type()]; ok {
+			for _, id := range m.AddedIDs(edge) {
+				affected = append(affected, id.(uuid.UUID))
+			}
+		}
+
+		slices.SortFunc(affected, func(a, b uuid.UUID) int { return slices.Compare(a[:], b[:]) })
+		if err := indexItems(ctx, hookClient(ctx, am), slices.Compact(affected)); err != nil {
+			return value, fmt.Errorf("failed to update search index: %w", err)
+		}
+
+		return value, nil
+	})
+}
+
+// searchAffectedItems returns the items whose index entries depend on the entities with the given type and IDs.
+func searchAffectedItems(ctx context.Context, client *ent.Client, entityType string, ids []uuid.UUID) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var (
+		affected []uuid.UUID
+		err      error
+	)
+
+	switch entityType {
+	case ent.TypeItem:
+		// items serving certificates index the certificate items they depend on
+		affected, err = client.Item.Query().
+			Where(item.HasOutgoingRelationsWith(itemrelation.HasTargetWith(item.IDIn(ids...)))).
+			IDs(ctx)
+		affected = append(affected, ids...)
+	case ent.TypeItemRelation:
+		affected, err = client.Item.Query().Where(item.HasOutgoingRelationsWith(itemrelation.IDIn(ids...))).IDs(ctx)
+	case ent.TypeDnsCheck:
+		affected, err = client.DnsCheck.Query().Where(dnscheck.IDIn(ids...)).QueryItem().IDs(ctx)
+	case ent.TypeDomainLookup:
+		affected, err = client.DomainLookup.Query().Where(domainlookup.IDIn(ids...)).QueryItem().IDs(ctx)
+	case ent.TypeTag:
+		affected, err = client.Item.Query().Where(item.HasTagsWith(tag.IDIn(ids...))).IDs(ctx)
+	case ent.TypeAssetClass:
+		affected, err = client.Item.Query().Where(item.HasAssetClassWith(assetclass.IDIn(ids...))).IDs(ctx)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to query indexed items: %w", err)
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSearchIndexHooks(t *testing.T) {
+	client := openTestClient(t, "search")
+	ctx := context.Background()
+
+	if err := InitializeSearchIndex(ctx, client); err != nil {
+		t.Fatalf("failed initializing search index: %v", err)
+	}
+	t.Cleanup(func() {
+		currentSearchMode = searchDisabled
+	})
+
+	class := client.AssetClass.Create().SetName("Appliance").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	tag := client.Tag.Create().SetName("quokka").SetCreatedBy("a").SetUpdatedBy("a").SaveX(ctx)
+	item := client.Item.Create().
+		SetName("firewall").
+		SetAssetClass(class).
+		AddTags(tag).
+		SetCreatedBy("a").
+		SetUpdatedBy("a").
+		SaveX(ctx)
+
+	expectHits := func(query string, expected int) {
+		t.Helper()
+
+		hits, err := Search(ctx, client, query)
+		if err != nil {
+			t.Fatalf("failed searching %q: %v", query, err)
+		}
+
+		if len(hits) != expected {
+			t.Errorf("expected %d hits for %q, got %d", expected, query, len(hits))
+		}
+
+		if expected > 0 && len(hits) > 0 && hits[0].ItemID != item.ID {
+			t.Errorf("unexpected hit for %q: %v", query, hits[0])
+		}
+	}
+
+	expectHits("firewall quokka", 1)
+	expectHits("appliance", 1)
+
+	client.Tag.UpdateOneID(tag.ID).SetName("wombat").SetUpdatedBy("b").SaveX(ctx)
+	expectHits("quokka", 0)
+	expectHits("wombat", 1)
+
+	client.AssetClass.UpdateOneID(class.ID).SetName("Gateway").SetUpdatedBy("b").SaveX(ctx)
+	expectHits("appliance", 0)
+
+	client.Item.UpdateOneID(item.ID).SetDeletedAt(time.Now()).SetUpdatedBy("b").SaveX(ctx)
+	expectHits("firewall", 0)
+
+	if err := RebuildSearchIndex(ctx, client); err != nil {
+		t.Fatalf("failed rebuilding search index: %v", err)
+	}
+	expectHits("firewall", 0)
+}
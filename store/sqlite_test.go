@@ -3,12 +3,23 @@ package store
 import (
 	"context"
 	"dig-inv/ent"
+	"dig-inv/ent/tag"
+	"dig-inv/store/storetest"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// openTestClient opens an in-memory store with the hooks of the inventory registered.
+func openTestClient(t *testing.T, name string) *ent.Client {
+	client := storetest.OpenClient(t, name)
+	RegisterHooks(client)
+
+	return client
+}
+
 func TestSQLite(t *testing.T) {
 	client, err := ent.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
 	if err != nil {
@@ -28,3 +39,34 @@ func TestSQLite(t *testing.T) {
 		t.Fatalf("failed creating schema resources: %v", err)
 	}
 }
+
+func TestOpenClientUTC(t *testing.T) {
+	client, err := openClient(dialect.SQLite, "file:utc?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed closing connection to sqlite: %v", err)
+		}
+	})
+
+	ctx := context.Background()
+	if err := client.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	local := time.Local
+	time.Local = time.FixedZone("UTC+5", 5*60*60)
+	t.Cleanup(func() {
+		time.Local = local
+	})
+
+	client.Tag.Create().SetName("utc").SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+
+	// SQLite compares the stored text, so both times have to be in the same location
+	after := time.Now().Add(time.Second).In(time.FixedZone("UTC-5", -5*60*60))
+	if n := client.Tag.Query().Where(tag.CreatedAtLTE(after)).CountX(ctx); n != 1 {
+		t.Errorf("Expected the tag to be created before %s, got %d tags", after, n)
+	}
+}
@@ -0,0 +1,35 @@
+// Package storetest provides in-memory stores for tests.
+package storetest
+
+import (
+	"context"
+	"dig-inv/ent"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// OpenClient opens an in-memory SQLite database with the schema of the inventory, which is closed when the test ends.
+// Clients opened with the same name share the database. Hooks are not registered, as the store package uses this
+// for its own tests.
+func OpenClient(t testing.TB, name string) *ent.Client {
+	t.Helper()
+
+	client, err := ent.Open(dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := client.Close(); err != nil {
+			t.Errorf("failed closing connection to sqlite: %v", err)
+		}
+	})
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema resources: %v", err)
+	}
+
+	return client
+}
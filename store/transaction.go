@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"fmt"
+	"slices"
+)
+
+func registerTransactionHooks(client *ent.Client) {
+	client.Use(transactionHook)
+}
+
+// transactionHook applies mutations of audited and webhook types that are not part of a transaction in a new one, so
+// the audit entries, search index entries and webhook deliveries written by the other hooks are committed together
+// with the change or not at all. It has to be the outermost hook: the mutation is applied again by the client of the
+// transaction, which runs all hooks within it.
+func transactionHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !slices.Contains(AuditedTypes, m.Type()) && !slices.Contains(WebhookTypes, m.Type()) {
+			return next.Mutate(ctx, m)
+		}
+
+		am, ok := m.(auditableMutation)
+		if !ok {
+			return nil, fmt.Errorf("mutation %T can not be applied in a transaction", m)
+		}
+
+		if _, err := am.Tx(); err == nil || ent.TxFromContext(ctx) != nil {
+			return next.Mutate(ctx, m)
+		}
+
+		tx, err := am.Client().Tx(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start transaction: %w", err)
+		}
+
+		value, err := tx.Client().Mutate(ent.NewTxContext(ctx, tx), m)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit %s: %w", m.Type(), err)
+		}
+
+		return value, nil
+	})
+}
+
+// hookClient returns the client the hooks write with, which is bound to the transaction of the mutation.
+func hookClient(ctx context.Context, m auditableMutation) *ent.Client {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx.Client()
+	}
+
+	return m.Client()
+}
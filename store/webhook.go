@@ -0,0 +1,249 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/attributedefinition"
+	"dig-inv/ent/hook"
+	"dig-inv/ent/item"
+	"dig-inv/ent/itemrelation"
+	"dig-inv/ent/webhook"
+	"dig-inv/ent/webhookdelivery"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// WebhookJobType is the type of the job runs that send a webhook delivery, with the ID of the delivery as
+// `delivery` parameter.
+const WebhookJobType = "webhook_delivery"
+
+// WebhookTypes lists the entity types whose changes are sent to webhooks.
+var WebhookTypes = []string{
+	ent.TypeItem,
+	ent.TypeTag,
+	ent.TypeUserGroup,
+	ent.TypeAssetClass,
+	ent.TypeAttributeDefinition,
+	ent.TypeItemRelation,
+}
+
+// WebhookPayload is the body posted to webhooks.
+type WebhookPayload struct {
+	Event      string    `json:"event"`
+	EntityType string    `json:"entity_type"`
+	EntityID   uuid.UUID `json:"entity_id"`
+	Actor      string    `json:"actor"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// the entity after the change, only set for creates and changes of single entities
+	Data ent.Value `json:"data,omitempty"`
+}
+
+func registerWebhookHooks(client *ent.Client) {
+	client.Use(hook.On(webhookHook, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne))
+}
+
+// webhookHook queues a delivery for every webhook that subscribed to the change. The deliveries are created in the
+// transaction of the change, which is started by the transaction hook if the caller did not start one, so events are
+// only sent for committed changes and a change is rolled back if its deliveries can not be queued.
+func webhookHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !slices.Contains(WebhookTypes, m.Type()) {
+			return next.Mutate(ctx, m)
+		}
+
+		am, ok := m.(auditableMutation)
+		if !ok {
+			return nil, fmt.Errorf("mutation %T can not be sent to webhooks", m)
+		}
+
+		event := webhookEvent(m)
+		webhooks, err := hookClient(ctx, am).Webhook.Query().
+			Where(webhook.Enabled(true), webhook.DeletedAtIsNil()).
+			WithAssetClasses().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query webhooks: %w", err)
+		}
+
+		webhooks = slices.DeleteFunc(webhooks, func(w *ent.Webhook) bool {
+			return (len(w.EntityTypes) > 0 && !slices.Contains(w.EntityTypes, m.Type())) ||
+				(len(w.Events) > 0 && !slices.Contains(w.Events, string(event)))
+		})
+		if len(webhooks) == 0 {
+			return next.Mutate(ctx, m)
+		}
+
+		// deleted entities and their asset classes have to be collected before the mutation
+		var ids []uuid.UUID
+		var classes map[uuid.UUID][]uuid.UUID
+		if !m.Op().Is(ent.OpCreate) {
+			if ids, err = am.IDs(ctx); err != nil {
+				return nil, fmt.Errorf("failed to determine changed IDs: %w", err)
+			}
+
+			if classes, err = webhookAssetClasses(ctx, hookClient(ctx, am), m.Type(), ids); err != nil {
+				return nil, err
+			}
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return value, err
+		}
+
+		var data ent.Value
+		if m.Op().Is(ent.OpCreate | ent.OpUpdateOne) {
+			data = value
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			id, exists := am.ID()
+			if !exists {
+				return value, fmt.Errorf("created %s has no ID", m.Type())
+			}
+			ids = []uuid.UUID{id}
+
+			if classes, err = webhookAssetClasses(ctx, hookClient(ctx, am), m.Type(), ids); err != nil {
+				return value, err
+			}
+		}
+
+		actor := auditActor(m)
+		for _, id := range ids {
+			body, err := json.Marshal(WebhookPayload{
+				Event:      string(event),
+				EntityType: m.Type(),
+				EntityID:   id,
+				Actor:      actor,
+				OccurredAt: time.Now().UTC(),
+				Data:       data,
+			})
+			if err != nil {
+				return value, fmt.Errorf("failed to encode webhook payload: %w", err)
+			}
+
+			for _, w := range webhooks {
+				if !webhookMatchesClass(w, classes[id]) {
+					continue
+				}
+
+				if err := queueWebhookDelivery(ctx, hookClient(ctx, am), w, event, m.Type(), id, string(body), actor); err != nil {
+					return value, err
+				}
+			}
+		}
+
+		return value, nil
+	})
+}
+
+func queueWebhookDelivery(ctx context.Context, client *ent.Client, w *ent.Webhook, event webhookdelivery.Event, entityType string, id uuid.UUID, body, actor string) error {
+	delivery, err := client.WebhookDelivery.Create().
+		SetWebhook(w).
+		SetEvent(event).
+		SetEntityType(entityType).
+		SetEntityID(id).
+		SetPayload(body).
+		SetCreatedBy(actor).
+		SetUpdatedBy(actor).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	err = client.JobRun.Create().
+		SetType(WebhookJobType).
+		SetParameters(map[string]string{"delivery": delivery.ID.String()}).
+		SetCreatedBy(actor).
+		SetUpdatedBy(actor).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// webhookEvent returns the kind of change, soft deletes set the deletion time.
+func webhookEvent(m ent.Mutation) webhookdelivery.Event {
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		return webhookdelivery.EventCreate
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		return webhookdelivery.EventDelete
+	}
+
+	if deletedAt, ok := m.Field("deleted_at"); ok && deletedAt != nil {
+		return webhookdelivery.EventDelete
+	}
+
+	return webhookdelivery.EventUpdate
+}
+
+// webhookMatchesClass reports whether the webhook subscribed to one of the asset classes of an entity. Entities that
+// do not belong to an asset class, e.g. tags, are sent to all webhooks.
+func webhookMatchesClass(w *ent.Webhook, classes []uuid.UUID) bool {
+	if len(w.Edges.AssetClasses) == 0 || len(classes) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(w.Edges.AssetClasses, func(class *ent.AssetClass) bool {
+		return slices.Contains(classes, class.ID)
+	})
+}
+
+// webhookAssetClasses returns the asset classes the entities belong to. Relations belong to the classes of both items.
+func webhookAssetClasses(ctx context.Context, client *ent.Client, entityType string, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	classes := make(map[uuid.UUID][]uuid.UUID, len(ids))
+
+	switch entityType {
+	case ent.TypeAssetClass:
+		for _, id := range ids {
+			classes[id] = []uuid.UUID{id}
+		}
+	case ent.TypeItem:
+		items, err := client.Item.Query().Where(item.IDIn(ids...)).WithAssetClass().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query asset classes of items: %w", err)
+		}
+
+		for _, i := range items {
+			if i.Edges.AssetClass != nil {
+				classes[i.ID] = []uuid.UUID{i.Edges.AssetClass.ID}
+			}
+		}
+	case ent.TypeAttributeDefinition:
+		definitions, err := client.AttributeDefinition.Query().Where(attributedefinition.IDIn(ids...)).WithAssetClass().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query asset classes of attribute definitions: %w", err)
+		}
+
+		for _, def := range definitions {
+			if def.Edges.AssetClass != nil {
+				classes[def.ID] = []uuid.UUID{def.Edges.AssetClass.ID}
+			}
+		}
+	case ent.TypeItemRelation:
+		relations, err := client.ItemRelation.Query().
+			Where(itemrelation.IDIn(ids...)).
+			WithSource(func(q *ent.ItemQuery) { q.WithAssetClass() }).
+			WithTarget(func(q *ent.ItemQuery) { q.WithAssetClass() }).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query asset classes of relations: %w", err)
+		}
+
+		for _, relation := range relations {
+			for _, i := range []*ent.Item{relation.Edges.Source, relation.Edges.Target} {
+				if i != nil && i.Edges.AssetClass != nil {
+					classes[relation.ID] = append(classes[relation.ID], i.Edges.AssetClass.ID)
+				}
+			}
+		}
+	}
+
+	return classes, nil
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/hook"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/tag"
+	"dig-inv/ent/webhookdelivery"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestWebhookHook(t *testing.T) {
+	client := openTestClient(t, "webhook")
+	ctx := context.Background()
+
+	servers := client.AssetClass.Create().SetName("Server").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+	domains := client.AssetClass.Create().SetName("Domain").SetCreatedBy("alice").SetUpdatedBy("alice").SaveX(ctx)
+
+	// changes before the webhooks exist are not sent
+	if count := client.WebhookDelivery.Query().CountX(ctx); count != 0 {
+		t.Fatalf("Expected no deliveries without webhooks, got %d", count)
+	}
+
+	all := client.Webhook.Create().
+		SetName("All").
+		SetURL("https://example.com/all").
+		SetSecret("s1").
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		SaveX(ctx)
+	filtered := client.Webhook.Create().
+		SetName("Deleted servers").
+		SetURL("https://example.com/servers").
+		SetSecret("s2").
+		SetEntityTypes([]string{ent.TypeItem}).
+		SetEvents([]string{"delete"}).
+		AddAssetClasses(servers).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		SaveX(ctx)
+	client.Webhook.Create().
+		SetName("Disabled").
+		SetURL("https://example.com/disabled").
+		SetSecret("s3").
+		SetEnabled(false).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		ExecX(ctx)
+
+	server := client.Item.Create().SetName("web-1").SetAssetClass(servers).SetCreatedBy("bob").SetUpdatedBy("bob").SaveX(ctx)
+	domain := client.Item.Create().SetName("example.com").SetAssetClass(domains).SetCreatedBy("bob").SetUpdatedBy("bob").SaveX(ctx)
+	client.Item.UpdateOne(server).SetDeletedAt(time.Now()).SetUpdatedBy("carol").ExecX(ctx)
+	client.Item.UpdateOne(domain).SetDeletedAt(time.Now()).SetUpdatedBy("carol").ExecX(ctx)
+	client.Tag.Create().SetName("critical").SetCreatedBy("bob").SetUpdatedBy("bob").ExecX(ctx)
+
+	deliveries := client.WebhookDelivery.Query().
+		WithWebhook().
+		Order(webhookdelivery.ByCreatedAt(), webhookdelivery.ByID()).
+		AllX(ctx)
+
+	counts := map[string]int{}
+	for _, delivery := range deliveries {
+		counts[delivery.Edges.Webhook.Name]++
+	}
+
+	if counts[all.Name] != 5 || counts[filtered.Name] != 1 || len(deliveries) != 6 {
+		t.Fatalf("Unexpected deliveries per webhook: %v", counts)
+	}
+
+	for _, delivery := range deliveries {
+		if delivery.Edges.Webhook.ID != filtered.ID {
+			continue
+		}
+
+		if delivery.Event != webhookdelivery.EventDelete || delivery.EntityID != server.ID {
+			t.Errorf("Expected deletion of the server to be sent, got %v", delivery)
+		}
+
+		var payload WebhookPayload
+		if err := json.Unmarshal([]byte(delivery.Payload), &payload); err != nil {
+			t.Fatalf("Failed to decode payload: %v", err)
+		}
+
+		if payload.Actor != "carol" || payload.EntityType != ent.TypeItem || payload.Data == nil {
+			t.Errorf("Unexpected payload: %s", delivery.Payload)
+		}
+	}
+
+	runs := client.JobRun.Query().Where(jobrun.Type(WebhookJobType)).CountX(ctx)
+	if runs != len(deliveries) {
+		t.Errorf("Expected a queued run per delivery, got %d runs for %d deliveries", runs, len(deliveries))
+	}
+
+	// changes that are rolled back are not sent
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatalf("Failed to start transaction: %v", err)
+	}
+	tx.Tag.Create().SetName("rolled back").SetCreatedBy("bob").SetUpdatedBy("bob").ExecX(ctx)
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Failed to roll back: %v", err)
+	}
+
+	if count := client.WebhookDelivery.Query().CountX(ctx); count != len(deliveries) {
+		t.Errorf("Expected rolled back change not to be sent, got %d deliveries", count)
+	}
+	// changes whose deliveries can not be queued are not committed
+	client.JobRun.Use(hook.Reject(ent.OpCreate))
+	if _, err := client.Tag.Create().SetName("unsent").SetCreatedBy("bob").SetUpdatedBy("bob").Save(ctx); err == nil {
+		t.Fatal("Expected the change to fail with its delivery")
+	}
+
+	if exists := client.Tag.Query().Where(tag.Name("unsent")).ExistX(ctx); exists {
+		t.Error("Expected the change to be rolled back")
+	}
+}
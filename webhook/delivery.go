@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"dig-inv/ent"
+	"dig-inv/ent/webhookdelivery"
+	"dig-inv/store"
+	"dig-inv/worker"
+	"encoding/hex"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net/http"
+	"time"
+)
+
+// JobType is the type of the job runs that send webhook deliveries. The runs are queued by the store for every change
+// a webhook subscribed to.
+const JobType = store.WebhookJobType
+
+// MaxAttempts is the number of requests sent for a delivery before it is given up.
+const MaxAttempts = 6
+
+const sendTimeout = 30 * time.Second
+
+// retryBackoff is the delay of the first retry, which doubles with every further attempt
+var retryBackoff = time.Minute
+
+// Sign returns the signature of a request body, as sent in the X-Signature-256 header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Redeliver queues another attempt of a delivery. Manual attempts are not retried automatically.
+func Redeliver(ctx context.Context, client *ent.Client, delivery *ent.WebhookDelivery, user string) (*ent.WebhookDelivery, error) {
+	delivery, err := client.WebhookDelivery.UpdateOne(delivery).
+		SetStatus(webhookdelivery.StatusPending).
+		ClearNextAttemptAt().
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update delivery: %w", err)
+	}
+
+	_, err = worker.Enqueue(ctx, client, JobType, map[string]string{"delivery": delivery.ID.String(), "manual": "true"}, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+// RunDeliveryJob is the worker handler of webhook deliveries. Failed attempts are retried by queueing another run
+// with exponential backoff, until MaxAttempts requests were sent.
+func RunDeliveryJob(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+	id, err := uuid.Parse(run.Parameters["delivery"])
+	if err != nil {
+		return "", fmt.Errorf("invalid delivery: %w", err)
+	}
+
+	delivery, err := client.WebhookDelivery.Query().
+		Where(webhookdelivery.ID(id)).
+		WithWebhook().
+		Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query delivery: %w", err)
+	}
+
+	if delivery.Status == webhookdelivery.StatusSucceeded {
+		return "already delivered", nil
+	}
+
+	w := delivery.Edges.Webhook
+	if w.DeletedAt != nil {
+		err := client.WebhookDelivery.UpdateOne(delivery).
+			SetStatus(webhookdelivery.StatusFailed).
+			SetError("webhook was deleted").
+			ClearNextAttemptAt().
+			SetUpdatedBy(worker.Subject).
+			Exec(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to update delivery: %w", err)
+		}
+
+		return "", fmt.Errorf("webhook %s was deleted", w.ID)
+	}
+
+	code, sendErr := send(ctx, w, delivery)
+	attempts := delivery.Attempts + 1
+	update := client.WebhookDelivery.UpdateOne(delivery).
+		SetAttempts(attempts).
+		SetResponseStatus(code).
+		SetUpdatedBy(worker.Subject)
+
+	if sendErr == nil {
+		err := update.
+			SetStatus(webhookdelivery.StatusSucceeded).
+			SetDeliveredAt(time.Now()).
+			ClearNextAttemptAt().
+			ClearError().
+			Exec(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to update delivery: %w", err)
+		}
+
+		return fmt.Sprintf("delivered to %s with status %d", w.Name, code), nil
+	}
+
+	if run.Parameters["manual"] != "true" && attempts < MaxAttempts {
+		next := time.Now().Add(retryBackoff << (attempts - 1))
+		if err := update.SetNextAttemptAt(next).SetError(sendErr.Error()).Exec(ctx); err != nil {
+			return "", fmt.Errorf("failed to update delivery: %w", err)
+		}
+
+		if _, err := worker.EnqueueAfter(ctx, client, JobType, map[string]string{"delivery": delivery.ID.String()}, worker.Subject, next); err != nil {
+			return "", err
+		}
+
+		worker.Logf(ctx, "Attempt %d of %d failed, retrying at %s", attempts, MaxAttempts, next.UTC().Format(time.RFC3339))
+	} else {
+		err := update.
+			SetStatus(webhookdelivery.StatusFailed).
+			ClearNextAttemptAt().
+			SetError(sendErr.Error()).
+			Exec(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to update delivery: %w", err)
+		}
+	}
+
+	return "", fmt.Errorf("attempt %d failed: %w", attempts, sendErr)
+}
+
+// send posts the payload of the delivery and returns the response status, 0 if the webhook could not be reached.
+func send(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event", string(delivery.Event))
+	req.Header.Set("X-Delivery", delivery.ID.String())
+	req.Header.Set("X-Signature-256", Sign(w.Secret, body))
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to call webhook: %w", err)
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return res.StatusCode, fmt.Errorf("webhook responded with %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/webhookdelivery"
+	"dig-inv/store"
+	"dig-inv/store/storetest"
+	"dig-inv/worker"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func openWebhookTestClient(t *testing.T) *ent.Client {
+	client := storetest.OpenClient(t, "webhook")
+	store.RegisterHooks(client)
+
+	return client
+}
+
+// receiver records the requests to a webhook and fails them while failing is set.
+type receiver struct {
+	mu       sync.Mutex
+	failing  bool
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	if r.failing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+func TestRunDeliveryJob(t *testing.T) {
+	client := openWebhookTestClient(t)
+	ctx := context.Background()
+
+	retryBackoff = 0
+	t.Cleanup(func() {
+		retryBackoff = time.Minute
+	})
+
+	rec := &receiver{failing: true}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	client.Webhook.Create().
+		SetName("CMDB").
+		SetURL(server.URL).
+		SetSecret("top-secret").
+		SetEntityTypes([]string{ent.TypeTag}).
+		SetCreatedBy("alice").
+		SetUpdatedBy("alice").
+		ExecX(ctx)
+
+	client.Tag.Create().SetName("critical").SetCreatedBy("bob").SetUpdatedBy("bob").ExecX(ctx)
+
+	w := worker.New(map[string]worker.Handler{JobType: RunDeliveryJob})
+	executed, err := w.RunPending(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	if executed != MaxAttempts || len(rec.requests) != MaxAttempts {
+		t.Fatalf("Expected %d attempts, got %d runs and %d requests", MaxAttempts, executed, len(rec.requests))
+	}
+
+	delivery := client.WebhookDelivery.Query().OnlyX(ctx)
+	if delivery.Status != webhookdelivery.StatusFailed || delivery.Attempts != MaxAttempts || delivery.ResponseStatus != http.StatusServiceUnavailable {
+		t.Errorf("Expected delivery to be given up, got %v", delivery)
+	}
+
+	retries := client.JobRun.Query().Where(jobrun.Type(JobType), jobrun.RunAfterNotNil()).CountX(ctx)
+	if retries != MaxAttempts-1 {
+		t.Errorf("Expected %d retries, got %d", MaxAttempts-1, retries)
+	}
+
+	req := rec.requests[0]
+	if req.Header.Get("X-Signature-256") != Sign("top-secret", rec.bodies[0]) || req.Header.Get("X-Event") != "create" || req.Header.Get("X-Delivery") != delivery.ID.String() {
+		t.Errorf("Unexpected headers: %v", req.Header)
+	}
+
+	rec.failing = false
+	if _, err := Redeliver(ctx, client, delivery, "alice"); err != nil {
+		t.Fatalf("Failed to redeliver: %v", err)
+	}
+
+	if _, err := w.RunPending(ctx, client); err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusSucceeded || delivery.DeliveredAt == nil || delivery.Error != "" {
+		t.Errorf("Expected redelivery to succeed, got %v", delivery)
+	}
+
+	if string(rec.bodies[len(rec.bodies)-1]) != string(rec.bodies[0]) {
+		t.Errorf("Expected redelivery to send the same payload")
+	}
+}
+
+func TestRunDeliveryJob_Backoff(t *testing.T) {
+	client := openWebhookTestClient(t)
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client.Webhook.Create().SetName("Broken").SetURL(server.URL).SetSecret("s").SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+	client.Tag.Create().SetName("backoff").SetCreatedBy("a").SetUpdatedBy("a").ExecX(ctx)
+
+	w := worker.New(map[string]worker.Handler{JobType: RunDeliveryJob})
+	executed, err := w.RunPending(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	// the retry is not due yet
+	if executed != 1 {
+		t.Errorf("Expected one attempt, got %d", executed)
+	}
+
+	delivery := client.WebhookDelivery.Query().OnlyX(ctx)
+	if delivery.Status != webhookdelivery.StatusPending || delivery.NextAttemptAt == nil || time.Until(*delivery.NextAttemptAt) < 50*time.Second {
+		t.Errorf("Expected retry in a minute, got %v", delivery)
+	}
+}
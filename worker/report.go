@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/log"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLines is the number of lines of the job log that are kept with a run.
+const LogLines = 100
+
+type reporterKey struct{}
+
+// reporter records the progress and log of the run a handler executes.
+type reporter struct {
+	client *ent.Client
+	run    *ent.JobRun
+	mu     sync.Mutex
+	lines  []string
+}
+
+// ReportProgress records the progress of the running job in percent. It does nothing outside of job handlers.
+func ReportProgress(ctx context.Context, progress int) {
+	r, ok := ctx.Value(reporterKey{}).(*reporter)
+	if !ok {
+		return
+	}
+
+	progress = min(max(progress, 0), 100)
+	if err := r.client.JobRun.UpdateOneID(r.run.ID).SetProgress(progress).SetUpdatedBy(Subject).Exec(ctx); err != nil {
+		log.S.Errorw("Failed to record job progress", "id", r.run.ID, "error", err)
+	}
+}
+
+// Logf adds a line to the log of the running job, which keeps the last LogLines lines. Outside of job handlers the
+// line is only written to the worker log.
+func Logf(ctx context.Context, format string, args ...any) {
+	line := fmt.Sprintf(format, args...)
+
+	r, ok := ctx.Value(reporterKey{}).(*reporter)
+	if !ok {
+		log.S.Info(line)
+		return
+	}
+
+	log.S.Infow(line, "id", r.run.ID, "type", r.run.Type)
+
+	r.mu.Lock()
+	r.lines = append(r.lines, time.Now().UTC().Format(time.RFC3339)+" "+line)
+	if len(r.lines) > LogLines {
+		r.lines = r.lines[len(r.lines)-LogLines:]
+	}
+	excerpt := strings.Join(r.lines, "\n")
+	r.mu.Unlock()
+
+	if err := r.client.JobRun.UpdateOneID(r.run.ID).SetLog(excerpt).SetUpdatedBy(Subject).Exec(ctx); err != nil {
+		log.S.Errorw("Failed to record job log", "id", r.run.ID, "error", err)
+	}
+}
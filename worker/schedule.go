@@ -0,0 +1,148 @@
+package worker
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/schedule"
+	"dig-inv/log"
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"math/rand/v2"
+	"time"
+)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ParseCron validates a cron expression and the timezone it is evaluated in.
+func ParseCron(expression, timezone string) (cron.Schedule, *time.Location, error) {
+	spec, err := cronParser.Parse(expression)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid cron expression %q: %w", expression, err)
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+
+	return spec, location, nil
+}
+
+// NextRun returns the time after the given one when the schedule has to queue its next run, delayed by a random
+// jitter.
+func NextRun(s *ent.Schedule, after time.Time) (time.Time, error) {
+	spec, location, err := ParseCron(s.Cron, s.Timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := spec.Next(after.In(location))
+	if s.Jitter > 0 {
+		next = next.Add(time.Duration(rand.Int64N(int64(s.Jitter)+1)) * time.Second)
+	}
+
+	return next.UTC(), nil
+}
+
+// EnqueueScheduled queues a run of the schedule and records it as the last run.
+func EnqueueScheduled(ctx context.Context, client *ent.Client, s *ent.Schedule, user string) (*ent.JobRun, error) {
+	run, err := client.JobRun.Create().
+		SetType(s.JobType).
+		SetParameters(s.Parameters).
+		SetSchedule(s).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue %s job of schedule %s: %w", s.JobType, s.Name, err)
+	}
+
+	if err := client.Schedule.UpdateOneID(s.ID).SetLastRunAt(run.CreatedAt).SetUpdatedBy(user).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to update schedule %s: %w", s.Name, err)
+	}
+
+	log.S.Debugw("Queued scheduled job", "id", run.ID, "schedule", s.Name, "type", s.JobType)
+
+	return run, nil
+}
+
+// RunSchedules queues a run of every due schedule and returns the number of queued runs.
+func (w *Worker) RunSchedules(ctx context.Context, client *ent.Client) (int, error) {
+	now := time.Now().UTC()
+
+	due, err := client.Schedule.Query().
+		Where(
+			schedule.PausedEQ(false),
+			schedule.DeletedAtIsNil(),
+			schedule.Or(schedule.NextRunAtIsNil(), schedule.NextRunAtLTE(now)),
+		).
+		Order(schedule.ByNextRunAt(), schedule.ByID()).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query due schedules: %w", err)
+	}
+
+	count := 0
+	for _, s := range due {
+		queued, err := w.runSchedule(ctx, client, s, now)
+		if err != nil {
+			log.S.Errorw("Failed to run schedule", "schedule", s.Name, "error", err)
+			continue
+		}
+
+		if queued {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// runSchedule moves the next run time of a due schedule forward and queues a run. The next run time is only changed
+// if no other worker changed it in the meantime, so a run is only queued by one of them. Schedules without a next run
+// time are initialized without queueing a run.
+func (w *Worker) runSchedule(ctx context.Context, client *ent.Client, s *ent.Schedule, now time.Time) (bool, error) {
+	next, err := NextRun(s, now)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	claim := tx.Schedule.Update().Where(schedule.ID(s.ID), schedule.PausedEQ(false))
+	if s.NextRunAt == nil {
+		claim = claim.Where(schedule.NextRunAtIsNil())
+	} else {
+		claim = claim.Where(schedule.NextRunAt(*s.NextRunAt))
+	}
+
+	claimed, err := claim.SetNextRunAt(next).SetUpdatedBy(Subject).Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return false, fmt.Errorf("failed to claim schedule: %w", err)
+	}
+
+	if claimed == 0 || s.NextRunAt == nil {
+		return false, tx.Commit()
+	}
+
+	if _, err := EnqueueScheduled(ctx, tx.Client(), s, Subject); err != nil {
+		_ = tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit scheduled run: %w", err)
+	}
+
+	log.S.Infow("Queued scheduled run", "schedule", s.Name, "next", next)
+
+	return true, nil
+}
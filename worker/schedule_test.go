@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"dig-inv/ent/jobrun"
+	"dig-inv/ent/schedule"
+	"dig-inv/store/storetest"
+	"testing"
+	"time"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		expression string
+		timezone   string
+		valid      bool
+	}{
+		{"0 3 * * *", "", true},
+		{"*/15 * * * 1-5", "Europe/Berlin", true},
+		{"@daily", "America/New_York", true},
+		{"0 3 * *", "UTC", false},
+		{"0 0 3 * * *", "UTC", false},
+		{"0 3 * * *", "Mars/Olympus", false},
+	}
+
+	for _, test := range tests {
+		_, _, err := ParseCron(test.expression, test.timezone)
+		if (err == nil) != test.valid {
+			t.Errorf("ParseCron(%q, %q) returned %v", test.expression, test.timezone, err)
+		}
+	}
+}
+
+func TestNextRun(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	s := client.Schedule.Create().
+		SetName("nightly").
+		SetJobType("purge").
+		SetCron("0 3 * * *").
+		SetTimezone("Europe/Berlin").
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+
+	after := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	next, err := NextRun(s, after)
+	if err != nil {
+		t.Fatalf("Failed to calculate next run: %v", err)
+	}
+
+	// 3:00 in Berlin is 1:00 UTC during daylight saving time
+	if expected := time.Date(2026, 7, 2, 1, 0, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("Expected next run at %s, got %s", expected, next)
+	}
+
+	s.Jitter = 600
+	for range 20 {
+		next, err := NextRun(s, after)
+		if err != nil {
+			t.Fatalf("Failed to calculate next run: %v", err)
+		}
+
+		expected := time.Date(2026, 7, 2, 1, 0, 0, 0, time.UTC)
+		if next.Before(expected) || next.After(expected.Add(10*time.Minute)) {
+			t.Errorf("Expected next run with jitter within 10 minutes after %s, got %s", expected, next)
+		}
+	}
+}
+
+func TestWorker_RunSchedules(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	w := New(map[string]Handler{})
+
+	due := client.Schedule.Create().
+		SetName("due").
+		SetJobType("purge").
+		SetParameters(map[string]string{"days": "30"}).
+		SetCron("@hourly").
+		SetNextRunAt(time.Now().Add(-time.Minute).UTC()).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	uninitialized := client.Schedule.Create().
+		SetName("uninitialized").
+		SetJobType("purge").
+		SetCron("@hourly").
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+	client.Schedule.Create().
+		SetName("paused").
+		SetJobType("purge").
+		SetCron("@hourly").
+		SetPaused(true).
+		SetNextRunAt(time.Now().Add(-time.Minute).UTC()).
+		SetCreatedBy("tester").
+		SetUpdatedBy("tester").
+		SaveX(ctx)
+
+	stale := client.Schedule.GetX(ctx, due.ID)
+
+	count, err := w.RunSchedules(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to run schedules: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("Expected 1 queued run, got %d", count)
+	}
+
+	runs := client.JobRun.Query().Where(jobrun.HasScheduleWith(schedule.ID(due.ID))).AllX(ctx)
+	if len(runs) != 1 || runs[0].Type != "purge" || runs[0].Parameters["days"] != "30" || runs[0].Status != jobrun.StatusQueued {
+		t.Errorf("Unexpected scheduled runs: %v", runs)
+	}
+
+	due = client.Schedule.GetX(ctx, due.ID)
+	if due.NextRunAt == nil || !due.NextRunAt.After(time.Now()) || due.LastRunAt == nil {
+		t.Errorf("Expected next run to be moved forward, got %v", due)
+	}
+
+	uninitialized = client.Schedule.GetX(ctx, uninitialized.ID)
+	if uninitialized.NextRunAt == nil || uninitialized.LastRunAt != nil {
+		t.Errorf("Expected next run to be initialized without a run, got %v", uninitialized)
+	}
+
+	// runs are only queued once, even if another worker saw the schedule as due
+	queued, err := New(map[string]Handler{}).runSchedule(ctx, client, stale, time.Now())
+	if err != nil {
+		t.Fatalf("Failed to run schedule: %v", err)
+	}
+
+	if queued {
+		t.Error("Expected stale schedule not to queue another run")
+	}
+
+	if count, err := w.RunSchedules(ctx, client); err != nil || count != 0 {
+		t.Errorf("Expected no due schedules, got %d, %v", count, err)
+	}
+}
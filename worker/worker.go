@@ -0,0 +1,284 @@
+package worker
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/jobrun"
+	"dig-inv/env"
+	"dig-inv/log"
+	"dig-inv/store"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// Subject is recorded as the updating user of the job runs changed by a worker.
+const Subject = "worker"
+
+// Handler executes a job run and returns its result, e.g. the name of the file it wrote.
+type Handler func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error)
+
+// Task is executed on every poll of the worker, independent of queued jobs, e.g. to remove expired files.
+type Task func(ctx context.Context, client *ent.Client) error
+
+type Worker struct {
+	handlers     map[string]Handler
+	housekeeping []Task
+	interval     time.Duration
+	lease        time.Duration
+}
+
+// Enqueue queues a job run of the given type, which is executed by the next available worker.
+func Enqueue(ctx context.Context, client *ent.Client, jobType string, parameters map[string]string, user string) (*ent.JobRun, error) {
+	return enqueue(ctx, client.JobRun.Create(), jobType, parameters, user)
+}
+
+// EnqueueAfter queues a job run that is not executed before the given time, e.g. to retry a failed attempt later.
+func EnqueueAfter(ctx context.Context, client *ent.Client, jobType string, parameters map[string]string, user string, after time.Time) (*ent.JobRun, error) {
+	return enqueue(ctx, client.JobRun.Create().SetRunAfter(after), jobType, parameters, user)
+}
+
+func enqueue(ctx context.Context, create *ent.JobRunCreate, jobType string, parameters map[string]string, user string) (*ent.JobRun, error) {
+	run, err := create.
+		SetType(jobType).
+		SetParameters(parameters).
+		SetCreatedBy(user).
+		SetUpdatedBy(user).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue %s job: %w", jobType, err)
+	}
+
+	log.S.Debugw("Queued job", "id", run.ID, "type", jobType)
+
+	return run, nil
+}
+
+// Run queues the runs of due schedules and polls for queued jobs until the context is cancelled.
+func (w *Worker) Run(ctx context.Context) error {
+	if err := store.InitializeSchema(ctx); err != nil {
+		return fmt.Errorf("failed to initialize schema: %w", err)
+	}
+
+	client, err := store.GetClient()
+	if err != nil {
+		return fmt.Errorf("failed to get store client: %w", err)
+	}
+
+	log.S.Infow("Starting worker", "jobTypes", slices.Sorted(maps.Keys(w.handlers)), "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, task := range w.housekeeping {
+			if err := task(ctx, client); err != nil {
+				log.S.Errorw("Failed to run housekeeping task", "error", err)
+			}
+		}
+
+		if _, err := w.RunSchedules(ctx, client); err != nil {
+			log.S.Errorw("Failed to run schedules", "error", err)
+		}
+
+		if _, err := w.RunPending(ctx, client); err != nil {
+			log.S.Errorw("Failed to run queued jobs", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.S.Info("Stopping worker")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunPending fails the runs of stopped workers and executes queued jobs until none are left. It returns the number of
+// executed jobs.
+func (w *Worker) RunPending(ctx context.Context, client *ent.Client) (int, error) {
+	if err := w.failExpired(ctx, client); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for ctx.Err() == nil {
+		run, err := w.claim(ctx, client)
+		if err != nil {
+			return count, err
+		}
+
+		if run == nil {
+			break
+		}
+
+		w.execute(ctx, client, run)
+		count++
+	}
+
+	return count, nil
+}
+
+// claim marks the oldest queued job that is due as running. The status is only changed if the job is still queued, so a job
+// that is claimed by several workers at the same time is only executed by one of them.
+func (w *Worker) claim(ctx context.Context, client *ent.Client) (*ent.JobRun, error) {
+	for {
+		run, err := client.JobRun.Query().
+			Where(
+				jobrun.StatusEQ(jobrun.StatusQueued),
+				jobrun.TypeIn(slices.Collect(maps.Keys(w.handlers))...),
+				jobrun.Or(jobrun.RunAfterIsNil(), jobrun.RunAfterLTE(time.Now())),
+				jobrun.DeletedAtIsNil(),
+			).
+			Order(jobrun.ByCreatedAt(), jobrun.ByID()).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query queued jobs: %w", err)
+		}
+
+		claimed, err := client.JobRun.Update().
+			Where(jobrun.ID(run.ID), jobrun.StatusEQ(jobrun.StatusQueued)).
+			SetStatus(jobrun.StatusRunning).
+			SetStartedAt(time.Now()).
+			SetHeartbeatAt(time.Now()).
+			SetUpdatedBy(Subject).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim job %s: %w", run.ID, err)
+		}
+
+		if claimed == 1 {
+			return client.JobRun.Get(ctx, run.ID)
+		}
+
+		log.S.Debugw("Job was claimed by another worker", "id", run.ID)
+	}
+}
+
+// failExpired fails the running jobs whose worker did not send a heartbeat within the lease, e.g. because it crashed.
+// They are not queued again, as their handlers might have had side effects, but they can be retried.
+func (w *Worker) failExpired(ctx context.Context, client *ent.Client) error {
+	expired := time.Now().Add(-w.lease)
+	failed, err := client.JobRun.Update().
+		Where(
+			jobrun.StatusEQ(jobrun.StatusRunning),
+			jobrun.Or(
+				jobrun.HeartbeatAtLT(expired),
+				jobrun.And(jobrun.HeartbeatAtIsNil(), jobrun.StartedAtLT(expired)),
+			),
+		).
+		SetStatus(jobrun.StatusFailed).
+		SetError("the worker stopped while running the job").
+		SetFinishedAt(time.Now()).
+		SetUpdatedBy(Subject).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fail expired jobs: %w", err)
+	}
+
+	if failed > 0 {
+		log.S.Warnw("Failed jobs of stopped workers", "count", failed)
+	}
+
+	return nil
+}
+
+func (w *Worker) execute(ctx context.Context, client *ent.Client, run *ent.JobRun) {
+	log.S.Infow("Running job", "id", run.ID, "type", run.Type)
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	canceled := make(chan struct{})
+	done := make(chan struct{})
+	go w.watchCancellation(runCtx, client, run, cancel, canceled, done)
+
+	result, err := w.handle(context.WithValue(runCtx, reporterKey{}, &reporter{client: client, run: run}), client, run)
+	close(done)
+
+	// runs that were failed by another worker as their lease expired keep their status
+	update := client.JobRun.Update().
+		Where(jobrun.ID(run.ID), jobrun.StatusEQ(jobrun.StatusRunning)).
+		SetFinishedAt(time.Now()).
+		SetUpdatedBy(Subject)
+	select {
+	case <-canceled:
+		log.S.Infow("Job canceled", "id", run.ID, "type", run.Type)
+		update = update.SetStatus(jobrun.StatusCanceled).SetError("canceled by user")
+	default:
+		if err != nil {
+			log.S.Errorw("Job failed", "id", run.ID, "type", run.Type, "error", err)
+			update = update.SetStatus(jobrun.StatusFailed).SetError(err.Error())
+		} else {
+			log.S.Infow("Job succeeded", "id", run.ID, "type", run.Type)
+			update = update.SetStatus(jobrun.StatusSucceeded).SetResult(result).SetProgress(100)
+		}
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		log.S.Errorw("Failed to update job status", "id", run.ID, "error", err)
+	} else if updated == 0 {
+		log.S.Warnw("Job was failed by another worker, as its lease expired", "id", run.ID, "type", run.Type)
+	}
+}
+
+// watchCancellation sends the heartbeats of the run and polls whether cancelling the run was requested until the
+// handler is done, and cancels the context of the handler if so.
+func (w *Worker) watchCancellation(ctx context.Context, client *ent.Client, run *ent.JobRun, cancel context.CancelFunc, canceled, done chan struct{}) {
+	ticker := time.NewTicker(min(w.interval, w.lease/3))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := client.JobRun.UpdateOneID(run.ID).SetHeartbeatAt(time.Now()).SetUpdatedBy(Subject).Exec(ctx)
+		if err != nil {
+			log.S.Errorw("Failed to send job heartbeat", "id", run.ID, "error", err)
+		}
+
+		requested, err := client.JobRun.Query().
+			Where(jobrun.ID(run.ID), jobrun.CancelRequested(true)).
+			Exist(ctx)
+		if err != nil {
+			log.S.Errorw("Failed to check for job cancellation", "id", run.ID, "error", err)
+			continue
+		}
+
+		if requested {
+			close(canceled)
+			cancel()
+			return
+		}
+	}
+}
+
+// handle runs the handler of the job, a panicking handler fails the job instead of the worker.
+func (w *Worker) handle(ctx context.Context, client *ent.Client, run *ent.JobRun) (result string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+
+	return w.handlers[run.Type](ctx, client, run)
+}
+
+func New(handlers map[string]Handler, housekeeping ...Task) *Worker {
+	return &Worker{
+		handlers:     handlers,
+		housekeeping: housekeeping,
+		interval:     env.GetWorkerPollInterval(),
+		lease:        env.GetWorkerLease(),
+	}
+}
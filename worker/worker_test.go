@@ -0,0 +1,253 @@
+package worker
+
+import (
+	"context"
+	"dig-inv/ent"
+	"dig-inv/ent/jobrun"
+	"dig-inv/store/storetest"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWorker_RunPending(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	executed := make([]string, 0)
+	w := New(map[string]Handler{
+		"echo": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			executed = append(executed, run.Parameters["value"])
+			return run.Parameters["value"], nil
+		},
+		"fail": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			return "", errors.New("provider unavailable")
+		},
+		"panic": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			panic("unexpected response")
+		},
+	})
+
+	first, err := Enqueue(ctx, client, "echo", map[string]string{"value": "first"}, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+	failing, err := Enqueue(ctx, client, "fail", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+	panicking, err := Enqueue(ctx, client, "panic", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+	unknown, err := Enqueue(ctx, client, "unknown", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+	if _, err := Enqueue(ctx, client, "echo", map[string]string{"value": "second"}, "tester"); err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	count, err := w.RunPending(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	if count != 4 {
+		t.Errorf("Expected 4 executed jobs, got %d", count)
+	}
+
+	if len(executed) != 2 || executed[0] != "first" || executed[1] != "second" {
+		t.Errorf("Expected jobs to run in queue order, got %v", executed)
+	}
+
+	run := client.JobRun.GetX(ctx, first.ID)
+	if run.Status != jobrun.StatusSucceeded || run.Result != "first" || run.StartedAt == nil || run.FinishedAt == nil {
+		t.Errorf("Unexpected succeeded run: %v", run)
+	}
+
+	run = client.JobRun.GetX(ctx, failing.ID)
+	if run.Status != jobrun.StatusFailed || run.Error != "provider unavailable" {
+		t.Errorf("Unexpected failed run: %v", run)
+	}
+
+	run = client.JobRun.GetX(ctx, panicking.ID)
+	if run.Status != jobrun.StatusFailed || run.Error != "job panicked: unexpected response" {
+		t.Errorf("Unexpected panicked run: %v", run)
+	}
+
+	// jobs without handler are left for workers that know them
+	run = client.JobRun.GetX(ctx, unknown.ID)
+	if run.Status != jobrun.StatusQueued {
+		t.Errorf("Expected unknown job to stay queued, got %s", run.Status)
+	}
+}
+
+func TestWorker_Claim(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	w := New(map[string]Handler{"claim": nil})
+
+	queued, err := Enqueue(ctx, client, "claim", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	run, err := w.claim(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to claim job: %v", err)
+	}
+
+	if run == nil || run.ID != queued.ID || run.Status != jobrun.StatusRunning {
+		t.Fatalf("Expected queued job to be claimed, got %v", run)
+	}
+
+	// a second worker must not get the same job
+	run, err = New(map[string]Handler{"claim": nil}).claim(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to claim job: %v", err)
+	}
+
+	if run != nil {
+		t.Errorf("Expected running job not to be claimed again, got %v", run)
+	}
+}
+
+func TestWorker_Report(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	w := New(map[string]Handler{
+		"sync": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			for i := range LogLines + 5 {
+				Logf(ctx, "synced server %d", i)
+			}
+			ReportProgress(ctx, 50)
+
+			return "", errors.New("rate limited")
+		},
+	})
+
+	queued, err := Enqueue(ctx, client, "sync", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	if _, err := w.RunPending(ctx, client); err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	run := client.JobRun.GetX(ctx, queued.ID)
+	lines := strings.Split(run.Log, "\n")
+	if len(lines) != LogLines || !strings.HasSuffix(lines[0], "synced server 5") || !strings.HasSuffix(lines[LogLines-1], "synced server 104") {
+		t.Errorf("Expected the last %d log lines, got %d: %q ... %q", LogLines, len(lines), lines[0], lines[len(lines)-1])
+	}
+
+	if run.Progress != 50 || run.Status != jobrun.StatusFailed {
+		t.Errorf("Unexpected failed run: %v", run)
+	}
+}
+
+func TestWorker_Cancel(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	w := New(map[string]Handler{
+		"wait": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			<-ctx.Done()
+			return "", ctx.Err()
+		},
+	})
+	w.interval = 10 * time.Millisecond
+
+	queued, err := Enqueue(ctx, client, "wait", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	go func() {
+		for !client.JobRun.Query().Where(jobrun.ID(queued.ID), jobrun.StatusEQ(jobrun.StatusRunning)).ExistX(ctx) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		client.JobRun.UpdateOneID(queued.ID).SetCancelRequested(true).SetUpdatedBy("tester").ExecX(ctx)
+	}()
+
+	runCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if _, err := w.RunPending(runCtx, client); err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	run := client.JobRun.GetX(ctx, queued.ID)
+	if run.Status != jobrun.StatusCanceled || run.FinishedAt == nil {
+		t.Errorf("Expected canceled run, got %v", run)
+	}
+}
+
+func TestWorker_Lease(t *testing.T) {
+	client := storetest.OpenClient(t, "worker")
+	ctx := context.Background()
+
+	w := New(map[string]Handler{
+		"beat": func(ctx context.Context, client *ent.Client, run *ent.JobRun) (string, error) {
+			time.Sleep(100 * time.Millisecond)
+			return "alive", nil
+		},
+	})
+	w.lease = 30 * time.Millisecond
+
+	// the worker that claimed these runs crashed
+	crashed := New(map[string]Handler{"beat": nil, "stale": nil})
+	for _, jobType := range []string{"beat", "stale"} {
+		if _, err := Enqueue(ctx, client, jobType, nil, "tester"); err != nil {
+			t.Fatalf("Failed to queue job: %v", err)
+		}
+	}
+
+	stale, err := crashed.claim(ctx, client)
+	if err != nil || stale == nil {
+		t.Fatalf("Failed to claim job: %v", err)
+	}
+	client.JobRun.UpdateOneID(stale.ID).SetHeartbeatAt(time.Now().Add(-time.Minute)).SetUpdatedBy("tester").ExecX(ctx)
+
+	alive, err := crashed.claim(ctx, client)
+	if err != nil || alive == nil {
+		t.Fatalf("Failed to claim job: %v", err)
+	}
+
+	queued, err := Enqueue(ctx, client, "beat", nil, "tester")
+	if err != nil {
+		t.Fatalf("Failed to queue job: %v", err)
+	}
+
+	if _, err := w.RunPending(ctx, client); err != nil {
+		t.Fatalf("Failed to run jobs: %v", err)
+	}
+
+	stale = client.JobRun.GetX(ctx, stale.ID)
+	if stale.Status != jobrun.StatusFailed || stale.FinishedAt == nil || !strings.Contains(stale.Error, "worker stopped") {
+		t.Errorf("Expected run of the stopped worker to be failed, got %v", stale)
+	}
+
+	alive = client.JobRun.GetX(ctx, alive.ID)
+	if alive.Status != jobrun.StatusRunning {
+		t.Errorf("Expected run with a recent heartbeat to keep running, got %v", alive)
+	}
+
+	// the heartbeats of a long running job keep it from expiring
+	run := client.JobRun.GetX(ctx, queued.ID)
+	if run.Status != jobrun.StatusSucceeded || run.HeartbeatAt == nil || !run.HeartbeatAt.After(*run.StartedAt) {
+		t.Errorf("Expected heartbeats of the succeeded run, got %v", run)
+	}
+
+	if err := w.failExpired(ctx, client); err != nil {
+		t.Fatalf("Failed to fail expired jobs: %v", err)
+	}
+
+	if run := client.JobRun.GetX(ctx, alive.ID); run.Status != jobrun.StatusFailed {
+		t.Errorf("Expected run without heartbeats to be failed after the lease, got %v", run)
+	}
+}